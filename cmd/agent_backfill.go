@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"errors"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethpandaops/tracoor/pkg/agent"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	backfillCfgFile          string
+	backfillFromSlot         uint64
+	backfillToSlot           uint64
+	backfillFromBlock        uint64
+	backfillToBlock          uint64
+	backfillConcurrency      int
+	backfillProgressFile     string
+	backfillProgressInterval time.Duration
+)
+
+// agentBackfillCmd represents the agent backfill command.
+var agentBackfillCmd = &cobra.Command{
+	Use:   "backfill",
+	Short: "Backfills a historical range of slots or execution blocks.",
+	Long: `Fetches and indexes a historical range of slots or execution blocks from
+	the configured nodes, ignoring the age limits of the live agent. A slot range captures
+	beacon states, beacon blocks, blob sidecars, execution block traces and execution witnesses
+	according to the enabled features, while a block range only captures execution block traces
+	and witnesses. Ranges far behind head require archive nodes.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		initCommon()
+
+		opts, err := backfillOptionsFromFlags(cmd)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.WithField("location", backfillCfgFile).Info("Loading config")
+
+		config, err := loadagentConfigFromFile(backfillCfgFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Info("Config loaded")
+
+		logLevel, err := logrus.ParseLevel(config.LoggingLevel)
+		if err != nil {
+			log.WithField("logLevel", config.LoggingLevel).Fatal("invalid logging level")
+		}
+
+		log.SetLevel(logLevel)
+
+		// Stop cleanly on a signal so progress is saved and the backfill can be resumed.
+		ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGTERM, syscall.SIGINT)
+		defer cancel()
+
+		a, err := agent.New(ctx, log, config)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := a.Backfill(ctx, opts); err != nil {
+			log.Fatal(err)
+		}
+
+		log.Info("tracoor agent backfill complete - cya!")
+	},
+}
+
+func init() {
+	agentCmd.AddCommand(agentBackfillCmd)
+
+	flags := agentBackfillCmd.Flags()
+
+	flags.StringVar(&backfillCfgFile, "config", "agent.yaml", "agent config file (default is agent.yaml)")
+	flags.Uint64Var(&backfillFromSlot, "from-slot", 0, "first slot to backfill")
+	flags.Uint64Var(&backfillToSlot, "to-slot", 0, "last slot to backfill (inclusive)")
+	flags.Uint64Var(&backfillFromBlock, "from-block", 0, "first execution block number to backfill traces for")
+	flags.Uint64Var(&backfillToBlock, "to-block", 0, "last execution block number to backfill traces for (inclusive)")
+	flags.IntVar(&backfillConcurrency, "concurrency", 4, "number of slots or blocks processed at once")
+	flags.StringVar(&backfillProgressFile, "progress-file", "", "file to save progress to. An existing file resumes the backfill")
+	flags.DurationVar(&backfillProgressInterval, "progress-interval", 30*time.Second, "how often progress is reported and saved")
+
+	agentBackfillCmd.MarkFlagsRequiredTogether("from-slot", "to-slot")
+	agentBackfillCmd.MarkFlagsRequiredTogether("from-block", "to-block")
+	agentBackfillCmd.MarkFlagsMutuallyExclusive("from-slot", "from-block")
+	agentBackfillCmd.MarkFlagsOneRequired("from-slot", "from-block")
+}
+
+func backfillOptionsFromFlags(cmd *cobra.Command) (*agent.BackfillOptions, error) {
+	opts := &agent.BackfillOptions{
+		Concurrency:      backfillConcurrency,
+		ProgressFile:     backfillProgressFile,
+		ProgressInterval: backfillProgressInterval,
+	}
+
+	switch {
+	case cmd.Flags().Changed("from-slot"):
+		opts.Target = agent.BackfillTargetSlot
+		opts.From = backfillFromSlot
+		opts.To = backfillToSlot
+	case cmd.Flags().Changed("from-block"):
+		opts.Target = agent.BackfillTargetBlock
+		opts.From = backfillFromBlock
+		opts.To = backfillToBlock
+	default:
+		return nil, errors.New("either --from-slot/--to-slot or --from-block/--to-block is required")
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"os/signal"
+	"syscall"
+
+	"github.com/ethpandaops/tracoor/pkg/agent"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var forkChoiceCfgFile string
+
+// agentForkChoiceCmd represents the agent fork-choice command.
+var agentForkChoiceCmd = &cobra.Command{
+	Use:   "fork-choice",
+	Short: "Captures the current fork choice of the beacon node.",
+	Long: `Fetches the fork choice of the configured beacon node once and indexes it with
+	the reason "manual". Useful to grab evidence while investigating a chain split or
+	reorg without waiting for the next scheduled capture.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		initCommon()
+
+		log.WithField("location", forkChoiceCfgFile).Info("Loading config")
+
+		config, err := loadagentConfigFromFile(forkChoiceCfgFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Info("Config loaded")
+
+		logLevel, err := logrus.ParseLevel(config.LoggingLevel)
+		if err != nil {
+			log.WithField("logLevel", config.LoggingLevel).Fatal("invalid logging level")
+		}
+
+		log.SetLevel(logLevel)
+
+		ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGTERM, syscall.SIGINT)
+		defer cancel()
+
+		a, err := agent.New(ctx, log, config)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := a.CaptureForkChoice(ctx); err != nil {
+			log.Fatal(err)
+		}
+
+		log.Info("Fork choice captured")
+	},
+}
+
+func init() {
+	agentCmd.AddCommand(agentForkChoiceCmd)
+
+	agentForkChoiceCmd.Flags().StringVar(&forkChoiceCfgFile, "config", "agent.yaml", "agent config file (default is agent.yaml)")
+}
@@ -0,0 +1,85 @@
+//nolint:dupl // disable duplicate code warning for cmds
+package cmd
+
+import (
+	"os"
+
+	"github.com/creasty/defaults"
+	"github.com/ethpandaops/tracoor/pkg/engineproxy"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	engineProxyCfgFile string
+)
+
+// engineProxyCmd represents the engine-proxy command.
+var engineProxyCmd = &cobra.Command{
+	Use:   "engine-proxy",
+	Short: "Runs tracoor as an Engine API proxy.",
+	Long: `Runs tracoor between a consensus and execution client on the authenticated
+	Engine API, capturing every payload the execution client rejects as invalid.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		initCommon()
+
+		log.WithField("location", engineProxyCfgFile).Info("Loading config")
+
+		config, err := loadEngineProxyConfigFromFile(engineProxyCfgFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Info("Config loaded")
+
+		logLevel, err := logrus.ParseLevel(config.LoggingLevel)
+		if err != nil {
+			log.WithField("logLevel", config.LoggingLevel).Fatal("invalid logging level")
+		}
+
+		log.SetLevel(logLevel)
+
+		proxy, err := engineproxy.New(cmd.Context(), log, config)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := proxy.Start(cmd.Context()); err != nil {
+			log.Fatal(err)
+		}
+
+		log.Info("tracoor engine proxy exited - cya!")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(engineProxyCmd)
+
+	engineProxyCmd.Flags().StringVar(&engineProxyCfgFile, "config", "engine-proxy.yaml", "config file (default is engine-proxy.yaml)")
+}
+
+func loadEngineProxyConfigFromFile(file string) (*engineproxy.Config, error) {
+	if file == "" {
+		file = "engine-proxy.yaml"
+	}
+
+	config := &engineproxy.Config{}
+
+	if err := defaults.Set(config); err != nil {
+		return nil, err
+	}
+
+	yamlFile, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	type plain engineproxy.Config
+
+	if err := yaml.Unmarshal(yamlFile, (*plain)(config)); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
@@ -19,14 +19,13 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/tracoor/pkg/agent/ethereum"
 	"github.com/ethpandaops/tracoor/pkg/agent/indexer"
+	"github.com/ethpandaops/tracoor/pkg/agent/queue"
 	"github.com/ethpandaops/tracoor/pkg/compression"
 	"github.com/ethpandaops/tracoor/pkg/networks"
 	"github.com/ethpandaops/tracoor/pkg/observability"
 	"github.com/ethpandaops/tracoor/pkg/proto/tracoor"
-	pIndexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
 	"github.com/ethpandaops/tracoor/pkg/store"
 	"github.com/go-co-op/gocron"
-	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
@@ -45,14 +44,29 @@ type agent struct {
 
 	store store.Store
 
-	beaconStateQueue         chan *BeaconStateRequest
-	beaconBlockQueue         chan *BeaconBlockRequest
-	beaconBadBlockQueue      chan *BeaconBadBlockRequest
-	beaconBadBlobQueue       chan *BeaconBadBlobRequest
-	executionBlockTraceQueue chan *ExecutionBlockTraceRequest
-	executionBadBlockQueue   chan *ExecutionBadBlockRequest
-
-	compressor *compression.Compressor
+	queues *queue.Store
+	// pending holds items until they're persisted to their queues.
+	pending chan *pendingItem
+	// persisted is closed once every pending item has been persisted.
+	persisted chan struct{}
+
+	beaconStateQueue          *queue.Queue
+	finalizedBeaconStateQueue *queue.Queue
+	beaconBlockQueue          *queue.Queue
+	blobSidecarQueue          *queue.Queue
+	forkChoiceQueue           *queue.Queue
+	beaconBadBlockQueue       *queue.Queue
+	beaconBadBlobQueue        *queue.Queue
+	executionBlockTraceQueue  *queue.Queue
+	executionWitnessQueue     *queue.Queue
+	executionBadBlockQueue    *queue.Queue
+
+	compressor            *compression.Compressor
+	compressionAlgorithms map[store.DataType]*compression.CompressionAlgorithm
+
+	indexCache *indexCache
+
+	status *agentStatus
 }
 
 const namespace = "tracoor_agent"
@@ -73,31 +87,67 @@ func New(ctx context.Context, log logrus.FieldLogger, config *Config) (*agent, e
 		return nil, err
 	}
 
-	st, err := store.NewStore(namespace, log, config.Store.Type, config.Store.Config, store.DefaultOptions())
+	// Agents uploading through the server don't have a store of their own.
+	var st store.Store
+
+	if config.Store.Type != store.ServerStoreType {
+		st, err = store.NewStore(namespace, log, config.Store.Type, config.Store.Config, store.DefaultOptions())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	compressionAlgorithms, err := config.Compression.Algorithms()
 	if err != nil {
 		return nil, err
 	}
 
+	queues, err := queue.NewStore(log, config.DataDir, &config.Queue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open queue: %w", err)
+	}
+
 	return &agent{
-		Config:                   config,
-		node:                     node,
-		log:                      log,
-		metrics:                  GetMetricsInstance(namespace),
-		scheduler:                gocron.NewScheduler(time.Local),
-		indexer:                  indexerClient,
-		store:                    st,
-		beaconStateQueue:         make(chan *BeaconStateRequest, 1000),
-		beaconBlockQueue:         make(chan *BeaconBlockRequest, 1000),
-		beaconBadBlockQueue:      make(chan *BeaconBadBlockRequest, 1000),
-		beaconBadBlobQueue:       make(chan *BeaconBadBlobRequest, 1000),
-		executionBlockTraceQueue: make(chan *ExecutionBlockTraceRequest, 1000),
-		executionBadBlockQueue:   make(chan *ExecutionBadBlockRequest, 1000),
-		compressor:               compression.NewCompressor(),
+		Config:                    config,
+		node:                      node,
+		log:                       log,
+		metrics:                   GetMetricsInstance(namespace),
+		scheduler:                 gocron.NewScheduler(time.Local),
+		indexer:                   indexerClient,
+		store:                     st,
+		queues:                    queues,
+		pending:                   make(chan *pendingItem, enqueueBufferSize),
+		persisted:                 make(chan struct{}),
+		beaconStateQueue:          queues.Queue(string(BeaconStateQueue), defaultQueueOptions[BeaconStateQueue]),
+		finalizedBeaconStateQueue: queues.Queue(string(FinalizedBeaconStateQueue), defaultQueueOptions[FinalizedBeaconStateQueue]),
+		beaconBlockQueue:          queues.Queue(string(BeaconBlockQueue), defaultQueueOptions[BeaconBlockQueue]),
+		blobSidecarQueue:          queues.Queue(string(BlobSidecarQueue), defaultQueueOptions[BlobSidecarQueue]),
+		forkChoiceQueue:           queues.Queue(string(ForkChoiceQueue), defaultQueueOptions[ForkChoiceQueue]),
+		beaconBadBlockQueue:       queues.Queue(string(BeaconBadBlockQueue), defaultQueueOptions[BeaconBadBlockQueue]),
+		beaconBadBlobQueue:        queues.Queue(string(BeaconBadBlobQueue), defaultQueueOptions[BeaconBadBlobQueue]),
+		executionBlockTraceQueue:  queues.Queue(string(ExecutionBlockTraceQueue), defaultQueueOptions[ExecutionBlockTraceQueue]),
+		executionWitnessQueue:     queues.Queue(string(ExecutionWitnessQueue), defaultQueueOptions[ExecutionWitnessQueue]),
+		executionBadBlockQueue:    queues.Queue(string(ExecutionBadBlockQueue), defaultQueueOptions[ExecutionBadBlockQueue]),
+		compressor:                compression.NewCompressor(),
+		compressionAlgorithms:     compressionAlgorithms,
+		indexCache:                newIndexCache(log, &config.IndexCache),
+		status:                    newAgentStatus(),
 	}, nil
 }
 
 //nolint:gocyclo // this is a complex function but most of it is event callbacks
 func (s *agent) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		defer close(s.persisted)
+
+		s.persistPending(ctx)
+	}()
+
+	go s.updateQueueSizeMetrics(ctx)
+
 	if s.Config.MetricsAddr != "" {
 		observability.StartMetricsServer(ctx, s.Config.MetricsAddr)
 	}
@@ -108,6 +158,14 @@ func (s *agent) Start(ctx context.Context) error {
 		}
 	}
 
+	if s.Config.Status.Addr != "" {
+		s.ServeStatus(ctx)
+	}
+
+	if s.Config.Capture.Addr != "" {
+		s.ServeCapture(ctx)
+	}
+
 	enabledFeatures := s.Config.Ethereum.Features.EnabledFlags()
 
 	s.log.
@@ -118,11 +176,16 @@ func (s *agent) Start(ctx context.Context) error {
 	s.node.OnReady(ctx, func(ctx context.Context) error {
 		s.log.Info("Ethereum node is ready, setting up beacon and execution events")
 
+		if err := s.indexCache.Seed(ctx, s.indexer, s.Config.Name, string(s.node.Beacon().Metadata().Network.Name)); err != nil {
+			s.log.WithError(err).Warn("Failed to seed index cache")
+		}
+
 		go s.processExecutionBlockTraceQueue(ctx)
+		go s.processExecutionWitnessQueue(ctx)
 		go s.processExecutionBadBlockQueue(ctx)
 
-		s.node.Beacon().Node().OnBlock(ctx, func(ctx context.Context, event *eth2v1.BlockEvent) error {
-			if !s.Config.Ethereum.Features.GetFetchExecutionBlockTrace() {
+		s.node.Beacon().OnBlock(ctx, func(ctx context.Context, event *eth2v1.BlockEvent) error {
+			if !s.Config.Ethereum.Features.GetFetchExecutionBlockTrace() && !s.Config.Ethereum.Features.GetFetchExecutionWitness() {
 				return nil
 			}
 
@@ -173,6 +236,7 @@ func (s *agent) Start(ctx context.Context) error {
 			}
 
 			s.enqueueExecutionBlockTrace(ctx, executionBlockHash, executionBlockNumberUint)
+			s.enqueueExecutionWitness(ctx, executionBlockHash, executionBlockNumberUint)
 
 			return nil
 		})
@@ -184,7 +248,10 @@ func (s *agent) Start(ctx context.Context) error {
 		s.log.Info("Beacon node is ready, setting up events that only depend on the beacon node")
 
 		go s.processBeaconStateQueue(ctx)
+		go s.processFinalizedBeaconStateQueue(ctx)
 		go s.processBeaconBlockQueue(ctx)
+		go s.processBlobSidecarQueue(ctx)
+		go s.processForkChoiceQueue(ctx)
 		go s.processBeaconBadBlockQueue(ctx)
 		go s.processBeaconBadBlobQueue(ctx)
 
@@ -192,7 +259,7 @@ func (s *agent) Start(ctx context.Context) error {
 			s.log.Fatal("Unable to determine Ethereum network. Provide an override network name via ethereum.overrideNetworkName")
 		}
 
-		s.node.Beacon().Node().OnBlock(ctx, func(ctx context.Context, event *eth2v1.BlockEvent) error {
+		s.node.Beacon().OnBlock(ctx, func(ctx context.Context, event *eth2v1.BlockEvent) error {
 			logCtx := s.log.WithFields(logrus.Fields{
 				"event_topic": "block",
 				"slot":        event.Slot,
@@ -214,11 +281,25 @@ func (s *agent) Start(ctx context.Context) error {
 
 			s.enqueueBeaconState(ctx, event.Slot)
 			s.enqueueBeaconBlock(ctx, event.Slot)
+			s.enqueueBlobSidecar(ctx, event.Slot)
 
 			return nil
 		})
 
-		s.node.Beacon().Node().OnChainReOrg(ctx, func(ctx context.Context, chainReorg *eth2v1.ChainReorgEvent) error {
+		s.node.Beacon().OnFinalizedCheckpoint(ctx, func(ctx context.Context, event *eth2v1.FinalizedCheckpointEvent) error {
+			s.log.WithFields(logrus.Fields{
+				"event_topic": "finalized_checkpoint",
+				"epoch":       event.Epoch,
+				"root":        rootAsString(event.Block),
+				"purpose":     "finalized_beacon_state",
+			}).Debug("Finalized checkpoint received")
+
+			s.enqueueFinalizedBeaconState(ctx, event.Epoch, rootAsString(event.Block))
+
+			return nil
+		})
+
+		s.node.Beacon().OnChainReOrg(ctx, func(ctx context.Context, chainReorg *eth2v1.ChainReorgEvent) error {
 			logCtx := s.log.WithFields(
 				logrus.Fields{
 					"event_old_head_block": rootAsString(chainReorg.OldHeadBlock),
@@ -236,6 +317,13 @@ func (s *agent) Start(ctx context.Context) error {
 				},
 			).Info("Chain reorg detected")
 
+			// Capture the fork choice as close to the reorg as possible.
+			s.enqueueForkChoice(ctx, ForkChoiceReasonChainReorg)
+
+			if err := s.markOrphaned(ctx, chainReorg); err != nil {
+				logCtx.WithError(err).Error("Failed to mark the old head chain as orphaned")
+			}
+
 			// Go back and fetch all the new beacon states
 			headSlot, _, err := s.node.Beacon().Metadata().Wallclock().Now()
 			if err != nil {
@@ -247,11 +335,12 @@ func (s *agent) Start(ctx context.Context) error {
 
 				s.enqueueBeaconState(ctx, slot)
 				s.enqueueBeaconBlock(ctx, slot)
+				s.enqueueBlobSidecar(ctx, slot)
 			}
 
 			// Go back and fetch all the new execution block traces
 			for slot := chainReorg.Slot; slot < phase0.Slot(headSlot.Number()); slot++ {
-				if !s.Config.Ethereum.Features.GetFetchExecutionBlockTrace() {
+				if !s.Config.Ethereum.Features.GetFetchExecutionBlockTrace() && !s.Config.Ethereum.Features.GetFetchExecutionWitness() {
 					continue
 				}
 
@@ -283,6 +372,7 @@ func (s *agent) Start(ctx context.Context) error {
 				}).Info("Queueing up a fresh execution block trace index after a beacon chain reorg")
 
 				s.enqueueExecutionBlockTrace(ctx, executionBlockHash, executionBlockNumberUint)
+				s.enqueueExecutionWitness(ctx, executionBlockHash, executionBlockNumberUint)
 			}
 
 			return nil
@@ -295,24 +385,39 @@ func (s *agent) Start(ctx context.Context) error {
 		s.log.WithField("network", s.Config.Ethereum.OverrideNetworkName).Info("Overriding network name")
 	}
 
-	if s.Config.Ethereum.Features.GetFetchBeaconBadBlock() && s.Config.Ethereum.Beacon.InvalidGossipVerifiedBlocksPath != nil {
-		_, err := s.scheduler.Every(30).Seconds().Do(func() {
-			path := s.Config.Ethereum.Beacon.InvalidGossipVerifiedBlocksPath
-			if path != nil {
-				s.enqueueBeaconBadBlock(ctx, *path)
-			}
-		})
-		if err != nil {
-			return err
-		}
+	beaconConfig := s.Config.Ethereum.Beacon
+
+	if s.Config.Ethereum.Features.GetFetchBeaconBadBlock() && beaconConfig.InvalidGossipVerifiedBlocksPath != nil {
+		path := *beaconConfig.InvalidGossipVerifiedBlocksPath
+
+		go newDirWatcher(
+			s.log.WithField("watcher", "beacon_bad_block"),
+			path,
+			beaconConfig.GetInvalidGossipSettleTime(),
+			beaconConfig.GetInvalidGossipPollInterval(),
+			func(ctx context.Context) {
+				s.enqueueBeaconBadBlock(ctx, path)
+			},
+		).Start(ctx)
 	}
 
-	if s.Config.Ethereum.Features.GetFetchBeaconBadBlob() && s.Config.Ethereum.Beacon.InvalidGossipVerifiedBlobsPath != nil {
-		_, err := s.scheduler.Every(30).Seconds().Do(func() {
-			path := s.Config.Ethereum.Beacon.InvalidGossipVerifiedBlobsPath
-			if path != nil {
-				s.enqueueBeaconBadBlob(ctx, *path)
-			}
+	if s.Config.Ethereum.Features.GetFetchBeaconBadBlob() && beaconConfig.InvalidGossipVerifiedBlobsPath != nil {
+		path := *beaconConfig.InvalidGossipVerifiedBlobsPath
+
+		go newDirWatcher(
+			s.log.WithField("watcher", "beacon_bad_blob"),
+			path,
+			beaconConfig.GetInvalidGossipSettleTime(),
+			beaconConfig.GetInvalidGossipPollInterval(),
+			func(ctx context.Context) {
+				s.enqueueBeaconBadBlob(ctx, path)
+			},
+		).Start(ctx)
+	}
+
+	if s.Config.Ethereum.Features.GetFetchForkChoice() && s.Config.Ethereum.Beacon.ForkChoiceInterval != nil {
+		_, err := s.scheduler.Every(s.Config.Ethereum.Beacon.ForkChoiceInterval.Duration).Do(func() {
+			s.enqueueForkChoice(ctx, ForkChoiceReasonScheduled)
 		})
 		if err != nil {
 			return err
@@ -336,62 +441,41 @@ func (s *agent) Start(ctx context.Context) error {
 		return err
 	}
 
-	cancel := make(chan os.Signal, 1)
-	signal.Notify(cancel, syscall.SIGTERM, syscall.SIGINT)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
 
-	sig := <-cancel
+	sig := <-signals
 	s.log.Printf("Caught signal: %v", sig)
 
-	return nil
-}
-
-func (s *agent) performTokenHandshake(ctx context.Context) error {
-	s.log.Info("Performing token handshake")
+	s.scheduler.Stop()
 
-	// Perform a token handshake with the indexer to ensure we're connected to the same store.
-	// This is important for the indexer to be able to find the beacon states we upload.
+	// Stop the workers and persist anything still buffered.
+	cancel()
 
-	// First check the store. If the token already exists, download it and use it.
-	token := uuid.New().String()
+	<-s.persisted
 
-	exists, err := s.store.StorageHandshakeTokenExists(ctx, s.Config.Name)
-	if err != nil {
-		return fmt.Errorf("failed to check if storage handshake token exists: %w", err)
+	if err := s.queues.Close(); err != nil {
+		s.log.WithError(err).Error("Failed to close queue")
 	}
 
-	if exists {
-		token, err = s.store.GetStorageHandshakeToken(ctx, s.Config.Name)
-		if err != nil {
-			return fmt.Errorf("failed to get storage handshake token: %w", err)
-		}
+	return nil
+}
 
-		s.log.WithField("token", token).Debug("Storage handshake token already exists")
-	} else {
-		// Save the token to the store
-		if err = s.store.SaveStorageHandshakeToken(ctx, s.Config.Name, token); err != nil {
-			return fmt.Errorf("failed to save storage handshake token: %w", err)
-		}
+func (s *agent) performTokenHandshake(ctx context.Context) error {
+	if s.uploadThroughServer() {
+		// The server saves everything itself, so there's no storage to share.
+		s.log.Info("Uploading through the server, skipping the storage handshake")
 
-		// Sleep for a bit to give the store time to update
-		time.Sleep(500 * time.Millisecond)
-	}
+		s.status.recordHandshake(nil)
 
-	// Perform the handshake with the indexer
-	rsp, err := s.indexer.GetStorageHandshakeToken(ctx, &pIndexer.GetStorageHandshakeTokenRequest{
-		Node:  s.Config.Name,
-		Token: token,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to get storage handshake token from indexer: %w", err)
+		return nil
 	}
 
-	if rsp.Token != token {
-		return fmt.Errorf("storage handshake token mismatch: %s (ours) != %s (theirs)", token, rsp.Token)
-	}
+	err := s.indexer.PerformStorageHandshake(ctx, s.store, s.Config.Name)
 
-	s.log.Info("Storage handshake complete 🤝 - we are connected to the same storage backend as the indexer")
+	s.status.recordHandshake(err)
 
-	return nil
+	return err
 }
 
 func (s *agent) ServePProf(ctx context.Context) error {
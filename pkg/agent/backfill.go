@@ -0,0 +1,506 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/tracoor/pkg/networks"
+	"github.com/sirupsen/logrus"
+)
+
+// BackfillTarget is the kind of position a backfill walks over.
+type BackfillTarget string
+
+const (
+	// BackfillTargetSlot walks beacon slots, fetching beacon states, beacon
+	// blocks and execution block traces according to the enabled features.
+	BackfillTargetSlot BackfillTarget = "slot"
+	// BackfillTargetBlock walks execution block numbers, fetching execution block traces.
+	BackfillTargetBlock BackfillTarget = "block"
+)
+
+// BackfillOptions configures a backfill of a historical range.
+type BackfillOptions struct {
+	Target BackfillTarget
+	// From and To are the inclusive bounds of the range.
+	From uint64
+	To   uint64
+	// Concurrency is the number of positions processed at once.
+	Concurrency int
+	// ProgressFile is where progress is saved. If the file already exists the
+	// backfill resumes from it, retrying any positions that previously failed.
+	ProgressFile string
+	// ProgressInterval is how often progress is reported and saved.
+	ProgressInterval time.Duration
+}
+
+func (o *BackfillOptions) Validate() error {
+	if o.Target != BackfillTargetSlot && o.Target != BackfillTargetBlock {
+		return fmt.Errorf("invalid backfill target %q", o.Target)
+	}
+
+	if o.From > o.To {
+		return fmt.Errorf("invalid range: from (%d) is after to (%d)", o.From, o.To)
+	}
+
+	if o.Concurrency < 1 {
+		return errors.New("concurrency must be at least 1")
+	}
+
+	if o.ProgressInterval <= 0 {
+		return errors.New("progress interval must be greater than 0")
+	}
+
+	return nil
+}
+
+// backfillProgress tracks which positions of a backfill have been processed.
+type backfillProgress struct {
+	Target BackfillTarget `json:"target"`
+	From   uint64         `json:"from"`
+	To     uint64         `json:"to"`
+	// Next is the lowest position of the range that hasn't been processed.
+	// Every position before it has been attempted.
+	Next uint64 `json:"next"`
+	// Failed holds positions that were attempted and failed.
+	Failed []uint64 `json:"failed"`
+
+	mu        sync.Mutex
+	done      map[uint64]bool
+	failed    map[uint64]bool
+	processed int
+}
+
+func newBackfillProgress(opts *BackfillOptions) *backfillProgress {
+	return &backfillProgress{
+		Target: opts.Target,
+		From:   opts.From,
+		To:     opts.To,
+		Next:   opts.From,
+		Failed: []uint64{},
+		done:   make(map[uint64]bool),
+		failed: make(map[uint64]bool),
+	}
+}
+
+// loadBackfillProgress loads the progress file if it exists, otherwise a fresh
+// progress is returned.
+func loadBackfillProgress(path string, opts *BackfillOptions) (*backfillProgress, error) {
+	progress := newBackfillProgress(opts)
+
+	if path == "" {
+		return progress, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return progress, nil
+		}
+
+		return nil, fmt.Errorf("failed to read progress file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, fmt.Errorf("failed to parse progress file: %w", err)
+	}
+
+	if progress.Target != opts.Target || progress.From != opts.From || progress.To != opts.To {
+		return nil, fmt.Errorf(
+			"progress file %s is for a different backfill (%s %d-%d)",
+			path, progress.Target, progress.From, progress.To,
+		)
+	}
+
+	for _, position := range progress.Failed {
+		progress.failed[position] = true
+	}
+
+	return progress, nil
+}
+
+// pending returns the positions that still need processing, previously failed
+// positions first.
+func (p *backfillProgress) pending() (retries []uint64, next uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	retries = make([]uint64, 0, len(p.failed))
+
+	for position := range p.failed {
+		if position < p.Next {
+			retries = append(retries, position)
+		}
+	}
+
+	sort.Slice(retries, func(i, j int) bool { return retries[i] < retries[j] })
+
+	return retries, p.Next
+}
+
+// complete records the outcome of processing a position.
+func (p *backfillProgress) complete(position uint64, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.processed++
+
+	if err != nil {
+		p.failed[position] = true
+	} else {
+		delete(p.failed, position)
+	}
+
+	if position < p.Next {
+		return
+	}
+
+	p.done[position] = true
+
+	for p.done[p.Next] {
+		delete(p.done, p.Next)
+
+		p.Next++
+	}
+}
+
+// stats returns the number of positions processed in this run and the number
+// of positions currently marked as failed.
+func (p *backfillProgress) stats() (processed, failed int, next uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.processed, len(p.failed), p.Next
+}
+
+func (p *backfillProgress) save(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	p.mu.Lock()
+
+	p.Failed = make([]uint64, 0, len(p.failed))
+	for position := range p.failed {
+		p.Failed = append(p.Failed, position)
+	}
+
+	sort.Slice(p.Failed, func(i, j int) bool { return p.Failed[i] < p.Failed[j] })
+
+	data, err := json.MarshalIndent(p, "", "  ")
+
+	p.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so an interrupted write can't corrupt the progress.
+	tmp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// Backfill fetches and indexes a historical range of slots or execution blocks.
+// Unlike the live event driven capture, positions aren't skipped for being
+// too old, so an archive node is required for ranges far behind head.
+func (s *agent) Backfill(ctx context.Context, opts *BackfillOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	if opts.Target == BackfillTargetBlock &&
+		!s.Config.Ethereum.Features.GetFetchExecutionBlockTrace() &&
+		!s.Config.Ethereum.Features.GetFetchExecutionWitness() {
+		return errors.New("backfilling execution blocks requires the fetchExecutionBlockTrace or fetchExecutionWitness feature")
+	}
+
+	progress, err := loadBackfillProgress(opts.ProgressFile, opts)
+	if err != nil {
+		return err
+	}
+
+	if err := s.performTokenHandshake(ctx); err != nil {
+		return err
+	}
+
+	if err := s.waitForNode(ctx); err != nil {
+		return err
+	}
+
+	if s.node.Beacon().Metadata().Network.Name == networks.NetworkNameUnknown {
+		return errors.New("unable to determine Ethereum network. Provide an override network name via ethereum.overrideNetworkName")
+	}
+
+	retries, next := progress.pending()
+
+	total := len(retries)
+	if next <= opts.To {
+		total += int(opts.To - next + 1) //nolint:gosec // ranges are far smaller than an int.
+	}
+
+	log := s.log.WithFields(logrus.Fields{
+		"target":      opts.Target,
+		"from":        opts.From,
+		"to":          opts.To,
+		"next":        next,
+		"retries":     len(retries),
+		"concurrency": opts.Concurrency,
+	})
+
+	log.Info("Starting backfill")
+
+	positions := make(chan uint64)
+
+	go func() {
+		defer close(positions)
+
+		for _, position := range retries {
+			select {
+			case positions <- position:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		for position := next; position <= opts.To; position++ {
+			select {
+			case positions <- position:
+			case <-ctx.Done():
+				return
+			}
+
+			// Avoid overflowing when the range ends at the maximum value.
+			if position == opts.To {
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < opts.Concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for position := range positions {
+				err := s.backfillPosition(ctx, opts.Target, position)
+				if err != nil {
+					s.log.
+						WithError(err).
+						WithField(string(opts.Target), position).
+						Error("Failed to backfill")
+				}
+
+				progress.complete(position, err)
+			}
+		}()
+	}
+
+	finished := make(chan struct{})
+
+	go func() {
+		wg.Wait()
+		close(finished)
+	}()
+
+	start := time.Now()
+	ticker := time.NewTicker(opts.ProgressInterval)
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.reportBackfillProgress(progress, opts.ProgressFile, total, start)
+		case <-finished:
+			s.reportBackfillProgress(progress, opts.ProgressFile, total, start)
+
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if _, failed, _ := progress.stats(); failed > 0 {
+				return fmt.Errorf("backfill finished with %d failed %ss. Run it again to retry them", failed, opts.Target)
+			}
+
+			log.WithField("duration", time.Since(start).String()).Info("Backfill complete")
+
+			return nil
+		}
+	}
+}
+
+func (s *agent) reportBackfillProgress(progress *backfillProgress, path string, total int, start time.Time) {
+	if err := progress.save(path); err != nil {
+		s.log.WithError(err).WithField("path", path).Error("Failed to save backfill progress")
+	}
+
+	processed, failed, next := progress.stats()
+	remaining := total - processed
+	elapsed := time.Since(start)
+
+	fields := logrus.Fields{
+		"processed": processed,
+		"remaining": remaining,
+		"failed":    failed,
+		"next":      next,
+		"elapsed":   elapsed.Truncate(time.Second).String(),
+	}
+
+	if processed > 0 {
+		rate := float64(processed) / elapsed.Seconds()
+		fields["rate_per_second"] = fmt.Sprintf("%.2f", rate)
+		fields["eta"] = (time.Duration(float64(remaining)/rate) * time.Second).Truncate(time.Second).String()
+	}
+
+	s.log.WithFields(fields).Info("Backfill progress")
+}
+
+// waitForNode starts the ethereum node and blocks until both the beacon and
+// execution nodes are ready.
+func (s *agent) waitForNode(ctx context.Context) error {
+	ready := make(chan struct{})
+
+	var once sync.Once
+
+	s.node.OnReady(ctx, func(_ context.Context) error {
+		once.Do(func() { close(ready) })
+
+		return nil
+	})
+
+	if err := s.node.Start(ctx); err != nil {
+		return err
+	}
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *agent) backfillPosition(ctx context.Context, target BackfillTarget, position uint64) error {
+	if target == BackfillTargetBlock {
+		blockHash, err := s.node.Execution().GetBlockHashByNumber(ctx, position)
+		if err != nil {
+			return fmt.Errorf("failed to fetch execution block hash: %w", err)
+		}
+
+		return s.backfillExecutionBlock(ctx, position, blockHash)
+	}
+
+	return s.backfillSlot(ctx, phase0.Slot(position))
+}
+
+func (s *agent) backfillSlot(ctx context.Context, slot phase0.Slot) error {
+	features := s.Config.Ethereum.Features
+
+	var errs []error
+
+	if features.GetFetchBeaconState() {
+		if err := s.fetchAndIndexBeaconState(ctx, slot); err != nil {
+			errs = append(errs, fmt.Errorf("beacon state: %w", err))
+		}
+	}
+
+	if !features.GetFetchBeaconBlock() &&
+		!features.GetFetchBlobSidecar() &&
+		!features.GetFetchExecutionBlockTrace() &&
+		!features.GetFetchExecutionWitness() {
+		return errors.Join(errs...)
+	}
+
+	// Blocks, sidecars and traces only exist for slots with a block.
+	if _, err := s.node.Beacon().Node().FetchBlockRoot(ctx, fmt.Sprintf("%d", slot)); err != nil {
+		var apiErr *api.Error
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			s.log.WithField("slot", slot).Debug("Skipping beacon block, blob sidecars and execution block data for empty slot")
+
+			return errors.Join(errs...)
+		}
+
+		return errors.Join(append(errs, fmt.Errorf("failed to fetch beacon block root: %w", err))...)
+	}
+
+	if features.GetFetchBeaconBlock() {
+		if err := s.fetchAndIndexBeaconBlock(ctx, slot); err != nil {
+			errs = append(errs, fmt.Errorf("beacon block: %w", err))
+		}
+	}
+
+	if features.GetFetchBlobSidecar() {
+		if err := s.fetchAndIndexBlobSidecars(ctx, slot); err != nil {
+			errs = append(errs, fmt.Errorf("blob sidecars: %w", err))
+		}
+	}
+
+	if features.GetFetchExecutionBlockTrace() || features.GetFetchExecutionWitness() {
+		if err := s.backfillExecutionBlockForSlot(ctx, slot); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// backfillExecutionBlock fetches and indexes the enabled execution block artifacts for a block.
+func (s *agent) backfillExecutionBlock(ctx context.Context, blockNumber uint64, blockHash string) error {
+	features := s.Config.Ethereum.Features
+
+	var errs []error
+
+	if features.GetFetchExecutionBlockTrace() {
+		if err := s.fetchAndIndexExecutionBlockTrace(ctx, blockNumber, blockHash); err != nil {
+			errs = append(errs, fmt.Errorf("execution block trace: %w", err))
+		}
+	}
+
+	if features.GetFetchExecutionWitness() {
+		if err := s.fetchAndIndexExecutionWitness(ctx, blockNumber, blockHash); err != nil {
+			errs = append(errs, fmt.Errorf("execution witness: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *agent) backfillExecutionBlockForSlot(ctx context.Context, slot phase0.Slot) error {
+	block, err := s.node.Beacon().GetVersionImmuneBlock(ctx, fmt.Sprintf("%d", slot))
+	if err != nil {
+		return fmt.Errorf("failed to fetch beacon block: %w", err)
+	}
+
+	payload := block.Data.Message.Body.ExecutionPayload
+
+	// Blocks from before the merge don't have an execution payload.
+	if payload.BlockNumber == "" || payload.BlockNumber == "0" {
+		return nil
+	}
+
+	blockNumber, err := strconv.ParseUint(payload.BlockNumber, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse execution block number: %w", err)
+	}
+
+	return s.backfillExecutionBlock(ctx, blockNumber, payload.BlockHash)
+}
@@ -0,0 +1,78 @@
+package agent
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBackfillOptions() *BackfillOptions {
+	return &BackfillOptions{
+		Target:           BackfillTargetSlot,
+		From:             10,
+		To:               15,
+		Concurrency:      2,
+		ProgressInterval: time.Second,
+	}
+}
+
+func TestBackfillProgress_CompleteOutOfOrder(t *testing.T) {
+	progress := newBackfillProgress(testBackfillOptions())
+
+	progress.complete(11, nil)
+	assert.Equal(t, uint64(10), progress.Next)
+
+	progress.complete(10, errors.New("boom"))
+	assert.Equal(t, uint64(12), progress.Next)
+
+	progress.complete(12, nil)
+	assert.Equal(t, uint64(13), progress.Next)
+
+	processed, failed, next := progress.stats()
+	assert.Equal(t, 3, processed)
+	assert.Equal(t, 1, failed)
+	assert.Equal(t, uint64(13), next)
+
+	retries, next := progress.pending()
+	assert.Equal(t, []uint64{10}, retries)
+	assert.Equal(t, uint64(13), next)
+
+	// A successful retry clears the failure without moving the watermark.
+	progress.complete(10, nil)
+
+	retries, next = progress.pending()
+	assert.Empty(t, retries)
+	assert.Equal(t, uint64(13), next)
+}
+
+func TestBackfillProgress_Resume(t *testing.T) {
+	opts := testBackfillOptions()
+	opts.ProgressFile = filepath.Join(t.TempDir(), "progress.json")
+
+	progress, err := loadBackfillProgress(opts.ProgressFile, opts)
+	require.NoError(t, err)
+
+	progress.complete(10, nil)
+	progress.complete(11, errors.New("boom"))
+	progress.complete(13, nil)
+
+	require.NoError(t, progress.save(opts.ProgressFile))
+
+	resumed, err := loadBackfillProgress(opts.ProgressFile, opts)
+	require.NoError(t, err)
+
+	retries, next := resumed.pending()
+	assert.Equal(t, []uint64{11}, retries)
+	// 13 completed but 12 didn't, so the backfill resumes from 12.
+	assert.Equal(t, uint64(12), next)
+
+	other := testBackfillOptions()
+	other.To = 20
+
+	_, err = loadBackfillProgress(opts.ProgressFile, other)
+	assert.Error(t, err)
+}
@@ -0,0 +1,305 @@
+package agent
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	eapi "github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+)
+
+// captureTimeout bounds a single capture request, including looking up the
+// IDs of the captured artifacts.
+const captureTimeout = 2 * time.Minute
+
+type CaptureConfig struct {
+	// Addr is the address the capture API listens on. The API is disabled if empty.
+	Addr string `yaml:"addr"`
+	// AuthorizationToken is required as a bearer token on capture requests. It
+	// must be set if the API is enabled.
+	AuthorizationToken string `yaml:"authorizationToken"`
+}
+
+func (c *CaptureConfig) Validate() error {
+	if c.Addr != "" && c.AuthorizationToken == "" {
+		return errors.New("capture.authorizationToken is required when capture.addr is set")
+	}
+
+	return nil
+}
+
+// CaptureBeaconStateRequest asks the agent to capture the beacon state at a
+// slot, or the state of a block. States are looked up by block root rather
+// than state root, as the beacon API can't give the slot of a state root.
+type CaptureBeaconStateRequest struct {
+	Slot      *uint64 `json:"slot,omitempty"`
+	BlockRoot string  `json:"block_root,omitempty"`
+}
+
+func (r *CaptureBeaconStateRequest) Validate() error {
+	if (r.Slot == nil) == (r.BlockRoot == "") {
+		return errors.New("exactly one of slot or block_root is required")
+	}
+
+	return nil
+}
+
+// CaptureExecutionBlockTraceRequest asks the agent to trace an execution block
+// with every configured tracer.
+type CaptureExecutionBlockTraceRequest struct {
+	BlockHash string `json:"block_hash"`
+}
+
+func (r *CaptureExecutionBlockTraceRequest) Validate() error {
+	if r.BlockHash == "" {
+		return errors.New("block_hash is required")
+	}
+
+	return nil
+}
+
+// CaptureResponse is the response of the capture API.
+type CaptureResponse struct {
+	Node  string   `json:"node"`
+	IDs   []string `json:"ids,omitempty"`
+	Error string   `json:"error,omitempty"`
+}
+
+// captureReady returns an error if the agent can't capture anything yet.
+func (s *agent) captureReady() error {
+	if reasons := s.readiness(); len(reasons) > 0 {
+		return fmt.Errorf("agent is not ready: %s", strings.Join(reasons, ", "))
+	}
+
+	return nil
+}
+
+// CaptureBeaconState captures the requested beacon state, regardless of its
+// age, and returns the IDs it's indexed with. A state that was already
+// captured is not fetched again.
+func (s *agent) CaptureBeaconState(ctx context.Context, req *CaptureBeaconStateRequest) ([]string, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		slot phase0.Slot
+		root phase0.Root
+	)
+
+	// Fetch the state from the same beacon node that served its root.
+	upstream := s.node.Beacon().Active()
+
+	if req.Slot != nil {
+		slot = phase0.Slot(*req.Slot)
+
+		stateRoot, err := upstream.Node().FetchBeaconStateRoot(ctx, fmt.Sprintf("%d", slot))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch beacon state root: %w", err)
+		}
+
+		root = stateRoot
+	} else {
+		header, err := upstream.Node().FetchBeaconBlockHeader(ctx, &eapi.BeaconBlockHeaderOpts{
+			Block: req.BlockRoot,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch beacon block header: %w", err)
+		}
+
+		if header == nil || header.Header == nil || header.Header.Message == nil {
+			return nil, errors.New("beacon block header is empty")
+		}
+
+		slot = header.Header.Message.Slot
+		root = header.Header.Message.StateRoot
+	}
+
+	if err := s.indexBeaconState(ctx, upstream, slot, root, nil); err != nil {
+		return nil, err
+	}
+
+	rsp, err := s.indexer.ListBeaconState(ctx, &indexer.ListBeaconStateRequest{
+		Node:      s.Config.Name,
+		StateRoot: fmt.Sprintf("%#x", root),
+		Network:   string(upstream.Metadata().Network.Name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up captured beacon state: %w", err)
+	}
+
+	ids := make([]string, 0, len(rsp.GetBeaconStates()))
+	for _, state := range rsp.GetBeaconStates() {
+		ids = append(ids, state.GetId().GetValue())
+	}
+
+	return ids, nil
+}
+
+// CaptureExecutionBlockTrace traces the requested execution block, regardless
+// of its age, and returns the IDs of its traces.
+func (s *agent) CaptureExecutionBlockTrace(ctx context.Context, req *CaptureExecutionBlockTraceRequest) ([]string, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	number, err := s.node.Execution().GetBlockNumberByHash(ctx, req.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch execution block: %w", err)
+	}
+
+	if err := s.fetchAndIndexExecutionBlockTrace(ctx, number, req.BlockHash); err != nil {
+		return nil, err
+	}
+
+	rsp, err := s.indexer.ListExecutionBlockTrace(ctx, &indexer.ListExecutionBlockTraceRequest{
+		Node:      s.Config.Name,
+		BlockHash: req.BlockHash,
+		Network:   string(s.node.Beacon().Metadata().Network.Name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up captured execution block trace: %w", err)
+	}
+
+	ids := make([]string, 0, len(rsp.GetExecutionBlockTraces()))
+	for _, trace := range rsp.GetExecutionBlockTraces() {
+		ids = append(ids, trace.GetId().GetValue())
+	}
+
+	return ids, nil
+}
+
+func (s *agent) captureHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /capture/beacon_state", s.handleCapture(func(ctx context.Context, r *http.Request) ([]string, error) {
+		var req CaptureBeaconStateRequest
+		if err := decodeCaptureRequest(r, &req); err != nil {
+			return nil, err
+		}
+
+		return s.CaptureBeaconState(ctx, &req)
+	}))
+
+	mux.HandleFunc("POST /capture/execution_block_trace", s.handleCapture(func(ctx context.Context, r *http.Request) ([]string, error) {
+		var req CaptureExecutionBlockTraceRequest
+		if err := decodeCaptureRequest(r, &req); err != nil {
+			return nil, err
+		}
+
+		return s.CaptureExecutionBlockTrace(ctx, &req)
+	}))
+
+	mux.HandleFunc("POST /capture/fork_choice", s.handleCapture(func(ctx context.Context, _ *http.Request) ([]string, error) {
+		id, err := s.fetchAndIndexForkChoice(ctx, ForkChoiceReasonManual)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{id}, nil
+	}))
+
+	return mux
+}
+
+// errInvalidCaptureRequest wraps errors caused by the request rather than the capture.
+var errInvalidCaptureRequest = errors.New("invalid capture request")
+
+func decodeCaptureRequest(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidCaptureRequest, err)
+	}
+
+	if validator, ok := v.(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			return fmt.Errorf("%w: %v", errInvalidCaptureRequest, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *agent) handleCapture(capture func(ctx context.Context, r *http.Request) ([]string, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.captureAuthorized(r) {
+			writeStatus(w, http.StatusUnauthorized, &CaptureResponse{Node: s.Config.Name, Error: "unauthorized"})
+
+			return
+		}
+
+		if err := s.captureReady(); err != nil {
+			writeStatus(w, http.StatusServiceUnavailable, &CaptureResponse{Node: s.Config.Name, Error: err.Error()})
+
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), captureTimeout)
+		defer cancel()
+
+		ids, err := capture(ctx, r)
+		if err != nil {
+			code := http.StatusInternalServerError
+			if errors.Is(err, errInvalidCaptureRequest) {
+				code = http.StatusBadRequest
+			}
+
+			s.log.
+				WithError(err).
+				WithField("path", r.URL.Path).
+				Warn("Failed to capture on demand")
+
+			writeStatus(w, code, &CaptureResponse{Node: s.Config.Name, Error: err.Error()})
+
+			return
+		}
+
+		s.log.
+			WithField("path", r.URL.Path).
+			WithField("ids", ids).
+			Info("Captured on demand")
+
+		writeStatus(w, http.StatusOK, &CaptureResponse{Node: s.Config.Name, IDs: ids})
+	}
+}
+
+func (s *agent) captureAuthorized(r *http.Request) bool {
+	token := s.Config.Capture.AuthorizationToken
+	if token == "" {
+		return false
+	}
+
+	provided := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(token)) == 1
+}
+
+// ServeCapture starts the capture API, which captures data on demand instead
+// of waiting for the beacon node's events.
+func (s *agent) ServeCapture(ctx context.Context) {
+	captureServer := &http.Server{
+		Addr:              s.Config.Capture.Addr,
+		ReadHeaderTimeout: 15 * time.Second,
+		Handler:           s.captureHandler(),
+	}
+
+	go func() {
+		s.log.Infof("Serving capture API at %s", s.Config.Capture.Addr)
+
+		if err := captureServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.WithError(err).Fatal("Failed to start capture server")
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+
+		_ = captureServer.Shutdown(context.Background())
+	}()
+}
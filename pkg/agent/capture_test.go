@@ -0,0 +1,112 @@
+package agent
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapture_Authorization(t *testing.T) {
+	s := newTestStatusAgent(t)
+	s.Config.Capture.AuthorizationToken = "secret"
+
+	handler := s.captureHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/capture/fork_choice", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	req := httptest.NewRequest(http.MethodPost, "/capture/fork_choice", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// An authorized request gets as far as the readiness check.
+	req = httptest.NewRequest(http.MethodPost, "/capture/fork_choice", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestCapture_NoToken(t *testing.T) {
+	s := newTestStatusAgent(t)
+
+	// Without a token every request is denied.
+	rec := httptest.NewRecorder()
+	s.captureHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/capture/fork_choice", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	config := CaptureConfig{Addr: ":8082"}
+	assert.Error(t, config.Validate())
+
+	config.AuthorizationToken = "secret"
+	assert.NoError(t, config.Validate())
+}
+
+func TestCapture_NotReady(t *testing.T) {
+	s := newTestStatusAgent(t)
+	s.Config.Capture.AuthorizationToken = "secret"
+	handler := s.captureHandler()
+
+	req := httptest.NewRequest(http.MethodPost, "/capture/beacon_state", strings.NewReader(`{"slot": 1}`))
+	req.Header.Set("Authorization", "Bearer secret")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	var rsp CaptureResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&rsp))
+	assert.Equal(t, "test", rsp.Node)
+	assert.Contains(t, rsp.Error, "beacon node is not ready")
+
+	// Captures are only triggered with POST.
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/capture/fork_choice", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestCapture_DecodeRequest(t *testing.T) {
+	slot := uint64(1)
+
+	for name, tc := range map[string]struct {
+		body    string
+		wantErr bool
+		want    CaptureBeaconStateRequest
+	}{
+		"slot":       {body: `{"slot": 1}`, want: CaptureBeaconStateRequest{Slot: &slot}},
+		"block root": {body: `{"block_root": "0xabc"}`, want: CaptureBeaconStateRequest{BlockRoot: "0xabc"}},
+		"neither":    {body: `{}`, wantErr: true},
+		"both":       {body: `{"slot": 1, "block_root": "0xabc"}`, wantErr: true},
+		"state root": {body: `{"state_root": "0xabc"}`, wantErr: true},
+		"malformed":  {body: `{`, wantErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var req CaptureBeaconStateRequest
+
+			err := decodeCaptureRequest(httptest.NewRequest(http.MethodPost, "/capture/beacon_state", strings.NewReader(tc.body)), &req)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, errInvalidCaptureRequest)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, req)
+		})
+	}
+
+	var req CaptureExecutionBlockTraceRequest
+
+	err := decodeCaptureRequest(httptest.NewRequest(http.MethodPost, "/capture/execution_block_trace", strings.NewReader(`{}`)), &req)
+	assert.ErrorIs(t, err, errInvalidCaptureRequest)
+}
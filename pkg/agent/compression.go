@@ -0,0 +1,99 @@
+package agent
+
+import (
+	"fmt"
+
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	"github.com/ethpandaops/tracoor/pkg/store"
+)
+
+// compressedDataTypes are the data types the agent compresses before storing.
+var compressedDataTypes = []store.DataType{
+	store.BeaconStateDataType,
+	store.BeaconBlockDataType,
+	store.BlobSidecarDataType,
+	store.ForkChoiceDataType,
+	store.BeaconBadBlockDataType,
+	store.BeaconBadBlobDataType,
+	store.BlockTraceDataType,
+	store.ExecutionWitnessDataType,
+	store.BadBlockDataType,
+}
+
+type CompressionConfig struct {
+	// Algorithm is the compression algorithm: gzip, zstd, snappy or none.
+	Algorithm string `yaml:"algorithm" default:"gzip"`
+	// Level is the compression level. 0 uses the algorithm's default.
+	Level int `yaml:"level"`
+	// DataTypes overrides the compression of individual data types, keyed by data type.
+	DataTypes map[string]CompressionOptions `yaml:"dataTypes"`
+}
+
+// CompressionOptions configure the compression of a single data type. If only
+// the level is set, it applies to the default algorithm.
+type CompressionOptions struct {
+	Algorithm string `yaml:"algorithm"`
+	Level     int    `yaml:"level"`
+}
+
+func (c *CompressionConfig) Validate() error {
+	for name := range c.DataTypes {
+		known := false
+
+		for _, dataType := range compressedDataTypes {
+			if name == string(dataType) {
+				known = true
+
+				break
+			}
+		}
+
+		if !known {
+			return fmt.Errorf("unknown data type %s in compression config", name)
+		}
+	}
+
+	_, err := c.Algorithms()
+
+	return err
+}
+
+// Algorithms returns the compression algorithm of each data type.
+func (c *CompressionConfig) Algorithms() (map[store.DataType]*compression.CompressionAlgorithm, error) {
+	algorithms := make(map[store.DataType]*compression.CompressionAlgorithm, len(compressedDataTypes))
+
+	for _, dataType := range compressedDataTypes {
+		options := CompressionOptions{Algorithm: c.Algorithm, Level: c.Level}
+
+		if overrides, ok := c.DataTypes[string(dataType)]; ok {
+			if overrides.Algorithm != "" {
+				options = overrides
+			} else {
+				options.Level = overrides.Level
+			}
+		}
+
+		algorithm, err := compression.GetCompressionAlgorithmFromName(options.Algorithm)
+		if err != nil {
+			return nil, fmt.Errorf("invalid compression algorithm %q for %s: %w", options.Algorithm, dataType, err)
+		}
+
+		algorithm, err = algorithm.WithLevel(options.Level)
+		if err != nil {
+			return nil, fmt.Errorf("invalid compression for %s: %w", dataType, err)
+		}
+
+		algorithms[dataType] = algorithm
+	}
+
+	return algorithms, nil
+}
+
+// compressionAlgorithm returns the algorithm data of the data type is compressed with.
+func (s *agent) compressionAlgorithm(dataType store.DataType) *compression.CompressionAlgorithm {
+	if algorithm, ok := s.compressionAlgorithms[dataType]; ok {
+		return algorithm
+	}
+
+	return compression.Gzip
+}
@@ -0,0 +1,54 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressionConfig_Algorithms(t *testing.T) {
+	config := &CompressionConfig{
+		Algorithm: "gzip",
+		Level:     6,
+		DataTypes: map[string]CompressionOptions{
+			string(store.BeaconStateDataType): {Algorithm: "zstd", Level: 3},
+			string(store.BeaconBlockDataType): {Algorithm: "snappy"},
+			string(store.ForkChoiceDataType):  {Level: 9},
+		},
+	}
+
+	require.NoError(t, config.Validate())
+
+	algorithms, err := config.Algorithms()
+	require.NoError(t, err)
+	assert.Len(t, algorithms, len(compressedDataTypes))
+
+	assert.Equal(t, compression.Zstd.Name, algorithms[store.BeaconStateDataType].Name)
+	assert.Equal(t, 3, algorithms[store.BeaconStateDataType].Level)
+
+	// The default level isn't applied to an overridden algorithm.
+	assert.Equal(t, compression.Snappy.Name, algorithms[store.BeaconBlockDataType].Name)
+	assert.Equal(t, 0, algorithms[store.BeaconBlockDataType].Level)
+
+	assert.Equal(t, compression.Gzip.Name, algorithms[store.ForkChoiceDataType].Name)
+	assert.Equal(t, 9, algorithms[store.ForkChoiceDataType].Level)
+
+	assert.Equal(t, compression.Gzip.Name, algorithms[store.BlockTraceDataType].Name)
+	assert.Equal(t, 6, algorithms[store.BlockTraceDataType].Level)
+}
+
+func TestCompressionConfig_Validate(t *testing.T) {
+	for name, config := range map[string]*CompressionConfig{
+		"unknown algorithm": {Algorithm: "brotli"},
+		"invalid level":     {Algorithm: "gzip", Level: 10},
+		"unknown data type": {Algorithm: "gzip", DataTypes: map[string]CompressionOptions{"beacon_states": {Algorithm: "zstd"}}},
+		"snappy level":      {Algorithm: "gzip", DataTypes: map[string]CompressionOptions{string(store.BeaconStateDataType): {Algorithm: "snappy", Level: 1}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, config.Validate())
+		})
+	}
+}
@@ -2,9 +2,11 @@ package agent
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/ethpandaops/tracoor/pkg/agent/ethereum"
 	"github.com/ethpandaops/tracoor/pkg/agent/indexer"
+	"github.com/ethpandaops/tracoor/pkg/agent/queue"
 	"github.com/ethpandaops/tracoor/pkg/store"
 )
 
@@ -22,8 +24,33 @@ type Config struct {
 	// Indexer configuration
 	Indexer *indexer.Config `yaml:"indexer"`
 
-	// Store configuration
+	// Store configuration. The server store type uploads to the server instead
+	// of writing to a store directly.
 	Store *store.Config `yaml:"store"`
+
+	// DataDir is the directory the agent keeps its durable work queue in.
+	// If empty, pending work is held in memory and lost on restart.
+	DataDir string `yaml:"dataDir"`
+
+	// Queue configuration
+	Queue queue.Config `yaml:"queue"`
+
+	// IndexCache configuration
+	IndexCache IndexCacheConfig `yaml:"indexCache"`
+
+	// Status API configuration
+	Status StatusConfig `yaml:"status"`
+
+	// Capture API configuration
+	Capture CaptureConfig `yaml:"capture"`
+
+	// Compression configuration
+	Compression CompressionConfig `yaml:"compression"`
+
+	// ContentAddressed stores beacon states and beacon blocks once per network,
+	// keyed by their root, instead of once per node. Nodes capturing the same
+	// state or block share the object.
+	ContentAddressed bool `yaml:"contentAddressed"`
 }
 
 func (c *Config) Validate() error {
@@ -31,9 +58,35 @@ func (c *Config) Validate() error {
 		return errors.New("name is required")
 	}
 
+	if c.Store == nil {
+		return errors.New("store is required")
+	}
+
 	if err := c.Ethereum.Validate(); err != nil {
 		return err
 	}
 
+	if err := c.IndexCache.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Status.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Compression.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Capture.Validate(); err != nil {
+		return err
+	}
+
+	for name := range c.Queue.Queues {
+		if _, ok := defaultQueueOptions[Queue(name)]; !ok {
+			return fmt.Errorf("unknown queue %s in queue config", name)
+		}
+	}
+
 	return nil
 }
@@ -3,20 +3,23 @@ package agent
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
-	"regexp"
-	"strconv"
 	"time"
 
+	eapi "github.com/attestantio/go-eth2-client/api"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/beacon"
 	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/beacon/services"
 	"github.com/ethpandaops/tracoor/pkg/compression"
 	"github.com/ethpandaops/tracoor/pkg/mime"
+	"github.com/ethpandaops/tracoor/pkg/networks"
 	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
 	"github.com/ethpandaops/tracoor/pkg/store"
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -25,146 +28,255 @@ func (s *agent) fetchAndIndexBeaconState(ctx context.Context, slot phase0.Slot)
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	root, err := s.node.Beacon().Node().FetchBeaconStateRoot(ctx, fmt.Sprintf("%d", slot))
+	// Fetch the state from the same beacon node that served its root.
+	upstream := s.node.Beacon().Active()
+
+	root, err := upstream.Node().FetchBeaconStateRoot(ctx, fmt.Sprintf("%d", slot))
 	if err != nil {
 		return errors.Wrap(err, "failed to fetch beacon state root")
 	}
 
+	return s.indexBeaconState(ctx, upstream, slot, root, nil)
+}
+
+// fetchAndIndexFinalizedBeaconState captures the state of a finalized checkpoint
+// block. States that were already captured at the head are flagged as finalized
+// instead of being fetched again.
+func (s *agent) fetchAndIndexFinalizedBeaconState(ctx context.Context, epoch phase0.Epoch, blockRoot string) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// Fetch the state from the same beacon node that served its root.
+	upstream := s.node.Beacon().Active()
+
+	header, err := upstream.Node().FetchBeaconBlockHeader(ctx, &eapi.BeaconBlockHeaderOpts{
+		Block: blockRoot,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch finalized checkpoint block header")
+	}
+
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return errors.New("finalized checkpoint block header is empty")
+	}
+
+	return s.indexBeaconState(ctx, upstream, header.Header.Message.Slot, header.Header.Message.StateRoot, &epoch)
+}
+
+// indexBeaconState fetches, stores and indexes the beacon state at the given slot.
+// The state is fetched from and attributed to upstream, which must be the beacon
+// node the root came from. A non-nil checkpointEpoch indexes the state as a
+// finalized checkpoint state.
+func (s *agent) indexBeaconState(ctx context.Context, upstream *beacon.Upstream, slot phase0.Slot, root phase0.Root, checkpointEpoch *phase0.Epoch) error {
 	rootAsString := fmt.Sprintf("%#x", root)
 
-	location := CreateBeaconStateFileName(
+	queueName := BeaconStateQueue
+	if checkpointEpoch != nil {
+		queueName = FinalizedBeaconStateQueue
+	}
+
+	logCtx := s.log.
+		WithField("state_root", rootAsString).
+		WithField("slot", slot)
+
+	location := store.CreateBeaconStateFileName(
 		s.Config.Name,
-		string(s.node.Beacon().Metadata().Network.Name),
-		slot,
+		string(upstream.Metadata().Network.Name),
+		uint64(slot),
 		rootAsString,
 	)
 
 	location = fmt.Sprintf("%s.ssz", location)
 
-	// Check if we've somehow already indexed this beacon state
-	rsp, err := s.indexer.ListBeaconState(ctx, &indexer.ListBeaconStateRequest{
-		Node:      s.Config.Name,
-		StateRoot: rootAsString,
-		Slot:      uint64(slot),
-		Network:   string(s.node.Beacon().Metadata().Network.Name),
-	})
-	if err != nil {
-		s.log.
-			WithField("state_root", rootAsString).
-			WithField("slot", slot).
-			WithError(err).
-			Error("Failed to check if beacon state is already indexed")
+	if s.Config.ContentAddressed {
+		// Shared objects are kept apart per compression algorithm, as nodes
+		// may compress differently.
+		location = compression.AddExtension(
+			fmt.Sprintf("%s.ssz", store.CreateSharedBeaconStateFileName(string(upstream.Metadata().Network.Name), rootAsString)),
+			s.compressionAlgorithm(store.BeaconStateDataType),
+		)
 	}
 
-	if rsp != nil && len(rsp.BeaconStates) > 0 {
-		s.log.
-			WithField("state_root", rootAsString).
-			WithField("slot", slot).
-			Debug("Beacon state already indexed")
+	// Check if we've somehow already indexed this beacon state
+	if checkpointEpoch == nil && s.indexCache.Has(store.BeaconStateDataType, rootAsString, "") {
+		logCtx.Debug("Beacon state already indexed")
 
 		return nil
 	}
 
-	now := time.Now()
+	var rsp *indexer.ListBeaconStateResponse
+
+	// Finalized checkpoint states always ask the indexer, as a state that was
+	// captured at the head only has to be flagged as finalized.
+	if checkpointEpoch != nil || s.indexCache == nil {
+		var err error
+
+		rsp, err = s.indexer.ListBeaconState(ctx, &indexer.ListBeaconStateRequest{
+			Node:      s.Config.Name,
+			StateRoot: rootAsString,
+			Slot:      uint64(slot),
+			Network:   string(upstream.Metadata().Network.Name),
+		})
+		if err != nil {
+			logCtx.
+				WithError(err).
+				Error("Failed to check if beacon state is already indexed")
+		}
+	}
 
-	stateID := rootAsString
+	// stateData is left nil if the state was already captured.
+	var stateData io.Reader
 
-	client := s.node.Beacon().Metadata().Client(ctx)
+	contentEncoding := s.compressionAlgorithm(store.BeaconStateDataType).ContentEncoding
 
-	if client == string(services.ClientLodestar) ||
-		client == string(services.ClientPrysm) {
-		// Lodestar/prysm requires us to fetch the state id by slot
-		stateID = fmt.Sprintf("%d", slot)
-	}
+	if rsp != nil && len(rsp.BeaconStates) > 0 {
+		existing := rsp.BeaconStates[0]
 
-	// Fetch the state
-	state, err := s.node.Beacon().Node().FetchRawBeaconState(ctx, stateID, string(mime.ContentTypeOctet))
-	if err != nil {
-		return err
-	}
+		if checkpointEpoch == nil || existing.GetFinalized().GetValue() {
+			logCtx.Debug("Beacon state already indexed")
 
-	s.log.WithField("location", location).Debug("Saving beacon state")
+			return nil
+		}
 
-	// Compress it
-	compressedState, err := s.compressor.Compress(&state, compression.Gzip)
-	if err != nil {
-		return errors.Wrap(err, "failed to compress beacon state")
-	}
+		// The state was captured at the head, so the indexer only needs to
+		// flag it as finalized.
+		location = existing.GetLocation().GetValue()
+		contentEncoding = existing.GetContentEncoding().GetValue()
+	} else if s.sharedObjectIndexed(ctx, store.BeaconStateDataType, location) {
+		// Another node already stored the state, so it only has to be indexed
+		// for this node.
+		logCtx.WithField("location", location).Debug("Beacon state already stored")
+	} else {
+		stateID := rootAsString
+
+		client := upstream.Metadata().Client(ctx)
+
+		if client == string(services.ClientLodestar) ||
+			client == string(services.ClientPrysm) {
+			// Lodestar/prysm requires us to fetch the state id by slot
+			stateID = fmt.Sprintf("%d", slot)
+		}
 
-	// Upload the state to the store
-	location, err = s.store.SaveBeaconState(ctx, &store.SaveParams{
-		Data:            &compressedState,
-		Location:        location,
-		ContentEncoding: compression.Gzip.ContentEncoding,
-	})
-	if err != nil {
-		return err
+		// Stream the state from the beacon node through the compressor into
+		// the store so the whole state is never held in memory.
+		state, err := upstream.StreamRawBeaconState(ctx, stateID)
+		if err != nil {
+			return err
+		}
+
+		defer state.Close()
+
+		logCtx.WithField("location", location).Debug("Saving beacon state")
+
+		compressedState, err := s.compressor.CompressStream(state, s.compressionAlgorithm(store.BeaconStateDataType))
+		if err != nil {
+			return errors.Wrap(err, "failed to compress beacon state")
+		}
+
+		defer compressedState.Close()
+
+		stateData = compressedState
 	}
 
-	// Sleep for 1s to give the store time to update
-	time.Sleep(1 * time.Second)
+	now := time.Now()
 
-	spec, err := s.node.Beacon().Node().Spec()
+	spec, err := upstream.Node().Spec()
 	if err != nil {
 		return err
 	}
 
 	req := &indexer.CreateBeaconStateRequest{
 		Node:            wrapperspb.String(s.Config.Name),
-		Network:         wrapperspb.String(string(s.node.Beacon().Metadata().Network.Name)),
+		Network:         wrapperspb.String(string(upstream.Metadata().Network.Name)),
 		Slot:            wrapperspb.UInt64(uint64(slot)),
 		Epoch:           wrapperspb.UInt64(uint64(slot) / uint64(spec.SlotsPerEpoch)),
 		StateRoot:       wrapperspb.String(rootAsString),
 		Location:        wrapperspb.String(location),
-		ContentEncoding: wrapperspb.String(compression.Gzip.ContentEncoding),
-		NodeVersion:     wrapperspb.String(s.node.Beacon().Metadata().NodeVersion(ctx)),
+		ContentEncoding: wrapperspb.String(contentEncoding),
+		NodeVersion:     wrapperspb.String(upstream.Metadata().NodeVersion(ctx)),
 		BeaconImplementation: wrapperspb.String(
-			s.node.Beacon().Metadata().Client(ctx),
+			upstream.Metadata().Client(ctx),
 		),
 		FetchedAt: timestamppb.New(now),
 	}
 
-	// Index the state
-	if _, err := s.indexer.CreateBeaconState(ctx, req); err != nil {
-		return err
+	if checkpointEpoch != nil {
+		req.Finalized = wrapperspb.Bool(true)
+		req.CheckpointEpoch = wrapperspb.UInt64(uint64(*checkpointEpoch))
 	}
 
-	s.metrics.IncrementItemExported(BeaconStateQueue, s.Config.Name)
+	// Index the state, saving it first unless it was already captured
+	if stateData == nil {
+		_, err = s.indexer.CreateBeaconState(ctx, req)
+	} else {
+		_, err = s.publishBeaconState(ctx, req, stateData)
+	}
 
-	s.log.
-		WithField("state_root", rootAsString).
-		WithField("slot", slot).
+	if err != nil {
+		if !isAlreadyIndexed(err) {
+			return err
+		}
+
+		s.indexCache.Add(store.BeaconStateDataType, rootAsString, "")
+
+		logCtx.Debug("Beacon state already indexed")
+
+		return nil
+	}
+
+	s.indexCache.Add(store.BeaconStateDataType, rootAsString, "")
+
+	s.metrics.IncrementItemExported(queueName, s.Config.Name)
+
+	logCtx.
+		WithField("finalized", checkpointEpoch != nil).
 		Debug("Indexed beacon state")
 
 	return nil
 }
 
 func (s *agent) fetchAndIndexBeaconBlock(ctx context.Context, slot phase0.Slot) error {
+	// Attribute the block to the beacon node that served it.
+	upstream := s.node.Beacon().Active()
+
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	blockRoot, err := s.node.Beacon().Node().FetchBlockRoot(ctx, fmt.Sprintf("%d", slot))
+	blockRoot, err := upstream.Node().FetchBlockRoot(ctx, fmt.Sprintf("%d", slot))
 	if err != nil {
 		return errors.Wrap(err, "failed to fetch beacon block root")
 	}
 
 	blockRootAsString := blockRoot.String()
 
-	location := CreateBeaconBlockFileName(
+	location := store.CreateBeaconBlockFileName(
 		s.Config.Name,
-		string(s.node.Beacon().Metadata().Network.Name),
-		slot,
+		string(upstream.Metadata().Network.Name),
+		uint64(slot),
 		blockRootAsString,
 	)
 
 	location = fmt.Sprintf("%s.ssz", location)
 
-	// Check if we've somehow already indexed this beacon state
-	rsp, err := s.indexer.ListBeaconBlock(ctx, &indexer.ListBeaconBlockRequest{
-		Node:      s.Config.Name,
-		BlockRoot: blockRootAsString,
-		Slot:      uint64(slot),
-		Network:   string(s.node.Beacon().Metadata().Network.Name),
+	algorithm := s.compressionAlgorithm(store.BeaconBlockDataType)
+
+	if s.Config.ContentAddressed {
+		location = compression.AddExtension(
+			fmt.Sprintf("%s.ssz", store.CreateSharedBeaconBlockFileName(string(upstream.Metadata().Network.Name), blockRootAsString)),
+			algorithm,
+		)
+	}
+
+	// Check if we've somehow already indexed this beacon block
+	indexed, err := s.alreadyIndexed(store.BeaconBlockDataType, blockRootAsString, "", func() (bool, error) {
+		rsp, err := s.indexer.ListBeaconBlock(ctx, &indexer.ListBeaconBlockRequest{
+			Node:      s.Config.Name,
+			BlockRoot: blockRootAsString,
+			Slot:      uint64(slot),
+			Network:   string(upstream.Metadata().Network.Name),
+		})
+
+		return rsp != nil && len(rsp.BeaconBlocks) > 0, err
 	})
 	if err != nil {
 		s.log.
@@ -174,7 +286,7 @@ func (s *agent) fetchAndIndexBeaconBlock(ctx context.Context, slot phase0.Slot)
 			Error("Failed to check if beacon block is already indexed")
 	}
 
-	if rsp != nil && len(rsp.BeaconBlocks) > 0 {
+	if indexed {
 		s.log.
 			WithField("block_root", blockRootAsString).
 			WithField("slot", slot).
@@ -185,498 +297,714 @@ func (s *agent) fetchAndIndexBeaconBlock(ctx context.Context, slot phase0.Slot)
 
 	now := time.Now()
 
-	stateID := fmt.Sprintf("%d", slot)
+	// Another node may have already stored the block, in which case it only
+	// has to be indexed for this node.
+	stored := s.sharedObjectIndexed(ctx, store.BeaconBlockDataType, location)
+
+	var compressedBlock []byte
 
-	// Fetch the block
-	blockRaw, err := s.node.Beacon().Node().FetchRawBlock(ctx, stateID, string(mime.ContentTypeOctet))
+	if !stored {
+		stateID := fmt.Sprintf("%d", slot)
+
+		// Fetch the block
+		blockRaw, err := upstream.Node().FetchRawBlock(ctx, stateID, string(mime.ContentTypeOctet))
+		if err != nil {
+			return err
+		}
+
+		// Compress it
+		compressedBlock, err = s.compressor.Compress(&blockRaw, algorithm)
+		if err != nil {
+			return errors.Wrap(err, "failed to compress beacon block")
+		}
+	}
+
+	spec, err := upstream.Node().Spec()
 	if err != nil {
 		return err
 	}
 
-	// Compress it
-	compressedBlock, err := s.compressor.Compress(&blockRaw, compression.Gzip)
-	if err != nil {
-		return errors.Wrap(err, "failed to compress beacon block")
+	req := &indexer.CreateBeaconBlockRequest{
+		Node:            wrapperspb.String(s.Config.Name),
+		Network:         wrapperspb.String(string(upstream.Metadata().Network.Name)),
+		Slot:            wrapperspb.UInt64(uint64(slot)),
+		Epoch:           wrapperspb.UInt64(uint64(slot) / uint64(spec.SlotsPerEpoch)),
+		BlockRoot:       wrapperspb.String(blockRootAsString),
+		Location:        wrapperspb.String(location),
+		ContentEncoding: wrapperspb.String(algorithm.ContentEncoding),
+		NodeVersion:     wrapperspb.String(upstream.Metadata().NodeVersion(ctx)),
+		BeaconImplementation: wrapperspb.String(
+			upstream.Metadata().Client(ctx),
+		),
+		FetchedAt: timestamppb.New(now),
 	}
 
 	s.log.WithField("location", location).Debug("Saving beacon block")
 
-	// Upload the block to the store
-	location, err = s.store.SaveBeaconBlock(ctx, &store.SaveParams{
-		Data:            &compressedBlock,
-		Location:        location,
-		ContentEncoding: compression.Gzip.ContentEncoding,
-	})
+	// Save and index the block, unless it's already stored
+	if stored {
+		_, err = s.indexer.CreateBeaconBlock(ctx, req)
+	} else {
+		_, err = s.publishBeaconBlock(ctx, req, compressedBlock)
+	}
+
+	if err != nil {
+		if !isAlreadyIndexed(err) {
+			return err
+		}
+
+		s.indexCache.Add(store.BeaconBlockDataType, blockRootAsString, "")
+
+		s.log.
+			WithField("block_root", blockRootAsString).
+			WithField("slot", slot).
+			Debug("Beacon block already indexed")
+
+		return nil
+	}
+
+	s.indexCache.Add(store.BeaconBlockDataType, blockRootAsString, "")
+
+	s.metrics.IncrementItemExported(BeaconBlockQueue, s.Config.Name)
+
+	s.log.
+		WithField("block_root", blockRootAsString).
+		WithField("slot", slot).
+		Debug("Indexed beacon block")
+
+	return nil
+}
+
+func (s *agent) fetchAndIndexBlobSidecars(ctx context.Context, slot phase0.Slot) error {
+	// Attribute the sidecars to the beacon node that served them.
+	upstream := s.node.Beacon().Active()
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	spec, err := upstream.Node().Spec()
 	if err != nil {
 		return err
 	}
 
-	// Sleep for 1s to give the store time to update
-	time.Sleep(1 * time.Second)
+	epoch := phase0.Epoch(uint64(slot) / uint64(spec.SlotsPerEpoch))
+
+	sidecarType, ok, err := upstream.SidecarTypeAtEpoch(epoch)
+	if err != nil {
+		return errors.Wrap(err, "failed to determine sidecar type")
+	}
+
+	if !ok {
+		// Blocks from before Deneb don't have sidecars.
+		s.metrics.IncrementItemSkipped(BlobSidecarQueue, s.Config.Name)
+
+		return nil
+	}
+
+	blockRoot, err := upstream.Node().FetchBlockRoot(ctx, fmt.Sprintf("%d", slot))
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch beacon block root")
+	}
+
+	blockRootAsString := blockRoot.String()
+
+	location := store.CreateBlobSidecarFileName(
+		s.Config.Name,
+		string(upstream.Metadata().Network.Name),
+		uint64(slot),
+		blockRootAsString,
+		string(sidecarType),
+	)
+
+	location = fmt.Sprintf("%s.ssz", location)
+
+	// Check if we've somehow already indexed these sidecars
+	indexed, err := s.alreadyIndexed(store.BlobSidecarDataType, blockRootAsString, string(sidecarType), func() (bool, error) {
+		rsp, err := s.indexer.ListBlobSidecar(ctx, &indexer.ListBlobSidecarRequest{
+			Node:        s.Config.Name,
+			BlockRoot:   blockRootAsString,
+			Slot:        uint64(slot),
+			Network:     string(upstream.Metadata().Network.Name),
+			SidecarType: string(sidecarType),
+		})
+
+		return rsp != nil && len(rsp.BlobSidecars) > 0, err
+	})
+	if err != nil {
+		s.log.
+			WithField("block_root", blockRootAsString).
+			WithField("slot", slot).
+			WithError(err).
+			Error("Failed to check if blob sidecars are already indexed")
+	}
+
+	if indexed {
+		s.log.
+			WithField("block_root", blockRootAsString).
+			WithField("slot", slot).
+			Debug("Blob sidecars already indexed")
 
-	spec, err := s.node.Beacon().Node().Spec()
+		return nil
+	}
+
+	now := time.Now()
+
+	// Fetch the sidecars by root so they're guaranteed to belong to the block above.
+	sidecarsRaw, err := upstream.FetchRawSidecars(ctx, sidecarType, blockRootAsString)
 	if err != nil {
 		return err
 	}
 
-	req := &indexer.CreateBeaconBlockRequest{
+	// An empty SSZ list means the block didn't carry any blobs.
+	if len(sidecarsRaw) == 0 {
+		s.log.
+			WithField("block_root", blockRootAsString).
+			WithField("slot", slot).
+			Debug("Beacon block has no blob sidecars")
+
+		s.metrics.IncrementItemSkipped(BlobSidecarQueue, s.Config.Name)
+
+		return nil
+	}
+
+	algorithm := s.compressionAlgorithm(store.BlobSidecarDataType)
+
+	// Compress it
+	compressedSidecars, err := s.compressor.Compress(&sidecarsRaw, algorithm)
+	if err != nil {
+		return errors.Wrap(err, "failed to compress blob sidecars")
+	}
+
+	req := &indexer.CreateBlobSidecarRequest{
 		Node:            wrapperspb.String(s.Config.Name),
-		Network:         wrapperspb.String(string(s.node.Beacon().Metadata().Network.Name)),
+		Network:         wrapperspb.String(string(upstream.Metadata().Network.Name)),
 		Slot:            wrapperspb.UInt64(uint64(slot)),
-		Epoch:           wrapperspb.UInt64(uint64(slot) / uint64(spec.SlotsPerEpoch)),
+		Epoch:           wrapperspb.UInt64(uint64(epoch)),
 		BlockRoot:       wrapperspb.String(blockRootAsString),
 		Location:        wrapperspb.String(location),
-		ContentEncoding: wrapperspb.String(compression.Gzip.ContentEncoding),
-		NodeVersion:     wrapperspb.String(s.node.Beacon().Metadata().NodeVersion(ctx)),
+		ContentEncoding: wrapperspb.String(algorithm.ContentEncoding),
+		NodeVersion:     wrapperspb.String(upstream.Metadata().NodeVersion(ctx)),
 		BeaconImplementation: wrapperspb.String(
-			s.node.Beacon().Metadata().Client(ctx),
+			upstream.Metadata().Client(ctx),
 		),
-		FetchedAt: timestamppb.New(now),
+		FetchedAt:   timestamppb.New(now),
+		SidecarType: wrapperspb.String(string(sidecarType)),
 	}
 
-	// Index the block
-	if _, err := s.indexer.CreateBeaconBlock(ctx, req); err != nil {
-		return err
+	s.log.WithField("location", location).Debug("Saving blob sidecars")
+
+	// Save and index the sidecars
+	if _, err := s.publishBlobSidecar(ctx, req, compressedSidecars); err != nil {
+		if !isAlreadyIndexed(err) {
+			return err
+		}
+
+		s.indexCache.Add(store.BlobSidecarDataType, blockRootAsString, string(sidecarType))
+
+		s.log.
+			WithField("block_root", blockRootAsString).
+			WithField("slot", slot).
+			Debug("Blob sidecars already indexed")
+
+		return nil
 	}
 
-	s.metrics.IncrementItemExported(BeaconBlockQueue, s.Config.Name)
+	s.indexCache.Add(store.BlobSidecarDataType, blockRootAsString, string(sidecarType))
+
+	s.metrics.IncrementItemExported(BlobSidecarQueue, s.Config.Name)
 
 	s.log.
 		WithField("block_root", blockRootAsString).
 		WithField("slot", slot).
-		Debug("Indexed beacon block")
+		WithField("sidecar_type", sidecarType).
+		Debug("Indexed blob sidecars")
 
 	return nil
 }
 
-func getBadBlocksFilePattern(client string) (*string, error) {
-	var pattern string
+// ForkChoiceReason is what caused a fork choice dump to be captured.
+type ForkChoiceReason string
+
+const (
+	ForkChoiceReasonChainReorg ForkChoiceReason = "chain_reorg"
+	ForkChoiceReasonScheduled  ForkChoiceReason = "scheduled"
+	ForkChoiceReasonManual     ForkChoiceReason = "manual"
+)
+
+// fetchAndIndexForkChoice captures the current fork choice and returns the ID
+// it was indexed with.
+func (s *agent) fetchAndIndexForkChoice(ctx context.Context, reason ForkChoiceReason) (string, error) {
+	// Attribute the fork choice to the beacon node that served it.
+	upstream := s.node.Beacon().Active()
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
 
-	switch client {
-	case string(services.ClientLighthouse):
-		pattern = `^(\d+)_([^.]+)\.ssz$`
-	case string(services.ClientNimbus):
-		pattern = `^block-(\d+)-([^.]+)\.ssz$`
-	case string(services.ClientPrysm):
-		pattern = `^beacon_block_(\d+)\.ssz$`
-	default:
-		return nil, errors.New("client does not have bad blocks available")
+	slot, epoch, err := upstream.Metadata().Wallclock().Now()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to determine current slot")
 	}
 
-	return &pattern, nil
-}
+	now := time.Now()
 
-func (s *agent) fetchAndIndexBeaconBadBlocks(ctx context.Context, path string) error {
-	client := s.node.Beacon().Metadata().Client(ctx)
+	forkChoiceRaw, err := upstream.FetchRawForkChoice(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to fetch fork choice")
+	}
 
-	// Verify the path is a directory
-	fileInfo, err := os.Stat(path)
+	location := store.CreateForkChoiceFileName(
+		s.Config.Name,
+		string(upstream.Metadata().Network.Name),
+		slot.Number(),
+		now,
+	)
+
+	location = fmt.Sprintf("%s.json", location)
+
+	algorithm := s.compressionAlgorithm(store.ForkChoiceDataType)
+
+	// Compress it
+	compressedForkChoice, err := s.compressor.Compress(&forkChoiceRaw, algorithm)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to compress fork choice")
+	}
+
+	req := &indexer.CreateForkChoiceRequest{
+		Node:            wrapperspb.String(s.Config.Name),
+		Network:         wrapperspb.String(string(upstream.Metadata().Network.Name)),
+		Slot:            wrapperspb.UInt64(slot.Number()),
+		Epoch:           wrapperspb.UInt64(epoch.Number()),
+		Location:        wrapperspb.String(location),
+		ContentEncoding: wrapperspb.String(algorithm.ContentEncoding),
+		NodeVersion:     wrapperspb.String(upstream.Metadata().NodeVersion(ctx)),
+		BeaconImplementation: wrapperspb.String(
+			upstream.Metadata().Client(ctx),
+		),
+		FetchedAt: timestamppb.New(now),
+		Reason:    wrapperspb.String(string(reason)),
+	}
+
+	s.log.WithField("location", location).Debug("Saving fork choice")
+
+	// Save and index the fork choice
+	rsp, err := s.publishForkChoice(ctx, req, compressedForkChoice)
 	if err != nil {
+		return "", err
+	}
+
+	s.metrics.IncrementItemExported(ForkChoiceQueue, s.Config.Name)
+
+	s.log.
+		WithField("slot", slot.Number()).
+		WithField("reason", reason).
+		Debug("Indexed fork choice")
+
+	return rsp.GetId().GetValue(), nil
+}
+
+// CaptureForkChoice captures and indexes the current fork choice of the beacon node once,
+// regardless of whether the fetchForkChoice feature is enabled.
+func (s *agent) CaptureForkChoice(ctx context.Context) error {
+	if err := s.performTokenHandshake(ctx); err != nil {
 		return err
 	}
 
-	if !fileInfo.IsDir() {
-		return fmt.Errorf("path %s is not a directory", path)
+	if err := s.waitForNode(ctx); err != nil {
+		return err
 	}
 
-	pattern, err := getBadBlocksFilePattern(client)
+	if s.node.Beacon().Metadata().Network.Name == networks.NetworkNameUnknown {
+		return errors.New("unable to determine Ethereum network. Provide an override network name via ethereum.overrideNetworkName")
+	}
+
+	_, err := s.fetchAndIndexForkChoice(ctx, ForkChoiceReasonManual)
+
+	return err
+}
+
+func (s *agent) fetchAndIndexBeaconBadBlocks(ctx context.Context, path string) error {
+	client := s.node.Beacon().Metadata().Client(ctx)
+
+	matchers, err := s.Config.Ethereum.Beacon.InvalidGossipFileMatchers(client, false)
 	if err != nil {
 		return err
 	}
 
-	matcher := regexp.MustCompile(*pattern)
+	if len(matchers) == 0 {
+		return fmt.Errorf("no bad block file patterns for client %s, configure them in ethereum.beacon.invalidGossipFilePatterns", client)
+	}
 
-	files, err := os.ReadDir(path)
+	files, err := s.settledInvalidGossipFiles(path)
 	if err != nil {
 		return err
 	}
 
 	for _, file := range files {
-		matches := matcher.FindStringSubmatch(file.Name())
-		if len(matches) == 2 {
-			filePath := filepath.Join(path, file.Name())
-			// Parse 'slot' and 'blockRoot' from the file name
-			slotI, err := strconv.ParseUint(matches[1], 10, 64)
-			if err != nil {
-				s.log.
-					WithField("fileName", file.Name()).
-					WithField("filePath", filePath).
-					WithError(err).Error("Failed to parse slot from beacon bad block file name")
-
-				continue
-			}
-
-			slot := phase0.Slot(slotI)
-
-			blockRoot := "unknown"
-
-			if len(matches) == 3 {
-				blockRoot = matches[2]
-			}
-
-			// Read the file into the `block` variable
-			blockRaw, err := os.ReadFile(filePath)
-			if err != nil {
-				s.log.
-					WithField("slot", slot).
-					WithField("blockRoot", blockRoot).
-					WithField("filePath", filePath).
-					WithError(err).
-					Error("Failed to read beacon bad block file")
-
-				continue
-			}
+		filePath := filepath.Join(path, file.Name())
+
+		matcher, parsed, err := matchInvalidGossipFile(matchers, file.Name())
+		if err != nil {
+			s.log.
+				WithField("filePath", filePath).
+				WithError(err).
+				Error("Failed to parse beacon bad block file name")
+
+			continue
+		}
 
+		if parsed == nil {
+			continue
+		}
+
+		// The file is only deleted once the indexer has confirmed the block is
+		// indexed, so nothing is lost if the indexer is briefly unavailable.
+		if err := s.indexBeaconBadBlockFile(ctx, filePath, matcher, parsed); err != nil {
 			s.log.
-				WithField("slot", slot).
-				WithField("blockRoot", blockRoot).
 				WithField("filePath", filePath).
-				Debug("Processing beacon bad block")
-
-			location := CreateBeaconBadBlockFileName(
-				s.Config.Name,
-				string(s.node.Beacon().Metadata().Network.Name),
-				slot,
-				blockRoot,
-			)
-
-			location = fmt.Sprintf("%s.ssz", location)
-
-			exists := false
-
-			// Check if we've somehow already indexed this beacon bad block
-			rsp, err := s.indexer.ListBeaconBadBlock(ctx, &indexer.ListBeaconBadBlockRequest{
-				Node:      s.Config.Name,
-				BlockRoot: blockRoot,
-				Slot:      slotI,
-				Network:   string(s.node.Beacon().Metadata().Network.Name),
-			})
-			if err != nil {
-				s.log.
-					WithField("blockRoot", blockRoot).
-					WithField("slot", slot).
-					WithError(err).
-					Error("Failed to check if beacon bad block is already indexed")
-			}
-
-			if rsp != nil && len(rsp.BeaconBadBlocks) > 0 {
-				s.log.
-					WithField("blockRoot", blockRoot).
-					WithField("slot", slot).
-					Debug("Beacon bad block already indexed")
-
-				exists = true
-			}
-
-			if !exists {
-				now := time.Now()
-
-				compressedBlock, err := s.compressor.Compress(&blockRaw, compression.Gzip)
-				if err != nil {
-					return errors.Wrap(err, "failed to compress beacon bad block")
-				}
-
-				s.log.WithField("location", location).Debug("Saving beacon bad block")
-
-				location, err = s.store.SaveBeaconBadBlock(ctx, &store.SaveParams{
-					Data:            &compressedBlock,
-					Location:        location,
-					ContentEncoding: compression.Gzip.ContentEncoding,
-				})
-				if err != nil {
-					s.log.WithFields(logrus.Fields{
-						"slot":      slot,
-						"blockRoot": blockRoot,
-						"filePath":  filePath,
-					}).WithError(err).Error("Failed to save beacon bad block to store")
-
-					continue
-				}
-
-				// Sleep for 1s to give the store time to update
-				time.Sleep(1 * time.Second)
-
-				spec, err := s.node.Beacon().Node().Spec()
-				if err != nil {
-					s.log.
-						WithField("slot", slot).
-						WithField("blockRoot", blockRoot).
-						WithField("filePath", filePath).
-						WithError(err).Error("Failed to fetch spec")
-
-					continue
-				}
-
-				req := &indexer.CreateBeaconBadBlockRequest{
-					Node:            wrapperspb.String(s.Config.Name),
-					Network:         wrapperspb.String(string(s.node.Beacon().Metadata().Network.Name)),
-					Slot:            wrapperspb.UInt64(uint64(slot)),
-					Epoch:           wrapperspb.UInt64(uint64(slot) / uint64(spec.SlotsPerEpoch)),
-					BlockRoot:       wrapperspb.String(blockRoot),
-					Location:        wrapperspb.String(location),
-					ContentEncoding: wrapperspb.String(compression.Gzip.ContentEncoding),
-					NodeVersion:     wrapperspb.String(s.node.Beacon().Metadata().NodeVersion(ctx)),
-					BeaconImplementation: wrapperspb.String(
-						s.node.Beacon().Metadata().Client(ctx),
-					),
-					FetchedAt: timestamppb.New(now),
-				}
-
-				// Index the block
-				if _, err := s.indexer.CreateBeaconBadBlock(ctx, req); err != nil {
-					s.log.
-						WithField("blockRoot", blockRoot).
-						WithField("slot", slot).
-						WithError(err).
-						Error("Failed to index beacon bad block")
-
-					continue
-				}
-
-				s.metrics.IncrementItemExported(BeaconBadBlockQueue, s.Config.Name)
-
-				s.log.
-					WithField("block_root", blockRoot).
-					WithField("slot", slot).
-					Debug("Indexed beacon bad block")
-			}
-
-			// Delete the file
-			if err := os.Remove(filePath); err != nil {
-				s.log.
-					WithField("filePath", filePath).
-					WithError(err).
-					Error("Failed to delete beacon bad block")
-
-				continue
-			}
-
-			s.log.WithField("filePath", filePath).Debug("Deleted beacon bad block")
+				WithError(err).
+				Error("Failed to index beacon bad block")
+
+			continue
 		}
+
+		if err := os.Remove(filePath); err != nil {
+			s.log.
+				WithField("filePath", filePath).
+				WithError(err).
+				Error("Failed to delete beacon bad block")
+
+			continue
+		}
+
+		s.log.WithField("filePath", filePath).Debug("Deleted beacon bad block")
 	}
 
 	return nil
 }
 
-func getBadBlobsFilePattern(client string) (*string, error) {
-	var pattern string
+// indexBeaconBadBlockFile uploads and indexes a single beacon bad block file.
+// A nil error means the block is indexed.
+func (s *agent) indexBeaconBadBlockFile(ctx context.Context, filePath string, matcher *beacon.InvalidGossipFileMatcher, parsed *beacon.InvalidGossipFile) error {
+	// Take the metadata from one beacon node, even if the active one changes
+	// while the file is indexed.
+	upstream := s.node.Beacon().Active()
+
+	slotI := parsed.Slot
+	slot := phase0.Slot(slotI)
+	blockRoot := parsed.Root
+
+	logCtx := s.log.
+		WithField("slot", slot).
+		WithField("blockRoot", blockRoot).
+		WithField("kind", matcher.Kind).
+		WithField("filePath", filePath)
+
+	// Read the file into the `block` variable
+	blockRaw, err := os.ReadFile(filePath)
+	if err != nil {
+		return errors.Wrap(err, "failed to read beacon bad block file")
+	}
+
+	logCtx.Debug("Processing beacon bad block")
+
+	location := store.CreateBeaconBadBlockFileName(
+		s.Config.Name,
+		string(upstream.Metadata().Network.Name),
+		uint64(slot),
+		blockRoot,
+	)
+
+	location = fmt.Sprintf("%s.%s", location, matcher.Encoding)
+
+	// Check if we've somehow already indexed this beacon bad block
+	rsp, err := s.indexer.ListBeaconBadBlock(ctx, &indexer.ListBeaconBadBlockRequest{
+		Node:      s.Config.Name,
+		BlockRoot: blockRoot,
+		Slot:      slotI,
+		Network:   string(upstream.Metadata().Network.Name),
+	})
+	if err != nil {
+		logCtx.
+			WithError(err).
+			Error("Failed to check if beacon bad block is already indexed")
+	}
+
+	if rsp != nil && len(rsp.BeaconBadBlocks) > 0 {
+		logCtx.Debug("Beacon bad block already indexed")
+
+		return nil
+	}
+
+	now := time.Now()
+
+	algorithm := s.compressionAlgorithm(store.BeaconBadBlockDataType)
 
-	switch client {
-	case string(services.ClientPrysm):
-		pattern = `^blob_sidecar_([^.]+)_(\d+)_(\d+)\.ssz$`
-	default:
-		return nil, errors.New("client does not have bad blobs available")
+	compressedBlock, err := s.compressor.Compress(&blockRaw, algorithm)
+	if err != nil {
+		return errors.Wrap(err, "failed to compress beacon bad block")
 	}
 
-	return &pattern, nil
+	spec, err := upstream.Node().Spec()
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch spec")
+	}
+
+	req := &indexer.CreateBeaconBadBlockRequest{
+		Node:            wrapperspb.String(s.Config.Name),
+		Network:         wrapperspb.String(string(upstream.Metadata().Network.Name)),
+		Slot:            wrapperspb.UInt64(uint64(slot)),
+		Epoch:           wrapperspb.UInt64(uint64(slot) / uint64(spec.SlotsPerEpoch)),
+		BlockRoot:       wrapperspb.String(blockRoot),
+		Location:        wrapperspb.String(location),
+		ContentEncoding: wrapperspb.String(algorithm.ContentEncoding),
+		NodeVersion:     wrapperspb.String(upstream.Metadata().NodeVersion(ctx)),
+		BeaconImplementation: wrapperspb.String(
+			upstream.Metadata().Client(ctx),
+		),
+		FetchedAt: timestamppb.New(now),
+	}
+
+	logCtx.WithField("location", location).Debug("Saving beacon bad block")
+
+	// Save and index the block
+	if _, err := s.publishBeaconBadBlock(ctx, req, compressedBlock); err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return nil
+		}
+
+		return errors.Wrap(err, "failed to save beacon bad block")
+	}
+
+	s.metrics.IncrementItemExported(BeaconBadBlockQueue, s.Config.Name)
+
+	logCtx.Debug("Indexed beacon bad block")
+
+	return nil
 }
 
 func (s *agent) fetchAndIndexBeaconBadBlobs(ctx context.Context, path string) error {
 	client := s.node.Beacon().Metadata().Client(ctx)
 
-	// Verify the path is a directory
-	fileInfo, err := os.Stat(path)
+	matchers, err := s.Config.Ethereum.Beacon.InvalidGossipFileMatchers(client, true)
 	if err != nil {
 		return err
 	}
 
-	if !fileInfo.IsDir() {
-		return fmt.Errorf("path %s is not a directory", path)
+	if len(matchers) == 0 {
+		return fmt.Errorf("no bad blob file patterns for client %s, configure them in ethereum.beacon.invalidGossipFilePatterns", client)
 	}
 
-	pattern, err := getBadBlobsFilePattern(client)
+	files, err := s.settledInvalidGossipFiles(path)
 	if err != nil {
 		return err
 	}
 
-	matcher := regexp.MustCompile(*pattern)
+	for _, file := range files {
+		filePath := filepath.Join(path, file.Name())
+
+		matcher, parsed, err := matchInvalidGossipFile(matchers, file.Name())
+		if err != nil {
+			s.log.
+				WithField("filePath", filePath).
+				WithError(err).
+				Error("Failed to parse beacon bad blob file name")
+
+			continue
+		}
+
+		if parsed == nil {
+			continue
+		}
+
+		// The file is only deleted once the indexer has confirmed the blob is
+		// indexed, so nothing is lost if the indexer is briefly unavailable.
+		if err := s.indexBeaconBadBlobFile(ctx, filePath, matcher, parsed); err != nil {
+			s.log.
+				WithField("filePath", filePath).
+				WithError(err).
+				Error("Failed to index beacon bad blob")
+
+			continue
+		}
+
+		if err := os.Remove(filePath); err != nil {
+			s.log.
+				WithField("filePath", filePath).
+				WithError(err).
+				Error("Failed to delete beacon bad blob")
+
+			continue
+		}
+
+		s.log.WithField("filePath", filePath).Debug("Deleted beacon bad blob")
+	}
+
+	return nil
+}
+
+// indexBeaconBadBlobFile uploads and indexes a single beacon bad blob file.
+// A nil error means the blob is indexed.
+func (s *agent) indexBeaconBadBlobFile(ctx context.Context, filePath string, matcher *beacon.InvalidGossipFileMatcher, parsed *beacon.InvalidGossipFile) error {
+	// Take the metadata from one beacon node, even if the active one changes
+	// while the file is indexed.
+	upstream := s.node.Beacon().Active()
+
+	slotI := parsed.Slot
+	slot := phase0.Slot(slotI)
+	blockRoot := parsed.Root
+	index := parsed.Index
+
+	logCtx := s.log.
+		WithField("slot", slot).
+		WithField("blockRoot", blockRoot).
+		WithField("index", index).
+		WithField("filePath", filePath)
+
+	// Read the file into the `blob` variable
+	blobRaw, err := os.ReadFile(filePath)
+	if err != nil {
+		return errors.Wrap(err, "failed to read beacon bad blob file")
+	}
+
+	logCtx.Debug("Processing beacon bad blob")
+
+	location := store.CreateBeaconBadBlobFileName(
+		s.Config.Name,
+		string(upstream.Metadata().Network.Name),
+		uint64(slot),
+		blockRoot,
+		index,
+	)
+
+	location = fmt.Sprintf("%s.%s", location, matcher.Encoding)
+
+	// Check if we've somehow already indexed this beacon bad blob
+	rsp, err := s.indexer.ListBeaconBadBlob(ctx, &indexer.ListBeaconBadBlobRequest{
+		Node:      s.Config.Name,
+		BlockRoot: blockRoot,
+		Slot:      slotI,
+		Index:     wrapperspb.UInt64(index),
+		Network:   string(upstream.Metadata().Network.Name),
+	})
+	if err != nil {
+		logCtx.
+			WithError(err).
+			Error("Failed to check if beacon bad blob is already indexed")
+	}
+
+	if rsp != nil && len(rsp.BeaconBadBlobs) > 0 {
+		logCtx.Debug("Beacon bad blob already indexed")
+
+		return nil
+	}
+
+	now := time.Now()
+
+	algorithm := s.compressionAlgorithm(store.BeaconBadBlobDataType)
+
+	// Compress it
+	compressedBlob, err := s.compressor.Compress(&blobRaw, algorithm)
+	if err != nil {
+		return errors.Wrap(err, "failed to compress beacon bad blob")
+	}
+
+	spec, err := upstream.Node().Spec()
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch spec")
+	}
+
+	req := &indexer.CreateBeaconBadBlobRequest{
+		Node:            wrapperspb.String(s.Config.Name),
+		Network:         wrapperspb.String(string(upstream.Metadata().Network.Name)),
+		Slot:            wrapperspb.UInt64(uint64(slot)),
+		Epoch:           wrapperspb.UInt64(uint64(slot) / uint64(spec.SlotsPerEpoch)),
+		BlockRoot:       wrapperspb.String(blockRoot),
+		Index:           wrapperspb.UInt64(index),
+		Location:        wrapperspb.String(location),
+		ContentEncoding: wrapperspb.String(algorithm.ContentEncoding),
+		NodeVersion:     wrapperspb.String(upstream.Metadata().NodeVersion(ctx)),
+		BeaconImplementation: wrapperspb.String(
+			upstream.Metadata().Client(ctx),
+		),
+		FetchedAt: timestamppb.New(now),
+	}
+
+	logCtx.WithField("location", location).Debug("Saving beacon bad blob")
+
+	// Save and index the blob
+	if _, err := s.publishBeaconBadBlob(ctx, req, compressedBlob); err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return nil
+		}
+
+		return errors.Wrap(err, "failed to save beacon bad blob")
+	}
+
+	s.metrics.IncrementItemExported(BeaconBadBlobQueue, s.Config.Name)
+
+	logCtx.Debug("Indexed beacon bad blob")
+
+	return nil
+}
+
+// matchInvalidGossipFile parses a file name with the first matcher it matches.
+// A nil file is returned if none of them match.
+func matchInvalidGossipFile(matchers []*beacon.InvalidGossipFileMatcher, name string) (*beacon.InvalidGossipFileMatcher, *beacon.InvalidGossipFile, error) {
+	for _, matcher := range matchers {
+		file, ok, err := matcher.Match(name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if ok {
+			return matcher, file, nil
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// settledInvalidGossipFiles lists the files in an invalid gossip path that
+// haven't been modified for the settle time, skipping any that are likely
+// still being written by the beacon node.
+func (s *agent) settledInvalidGossipFiles(path string) ([]os.DirEntry, error) {
+	// Verify the path is a directory
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fileInfo.IsDir() {
+		return nil, fmt.Errorf("path %s is not a directory", path)
+	}
 
 	files, err := os.ReadDir(path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	settled := make([]os.DirEntry, 0, len(files))
+	cutoff := time.Now().Add(-s.Config.Ethereum.Beacon.GetInvalidGossipSettleTime())
+
 	for _, file := range files {
-		matches := matcher.FindStringSubmatch(file.Name())
-		if len(matches) == 4 {
-			filePath := filepath.Join(path, file.Name())
-			// Parse 'slot', 'blockRoot' and 'index' from the file name
-			blockRoot := matches[1]
-
-			slotI, err := strconv.ParseUint(matches[2], 10, 64)
-			if err != nil {
-				s.log.
-					WithField("fileName", file.Name()).
-					WithField("filePath", filePath).
-					WithError(err).Error("Failed to parse slot from beacon bad blob file name")
-
-				continue
-			}
-
-			slot := phase0.Slot(slotI)
-
-			index, err := strconv.ParseUint(matches[3], 10, 64)
-			if err != nil {
-				s.log.
-					WithField("fileName", file.Name()).
-					WithField("filePath", filePath).
-					WithError(err).Error("Failed to parse index from beacon bad blob file name")
-
-				continue
-			}
-
-			// Read the file into the `blob` variable
-			blobRaw, err := os.ReadFile(filePath)
-			if err != nil {
-				s.log.
-					WithField("slot", slot).
-					WithField("blockRoot", blockRoot).
-					WithField("index", index).
-					WithField("filePath", filePath).
-					WithError(err).
-					Error("Failed to read beacon bad blob file")
-
-				continue
-			}
+		if file.IsDir() {
+			continue
+		}
 
-			s.log.
-				WithField("slot", slot).
-				WithField("blockRoot", blockRoot).
-				WithField("index", index).
-				WithField("filePath", filePath).
-				Debug("Processing beacon bad block")
-
-			location := CreateBeaconBadBlobFileName(
-				s.Config.Name,
-				string(s.node.Beacon().Metadata().Network.Name),
-				slot,
-				blockRoot,
-				index,
-			)
-
-			location = fmt.Sprintf("%s.ssz", location)
-
-			exists := false
-
-			// Check if we've somehow already indexed this beacon bad blob
-			rsp, err := s.indexer.ListBeaconBadBlob(ctx, &indexer.ListBeaconBadBlobRequest{
-				Node:      s.Config.Name,
-				BlockRoot: blockRoot,
-				Slot:      slotI,
-				Index:     wrapperspb.UInt64(index),
-				Network:   string(s.node.Beacon().Metadata().Network.Name),
-			})
-			if err != nil {
-				s.log.
-					WithField("index", index).
-					WithField("blockRoot", blockRoot).
-					WithField("slot", slot).
-					WithError(err).
-					Error("Failed to check if beacon bad blob is already indexed")
-			}
-
-			if rsp != nil && len(rsp.BeaconBadBlobs) > 0 {
-				s.log.
-					WithField("index", index).
-					WithField("blockRoot", blockRoot).
-					WithField("slot", slot).
-					Debug("Beacon bad blob already indexed")
-
-				exists = true
-			}
-
-			if !exists {
-				now := time.Now()
-
-				// Compress it
-				compressedBlob, err := s.compressor.Compress(&blobRaw, compression.Gzip)
-				if err != nil {
-					return errors.Wrap(err, "failed to compress beacon bad block")
-				}
-
-				s.log.WithField("location", location).Debug("Saving beacon bad blob")
-
-				location, err = s.store.SaveBeaconBadBlob(ctx, &store.SaveParams{
-					Data:            &compressedBlob,
-					Location:        location,
-					ContentEncoding: compression.Gzip.ContentEncoding,
-				})
-				if err != nil {
-					s.log.WithFields(logrus.Fields{
-						"slot":      slot,
-						"blockRoot": blockRoot,
-						"index":     index,
-						"filePath":  filePath,
-					}).WithError(err).Error("Failed to save beacon bad blob to store")
-
-					continue
-				}
-
-				// Sleep for 1s to give the store time to update
-				time.Sleep(1 * time.Second)
-
-				spec, err := s.node.Beacon().Node().Spec()
-				if err != nil {
-					s.log.
-						WithField("slot", slot).
-						WithField("blockRoot", blockRoot).
-						WithField("index", index).
-						WithField("filePath", filePath).
-						WithError(err).Error("Failed to fetch spec")
-
-					continue
-				}
-
-				req := &indexer.CreateBeaconBadBlobRequest{
-					Node:            wrapperspb.String(s.Config.Name),
-					Network:         wrapperspb.String(string(s.node.Beacon().Metadata().Network.Name)),
-					Slot:            wrapperspb.UInt64(uint64(slot)),
-					Epoch:           wrapperspb.UInt64(uint64(slot) / uint64(spec.SlotsPerEpoch)),
-					BlockRoot:       wrapperspb.String(blockRoot),
-					Index:           wrapperspb.UInt64(index),
-					Location:        wrapperspb.String(location),
-					ContentEncoding: wrapperspb.String(compression.Gzip.ContentEncoding),
-					NodeVersion:     wrapperspb.String(s.node.Beacon().Metadata().NodeVersion(ctx)),
-					BeaconImplementation: wrapperspb.String(
-						s.node.Beacon().Metadata().Client(ctx),
-					),
-					FetchedAt: timestamppb.New(now),
-				}
-
-				// Index the blob
-				if _, err := s.indexer.CreateBeaconBadBlob(ctx, req); err != nil {
-					s.log.
-						WithField("blockRoot", blockRoot).
-						WithField("index", index).
-						WithField("slot", slot).
-						WithError(err).
-						Error("Failed to index beacon bad blob")
-
-					continue
-				}
-
-				s.metrics.IncrementItemExported(BeaconBadBlobQueue, s.Config.Name)
-
-				s.log.
-					WithField("blockRoot", blockRoot).
-					WithField("index", index).
-					WithField("slot", slot).
-					Debug("Indexed beacon bad block")
-			}
-
-			// Delete the file
-			if err := os.Remove(filePath); err != nil {
-				s.log.
-					WithField("filePath", filePath).
-					WithError(err).
-					Error("Failed to delete beacon bad blob")
-
-				continue
-			}
-
-			s.log.WithField("filePath", filePath).Debug("Deleted beacon bad blob")
+		info, err := file.Info()
+		if err != nil {
+			// The file was removed since the directory was read.
+			continue
+		}
+
+		if info.ModTime().After(cutoff) {
+			s.log.WithField("file", file.Name()).Debug("Skipping invalid gossip file that is still being written")
+
+			continue
 		}
+
+		settled = append(settled, file)
 	}
 
-	return nil
+	return settled, nil
 }
@@ -2,28 +2,33 @@ package beacon
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	bn "github.com/ethpandaops/beacon/pkg/beacon"
 	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/beacon/services"
-	"github.com/ethpandaops/tracoor/pkg/mime"
-	"github.com/go-co-op/gocron"
-	"github.com/pkg/errors"
+	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/failover"
 	"github.com/sirupsen/logrus"
 )
 
+// healthCheckInterval is how often the beacon nodes are health checked.
+const healthCheckInterval = 3 * time.Second
+
 type Node struct {
 	config *Config
 	log    logrus.FieldLogger
 
-	beacon bn.Node
-
-	services []services.Service
+	upstreams []*Upstream
+	selector  *failover.Selector
 
 	onReadyCallbacks []func(ctx context.Context) error
+	readyOnce        sync.Once
+	ready            atomic.Bool
+	healthy          atomic.Bool
 }
 
 func NewNode(ctx context.Context, log logrus.FieldLogger, name, overrideNetworkName string, config *Config) *Node {
@@ -39,124 +44,185 @@ func NewNode(ctx context.Context, log logrus.FieldLogger, name, overrideNetworkN
 	} else {
 		opts.BeaconSubscription = bn.BeaconSubscriptionOptions{
 			Enabled: true,
-			Topics:  []string{"block", "chain_reorg"},
+			Topics:  []string{"block", "chain_reorg", "finalized_checkpoint"},
 		}
 	}
 
-	opts.HealthCheck.Interval.Duration = time.Second * 3
+	opts.HealthCheck.Interval.Duration = healthCheckInterval
 	opts.HealthCheck.SuccessfulResponses = 1
 
-	node := bn.NewNode(log, &bn.Config{
-		Name:    name,
-		Addr:    config.NodeAddress,
-		Headers: config.NodeHeaders,
-	}, "tracoor_agent", opts)
+	upstreams := []*Upstream{}
+	candidates := []failover.Upstream{}
 
-	metadata := services.NewMetadataService(log, node, overrideNetworkName)
+	for _, nodeConfig := range config.GetNodes() {
+		upstream := newUpstream(log, name, overrideNetworkName, nodeConfig, &opts)
 
-	svcs := []services.Service{
-		&metadata,
+		upstreams = append(upstreams, upstream)
+		candidates = append(candidates, upstream)
 	}
 
 	return &Node{
-		config:   config,
-		log:      log.WithField("module", "agent/ethereum/beacon"),
-		beacon:   node,
-		services: svcs,
+		config:    config,
+		log:       log.WithField("module", "agent/ethereum/beacon"),
+		upstreams: upstreams,
+		selector: failover.NewSelector(
+			log.WithField("module", "agent/ethereum/beacon/failover"),
+			candidates,
+			failover.DefaultFailbackChecks,
+		),
 	}
 }
 
+// Active returns the beacon node that's currently in use.
+func (b *Node) Active() *Upstream {
+	return b.upstreams[b.selector.Active()]
+}
+
 func (b *Node) GetVersionImmuneBlock(ctx context.Context, blockID string) (*VersionImmuneBlock, error) {
-	data, err := b.beacon.FetchRawBlock(ctx, blockID, string(mime.ContentTypeJSON))
-	if err != nil {
-		return nil, err
-	}
+	return b.Active().GetVersionImmuneBlock(ctx, blockID)
+}
 
-	block := &VersionImmuneBlock{}
+// SidecarTypeAtEpoch returns the type of sidecar blocks carry at the given
+// epoch. Returns false if blocks at the epoch don't have sidecars.
+func (b *Node) SidecarTypeAtEpoch(epoch phase0.Epoch) (SidecarType, bool, error) {
+	return b.Active().SidecarTypeAtEpoch(epoch)
+}
 
-	if err := json.Unmarshal(data, block); err != nil {
-		return nil, err
-	}
+// FetchRawSidecars fetches the SSZ encoded sidecars of the given type for a block.
+func (b *Node) FetchRawSidecars(ctx context.Context, sidecarType SidecarType, blockID string) ([]byte, error) {
+	return b.Active().FetchRawSidecars(ctx, sidecarType, blockID)
+}
 
-	return block, nil
+// FetchRawForkChoice fetches the JSON encoded fork choice store dump of the beacon node.
+func (b *Node) FetchRawForkChoice(ctx context.Context) ([]byte, error) {
+	return b.Active().FetchRawForkChoice(ctx)
 }
 
 func (b *Node) Start(ctx context.Context) error {
-	s := gocron.NewScheduler(time.Local)
+	for _, upstream := range b.upstreams {
+		upstream.metadata.OnReady(ctx, func(ctx context.Context) error {
+			upstream.log.Info("Beacon node is ready")
 
-	errs := make(chan error, 1)
+			b.checkReady(ctx)
 
-	go func() {
-		wg := sync.WaitGroup{}
+			return nil
+		})
 
-		for _, service := range b.services {
-			wg.Add(1)
+		upstream.log.Info("Starting beacon node")
 
-			service.OnReady(ctx, func(ctx context.Context) error {
-				b.log.WithField("service", service.Name()).Info("Service is ready")
+		if err := upstream.metadata.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start service: %w", err)
+		}
 
-				wg.Done()
+		// Starting blocks until the beacon node is reachable, so each one is
+		// started separately to not hold up the others.
+		go func() {
+			if err := upstream.beacon.Start(ctx); err != nil {
+				upstream.log.WithError(err).Error("Failed to start beacon node")
+			}
+		}()
+	}
 
-				return nil
-			})
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
 
-			b.log.WithField("service", service.Name()).Info("Starting service")
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			b.checkReady(ctx)
+		}
+	}
+}
 
-			if err := service.Start(ctx); err != nil {
-				errs <- fmt.Errorf("failed to start service: %w", err)
-			}
+// Ready returns true once a beacon node has been ready.
+func (b *Node) Ready() bool {
+	return b.ready.Load()
+}
+
+// Healthy returns true if the beacon node in use passed its last health check.
+func (b *Node) Healthy() bool {
+	return b.healthy.Load()
+}
 
-			wg.Wait()
+// checkReady picks the beacon node to use and runs the on ready callbacks
+// the first time one is ready.
+func (b *Node) checkReady(ctx context.Context) {
+	healthy := b.selector.Check(ctx)
+
+	b.healthy.Store(healthy)
+
+	if !healthy {
+		if b.ready.Load() {
+			b.log.Warn("No healthy beacon nodes available")
 		}
 
-		b.log.Info("All services are ready")
+		return
+	}
+
+	b.readyOnce.Do(func() {
+		b.ready.Store(true)
+
+		b.log.WithField("upstream", b.Active().Name()).Info("All services are ready")
 
 		for _, callback := range b.onReadyCallbacks {
 			if err := callback(ctx); err != nil {
-				errs <- fmt.Errorf("failed to run on ready callback: %w", err)
+				b.log.WithError(err).Error("Failed to run on ready callback")
 			}
 		}
-	}()
-
-	s.StartAsync()
-
-	if err := b.beacon.Start(ctx); err != nil {
-		return err
-	}
-
-	select {
-	case err := <-errs:
-		return err
-	case <-ctx.Done():
-		return ctx.Err()
-	}
+	})
 }
 
+// Node returns the beacon node that's currently in use.
 func (b *Node) Node() bn.Node {
-	return b.beacon
+	return b.Active().Node()
 }
 
-func (b *Node) getServiceByName(name services.Name) (services.Service, error) {
-	for _, service := range b.services {
-		if service.Name() == name {
-			return service, nil
-		}
-	}
+// Metadata returns the metadata of the beacon node that's currently in use.
+func (b *Node) Metadata() *services.MetadataService {
+	return b.Active().Metadata()
+}
 
-	return nil, errors.New("service not found")
+func (b *Node) OnReady(_ context.Context, callback func(ctx context.Context) error) {
+	b.onReadyCallbacks = append(b.onReadyCallbacks, callback)
 }
 
-func (b *Node) Metadata() *services.MetadataService {
-	service, err := b.getServiceByName("metadata")
-	if err != nil {
-		// This should never happen. If it does, good luck.
-		return nil
+// OnBlock is called when the beacon node in use receives a block.
+func (b *Node) OnBlock(ctx context.Context, handler func(ctx context.Context, event *v1.BlockEvent) error) {
+	for _, upstream := range b.upstreams {
+		upstream.beacon.OnBlock(ctx, func(ctx context.Context, event *v1.BlockEvent) error {
+			if b.Active() != upstream {
+				return nil
+			}
+
+			return handler(ctx, event)
+		})
 	}
+}
+
+// OnChainReOrg is called when the beacon node in use reorgs.
+func (b *Node) OnChainReOrg(ctx context.Context, handler func(ctx context.Context, event *v1.ChainReorgEvent) error) {
+	for _, upstream := range b.upstreams {
+		upstream.beacon.OnChainReOrg(ctx, func(ctx context.Context, event *v1.ChainReorgEvent) error {
+			if b.Active() != upstream {
+				return nil
+			}
 
-	//nolint:errcheck // casting fine.
-	return service.(*services.MetadataService)
+			return handler(ctx, event)
+		})
+	}
 }
 
-func (b *Node) OnReady(_ context.Context, callback func(ctx context.Context) error) {
-	b.onReadyCallbacks = append(b.onReadyCallbacks, callback)
+// OnFinalizedCheckpoint is called when the beacon node in use finalizes a checkpoint.
+func (b *Node) OnFinalizedCheckpoint(ctx context.Context, handler func(ctx context.Context, event *v1.FinalizedCheckpointEvent) error) {
+	for _, upstream := range b.upstreams {
+		upstream.beacon.OnFinalizedCheckpoint(ctx, func(ctx context.Context, event *v1.FinalizedCheckpointEvent) error {
+			if b.Active() != upstream {
+				return nil
+			}
+
+			return handler(ctx, event)
+		})
+	}
 }
@@ -1,24 +1,138 @@
 package beacon
 
-import "github.com/pkg/errors"
+import (
+	"time"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+	"github.com/pkg/errors"
+)
+
+// NodeConfig is a beacon node the agent can connect to.
+type NodeConfig struct {
+	// Address is the address of the beacon node.
+	Address string `yaml:"address"`
+	// Headers is a map of headers to send to the beacon node.
+	Headers map[string]string `yaml:"headers"`
+}
 
 type Config struct {
 	// The address of the Beacon node to connect to
 	NodeAddress string `yaml:"nodeAddress"`
 	// BeaconNodeHeaders is a map of headers to send to the beacon node.
 	NodeHeaders map[string]string `yaml:"nodeHeaders"`
+	// Nodes is an ordered list of beacon nodes to connect to instead of NodeAddress. The first
+	// healthy node is used, failing over to the next one when it becomes unhealthy.
+	Nodes []NodeConfig `yaml:"nodes"`
 	// BeaconSubscriptions is a list of beacon subscriptions to subscribe to.
 	BeaconSubscriptions *[]string `yaml:"beaconSubscriptions"`
 	// InvalidGossipVerifiedBlocksPath is the path to watch for invalid gossip verified blocks from the beacon node.
 	InvalidGossipVerifiedBlocksPath *string `yaml:"invalidGossipVerifiedBlocksPath"`
 	// InvalidGossipVerifiedBlobsPath is the path to watch for invalid gossip verified blobs from the beacon node.
 	InvalidGossipVerifiedBlobsPath *string `yaml:"invalidGossipVerifiedBlobsPath"`
+	// InvalidGossipSettleTime is how long a file in the invalid gossip paths must go unmodified
+	// before it's captured, so files the beacon node is still writing aren't picked up. Defaults to 2s.
+	InvalidGossipSettleTime *human.Duration `yaml:"invalidGossipSettleTime"`
+	// InvalidGossipPollInterval is how often the invalid gossip paths are rescanned. New files are
+	// picked up straight away where file system notifications are available. Defaults to 30s.
+	InvalidGossipPollInterval *human.Duration `yaml:"invalidGossipPollInterval"`
+	// InvalidGossipFilePatterns describes the files each client writes to the invalid gossip paths.
+	// Patterns configured for a client replace the built-in patterns for that client.
+	InvalidGossipFilePatterns []InvalidGossipFilePattern `yaml:"invalidGossipFilePatterns"`
+	// ForkChoiceInterval is how often to capture the fork choice of the beacon node, in addition
+	// to capturing it on chain reorgs. If unset, the fork choice is only captured on chain reorgs.
+	ForkChoiceInterval *human.Duration `yaml:"forkChoiceInterval"`
 }
 
 func (c *Config) Validate() error {
-	if c.NodeAddress == "" {
+	if c.NodeAddress == "" && len(c.Nodes) == 0 {
 		return errors.New("beaconNodeAddress is required")
 	}
 
+	if c.NodeAddress != "" && len(c.Nodes) > 0 {
+		return errors.New("only one of nodeAddress and nodes can be set")
+	}
+
+	for _, node := range c.Nodes {
+		if node.Address == "" {
+			return errors.New("address is required for each of nodes")
+		}
+	}
+
+	if c.ForkChoiceInterval != nil && c.ForkChoiceInterval.Duration <= 0 {
+		return errors.New("forkChoiceInterval must be positive")
+	}
+
+	if c.InvalidGossipSettleTime != nil && c.InvalidGossipSettleTime.Duration < 0 {
+		return errors.New("invalidGossipSettleTime must not be negative")
+	}
+
+	if c.InvalidGossipPollInterval != nil && c.InvalidGossipPollInterval.Duration <= 0 {
+		return errors.New("invalidGossipPollInterval must be positive")
+	}
+
+	for i := range c.InvalidGossipFilePatterns {
+		if err := c.InvalidGossipFilePatterns[i].Validate(); err != nil {
+			return errors.Wrap(err, "invalid invalidGossipFilePatterns")
+		}
+	}
+
 	return nil
 }
+
+// GetNodes returns the beacon nodes to connect to, in order of preference.
+func (c *Config) GetNodes() []NodeConfig {
+	if len(c.Nodes) > 0 {
+		return c.Nodes
+	}
+
+	return []NodeConfig{{Address: c.NodeAddress, Headers: c.NodeHeaders}}
+}
+
+func (c *Config) GetInvalidGossipSettleTime() time.Duration {
+	if c.InvalidGossipSettleTime == nil {
+		return 2 * time.Second // default value
+	}
+
+	return c.InvalidGossipSettleTime.Duration
+}
+
+func (c *Config) GetInvalidGossipPollInterval() time.Duration {
+	if c.InvalidGossipPollInterval == nil {
+		return 30 * time.Second // default value
+	}
+
+	return c.InvalidGossipPollInterval.Duration
+}
+
+// InvalidGossipFileMatchers returns the matchers for the invalid gossip files
+// the client writes, either for blobs or for blocks.
+func (c *Config) InvalidGossipFileMatchers(client string, blobs bool) ([]*InvalidGossipFileMatcher, error) {
+	patterns := DefaultInvalidGossipFilePatterns
+
+	for _, pattern := range c.InvalidGossipFilePatterns {
+		if pattern.Client == client {
+			patterns = c.InvalidGossipFilePatterns
+
+			break
+		}
+	}
+
+	var matchers []*InvalidGossipFileMatcher
+
+	for i := range patterns {
+		pattern := &patterns[i]
+
+		if pattern.Client != client || pattern.Kind.IsBlob() != blobs {
+			continue
+		}
+
+		matcher, err := pattern.Matcher()
+		if err != nil {
+			return nil, err
+		}
+
+		matchers = append(matchers, matcher)
+	}
+
+	return matchers, nil
+}
@@ -0,0 +1,32 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Nodes(t *testing.T) {
+	single := &Config{
+		NodeAddress: "http://localhost:5052",
+		NodeHeaders: map[string]string{"authorization": "Basic abc"},
+	}
+
+	require.NoError(t, single.Validate())
+	assert.Equal(t, []NodeConfig{{Address: single.NodeAddress, Headers: single.NodeHeaders}}, single.GetNodes())
+
+	multiple := &Config{
+		Nodes: []NodeConfig{
+			{Address: "http://primary:5052"},
+			{Address: "http://standby:5052"},
+		},
+	}
+
+	require.NoError(t, multiple.Validate())
+	assert.Equal(t, multiple.Nodes, multiple.GetNodes())
+
+	assert.Error(t, (&Config{}).Validate(), "no nodes")
+	assert.Error(t, (&Config{NodeAddress: "http://localhost:5052", Nodes: multiple.Nodes}).Validate(), "both set")
+	assert.Error(t, (&Config{Nodes: []NodeConfig{{}}}).Validate(), "missing address")
+}
@@ -0,0 +1,12 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/ethpandaops/tracoor/pkg/mime"
+)
+
+// FetchRawForkChoice fetches the JSON encoded fork choice store dump of the beacon node.
+func (u *Upstream) FetchRawForkChoice(ctx context.Context) ([]byte, error) {
+	return u.fetchRaw(ctx, "/eth/v1/debug/fork_choice", mime.ContentTypeJSON)
+}
@@ -0,0 +1,245 @@
+package beacon
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/beacon/services"
+	"github.com/pkg/errors"
+)
+
+// InvalidGossipFileKind is what an invalid gossip file contains.
+type InvalidGossipFileKind string
+
+const (
+	InvalidGossipFileKindSignedBlock InvalidGossipFileKind = "signed_block"
+	InvalidGossipFileKindBlock       InvalidGossipFileKind = "block"
+	InvalidGossipFileKindBlobSidecar InvalidGossipFileKind = "blob_sidecar"
+)
+
+// IsBlob returns true if the file contains a blob rather than a block.
+func (k InvalidGossipFileKind) IsBlob() bool {
+	return k == InvalidGossipFileKindBlobSidecar
+}
+
+// InvalidGossipFileEncoding is how an invalid gossip file is encoded. It's
+// used as the file extension when the file is stored.
+type InvalidGossipFileEncoding string
+
+const (
+	InvalidGossipFileEncodingSSZ       InvalidGossipFileEncoding = "ssz"
+	InvalidGossipFileEncodingSSZSnappy InvalidGossipFileEncoding = "ssz_snappy"
+	InvalidGossipFileEncodingJSON      InvalidGossipFileEncoding = "json"
+)
+
+// InvalidGossipFileGroups are the capture groups of an invalid gossip file
+// pattern that hold each value. Zero means the file name doesn't contain it.
+type InvalidGossipFileGroups struct {
+	Slot  int `yaml:"slot"`
+	Root  int `yaml:"root"`
+	Index int `yaml:"index"`
+}
+
+// InvalidGossipFilePattern describes the files a beacon node client writes
+// to its invalid gossip path.
+type InvalidGossipFilePattern struct {
+	// Client is the beacon node client that writes these files.
+	Client string `yaml:"client"`
+	// Pattern is the regex the file name must match.
+	Pattern string `yaml:"pattern"`
+	// Groups are the capture groups of Pattern that hold the slot, root and index.
+	Groups InvalidGossipFileGroups `yaml:"groups"`
+	// Kind is what the file contains. Blob sidecars are indexed as beacon bad
+	// blobs and everything else as beacon bad blocks. The file is never decoded,
+	// so signed_block and block are handled the same.
+	Kind InvalidGossipFileKind `yaml:"kind"`
+	// Encoding is how the file is encoded. The file is stored as is, with the
+	// encoding as its extension.
+	Encoding InvalidGossipFileEncoding `yaml:"encoding"`
+}
+
+// InvalidGossipFile is an invalid gossip file name parsed with a pattern.
+type InvalidGossipFile struct {
+	Slot  uint64
+	Root  string
+	Index uint64
+}
+
+// DefaultInvalidGossipFilePatterns are the file patterns of each client's
+// invalid gossip dumps, used unless a client's patterns are configured.
+// Grandine has none yet, as its dump file names haven't been confirmed, so its
+// patterns must be configured until they're added here.
+var DefaultInvalidGossipFilePatterns = []InvalidGossipFilePattern{
+	{
+		// --invalid-gossip-verified-blocks-path
+		Client:   string(services.ClientLighthouse),
+		Pattern:  `^(\d+)_([^.]+)\.ssz$`,
+		Groups:   InvalidGossipFileGroups{Slot: 1, Root: 2},
+		Kind:     InvalidGossipFileKindSignedBlock,
+		Encoding: InvalidGossipFileEncodingSSZ,
+	},
+	{
+		// --dump, written to <data-dir>/dump/invalid
+		Client:   string(services.ClientNimbus),
+		Pattern:  `^block-(\d+)-([^.]+)\.ssz$`,
+		Groups:   InvalidGossipFileGroups{Slot: 1, Root: 2},
+		Kind:     InvalidGossipFileKindSignedBlock,
+		Encoding: InvalidGossipFileEncodingSSZ,
+	},
+	{
+		// --save-invalid-block-temp, written to os.TempDir()
+		Client:   string(services.ClientPrysm),
+		Pattern:  `^beacon_block_(\d+)\.ssz$`,
+		Groups:   InvalidGossipFileGroups{Slot: 1},
+		Kind:     InvalidGossipFileKindSignedBlock,
+		Encoding: InvalidGossipFileEncodingSSZ,
+	},
+	{
+		// --save-invalid-blob-temp, written to os.TempDir()
+		Client:   string(services.ClientPrysm),
+		Pattern:  `^blob_sidecar_([^.]+)_(\d+)_(\d+)\.ssz$`,
+		Groups:   InvalidGossipFileGroups{Root: 1, Slot: 2, Index: 3},
+		Kind:     InvalidGossipFileKindBlobSidecar,
+		Encoding: InvalidGossipFileEncodingSSZ,
+	},
+	{
+		// --Xdebug-data-dumping-enabled, written to <data-path>/debug/invalid_blocks
+		Client:   string(services.ClientTeku),
+		Pattern:  `^(\d+)_(?:0x)?([0-9a-fA-F]{64})\.ssz$`,
+		Groups:   InvalidGossipFileGroups{Slot: 1, Root: 2},
+		Kind:     InvalidGossipFileKindSignedBlock,
+		Encoding: InvalidGossipFileEncodingSSZ,
+	},
+	{
+		// --Xdebug-data-dumping-enabled, written to <data-path>/debug/invalid_blob_sidecars
+		Client:   string(services.ClientTeku),
+		Pattern:  `^(\d+)_(?:0x)?([0-9a-fA-F]{64})_(\d+)\.ssz$`,
+		Groups:   InvalidGossipFileGroups{Slot: 1, Root: 2, Index: 3},
+		Kind:     InvalidGossipFileKindBlobSidecar,
+		Encoding: InvalidGossipFileEncodingSSZ,
+	},
+	{
+		// --chain.persistInvalidSszObjects, written to <dataDir>/invalidSszObjects
+		Client:   string(services.ClientLodestar),
+		Pattern:  `^signedBlock_(\d+)_(0x[0-9a-fA-F]+)\.ssz$`,
+		Groups:   InvalidGossipFileGroups{Slot: 1, Root: 2},
+		Kind:     InvalidGossipFileKindSignedBlock,
+		Encoding: InvalidGossipFileEncodingSSZ,
+	},
+	{
+		// --chain.persistInvalidSszObjects, written to <dataDir>/invalidSszObjects
+		Client:   string(services.ClientLodestar),
+		Pattern:  `^blobSidecar_(\d+)_(0x[0-9a-fA-F]+)_(\d+)\.ssz$`,
+		Groups:   InvalidGossipFileGroups{Slot: 1, Root: 2, Index: 3},
+		Kind:     InvalidGossipFileKindBlobSidecar,
+		Encoding: InvalidGossipFileEncodingSSZ,
+	},
+}
+
+func (p *InvalidGossipFilePattern) Validate() error {
+	if p.Client == "" {
+		return errors.New("client is required")
+	}
+
+	re, err := regexp.Compile(p.Pattern)
+	if err != nil {
+		return errors.Wrapf(err, "invalid pattern for %s", p.Client)
+	}
+
+	switch p.Kind {
+	case InvalidGossipFileKindSignedBlock, InvalidGossipFileKindBlock, InvalidGossipFileKindBlobSidecar:
+	default:
+		return fmt.Errorf("invalid kind %q for %s", p.Kind, p.Client)
+	}
+
+	switch p.Encoding {
+	case InvalidGossipFileEncodingSSZ, InvalidGossipFileEncodingSSZSnappy, InvalidGossipFileEncodingJSON:
+	default:
+		return fmt.Errorf("invalid encoding %q for %s", p.Encoding, p.Client)
+	}
+
+	if p.Groups.Slot == 0 {
+		return fmt.Errorf("slot group is required for %s", p.Client)
+	}
+
+	if p.Kind.IsBlob() && (p.Groups.Root == 0 || p.Groups.Index == 0) {
+		return fmt.Errorf("root and index groups are required for %s blob sidecars", p.Client)
+	}
+
+	for _, group := range []int{p.Groups.Slot, p.Groups.Root, p.Groups.Index} {
+		if group < 0 || group > re.NumSubexp() {
+			return fmt.Errorf("pattern for %s has no capture group %d", p.Client, group)
+		}
+	}
+
+	return nil
+}
+
+// Matcher compiles the pattern into a matcher.
+func (p *InvalidGossipFilePattern) Matcher() (*InvalidGossipFileMatcher, error) {
+	re, err := regexp.Compile(p.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InvalidGossipFileMatcher{
+		InvalidGossipFilePattern: p,
+		re:                       re,
+	}, nil
+}
+
+// InvalidGossipFileMatcher parses invalid gossip file names with a compiled pattern.
+type InvalidGossipFileMatcher struct {
+	*InvalidGossipFilePattern
+
+	re *regexp.Regexp
+}
+
+// Match parses a file name. It returns false if the name doesn't match the pattern.
+func (m *InvalidGossipFileMatcher) Match(name string) (*InvalidGossipFile, bool, error) {
+	matches := m.re.FindStringSubmatch(name)
+	if matches == nil {
+		return nil, false, nil
+	}
+
+	file := &InvalidGossipFile{
+		Root: "unknown",
+	}
+
+	slot, err := strconv.ParseUint(matches[m.Groups.Slot], 10, 64)
+	if err != nil {
+		return nil, true, errors.Wrap(err, "failed to parse slot from file name")
+	}
+
+	file.Slot = slot
+
+	if m.Groups.Root != 0 {
+		file.Root = normalizeRoot(matches[m.Groups.Root])
+	}
+
+	if m.Groups.Index != 0 {
+		index, err := strconv.ParseUint(matches[m.Groups.Index], 10, 64)
+		if err != nil {
+			return nil, true, errors.Wrap(err, "failed to parse index from file name")
+		}
+
+		file.Index = index
+	}
+
+	return file, true, nil
+}
+
+// normalizeRoot returns a hex root as lowercase with a 0x prefix, the way the
+// beacon API and every other client's file names write it. Clients like Teku
+// leave the prefix out of their file names.
+func normalizeRoot(root string) string {
+	hex := strings.TrimPrefix(strings.ToLower(root), "0x")
+
+	if hex == "" || strings.Trim(hex, "0123456789abcdef") != "" {
+		return root
+	}
+
+	return "0x" + hex
+}
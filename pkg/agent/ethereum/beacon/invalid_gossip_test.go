@@ -0,0 +1,155 @@
+package beacon
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/beacon/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRoot = "0x3a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f6071829"
+
+func matchFile(t *testing.T, config *Config, client string, blobs bool, name string) (*InvalidGossipFileMatcher, *InvalidGossipFile) {
+	t.Helper()
+
+	matchers, err := config.InvalidGossipFileMatchers(client, blobs)
+	require.NoError(t, err)
+
+	for _, matcher := range matchers {
+		file, ok, err := matcher.Match(name)
+		require.NoError(t, err)
+
+		if ok {
+			return matcher, file
+		}
+	}
+
+	return nil, nil
+}
+
+func TestDefaultInvalidGossipFilePatterns(t *testing.T) {
+	for _, pattern := range DefaultInvalidGossipFilePatterns {
+		require.NoError(t, pattern.Validate(), pattern.Client)
+	}
+
+	tests := []struct {
+		client string
+		blobs  bool
+		name   string
+		want   InvalidGossipFile
+	}{
+		{string(services.ClientLighthouse), false, "123_" + testRoot + ".ssz", InvalidGossipFile{Slot: 123, Root: testRoot}},
+		{string(services.ClientNimbus), false, "block-123-3a4b5c6d.ssz", InvalidGossipFile{Slot: 123, Root: "0x3a4b5c6d"}},
+		{string(services.ClientPrysm), false, "beacon_block_123.ssz", InvalidGossipFile{Slot: 123, Root: "unknown"}},
+		{string(services.ClientPrysm), true, "blob_sidecar_" + testRoot + "_123_2.ssz", InvalidGossipFile{Slot: 123, Root: testRoot, Index: 2}},
+		{string(services.ClientTeku), false, "123_" + testRoot[2:] + ".ssz", InvalidGossipFile{Slot: 123, Root: testRoot}},
+		{string(services.ClientTeku), false, "123_" + strings.ToUpper(testRoot[2:]) + ".ssz", InvalidGossipFile{Slot: 123, Root: testRoot}},
+		{string(services.ClientTeku), true, "123_" + testRoot[2:] + "_2.ssz", InvalidGossipFile{Slot: 123, Root: testRoot, Index: 2}},
+		{string(services.ClientLodestar), false, "signedBlock_123_" + testRoot + ".ssz", InvalidGossipFile{Slot: 123, Root: testRoot}},
+		{string(services.ClientLodestar), true, "blobSidecar_123_" + testRoot + "_2.ssz", InvalidGossipFile{Slot: 123, Root: testRoot, Index: 2}},
+	}
+
+	config := &Config{}
+
+	for _, test := range tests {
+		t.Run(test.client+"/"+test.name, func(t *testing.T) {
+			matcher, file := matchFile(t, config, test.client, test.blobs, test.name)
+			require.NotNil(t, file)
+
+			assert.Equal(t, test.want, *file)
+			assert.Equal(t, test.blobs, matcher.Kind.IsBlob())
+
+			// Blocks and blobs written to the same directory aren't mixed up.
+			_, other := matchFile(t, config, test.client, !test.blobs, test.name)
+			assert.Nil(t, other)
+		})
+	}
+}
+
+func TestDefaultInvalidGossipFilePatterns_TekuRoot(t *testing.T) {
+	// Teku leaves the 0x prefix out of its file names, but the root is indexed
+	// with it so it matches block_root filters like every other client's.
+	for _, blobs := range []bool{false, true} {
+		name := "123_" + testRoot[2:] + ".ssz"
+		if blobs {
+			name = "123_" + testRoot[2:] + "_2.ssz"
+		}
+
+		_, file := matchFile(t, &Config{}, string(services.ClientTeku), blobs, name)
+		require.NotNil(t, file)
+
+		assert.True(t, strings.HasPrefix(file.Root, "0x"), file.Root)
+		assert.Equal(t, testRoot, file.Root)
+	}
+}
+
+func TestDefaultInvalidGossipFilePatterns_Unknown(t *testing.T) {
+	// Grandine's dump format isn't documented, so its patterns must be configured.
+	for _, blobs := range []bool{false, true} {
+		matchers, err := (&Config{}).InvalidGossipFileMatchers(string(services.ClientGrandine), blobs)
+		require.NoError(t, err)
+		assert.Empty(t, matchers)
+	}
+}
+
+func TestInvalidGossipFilePatterns_Override(t *testing.T) {
+	config := &Config{
+		NodeAddress: "http://localhost:5052",
+		InvalidGossipFilePatterns: []InvalidGossipFilePattern{
+			{
+				Client:   string(services.ClientTeku),
+				Pattern:  `^invalid_(\d+)\.json$`,
+				Groups:   InvalidGossipFileGroups{Slot: 1},
+				Kind:     InvalidGossipFileKindBlock,
+				Encoding: InvalidGossipFileEncodingJSON,
+			},
+		},
+	}
+
+	require.NoError(t, config.Validate())
+
+	matcher, file := matchFile(t, config, string(services.ClientTeku), false, "invalid_7.json")
+	require.NotNil(t, file)
+	assert.Equal(t, uint64(7), file.Slot)
+	assert.Equal(t, InvalidGossipFileEncodingJSON, matcher.Encoding)
+
+	// The built-in patterns for the client are replaced.
+	_, file = matchFile(t, config, string(services.ClientTeku), false, "123_"+testRoot[2:]+".ssz")
+	assert.Nil(t, file)
+
+	// Other clients keep their built-in patterns.
+	_, file = matchFile(t, config, string(services.ClientPrysm), false, "beacon_block_1.ssz")
+	assert.NotNil(t, file)
+}
+
+func TestInvalidGossipFilePattern_Validate(t *testing.T) {
+	valid := InvalidGossipFilePattern{
+		Client:   "teku",
+		Pattern:  `^(\d+)_(\w+)_(\d+)\.ssz$`,
+		Groups:   InvalidGossipFileGroups{Slot: 1, Root: 2, Index: 3},
+		Kind:     InvalidGossipFileKindBlobSidecar,
+		Encoding: InvalidGossipFileEncodingSSZ,
+	}
+
+	require.NoError(t, valid.Validate())
+
+	invalid := map[string]func(p *InvalidGossipFilePattern){
+		"bad regex":        func(p *InvalidGossipFilePattern) { p.Pattern = `(` },
+		"unknown kind":     func(p *InvalidGossipFilePattern) { p.Kind = "state" },
+		"unknown encoding": func(p *InvalidGossipFilePattern) { p.Encoding = "rlp" },
+		"missing slot":     func(p *InvalidGossipFilePattern) { p.Groups.Slot = 0 },
+		"blob index":       func(p *InvalidGossipFilePattern) { p.Groups.Index = 0 },
+		"missing group":    func(p *InvalidGossipFilePattern) { p.Groups.Root = 4 },
+	}
+
+	for name, mutate := range invalid {
+		t.Run(name, func(t *testing.T) {
+			p := valid
+			mutate(&p)
+
+			assert.Error(t, p.Validate())
+		})
+	}
+}
@@ -66,7 +66,7 @@ func (m *MetadataService) Start(ctx context.Context) error {
 			return nil
 		}
 
-		if err := backoff.Retry(operation, backoff.NewExponentialBackOff()); err != nil {
+		if err := backoff.Retry(operation, newBackOff(ctx)); err != nil {
 			m.log.WithError(err).Warn("Failed to refresh metadata")
 		}
 
@@ -111,13 +111,20 @@ func (m *MetadataService) WaitForHealthyBeaconNode(ctx context.Context) {
 		return nil
 	}
 
-	if err := backoff.Retry(operation, backoff.NewExponentialBackOff()); err != nil {
+	if err := backoff.Retry(operation, newBackOff(ctx)); err != nil {
 		m.log.WithError(err).Warn("Failed to wait for healthy beacon node")
-
-		m.log.Fatal(err)
 	}
 }
 
+// newBackOff retries until the context is cancelled. Other beacon nodes can be
+// used in the meantime, so there's no point giving up on this one.
+func newBackOff(ctx context.Context) backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = 0
+
+	return backoff.WithContext(b, ctx)
+}
+
 func (m *MetadataService) Ready(ctx context.Context) error {
 	if m.Genesis == nil {
 		return errors.New("genesis is not available")
@@ -0,0 +1,105 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/tracoor/pkg/mime"
+)
+
+// SidecarType is the kind of sidecar that accompanies a beacon block.
+type SidecarType string
+
+const (
+	// SidecarTypeBlob is a Deneb/Electra blob sidecar.
+	SidecarTypeBlob SidecarType = "blob_sidecar"
+	// SidecarTypeDataColumn is a Fulu (PeerDAS) data column sidecar.
+	SidecarTypeDataColumn SidecarType = "data_column_sidecar"
+)
+
+// SidecarTypeAtEpoch returns the type of sidecar blocks carry at the given
+// epoch. Returns false if blocks at the epoch don't have sidecars.
+func (u *Upstream) SidecarTypeAtEpoch(epoch phase0.Epoch) (SidecarType, bool, error) {
+	sp, err := u.beacon.Spec()
+	if err != nil {
+		return "", false, err
+	}
+
+	fulu, err := sp.ForkEpochs.GetByName(spec.DataVersionFulu.String())
+	if err == nil && fulu.Active(epoch) {
+		return SidecarTypeDataColumn, true, nil
+	}
+
+	deneb, err := sp.ForkEpochs.GetByName(spec.DataVersionDeneb.String())
+	if err == nil && deneb.Active(epoch) {
+		return SidecarTypeBlob, true, nil
+	}
+
+	return "", false, nil
+}
+
+// FetchRawSidecars fetches the SSZ encoded sidecars of the given type for a block.
+func (u *Upstream) FetchRawSidecars(ctx context.Context, sidecarType SidecarType, blockID string) ([]byte, error) {
+	var path string
+
+	switch sidecarType {
+	case SidecarTypeBlob:
+		path = fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%s", blockID)
+	case SidecarTypeDataColumn:
+		path = fmt.Sprintf("/eth/v1/debug/beacon/data_column_sidecars/%s", blockID)
+	default:
+		return nil, fmt.Errorf("unknown sidecar type %s", sidecarType)
+	}
+
+	return u.fetchRaw(ctx, path, mime.ContentTypeOctet)
+}
+
+// fetchRaw performs a GET request against the beacon node and returns the raw response body.
+func (u *Upstream) fetchRaw(ctx context.Context, path string, accept mime.ContentType) ([]byte, error) {
+	body, err := u.streamRaw(ctx, path, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
+
+// streamRaw performs a GET request against the beacon node and returns the
+// response body unread. The caller must close it.
+func (u *Upstream) streamRaw(ctx context.Context, path string, accept mime.ContentType) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(u.config.Address, "/")+path, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range u.config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	req.Header.Set("Accept", string(accept))
+
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		defer rsp.Body.Close()
+
+		body, err := io.ReadAll(rsp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("unexpected status code %d fetching %s: %s", rsp.StatusCode, path, string(body))
+	}
+
+	return rsp.Body, nil
+}
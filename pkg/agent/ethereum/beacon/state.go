@@ -0,0 +1,16 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ethpandaops/tracoor/pkg/mime"
+)
+
+// StreamRawBeaconState fetches the SSZ encoded beacon state for the given state
+// ID, returning the response body as it's read from the beacon node rather than
+// holding the whole state in memory. The caller must close it.
+func (u *Upstream) StreamRawBeaconState(ctx context.Context, stateID string) (io.ReadCloser, error) {
+	return u.streamRaw(ctx, fmt.Sprintf("/eth/v2/debug/beacon/states/%s", stateID), mime.ContentTypeOctet)
+}
@@ -0,0 +1,75 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+
+	bn "github.com/ethpandaops/beacon/pkg/beacon"
+	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/beacon/services"
+	"github.com/ethpandaops/tracoor/pkg/mime"
+	"github.com/sirupsen/logrus"
+)
+
+// Upstream is a single beacon node. Artifacts fetched from an upstream should
+// be attributed using its own metadata, as the active upstream can change
+// while they're being captured.
+type Upstream struct {
+	config NodeConfig
+	log    logrus.FieldLogger
+
+	beacon bn.Node
+
+	metadata *services.MetadataService
+}
+
+func newUpstream(log logrus.FieldLogger, name, overrideNetworkName string, config NodeConfig, opts *bn.Options) *Upstream {
+	log = log.WithField("upstream", config.Address)
+
+	node := bn.NewNode(log, &bn.Config{
+		Name:    name,
+		Addr:    config.Address,
+		Headers: config.Headers,
+	}, "tracoor_agent", *opts)
+
+	metadata := services.NewMetadataService(log, node, overrideNetworkName)
+
+	return &Upstream{
+		config:   config,
+		log:      log,
+		beacon:   node,
+		metadata: &metadata,
+	}
+}
+
+// Name returns the address of the beacon node.
+func (u *Upstream) Name() string {
+	return u.config.Address
+}
+
+// Healthy returns true if the beacon node is healthy and its metadata is available.
+func (u *Upstream) Healthy(ctx context.Context) bool {
+	return u.beacon.Healthy() && u.metadata.Ready(ctx) == nil
+}
+
+func (u *Upstream) Node() bn.Node {
+	return u.beacon
+}
+
+func (u *Upstream) Metadata() *services.MetadataService {
+	return u.metadata
+}
+
+func (u *Upstream) GetVersionImmuneBlock(ctx context.Context, blockID string) (*VersionImmuneBlock, error) {
+	data, err := u.beacon.FetchRawBlock(ctx, blockID, string(mime.ContentTypeJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	block := &VersionImmuneBlock{}
+
+	if err := json.Unmarshal(data, block); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
@@ -47,12 +47,16 @@ func (c *Config) Validate() error {
 
 // Features contains feature flags for the agent.
 type Features struct {
-	FetchBeaconState         *bool `yaml:"fetchBeaconState" default:"true"`
-	FetchBeaconBlock         *bool `yaml:"fetchBeaconBlock" default:"true"`
-	FetchBeaconBadBlock      *bool `yaml:"fetchBeaconBadBlock" default:"true"`
-	FetchBeaconBadBlob       *bool `yaml:"fetchBeaconBadBlob" default:"true"`
-	FetchExecutionBlockTrace *bool `yaml:"fetchExecutionBlockTrace" default:"true"`
-	FetchExecutionBadBlock   *bool `yaml:"fetchExecutionBadBlock" default:"true"`
+	FetchBeaconState          *bool `yaml:"fetchBeaconState" default:"true"`
+	FetchFinalizedBeaconState *bool `yaml:"fetchFinalizedBeaconState" default:"true"`
+	FetchBeaconBlock          *bool `yaml:"fetchBeaconBlock" default:"true"`
+	FetchBlobSidecar          *bool `yaml:"fetchBlobSidecar" default:"false"`
+	FetchForkChoice           *bool `yaml:"fetchForkChoice" default:"false"`
+	FetchBeaconBadBlock       *bool `yaml:"fetchBeaconBadBlock" default:"true"`
+	FetchBeaconBadBlob        *bool `yaml:"fetchBeaconBadBlob" default:"true"`
+	FetchExecutionBlockTrace  *bool `yaml:"fetchExecutionBlockTrace" default:"true"`
+	FetchExecutionWitness     *bool `yaml:"fetchExecutionWitness" default:"false"`
+	FetchExecutionBadBlock    *bool `yaml:"fetchExecutionBadBlock" default:"true"`
 }
 
 func (f Features) Validate() error {
@@ -67,6 +71,14 @@ func (f Features) GetFetchBeaconState() bool {
 	return *f.FetchBeaconState
 }
 
+func (f Features) GetFetchFinalizedBeaconState() bool {
+	if f.FetchFinalizedBeaconState == nil {
+		return true // default value
+	}
+
+	return *f.FetchFinalizedBeaconState
+}
+
 func (f Features) GetFetchBeaconBlock() bool {
 	if f.FetchBeaconBlock == nil {
 		return true // default value
@@ -75,6 +87,22 @@ func (f Features) GetFetchBeaconBlock() bool {
 	return *f.FetchBeaconBlock
 }
 
+func (f Features) GetFetchBlobSidecar() bool {
+	if f.FetchBlobSidecar == nil {
+		return false // default value
+	}
+
+	return *f.FetchBlobSidecar
+}
+
+func (f Features) GetFetchForkChoice() bool {
+	if f.FetchForkChoice == nil {
+		return false // default value
+	}
+
+	return *f.FetchForkChoice
+}
+
 func (f Features) GetFetchBeaconBadBlock() bool {
 	if f.FetchBeaconBadBlock == nil {
 		return true // default value
@@ -99,6 +127,14 @@ func (f Features) GetFetchExecutionBlockTrace() bool {
 	return *f.FetchExecutionBlockTrace
 }
 
+func (f Features) GetFetchExecutionWitness() bool {
+	if f.FetchExecutionWitness == nil {
+		return false // default value
+	}
+
+	return *f.FetchExecutionWitness
+}
+
 func (f Features) GetFetchExecutionBadBlock() bool {
 	if f.FetchExecutionBadBlock == nil {
 		return true // default value
@@ -114,10 +150,22 @@ func (f Features) EnabledFlags() []string {
 		enabled = append(enabled, "FetchBeaconState")
 	}
 
+	if f.GetFetchFinalizedBeaconState() {
+		enabled = append(enabled, "FetchFinalizedBeaconState")
+	}
+
 	if f.GetFetchBeaconBlock() {
 		enabled = append(enabled, "FetchBeaconBlock")
 	}
 
+	if f.GetFetchBlobSidecar() {
+		enabled = append(enabled, "FetchBlobSidecar")
+	}
+
+	if f.GetFetchForkChoice() {
+		enabled = append(enabled, "FetchForkChoice")
+	}
+
 	if f.GetFetchBeaconBadBlock() {
 		enabled = append(enabled, "FetchBeaconBadBlock")
 	}
@@ -130,6 +178,10 @@ func (f Features) EnabledFlags() []string {
 		enabled = append(enabled, "FetchExecutionBlockTrace")
 	}
 
+	if f.GetFetchExecutionWitness() {
+		enabled = append(enabled, "FetchExecutionWitness")
+	}
+
 	if f.GetFetchExecutionBadBlock() {
 		enabled = append(enabled, "FetchExecutionBadBlock")
 	}
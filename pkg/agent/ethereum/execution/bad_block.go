@@ -1,11 +1,18 @@
 package execution
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 
+	"github.com/0xsequence/ethkit/ethrpc"
+	"github.com/0xsequence/ethkit/ethrpc/jsonrpc"
 	"github.com/0xsequence/ethkit/go-ethereum/core/types"
 )
 
+// methodNotFoundCode is the JSON-RPC error code for an unsupported method.
+const methodNotFoundCode = -32601
+
 type BadBlock struct {
 	// The hash of the block
 	Hash string `json:"hash"`
@@ -27,3 +34,52 @@ func (b *BadBlock) ParseBlockHeader() (*types.Header, error) {
 
 	return &header, nil
 }
+
+// GetRawBadBlockTrace traces a block from the node's bad block cache.
+func (u *Upstream) GetRawBadBlockTrace(ctx context.Context, hash, client string, tracer *TracerConfig) (*[]byte, error) {
+	return u.doRawCall(ctx, ethrpc.NewCall(
+		"debug_traceBadBlock",
+		hash,
+		u.getDebugBlockTraceParms(ctx, client, tracer),
+	))
+}
+
+// GetRawIntermediateRoots returns the state root after each transaction of a
+// block, which may be a bad block from the node's cache.
+func (u *Upstream) GetRawIntermediateRoots(ctx context.Context, hash string) (*[]byte, error) {
+	return u.doRawCall(ctx, ethrpc.NewCall(
+		"debug_intermediateRoots",
+		hash,
+		map[string]interface{}{},
+	))
+}
+
+// doRawCall returns the raw result of the call, or the JSON-RPC error the node responded with.
+func (u *Upstream) doRawCall(ctx context.Context, call ethrpc.Call) (*[]byte, error) {
+	data := jsonrpc.Message{}
+
+	rsp, err := u.rpc.Do(ctx, call)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(rsp, &data); err != nil {
+		return nil, err
+	}
+
+	if data.Error != nil {
+		return nil, data.Error
+	}
+
+	s := []byte(data.Result)
+
+	return &s, nil
+}
+
+// IsMethodNotFound returns true if the error is the node reporting that it
+// doesn't support the called method.
+func IsMethodNotFound(err error) bool {
+	var rpcErr *jsonrpc.Error
+
+	return errors.As(err, &rpcErr) && rpcErr.Code == methodNotFoundCode
+}
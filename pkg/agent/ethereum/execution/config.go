@@ -1,23 +1,81 @@
 package execution
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
+
+// NodeConfig is an execution node the agent can connect to.
+type NodeConfig struct {
+	// Address is the address of the execution node.
+	Address string `yaml:"address"`
+}
 
 type Config struct {
 	// The address of the Execution node to connect to
-	NodeAddress         string `yaml:"nodeAddress"`
-	TraceDisableMemory  *bool  `yaml:"traceDisableMemory" default:"false"`
-	TraceDisableStack   *bool  `yaml:"traceDisableStack" default:"true"`
-	TraceDisableStorage *bool  `yaml:"traceDisableStorage" default:"false"`
+	NodeAddress string `yaml:"nodeAddress"`
+	// Nodes is an ordered list of execution nodes to connect to instead of NodeAddress. The first
+	// healthy node is used, failing over to the next one when it becomes unhealthy.
+	Nodes               []NodeConfig `yaml:"nodes"`
+	TraceDisableMemory  *bool        `yaml:"traceDisableMemory" default:"false"`
+	TraceDisableStack   *bool        `yaml:"traceDisableStack" default:"true"`
+	TraceDisableStorage *bool        `yaml:"traceDisableStorage" default:"false"`
+	// Tracers is the list of debug_traceBlockByHash tracers to fetch for each block.
+	// Defaults to the struct logger only.
+	Tracers []TracerConfig `yaml:"tracers"`
 }
 
 func (c *Config) Validate() error {
-	if c.NodeAddress == "" {
+	if c.NodeAddress == "" && len(c.Nodes) == 0 {
 		return errors.New("nodeAddress is required")
 	}
 
+	if c.NodeAddress != "" && len(c.Nodes) > 0 {
+		return errors.New("only one of nodeAddress and nodes can be set")
+	}
+
+	for _, node := range c.Nodes {
+		if node.Address == "" {
+			return errors.New("address is required for each of nodes")
+		}
+	}
+
+	names := make(map[string]bool, len(c.Tracers))
+
+	for idx := range c.Tracers {
+		tracer := &c.Tracers[idx]
+
+		if err := tracer.Validate(); err != nil {
+			return err
+		}
+
+		if names[tracer.GetName()] {
+			return fmt.Errorf("duplicate tracer name %s", tracer.GetName())
+		}
+
+		names[tracer.GetName()] = true
+	}
+
 	return nil
 }
 
+// GetNodes returns the execution nodes to connect to, in order of preference.
+func (c *Config) GetNodes() []NodeConfig {
+	if len(c.Nodes) > 0 {
+		return c.Nodes
+	}
+
+	return []NodeConfig{{Address: c.NodeAddress}}
+}
+
+func (c *Config) GetTracers() []TracerConfig {
+	if len(c.Tracers) == 0 {
+		return []TracerConfig{{Tracer: StructLoggerTracer}}
+	}
+
+	return c.Tracers
+}
+
 func (c *Config) GetTraceDisableMemory() bool {
 	if c.TraceDisableMemory == nil {
 		return false // Assuming false as the default value
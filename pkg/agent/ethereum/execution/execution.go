@@ -3,31 +3,40 @@ package execution
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/0xsequence/ethkit/ethrpc"
 	"github.com/0xsequence/ethkit/ethrpc/jsonrpc"
 	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/execution/services"
+	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/failover"
 	"github.com/sirupsen/logrus"
 )
 
+// healthCheckInterval is how often the execution nodes are health checked.
+const healthCheckInterval = 5 * time.Second
+
 type Node struct {
 	config *Config
 	log    logrus.FieldLogger
-	rpc    *ethrpc.Provider
 
-	services []services.Service
+	upstreams []*Upstream
+	selector  *failover.Selector
 
 	onReadyCallbacks []func(ctx context.Context) error
+	readyOnce        sync.Once
+	ready            atomic.Bool
+	healthy          atomic.Bool
 }
 
 func NewNode(log logrus.FieldLogger, conf *Config) *Node {
 	return &Node{
-		config:   conf,
-		log:      log.WithField("module", "agent/ethereum/execution"),
-		services: []services.Service{},
+		config: conf,
+		log:    log.WithField("module", "agent/ethereum/execution"),
 	}
 }
 
@@ -36,106 +45,189 @@ func (n *Node) OnReady(_ context.Context, callback func(ctx context.Context) err
 }
 
 func (n *Node) Start(ctx context.Context) error {
-	rpc, err := ethrpc.NewProvider(n.config.NodeAddress)
-	if err != nil {
-		return err
-	}
+	candidates := []failover.Upstream{}
 
-	metadata := services.NewMetadataService(n.log, rpc)
+	for _, nodeConfig := range n.config.GetNodes() {
+		upstream, err := newUpstream(n.log, n.config, nodeConfig)
+		if err != nil {
+			return err
+		}
 
-	svcs := []services.Service{
-		&metadata,
+		n.upstreams = append(n.upstreams, upstream)
+		candidates = append(candidates, upstream)
 	}
 
-	n.rpc = rpc
+	n.selector = failover.NewSelector(
+		n.log.WithField("module", "agent/ethereum/execution/failover"),
+		candidates,
+		failover.DefaultFailbackChecks,
+	)
 
-	n.services = svcs
+	for _, upstream := range n.upstreams {
+		upstream.metadata.OnReady(ctx, func(ctx context.Context) error {
+			upstream.log.Info("Execution node is ready")
 
-	errs := make(chan error, 1)
+			n.checkReady(ctx)
 
-	go func() {
-		wg := sync.WaitGroup{}
+			return nil
+		})
+
+		upstream.log.Info("Starting execution node")
 
-		for _, service := range n.services {
-			wg.Add(1)
+		if err := upstream.metadata.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start service: %w", err)
+		}
+	}
+
+	go func() {
+		ticker := time.NewTicker(healthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				n.checkReady(ctx)
+			}
+		}
+	}()
 
-			service.OnReady(ctx, func(ctx context.Context) error {
-				n.log.WithField("service", service.Name()).Info("Service is ready")
+	return nil
+}
 
-				wg.Done()
+// Ready returns true once a execution node has been ready.
+func (n *Node) Ready() bool {
+	return n.ready.Load()
+}
 
-				return nil
-			})
+// Healthy returns true if the execution node in use passed its last health check.
+func (n *Node) Healthy() bool {
+	return n.healthy.Load()
+}
 
-			n.log.WithField("service", service.Name()).Info("Starting service")
+// checkReady picks the execution node to use and runs the on ready callbacks
+// the first time one is ready.
+func (n *Node) checkReady(ctx context.Context) {
+	healthy := n.selector.Check(ctx)
 
-			if err := service.Start(ctx); err != nil {
-				errs <- fmt.Errorf("failed to start service: %w", err)
-			}
+	n.healthy.Store(healthy)
 
-			wg.Wait()
+	if !healthy {
+		if n.ready.Load() {
+			n.log.Warn("No healthy execution nodes available")
 		}
 
-		n.log.Info("All services are ready")
+		return
+	}
+
+	n.readyOnce.Do(func() {
+		n.ready.Store(true)
+
+		n.log.WithField("upstream", n.Active().Name()).Info("All services are ready")
 
 		for _, callback := range n.onReadyCallbacks {
 			if err := callback(ctx); err != nil {
-				errs <- fmt.Errorf("failed to run on ready callback: %w", err)
+				n.log.WithError(err).Error("Failed to run on ready callback")
 			}
 		}
-	}()
-
-	return nil
+	})
 }
 
 func (n *Node) Stop() error {
 	return nil
 }
 
-func (n *Node) getServiceByName(name services.Name) (services.Service, error) {
-	for _, service := range n.services {
-		if service.Name() == name {
-			return service, nil
-		}
+// Active returns the execution node that's currently in use, or nil if the
+// node hasn't been started.
+func (n *Node) Active() *Upstream {
+	if n.selector == nil {
+		return nil
 	}
 
-	return nil, errors.New("service not found")
+	return n.upstreams[n.selector.Active()]
 }
 
+// Metadata returns the metadata of the execution node that's currently in use.
 func (n *Node) Metadata() *services.MetadataService {
-	service, err := n.getServiceByName("metadata")
-	if err != nil {
+	upstream := n.Active()
+	if upstream == nil {
 		// This should never happen. If it does, good luck.
 		return nil
 	}
 
-	//nolint:errcheck // casting fine.
-	return service.(*services.MetadataService)
+	return upstream.Metadata()
+}
+
+func (n *Node) GetRawDebugBlockTrace(ctx context.Context, hash, client string, tracer *TracerConfig) (*[]byte, error) {
+	return n.Active().GetRawDebugBlockTrace(ctx, hash, client, tracer)
+}
+
+// GetRawExecutionWitness returns the execution witness for the block at the given number.
+func (n *Node) GetRawExecutionWitness(ctx context.Context, number uint64) (*[]byte, error) {
+	return n.Active().GetRawExecutionWitness(ctx, number)
+}
+
+// GetBlockHashByNumber returns the hash of the canonical block at the given number.
+func (n *Node) GetBlockHashByNumber(ctx context.Context, number uint64) (string, error) {
+	return n.Active().GetBlockHashByNumber(ctx, number)
 }
 
-func (n *Node) getDebugBlockTraceParms(ctx context.Context, client string) map[string]interface{} {
-	params := map[string]interface{}{
-		"disableMemory":  n.config.GetTraceDisableMemory(),
-		"disableStorage": n.config.GetTraceDisableStorage(),
-		"disableStack":   n.config.GetTraceDisableStack(),
+// GetBlockNumberByHash returns the number of the block with the given hash.
+func (n *Node) GetBlockNumberByHash(ctx context.Context, hash string) (uint64, error) {
+	return n.Active().GetBlockNumberByHash(ctx, hash)
+}
+
+func (n *Node) GetBadBlocks(ctx context.Context) (*BadBlocksResponse, error) {
+	return n.Active().GetBadBlocks(ctx)
+}
+
+// GetRawBadBlockTrace traces a block from the node's bad block cache.
+func (n *Node) GetRawBadBlockTrace(ctx context.Context, hash, client string, tracer *TracerConfig) (*[]byte, error) {
+	return n.Active().GetRawBadBlockTrace(ctx, hash, client, tracer)
+}
+
+// GetRawIntermediateRoots returns the state root after each transaction of a
+// block, which may be a bad block from the node's cache.
+func (n *Node) GetRawIntermediateRoots(ctx context.Context, hash string) (*[]byte, error) {
+	return n.Active().GetRawIntermediateRoots(ctx, hash)
+}
+
+func (u *Upstream) getDebugBlockTraceParms(ctx context.Context, client string, tracer *TracerConfig) map[string]interface{} {
+	params := map[string]interface{}{}
+
+	if tracer.IsStructLogger() {
+		params["disableMemory"] = u.config.GetTraceDisableMemory()
+		params["disableStorage"] = u.config.GetTraceDisableStorage()
+		params["disableStack"] = u.config.GetTraceDisableStack()
+
+		// geth/reth inverts memory flag
+		if client == "geth" || client == "reth" {
+			params["enableMemory"] = !u.config.GetTraceDisableMemory()
+			delete(params, "disableMemory")
+		}
+	} else {
+		params["tracer"] = tracer.Tracer
+
+		if len(tracer.Config) > 0 {
+			params["tracerConfig"] = tracer.Config
+		}
 	}
 
-	// geth/reth inverts memory flag
-	if client == "geth" || client == "reth" {
-		params["enableMemory"] = !n.config.GetTraceDisableMemory()
-		delete(params, "disableMemory")
+	if tracer.Timeout != "" {
+		params["timeout"] = tracer.Timeout
 	}
 
 	return params
 }
 
-func (n *Node) GetRawDebugBlockTrace(ctx context.Context, hash, client string) (*[]byte, error) {
+func (u *Upstream) GetRawDebugBlockTrace(ctx context.Context, hash, client string, tracer *TracerConfig) (*[]byte, error) {
 	data := jsonrpc.Message{}
 
-	rsp, err := n.rpc.Do(ctx, ethrpc.NewCall(
+	rsp, err := u.rpc.Do(ctx, ethrpc.NewCall(
 		"debug_traceBlockByHash",
 		hash,
-		n.getDebugBlockTraceParms(ctx, client),
+		u.getDebugBlockTraceParms(ctx, client, tracer),
 	))
 	if err != nil {
 		return nil, err
@@ -150,10 +242,99 @@ func (n *Node) GetRawDebugBlockTrace(ctx context.Context, hash, client string) (
 	return &s, nil
 }
 
-func (n *Node) GetBadBlocks(ctx context.Context) (*BadBlocksResponse, error) {
+// GetRawExecutionWitness returns the execution witness for the block at the given number.
+func (u *Upstream) GetRawExecutionWitness(ctx context.Context, number uint64) (*[]byte, error) {
+	data := jsonrpc.Message{}
+
+	rsp, err := u.rpc.Do(ctx, ethrpc.NewCall(
+		"debug_executionWitness",
+		fmt.Sprintf("0x%x", number),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(rsp, &data); err != nil {
+		return nil, err
+	}
+
+	s := []byte(data.Result)
+
+	return &s, nil
+}
+
+// GetBlockHashByNumber returns the hash of the canonical block at the given number.
+func (u *Upstream) GetBlockHashByNumber(ctx context.Context, number uint64) (string, error) {
+	data := jsonrpc.Message{}
+
+	rsp, err := u.rpc.Do(ctx, ethrpc.NewCall(
+		"eth_getBlockByNumber",
+		fmt.Sprintf("0x%x", number),
+		false,
+	))
+	if err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal(rsp, &data); err != nil {
+		return "", err
+	}
+
+	var block *struct {
+		Hash string `json:"hash"`
+	}
+
+	if err := json.Unmarshal([]byte(data.Result), &block); err != nil {
+		return "", fmt.Errorf("failed to unmarshal block: %w", err)
+	}
+
+	if block == nil || block.Hash == "" {
+		return "", fmt.Errorf("block %d not found", number)
+	}
+
+	return block.Hash, nil
+}
+
+func (u *Upstream) GetBlockNumberByHash(ctx context.Context, hash string) (uint64, error) {
+	data := jsonrpc.Message{}
+
+	rsp, err := u.rpc.Do(ctx, ethrpc.NewCall(
+		"eth_getBlockByHash",
+		hash,
+		false,
+	))
+	if err != nil {
+		return 0, err
+	}
+
+	if err := json.Unmarshal(rsp, &data); err != nil {
+		return 0, err
+	}
+
+	var block *struct {
+		Number string `json:"number"`
+	}
+
+	if err := json.Unmarshal([]byte(data.Result), &block); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal block: %w", err)
+	}
+
+	if block == nil || block.Number == "" {
+		return 0, fmt.Errorf("block %s not found", hash)
+	}
+
+	number, err := strconv.ParseUint(strings.TrimPrefix(block.Number, "0x"), 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse block number %s: %w", block.Number, err)
+	}
+
+	return number, nil
+}
+
+func (u *Upstream) GetBadBlocks(ctx context.Context) (*BadBlocksResponse, error) {
 	data := jsonrpc.Message{}
 
-	rsp, err := n.rpc.Do(ctx, ethrpc.NewCall(
+	rsp, err := u.rpc.Do(ctx, ethrpc.NewCall(
 		"debug_getBadBlocks",
 	))
 	if err != nil {
@@ -26,6 +26,9 @@ type MetadataService struct {
 
 	synced bool
 
+	// reachable is true if the last sync status check succeeded.
+	reachable bool
+
 	mu sync.Mutex
 }
 
@@ -53,7 +56,11 @@ func (m *MetadataService) Start(ctx context.Context) error {
 			return nil
 		}
 
-		if err := backoff.Retry(operation, backoff.NewExponentialBackOff()); err != nil {
+		// Retry until the context is cancelled, other execution nodes can be used in the meantime.
+		b := backoff.NewExponentialBackOff()
+		b.MaxElapsedTime = 0
+
+		if err := backoff.Retry(operation, backoff.WithContext(b, ctx)); err != nil {
 			m.log.WithError(err).Warn("Failed to refresh metadata")
 		}
 
@@ -154,12 +161,16 @@ func (m *MetadataService) updateSyncStatus(ctx context.Context) error {
 			return fmt.Errorf("context canceled: %w", err)
 		}
 
+		m.setReachable(false)
+
 		// Log other errors
 		m.log.WithError(err).Debug("Failed to get sync status")
 
 		return fmt.Errorf("failed to get sync status: %w", err)
 	}
 
+	m.setReachable(true)
+
 	// Update sync status based on response
 	if status == nil {
 		m.synced = true
@@ -173,3 +184,18 @@ func (m *MetadataService) updateSyncStatus(ctx context.Context) error {
 func (m *MetadataService) IsSynced() bool {
 	return m.synced
 }
+
+// Reachable returns true if the execution node responded to the last sync status check.
+func (m *MetadataService) Reachable() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.reachable
+}
+
+func (m *MetadataService) setReachable(reachable bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.reachable = reachable
+}
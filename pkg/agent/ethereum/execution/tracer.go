@@ -0,0 +1,59 @@
+package execution
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// StructLoggerTracer is the name of the default debug_traceBlockByHash tracer.
+const StructLoggerTracer = "structLogger"
+
+var tracerNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// TracerConfig configures a single debug_traceBlockByHash tracer. Each configured
+// tracer is fetched, stored and indexed as its own execution block trace.
+type TracerConfig struct {
+	// Name identifies the tracer output in the index and the store.
+	// Defaults to Tracer, and is required when Tracer is a JavaScript tracer.
+	Name string `yaml:"name"`
+	// Tracer is a built-in tracer (e.g. callTracer, prestateTracer or 4byteTracer)
+	// or the source of a JavaScript tracer. Empty or structLogger uses the struct logger.
+	Tracer string `yaml:"tracer"`
+	// Config is passed to the node as the tracerConfig, e.g. diffMode for the prestateTracer.
+	Config map[string]interface{} `yaml:"config"`
+	// Timeout overrides the node's default tracer timeout, e.g. 30s.
+	Timeout string `yaml:"timeout"`
+}
+
+// GetName returns the name the tracer output is indexed under.
+func (t *TracerConfig) GetName() string {
+	if t.Name != "" {
+		return t.Name
+	}
+
+	if t.Tracer == "" {
+		return StructLoggerTracer
+	}
+
+	return t.Tracer
+}
+
+// IsStructLogger returns true if the tracer is the default struct logger.
+func (t *TracerConfig) IsStructLogger() bool {
+	return t.Tracer == "" || t.Tracer == StructLoggerTracer
+}
+
+func (t *TracerConfig) Validate() error {
+	if !tracerNamePattern.MatchString(t.GetName()) {
+		return fmt.Errorf("invalid tracer name %q: may only contain letters, digits, '-' and '_' (JavaScript tracers require a name)", t.GetName())
+	}
+
+	if t.Timeout != "" {
+		if _, err := time.ParseDuration(t.Timeout); err != nil {
+			return fmt.Errorf("invalid timeout for tracer %s: %w", t.GetName(), err)
+		}
+	}
+
+	return nil
+}
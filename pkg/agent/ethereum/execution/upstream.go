@@ -0,0 +1,55 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/0xsequence/ethkit/ethrpc"
+	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/execution/services"
+	"github.com/sirupsen/logrus"
+)
+
+// Upstream is a single execution node. Artifacts fetched from an upstream
+// should be attributed using its own metadata, as the active upstream can
+// change while they're being captured.
+type Upstream struct {
+	config *Config
+	node   NodeConfig
+	log    logrus.FieldLogger
+
+	rpc *ethrpc.Provider
+
+	metadata *services.MetadataService
+}
+
+func newUpstream(log logrus.FieldLogger, config *Config, node NodeConfig) (*Upstream, error) {
+	rpc, err := ethrpc.NewProvider(node.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	log = log.WithField("upstream", node.Address)
+
+	metadata := services.NewMetadataService(log, rpc)
+
+	return &Upstream{
+		config:   config,
+		node:     node,
+		log:      log,
+		rpc:      rpc,
+		metadata: &metadata,
+	}, nil
+}
+
+// Name returns the address of the execution node.
+func (u *Upstream) Name() string {
+	return u.node.Address
+}
+
+// Healthy returns true if the execution node is reachable and its metadata is available.
+func (u *Upstream) Healthy(ctx context.Context) bool {
+	return u.metadata.Reachable() && u.metadata.Ready(ctx) == nil
+}
+
+func (u *Upstream) Metadata() *services.MetadataService {
+	return u.metadata
+}
@@ -0,0 +1,113 @@
+package failover
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultFailbackChecks is the number of consecutive health checks a preferred
+// upstream must pass before the selector fails back to it.
+const DefaultFailbackChecks = 10
+
+// Upstream is a node the selector can fail over to.
+type Upstream interface {
+	Name() string
+	Healthy(ctx context.Context) bool
+}
+
+// Selector picks the upstream to use from an ordered list. It sticks with the
+// active upstream while it's healthy and fails over to the first healthy one
+// when it isn't. A preferred (earlier) upstream is failed back to once it has
+// been healthy for failbackChecks consecutive checks, so a flapping node isn't
+// switched to on every recovery.
+type Selector struct {
+	log logrus.FieldLogger
+
+	upstreams []Upstream
+
+	failbackChecks int
+
+	mu            sync.RWMutex
+	active        int
+	healthyChecks []int
+}
+
+func NewSelector(log logrus.FieldLogger, upstreams []Upstream, failbackChecks int) *Selector {
+	if failbackChecks < 1 {
+		failbackChecks = 1
+	}
+
+	return &Selector{
+		log:            log,
+		upstreams:      upstreams,
+		failbackChecks: failbackChecks,
+		healthyChecks:  make([]int, len(upstreams)),
+	}
+}
+
+// Active returns the index of the active upstream.
+func (s *Selector) Active() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.active
+}
+
+// Check health checks every upstream and switches the active upstream if
+// needed. Returns true if the active upstream is healthy.
+func (s *Selector) Check(ctx context.Context) bool {
+	healthy := make([]bool, len(s.upstreams))
+
+	// Health checks can be slow so run them without holding the lock.
+	for i, upstream := range s.upstreams {
+		healthy[i] = upstream.Healthy(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.upstreams {
+		if healthy[i] {
+			s.healthyChecks[i]++
+		} else {
+			s.healthyChecks[i] = 0
+		}
+	}
+
+	if healthy[s.active] {
+		for i := 0; i < s.active; i++ {
+			if s.healthyChecks[i] >= s.failbackChecks {
+				s.switchTo(i, "Failing back to preferred upstream")
+
+				break
+			}
+		}
+
+		return true
+	}
+
+	for i := range s.upstreams {
+		if healthy[i] {
+			s.switchTo(i, "Active upstream is unhealthy, failing over")
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Selector) switchTo(i int, msg string) {
+	if i == s.active {
+		return
+	}
+
+	s.log.
+		WithField("from", s.upstreams[s.active].Name()).
+		WithField("to", s.upstreams[i].Name()).
+		Warn(msg)
+
+	s.active = i
+}
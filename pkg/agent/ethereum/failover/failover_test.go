@@ -0,0 +1,116 @@
+package failover
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type testUpstream struct {
+	name    string
+	healthy atomic.Bool
+}
+
+func (u *testUpstream) Name() string {
+	return u.name
+}
+
+func (u *testUpstream) Healthy(_ context.Context) bool {
+	return u.healthy.Load()
+}
+
+func newTestUpstreams(names ...string) ([]*testUpstream, []Upstream) {
+	concrete := make([]*testUpstream, 0, len(names))
+	upstreams := make([]Upstream, 0, len(names))
+
+	for _, name := range names {
+		u := &testUpstream{name: name}
+		u.healthy.Store(true)
+
+		concrete = append(concrete, u)
+		upstreams = append(upstreams, u)
+	}
+
+	return concrete, upstreams
+}
+
+func TestSelector_PrefersFirstUpstream(t *testing.T) {
+	_, upstreams := newTestUpstreams("primary", "standby")
+
+	s := NewSelector(logrus.New(), upstreams, 3)
+
+	assert.True(t, s.Check(context.Background()))
+	assert.Equal(t, 0, s.Active())
+}
+
+func TestSelector_FailsOverAndBack(t *testing.T) {
+	ctx := context.Background()
+
+	nodes, upstreams := newTestUpstreams("primary", "standby")
+
+	s := NewSelector(logrus.New(), upstreams, 3)
+
+	nodes[0].healthy.Store(false)
+
+	assert.True(t, s.Check(ctx))
+	assert.Equal(t, 1, s.Active())
+
+	// The primary has to stay healthy before it's failed back to.
+	nodes[0].healthy.Store(true)
+
+	assert.True(t, s.Check(ctx))
+	assert.Equal(t, 1, s.Active())
+
+	assert.True(t, s.Check(ctx))
+	assert.Equal(t, 1, s.Active())
+
+	assert.True(t, s.Check(ctx))
+	assert.Equal(t, 0, s.Active())
+}
+
+func TestSelector_FlappingUpstreamIsNotFailedBackTo(t *testing.T) {
+	ctx := context.Background()
+
+	nodes, upstreams := newTestUpstreams("primary", "standby")
+
+	s := NewSelector(logrus.New(), upstreams, 2)
+
+	nodes[0].healthy.Store(false)
+	s.Check(ctx)
+
+	for i := 0; i < 5; i++ {
+		nodes[0].healthy.Store(i%2 == 0)
+		s.Check(ctx)
+
+		assert.Equal(t, 1, s.Active())
+	}
+}
+
+func TestSelector_StaysOnActiveWhenNothingIsHealthy(t *testing.T) {
+	ctx := context.Background()
+
+	nodes, upstreams := newTestUpstreams("primary", "standby", "backup")
+
+	s := NewSelector(logrus.New(), upstreams, 1)
+
+	nodes[0].healthy.Store(false)
+	nodes[1].healthy.Store(false)
+
+	assert.True(t, s.Check(ctx))
+	assert.Equal(t, 2, s.Active())
+
+	nodes[2].healthy.Store(false)
+
+	assert.False(t, s.Check(ctx))
+	assert.Equal(t, 2, s.Active())
+
+	// Failing over picks the most preferred healthy upstream.
+	nodes[1].healthy.Store(true)
+	nodes[0].healthy.Store(true)
+
+	assert.True(t, s.Check(ctx))
+	assert.Equal(t, 0, s.Active())
+}
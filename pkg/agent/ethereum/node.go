@@ -3,6 +3,7 @@ package ethereum
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/beacon"
@@ -19,8 +20,8 @@ type Node struct {
 
 	onReadyCallbacks []func(ctx context.Context) error
 
-	executionReady bool
-	beaconReady    bool
+	executionReady atomic.Bool
+	beaconReady    atomic.Bool
 
 	syncToleranceSlots phase0.Slot
 }
@@ -44,7 +45,7 @@ func (n *Node) Beacon() *beacon.Node {
 
 func (n *Node) Start(ctx context.Context) error {
 	n.beacon.OnReady(ctx, func(ctx context.Context) error {
-		n.beaconReady = true
+		n.beaconReady.Store(true)
 
 		n.checkReadyPublish(ctx)
 
@@ -52,7 +53,7 @@ func (n *Node) Start(ctx context.Context) error {
 	})
 
 	n.execution.OnReady(ctx, func(ctx context.Context) error {
-		n.executionReady = true
+		n.executionReady.Store(true)
 
 		n.checkReadyPublish(ctx)
 
@@ -76,8 +77,23 @@ func (n *Node) OnReady(_ context.Context, callback func(ctx context.Context) err
 	n.onReadyCallbacks = append(n.onReadyCallbacks, callback)
 }
 
+// BeaconReady returns true once the beacon node has been ready.
+func (n *Node) BeaconReady() bool {
+	return n.beaconReady.Load()
+}
+
+// ExecutionReady returns true once the execution node has been ready.
+func (n *Node) ExecutionReady() bool {
+	return n.executionReady.Load()
+}
+
+// Ready returns true once both the beacon and execution nodes have been ready.
+func (n *Node) Ready() bool {
+	return n.beaconReady.Load() && n.executionReady.Load()
+}
+
 func (n *Node) checkReadyPublish(ctx context.Context) {
-	if n.beaconReady && n.executionReady {
+	if n.Ready() {
 		for _, callback := range n.onReadyCallbacks {
 			if err := callback(ctx); err != nil {
 				n.log.WithError(err).Error("error executing on_ready callback")
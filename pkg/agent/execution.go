@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/execution"
-	"github.com/ethpandaops/tracoor/pkg/compression"
 	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
 	"github.com/ethpandaops/tracoor/pkg/store"
 	"github.com/pkg/errors"
@@ -17,88 +16,233 @@ import (
 )
 
 func (s *agent) fetchAndIndexExecutionBlockTrace(ctx context.Context, blockNumber uint64, blockHash string) error {
+	var failed []string
+
+	// Each tracer is fetched and indexed independently so one failing tracer
+	// doesn't prevent the others from being captured.
+	tracers := s.Config.Ethereum.Execution.GetTracers()
+
+	for idx := range tracers {
+		tracer := &tracers[idx]
+
+		if err := s.fetchAndIndexExecutionBlockTraceWithTracer(ctx, blockNumber, blockHash, tracer); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", tracer.GetName(), err))
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to fetch and index execution block trace for tracers: %s", strings.Join(failed, "; "))
+	}
+
+	return nil
+}
+
+func (s *agent) fetchAndIndexExecutionBlockTraceWithTracer(ctx context.Context, blockNumber uint64, blockHash string, tracer *execution.TracerConfig) error {
+	// Attribute the trace to the execution node that served it.
+	upstream := s.node.Execution().Active()
+
 	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
 	defer cancel()
 
+	tracerName := tracer.GetName()
+
 	// Check if we've somehow already indexed this execution block trace.
-	rsp, err := s.indexer.ListExecutionBlockTrace(ctx, &indexer.ListExecutionBlockTraceRequest{
-		Node:      s.Config.Name,
-		BlockHash: blockHash,
-		Network:   string(s.node.Beacon().Metadata().Network.Name),
+	indexed, err := s.alreadyIndexed(store.BlockTraceDataType, blockHash, tracerName, func() (bool, error) {
+		rsp, err := s.indexer.ListExecutionBlockTrace(ctx, &indexer.ListExecutionBlockTraceRequest{
+			Node:      s.Config.Name,
+			BlockHash: blockHash,
+			Network:   string(s.node.Beacon().Metadata().Network.Name),
+			Tracer:    tracerName,
+		})
+
+		return rsp != nil && len(rsp.ExecutionBlockTraces) > 0, err
 	})
 	if err != nil {
 		s.log.
 			WithField("block_hash", blockHash).
 			WithField("block_number", blockNumber).
+			WithField("tracer", tracerName).
 			WithError(err).
 			Warn("Failed to check if execution block trace is already indexed. Will attempt to fetch and index anyway")
-	} else if rsp != nil && len(rsp.ExecutionBlockTraces) > 0 {
-		s.log.WithField("block_hash", blockHash).WithField("block_number", blockNumber).Debug("Execution block trace already indexed")
+	} else if indexed {
+		s.log.
+			WithField("block_hash", blockHash).
+			WithField("block_number", blockNumber).
+			WithField("tracer", tracerName).
+			Debug("Execution block trace already indexed")
 
 		return nil
 	}
 
 	// Fetch the execution block trace from the execution node.
-	data, err := s.node.Execution().GetRawDebugBlockTrace(ctx, blockHash, s.node.Execution().Metadata().Client(ctx))
+	data, err := upstream.GetRawDebugBlockTrace(ctx, blockHash, upstream.Metadata().Client(ctx), tracer)
 	if err != nil {
 		return err
 	}
 
 	now := time.Now()
 
-	compressedData, err := s.compressor.Compress(data, compression.Gzip)
+	algorithm := s.compressionAlgorithm(store.BlockTraceDataType)
+
+	compressedData, err := s.compressor.Compress(data, algorithm)
 	if err != nil {
 		return errors.Wrapf(err, "failed to compress execution block trace")
 	}
 
-	location := CreateExecutionBlockTraceFileName(
+	location := store.CreateExecutionBlockTraceFileName(
 		s.Config.Name,
 		string(s.node.Beacon().Metadata().Network.Name),
 		blockNumber,
 		blockHash,
+		tracerName,
 	)
 
 	location = fmt.Sprintf("%s.json", location)
 
-	// Upload the execution block trace to the store.
-	location, err = s.store.SaveExecutionBlockTrace(ctx, &store.SaveParams{
-		Data:            &compressedData,
-		Location:        location,
-		ContentEncoding: compression.Gzip.ContentEncoding,
+	// Save and index the execution block trace.
+	rrsp, err := s.publishExecutionBlockTrace(ctx, &indexer.CreateExecutionBlockTraceRequest{
+		Node:                    wrapperspb.String(s.Config.Name),
+		BlockNumber:             wrapperspb.Int64(int64(blockNumber)), //nolint:gosec // safe.
+		BlockHash:               wrapperspb.String(blockHash),
+		FetchedAt:               timestamppb.New(now),
+		ContentEncoding:         wrapperspb.String(algorithm.ContentEncoding),
+		Location:                wrapperspb.String(location),
+		Network:                 wrapperspb.String(string(s.node.Beacon().Metadata().Network.Name)),
+		ExecutionImplementation: wrapperspb.String(upstream.Metadata().Client(ctx)),
+		NodeVersion:             wrapperspb.String(upstream.Metadata().ClientVersion()),
+		Tracer:                  wrapperspb.String(tracerName),
+	}, compressedData)
+	if err != nil {
+		if !isAlreadyIndexed(err) {
+			return errors.Wrap(err, "failed to save execution block trace")
+		}
+
+		s.indexCache.Add(store.BlockTraceDataType, blockHash, tracerName)
+
+		s.log.
+			WithField("block_hash", blockHash).
+			WithField("block_number", blockNumber).
+			WithField("tracer", tracerName).
+			Debug("Execution block trace already indexed")
+
+		return nil
+	}
+
+	s.indexCache.Add(store.BlockTraceDataType, blockHash, tracerName)
+
+	s.metrics.IncrementItemExported(ExecutionBlockTraceQueue, s.Config.Name)
+
+	s.log.
+		WithField("id", rrsp.GetId().GetValue()).
+		WithField("location", location).
+		WithField("tracer", tracerName).
+		Debug("Execution block trace indexed")
+
+	return nil
+}
+
+func (s *agent) fetchAndIndexExecutionWitness(ctx context.Context, blockNumber uint64, blockHash string) error {
+	// Attribute the witness to the execution node that served it.
+	upstream := s.node.Execution().Active()
+
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+
+	// Check if we've somehow already indexed this execution witness.
+	indexed, err := s.alreadyIndexed(store.ExecutionWitnessDataType, blockHash, "", func() (bool, error) {
+		rsp, err := s.indexer.ListExecutionWitness(ctx, &indexer.ListExecutionWitnessRequest{
+			Node:      s.Config.Name,
+			BlockHash: blockHash,
+			Network:   string(s.node.Beacon().Metadata().Network.Name),
+		})
+
+		return rsp != nil && len(rsp.ExecutionWitnesses) > 0, err
 	})
 	if err != nil {
-		return errors.Wrap(err, "failed to save execution block trace to store")
+		s.log.
+			WithField("block_hash", blockHash).
+			WithField("block_number", blockNumber).
+			WithError(err).
+			Warn("Failed to check if execution witness is already indexed. Will attempt to fetch and index anyway")
+	} else if indexed {
+		s.log.
+			WithField("block_hash", blockHash).
+			WithField("block_number", blockNumber).
+			Debug("Execution witness already indexed")
+
+		return nil
+	}
+
+	// Fetch the execution witness from the execution node.
+	data, err := upstream.GetRawExecutionWitness(ctx, blockNumber)
+	if err != nil {
+		return err
 	}
 
-	// Index the execution block trace.
-	rrsp, err := s.indexer.CreateExecutionBlockTrace(ctx, &indexer.CreateExecutionBlockTraceRequest{
+	now := time.Now()
+
+	algorithm := s.compressionAlgorithm(store.ExecutionWitnessDataType)
+
+	compressedData, err := s.compressor.Compress(data, algorithm)
+	if err != nil {
+		return errors.Wrapf(err, "failed to compress execution witness")
+	}
+
+	location := store.CreateExecutionWitnessFileName(
+		s.Config.Name,
+		string(s.node.Beacon().Metadata().Network.Name),
+		blockNumber,
+		blockHash,
+	)
+
+	location = fmt.Sprintf("%s.json", location)
+
+	// Save and index the execution witness.
+	rrsp, err := s.publishExecutionWitness(ctx, &indexer.CreateExecutionWitnessRequest{
 		Node:                    wrapperspb.String(s.Config.Name),
 		BlockNumber:             wrapperspb.Int64(int64(blockNumber)), //nolint:gosec // safe.
 		BlockHash:               wrapperspb.String(blockHash),
 		FetchedAt:               timestamppb.New(now),
-		ContentEncoding:         wrapperspb.String(compression.Gzip.ContentEncoding),
+		ContentEncoding:         wrapperspb.String(algorithm.ContentEncoding),
 		Location:                wrapperspb.String(location),
 		Network:                 wrapperspb.String(string(s.node.Beacon().Metadata().Network.Name)),
-		ExecutionImplementation: wrapperspb.String(s.node.Execution().Metadata().Client(ctx)),
-		NodeVersion:             wrapperspb.String(s.node.Execution().Metadata().ClientVersion()),
-	})
+		ExecutionImplementation: wrapperspb.String(upstream.Metadata().Client(ctx)),
+		NodeVersion:             wrapperspb.String(upstream.Metadata().ClientVersion()),
+	}, compressedData)
 	if err != nil {
-		return err
+		if !isAlreadyIndexed(err) {
+			return errors.Wrap(err, "failed to save execution witness")
+		}
+
+		s.indexCache.Add(store.ExecutionWitnessDataType, blockHash, "")
+
+		s.log.
+			WithField("block_hash", blockHash).
+			WithField("block_number", blockNumber).
+			Debug("Execution witness already indexed")
+
+		return nil
 	}
 
-	s.metrics.IncrementItemExported(ExecutionBlockTraceQueue, s.Config.Name)
+	s.indexCache.Add(store.ExecutionWitnessDataType, blockHash, "")
+
+	s.metrics.IncrementItemExported(ExecutionWitnessQueue, s.Config.Name)
 
 	s.log.
 		WithField("id", rrsp.GetId().GetValue()).
 		WithField("location", location).
-		Debug("Execution block trace indexed")
+		Debug("Execution witness indexed")
 
 	return nil
 }
 
 func (s *agent) fetchAndIndexExecutionBadBlocks(ctx context.Context) error {
+	// Bad blocks are only in the cache of the execution node that rejected
+	// them, so they're traced and attributed using that node.
+	upstream := s.node.Execution().Active()
+
 	// Fetch the bad blocks from the execution node.
-	blocks, err := s.node.Execution().GetBadBlocks(ctx)
+	blocks, err := upstream.GetBadBlocks(ctx)
 	if err != nil {
 		return err
 	}
@@ -106,7 +250,7 @@ func (s *agent) fetchAndIndexExecutionBadBlocks(ctx context.Context) error {
 	for _, block := range *blocks {
 		b := block
 
-		if err := s.indexExecutionBadBlock(ctx, &b); err != nil {
+		if err := s.indexExecutionBadBlock(ctx, upstream, &b); err != nil {
 			s.log.WithError(err).Error("Failed to index execution bad block")
 		}
 	}
@@ -114,7 +258,7 @@ func (s *agent) fetchAndIndexExecutionBadBlocks(ctx context.Context) error {
 	return nil
 }
 
-func (s *agent) indexExecutionBadBlock(ctx context.Context, block *execution.BadBlock) error {
+func (s *agent) indexExecutionBadBlock(ctx context.Context, upstream *execution.Upstream, block *execution.BadBlock) error {
 	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
 	defer cancel()
 
@@ -150,41 +294,54 @@ func (s *agent) indexExecutionBadBlock(ctx context.Context, block *execution.Bad
 		return err
 	}
 
+	algorithm := s.compressionAlgorithm(store.BadBlockDataType)
+
 	// Compress it
-	compressedBlockData, err := s.compressor.Compress(&rawBlockData, compression.Gzip)
+	compressedBlockData, err := s.compressor.Compress(&rawBlockData, algorithm)
 	if err != nil {
 		s.log.WithError(err).Error("Failed to compress execution bad block")
 
 		return err
 	}
 
-	location := CreateExecutionBadBlockFileName(
+	baseLocation := store.CreateExecutionBadBlockFileName(
 		s.Config.Name,
 		string(s.node.Beacon().Metadata().Network.Name),
 		block.Hash,
 	)
 
-	location = fmt.Sprintf("%s.json", location)
-
-	// Upload the execution block trace to the store.
-	location, err = s.store.SaveExecutionBadBlock(ctx, &store.SaveParams{
-		Data:            &compressedBlockData,
-		Location:        location,
-		ContentEncoding: compression.Gzip.ContentEncoding,
-	})
-	if err != nil {
-		return errors.Wrap(err, "failed to save execution bad block to store")
-	}
+	location := fmt.Sprintf("%s.json", baseLocation)
 
 	req := &indexer.CreateExecutionBadBlockRequest{
 		Node:                    wrapperspb.String(s.Config.Name),
 		BlockHash:               wrapperspb.String(block.Hash),
 		FetchedAt:               timestamppb.New(time.Now()),
 		Location:                wrapperspb.String(location),
-		ContentEncoding:         wrapperspb.String(compression.Gzip.ContentEncoding),
+		ContentEncoding:         wrapperspb.String(algorithm.ContentEncoding),
 		Network:                 wrapperspb.String(string(s.node.Beacon().Metadata().Network.Name)),
-		ExecutionImplementation: wrapperspb.String(s.node.Execution().Metadata().Client(ctx)),
-		NodeVersion:             wrapperspb.String(s.node.Execution().Metadata().ClientVersion()),
+		ExecutionImplementation: wrapperspb.String(upstream.Metadata().Client(ctx)),
+		NodeVersion:             wrapperspb.String(upstream.Metadata().ClientVersion()),
+	}
+
+	// Trace the block now as the node is likely to have evicted it from its
+	// bad block cache by the time anyone looks at it. Only the first configured
+	// tracer is used since bad blocks are rare and traced on the spot.
+	tracers := s.Config.Ethereum.Execution.GetTracers()
+
+	var files []*executionBadBlockFile
+
+	if trace := s.fetchExecutionBadBlockDebugData(ctx, block.Hash, "trace", fmt.Sprintf("%s.trace.json", baseLocation), func(ctx context.Context) (*[]byte, error) {
+		return upstream.GetRawBadBlockTrace(ctx, block.Hash, upstream.Metadata().Client(ctx), &tracers[0])
+	}); trace != nil {
+		req.TraceLocation = wrapperspb.String(trace.location)
+		files = append(files, trace)
+	}
+
+	if roots := s.fetchExecutionBadBlockDebugData(ctx, block.Hash, "intermediate roots", fmt.Sprintf("%s.intermediate_roots.json", baseLocation), func(ctx context.Context) (*[]byte, error) {
+		return upstream.GetRawIntermediateRoots(ctx, block.Hash)
+	}); roots != nil {
+		req.IntermediateRootsLocation = wrapperspb.String(roots.location)
+		files = append(files, roots)
 	}
 
 	// Attempt to parse the block number from the json of the block.
@@ -204,8 +361,8 @@ func (s *agent) indexExecutionBadBlock(ctx context.Context, block *execution.Bad
 		}
 	}
 
-	// Index the execution block trace.
-	rrsp, err := s.indexer.CreateExecutionBadBlock(ctx, req)
+	// Save and index the execution bad block.
+	rrsp, err := s.publishExecutionBadBlock(ctx, req, compressedBlockData, files)
 	if err != nil {
 		return errors.Wrapf(err, "failed to index execution bad block: %v", block.Hash)
 	}
@@ -219,3 +376,41 @@ func (s *agent) indexExecutionBadBlock(ctx context.Context, block *execution.Bad
 
 	return nil
 }
+
+// fetchExecutionBadBlockDebugData fetches debug data for a bad block to save
+// alongside the block. Failures are logged rather than returned so the bad
+// block itself is always indexed, and nil is returned instead.
+func (s *agent) fetchExecutionBadBlockDebugData(
+	ctx context.Context,
+	blockHash string,
+	kind string,
+	location string,
+	fetch func(ctx context.Context) (*[]byte, error),
+) *executionBadBlockFile {
+	logCtx := s.log.WithField("block_hash", blockHash).WithField("kind", kind)
+
+	data, err := fetch(ctx)
+	if err != nil {
+		if execution.IsMethodNotFound(err) {
+			logCtx.WithError(err).Debug("Execution client does not support fetching debug data for bad blocks")
+		} else {
+			logCtx.WithError(err).Warn("Failed to fetch debug data for execution bad block")
+		}
+
+		return nil
+	}
+
+	algorithm := s.compressionAlgorithm(store.BadBlockDataType)
+
+	compressedData, err := s.compressor.Compress(data, algorithm)
+	if err != nil {
+		logCtx.WithError(err).Error("Failed to compress debug data for execution bad block")
+
+		return nil
+	}
+
+	return &executionBadBlockFile{
+		location: location,
+		data:     compressedData,
+	}
+}
@@ -0,0 +1,142 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type IndexCacheConfig struct {
+	// Enabled skips asking the indexer whether every item is already indexed.
+	// Items missing from the cache are captured and the indexer rejects any
+	// that turn out to be duplicates.
+	Enabled bool `yaml:"enabled" default:"true"`
+	// MaxItems is the number of indexed keys held in memory.
+	MaxItems int `yaml:"maxItems" default:"100000"`
+	// Window is how long indexed keys are remembered for, and how far back the
+	// cache is seeded from the indexer on startup.
+	Window human.Duration `yaml:"window" default:"24h"`
+}
+
+func (c *IndexCacheConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.MaxItems <= 0 {
+		return errors.New("indexCache.maxItems must be greater than 0")
+	}
+
+	if c.Window.Duration <= 0 {
+		return errors.New("indexCache.window must be greater than 0")
+	}
+
+	return nil
+}
+
+// indexCache remembers the keys of the items the agent has indexed, so it
+// doesn't have to ask the indexer before capturing every item. A nil cache
+// never has anything in it.
+type indexCache struct {
+	log    logrus.FieldLogger
+	config *IndexCacheConfig
+
+	keys *expirable.LRU[string, struct{}]
+}
+
+func newIndexCache(log logrus.FieldLogger, config *IndexCacheConfig) *indexCache {
+	if !config.Enabled {
+		return nil
+	}
+
+	return &indexCache{
+		log:    log.WithField("component", "index_cache"),
+		config: config,
+		keys:   expirable.NewLRU[string, struct{}](config.MaxItems, nil, config.Window.Duration),
+	}
+}
+
+func indexCacheKey(dataType store.DataType, root, variant string) string {
+	return fmt.Sprintf("%s/%s/%s", dataType, root, variant)
+}
+
+// Has returns true if the item is known to be indexed.
+func (c *indexCache) Has(dataType store.DataType, root, variant string) bool {
+	if c == nil {
+		return false
+	}
+
+	return c.keys.Contains(indexCacheKey(dataType, root, variant))
+}
+
+// Add records that the item is indexed.
+func (c *indexCache) Add(dataType store.DataType, root, variant string) {
+	if c == nil {
+		return
+	}
+
+	c.keys.Add(indexCacheKey(dataType, root, variant), struct{}{})
+}
+
+// Seed fills the cache with the keys the indexer has for the node within the
+// cache window. Indexers that don't support listing keys leave the cache empty.
+func (c *indexCache) Seed(ctx context.Context, client indexCacheSeeder, node, network string) error {
+	if c == nil {
+		return nil
+	}
+
+	rsp, err := client.ListIndexedKeys(ctx, &indexer.ListIndexedKeysRequest{
+		Node:    node,
+		Network: network,
+		Since:   timestamppb.New(time.Now().Add(-c.config.Window.Duration)),
+		//nolint:gosec // MaxItems is validated to be positive
+		Limit: uint32(c.config.MaxItems),
+	})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			c.log.Warn("Indexer does not support listing indexed keys, the index cache will only hold items indexed from now on")
+
+			return nil
+		}
+
+		return err
+	}
+
+	for _, key := range rsp.GetKeys() {
+		c.Add(store.DataType(key.GetType()), key.GetRoot(), key.GetVariant())
+	}
+
+	c.log.WithField("keys", len(rsp.GetKeys())).Info("Seeded index cache")
+
+	return nil
+}
+
+type indexCacheSeeder interface {
+	ListIndexedKeys(ctx context.Context, req *indexer.ListIndexedKeysRequest) (*indexer.ListIndexedKeysResponse, error)
+}
+
+// isAlreadyIndexed returns true if the indexer rejected an item because it's
+// already indexed.
+func isAlreadyIndexed(err error) bool {
+	return status.Code(err) == codes.AlreadyExists
+}
+
+// alreadyIndexed returns true if the item is already indexed. Only the index
+// cache is checked when it's enabled, otherwise the indexer is asked with probe.
+func (s *agent) alreadyIndexed(dataType store.DataType, root, variant string, probe func() (bool, error)) (bool, error) {
+	if s.indexCache != nil {
+		return s.indexCache.Has(dataType, root, variant), nil
+	}
+
+	return probe()
+}
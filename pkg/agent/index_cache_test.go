@@ -0,0 +1,102 @@
+package agent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testSeeder struct {
+	req  *indexer.ListIndexedKeysRequest
+	keys []*indexer.IndexedKey
+	err  error
+}
+
+func (s *testSeeder) ListIndexedKeys(_ context.Context, req *indexer.ListIndexedKeysRequest) (*indexer.ListIndexedKeysResponse, error) {
+	s.req = req
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &indexer.ListIndexedKeysResponse{Keys: s.keys}, nil
+}
+
+func newTestIndexCache() *indexCache {
+	return newIndexCache(logrus.New(), &IndexCacheConfig{
+		Enabled:  true,
+		MaxItems: 10,
+		Window:   human.Duration{Duration: time.Hour},
+	})
+}
+
+func TestIndexCache_Seed(t *testing.T) {
+	cache := newTestIndexCache()
+
+	seeder := &testSeeder{
+		keys: []*indexer.IndexedKey{
+			{Type: string(store.BeaconBlockDataType), Root: "0x01"},
+			{Type: string(store.BlockTraceDataType), Root: "0x02", Variant: "callTracer"},
+		},
+	}
+
+	require.NoError(t, cache.Seed(context.Background(), seeder, "node", "mainnet"))
+
+	assert.Equal(t, "node", seeder.req.GetNode())
+	assert.WithinDuration(t, time.Now().Add(-time.Hour), seeder.req.GetSince().AsTime(), time.Minute)
+
+	assert.True(t, cache.Has(store.BeaconBlockDataType, "0x01", ""))
+	assert.True(t, cache.Has(store.BlockTraceDataType, "0x02", "callTracer"))
+
+	// Keys are scoped to their type and variant.
+	assert.False(t, cache.Has(store.BeaconStateDataType, "0x01", ""))
+	assert.False(t, cache.Has(store.BlockTraceDataType, "0x02", "structLogger"))
+}
+
+func TestIndexCache_SeedUnimplemented(t *testing.T) {
+	cache := newTestIndexCache()
+
+	// Older indexers don't support listing keys, which leaves the cache empty.
+	seeder := &testSeeder{err: status.Error(codes.Unimplemented, "unknown method")}
+	require.NoError(t, cache.Seed(context.Background(), seeder, "node", "mainnet"))
+
+	seeder.err = status.Error(codes.Unavailable, "connection refused")
+	require.Error(t, cache.Seed(context.Background(), seeder, "node", "mainnet"))
+}
+
+func TestIndexCache_Disabled(t *testing.T) {
+	cache := newIndexCache(logrus.New(), &IndexCacheConfig{Enabled: false})
+	assert.Nil(t, cache)
+
+	// A disabled cache is safe to use and never has anything in it.
+	cache.Add(store.BeaconBlockDataType, "0x01", "")
+	assert.False(t, cache.Has(store.BeaconBlockDataType, "0x01", ""))
+	require.NoError(t, cache.Seed(context.Background(), &testSeeder{}, "node", "mainnet"))
+
+	probed := false
+	s := &agent{indexCache: cache}
+
+	indexed, err := s.alreadyIndexed(store.BeaconBlockDataType, "0x01", "", func() (bool, error) {
+		probed = true
+
+		return true, nil
+	})
+	require.NoError(t, err)
+	assert.True(t, indexed)
+	assert.True(t, probed, "the indexer is asked when the cache is disabled")
+}
+
+func TestIsAlreadyIndexed(t *testing.T) {
+	assert.True(t, isAlreadyIndexed(status.Error(codes.AlreadyExists, "beacon block already indexed")))
+	assert.False(t, isAlreadyIndexed(status.Error(codes.Internal, "failed")))
+	assert.False(t, isAlreadyIndexed(nil))
+}
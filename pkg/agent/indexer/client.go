@@ -100,6 +100,27 @@ func (c *Client) ListBeaconBlock(ctx context.Context, req *indexer.ListBeaconBlo
 	return c.pb.ListBeaconBlock(ctx, req, grpc.UseCompressor(gzip.Name))
 }
 
+func (c *Client) CreateBlobSidecar(ctx context.Context, req *indexer.CreateBlobSidecarRequest) (*indexer.CreateBlobSidecarResponse, error) {
+	md := metadata.New(c.config.Headers)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	return c.pb.CreateBlobSidecar(ctx, req, grpc.UseCompressor(gzip.Name))
+}
+
+func (c *Client) ListBlobSidecar(ctx context.Context, req *indexer.ListBlobSidecarRequest) (*indexer.ListBlobSidecarResponse, error) {
+	md := metadata.New(c.config.Headers)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	return c.pb.ListBlobSidecar(ctx, req, grpc.UseCompressor(gzip.Name))
+}
+
+func (c *Client) CreateForkChoice(ctx context.Context, req *indexer.CreateForkChoiceRequest) (*indexer.CreateForkChoiceResponse, error) {
+	md := metadata.New(c.config.Headers)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	return c.pb.CreateForkChoice(ctx, req, grpc.UseCompressor(gzip.Name))
+}
+
 func (c *Client) CreateBeaconBadBlock(ctx context.Context, req *indexer.CreateBeaconBadBlockRequest) (*indexer.CreateBeaconBadBlockResponse, error) {
 	md := metadata.New(c.config.Headers)
 	ctx = metadata.NewOutgoingContext(ctx, md)
@@ -149,6 +170,20 @@ func (c *Client) ListExecutionBlockTrace(ctx context.Context, req *indexer.ListE
 	return c.pb.ListExecutionBlockTrace(ctx, req, grpc.UseCompressor(gzip.Name))
 }
 
+func (c *Client) CreateExecutionWitness(ctx context.Context, req *indexer.CreateExecutionWitnessRequest) (*indexer.CreateExecutionWitnessResponse, error) {
+	md := metadata.New(c.config.Headers)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	return c.pb.CreateExecutionWitness(ctx, req, grpc.UseCompressor(gzip.Name))
+}
+
+func (c *Client) ListExecutionWitness(ctx context.Context, req *indexer.ListExecutionWitnessRequest) (*indexer.ListExecutionWitnessResponse, error) {
+	md := metadata.New(c.config.Headers)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	return c.pb.ListExecutionWitness(ctx, req, grpc.UseCompressor(gzip.Name))
+}
+
 func (c *Client) CreateExecutionBadBlock(ctx context.Context, req *indexer.CreateExecutionBadBlockRequest) (*indexer.CreateExecutionBadBlockResponse, error) {
 	md := metadata.New(c.config.Headers)
 	ctx = metadata.NewOutgoingContext(ctx, md)
@@ -162,3 +197,17 @@ func (c *Client) ListExecutionBadBlock(ctx context.Context, req *indexer.ListExe
 
 	return c.pb.ListExecutionBadBlock(ctx, req, grpc.UseCompressor(gzip.Name))
 }
+
+func (c *Client) MarkOrphaned(ctx context.Context, req *indexer.MarkOrphanedRequest) (*indexer.MarkOrphanedResponse, error) {
+	md := metadata.New(c.config.Headers)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	return c.pb.MarkOrphaned(ctx, req, grpc.UseCompressor(gzip.Name))
+}
+
+func (c *Client) ListIndexedKeys(ctx context.Context, req *indexer.ListIndexedKeysRequest) (*indexer.ListIndexedKeysResponse, error) {
+	md := metadata.New(c.config.Headers)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	return c.pb.ListIndexedKeys(ctx, req, grpc.UseCompressor(gzip.Name))
+}
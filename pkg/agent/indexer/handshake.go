@@ -0,0 +1,59 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"github.com/google/uuid"
+)
+
+// PerformStorageHandshake ensures the indexer is connected to the same storage backend as the given store.
+// This is important for the indexer to be able to find the items we upload.
+func (c *Client) PerformStorageHandshake(ctx context.Context, st store.Store, node string) error {
+	c.log.Info("Performing token handshake")
+
+	// First check the store. If the token already exists, download it and use it.
+	token := uuid.New().String()
+
+	exists, err := st.StorageHandshakeTokenExists(ctx, node)
+	if err != nil {
+		return fmt.Errorf("failed to check if storage handshake token exists: %w", err)
+	}
+
+	if exists {
+		token, err = st.GetStorageHandshakeToken(ctx, node)
+		if err != nil {
+			return fmt.Errorf("failed to get storage handshake token: %w", err)
+		}
+
+		c.log.WithField("token", token).Debug("Storage handshake token already exists")
+	} else {
+		// Save the token to the store
+		if err = st.SaveStorageHandshakeToken(ctx, node, token); err != nil {
+			return fmt.Errorf("failed to save storage handshake token: %w", err)
+		}
+
+		// Sleep for a bit to give the store time to update
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	// Perform the handshake with the indexer
+	rsp, err := c.GetStorageHandshakeToken(ctx, &indexer.GetStorageHandshakeTokenRequest{
+		Node:  node,
+		Token: token,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get storage handshake token from indexer: %w", err)
+	}
+
+	if rsp.Token != token {
+		return fmt.Errorf("storage handshake token mismatch: %s (ours) != %s (theirs)", token, rsp.Token)
+	}
+
+	c.log.Info("Storage handshake complete 🤝 - we are connected to the same storage backend as the indexer")
+
+	return nil
+}
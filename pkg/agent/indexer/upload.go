@@ -0,0 +1,282 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"google.golang.org/grpc/metadata"
+)
+
+// uploadChunkSize is the size of the chunks uploads are streamed in. It's kept
+// well under the default gRPC message size limit.
+const uploadChunkSize = 1024 * 1024
+
+// UploadFile is an extra file of an upload, such as the trace of an execution bad block.
+type UploadFile struct {
+	Location string
+	Data     io.Reader
+}
+
+// sendUploadData streams data in chunks with send. Uploads aren't gRPC
+// compressed as the data is compressed before it's uploaded.
+func sendUploadData(data io.Reader, send func(chunk []byte) error) error {
+	buf := make([]byte, uploadChunkSize)
+
+	for {
+		n, err := io.ReadFull(data, buf)
+		if n > 0 {
+			// The chunk is marshalled by send, so buf can be reused afterwards.
+			if serr := send(buf[:n]); serr != nil {
+				return serr
+			}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read upload data: %w", err)
+		}
+	}
+}
+
+// uploadFailed returns true if sending an upload failed. Sending fails with
+// io.EOF when the server ends the stream, in which case the server's error is
+// returned by CloseAndRecv.
+func uploadFailed(err error) bool {
+	return err != nil && !errors.Is(err, io.EOF)
+}
+
+// uploadContext returns the context of an upload. Cancelling it aborts the
+// upload so the server doesn't index partial data.
+func (c *Client) uploadContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return metadata.NewOutgoingContext(ctx, metadata.New(c.config.Headers)), cancel
+}
+
+func (c *Client) UploadBeaconState(ctx context.Context, req *indexer.CreateBeaconStateRequest, data io.Reader) (*indexer.CreateBeaconStateResponse, error) {
+	ctx, cancel := c.uploadContext(ctx)
+	defer cancel()
+
+	stream, err := c.pb.UploadBeaconState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&indexer.UploadBeaconStateRequest{Payload: &indexer.UploadBeaconStateRequest_Metadata{Metadata: req}})
+	if err == nil {
+		err = sendUploadData(data, func(chunk []byte) error {
+			return stream.Send(&indexer.UploadBeaconStateRequest{Payload: &indexer.UploadBeaconStateRequest_Chunk{Chunk: chunk}})
+		})
+	}
+
+	if uploadFailed(err) {
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func (c *Client) UploadBeaconBlock(ctx context.Context, req *indexer.CreateBeaconBlockRequest, data io.Reader) (*indexer.CreateBeaconBlockResponse, error) {
+	ctx, cancel := c.uploadContext(ctx)
+	defer cancel()
+
+	stream, err := c.pb.UploadBeaconBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&indexer.UploadBeaconBlockRequest{Payload: &indexer.UploadBeaconBlockRequest_Metadata{Metadata: req}})
+	if err == nil {
+		err = sendUploadData(data, func(chunk []byte) error {
+			return stream.Send(&indexer.UploadBeaconBlockRequest{Payload: &indexer.UploadBeaconBlockRequest_Chunk{Chunk: chunk}})
+		})
+	}
+
+	if uploadFailed(err) {
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func (c *Client) UploadBlobSidecar(ctx context.Context, req *indexer.CreateBlobSidecarRequest, data io.Reader) (*indexer.CreateBlobSidecarResponse, error) {
+	ctx, cancel := c.uploadContext(ctx)
+	defer cancel()
+
+	stream, err := c.pb.UploadBlobSidecar(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&indexer.UploadBlobSidecarRequest{Payload: &indexer.UploadBlobSidecarRequest_Metadata{Metadata: req}})
+	if err == nil {
+		err = sendUploadData(data, func(chunk []byte) error {
+			return stream.Send(&indexer.UploadBlobSidecarRequest{Payload: &indexer.UploadBlobSidecarRequest_Chunk{Chunk: chunk}})
+		})
+	}
+
+	if uploadFailed(err) {
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func (c *Client) UploadForkChoice(ctx context.Context, req *indexer.CreateForkChoiceRequest, data io.Reader) (*indexer.CreateForkChoiceResponse, error) {
+	ctx, cancel := c.uploadContext(ctx)
+	defer cancel()
+
+	stream, err := c.pb.UploadForkChoice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&indexer.UploadForkChoiceRequest{Payload: &indexer.UploadForkChoiceRequest_Metadata{Metadata: req}})
+	if err == nil {
+		err = sendUploadData(data, func(chunk []byte) error {
+			return stream.Send(&indexer.UploadForkChoiceRequest{Payload: &indexer.UploadForkChoiceRequest_Chunk{Chunk: chunk}})
+		})
+	}
+
+	if uploadFailed(err) {
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func (c *Client) UploadBeaconBadBlock(ctx context.Context, req *indexer.CreateBeaconBadBlockRequest, data io.Reader) (*indexer.CreateBeaconBadBlockResponse, error) {
+	ctx, cancel := c.uploadContext(ctx)
+	defer cancel()
+
+	stream, err := c.pb.UploadBeaconBadBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&indexer.UploadBeaconBadBlockRequest{Payload: &indexer.UploadBeaconBadBlockRequest_Metadata{Metadata: req}})
+	if err == nil {
+		err = sendUploadData(data, func(chunk []byte) error {
+			return stream.Send(&indexer.UploadBeaconBadBlockRequest{Payload: &indexer.UploadBeaconBadBlockRequest_Chunk{Chunk: chunk}})
+		})
+	}
+
+	if uploadFailed(err) {
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func (c *Client) UploadBeaconBadBlob(ctx context.Context, req *indexer.CreateBeaconBadBlobRequest, data io.Reader) (*indexer.CreateBeaconBadBlobResponse, error) {
+	ctx, cancel := c.uploadContext(ctx)
+	defer cancel()
+
+	stream, err := c.pb.UploadBeaconBadBlob(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&indexer.UploadBeaconBadBlobRequest{Payload: &indexer.UploadBeaconBadBlobRequest_Metadata{Metadata: req}})
+	if err == nil {
+		err = sendUploadData(data, func(chunk []byte) error {
+			return stream.Send(&indexer.UploadBeaconBadBlobRequest{Payload: &indexer.UploadBeaconBadBlobRequest_Chunk{Chunk: chunk}})
+		})
+	}
+
+	if uploadFailed(err) {
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func (c *Client) UploadExecutionBlockTrace(ctx context.Context, req *indexer.CreateExecutionBlockTraceRequest, data io.Reader) (*indexer.CreateExecutionBlockTraceResponse, error) {
+	ctx, cancel := c.uploadContext(ctx)
+	defer cancel()
+
+	stream, err := c.pb.UploadExecutionBlockTrace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&indexer.UploadExecutionBlockTraceRequest{Payload: &indexer.UploadExecutionBlockTraceRequest_Metadata{Metadata: req}})
+	if err == nil {
+		err = sendUploadData(data, func(chunk []byte) error {
+			return stream.Send(&indexer.UploadExecutionBlockTraceRequest{Payload: &indexer.UploadExecutionBlockTraceRequest_Chunk{Chunk: chunk}})
+		})
+	}
+
+	if uploadFailed(err) {
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func (c *Client) UploadExecutionWitness(ctx context.Context, req *indexer.CreateExecutionWitnessRequest, data io.Reader) (*indexer.CreateExecutionWitnessResponse, error) {
+	ctx, cancel := c.uploadContext(ctx)
+	defer cancel()
+
+	stream, err := c.pb.UploadExecutionWitness(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&indexer.UploadExecutionWitnessRequest{Payload: &indexer.UploadExecutionWitnessRequest_Metadata{Metadata: req}})
+	if err == nil {
+		err = sendUploadData(data, func(chunk []byte) error {
+			return stream.Send(&indexer.UploadExecutionWitnessRequest{Payload: &indexer.UploadExecutionWitnessRequest_Chunk{Chunk: chunk}})
+		})
+	}
+
+	if uploadFailed(err) {
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// UploadExecutionBadBlock uploads the bad block followed by its extra files,
+// whose locations must be set in req.
+func (c *Client) UploadExecutionBadBlock(ctx context.Context, req *indexer.CreateExecutionBadBlockRequest, data io.Reader, files ...*UploadFile) (*indexer.CreateExecutionBadBlockResponse, error) {
+	ctx, cancel := c.uploadContext(ctx)
+	defer cancel()
+
+	stream, err := c.pb.UploadExecutionBadBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	send := func(chunk []byte) error {
+		return stream.Send(&indexer.UploadExecutionBadBlockRequest{Payload: &indexer.UploadExecutionBadBlockRequest_Chunk{Chunk: chunk}})
+	}
+
+	err = stream.Send(&indexer.UploadExecutionBadBlockRequest{Payload: &indexer.UploadExecutionBadBlockRequest_Metadata{Metadata: req}})
+	if err == nil {
+		err = sendUploadData(data, send)
+	}
+
+	for _, file := range files {
+		if uploadFailed(err) {
+			return nil, err
+		}
+
+		err = stream.Send(&indexer.UploadExecutionBadBlockRequest{Payload: &indexer.UploadExecutionBadBlockRequest_File{File: file.Location}})
+		if err == nil {
+			err = sendUploadData(file.Data, send)
+		}
+	}
+
+	if uploadFailed(err) {
+		return nil, err
+	}
+
+	return stream.CloseAndRecv()
+}
@@ -14,17 +14,25 @@ type Metrics struct {
 	queueItemProcessingTime *prometheus.HistogramVec
 	itemExported            *prometheus.CounterVec
 	queueItemSkipped        *prometheus.CounterVec
+	queueItemRetried        *prometheus.CounterVec
+	queueItemDeadLettered   *prometheus.CounterVec
+	deadLetterQueueSize     *prometheus.GaugeVec
+	queueItemDropped        *prometheus.CounterVec
 }
 
 type Queue string
 
 var (
-	BeaconStateQueue         Queue = "beacon_state"
-	BeaconBlockQueue         Queue = "beacon_block"
-	BeaconBadBlockQueue      Queue = "beacon_bad_block"
-	BeaconBadBlobQueue       Queue = "beacon_bad_blob"
-	ExecutionBlockTraceQueue Queue = "execution_block_trace"
-	ExecutionBadBlockQueue   Queue = "execution_bad_block"
+	BeaconStateQueue          Queue = "beacon_state"
+	FinalizedBeaconStateQueue Queue = "finalized_beacon_state"
+	BeaconBlockQueue          Queue = "beacon_block"
+	BlobSidecarQueue          Queue = "blob_sidecar"
+	ForkChoiceQueue           Queue = "fork_choice"
+	BeaconBadBlockQueue       Queue = "beacon_bad_block"
+	BeaconBadBlobQueue        Queue = "beacon_bad_blob"
+	ExecutionBlockTraceQueue  Queue = "execution_block_trace"
+	ExecutionWitnessQueue     Queue = "execution_witness"
+	ExecutionBadBlockQueue    Queue = "execution_bad_block"
 )
 
 var (
@@ -56,12 +64,36 @@ func GetMetricsInstance(namespace string) *Metrics {
 				Name:      "queue_item_skipped",
 				Help:      "The number of items skipped",
 			}, []string{"queue", "agent"}),
+			queueItemRetried: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "queue_item_retried",
+				Help:      "The number of failed items scheduled for a retry",
+			}, []string{"queue", "agent"}),
+			queueItemDeadLettered: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "queue_item_dead_lettered",
+				Help:      "The number of items moved to the dead-letter section after running out of attempts",
+			}, []string{"queue", "agent"}),
+			deadLetterQueueSize: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "dead_letter_queue_size",
+				Help:      "The number of items in the dead-letter section of the queue",
+			}, []string{"queue", "agent"}),
+			queueItemDropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "queue_item_dropped",
+				Help:      "The number of items dropped by the overflow policy of a full queue",
+			}, []string{"queue", "agent"}),
 		}
 
 		prometheus.MustRegister(metricsInstance.queueSize)
 		prometheus.MustRegister(metricsInstance.queueItemProcessingTime)
 		prometheus.MustRegister(metricsInstance.itemExported)
 		prometheus.MustRegister(metricsInstance.queueItemSkipped)
+		prometheus.MustRegister(metricsInstance.queueItemRetried)
+		prometheus.MustRegister(metricsInstance.queueItemDeadLettered)
+		prometheus.MustRegister(metricsInstance.deadLetterQueueSize)
+		prometheus.MustRegister(metricsInstance.queueItemDropped)
 	})
 
 	return metricsInstance
@@ -83,6 +115,22 @@ func (m *Metrics) IncrementItemSkipped(queue Queue, agentName string) {
 	m.queueItemSkipped.WithLabelValues(string(queue), agentName).Inc()
 }
 
+func (m *Metrics) IncrementItemRetried(queue Queue, agentName string) {
+	m.queueItemRetried.WithLabelValues(string(queue), agentName).Inc()
+}
+
+func (m *Metrics) IncrementItemDeadLettered(queue Queue, agentName string) {
+	m.queueItemDeadLettered.WithLabelValues(string(queue), agentName).Inc()
+}
+
+func (m *Metrics) SetDeadLetterQueueSize(queue Queue, count int, agentName string) {
+	m.deadLetterQueueSize.WithLabelValues(string(queue), agentName).Set(float64(count))
+}
+
+func (m *Metrics) AddItemsDropped(queue Queue, count int, agentName string) {
+	m.queueItemDropped.WithLabelValues(string(queue), agentName).Add(float64(count))
+}
+
 func (m *Metrics) ServeMetrics(ctx context.Context, addr string) {
 	observability.StartMetricsServer(ctx, addr)
 }
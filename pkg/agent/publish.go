@@ -0,0 +1,263 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	agentindexer "github.com/ethpandaops/tracoor/pkg/agent/indexer"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+)
+
+// The publish functions save captured data to the store and index it. Agents
+// configured with the server store type upload the data to the server instead,
+// which saves and indexes it in one step.
+
+// uploadThroughServer returns true if the agent uploads to the server instead
+// of writing to a store itself.
+func (s *agent) uploadThroughServer() bool {
+	return s.Config.Store.Type == store.ServerStoreType
+}
+
+// sharedObjectIndexed returns true if another node already indexed the
+// content addressed object at location, so it doesn't need to be captured
+// again. The indexer checks the object is still stored when it's indexed.
+func (s *agent) sharedObjectIndexed(ctx context.Context, dataType store.DataType, location string) bool {
+	if !s.Config.ContentAddressed {
+		return false
+	}
+
+	pagination := &indexer.PaginationCursor{Limit: 1}
+
+	var (
+		indexed bool
+		err     error
+	)
+
+	switch dataType {
+	case store.BeaconStateDataType:
+		var rsp *indexer.ListBeaconStateResponse
+
+		rsp, err = s.indexer.ListBeaconState(ctx, &indexer.ListBeaconStateRequest{Location: location, Pagination: pagination})
+		indexed = rsp != nil && len(rsp.BeaconStates) > 0
+	case store.BeaconBlockDataType:
+		var rsp *indexer.ListBeaconBlockResponse
+
+		rsp, err = s.indexer.ListBeaconBlock(ctx, &indexer.ListBeaconBlockRequest{Location: location, Pagination: pagination})
+		indexed = rsp != nil && len(rsp.BeaconBlocks) > 0
+	default:
+		return false
+	}
+
+	if err != nil {
+		s.log.WithError(err).WithField("location", location).Debug("Failed to check if shared object is indexed")
+
+		return false
+	}
+
+	return indexed
+}
+
+// waitForStore gives the store time to update before the indexer looks for
+// the data that was just saved.
+func waitForStore() {
+	time.Sleep(1 * time.Second)
+}
+
+func (s *agent) publishBeaconState(ctx context.Context, req *indexer.CreateBeaconStateRequest, data io.Reader) (*indexer.CreateBeaconStateResponse, error) {
+	if s.uploadThroughServer() {
+		return s.indexer.UploadBeaconState(ctx, req, data)
+	}
+
+	if _, err := s.store.SaveStream(ctx, store.BeaconStateDataType, &store.SaveStreamParams{
+		Data:            data,
+		Location:        req.GetLocation().GetValue(),
+		ContentEncoding: req.GetContentEncoding().GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+
+	waitForStore()
+
+	return s.indexer.CreateBeaconState(ctx, req)
+}
+
+func (s *agent) publishBeaconBlock(ctx context.Context, req *indexer.CreateBeaconBlockRequest, data []byte) (*indexer.CreateBeaconBlockResponse, error) {
+	if s.uploadThroughServer() {
+		return s.indexer.UploadBeaconBlock(ctx, req, bytes.NewReader(data))
+	}
+
+	if _, err := s.store.SaveBeaconBlock(ctx, &store.SaveParams{
+		Data:            &data,
+		Location:        req.GetLocation().GetValue(),
+		ContentEncoding: req.GetContentEncoding().GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+
+	waitForStore()
+
+	return s.indexer.CreateBeaconBlock(ctx, req)
+}
+
+func (s *agent) publishBlobSidecar(ctx context.Context, req *indexer.CreateBlobSidecarRequest, data []byte) (*indexer.CreateBlobSidecarResponse, error) {
+	if s.uploadThroughServer() {
+		return s.indexer.UploadBlobSidecar(ctx, req, bytes.NewReader(data))
+	}
+
+	if _, err := s.store.SaveBlobSidecar(ctx, &store.SaveParams{
+		Data:            &data,
+		Location:        req.GetLocation().GetValue(),
+		ContentEncoding: req.GetContentEncoding().GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+
+	waitForStore()
+
+	return s.indexer.CreateBlobSidecar(ctx, req)
+}
+
+func (s *agent) publishForkChoice(ctx context.Context, req *indexer.CreateForkChoiceRequest, data []byte) (*indexer.CreateForkChoiceResponse, error) {
+	if s.uploadThroughServer() {
+		return s.indexer.UploadForkChoice(ctx, req, bytes.NewReader(data))
+	}
+
+	if _, err := s.store.SaveForkChoice(ctx, &store.SaveParams{
+		Data:            &data,
+		Location:        req.GetLocation().GetValue(),
+		ContentEncoding: req.GetContentEncoding().GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+
+	waitForStore()
+
+	return s.indexer.CreateForkChoice(ctx, req)
+}
+
+func (s *agent) publishBeaconBadBlock(ctx context.Context, req *indexer.CreateBeaconBadBlockRequest, data []byte) (*indexer.CreateBeaconBadBlockResponse, error) {
+	if s.uploadThroughServer() {
+		return s.indexer.UploadBeaconBadBlock(ctx, req, bytes.NewReader(data))
+	}
+
+	if _, err := s.store.SaveBeaconBadBlock(ctx, &store.SaveParams{
+		Data:            &data,
+		Location:        req.GetLocation().GetValue(),
+		ContentEncoding: req.GetContentEncoding().GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+
+	waitForStore()
+
+	return s.indexer.CreateBeaconBadBlock(ctx, req)
+}
+
+func (s *agent) publishBeaconBadBlob(ctx context.Context, req *indexer.CreateBeaconBadBlobRequest, data []byte) (*indexer.CreateBeaconBadBlobResponse, error) {
+	if s.uploadThroughServer() {
+		return s.indexer.UploadBeaconBadBlob(ctx, req, bytes.NewReader(data))
+	}
+
+	if _, err := s.store.SaveBeaconBadBlob(ctx, &store.SaveParams{
+		Data:            &data,
+		Location:        req.GetLocation().GetValue(),
+		ContentEncoding: req.GetContentEncoding().GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+
+	waitForStore()
+
+	return s.indexer.CreateBeaconBadBlob(ctx, req)
+}
+
+func (s *agent) publishExecutionBlockTrace(ctx context.Context, req *indexer.CreateExecutionBlockTraceRequest, data []byte) (*indexer.CreateExecutionBlockTraceResponse, error) {
+	if s.uploadThroughServer() {
+		return s.indexer.UploadExecutionBlockTrace(ctx, req, bytes.NewReader(data))
+	}
+
+	if _, err := s.store.SaveExecutionBlockTrace(ctx, &store.SaveParams{
+		Data:            &data,
+		Location:        req.GetLocation().GetValue(),
+		ContentEncoding: req.GetContentEncoding().GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.indexer.CreateExecutionBlockTrace(ctx, req)
+}
+
+func (s *agent) publishExecutionWitness(ctx context.Context, req *indexer.CreateExecutionWitnessRequest, data []byte) (*indexer.CreateExecutionWitnessResponse, error) {
+	if s.uploadThroughServer() {
+		return s.indexer.UploadExecutionWitness(ctx, req, bytes.NewReader(data))
+	}
+
+	if _, err := s.store.SaveExecutionWitness(ctx, &store.SaveParams{
+		Data:            &data,
+		Location:        req.GetLocation().GetValue(),
+		ContentEncoding: req.GetContentEncoding().GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.indexer.CreateExecutionWitness(ctx, req)
+}
+
+// executionBadBlockFile is debug data saved alongside an execution bad block.
+type executionBadBlockFile struct {
+	location string
+	data     []byte
+}
+
+// publishExecutionBadBlock saves the bad block and its debug data files. The
+// locations of the files must already be set in req.
+func (s *agent) publishExecutionBadBlock(ctx context.Context, req *indexer.CreateExecutionBadBlockRequest, data []byte, files []*executionBadBlockFile) (*indexer.CreateExecutionBadBlockResponse, error) {
+	if s.uploadThroughServer() {
+		uploads := make([]*agentindexer.UploadFile, 0, len(files))
+
+		for _, file := range files {
+			uploads = append(uploads, &agentindexer.UploadFile{
+				Location: file.location,
+				Data:     bytes.NewReader(file.data),
+			})
+		}
+
+		return s.indexer.UploadExecutionBadBlock(ctx, req, bytes.NewReader(data), uploads...)
+	}
+
+	if _, err := s.store.SaveExecutionBadBlock(ctx, &store.SaveParams{
+		Data:            &data,
+		Location:        req.GetLocation().GetValue(),
+		ContentEncoding: req.GetContentEncoding().GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+
+	// The debug data is optional, so the bad block is still indexed without
+	// any files that couldn't be saved.
+	for _, file := range files {
+		if _, err := s.store.SaveExecutionBadBlock(ctx, &store.SaveParams{
+			Data:            &file.data,
+			Location:        file.location,
+			ContentEncoding: req.GetContentEncoding().GetValue(),
+		}); err != nil {
+			s.log.
+				WithError(err).
+				WithField("location", file.location).
+				Error("Failed to save debug data for execution bad block to store")
+
+			if req.GetTraceLocation().GetValue() == file.location {
+				req.TraceLocation = nil
+			}
+
+			if req.GetIntermediateRootsLocation().GetValue() == file.location {
+				req.IntermediateRootsLocation = nil
+			}
+		}
+	}
+
+	return s.indexer.CreateExecutionBadBlock(ctx, req)
+}
@@ -2,19 +2,35 @@ package agent
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/tracoor/pkg/agent/queue"
 )
 
 type BeaconStateRequest struct {
 	Slot phase0.Slot
 }
 
+type FinalizedBeaconStateRequest struct {
+	Epoch     phase0.Epoch
+	BlockRoot string
+}
+
 type BeaconBlockRequest struct {
 	Slot phase0.Slot
 }
 
+type BlobSidecarRequest struct {
+	Slot phase0.Slot
+}
+
+type ForkChoiceRequest struct {
+	Reason ForkChoiceReason
+}
+
 type BeaconBadBlockRequest struct {
 	Path string
 }
@@ -28,17 +44,121 @@ type ExecutionBlockTraceRequest struct {
 	BlockHash   string
 }
 
+type ExecutionWitnessRequest struct {
+	BlockNumber uint64
+	BlockHash   string
+}
+
 type ExecutionBadBlockRequest struct {
 }
 
+// defaultQueueOptions are the options each queue is opened with unless
+// overridden in the queue config.
+var defaultQueueOptions = map[Queue]queue.Options{
+	// Only the newest states are worth fetching if we've fallen behind.
+	BeaconStateQueue:         {Workers: 1, MaxSize: 32, OverflowPolicy: queue.Coalesce},
+	BeaconBlockQueue:         {Workers: 1, MaxSize: 1000, OverflowPolicy: queue.DropOldest},
+	BlobSidecarQueue:         {Workers: 1, MaxSize: 1000, OverflowPolicy: queue.DropOldest},
+	ExecutionBlockTraceQueue: {Workers: 1, MaxSize: 1000, OverflowPolicy: queue.DropOldest},
+	ExecutionWitnessQueue:    {Workers: 1, MaxSize: 1000, OverflowPolicy: queue.DropOldest},
+	// Checkpoints finalize once an epoch at most, so a short backlog covers
+	// any sensible outage.
+	FinalizedBeaconStateQueue: {Workers: 1, MaxSize: 4, OverflowPolicy: queue.DropOldest},
+	// Fork choice dumps are taken when processed rather than when queued, so a
+	// short backlog is enough to keep the reason of each recent trigger.
+	ForkChoiceQueue: {Workers: 1, MaxSize: 8, OverflowPolicy: queue.DropOldest},
+	// The remaining queues are periodic triggers that fetch everything
+	// outstanding, so a single pending trigger is enough.
+	BeaconBadBlockQueue:    {Workers: 1, MaxSize: 1, OverflowPolicy: queue.Coalesce},
+	BeaconBadBlobQueue:     {Workers: 1, MaxSize: 1, OverflowPolicy: queue.Coalesce},
+	ExecutionBadBlockQueue: {Workers: 1, MaxSize: 1, OverflowPolicy: queue.Coalesce},
+}
+
+const (
+	// enqueueBufferSize is the number of items that can wait to be persisted to
+	// their queues before new items are dropped.
+	enqueueBufferSize = 256
+
+	// queueSizeMetricsInterval is how often the queue size gauges are refreshed.
+	queueSizeMetricsInterval = 15 * time.Second
+)
+
+// pendingItem is an item waiting to be persisted to its queue.
+type pendingItem struct {
+	name  Queue
+	queue *queue.Queue
+	req   interface{}
+}
+
+// enqueue hands the item to the persister so event handlers never wait on the
+// queue database. The item is dropped if the persister has fallen behind.
+func (s *agent) enqueue(ctx context.Context, name Queue, q *queue.Queue, req interface{}) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	select {
+	case s.pending <- &pendingItem{name: name, queue: q, req: req}:
+	default:
+		s.metrics.AddItemsDropped(name, 1, s.Config.Name)
+
+		s.log.WithField("queue", name).Warn("Enqueue buffer is full, dropped item")
+	}
+}
+
+// persistPending persists buffered items to their queues until the context is
+// cancelled. Items still buffered at that point are persisted before it
+// returns.
+func (s *agent) persistPending(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			for {
+				select {
+				case item := <-s.pending:
+					s.persist(context.Background(), item)
+				default:
+					return
+				}
+			}
+		case item := <-s.pending:
+			s.persist(ctx, item)
+		}
+	}
+}
+
+func (s *agent) persist(ctx context.Context, item *pendingItem) {
+	dropped, err := item.queue.Enqueue(ctx, item.req)
+	if err != nil {
+		s.log.WithError(err).WithField("queue", item.name).Error("Failed to enqueue item")
+	}
+
+	if dropped > 0 {
+		s.metrics.AddItemsDropped(item.name, dropped, s.Config.Name)
+
+		s.log.WithField("queue", item.name).WithField("dropped", dropped).Debug("Queue is full, dropped items")
+	}
+}
+
 func (s *agent) enqueueBeaconState(ctx context.Context, slot phase0.Slot) {
 	if !s.Config.Ethereum.Features.GetFetchBeaconState() {
 		return
 	}
 
-	s.beaconStateQueue <- &BeaconStateRequest{
+	s.enqueue(ctx, BeaconStateQueue, s.beaconStateQueue, &BeaconStateRequest{
 		Slot: slot,
+	})
+}
+
+func (s *agent) enqueueFinalizedBeaconState(ctx context.Context, epoch phase0.Epoch, blockRoot string) {
+	if !s.Config.Ethereum.Features.GetFetchFinalizedBeaconState() {
+		return
 	}
+
+	s.enqueue(ctx, FinalizedBeaconStateQueue, s.finalizedBeaconStateQueue, &FinalizedBeaconStateRequest{
+		Epoch:     epoch,
+		BlockRoot: blockRoot,
+	})
 }
 
 func (s *agent) enqueueBeaconBlock(ctx context.Context, slot phase0.Slot) {
@@ -46,9 +166,29 @@ func (s *agent) enqueueBeaconBlock(ctx context.Context, slot phase0.Slot) {
 		return
 	}
 
-	s.beaconBlockQueue <- &BeaconBlockRequest{
+	s.enqueue(ctx, BeaconBlockQueue, s.beaconBlockQueue, &BeaconBlockRequest{
 		Slot: slot,
+	})
+}
+
+func (s *agent) enqueueBlobSidecar(ctx context.Context, slot phase0.Slot) {
+	if !s.Config.Ethereum.Features.GetFetchBlobSidecar() {
+		return
 	}
+
+	s.enqueue(ctx, BlobSidecarQueue, s.blobSidecarQueue, &BlobSidecarRequest{
+		Slot: slot,
+	})
+}
+
+func (s *agent) enqueueForkChoice(ctx context.Context, reason ForkChoiceReason) {
+	if !s.Config.Ethereum.Features.GetFetchForkChoice() {
+		return
+	}
+
+	s.enqueue(ctx, ForkChoiceQueue, s.forkChoiceQueue, &ForkChoiceRequest{
+		Reason: reason,
+	})
 }
 
 func (s *agent) enqueueBeaconBadBlock(ctx context.Context, path string) {
@@ -56,9 +196,9 @@ func (s *agent) enqueueBeaconBadBlock(ctx context.Context, path string) {
 		return
 	}
 
-	s.beaconBadBlockQueue <- &BeaconBadBlockRequest{
+	s.enqueue(ctx, BeaconBadBlockQueue, s.beaconBadBlockQueue, &BeaconBadBlockRequest{
 		Path: path,
-	}
+	})
 }
 
 func (s *agent) enqueueBeaconBadBlob(ctx context.Context, path string) {
@@ -66,9 +206,9 @@ func (s *agent) enqueueBeaconBadBlob(ctx context.Context, path string) {
 		return
 	}
 
-	s.beaconBadBlobQueue <- &BeaconBadBlobRequest{
+	s.enqueue(ctx, BeaconBadBlobQueue, s.beaconBadBlobQueue, &BeaconBadBlobRequest{
 		Path: path,
-	}
+	})
 }
 
 func (s *agent) enqueueExecutionBlockTrace(ctx context.Context, blockHash string, blockNumber uint64) {
@@ -76,10 +216,21 @@ func (s *agent) enqueueExecutionBlockTrace(ctx context.Context, blockHash string
 		return
 	}
 
-	s.executionBlockTraceQueue <- &ExecutionBlockTraceRequest{
+	s.enqueue(ctx, ExecutionBlockTraceQueue, s.executionBlockTraceQueue, &ExecutionBlockTraceRequest{
 		BlockNumber: blockNumber,
 		BlockHash:   blockHash,
+	})
+}
+
+func (s *agent) enqueueExecutionWitness(ctx context.Context, blockHash string, blockNumber uint64) {
+	if !s.Config.Ethereum.Features.GetFetchExecutionWitness() {
+		return
 	}
+
+	s.enqueue(ctx, ExecutionWitnessQueue, s.executionWitnessQueue, &ExecutionWitnessRequest{
+		BlockNumber: blockNumber,
+		BlockHash:   blockHash,
+	})
 }
 
 func (s *agent) enqueueExecutionBadBlock(ctx context.Context) {
@@ -87,7 +238,115 @@ func (s *agent) enqueueExecutionBadBlock(ctx context.Context) {
 		return
 	}
 
-	s.executionBadBlockQueue <- &ExecutionBadBlockRequest{}
+	s.enqueue(ctx, ExecutionBadBlockQueue, s.executionBadBlockQueue, &ExecutionBadBlockRequest{})
+}
+
+// processQueue runs the configured number of workers for the queue until the
+// context is cancelled.
+func (s *agent) processQueue(ctx context.Context, name Queue, q *queue.Queue, handler func(ctx context.Context, item *queue.Item) error) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < q.Workers(); i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			s.runQueueWorker(ctx, name, q, handler)
+		}()
+	}
+
+	wg.Wait()
+}
+
+// runQueueWorker pulls items from the queue until the context is cancelled. Items
+// that fail are retried with backoff until they are moved to the dead-letter section.
+func (s *agent) runQueueWorker(ctx context.Context, name Queue, q *queue.Queue, handler func(ctx context.Context, item *queue.Item) error) {
+	for {
+		item, err := q.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			s.log.WithError(err).WithField("queue", name).Error("Failed to fetch next item from queue")
+
+			time.Sleep(time.Second)
+
+			continue
+		}
+
+		start := time.Now()
+
+		if err := handler(ctx, item); err != nil {
+			s.status.recordQueueError(name, err)
+
+			deadLettered, nerr := item.Nack(ctx, err)
+			if nerr != nil {
+				s.log.WithError(nerr).WithField("queue", name).Error("Failed to record failed queue item")
+			}
+
+			if deadLettered {
+				s.metrics.IncrementItemDeadLettered(name, s.Config.Name)
+			} else {
+				s.metrics.IncrementItemRetried(name, s.Config.Name)
+			}
+
+			continue
+		}
+
+		if err := item.Ack(ctx); err != nil {
+			s.log.WithError(err).WithField("queue", name).Error("Failed to acknowledge queue item")
+		}
+
+		s.status.recordQueueSuccess(name)
+
+		s.metrics.ObserveQueueItemProcessingTime(
+			name,
+			time.Since(start),
+			s.Config.Name,
+		)
+	}
+}
+
+// updateQueueSizeMetrics refreshes the queue size gauges until the context is
+// cancelled.
+func (s *agent) updateQueueSizeMetrics(ctx context.Context) {
+	ticker := time.NewTicker(queueSizeMetricsInterval)
+	defer ticker.Stop()
+
+	for {
+		for name, q := range s.queuesByName() {
+			if size, err := q.Size(ctx); err == nil {
+				s.metrics.SetQueueSize(name, int(size), s.Config.Name)
+			}
+
+			if size, err := q.DeadLetterSize(ctx); err == nil {
+				s.metrics.SetDeadLetterQueueSize(name, int(size), s.Config.Name)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *agent) queuesByName() map[Queue]*queue.Queue {
+	return map[Queue]*queue.Queue{
+		BeaconStateQueue:          s.beaconStateQueue,
+		FinalizedBeaconStateQueue: s.finalizedBeaconStateQueue,
+		BeaconBlockQueue:          s.beaconBlockQueue,
+		BlobSidecarQueue:          s.blobSidecarQueue,
+		ForkChoiceQueue:           s.forkChoiceQueue,
+		BeaconBadBlockQueue:       s.beaconBadBlockQueue,
+		BeaconBadBlobQueue:        s.beaconBadBlobQueue,
+		ExecutionBlockTraceQueue:  s.executionBlockTraceQueue,
+		ExecutionWitnessQueue:     s.executionWitnessQueue,
+		ExecutionBadBlockQueue:    s.executionBadBlockQueue,
+	}
 }
 
 func (s *agent) processBeaconStateQueue(ctx context.Context) {
@@ -95,16 +354,18 @@ func (s *agent) processBeaconStateQueue(ctx context.Context) {
 		return
 	}
 
-	for stateRequest := range s.beaconStateQueue {
-		s.metrics.SetQueueSize(BeaconStateQueue, len(s.beaconStateQueue), s.Config.Name)
+	s.processQueue(ctx, BeaconStateQueue, s.beaconStateQueue, func(ctx context.Context, item *queue.Item) error {
+		var stateRequest BeaconStateRequest
 
-		start := time.Now()
+		if err := item.Decode(&stateRequest); err != nil {
+			return fmt.Errorf("failed to decode beacon state request: %w", err)
+		}
 
 		_, nowEpoch, err := s.node.Beacon().Metadata().Wallclock().Now()
 		if err != nil {
 			s.log.WithError(err).Error("Failed to get current time")
 
-			return
+			return err
 		}
 
 		targetEpoch := s.node.Beacon().Metadata().Wallclock().Epochs().FromSlot(uint64(stateRequest.Slot))
@@ -113,21 +374,51 @@ func (s *agent) processBeaconStateQueue(ctx context.Context) {
 		// If the slot is older than the allowed number of epochs we'll skip it.
 		if nowEpoch.Number()-targetEpochNumber > s.Config.Ethereum.BeaconStateAgeThresholdEpochs {
 			s.metrics.IncrementItemSkipped(BeaconStateQueue, s.Config.Name)
-		} else {
-			if err := s.fetchAndIndexBeaconState(ctx, stateRequest.Slot); err != nil {
-				s.log.
-					WithError(err).
-					WithField("slot", stateRequest.Slot).
-					Error("Failed to fetch and index beacon state")
-			}
+
+			return nil
 		}
 
-		s.metrics.ObserveQueueItemProcessingTime(
-			BeaconStateQueue,
-			time.Since(start),
-			s.Config.Name,
-		)
+		if err := s.fetchAndIndexBeaconState(ctx, stateRequest.Slot); err != nil {
+			s.log.
+				WithError(err).
+				WithField("slot", stateRequest.Slot).
+				WithField("attempt", item.Attempts+1).
+				Error("Failed to fetch and index beacon state")
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *agent) processFinalizedBeaconStateQueue(ctx context.Context) {
+	if !s.Config.Ethereum.Features.GetFetchFinalizedBeaconState() {
+		return
 	}
+
+	s.processQueue(ctx, FinalizedBeaconStateQueue, s.finalizedBeaconStateQueue, func(ctx context.Context, item *queue.Item) error {
+		var stateRequest FinalizedBeaconStateRequest
+
+		if err := item.Decode(&stateRequest); err != nil {
+			return fmt.Errorf("failed to decode finalized beacon state request: %w", err)
+		}
+
+		// Finalized checkpoint states are always older than the age threshold
+		// so, unlike head states, they're never skipped.
+		if err := s.fetchAndIndexFinalizedBeaconState(ctx, stateRequest.Epoch, stateRequest.BlockRoot); err != nil {
+			s.log.
+				WithError(err).
+				WithField("epoch", stateRequest.Epoch).
+				WithField("block_root", stateRequest.BlockRoot).
+				WithField("attempt", item.Attempts+1).
+				Error("Failed to fetch and index finalized beacon state")
+
+			return err
+		}
+
+		return nil
+	})
 }
 
 func (s *agent) processBeaconBlockQueue(ctx context.Context) {
@@ -135,26 +426,77 @@ func (s *agent) processBeaconBlockQueue(ctx context.Context) {
 		return
 	}
 
-	for blockRequest := range s.beaconBlockQueue {
-		s.metrics.SetQueueSize(BeaconBlockQueue, len(s.beaconBlockQueue), s.Config.Name)
+	s.processQueue(ctx, BeaconBlockQueue, s.beaconBlockQueue, func(ctx context.Context, item *queue.Item) error {
+		var blockRequest BeaconBlockRequest
 
-		start := time.Now()
+		if err := item.Decode(&blockRequest); err != nil {
+			return fmt.Errorf("failed to decode beacon block request: %w", err)
+		}
 
 		if err := s.fetchAndIndexBeaconBlock(ctx, blockRequest.Slot); err != nil {
 			s.log.
 				WithError(err).
 				WithField("slot", blockRequest.Slot).
+				WithField("attempt", item.Attempts+1).
 				Error("Failed to fetch and index beacon block")
 
-			continue
+			return err
 		}
 
-		s.metrics.ObserveQueueItemProcessingTime(
-			BeaconBlockQueue,
-			time.Since(start),
-			s.Config.Name,
-		)
+		return nil
+	})
+}
+
+func (s *agent) processBlobSidecarQueue(ctx context.Context) {
+	if !s.Config.Ethereum.Features.GetFetchBlobSidecar() {
+		return
 	}
+
+	s.processQueue(ctx, BlobSidecarQueue, s.blobSidecarQueue, func(ctx context.Context, item *queue.Item) error {
+		var sidecarRequest BlobSidecarRequest
+
+		if err := item.Decode(&sidecarRequest); err != nil {
+			return fmt.Errorf("failed to decode blob sidecar request: %w", err)
+		}
+
+		if err := s.fetchAndIndexBlobSidecars(ctx, sidecarRequest.Slot); err != nil {
+			s.log.
+				WithError(err).
+				WithField("slot", sidecarRequest.Slot).
+				WithField("attempt", item.Attempts+1).
+				Error("Failed to fetch and index blob sidecars")
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *agent) processForkChoiceQueue(ctx context.Context) {
+	if !s.Config.Ethereum.Features.GetFetchForkChoice() {
+		return
+	}
+
+	s.processQueue(ctx, ForkChoiceQueue, s.forkChoiceQueue, func(ctx context.Context, item *queue.Item) error {
+		var forkChoiceRequest ForkChoiceRequest
+
+		if err := item.Decode(&forkChoiceRequest); err != nil {
+			return fmt.Errorf("failed to decode fork choice request: %w", err)
+		}
+
+		if _, err := s.fetchAndIndexForkChoice(ctx, forkChoiceRequest.Reason); err != nil {
+			s.log.
+				WithError(err).
+				WithField("reason", forkChoiceRequest.Reason).
+				WithField("attempt", item.Attempts+1).
+				Error("Failed to fetch and index fork choice")
+
+			return err
+		}
+
+		return nil
+	})
 }
 
 func (s *agent) processBeaconBadBlockQueue(ctx context.Context) {
@@ -162,25 +504,24 @@ func (s *agent) processBeaconBadBlockQueue(ctx context.Context) {
 		return
 	}
 
-	for badBlockRequest := range s.beaconBadBlockQueue {
-		s.metrics.SetQueueSize(BeaconBadBlockQueue, len(s.beaconBadBlockQueue), s.Config.Name)
+	s.processQueue(ctx, BeaconBadBlockQueue, s.beaconBadBlockQueue, func(ctx context.Context, item *queue.Item) error {
+		var badBlockRequest BeaconBadBlockRequest
 
-		start := time.Now()
+		if err := item.Decode(&badBlockRequest); err != nil {
+			return fmt.Errorf("failed to decode beacon bad block request: %w", err)
+		}
 
 		if err := s.fetchAndIndexBeaconBadBlocks(ctx, badBlockRequest.Path); err != nil {
 			s.log.
 				WithError(err).
+				WithField("attempt", item.Attempts+1).
 				Error("Failed to fetch and index beacon bad blocks")
 
-			continue
+			return err
 		}
 
-		s.metrics.ObserveQueueItemProcessingTime(
-			BeaconBadBlockQueue,
-			time.Since(start),
-			s.Config.Name,
-		)
-	}
+		return nil
+	})
 }
 
 func (s *agent) processBeaconBadBlobQueue(ctx context.Context) {
@@ -188,25 +529,24 @@ func (s *agent) processBeaconBadBlobQueue(ctx context.Context) {
 		return
 	}
 
-	for badBlobRequest := range s.beaconBadBlobQueue {
-		s.metrics.SetQueueSize(BeaconBadBlobQueue, len(s.beaconBadBlobQueue), s.Config.Name)
+	s.processQueue(ctx, BeaconBadBlobQueue, s.beaconBadBlobQueue, func(ctx context.Context, item *queue.Item) error {
+		var badBlobRequest BeaconBadBlobRequest
 
-		start := time.Now()
+		if err := item.Decode(&badBlobRequest); err != nil {
+			return fmt.Errorf("failed to decode beacon bad blob request: %w", err)
+		}
 
 		if err := s.fetchAndIndexBeaconBadBlobs(ctx, badBlobRequest.Path); err != nil {
 			s.log.
 				WithError(err).
-				Error("Failed to fetch and index beacon bad blocks")
+				WithField("attempt", item.Attempts+1).
+				Error("Failed to fetch and index beacon bad blobs")
 
-			continue
+			return err
 		}
 
-		s.metrics.ObserveQueueItemProcessingTime(
-			BeaconBadBlobQueue,
-			time.Since(start),
-			s.Config.Name,
-		)
-	}
+		return nil
+	})
 }
 
 func (s *agent) processExecutionBlockTraceQueue(ctx context.Context) {
@@ -214,27 +554,53 @@ func (s *agent) processExecutionBlockTraceQueue(ctx context.Context) {
 		return
 	}
 
-	for traceRequest := range s.executionBlockTraceQueue {
-		s.metrics.SetQueueSize(ExecutionBlockTraceQueue, len(s.executionBlockTraceQueue), s.Config.Name)
+	s.processQueue(ctx, ExecutionBlockTraceQueue, s.executionBlockTraceQueue, func(ctx context.Context, item *queue.Item) error {
+		var traceRequest ExecutionBlockTraceRequest
 
-		start := time.Now()
+		if err := item.Decode(&traceRequest); err != nil {
+			return fmt.Errorf("failed to decode execution block trace request: %w", err)
+		}
 
 		if err := s.fetchAndIndexExecutionBlockTrace(ctx, traceRequest.BlockNumber, traceRequest.BlockHash); err != nil {
 			s.log.
 				WithError(err).
 				WithField("block_hash", traceRequest.BlockHash).
 				WithField("block_number", traceRequest.BlockNumber).
+				WithField("attempt", item.Attempts+1).
 				Error("Failed to fetch and index execution block trace")
 
-			continue
+			return err
 		}
 
-		s.metrics.ObserveQueueItemProcessingTime(
-			ExecutionBlockTraceQueue,
-			time.Since(start),
-			s.Config.Name,
-		)
+		return nil
+	})
+}
+
+func (s *agent) processExecutionWitnessQueue(ctx context.Context) {
+	if !s.Config.Ethereum.Features.GetFetchExecutionWitness() {
+		return
 	}
+
+	s.processQueue(ctx, ExecutionWitnessQueue, s.executionWitnessQueue, func(ctx context.Context, item *queue.Item) error {
+		var witnessRequest ExecutionWitnessRequest
+
+		if err := item.Decode(&witnessRequest); err != nil {
+			return fmt.Errorf("failed to decode execution witness request: %w", err)
+		}
+
+		if err := s.fetchAndIndexExecutionWitness(ctx, witnessRequest.BlockNumber, witnessRequest.BlockHash); err != nil {
+			s.log.
+				WithError(err).
+				WithField("block_hash", witnessRequest.BlockHash).
+				WithField("block_number", witnessRequest.BlockNumber).
+				WithField("attempt", item.Attempts+1).
+				Error("Failed to fetch and index execution witness")
+
+			return err
+		}
+
+		return nil
+	})
 }
 
 func (s *agent) processExecutionBadBlockQueue(ctx context.Context) {
@@ -242,23 +608,16 @@ func (s *agent) processExecutionBadBlockQueue(ctx context.Context) {
 		return
 	}
 
-	for range s.executionBadBlockQueue {
-		s.metrics.SetQueueSize(ExecutionBadBlockQueue, len(s.executionBadBlockQueue), s.Config.Name)
-
-		start := time.Now()
-
+	s.processQueue(ctx, ExecutionBadBlockQueue, s.executionBadBlockQueue, func(ctx context.Context, item *queue.Item) error {
 		if err := s.fetchAndIndexExecutionBadBlocks(ctx); err != nil {
 			s.log.
 				WithError(err).
+				WithField("attempt", item.Attempts+1).
 				Error("Failed to fetch and index execution bad blocks")
 
-			continue
+			return err
 		}
 
-		s.metrics.ObserveQueueItemProcessingTime(
-			ExecutionBadBlockQueue,
-			time.Since(start),
-			s.Config.Name,
-		)
-	}
+		return nil
+	})
 }
@@ -0,0 +1,140 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+)
+
+type Config struct {
+	// MaxAttempts is the number of times an item is attempted before it is moved to the dead-letter section.
+	MaxAttempts int `yaml:"maxAttempts" default:"5"`
+	// InitialBackoff is the delay before the first retry. Each subsequent retry doubles the delay.
+	InitialBackoff human.Duration `yaml:"initialBackoff" default:"5s"`
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff human.Duration `yaml:"maxBackoff" default:"5m"`
+	// MaxDeadLetterItems is the number of dead-lettered items kept per queue. The oldest are pruned first.
+	MaxDeadLetterItems int `yaml:"maxDeadLetterItems" default:"1000"`
+	// Queues overrides the options of individual queues, keyed by queue name.
+	Queues map[string]Options `yaml:"queues"`
+}
+
+// OverflowPolicy decides what happens to new items when a queue is full.
+type OverflowPolicy string
+
+const (
+	// DropNewest drops the incoming item.
+	DropNewest OverflowPolicy = "dropNewest"
+	// DropOldest drops the oldest pending item to make room for the incoming item.
+	DropOldest OverflowPolicy = "dropOldest"
+	// Coalesce drops every pending item in favour of the incoming item.
+	Coalesce OverflowPolicy = "coalesce"
+)
+
+func (p OverflowPolicy) Validate() error {
+	switch p {
+	case DropNewest, DropOldest, Coalesce:
+		return nil
+	default:
+		return fmt.Errorf("invalid overflow policy %q: must be one of %s, %s or %s", p, DropNewest, DropOldest, Coalesce)
+	}
+}
+
+// Options configure a single queue.
+type Options struct {
+	// Workers is the number of items processed concurrently.
+	Workers int `yaml:"workers"`
+	// MaxSize is the number of pending items the queue holds before the
+	// overflow policy kicks in. Items currently being processed don't count
+	// towards the limit. 0 means unbounded.
+	MaxSize int `yaml:"maxSize"`
+	// OverflowPolicy is applied to new items once the queue is full.
+	OverflowPolicy OverflowPolicy `yaml:"overflowPolicy"`
+}
+
+func (o *Options) Validate() error {
+	if o.Workers < 0 {
+		return errors.New("workers must not be negative")
+	}
+
+	if o.MaxSize < 0 {
+		return errors.New("maxSize must not be negative")
+	}
+
+	if o.OverflowPolicy != "" {
+		if err := o.OverflowPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// merge returns a copy of o with any set fields of overrides applied.
+func (o Options) merge(overrides Options) Options {
+	if overrides.Workers != 0 {
+		o.Workers = overrides.Workers
+	}
+
+	if overrides.MaxSize != 0 {
+		o.MaxSize = overrides.MaxSize
+	}
+
+	if overrides.OverflowPolicy != "" {
+		o.OverflowPolicy = overrides.OverflowPolicy
+	}
+
+	if o.Workers == 0 {
+		o.Workers = 1
+	}
+
+	if o.OverflowPolicy == "" {
+		o.OverflowPolicy = DropOldest
+	}
+
+	return o
+}
+
+func (c *Config) Validate() error {
+	if c.MaxAttempts < 1 {
+		return errors.New("maxAttempts must be at least 1")
+	}
+
+	if c.InitialBackoff.Duration <= 0 {
+		return errors.New("initialBackoff must be greater than 0")
+	}
+
+	if c.MaxBackoff.Duration < c.InitialBackoff.Duration {
+		return errors.New("maxBackoff must be greater than or equal to initialBackoff")
+	}
+
+	if c.MaxDeadLetterItems < 0 {
+		return errors.New("maxDeadLetterItems must not be negative")
+	}
+
+	for name, options := range c.Queues {
+		if err := options.Validate(); err != nil {
+			return fmt.Errorf("invalid options for queue %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Backoff returns the delay before the next attempt of an item that has
+// already been attempted the given number of times.
+func (c *Config) Backoff(attempts int) time.Duration {
+	backoff := c.InitialBackoff.Duration
+
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+
+		if backoff >= c.MaxBackoff.Duration {
+			return c.MaxBackoff.Duration
+		}
+	}
+
+	return backoff
+}
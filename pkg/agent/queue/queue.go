@@ -0,0 +1,440 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/creasty/defaults"
+	"github.com/glebarez/sqlite"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	// DatabaseFileName is the name of the queue database within the data dir.
+	DatabaseFileName = "queue.db"
+
+	// pollInterval is the longest a consumer waits before re-checking for ready items.
+	pollInterval = 5 * time.Second
+)
+
+// item is a single queued unit of work. Items are deleted once processed
+// successfully and moved to the dead-letter section once they run out of attempts.
+type item struct {
+	ID            uint64    `gorm:"primaryKey;autoIncrement"`
+	Queue         string    `gorm:"not null;index:idx_queue_items_ready,priority:1"`
+	DeadLetter    bool      `gorm:"not null;default:false;index:idx_queue_items_ready,priority:2"`
+	NextAttemptAt time.Time `gorm:"not null;index:idx_queue_items_ready,priority:3"`
+	Attempts      int       `gorm:"not null;default:0"`
+	LastError     string    `gorm:"not null;default:''"`
+	Payload       []byte
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (item) TableName() string {
+	return "queue_items"
+}
+
+// Store is a durable work queue backed by a SQLite database. Pending items
+// are replayed when the store is reopened.
+type Store struct {
+	log    logrus.FieldLogger
+	config *Config
+	db     *gorm.DB
+
+	mu     sync.Mutex
+	queues map[string]*Queue
+}
+
+// NewStore opens the queue database in dataDir. If dataDir is empty the
+// queue is held in memory and pending items are lost on restart.
+func NewStore(log logrus.FieldLogger, dataDir string, config *Config) (*Store, error) {
+	// Fill in any unset values, the config isn't guaranteed to have been
+	// defaulted when the agent is embedded in single mode.
+	if err := defaults.Set(config); err != nil {
+		return nil, err
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid queue config: %w", err)
+	}
+
+	dsn := ":memory:"
+
+	if dataDir != "" {
+		if err := os.MkdirAll(dataDir, 0o750); err != nil {
+			return nil, fmt.Errorf("failed to create data dir: %w", err)
+		}
+
+		dsn = filepath.Join(dataDir, DatabaseFileName)
+	}
+
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open queue database: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite only supports a single writer, and each connection to an in-memory
+	// database would otherwise get its own empty database.
+	sqlDB.SetMaxOpenConns(1)
+
+	if err := db.Exec("PRAGMA journal_mode = WAL;").Error; err != nil {
+		return nil, fmt.Errorf("failed to enable queue database WAL mode: %w", err)
+	}
+
+	if err := db.AutoMigrate(&item{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate queue database: %w", err)
+	}
+
+	return &Store{
+		log:    log.WithField("component", "agent/queue").WithField("dsn", dsn),
+		config: config,
+		db:     db,
+		queues: make(map[string]*Queue),
+	}, nil
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+
+	return sqlDB.Close()
+}
+
+// Queue returns the named queue, creating it if required. Options set in the
+// store config for the queue take precedence over defaults.
+func (s *Store) Queue(name string, defaults Options) *Queue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if q, ok := s.queues[name]; ok {
+		return q
+	}
+
+	q := &Queue{
+		name:     name,
+		options:  defaults.merge(s.config.Queues[name]),
+		store:    s,
+		inflight: make(map[uint64]bool),
+		notify:   make(chan struct{}, 1),
+	}
+
+	s.queues[name] = q
+
+	return q
+}
+
+// Queue is a single named queue within a Store.
+type Queue struct {
+	name    string
+	options Options
+	store   *Store
+
+	mu       sync.Mutex
+	inflight map[uint64]bool
+	notify   chan struct{}
+}
+
+// Item is an item that has been claimed from a queue. Exactly one of Ack or
+// Nack must be called once the item has been processed.
+type Item struct {
+	ID uint64
+	// Attempts is the number of previous attempts at processing the item.
+	Attempts int
+
+	payload []byte
+	queue   *Queue
+}
+
+// Name returns the name of the queue.
+func (q *Queue) Name() string {
+	return q.name
+}
+
+// Workers returns the number of items the queue should process concurrently.
+func (q *Queue) Workers() int {
+	return q.options.Workers
+}
+
+// Enqueue JSON encodes v and appends it to the queue. If the queue is full the
+// overflow policy is applied, and the number of items dropped as a result
+// (including v itself) is returned.
+func (q *Queue) Enqueue(ctx context.Context, v interface{}) (int, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode queue item: %w", err)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	dropped := 0
+
+	if q.options.MaxSize > 0 {
+		pending, err := q.pendingSize(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		if pending >= int64(q.options.MaxSize) {
+			switch q.options.OverflowPolicy {
+			case DropNewest:
+				return 1, nil
+			case Coalesce:
+				dropped, err = q.dropPending(ctx, -1)
+			default:
+				dropped, err = q.dropPending(ctx, int(pending)-q.options.MaxSize+1)
+			}
+
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if err := q.store.db.WithContext(ctx).Create(&item{
+		Queue:         q.name,
+		Payload:       payload,
+		NextAttemptAt: time.Now(),
+	}).Error; err != nil {
+		return dropped, fmt.Errorf("failed to enqueue item: %w", err)
+	}
+
+	q.wake()
+
+	return dropped, nil
+}
+
+// Next blocks until an item is ready to be processed or the context is cancelled.
+func (q *Queue) Next(ctx context.Context) (*Item, error) {
+	for {
+		claimed, wait, err := q.claim(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if claimed != nil {
+			return claimed, nil
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, ctx.Err()
+		case <-q.notify:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// Size returns the number of pending (non dead-lettered) items in the queue.
+func (q *Queue) Size(ctx context.Context) (int64, error) {
+	var count int64
+
+	err := q.store.db.WithContext(ctx).
+		Model(&item{}).
+		Where("queue = ? AND dead_letter = ?", q.name, false).
+		Count(&count).Error
+
+	return count, err
+}
+
+// DeadLetterSize returns the number of dead-lettered items in the queue.
+func (q *Queue) DeadLetterSize(ctx context.Context) (int64, error) {
+	var count int64
+
+	err := q.store.db.WithContext(ctx).
+		Model(&item{}).
+		Where("queue = ? AND dead_letter = ?", q.name, true).
+		Count(&count).Error
+
+	return count, err
+}
+
+// pending returns a query for items that are waiting to be processed. Must be
+// called with q.mu held.
+func (q *Queue) pending(ctx context.Context) *gorm.DB {
+	query := q.store.db.WithContext(ctx).
+		Model(&item{}).
+		Where("queue = ? AND dead_letter = ?", q.name, false)
+
+	if len(q.inflight) > 0 {
+		inflight := make([]uint64, 0, len(q.inflight))
+		for id := range q.inflight {
+			inflight = append(inflight, id)
+		}
+
+		query = query.Where("id NOT IN ?", inflight)
+	}
+
+	return query
+}
+
+func (q *Queue) pendingSize(ctx context.Context) (int64, error) {
+	var count int64
+
+	if err := q.pending(ctx).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count pending queue items: %w", err)
+	}
+
+	return count, nil
+}
+
+// dropPending deletes up to limit of the oldest pending items, or all of them
+// if limit is negative. Must be called with q.mu held.
+func (q *Queue) dropPending(ctx context.Context, limit int) (int, error) {
+	var ids []uint64
+
+	if err := q.pending(ctx).Order("id ASC").Limit(limit).Pluck("id", &ids).Error; err != nil {
+		return 0, fmt.Errorf("failed to find queue items to drop: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if err := q.store.db.WithContext(ctx).Delete(&item{}, ids).Error; err != nil {
+		return 0, fmt.Errorf("failed to drop queue items: %w", err)
+	}
+
+	return len(ids), nil
+}
+
+// pruneDeadLetters removes the oldest dead-lettered items beyond the configured limit.
+func (q *Queue) pruneDeadLetters(ctx context.Context) error {
+	keep := q.store.db.
+		Model(&item{}).
+		Select("id").
+		Where("queue = ? AND dead_letter = ?", q.name, true).
+		Order("id DESC").
+		Limit(q.store.config.MaxDeadLetterItems)
+
+	return q.store.db.WithContext(ctx).
+		Where("queue = ? AND dead_letter = ? AND id NOT IN (?)", q.name, true, keep).
+		Delete(&item{}).Error
+}
+
+func (q *Queue) claim(ctx context.Context) (*Item, time.Duration, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var candidates []item
+
+	if err := q.pending(ctx).Order("next_attempt_at ASC, id ASC").Limit(1).Find(&candidates).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to claim queue item: %w", err)
+	}
+
+	if len(candidates) == 0 {
+		return nil, pollInterval, nil
+	}
+
+	candidate := candidates[0]
+
+	if wait := time.Until(candidate.NextAttemptAt); wait > 0 {
+		if wait > pollInterval {
+			wait = pollInterval
+		}
+
+		return nil, wait, nil
+	}
+
+	q.inflight[candidate.ID] = true
+
+	return &Item{
+		ID:       candidate.ID,
+		Attempts: candidate.Attempts,
+		payload:  candidate.Payload,
+		queue:    q,
+	}, 0, nil
+}
+
+func (q *Queue) release(id uint64) {
+	q.mu.Lock()
+	delete(q.inflight, id)
+	q.mu.Unlock()
+
+	q.wake()
+}
+
+func (q *Queue) wake() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Decode JSON decodes the item payload in to v.
+func (i *Item) Decode(v interface{}) error {
+	return json.Unmarshal(i.payload, v)
+}
+
+// Ack marks the item as successfully processed and removes it from the queue.
+func (i *Item) Ack(ctx context.Context) error {
+	defer i.queue.release(i.ID)
+
+	return i.queue.store.db.WithContext(ctx).Delete(&item{}, i.ID).Error
+}
+
+// Nack records a failed attempt at processing the item. The item is retried
+// with exponential backoff until it runs out of attempts, at which point it
+// is moved to the dead-letter section. Returns true if the item was dead-lettered.
+func (i *Item) Nack(ctx context.Context, cause error) (bool, error) {
+	defer i.queue.release(i.ID)
+
+	config := i.queue.store.config
+	attempts := i.Attempts + 1
+	deadLetter := attempts >= config.MaxAttempts
+
+	updates := map[string]interface{}{
+		"attempts":        attempts,
+		"dead_letter":     deadLetter,
+		"next_attempt_at": time.Now().Add(config.Backoff(attempts)),
+	}
+
+	if cause != nil {
+		updates["last_error"] = cause.Error()
+	}
+
+	if err := i.queue.store.db.WithContext(ctx).
+		Model(&item{}).
+		Where("id = ?", i.ID).
+		Updates(updates).Error; err != nil {
+		return false, fmt.Errorf("failed to update queue item: %w", err)
+	}
+
+	if deadLetter {
+		if err := i.queue.pruneDeadLetters(ctx); err != nil {
+			i.queue.store.log.WithError(err).WithField("queue", i.queue.name).Warn("Failed to prune dead-lettered items")
+		}
+
+		i.queue.store.log.
+			WithField("queue", i.queue.name).
+			WithField("id", i.ID).
+			WithField("attempts", attempts).
+			WithError(cause).
+			Warn("Queue item ran out of attempts and was moved to the dead-letter section")
+	}
+
+	return deadLetter, nil
+}
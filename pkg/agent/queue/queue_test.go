@@ -0,0 +1,324 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRequest struct {
+	Slot uint64
+}
+
+func testConfig() *Config {
+	return &Config{
+		MaxAttempts:        2,
+		InitialBackoff:     human.Duration{Duration: 10 * time.Millisecond},
+		MaxBackoff:         human.Duration{Duration: 20 * time.Millisecond},
+		MaxDeadLetterItems: 1000,
+	}
+}
+
+func enqueue(t *testing.T, q *Queue, req *testRequest) {
+	t.Helper()
+
+	dropped, err := q.Enqueue(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 0, dropped)
+}
+
+func pendingSlots(t *testing.T, q *Queue) []uint64 {
+	t.Helper()
+
+	ctx := context.Background()
+	slots := []uint64{}
+
+	for {
+		size, err := q.pendingSize(ctx)
+		require.NoError(t, err)
+
+		if size == 0 {
+			return slots
+		}
+
+		item, err := q.Next(ctx)
+		require.NoError(t, err)
+
+		var req testRequest
+
+		require.NoError(t, item.Decode(&req))
+		require.NoError(t, item.Ack(ctx))
+
+		slots = append(slots, req.Slot)
+	}
+}
+
+func TestQueue_EnqueueNextAck(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewStore(logrus.New(), "", testConfig())
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	q := store.Queue("beacon_state", Options{})
+
+	enqueue(t, q, &testRequest{Slot: 1})
+	enqueue(t, q, &testRequest{Slot: 2})
+
+	size, err := q.Size(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), size)
+
+	first, err := q.Next(ctx)
+	require.NoError(t, err)
+
+	// The first item is in flight so the second should be handed out next.
+	second, err := q.Next(ctx)
+	require.NoError(t, err)
+
+	var req testRequest
+
+	require.NoError(t, first.Decode(&req))
+	assert.Equal(t, uint64(1), req.Slot)
+
+	require.NoError(t, second.Decode(&req))
+	assert.Equal(t, uint64(2), req.Slot)
+
+	require.NoError(t, first.Ack(ctx))
+	require.NoError(t, second.Ack(ctx))
+
+	size, err = q.Size(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), size)
+}
+
+func TestQueue_QueuesAreIsolated(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewStore(logrus.New(), "", testConfig())
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	enqueue(t, store.Queue("a", Options{}), &testRequest{Slot: 1})
+
+	size, err := store.Queue("b", Options{}).Size(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), size)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	_, err = store.Queue("b", Options{}).Next(timeoutCtx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestQueue_RetryAndDeadLetter(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewStore(logrus.New(), "", testConfig())
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	q := store.Queue("beacon_block", Options{})
+
+	enqueue(t, q, &testRequest{Slot: 1})
+
+	item, err := q.Next(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, item.Attempts)
+
+	deadLettered, err := item.Nack(ctx, errors.New("boom"))
+	require.NoError(t, err)
+	assert.False(t, deadLettered)
+
+	// The retry should not be handed out before its backoff has elapsed.
+	start := time.Now()
+
+	item, err = q.Next(ctx)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 5*time.Millisecond)
+	assert.Equal(t, 1, item.Attempts)
+
+	deadLettered, err = item.Nack(ctx, errors.New("boom"))
+	require.NoError(t, err)
+	assert.True(t, deadLettered)
+
+	size, err := q.Size(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), size)
+
+	deadLetterSize, err := q.DeadLetterSize(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deadLetterSize)
+}
+
+func TestQueue_DeadLettersArePruned(t *testing.T) {
+	ctx := context.Background()
+
+	config := testConfig()
+	config.MaxAttempts = 1
+	config.MaxDeadLetterItems = 2
+
+	store, err := NewStore(logrus.New(), "", config)
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	q := store.Queue("beacon_bad_block", Options{})
+
+	for i := 0; i < 5; i++ {
+		enqueue(t, q, &testRequest{Slot: uint64(i)})
+
+		item, err := q.Next(ctx)
+		require.NoError(t, err)
+
+		deadLettered, err := item.Nack(ctx, errors.New("boom"))
+		require.NoError(t, err)
+		assert.True(t, deadLettered)
+	}
+
+	deadLetterSize, err := q.DeadLetterSize(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deadLetterSize)
+}
+
+func TestQueue_ReplayedAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := NewStore(logrus.New(), dir, testConfig())
+	require.NoError(t, err)
+
+	enqueue(t, store.Queue("execution_block_trace", Options{}), &testRequest{Slot: 42})
+
+	// Claim the item but "crash" before acking it.
+	_, err = store.Queue("execution_block_trace", Options{}).Next(ctx)
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+
+	store, err = NewStore(logrus.New(), dir, testConfig())
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	item, err := store.Queue("execution_block_trace", Options{}).Next(ctx)
+	require.NoError(t, err)
+
+	var req testRequest
+
+	require.NoError(t, item.Decode(&req))
+	assert.Equal(t, uint64(42), req.Slot)
+}
+
+func TestQueue_OverflowPolicies(t *testing.T) {
+	tests := []struct {
+		policy          OverflowPolicy
+		expectedDropped []int
+		expectedSlots   []uint64
+	}{
+		{
+			policy:          DropNewest,
+			expectedDropped: []int{0, 0, 1, 1},
+			expectedSlots:   []uint64{1, 2},
+		},
+		{
+			policy:          DropOldest,
+			expectedDropped: []int{0, 0, 1, 1},
+			expectedSlots:   []uint64{3, 4},
+		},
+		{
+			policy:          Coalesce,
+			expectedDropped: []int{0, 0, 2, 0},
+			expectedSlots:   []uint64{3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			ctx := context.Background()
+
+			store, err := NewStore(logrus.New(), "", testConfig())
+			require.NoError(t, err)
+
+			defer store.Close()
+
+			q := store.Queue("beacon_state", Options{MaxSize: 2, OverflowPolicy: tt.policy})
+
+			for i, expected := range tt.expectedDropped {
+				dropped, err := q.Enqueue(ctx, &testRequest{Slot: uint64(i + 1)})
+				require.NoError(t, err)
+				assert.Equal(t, expected, dropped, "slot %d", i+1)
+			}
+
+			assert.Equal(t, tt.expectedSlots, pendingSlots(t, q))
+		})
+	}
+}
+
+func TestQueue_InflightItemsAreNotDropped(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewStore(logrus.New(), "", testConfig())
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	q := store.Queue("beacon_state", Options{MaxSize: 1, OverflowPolicy: Coalesce})
+
+	enqueue(t, q, &testRequest{Slot: 1})
+
+	inflight, err := q.Next(ctx)
+	require.NoError(t, err)
+
+	// The in-flight item doesn't count towards the limit.
+	enqueue(t, q, &testRequest{Slot: 2})
+
+	dropped, err := q.Enqueue(ctx, &testRequest{Slot: 3})
+	require.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+
+	require.NoError(t, inflight.Ack(ctx))
+
+	assert.Equal(t, []uint64{3}, pendingSlots(t, q))
+}
+
+func TestStore_QueueOptions(t *testing.T) {
+	config := testConfig()
+	config.Queues = map[string]Options{
+		"beacon_block": {Workers: 4},
+	}
+
+	store, err := NewStore(logrus.New(), "", config)
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	q := store.Queue("beacon_block", Options{MaxSize: 10, OverflowPolicy: DropNewest})
+	assert.Equal(t, Options{Workers: 4, MaxSize: 10, OverflowPolicy: DropNewest}, q.options)
+
+	q = store.Queue("beacon_state", Options{})
+	assert.Equal(t, Options{Workers: 1, OverflowPolicy: DropOldest}, q.options)
+}
+
+func TestConfig_Backoff(t *testing.T) {
+	config := &Config{
+		MaxAttempts:    10,
+		InitialBackoff: human.Duration{Duration: time.Second},
+		MaxBackoff:     human.Duration{Duration: 5 * time.Second},
+	}
+
+	assert.Equal(t, time.Second, config.Backoff(1))
+	assert.Equal(t, 2*time.Second, config.Backoff(2))
+	assert.Equal(t, 4*time.Second, config.Backoff(3))
+	assert.Equal(t, 5*time.Second, config.Backoff(4))
+	assert.Equal(t, 5*time.Second, config.Backoff(20))
+}
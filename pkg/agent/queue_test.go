@@ -0,0 +1,53 @@
+package agent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethpandaops/tracoor/pkg/agent/queue"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnqueue_DropsWhenBufferIsFull(t *testing.T) {
+	queues, err := queue.NewStore(logrus.New(), "", &queue.Config{})
+	require.NoError(t, err)
+
+	defer queues.Close()
+
+	s := &agent{
+		Config:  &Config{Name: "test"},
+		log:     logrus.New(),
+		metrics: GetMetricsInstance(namespace),
+		pending: make(chan *pendingItem, 1),
+	}
+
+	q := queues.Queue(string(BeaconBlockQueue), defaultQueueOptions[BeaconBlockQueue])
+	dropped := s.metrics.queueItemDropped.WithLabelValues(string(BeaconBlockQueue), s.Config.Name)
+	before := testutil.ToFloat64(dropped)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s.enqueue(ctx, BeaconBlockQueue, q, &BeaconBlockRequest{Slot: 1})
+	s.enqueue(ctx, BeaconBlockQueue, q, &BeaconBlockRequest{Slot: 2})
+
+	assert.Equal(t, before+1, testutil.ToFloat64(dropped))
+
+	// Buffered items are persisted once the agent stops.
+	cancel()
+	s.persistPending(ctx)
+
+	size, err := q.Size(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), size)
+
+	item, err := q.Next(context.Background())
+	require.NoError(t, err)
+
+	var req BeaconBlockRequest
+
+	require.NoError(t, item.Decode(&req))
+	assert.EqualValues(t, 1, req.Slot)
+}
@@ -0,0 +1,83 @@
+package agent
+
+import (
+	"context"
+	"time"
+
+	eapi "github.com/attestantio/go-eth2-client/api"
+	eth2v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/pkg/errors"
+)
+
+// maxOrphanedBlocks bounds how far back the old head chain is walked after a reorg.
+const maxOrphanedBlocks = 64
+
+// markOrphaned walks the old head chain of a reorg back to where it meets the
+// new canonical chain, and has the indexer mark everything this node indexed
+// for those blocks as orphaned.
+func (s *agent) markOrphaned(ctx context.Context, reorg *eth2v1.ChainReorgEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	req := &indexer.MarkOrphanedRequest{
+		Node:    s.Config.Name,
+		Network: string(s.node.Beacon().Metadata().Network.Name),
+	}
+
+	root := rootAsString(reorg.OldHeadBlock)
+
+	for i := 0; i < maxOrphanedBlocks; i++ {
+		header, err := s.node.Beacon().Node().FetchBeaconBlockHeader(ctx, &eapi.BeaconBlockHeaderOpts{
+			Block: root,
+		})
+		if err != nil {
+			// The node may have already pruned the rest of the old chain, so
+			// mark whatever we've found so far.
+			if len(req.BlockRoots) == 0 {
+				return errors.Wrap(err, "failed to fetch old head block header")
+			}
+
+			s.log.WithError(err).WithField("block_root", root).Debug("Stopped walking the old head chain")
+
+			break
+		}
+
+		if header == nil || header.Header == nil || header.Header.Message == nil {
+			break
+		}
+
+		// We've reached the common ancestor with the new canonical chain.
+		if header.Canonical {
+			break
+		}
+
+		req.BlockRoots = append(req.BlockRoots, root)
+		req.StateRoots = append(req.StateRoots, rootAsString(header.Header.Message.StateRoot))
+
+		block, err := s.node.Beacon().GetVersionImmuneBlock(ctx, root)
+		if err == nil && block != nil && block.Data.Message.Body.ExecutionPayload.BlockHash != "" {
+			req.ExecutionBlockHashes = append(req.ExecutionBlockHashes, block.Data.Message.Body.ExecutionPayload.BlockHash)
+		}
+
+		root = rootAsString(header.Header.Message.ParentRoot)
+	}
+
+	if len(req.BlockRoots) == 0 {
+		return nil
+	}
+
+	rsp, err := s.indexer.MarkOrphaned(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to mark orphaned blocks")
+	}
+
+	s.log.
+		WithField("orphaned_blocks", len(req.BlockRoots)).
+		WithField("beacon_blocks", rsp.GetBeaconBlocks().GetValue()).
+		WithField("beacon_states", rsp.GetBeaconStates().GetValue()).
+		WithField("execution_block_traces", rsp.GetExecutionBlockTraces().GetValue()).
+		Info("Marked artifacts from the old head chain as orphaned")
+
+	return nil
+}
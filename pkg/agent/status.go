@@ -0,0 +1,350 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+	"github.com/ethpandaops/tracoor/pkg/agent/queue"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor"
+)
+
+const statusCheckInterval = 5 * time.Second
+
+type StatusConfig struct {
+	// Addr is the address the status API listens on. The API is disabled if empty.
+	Addr string `yaml:"addr"`
+	// UnhealthyAfter is how long the agent can go without being ready before
+	// /healthz fails, including while it starts up.
+	UnhealthyAfter human.Duration `yaml:"unhealthyAfter" default:"5m"`
+}
+
+func (c *StatusConfig) Validate() error {
+	if c.Addr != "" && c.UnhealthyAfter.Duration <= 0 {
+		return errors.New("status.unhealthyAfter must be greater than 0")
+	}
+
+	return nil
+}
+
+// AgentStatus is the response of the status API.
+type AgentStatus struct {
+	Name     string   `json:"name"`
+	Version  string   `json:"version"`
+	Ready    bool     `json:"ready"`
+	Healthy  bool     `json:"healthy"`
+	Reasons  []string `json:"reasons,omitempty"`
+	Network  string   `json:"network"`
+	Features []string `json:"features"`
+
+	Beacon           NodeStatus             `json:"beacon"`
+	Execution        NodeStatus             `json:"execution"`
+	StorageHandshake StorageHandshakeStatus `json:"storage_handshake"`
+	Queues           map[Queue]*QueueStatus `json:"queues"`
+}
+
+// NodeStatus is the status of the beacon or execution node in use.
+type NodeStatus struct {
+	Ready    bool   `json:"ready"`
+	Healthy  bool   `json:"healthy"`
+	Upstream string `json:"upstream"`
+	Client   string `json:"client"`
+	Version  string `json:"version"`
+}
+
+type StorageHandshakeStatus struct {
+	Complete    bool       `json:"complete"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
+type QueueStatus struct {
+	Depth       int64      `json:"depth"`
+	DeadLetters int64      `json:"dead_letters"`
+	LastSuccess *time.Time `json:"last_success,omitempty"`
+	LastError   string     `json:"last_error,omitempty"`
+	LastErrorAt *time.Time `json:"last_error_at,omitempty"`
+}
+
+// agentStatus tracks the parts of the agent's status that aren't held elsewhere.
+type agentStatus struct {
+	mu sync.Mutex
+
+	lastReady  time.Time
+	handshake  StorageHandshakeStatus
+	queueStats map[Queue]*QueueStatus
+}
+
+func newAgentStatus() *agentStatus {
+	now := time.Now()
+
+	return &agentStatus{
+		lastReady:  now,
+		queueStats: make(map[Queue]*QueueStatus),
+	}
+}
+
+func (a *agentStatus) recordHandshake(err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err != nil {
+		a.handshake = StorageHandshakeStatus{Error: err.Error()}
+
+		return
+	}
+
+	now := time.Now()
+
+	a.handshake = StorageHandshakeStatus{Complete: true, CompletedAt: &now}
+}
+
+func (a *agentStatus) queueStat(name Queue) *QueueStatus {
+	stat, ok := a.queueStats[name]
+	if !ok {
+		stat = &QueueStatus{}
+		a.queueStats[name] = stat
+	}
+
+	return stat
+}
+
+func (a *agentStatus) recordQueueSuccess(name Queue) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+
+	a.queueStat(name).LastSuccess = &now
+}
+
+func (a *agentStatus) recordQueueError(name Queue, err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+
+	stat := a.queueStat(name)
+	stat.LastError = err.Error()
+	stat.LastErrorAt = &now
+}
+
+// namedQueues returns every queue of the agent in a stable order.
+func (s *agent) namedQueues() []struct {
+	name  Queue
+	queue *queue.Queue
+} {
+	return []struct {
+		name  Queue
+		queue *queue.Queue
+	}{
+		{BeaconStateQueue, s.beaconStateQueue},
+		{FinalizedBeaconStateQueue, s.finalizedBeaconStateQueue},
+		{BeaconBlockQueue, s.beaconBlockQueue},
+		{BlobSidecarQueue, s.blobSidecarQueue},
+		{ForkChoiceQueue, s.forkChoiceQueue},
+		{BeaconBadBlockQueue, s.beaconBadBlockQueue},
+		{BeaconBadBlobQueue, s.beaconBadBlobQueue},
+		{ExecutionBlockTraceQueue, s.executionBlockTraceQueue},
+		{ExecutionWitnessQueue, s.executionWitnessQueue},
+		{ExecutionBadBlockQueue, s.executionBadBlockQueue},
+	}
+}
+
+// readiness returns the reasons the agent isn't ready, if any.
+func (s *agent) readiness() []string {
+	var reasons []string
+
+	if !s.node.BeaconReady() {
+		reasons = append(reasons, "beacon node is not ready")
+	} else if !s.node.Beacon().Healthy() {
+		reasons = append(reasons, "no healthy beacon node")
+	}
+
+	if !s.node.ExecutionReady() {
+		reasons = append(reasons, "execution node is not ready")
+	} else if !s.node.Execution().Healthy() {
+		reasons = append(reasons, "no healthy execution node")
+	}
+
+	s.status.mu.Lock()
+	defer s.status.mu.Unlock()
+
+	if !s.status.handshake.Complete {
+		reasons = append(reasons, "storage handshake is not complete")
+	}
+
+	return reasons
+}
+
+// checkHealth records when the agent was last ready. The agent is healthy
+// until it has gone UnhealthyAfter without being ready, so an agent stuck
+// retrying can be restarted.
+func (s *agent) checkHealth() bool {
+	ready := len(s.readiness()) == 0
+
+	s.status.mu.Lock()
+	defer s.status.mu.Unlock()
+
+	now := time.Now()
+
+	if ready {
+		s.status.lastReady = now
+	}
+
+	return now.Sub(s.status.lastReady) < s.Config.Status.UnhealthyAfter.Duration
+}
+
+func (s *agent) Status(ctx context.Context) *AgentStatus {
+	reasons := s.readiness()
+
+	status := &AgentStatus{
+		Name:     s.Config.Name,
+		Version:  tracoor.Full(),
+		Ready:    len(reasons) == 0,
+		Healthy:  s.checkHealth(),
+		Reasons:  reasons,
+		Features: s.Config.Ethereum.Features.EnabledFlags(),
+		Beacon: NodeStatus{
+			Ready:   s.node.BeaconReady(),
+			Healthy: s.node.Beacon().Healthy(),
+		},
+		Execution: NodeStatus{
+			Ready:   s.node.ExecutionReady(),
+			Healthy: s.node.Execution().Healthy(),
+		},
+		Queues: make(map[Queue]*QueueStatus),
+	}
+
+	if s.node.BeaconReady() {
+		upstream := s.node.Beacon().Active()
+
+		status.Beacon.Upstream = upstream.Name()
+		status.Beacon.Client = upstream.Metadata().Client(ctx)
+		status.Beacon.Version = upstream.Metadata().NodeVersion(ctx)
+
+		if network := upstream.Metadata().Network; network != nil {
+			status.Network = string(network.Name)
+		}
+	}
+
+	if s.node.ExecutionReady() {
+		upstream := s.node.Execution().Active()
+
+		status.Execution.Upstream = upstream.Name()
+		status.Execution.Client = upstream.Metadata().Client(ctx)
+		status.Execution.Version = upstream.Metadata().ClientVersion()
+	}
+
+	for _, q := range s.namedQueues() {
+		stat := &QueueStatus{}
+
+		if depth, err := q.queue.Size(ctx); err == nil {
+			stat.Depth = depth
+		}
+
+		if deadLetters, err := q.queue.DeadLetterSize(ctx); err == nil {
+			stat.DeadLetters = deadLetters
+		}
+
+		status.Queues[q.name] = stat
+	}
+
+	s.status.mu.Lock()
+	defer s.status.mu.Unlock()
+
+	status.StorageHandshake = s.status.handshake
+
+	for name, stat := range s.status.queueStats {
+		if _, ok := status.Queues[name]; !ok {
+			continue
+		}
+
+		status.Queues[name].LastSuccess = stat.LastSuccess
+		status.Queues[name].LastError = stat.LastError
+		status.Queues[name].LastErrorAt = stat.LastErrorAt
+	}
+
+	return status
+}
+
+func (s *agent) statusHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		writeStatus(w, http.StatusOK, s.Status(r.Context()))
+	})
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		if !s.checkHealth() {
+			writeStatus(w, http.StatusServiceUnavailable, map[string]any{
+				"healthy": false,
+				"reasons": s.readiness(),
+			})
+
+			return
+		}
+
+		writeStatus(w, http.StatusOK, map[string]any{"healthy": true})
+	})
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		if reasons := s.readiness(); len(reasons) > 0 {
+			writeStatus(w, http.StatusServiceUnavailable, map[string]any{
+				"ready":   false,
+				"reasons": reasons,
+			})
+
+			return
+		}
+
+		writeStatus(w, http.StatusOK, map[string]any{"ready": true})
+	})
+
+	return mux
+}
+
+func writeStatus(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// ServeStatus starts the status API and keeps track of when the agent was
+// last ready, so /healthz fails even if nothing is polling it.
+func (s *agent) ServeStatus(ctx context.Context) {
+	statusServer := &http.Server{
+		Addr:              s.Config.Status.Addr,
+		ReadHeaderTimeout: 15 * time.Second,
+		Handler:           s.statusHandler(),
+	}
+
+	go func() {
+		s.log.Infof("Serving status API at %s", s.Config.Status.Addr)
+
+		if err := statusServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.WithError(err).Fatal("Failed to start status server")
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(statusCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				_ = statusServer.Shutdown(context.Background())
+
+				return
+			case <-ticker.C:
+				s.checkHealth()
+			}
+		}
+	}()
+}
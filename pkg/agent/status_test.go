@@ -0,0 +1,106 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+	"github.com/ethpandaops/tracoor/pkg/agent/ethereum"
+	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/beacon"
+	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/execution"
+	"github.com/ethpandaops/tracoor/pkg/agent/queue"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStatusAgent(t *testing.T) *agent {
+	t.Helper()
+
+	log := logrus.New()
+	config := &Config{
+		Name: "test",
+		Ethereum: ethereum.Config{
+			Beacon:    &beacon.Config{NodeAddress: "http://127.0.0.1:0"},
+			Execution: &execution.Config{NodeAddress: "http://127.0.0.1:0"},
+		},
+		Status: StatusConfig{
+			Addr:           ":0",
+			UnhealthyAfter: human.Duration{Duration: time.Minute},
+		},
+	}
+
+	queues, err := queue.NewStore(log, "", &queue.Config{})
+	require.NoError(t, err)
+
+	return &agent{
+		Config:                    config,
+		log:                       log,
+		node:                      ethereum.NewNode(context.Background(), log, &config.Ethereum, config.Name, 0),
+		status:                    newAgentStatus(),
+		beaconStateQueue:          queues.Queue(string(BeaconStateQueue), defaultQueueOptions[BeaconStateQueue]),
+		finalizedBeaconStateQueue: queues.Queue(string(FinalizedBeaconStateQueue), defaultQueueOptions[FinalizedBeaconStateQueue]),
+		beaconBlockQueue:          queues.Queue(string(BeaconBlockQueue), defaultQueueOptions[BeaconBlockQueue]),
+		blobSidecarQueue:          queues.Queue(string(BlobSidecarQueue), defaultQueueOptions[BlobSidecarQueue]),
+		forkChoiceQueue:           queues.Queue(string(ForkChoiceQueue), defaultQueueOptions[ForkChoiceQueue]),
+		beaconBadBlockQueue:       queues.Queue(string(BeaconBadBlockQueue), defaultQueueOptions[BeaconBadBlockQueue]),
+		beaconBadBlobQueue:        queues.Queue(string(BeaconBadBlobQueue), defaultQueueOptions[BeaconBadBlobQueue]),
+		executionBlockTraceQueue:  queues.Queue(string(ExecutionBlockTraceQueue), defaultQueueOptions[ExecutionBlockTraceQueue]),
+		executionWitnessQueue:     queues.Queue(string(ExecutionWitnessQueue), defaultQueueOptions[ExecutionWitnessQueue]),
+		executionBadBlockQueue:    queues.Queue(string(ExecutionBadBlockQueue), defaultQueueOptions[ExecutionBadBlockQueue]),
+	}
+}
+
+func TestStatus_NotReady(t *testing.T) {
+	s := newTestStatusAgent(t)
+	handler := s.statusHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "beacon node is not ready")
+	assert.Contains(t, rec.Body.String(), "storage handshake is not complete")
+
+	// The agent is still healthy while it's within UnhealthyAfter of last being ready.
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	s.status.lastReady = time.Now().Add(-2 * time.Minute)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestStatus_Report(t *testing.T) {
+	s := newTestStatusAgent(t)
+
+	s.status.recordHandshake(errors.New("permission denied"))
+	s.status.recordHandshake(nil)
+	s.status.recordQueueSuccess(BeaconBlockQueue)
+	s.status.recordQueueError(BeaconStateQueue, errors.New("state not found"))
+
+	rec := httptest.NewRecorder()
+	s.statusHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/status", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status AgentStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+
+	assert.Equal(t, "test", status.Name)
+	assert.False(t, status.Ready)
+	assert.False(t, status.Beacon.Ready)
+	assert.True(t, status.StorageHandshake.Complete)
+	assert.Empty(t, status.StorageHandshake.Error)
+
+	require.Len(t, status.Queues, len(defaultQueueOptions))
+	assert.NotNil(t, status.Queues[BeaconBlockQueue].LastSuccess)
+	assert.Equal(t, "state not found", status.Queues[BeaconStateQueue].LastError)
+	assert.NotNil(t, status.Queues[BeaconStateQueue].LastErrorAt)
+}
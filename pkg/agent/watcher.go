@@ -0,0 +1,120 @@
+package agent
+
+import (
+	"context"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+)
+
+// dirWatcher calls onChange whenever files in a directory are created or
+// written to. It relies on file system notifications where they're available
+// and rescans the directory every poll interval regardless, so nothing is
+// missed on file systems that don't support them (e.g. network mounts).
+type dirWatcher struct {
+	log logrus.FieldLogger
+
+	path string
+
+	// settle is how long to wait after the last notification before calling
+	// onChange, so a file that's still being written only triggers it once.
+	settle time.Duration
+
+	interval time.Duration
+
+	onChange func(ctx context.Context)
+
+	// notify controls whether file system notifications are used.
+	notify bool
+}
+
+func newDirWatcher(log logrus.FieldLogger, path string, settle, interval time.Duration, onChange func(ctx context.Context)) *dirWatcher {
+	return &dirWatcher{
+		log:      log.WithField("path", path),
+		path:     path,
+		settle:   settle,
+		interval: interval,
+		onChange: onChange,
+		notify:   true,
+	}
+}
+
+// Start watches the directory until the context is cancelled.
+func (w *dirWatcher) Start(ctx context.Context) {
+	var (
+		events <-chan fsnotify.Event
+		errs   <-chan error
+	)
+
+	if w.notify {
+		watcher, err := w.newNotifier()
+		if err != nil {
+			w.log.WithError(err).Warn("File system notifications are unavailable, falling back to polling")
+		} else {
+			defer watcher.Close()
+
+			events = watcher.Events
+			errs = watcher.Errors
+		}
+	}
+
+	// Pick up anything that was written while we weren't watching.
+	w.onChange(ctx)
+
+	poll := time.NewTicker(w.interval)
+	defer poll.Stop()
+
+	var debounce <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+			w.onChange(ctx)
+		case <-debounce:
+			debounce = nil
+
+			w.onChange(ctx)
+		case event, ok := <-events:
+			if !ok {
+				w.log.Warn("File system notifications stopped, falling back to polling")
+
+				events = nil
+				errs = nil
+
+				continue
+			}
+
+			if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+				continue
+			}
+
+			debounce = time.After(w.settle)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+
+				continue
+			}
+
+			w.log.WithError(err).Warn("Error watching directory")
+		}
+	}
+}
+
+func (w *dirWatcher) newNotifier() (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := watcher.Add(w.path); err != nil {
+		_ = watcher.Close()
+
+		return nil, err
+	}
+
+	return watcher, nil
+}
@@ -0,0 +1,99 @@
+package agent
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startTestWatcher(t *testing.T, notify bool, settle, interval time.Duration) (string, *atomic.Int32) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	var calls atomic.Int32
+
+	w := newDirWatcher(logrus.New(), dir, settle, interval, func(_ context.Context) {
+		calls.Add(1)
+	})
+	w.notify = notify
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go w.Start(ctx)
+
+	// Wait for the initial scan.
+	require.Eventually(t, func() bool {
+		return calls.Load() == 1
+	}, time.Second, 5*time.Millisecond)
+
+	return dir, &calls
+}
+
+func TestDirWatcher_NotifiesOnNewFiles(t *testing.T) {
+	dir, calls := startTestWatcher(t, true, 50*time.Millisecond, time.Hour)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "block_1.ssz"), []byte("block"), 0o600))
+
+	require.Eventually(t, func() bool {
+		return calls.Load() == 2
+	}, 5*time.Second, 5*time.Millisecond)
+}
+
+func TestDirWatcher_DebouncesWrites(t *testing.T) {
+	dir, calls := startTestWatcher(t, true, 200*time.Millisecond, time.Hour)
+
+	f, err := os.Create(filepath.Join(dir, "block_1.ssz"))
+	require.NoError(t, err)
+
+	defer f.Close()
+
+	for i := 0; i < 5; i++ {
+		_, err := f.WriteString("chunk")
+		require.NoError(t, err)
+
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	require.Eventually(t, func() bool {
+		return calls.Load() == 2
+	}, 5*time.Second, 5*time.Millisecond)
+
+	// Let any stray timers fire.
+	time.Sleep(300 * time.Millisecond)
+
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestDirWatcher_Polls(t *testing.T) {
+	_, calls := startTestWatcher(t, false, time.Millisecond, 20*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return calls.Load() >= 3
+	}, 5*time.Second, 5*time.Millisecond)
+}
+
+func TestDirWatcher_FallsBackToPollingForMissingPaths(t *testing.T) {
+	var calls atomic.Int32
+
+	w := newDirWatcher(logrus.New(), filepath.Join(t.TempDir(), "missing"), time.Millisecond, 20*time.Millisecond, func(_ context.Context) {
+		calls.Add(1)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go w.Start(ctx)
+
+	require.Eventually(t, func() bool {
+		return calls.Load() >= 3
+	}, 5*time.Second, 5*time.Millisecond)
+}
@@ -4,8 +4,12 @@ import (
 	"bytes"
 	"compress/gzip"
 	"errors"
+	"fmt"
 	"io"
 	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 )
 
 // CompressionAlgorithm represents the type of compression algorithm.
@@ -13,6 +17,8 @@ type CompressionAlgorithm struct {
 	Name            string
 	Extension       string
 	ContentEncoding string
+	// Level is the compression level. 0 uses the algorithm's default.
+	Level int
 }
 
 var (
@@ -21,13 +27,53 @@ var (
 		Extension:       ".gz",
 		ContentEncoding: "gzip",
 	}
+	Zstd = &CompressionAlgorithm{
+		Name:            "zstd",
+		Extension:       ".zst",
+		ContentEncoding: "zstd",
+	}
+	// Snappy is the framed snappy format used by ssz_snappy. There's no
+	// standard content encoding for it, so clients have to decode it themselves.
+	Snappy = &CompressionAlgorithm{
+		Name:            "snappy",
+		Extension:       ".sz",
+		ContentEncoding: "x-snappy-framed",
+	}
 	None = &CompressionAlgorithm{
 		Name:            "none",
 		Extension:       "",
 		ContentEncoding: "identity",
 	}
+
+	algorithms = []*CompressionAlgorithm{Gzip, Zstd, Snappy, None}
 )
 
+// WithLevel returns a copy of the algorithm that compresses at the given level.
+// Gzip supports levels 1 to 9 and zstd levels 1 to 22. Snappy has no levels.
+func (a *CompressionAlgorithm) WithLevel(level int) (*CompressionAlgorithm, error) {
+	if level != 0 {
+		var minLevel, maxLevel int
+
+		switch a.Name {
+		case Gzip.Name:
+			minLevel, maxLevel = gzip.BestSpeed, gzip.BestCompression
+		case Zstd.Name:
+			minLevel, maxLevel = 1, 22
+		default:
+			return nil, fmt.Errorf("%s does not support compression levels", a.Name)
+		}
+
+		if level < minLevel || level > maxLevel {
+			return nil, fmt.Errorf("invalid %s compression level %d: must be between %d and %d", a.Name, level, minLevel, maxLevel)
+		}
+	}
+
+	algorithm := *a
+	algorithm.Level = level
+
+	return &algorithm, nil
+}
+
 // Compressor provides methods for compressing and decompressing data.
 type Compressor struct{}
 
@@ -48,19 +94,12 @@ func (c *Compressor) Compress(data *[]byte, algorithm *CompressionAlgorithm) ([]
 
 	var buf bytes.Buffer
 
-	var w io.WriteCloser
-
-	switch algorithm.Name {
-	case Gzip.Name:
-		w = gzip.NewWriter(&buf)
-	case None.Name:
-		w = nopWriteCloser{&buf}
-	default:
-		return nil, ErrUnsupportedAlgorithm
+	w, err := newWriter(&buf, algorithm)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err := w.Write(*data)
-	if err != nil {
+	if _, err := w.Write(*data); err != nil {
 		return nil, err
 	}
 
@@ -71,30 +110,97 @@ func (c *Compressor) Compress(data *[]byte, algorithm *CompressionAlgorithm) ([]
 	return buf.Bytes(), nil
 }
 
-// Decompress decompresses the input data using the specified algorithm.
-func (c *Compressor) Decompress(data *[]byte, filename string) ([]byte, error) {
-	if data == nil {
-		return nil, errors.New("data is nil")
+// CompressStream returns a reader of the data read from r compressed with the
+// specified algorithm. Data is compressed as it's read, so only a small buffer
+// is held in memory regardless of the size of the data. The returned reader
+// must be closed, which also stops reading from r.
+func (c *Compressor) CompressStream(r io.Reader, algorithm *CompressionAlgorithm) (io.ReadCloser, error) {
+	if r == nil {
+		return nil, errors.New("reader is nil")
 	}
 
-	var r io.ReadCloser
+	if algorithm == nil {
+		return nil, errors.New("algorithm is nil")
+	}
 
-	var err error
+	pr, pw := io.Pipe()
 
-	algo, err := GetCompressionAlgorithm(filename)
+	w, err := newWriter(pw, algorithm)
 	if err != nil {
 		return nil, err
 	}
 
-	switch algo.Name {
+	go func() {
+		var err error
+
+		// Always close the writer so encoders release their resources, even
+		// when the copy fails.
+		defer func() {
+			if closeErr := w.Close(); err == nil {
+				err = closeErr
+			}
+
+			pw.CloseWithError(err)
+		}()
+
+		_, err = io.Copy(w, r)
+	}()
+
+	return pr, nil
+}
+
+// newWriter returns a writer that compresses data written to it with the specified algorithm.
+func newWriter(w io.Writer, algorithm *CompressionAlgorithm) (io.WriteCloser, error) {
+	switch algorithm.Name {
 	case Gzip.Name:
-		r, err = gzip.NewReader(bytes.NewReader(*data))
+		if algorithm.Level == 0 {
+			return gzip.NewWriter(w), nil
+		}
+
+		return gzip.NewWriterLevel(w, algorithm.Level)
+	case Zstd.Name:
+		opts := []zstd.EOption{}
+
+		if algorithm.Level != 0 {
+			opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(algorithm.Level)))
+		}
+
+		return zstd.NewWriter(w, opts...)
+	case Snappy.Name:
+		return snappy.NewBufferedWriter(w), nil
 	case None.Name:
-		r = io.NopCloser(bytes.NewReader(*data))
+		return nopWriteCloser{w}, nil
 	default:
 		return nil, ErrUnsupportedAlgorithm
 	}
+}
+
+// Decompress decompresses the input data using the specified algorithm.
+func (c *Compressor) Decompress(data *[]byte, filename string) ([]byte, error) {
+	if data == nil {
+		return nil, errors.New("data is nil")
+	}
 
+	algo, err := GetCompressionAlgorithm(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.DecompressWithAlgorithm(data, algo)
+}
+
+// DecompressWithAlgorithm decompresses the input data using the specified algorithm.
+// Useful when the algorithm is known from the content encoding rather than the filename.
+func (c *Compressor) DecompressWithAlgorithm(data *[]byte, algo *CompressionAlgorithm) ([]byte, error) {
+	if data == nil {
+		return nil, errors.New("data is nil")
+	}
+
+	if algo == nil {
+		return nil, errors.New("algorithm is nil")
+	}
+
+	r, err := c.DecompressStream(bytes.NewReader(*data), algo)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +210,37 @@ func (c *Compressor) Decompress(data *[]byte, filename string) ([]byte, error) {
 	return io.ReadAll(r)
 }
 
+// DecompressStream returns a reader of the decompressed data of r, so data too
+// large to hold in memory can be decoded as it's read. The caller must close it.
+func (c *Compressor) DecompressStream(r io.Reader, algo *CompressionAlgorithm) (io.ReadCloser, error) {
+	if algo == nil {
+		return nil, errors.New("algorithm is nil")
+	}
+
+	switch algo.Name {
+	case Gzip.Name:
+		g, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return g, nil
+	case Zstd.Name:
+		d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+
+		return d.IOReadCloser(), nil
+	case Snappy.Name:
+		return io.NopCloser(snappy.NewReader(r)), nil
+	case None.Name:
+		return io.NopCloser(r), nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
 // AddExtension adds the compression extension to the filename if it's not already present.
 func AddExtension(filename string, algorithm *CompressionAlgorithm) string {
 	if !strings.HasSuffix(filename, algorithm.Extension) {
@@ -115,40 +252,75 @@ func AddExtension(filename string, algorithm *CompressionAlgorithm) string {
 
 // RemoveExtension removes the compression extension from the filename if it's present.
 func RemoveExtension(filename string) string {
-	filename = strings.TrimSuffix(filename, Gzip.Extension)
-	filename = strings.TrimSuffix(filename, None.Extension)
+	if algorithm, err := GetCompressionAlgorithm(filename); err == nil {
+		return strings.TrimSuffix(filename, algorithm.Extension)
+	}
 
 	return filename
 }
 
 // HasCompressionExtension checks if the filename has the compression extension.
 func HasCompressionExtension(filename string, algorithm *CompressionAlgorithm) bool {
+	if algorithm == nil || algorithm.Extension == "" {
+		return false
+	}
+
 	return strings.HasSuffix(filename, algorithm.Extension)
 }
 
-// HasCompressionExtension checks if the filename has the compression extension.
+// HasAnyCompressionExtension checks if the filename has the extension of any compression algorithm.
 func HasAnyCompressionExtension(filename string) bool {
-	return strings.HasSuffix(filename, Gzip.Extension) || strings.HasSuffix(filename, None.Extension)
+	_, err := GetCompressionAlgorithm(filename)
+
+	return err == nil
 }
 
+// GetCompressionAlgorithm returns the algorithm the filename's extension belongs to.
 func GetCompressionAlgorithm(filename string) (*CompressionAlgorithm, error) {
-	if strings.HasSuffix(filename, Gzip.Extension) {
-		return Gzip, nil
+	for _, algorithm := range algorithms {
+		if HasCompressionExtension(filename, algorithm) {
+			return algorithm, nil
+		}
 	}
 
-	return nil, errors.New("unsupported compression algorithm")
+	return nil, ErrUnsupportedAlgorithm
 }
 
 func GetCompressionAlgorithmFromContentEncoding(contentEncoding string) (*CompressionAlgorithm, error) {
-	if contentEncoding == Gzip.ContentEncoding {
-		return Gzip, nil
+	for _, algorithm := range algorithms {
+		if contentEncoding == algorithm.ContentEncoding {
+			return algorithm, nil
+		}
+	}
+
+	return nil, ErrUnsupportedAlgorithm
+}
+
+// GetItemCompressionAlgorithm returns the algorithm a stored item is compressed
+// with, going by the content encoding it was indexed with or, for items indexed
+// before the content encoding was recorded, the extension of its location. It
+// returns nil if neither is known.
+func GetItemCompressionAlgorithm(location, contentEncoding string) *CompressionAlgorithm {
+	if algo, err := GetCompressionAlgorithmFromContentEncoding(contentEncoding); err == nil {
+		return algo
+	}
+
+	if algo, err := GetCompressionAlgorithm(location); err == nil {
+		return algo
 	}
 
-	if contentEncoding == None.ContentEncoding {
-		return None, nil
+	return nil
+}
+
+// GetCompressionAlgorithmFromName returns the algorithm with the given name.
+func GetCompressionAlgorithmFromName(name string) (*CompressionAlgorithm, error) {
+	for _, algorithm := range algorithms {
+		if name == algorithm.Name {
+			return algorithm, nil
+		}
 	}
 
-	return nil, errors.New("unsupported compression algorithm")
+	return nil, ErrUnsupportedAlgorithm
 }
 
 // ErrUnsupportedAlgorithm is returned when an unsupported compression algorithm is specified.
@@ -1,8 +1,12 @@
 package compression_test
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -30,6 +34,18 @@ func TestCompressor_Compress(t *testing.T) {
 			algorithm: compression.Gzip,
 			wantErr:   false,
 		},
+		{
+			name:      "Compress with Zstd",
+			data:      []byte("test data"),
+			algorithm: compression.Zstd,
+			wantErr:   false,
+		},
+		{
+			name:      "Compress with Snappy",
+			data:      []byte("test data"),
+			algorithm: compression.Snappy,
+			wantErr:   false,
+		},
 		{
 			name:      "Compress with unsupported algorithm",
 			data:      []byte("test data"),
@@ -54,6 +70,75 @@ func TestCompressor_Compress(t *testing.T) {
 	}
 }
 
+func TestCompressor_CompressStream(t *testing.T) {
+	c := compression.NewCompressor()
+
+	testData := []byte(strings.Repeat("test data", 100000))
+
+	for _, algorithm := range []*compression.CompressionAlgorithm{compression.Gzip, compression.Zstd, compression.Snappy, compression.None} {
+		t.Run(algorithm.Name, func(t *testing.T) {
+			r, err := c.CompressStream(bytes.NewReader(testData), algorithm)
+			require.NoError(t, err)
+
+			defer r.Close()
+
+			compressed, err := io.ReadAll(r)
+			require.NoError(t, err)
+
+			// The stream matches the output of Compress.
+			want, err := c.Compress(&testData, algorithm)
+			require.NoError(t, err)
+			assert.Equal(t, want, compressed)
+		})
+	}
+
+	_, err := c.CompressStream(bytes.NewReader(testData), &compression.CompressionAlgorithm{Name: "unsupported"})
+	assert.ErrorIs(t, err, compression.ErrUnsupportedAlgorithm)
+}
+
+func TestCompressor_DecompressStream(t *testing.T) {
+	c := compression.NewCompressor()
+
+	testData := []byte(strings.Repeat("test data", 100000))
+
+	for _, algorithm := range []*compression.CompressionAlgorithm{compression.Gzip, compression.Zstd, compression.Snappy, compression.None} {
+		t.Run(algorithm.Name, func(t *testing.T) {
+			compressed, err := c.Compress(&testData, algorithm)
+			require.NoError(t, err)
+
+			r, err := c.DecompressStream(bytes.NewReader(compressed), algorithm)
+			require.NoError(t, err)
+
+			defer r.Close()
+
+			decompressed, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, testData, decompressed)
+		})
+	}
+
+	_, err := c.DecompressStream(bytes.NewReader(testData), &compression.CompressionAlgorithm{Name: "unsupported"})
+	assert.ErrorIs(t, err, compression.ErrUnsupportedAlgorithm)
+}
+
+func TestCompressor_CompressStreamReadError(t *testing.T) {
+	c := compression.NewCompressor()
+
+	readErr := errors.New("connection reset")
+
+	for _, algorithm := range []*compression.CompressionAlgorithm{compression.Gzip, compression.Zstd, compression.Snappy} {
+		t.Run(algorithm.Name, func(t *testing.T) {
+			r, err := c.CompressStream(io.MultiReader(strings.NewReader("test data"), iotest.ErrReader(readErr)), algorithm)
+			require.NoError(t, err)
+
+			defer r.Close()
+
+			_, err = io.ReadAll(r)
+			assert.ErrorIs(t, err, readErr)
+		})
+	}
+}
+
 func TestCompressor_Decompress(t *testing.T) {
 	c := compression.NewCompressor()
 
@@ -102,6 +187,60 @@ func TestCompressor_Decompress(t *testing.T) {
 	}
 }
 
+func TestCompressor_DecompressWithAlgorithm(t *testing.T) {
+	c := compression.NewCompressor()
+
+	testData := []byte("test data")
+	compressed, err := c.Compress(&testData, compression.Gzip)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		data      []byte
+		algorithm *compression.CompressionAlgorithm
+		wantErr   bool
+	}{
+		{
+			name:      "Decompress Gzip",
+			data:      compressed,
+			algorithm: compression.Gzip,
+			wantErr:   false,
+		},
+		{
+			name:      "Decompress None",
+			data:      testData,
+			algorithm: compression.None,
+			wantErr:   false,
+		},
+		{
+			name:      "Decompress with nil algorithm",
+			data:      compressed,
+			algorithm: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "Decompress with unsupported algorithm",
+			data:      testData,
+			algorithm: &compression.CompressionAlgorithm{Name: "unsupported"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		testCase := tc
+
+		t.Run(testCase.name, func(t *testing.T) {
+			decompressed, err := c.DecompressWithAlgorithm(&testCase.data, testCase.algorithm)
+			if testCase.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testData, decompressed)
+			}
+		})
+	}
+}
+
 func TestAddExtension(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -146,6 +285,12 @@ func TestRemoveExtension(t *testing.T) {
 			algorithm: compression.Gzip,
 			want:      "test",
 		},
+		{
+			name:      "Remove Zstd extension from .ssz.zst",
+			filename:  "test.ssz.zst",
+			algorithm: compression.Zstd,
+			want:      "test.ssz",
+		},
 		{
 			name:      "No extension to remove",
 			filename:  "test",
@@ -189,6 +334,18 @@ func TestHasCompressionExtension(t *testing.T) {
 			algorithm: compression.Gzip,
 			want:      false,
 		},
+		{
+			name:      "Has Snappy extension",
+			filename:  "test.ssz.sz",
+			algorithm: compression.Snappy,
+			want:      true,
+		},
+		{
+			name:      "None has no extension",
+			filename:  "test",
+			algorithm: compression.None,
+			want:      false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -214,6 +371,18 @@ func TestGetCompressionAlgorithm(t *testing.T) {
 			want:     compression.Gzip,
 			wantErr:  false,
 		},
+		{
+			name:     "Get Zstd algorithm",
+			filename: "test.ssz.zst",
+			want:     compression.Zstd,
+			wantErr:  false,
+		},
+		{
+			name:     "Get Snappy algorithm",
+			filename: "test.ssz.sz",
+			want:     compression.Snappy,
+			wantErr:  false,
+		},
 		{
 			name:     "Unsupported algorithm",
 			filename: "test.unsupported",
@@ -261,6 +430,16 @@ func TestCompressAndDecompress(t *testing.T) {
 			data:      []byte(strings.Repeat("Large data test ", 1000)),
 			algorithm: compression.Gzip,
 		},
+		{
+			name:      "Compress and decompress large data with Zstd",
+			data:      []byte(strings.Repeat("Large data test ", 1000)),
+			algorithm: compression.Zstd,
+		},
+		{
+			name:      "Compress and decompress large data with Snappy",
+			data:      []byte(strings.Repeat("Large data test ", 1000)),
+			algorithm: compression.Snappy,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -283,3 +462,100 @@ func TestCompressAndDecompress(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCompressionAlgorithmFromContentEncoding(t *testing.T) {
+	for _, algorithm := range []*compression.CompressionAlgorithm{compression.Gzip, compression.Zstd, compression.Snappy, compression.None} {
+		result, err := compression.GetCompressionAlgorithmFromContentEncoding(algorithm.ContentEncoding)
+		require.NoError(t, err)
+		assert.Equal(t, algorithm, result)
+
+		result, err = compression.GetCompressionAlgorithmFromName(algorithm.Name)
+		require.NoError(t, err)
+		assert.Equal(t, algorithm, result)
+	}
+
+	_, err := compression.GetCompressionAlgorithmFromContentEncoding("br")
+	assert.ErrorIs(t, err, compression.ErrUnsupportedAlgorithm)
+
+	_, err = compression.GetCompressionAlgorithmFromName("brotli")
+	assert.ErrorIs(t, err, compression.ErrUnsupportedAlgorithm)
+}
+
+func TestCompressionAlgorithm_WithLevel(t *testing.T) {
+	c := compression.NewCompressor()
+
+	data := []byte(strings.Repeat("Large data test ", 1000))
+
+	for _, tc := range []struct {
+		algorithm *compression.CompressionAlgorithm
+		level     int
+		wantErr   bool
+	}{
+		{algorithm: compression.Gzip, level: 1},
+		{algorithm: compression.Gzip, level: 9},
+		{algorithm: compression.Gzip, level: 10, wantErr: true},
+		{algorithm: compression.Zstd, level: 1},
+		{algorithm: compression.Zstd, level: 19},
+		{algorithm: compression.Zstd, level: 23, wantErr: true},
+		{algorithm: compression.Snappy, level: 0},
+		{algorithm: compression.Snappy, level: 1, wantErr: true},
+	} {
+		algorithm, err := tc.algorithm.WithLevel(tc.level)
+		if tc.wantErr {
+			assert.Error(t, err, "%s level %d", tc.algorithm.Name, tc.level)
+
+			continue
+		}
+
+		require.NoError(t, err)
+		assert.Equal(t, tc.level, algorithm.Level)
+		assert.Zero(t, tc.algorithm.Level, "the shared algorithm is not modified")
+
+		compressed, err := c.Compress(&data, algorithm)
+		require.NoError(t, err)
+
+		decompressed, err := c.DecompressWithAlgorithm(&compressed, tc.algorithm)
+		require.NoError(t, err)
+		assert.Equal(t, data, decompressed)
+	}
+}
+
+func TestGetItemCompressionAlgorithm(t *testing.T) {
+	testCases := []struct {
+		name            string
+		location        string
+		contentEncoding string
+		want            *compression.CompressionAlgorithm
+	}{
+		{
+			name:            "Content encoding",
+			location:        "trace.json",
+			contentEncoding: "zstd",
+			want:            compression.Zstd,
+		},
+		{
+			name:            "Content encoding takes precedence over the extension",
+			location:        "trace.json.gz",
+			contentEncoding: "identity",
+			want:            compression.None,
+		},
+		{
+			name:     "Extension of items indexed without a content encoding",
+			location: "block.ssz.sz",
+			want:     compression.Snappy,
+		},
+		{
+			name:     "Unknown",
+			location: "trace.json",
+			want:     nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		testCase := tc
+
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, compression.GetItemCompressionAlgorithm(testCase.location, testCase.contentEncoding))
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package engineproxy
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/tracoor/pkg/agent/ethereum/execution/services"
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// CapturedPayload is a payload the execution node rejected along with
+// everything the consensus client sent with it.
+type CapturedPayload struct {
+	Method                      string          `json:"method"`
+	ExecutionPayload            json.RawMessage `json:"executionPayload"`
+	ExpectedBlobVersionedHashes json.RawMessage `json:"expectedBlobVersionedHashes,omitempty"`
+	ParentBeaconBlockRoot       json.RawMessage `json:"parentBeaconBlockRoot,omitempty"`
+	ExecutionRequests           json.RawMessage `json:"executionRequests,omitempty"`
+	Status                      string          `json:"status"`
+	LatestValidHash             *string         `json:"latestValidHash"`
+	ValidationError             *string         `json:"validationError"`
+}
+
+type executionPayloadHeader struct {
+	BlockHash   string `json:"blockHash"`
+	BlockNumber string `json:"blockNumber"`
+	ExtraData   string `json:"extraData"`
+}
+
+type engineClientVersion struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+}
+
+// capture saves a rejected engine_newPayload request to the store and indexes it as an execution bad block.
+func (p *Proxy) capture(ctx context.Context, req *rpcRequest, status *payloadStatus) error {
+	if len(req.Params) == 0 {
+		return errors.New("request has no execution payload")
+	}
+
+	captured := &CapturedPayload{
+		Method:           req.Method,
+		ExecutionPayload: req.Params[0],
+		Status:           status.Status,
+		LatestValidHash:  status.LatestValidHash,
+		ValidationError:  status.ValidationError,
+	}
+
+	// V3 onwards carries the versioned hashes and parent beacon block root, and V4 onwards the execution requests.
+	if len(req.Params) > 1 {
+		captured.ExpectedBlobVersionedHashes = req.Params[1]
+	}
+
+	if len(req.Params) > 2 {
+		captured.ParentBeaconBlockRoot = req.Params[2]
+	}
+
+	if len(req.Params) > 3 {
+		captured.ExecutionRequests = req.Params[3]
+	}
+
+	var header executionPayloadHeader
+	if err := json.Unmarshal(captured.ExecutionPayload, &header); err != nil {
+		return fmt.Errorf("failed to parse execution payload: %w", err)
+	}
+
+	if header.BlockHash == "" {
+		return errors.New("execution payload has no block hash")
+	}
+
+	blockNumber, err := strconv.ParseInt(strings.TrimPrefix(header.BlockNumber, "0x"), 16, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse block number %q: %w", header.BlockNumber, err)
+	}
+
+	// The consensus client retries payloads, so make sure each one is only captured once.
+	if _, loaded := p.capturing.LoadOrStore(header.BlockHash, struct{}{}); loaded {
+		return nil
+	}
+
+	defer p.capturing.Delete(header.BlockHash)
+
+	logCtx := p.log.
+		WithField("block_hash", header.BlockHash).
+		WithField("block_number", blockNumber).
+		WithField("status", status.Status)
+
+	rsp, err := p.indexer.ListExecutionBadBlock(ctx, &indexer.ListExecutionBadBlockRequest{
+		Node:      p.config.Name,
+		BlockHash: header.BlockHash,
+		Network:   p.config.Network,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check if invalid payload is already indexed: %w", err)
+	}
+
+	if rsp != nil && len(rsp.ExecutionBadBlocks) > 0 {
+		logCtx.Debug("Invalid payload already indexed")
+
+		return nil
+	}
+
+	data, err := json.Marshal(captured)
+	if err != nil {
+		return fmt.Errorf("failed to marshal invalid payload: %w", err)
+	}
+
+	compressed, err := p.compressor.Compress(&data, compression.Gzip)
+	if err != nil {
+		return fmt.Errorf("failed to compress invalid payload: %w", err)
+	}
+
+	location := fmt.Sprintf("%s.new_payload.json", store.CreateExecutionBadBlockFileName(
+		p.config.Name,
+		p.config.Network,
+		header.BlockHash,
+	))
+
+	location, err = p.store.SaveExecutionBadBlock(ctx, &store.SaveParams{
+		Data:            &compressed,
+		Location:        location,
+		ContentEncoding: compression.Gzip.ContentEncoding,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save invalid payload to store: %w", err)
+	}
+
+	implementation, version := p.executionClient(ctx)
+
+	createReq := &indexer.CreateExecutionBadBlockRequest{
+		Node:                    wrapperspb.String(p.config.Name),
+		BlockHash:               wrapperspb.String(header.BlockHash),
+		BlockNumber:             wrapperspb.Int64(blockNumber),
+		FetchedAt:               timestamppb.New(time.Now()),
+		Location:                wrapperspb.String(location),
+		ContentEncoding:         wrapperspb.String(compression.Gzip.ContentEncoding),
+		Network:                 wrapperspb.String(p.config.Network),
+		ExecutionImplementation: wrapperspb.String(implementation),
+		NodeVersion:             wrapperspb.String(version),
+	}
+
+	if extra, err := hex.DecodeString(strings.TrimPrefix(header.ExtraData, "0x")); err == nil {
+		createReq.BlockExtraData = wrapperspb.String(strings.ToValidUTF8(string(extra), ""))
+	}
+
+	created, err := p.indexer.CreateExecutionBadBlock(ctx, createReq)
+	if err != nil {
+		return fmt.Errorf("failed to index invalid payload: %w", err)
+	}
+
+	p.metrics.IncrementCapturedPayloads(p.config.Name)
+
+	logCtx.
+		WithField("id", created.GetId().GetValue()).
+		WithField("location", location).
+		Info("Captured invalid payload")
+
+	return nil
+}
+
+// executionClient returns the implementation and version of the execution node.
+// Unknown values are returned if the node doesn't support engine_getClientVersionV1.
+func (p *Proxy) executionClient(ctx context.Context) (implementation, version string) {
+	p.clientVersionMu.Lock()
+	defer p.clientVersionMu.Unlock()
+
+	if p.clientVersion == nil {
+		v, err := p.fetchClientVersion(ctx)
+		if err != nil {
+			p.log.WithError(err).Warn("Failed to fetch the execution node client version")
+
+			return string(services.ClientUnknown), string(services.ClientUnknown)
+		}
+
+		p.clientVersion = v
+	}
+
+	return string(services.ClientFromString(p.clientVersion.Name)),
+		fmt.Sprintf("%s/%s-%s", p.clientVersion.Name, p.clientVersion.Version, p.clientVersion.Commit)
+}
+
+func (p *Proxy) fetchClientVersion(ctx context.Context) (*engineClientVersion, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "engine_getClientVersionV1",
+		"params": []engineClientVersion{{
+			Code:    "TR",
+			Name:    tracoor.Implementation,
+			Version: tracoor.Release,
+			Commit:  tracoor.GitCommit,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, rspBody, err := p.forward(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp rpcResponse
+	if err := json.Unmarshal(rspBody, &rsp); err != nil {
+		return nil, err
+	}
+
+	if rsp.Error != nil {
+		return nil, fmt.Errorf("rpc error %d: %s", rsp.Error.Code, rsp.Error.Message)
+	}
+
+	var versions []engineClientVersion
+	if err := json.Unmarshal(rsp.Result, &versions); err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		return nil, errors.New("execution node returned no client versions")
+	}
+
+	return &versions[0], nil
+}
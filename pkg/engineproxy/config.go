@@ -0,0 +1,66 @@
+package engineproxy
+
+import (
+	"errors"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+	"github.com/ethpandaops/tracoor/pkg/agent/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+)
+
+type Config struct {
+	LoggingLevel string `yaml:"logging" default:"info"`
+	MetricsAddr  string `yaml:"metricsAddr" default:":9090"`
+
+	// The name of the proxy. Captured payloads are indexed with this as their node.
+	Name string `yaml:"name"`
+
+	// Network is the name of the network the execution node is on.
+	Network string `yaml:"network"`
+
+	// ListenAddr is the address the consensus client connects to in place of the execution node.
+	ListenAddr string `yaml:"listenAddr" default:":8551"`
+
+	// UpstreamAddress is the authenticated Engine API address of the execution node.
+	UpstreamAddress string `yaml:"upstreamAddress"`
+
+	// UpstreamTimeout is how long to wait for the execution node to respond to a request.
+	UpstreamTimeout human.Duration `yaml:"upstreamTimeout" default:"30s"`
+
+	// JWTSecretPath is the path to the hex encoded JWT secret shared by the consensus and execution clients.
+	JWTSecretPath string `yaml:"jwtSecretPath"`
+
+	// Indexer configuration
+	Indexer *indexer.Config `yaml:"indexer"`
+
+	// Store configuration
+	Store *store.Config `yaml:"store"`
+}
+
+func (c *Config) Validate() error {
+	if c.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if c.Network == "" {
+		return errors.New("network is required")
+	}
+
+	if c.UpstreamAddress == "" {
+		return errors.New("upstreamAddress is required")
+	}
+
+	if c.JWTSecretPath == "" {
+		return errors.New("jwtSecretPath is required")
+	}
+
+	if c.Indexer == nil {
+		return errors.New("indexer is required")
+	}
+
+	if c.Store == nil {
+		return errors.New("store is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package engineproxy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// jwtIssuedAtTolerance is how far the iat claim of a token may drift from
+// our clock, as specified by the Engine API authentication spec.
+const jwtIssuedAtTolerance = 60 * time.Second
+
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+type jwtClaims struct {
+	IssuedAt int64 `json:"iat"`
+}
+
+// loadJWTSecret reads a hex encoded 32 byte JWT secret from a file.
+func loadJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jwt secret: %w", err)
+	}
+
+	secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode jwt secret: %w", err)
+	}
+
+	if len(secret) != 32 {
+		return nil, fmt.Errorf("jwt secret must be 32 bytes, got %d", len(secret))
+	}
+
+	return secret, nil
+}
+
+// signJWT creates a HS256 token issued at the given time.
+func signJWT(secret []byte, issuedAt time.Time) (string, error) {
+	claims, err := json.Marshal(jwtClaims{IssuedAt: issuedAt.Unix()})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	return unsigned + "." + jwtSignature(secret, unsigned), nil
+}
+
+// verifyJWT checks a HS256 token was signed with the secret and issued close to now.
+func verifyJWT(token string, secret []byte, now time.Time) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return errors.New("malformed token")
+	}
+
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return fmt.Errorf("malformed token header: %w", err)
+	}
+
+	var h struct {
+		Alg string `json:"alg"`
+	}
+
+	if err := json.Unmarshal(header, &h); err != nil {
+		return fmt.Errorf("malformed token header: %w", err)
+	}
+
+	if h.Alg != "HS256" {
+		return fmt.Errorf("unsupported token algorithm %q", h.Alg)
+	}
+
+	if !hmac.Equal([]byte(parts[2]), []byte(jwtSignature(secret, parts[0]+"."+parts[1]))) {
+		return errors.New("invalid token signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return fmt.Errorf("malformed token claims: %w", err)
+	}
+
+	var claims jwtClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return fmt.Errorf("malformed token claims: %w", err)
+	}
+
+	drift := now.Sub(time.Unix(claims.IssuedAt, 0))
+	if drift > jwtIssuedAtTolerance || drift < -jwtIssuedAtTolerance {
+		return fmt.Errorf("token issued at %d is stale", claims.IssuedAt)
+	}
+
+	return nil
+}
+
+func jwtSignature(secret []byte, unsigned string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
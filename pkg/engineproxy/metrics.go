@@ -0,0 +1,69 @@
+package engineproxy
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type Metrics struct {
+	requests         *prometheus.CounterVec
+	unauthorized     *prometheus.CounterVec
+	invalidPayloads  *prometheus.CounterVec
+	capturedPayloads *prometheus.CounterVec
+}
+
+var (
+	metricsInstance *Metrics
+	once            sync.Once
+)
+
+func GetMetricsInstance(namespace string) *Metrics {
+	once.Do(func() {
+		metricsInstance = &Metrics{
+			requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "requests",
+				Help:      "The number of requests forwarded to the execution node",
+			}, []string{"method", "proxy"}),
+			unauthorized: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "unauthorized_requests",
+				Help:      "The number of requests rejected for not having a valid token",
+			}, []string{"proxy"}),
+			invalidPayloads: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "invalid_payloads",
+				Help:      "The number of requests the execution node responded to with an invalid payload status",
+			}, []string{"method", "status", "proxy"}),
+			capturedPayloads: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "captured_payloads",
+				Help:      "The number of invalid payloads captured and indexed",
+			}, []string{"proxy"}),
+		}
+
+		prometheus.MustRegister(metricsInstance.requests)
+		prometheus.MustRegister(metricsInstance.unauthorized)
+		prometheus.MustRegister(metricsInstance.invalidPayloads)
+		prometheus.MustRegister(metricsInstance.capturedPayloads)
+	})
+
+	return metricsInstance
+}
+
+func (m *Metrics) IncrementRequests(method, proxyName string) {
+	m.requests.WithLabelValues(method, proxyName).Inc()
+}
+
+func (m *Metrics) IncrementUnauthorized(proxyName string) {
+	m.unauthorized.WithLabelValues(proxyName).Inc()
+}
+
+func (m *Metrics) IncrementInvalidPayloads(method, status, proxyName string) {
+	m.invalidPayloads.WithLabelValues(method, status, proxyName).Inc()
+}
+
+func (m *Metrics) IncrementCapturedPayloads(proxyName string) {
+	m.capturedPayloads.WithLabelValues(proxyName).Inc()
+}
@@ -0,0 +1,345 @@
+package engineproxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ethpandaops/tracoor/pkg/agent/indexer"
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	"github.com/ethpandaops/tracoor/pkg/observability"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor"
+	pIndexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const namespace = "tracoor_engine_proxy"
+
+// maxRequestSize bounds the size of a request body from the consensus client.
+const maxRequestSize = 128 * 1024 * 1024
+
+// Payload statuses the execution node uses to reject a payload.
+const (
+	PayloadStatusInvalid          = "INVALID"
+	PayloadStatusInvalidBlockHash = "INVALID_BLOCK_HASH"
+)
+
+// badBlockIndexer is the subset of the indexer client the proxy uses.
+type badBlockIndexer interface {
+	PerformStorageHandshake(ctx context.Context, st store.Store, node string) error
+	CreateExecutionBadBlock(ctx context.Context, req *pIndexer.CreateExecutionBadBlockRequest) (*pIndexer.CreateExecutionBadBlockResponse, error)
+	ListExecutionBadBlock(ctx context.Context, req *pIndexer.ListExecutionBadBlockRequest) (*pIndexer.ListExecutionBadBlockResponse, error)
+}
+
+// Proxy sits between a consensus and execution client on the Engine API and
+// captures any payload the execution client rejects.
+type Proxy struct {
+	config *Config
+
+	log logrus.FieldLogger
+
+	metrics *Metrics
+
+	secret []byte
+
+	client *http.Client
+
+	indexer badBlockIndexer
+
+	store store.Store
+
+	compressor *compression.Compressor
+
+	clientVersionMu sync.Mutex
+	clientVersion   *engineClientVersion
+
+	// capturing holds the hashes of payloads that are currently being captured.
+	capturing sync.Map
+}
+
+type rpcRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type payloadStatus struct {
+	Status          string  `json:"status"`
+	LatestValidHash *string `json:"latestValidHash"`
+	ValidationError *string `json:"validationError"`
+}
+
+type forkchoiceUpdatedResult struct {
+	PayloadStatus payloadStatus `json:"payloadStatus"`
+}
+
+type forkchoiceState struct {
+	HeadBlockHash string `json:"headBlockHash"`
+}
+
+func New(ctx context.Context, log logrus.FieldLogger, config *Config) (*Proxy, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	secret, err := loadJWTSecret(config.JWTSecretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	indexerClient, err := indexer.NewClient(config.Indexer, log)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := store.NewStore(namespace, log, config.Store.Type, config.Store.Config, store.DefaultOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	return newProxy(log, config, secret, indexerClient, st), nil
+}
+
+func newProxy(log logrus.FieldLogger, config *Config, secret []byte, idx badBlockIndexer, st store.Store) *Proxy {
+	return &Proxy{
+		config:     config,
+		log:        log.WithField("module", "engine_proxy"),
+		metrics:    GetMetricsInstance(namespace),
+		secret:     secret,
+		client:     &http.Client{Timeout: config.UpstreamTimeout.Duration},
+		indexer:    idx,
+		store:      st,
+		compressor: compression.NewCompressor(),
+	}
+}
+
+func (p *Proxy) Start(ctx context.Context) error {
+	if p.config.MetricsAddr != "" {
+		observability.StartMetricsServer(ctx, p.config.MetricsAddr)
+	}
+
+	p.log.
+		WithField("version", tracoor.Full()).
+		WithField("upstream", p.config.UpstreamAddress).
+		Info("Starting tracoor in engine proxy mode")
+
+	if err := p.indexer.PerformStorageHandshake(ctx, p.store, p.config.Name); err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:              p.config.ListenAddr,
+		Handler:           p,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		p.log.WithField("addr", p.config.ListenAddr).Info("Serving engine API")
+
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			p.log.Fatal(err)
+		}
+	}()
+
+	cancel := make(chan os.Signal, 1)
+	signal.Notify(cancel, syscall.SIGTERM, syscall.SIGINT)
+
+	sig := <-cancel
+	p.log.Printf("Caught signal: %v", sig)
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer shutdownCancel()
+
+	return server.Shutdown(shutdownCtx)
+}
+
+// ServeHTTP validates the consensus client's token, forwards the request to
+// the execution node with a freshly signed token and inspects the response.
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		p.metrics.IncrementUnauthorized(p.config.Name)
+
+		http.Error(w, "missing token", http.StatusUnauthorized)
+
+		return
+	}
+
+	if err := verifyJWT(token, p.secret, time.Now()); err != nil {
+		p.metrics.IncrementUnauthorized(p.config.Name)
+
+		p.log.WithError(err).Debug("Rejected request with an invalid token")
+
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, "failed to read request", http.StatusBadRequest)
+
+		return
+	}
+
+	// Batches and malformed requests are forwarded as is for the execution node to deal with.
+	var req rpcRequest
+
+	_ = json.Unmarshal(body, &req)
+
+	status, header, rspBody, err := p.forward(r.Context(), body)
+	if err != nil {
+		p.log.WithError(err).WithField("method", req.Method).Error("Failed to forward request to the execution node")
+
+		http.Error(w, "failed to forward request", http.StatusBadGateway)
+
+		return
+	}
+
+	p.metrics.IncrementRequests(req.Method, p.config.Name)
+
+	if contentType := header.Get("Content-Type"); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+
+	w.WriteHeader(status)
+
+	if _, err := w.Write(rspBody); err != nil {
+		p.log.WithError(err).WithField("method", req.Method).Debug("Failed to write response to the consensus client")
+	}
+
+	if status == http.StatusOK {
+		p.inspect(r.Context(), &req, rspBody)
+	}
+}
+
+// forward sends a request body to the execution node.
+func (p *Proxy) forward(ctx context.Context, body []byte) (int, http.Header, []byte, error) {
+	token, err := signJWT(p.secret, time.Now())
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.UpstreamAddress, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	rsp, err := p.client.Do(req)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	defer rsp.Body.Close()
+
+	rspBody, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	return rsp.StatusCode, rsp.Header, rspBody, nil
+}
+
+// inspect looks for payloads the execution node rejected in a response.
+func (p *Proxy) inspect(ctx context.Context, req *rpcRequest, body []byte) {
+	isNewPayload := strings.HasPrefix(req.Method, "engine_newPayloadV")
+	isForkchoiceUpdated := strings.HasPrefix(req.Method, "engine_forkchoiceUpdatedV")
+
+	if !isNewPayload && !isForkchoiceUpdated {
+		return
+	}
+
+	var rsp rpcResponse
+	if err := json.Unmarshal(body, &rsp); err != nil || rsp.Error != nil || len(rsp.Result) == 0 {
+		return
+	}
+
+	var status payloadStatus
+
+	if isNewPayload {
+		if err := json.Unmarshal(rsp.Result, &status); err != nil {
+			return
+		}
+	} else {
+		var result forkchoiceUpdatedResult
+		if err := json.Unmarshal(rsp.Result, &result); err != nil {
+			return
+		}
+
+		status = result.PayloadStatus
+	}
+
+	if status.Status != PayloadStatusInvalid && status.Status != PayloadStatusInvalidBlockHash {
+		return
+	}
+
+	p.metrics.IncrementInvalidPayloads(req.Method, status.Status, p.config.Name)
+
+	if isForkchoiceUpdated {
+		// The request only references the head by hash so there's no payload to capture.
+		var state forkchoiceState
+		if len(req.Params) > 0 {
+			_ = json.Unmarshal(req.Params[0], &state)
+		}
+
+		p.log.
+			WithField("method", req.Method).
+			WithField("head_block_hash", state.HeadBlockHash).
+			WithField("validation_error", stringValue(status.ValidationError)).
+			Warn("Execution node rejected the fork choice head")
+
+		return
+	}
+
+	// Capture in the background so the consensus client isn't held up.
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 60*time.Second)
+		defer cancel()
+
+		if err := p.capture(ctx, req, &status); err != nil {
+			p.log.WithError(err).WithField("method", req.Method).Error("Failed to capture invalid payload")
+		}
+	}()
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
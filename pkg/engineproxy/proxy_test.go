@@ -0,0 +1,263 @@
+package engineproxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	validBlockHash   = "0x1111111111111111111111111111111111111111111111111111111111111111"
+	invalidBlockHash = "0x2222222222222222222222222222222222222222222222222222222222222222"
+)
+
+var testSecret = bytes.Repeat([]byte{0x42}, 32)
+
+type fakeIndexer struct {
+	mu      sync.Mutex
+	created []*indexer.CreateExecutionBadBlockRequest
+}
+
+func (f *fakeIndexer) PerformStorageHandshake(_ context.Context, _ store.Store, _ string) error {
+	return nil
+}
+
+func (f *fakeIndexer) CreateExecutionBadBlock(_ context.Context, req *indexer.CreateExecutionBadBlockRequest) (*indexer.CreateExecutionBadBlockResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.created = append(f.created, req)
+
+	return &indexer.CreateExecutionBadBlockResponse{Id: wrapperspb.String(fmt.Sprintf("%d", len(f.created)))}, nil
+}
+
+func (f *fakeIndexer) ListExecutionBadBlock(_ context.Context, req *indexer.ListExecutionBadBlockRequest) (*indexer.ListExecutionBadBlockResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	rsp := &indexer.ListExecutionBadBlockResponse{}
+
+	for _, created := range f.created {
+		if created.GetBlockHash().GetValue() == req.BlockHash {
+			rsp.ExecutionBadBlocks = append(rsp.ExecutionBadBlocks, &indexer.ExecutionBadBlock{BlockHash: created.BlockHash})
+		}
+	}
+
+	return rsp, nil
+}
+
+func (f *fakeIndexer) createdRequests() []*indexer.CreateExecutionBadBlockRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]*indexer.CreateExecutionBadBlockRequest{}, f.created...)
+}
+
+// newExecutionNode starts a stand-in execution node that rejects payloads
+// with invalidBlockHash and accepts everything else.
+func newExecutionNode(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if err := verifyJWT(token, testSecret, time.Now()); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+
+			return
+		}
+
+		var req rpcRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		var result string
+
+		switch {
+		case req.Method == "engine_getClientVersionV1":
+			result = `[{"code":"GE","name":"Geth","version":"1.15.0","commit":"abcd1234"}]`
+		case strings.HasPrefix(req.Method, "engine_newPayloadV"):
+			var header executionPayloadHeader
+			if err := json.Unmarshal(req.Params[0], &header); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+
+				return
+			}
+
+			if header.BlockHash == invalidBlockHash {
+				result = `{"status":"INVALID","latestValidHash":"` + validBlockHash + `","validationError":"invalid state root"}`
+			} else {
+				result = `{"status":"VALID","latestValidHash":"` + header.BlockHash + `","validationError":null}`
+			}
+		default:
+			result = `{"payloadStatus":{"status":"VALID","latestValidHash":null,"validationError":null},"payloadId":null}`
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, result)
+	}))
+}
+
+func newTestProxy(t *testing.T, upstream string) (*Proxy, *fakeIndexer, store.Store) {
+	t.Helper()
+
+	st, err := store.NewFSStore("test", logrus.New(), &store.FSStoreConfig{BasePath: t.TempDir()}, nil)
+	require.NoError(t, err)
+
+	idx := &fakeIndexer{}
+
+	config := &Config{
+		Name:            "proxy",
+		Network:         "testnet",
+		UpstreamAddress: upstream,
+		UpstreamTimeout: human.Duration{Duration: 5 * time.Second},
+	}
+
+	return newProxy(logrus.New(), config, testSecret, idx, st), idx, st
+}
+
+func newPayloadRequest(t *testing.T, blockHash string) *http.Request {
+	t.Helper()
+
+	body := `{"jsonrpc":"2.0","id":7,"method":"engine_newPayloadV3","params":[` +
+		`{"blockHash":"` + blockHash + `","blockNumber":"0x10","extraData":"0x747261636f6f72"},` +
+		`["0x01aa"],"0x3333333333333333333333333333333333333333333333333333333333333333"]}`
+
+	token, err := signJWT(testSecret, time.Now())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return req
+}
+
+func TestJWT(t *testing.T) {
+	now := time.Now()
+
+	token, err := signJWT(testSecret, now)
+	require.NoError(t, err)
+
+	require.NoError(t, verifyJWT(token, testSecret, now))
+	require.NoError(t, verifyJWT(token, testSecret, now.Add(30*time.Second)))
+
+	assert.Error(t, verifyJWT(token, bytes.Repeat([]byte{0x01}, 32), now), "wrong secret")
+	assert.Error(t, verifyJWT(token, testSecret, now.Add(2*time.Minute)), "stale token")
+	assert.Error(t, verifyJWT("not-a-token", testSecret, now), "malformed token")
+}
+
+func TestProxy_RejectsInvalidToken(t *testing.T) {
+	var requests atomic.Int32
+
+	upstream := newExecutionNode(t, &requests)
+	defer upstream.Close()
+
+	proxy, _, _ := newTestProxy(t, upstream.URL)
+
+	req := newPayloadRequest(t, validBlockHash)
+
+	token, err := signJWT(bytes.Repeat([]byte{0x01}, 32), time.Now())
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	rec := httptest.NewRecorder()
+	proxy.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, int32(0), requests.Load())
+}
+
+func TestProxy_ForwardsValidPayloads(t *testing.T) {
+	var requests atomic.Int32
+
+	upstream := newExecutionNode(t, &requests)
+	defer upstream.Close()
+
+	proxy, idx, _ := newTestProxy(t, upstream.URL)
+
+	rec := httptest.NewRecorder()
+	proxy.ServeHTTP(rec, newPayloadRequest(t, validBlockHash))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"status":"VALID"`)
+	assert.Contains(t, rec.Body.String(), `"id":7`)
+	assert.Equal(t, int32(1), requests.Load())
+	assert.Empty(t, idx.createdRequests())
+}
+
+func TestProxy_CapturesInvalidPayloads(t *testing.T) {
+	var requests atomic.Int32
+
+	upstream := newExecutionNode(t, &requests)
+	defer upstream.Close()
+
+	proxy, idx, st := newTestProxy(t, upstream.URL)
+
+	rec := httptest.NewRecorder()
+	proxy.ServeHTTP(rec, newPayloadRequest(t, invalidBlockHash))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"status":"INVALID"`)
+
+	require.Eventually(t, func() bool {
+		return len(idx.createdRequests()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	created := idx.createdRequests()[0]
+
+	assert.Equal(t, invalidBlockHash, created.GetBlockHash().GetValue())
+	assert.Equal(t, int64(16), created.GetBlockNumber().GetValue())
+	assert.Equal(t, "tracoor", created.GetBlockExtraData().GetValue())
+	assert.Equal(t, "geth", created.GetExecutionImplementation().GetValue())
+	assert.Equal(t, "Geth/1.15.0-abcd1234", created.GetNodeVersion().GetValue())
+	assert.Equal(t, "testnet", created.GetNetwork().GetValue())
+	assert.Equal(t, "proxy", created.GetNode().GetValue())
+
+	data, err := st.GetExecutionBadBlock(context.Background(), created.GetLocation().GetValue())
+	require.NoError(t, err)
+
+	decompressed, err := compression.NewCompressor().DecompressWithAlgorithm(data, compression.Gzip)
+	require.NoError(t, err)
+
+	var captured CapturedPayload
+
+	require.NoError(t, json.Unmarshal(decompressed, &captured))
+	assert.Equal(t, "engine_newPayloadV3", captured.Method)
+	assert.Equal(t, PayloadStatusInvalid, captured.Status)
+	assert.Equal(t, "invalid state root", *captured.ValidationError)
+	assert.JSONEq(t, `["0x01aa"]`, string(captured.ExpectedBlobVersionedHashes))
+	assert.JSONEq(t, `"0x3333333333333333333333333333333333333333333333333333333333333333"`, string(captured.ParentBeaconBlockRoot))
+
+	// A retry of the same payload isn't captured again.
+	rec = httptest.NewRecorder()
+	proxy.ServeHTTP(rec, newPayloadRequest(t, invalidBlockHash))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	time.Sleep(100 * time.Millisecond)
+
+	assert.Len(t, idx.createdRequests(), 1)
+}
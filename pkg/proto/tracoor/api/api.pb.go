@@ -80,7 +80,7 @@ func (x ListUniqueBeaconStateValuesRequest_Field) Number() protoreflect.EnumNumb
 
 // Deprecated: Use ListUniqueBeaconStateValuesRequest_Field.Descriptor instead.
 func (ListUniqueBeaconStateValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{19, 0}
+	return file_api_api_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type ListUniqueBeaconBlockValuesRequest_Field int32
@@ -141,7 +141,132 @@ func (x ListUniqueBeaconBlockValuesRequest_Field) Number() protoreflect.EnumNumb
 
 // Deprecated: Use ListUniqueBeaconBlockValuesRequest_Field.Descriptor instead.
 func (ListUniqueBeaconBlockValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{25, 0}
+	return file_api_api_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type ListUniqueBlobSidecarValuesRequest_Field int32
+
+const (
+	ListUniqueBlobSidecarValuesRequest_node                  ListUniqueBlobSidecarValuesRequest_Field = 0
+	ListUniqueBlobSidecarValuesRequest_slot                  ListUniqueBlobSidecarValuesRequest_Field = 1
+	ListUniqueBlobSidecarValuesRequest_epoch                 ListUniqueBlobSidecarValuesRequest_Field = 2
+	ListUniqueBlobSidecarValuesRequest_block_root            ListUniqueBlobSidecarValuesRequest_Field = 3
+	ListUniqueBlobSidecarValuesRequest_node_version          ListUniqueBlobSidecarValuesRequest_Field = 4
+	ListUniqueBlobSidecarValuesRequest_network               ListUniqueBlobSidecarValuesRequest_Field = 5
+	ListUniqueBlobSidecarValuesRequest_beacon_implementation ListUniqueBlobSidecarValuesRequest_Field = 6
+	ListUniqueBlobSidecarValuesRequest_sidecar_type          ListUniqueBlobSidecarValuesRequest_Field = 7
+)
+
+// Enum value maps for ListUniqueBlobSidecarValuesRequest_Field.
+var (
+	ListUniqueBlobSidecarValuesRequest_Field_name = map[int32]string{
+		0: "node",
+		1: "slot",
+		2: "epoch",
+		3: "block_root",
+		4: "node_version",
+		5: "network",
+		6: "beacon_implementation",
+		7: "sidecar_type",
+	}
+	ListUniqueBlobSidecarValuesRequest_Field_value = map[string]int32{
+		"node":                  0,
+		"slot":                  1,
+		"epoch":                 2,
+		"block_root":            3,
+		"node_version":          4,
+		"network":               5,
+		"beacon_implementation": 6,
+		"sidecar_type":          7,
+	}
+)
+
+func (x ListUniqueBlobSidecarValuesRequest_Field) Enum() *ListUniqueBlobSidecarValuesRequest_Field {
+	p := new(ListUniqueBlobSidecarValuesRequest_Field)
+	*p = x
+	return p
+}
+
+func (x ListUniqueBlobSidecarValuesRequest_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListUniqueBlobSidecarValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_api_proto_enumTypes[2].Descriptor()
+}
+
+func (ListUniqueBlobSidecarValuesRequest_Field) Type() protoreflect.EnumType {
+	return &file_api_api_proto_enumTypes[2]
+}
+
+func (x ListUniqueBlobSidecarValuesRequest_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListUniqueBlobSidecarValuesRequest_Field.Descriptor instead.
+func (ListUniqueBlobSidecarValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type ListUniqueForkChoiceValuesRequest_Field int32
+
+const (
+	ListUniqueForkChoiceValuesRequest_node                  ListUniqueForkChoiceValuesRequest_Field = 0
+	ListUniqueForkChoiceValuesRequest_slot                  ListUniqueForkChoiceValuesRequest_Field = 1
+	ListUniqueForkChoiceValuesRequest_epoch                 ListUniqueForkChoiceValuesRequest_Field = 2
+	ListUniqueForkChoiceValuesRequest_node_version          ListUniqueForkChoiceValuesRequest_Field = 3
+	ListUniqueForkChoiceValuesRequest_network               ListUniqueForkChoiceValuesRequest_Field = 4
+	ListUniqueForkChoiceValuesRequest_beacon_implementation ListUniqueForkChoiceValuesRequest_Field = 5
+	ListUniqueForkChoiceValuesRequest_reason                ListUniqueForkChoiceValuesRequest_Field = 6
+)
+
+// Enum value maps for ListUniqueForkChoiceValuesRequest_Field.
+var (
+	ListUniqueForkChoiceValuesRequest_Field_name = map[int32]string{
+		0: "node",
+		1: "slot",
+		2: "epoch",
+		3: "node_version",
+		4: "network",
+		5: "beacon_implementation",
+		6: "reason",
+	}
+	ListUniqueForkChoiceValuesRequest_Field_value = map[string]int32{
+		"node":                  0,
+		"slot":                  1,
+		"epoch":                 2,
+		"node_version":          3,
+		"network":               4,
+		"beacon_implementation": 5,
+		"reason":                6,
+	}
+)
+
+func (x ListUniqueForkChoiceValuesRequest_Field) Enum() *ListUniqueForkChoiceValuesRequest_Field {
+	p := new(ListUniqueForkChoiceValuesRequest_Field)
+	*p = x
+	return p
+}
+
+func (x ListUniqueForkChoiceValuesRequest_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListUniqueForkChoiceValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_api_proto_enumTypes[3].Descriptor()
+}
+
+func (ListUniqueForkChoiceValuesRequest_Field) Type() protoreflect.EnumType {
+	return &file_api_api_proto_enumTypes[3]
+}
+
+func (x ListUniqueForkChoiceValuesRequest_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListUniqueForkChoiceValuesRequest_Field.Descriptor instead.
+func (ListUniqueForkChoiceValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{43, 0}
 }
 
 type ListUniqueBeaconBadBlockValuesRequest_Field int32
@@ -189,11 +314,11 @@ func (x ListUniqueBeaconBadBlockValuesRequest_Field) String() string {
 }
 
 func (ListUniqueBeaconBadBlockValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_api_proto_enumTypes[2].Descriptor()
+	return file_api_api_proto_enumTypes[4].Descriptor()
 }
 
 func (ListUniqueBeaconBadBlockValuesRequest_Field) Type() protoreflect.EnumType {
-	return &file_api_api_proto_enumTypes[2]
+	return &file_api_api_proto_enumTypes[4]
 }
 
 func (x ListUniqueBeaconBadBlockValuesRequest_Field) Number() protoreflect.EnumNumber {
@@ -202,7 +327,7 @@ func (x ListUniqueBeaconBadBlockValuesRequest_Field) Number() protoreflect.EnumN
 
 // Deprecated: Use ListUniqueBeaconBadBlockValuesRequest_Field.Descriptor instead.
 func (ListUniqueBeaconBadBlockValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{31, 0}
+	return file_api_api_proto_rawDescGZIP(), []int{49, 0}
 }
 
 type ListUniqueBeaconBadBlobValuesRequest_Field int32
@@ -253,11 +378,11 @@ func (x ListUniqueBeaconBadBlobValuesRequest_Field) String() string {
 }
 
 func (ListUniqueBeaconBadBlobValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_api_proto_enumTypes[3].Descriptor()
+	return file_api_api_proto_enumTypes[5].Descriptor()
 }
 
 func (ListUniqueBeaconBadBlobValuesRequest_Field) Type() protoreflect.EnumType {
-	return &file_api_api_proto_enumTypes[3]
+	return &file_api_api_proto_enumTypes[5]
 }
 
 func (x ListUniqueBeaconBadBlobValuesRequest_Field) Number() protoreflect.EnumNumber {
@@ -266,7 +391,7 @@ func (x ListUniqueBeaconBadBlobValuesRequest_Field) Number() protoreflect.EnumNu
 
 // Deprecated: Use ListUniqueBeaconBadBlobValuesRequest_Field.Descriptor instead.
 func (ListUniqueBeaconBadBlobValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{37, 0}
+	return file_api_api_proto_rawDescGZIP(), []int{55, 0}
 }
 
 type ListUniqueExecutionBlockTraceValuesRequest_Field int32
@@ -278,6 +403,7 @@ const (
 	ListUniqueExecutionBlockTraceValuesRequest_network                  ListUniqueExecutionBlockTraceValuesRequest_Field = 3
 	ListUniqueExecutionBlockTraceValuesRequest_node_version             ListUniqueExecutionBlockTraceValuesRequest_Field = 4
 	ListUniqueExecutionBlockTraceValuesRequest_execution_implementation ListUniqueExecutionBlockTraceValuesRequest_Field = 5
+	ListUniqueExecutionBlockTraceValuesRequest_tracer                   ListUniqueExecutionBlockTraceValuesRequest_Field = 6
 )
 
 // Enum value maps for ListUniqueExecutionBlockTraceValuesRequest_Field.
@@ -289,6 +415,7 @@ var (
 		3: "network",
 		4: "node_version",
 		5: "execution_implementation",
+		6: "tracer",
 	}
 	ListUniqueExecutionBlockTraceValuesRequest_Field_value = map[string]int32{
 		"node":                     0,
@@ -297,6 +424,7 @@ var (
 		"network":                  3,
 		"node_version":             4,
 		"execution_implementation": 5,
+		"tracer":                   6,
 	}
 )
 
@@ -311,11 +439,11 @@ func (x ListUniqueExecutionBlockTraceValuesRequest_Field) String() string {
 }
 
 func (ListUniqueExecutionBlockTraceValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_api_proto_enumTypes[4].Descriptor()
+	return file_api_api_proto_enumTypes[6].Descriptor()
 }
 
 func (ListUniqueExecutionBlockTraceValuesRequest_Field) Type() protoreflect.EnumType {
-	return &file_api_api_proto_enumTypes[4]
+	return &file_api_api_proto_enumTypes[6]
 }
 
 func (x ListUniqueExecutionBlockTraceValuesRequest_Field) Number() protoreflect.EnumNumber {
@@ -324,7 +452,65 @@ func (x ListUniqueExecutionBlockTraceValuesRequest_Field) Number() protoreflect.
 
 // Deprecated: Use ListUniqueExecutionBlockTraceValuesRequest_Field.Descriptor instead.
 func (ListUniqueExecutionBlockTraceValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{43, 0}
+	return file_api_api_proto_rawDescGZIP(), []int{61, 0}
+}
+
+type ListUniqueExecutionWitnessValuesRequest_Field int32
+
+const (
+	ListUniqueExecutionWitnessValuesRequest_node                     ListUniqueExecutionWitnessValuesRequest_Field = 0
+	ListUniqueExecutionWitnessValuesRequest_block_hash               ListUniqueExecutionWitnessValuesRequest_Field = 1
+	ListUniqueExecutionWitnessValuesRequest_block_number             ListUniqueExecutionWitnessValuesRequest_Field = 2
+	ListUniqueExecutionWitnessValuesRequest_network                  ListUniqueExecutionWitnessValuesRequest_Field = 3
+	ListUniqueExecutionWitnessValuesRequest_node_version             ListUniqueExecutionWitnessValuesRequest_Field = 4
+	ListUniqueExecutionWitnessValuesRequest_execution_implementation ListUniqueExecutionWitnessValuesRequest_Field = 5
+)
+
+// Enum value maps for ListUniqueExecutionWitnessValuesRequest_Field.
+var (
+	ListUniqueExecutionWitnessValuesRequest_Field_name = map[int32]string{
+		0: "node",
+		1: "block_hash",
+		2: "block_number",
+		3: "network",
+		4: "node_version",
+		5: "execution_implementation",
+	}
+	ListUniqueExecutionWitnessValuesRequest_Field_value = map[string]int32{
+		"node":                     0,
+		"block_hash":               1,
+		"block_number":             2,
+		"network":                  3,
+		"node_version":             4,
+		"execution_implementation": 5,
+	}
+)
+
+func (x ListUniqueExecutionWitnessValuesRequest_Field) Enum() *ListUniqueExecutionWitnessValuesRequest_Field {
+	p := new(ListUniqueExecutionWitnessValuesRequest_Field)
+	*p = x
+	return p
+}
+
+func (x ListUniqueExecutionWitnessValuesRequest_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListUniqueExecutionWitnessValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_api_proto_enumTypes[7].Descriptor()
+}
+
+func (ListUniqueExecutionWitnessValuesRequest_Field) Type() protoreflect.EnumType {
+	return &file_api_api_proto_enumTypes[7]
+}
+
+func (x ListUniqueExecutionWitnessValuesRequest_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListUniqueExecutionWitnessValuesRequest_Field.Descriptor instead.
+func (ListUniqueExecutionWitnessValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{73, 0}
 }
 
 type ListUniqueExecutionBadBlockValuesRequest_Field int32
@@ -372,11 +558,11 @@ func (x ListUniqueExecutionBadBlockValuesRequest_Field) String() string {
 }
 
 func (ListUniqueExecutionBadBlockValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_api_proto_enumTypes[5].Descriptor()
+	return file_api_api_proto_enumTypes[8].Descriptor()
 }
 
 func (ListUniqueExecutionBadBlockValuesRequest_Field) Type() protoreflect.EnumType {
-	return &file_api_api_proto_enumTypes[5]
+	return &file_api_api_proto_enumTypes[8]
 }
 
 func (x ListUniqueExecutionBadBlockValuesRequest_Field) Number() protoreflect.EnumNumber {
@@ -385,7 +571,7 @@ func (x ListUniqueExecutionBadBlockValuesRequest_Field) Number() protoreflect.En
 
 // Deprecated: Use ListUniqueExecutionBadBlockValuesRequest_Field.Descriptor instead.
 func (ListUniqueExecutionBadBlockValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{49, 0}
+	return file_api_api_proto_rawDescGZIP(), []int{79, 0}
 }
 
 type BeaconState struct {
@@ -402,6 +588,9 @@ type BeaconState struct {
 	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=node_version,proto3" json:"node_version,omitempty"`
 	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
 	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Finalized            *wrapperspb.BoolValue   `protobuf:"bytes,10,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	CheckpointEpoch      *wrapperspb.UInt64Value `protobuf:"bytes,11,opt,name=checkpoint_epoch,proto3" json:"checkpoint_epoch,omitempty"`
+	Canonical            *wrapperspb.BoolValue   `protobuf:"bytes,12,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
 func (x *BeaconState) Reset() {
@@ -499,6 +688,27 @@ func (x *BeaconState) GetBeaconImplementation() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *BeaconState) GetFinalized() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Finalized
+	}
+	return nil
+}
+
+func (x *BeaconState) GetCheckpointEpoch() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.CheckpointEpoch
+	}
+	return nil
+}
+
+func (x *BeaconState) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
 type BeaconBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -513,6 +723,7 @@ type BeaconBlock struct {
 	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=node_version,proto3" json:"node_version,omitempty"`
 	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
 	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Canonical            *wrapperspb.BoolValue   `protobuf:"bytes,10,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
 func (x *BeaconBlock) Reset() {
@@ -610,7 +821,14 @@ func (x *BeaconBlock) GetBeaconImplementation() *wrapperspb.StringValue {
 	return nil
 }
 
-type BeaconBadBlock struct {
+func (x *BeaconBlock) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type BlobSidecar struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -624,10 +842,11 @@ type BeaconBadBlock struct {
 	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=node_version,proto3" json:"node_version,omitempty"`
 	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
 	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	SidecarType          *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=sidecar_type,proto3" json:"sidecar_type,omitempty"`
 }
 
-func (x *BeaconBadBlock) Reset() {
-	*x = BeaconBadBlock{}
+func (x *BlobSidecar) Reset() {
+	*x = BlobSidecar{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -635,13 +854,13 @@ func (x *BeaconBadBlock) Reset() {
 	}
 }
 
-func (x *BeaconBadBlock) String() string {
+func (x *BlobSidecar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BeaconBadBlock) ProtoMessage() {}
+func (*BlobSidecar) ProtoMessage() {}
 
-func (x *BeaconBadBlock) ProtoReflect() protoreflect.Message {
+func (x *BlobSidecar) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -653,75 +872,82 @@ func (x *BeaconBadBlock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BeaconBadBlock.ProtoReflect.Descriptor instead.
-func (*BeaconBadBlock) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlobSidecar.ProtoReflect.Descriptor instead.
+func (*BlobSidecar) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *BeaconBadBlock) GetId() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetId() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetNode() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetFetchedAt() *timestamppb.Timestamp {
+func (x *BlobSidecar) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.FetchedAt
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetSlot() *wrapperspb.UInt64Value {
+func (x *BlobSidecar) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Slot
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetEpoch() *wrapperspb.UInt64Value {
+func (x *BlobSidecar) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Epoch
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetBlockRoot() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetBlockRoot() *wrapperspb.StringValue {
 	if x != nil {
 		return x.BlockRoot
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetNodeVersion() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetNetwork() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Network
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetBeaconImplementation() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.BeaconImplementation
 	}
 	return nil
 }
 
-type BeaconBadBlob struct {
+func (x *BlobSidecar) GetSidecarType() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SidecarType
+	}
+	return nil
+}
+
+type ForkChoice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -731,15 +957,14 @@ type BeaconBadBlob struct {
 	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,proto3" json:"fetched_at,omitempty"`
 	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
 	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=block_root,proto3" json:"block_root,omitempty"`
-	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=node_version,proto3" json:"node_version,omitempty"`
-	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
-	Index                *wrapperspb.UInt64Value `protobuf:"bytes,10,opt,name=index,proto3" json:"index,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Reason               *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *BeaconBadBlob) Reset() {
-	*x = BeaconBadBlob{}
+func (x *ForkChoice) Reset() {
+	*x = ForkChoice{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -747,13 +972,13 @@ func (x *BeaconBadBlob) Reset() {
 	}
 }
 
-func (x *BeaconBadBlob) String() string {
+func (x *ForkChoice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BeaconBadBlob) ProtoMessage() {}
+func (*ForkChoice) ProtoMessage() {}
 
-func (x *BeaconBadBlob) ProtoReflect() protoreflect.Message {
+func (x *ForkChoice) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -765,98 +990,92 @@ func (x *BeaconBadBlob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BeaconBadBlob.ProtoReflect.Descriptor instead.
-func (*BeaconBadBlob) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForkChoice.ProtoReflect.Descriptor instead.
+func (*ForkChoice) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *BeaconBadBlob) GetId() *wrapperspb.StringValue {
+func (x *ForkChoice) GetId() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetNode() *wrapperspb.StringValue {
+func (x *ForkChoice) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetFetchedAt() *timestamppb.Timestamp {
+func (x *ForkChoice) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.FetchedAt
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetSlot() *wrapperspb.UInt64Value {
+func (x *ForkChoice) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Slot
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetEpoch() *wrapperspb.UInt64Value {
+func (x *ForkChoice) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Epoch
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetBlockRoot() *wrapperspb.StringValue {
-	if x != nil {
-		return x.BlockRoot
-	}
-	return nil
-}
-
-func (x *BeaconBadBlob) GetNodeVersion() *wrapperspb.StringValue {
+func (x *ForkChoice) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetNetwork() *wrapperspb.StringValue {
+func (x *ForkChoice) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Network
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetBeaconImplementation() *wrapperspb.StringValue {
+func (x *ForkChoice) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.BeaconImplementation
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetIndex() *wrapperspb.UInt64Value {
+func (x *ForkChoice) GetReason() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Index
+		return x.Reason
 	}
 	return nil
 }
 
-type ExecutionBlockTrace struct {
+type BeaconBadBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Node                    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,proto3" json:"fetched_at,omitempty"`
-	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
-	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=block_number,proto3" json:"block_number,omitempty"`
-	Network                 *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
-	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Id                   *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node                 *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,proto3" json:"fetched_at,omitempty"`
+	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
 }
 
-func (x *ExecutionBlockTrace) Reset() {
-	*x = ExecutionBlockTrace{}
+func (x *BeaconBadBlock) Reset() {
+	*x = BeaconBadBlock{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -864,13 +1083,13 @@ func (x *ExecutionBlockTrace) Reset() {
 	}
 }
 
-func (x *ExecutionBlockTrace) String() string {
+func (x *BeaconBadBlock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutionBlockTrace) ProtoMessage() {}
+func (*BeaconBadBlock) ProtoMessage() {}
 
-func (x *ExecutionBlockTrace) ProtoReflect() protoreflect.Message {
+func (x *BeaconBadBlock) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -882,85 +1101,93 @@ func (x *ExecutionBlockTrace) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutionBlockTrace.ProtoReflect.Descriptor instead.
-func (*ExecutionBlockTrace) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeaconBadBlock.ProtoReflect.Descriptor instead.
+func (*BeaconBadBlock) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ExecutionBlockTrace) GetId() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetId() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetNode() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetFetchedAt() *timestamppb.Timestamp {
+func (x *BeaconBadBlock) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.FetchedAt
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetBlockHash() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.BlockHash
+		return x.Slot
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetBlockNumber() *wrapperspb.Int64Value {
+func (x *BeaconBadBlock) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.BlockNumber
+		return x.Epoch
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetNetwork() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetBlockRoot() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Network
+		return x.BlockRoot
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetExecutionImplementation() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetNodeVersion() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
-		return x.NodeVersion
+		return x.Network
 	}
 	return nil
 }
 
-type ExecutionBadBlock struct {
+func (x *BeaconBadBlock) GetBeaconImplementation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return nil
+}
+
+type BeaconBadBlob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Node                    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,proto3" json:"fetched_at,omitempty"`
-	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
-	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=block_number,proto3" json:"block_number,omitempty"`
-	Network                 *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
-	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=node_version,proto3" json:"node_version,omitempty"`
-	BlockExtraData          *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=block_extra_data,proto3" json:"block_extra_data,omitempty"`
+	Id                   *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node                 *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,proto3" json:"fetched_at,omitempty"`
+	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Index                *wrapperspb.UInt64Value `protobuf:"bytes,10,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *ExecutionBadBlock) Reset() {
-	*x = ExecutionBadBlock{}
+func (x *BeaconBadBlob) Reset() {
+	*x = BeaconBadBlob{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -968,13 +1195,13 @@ func (x *ExecutionBadBlock) Reset() {
 	}
 }
 
-func (x *ExecutionBadBlock) String() string {
+func (x *BeaconBadBlob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutionBadBlock) ProtoMessage() {}
+func (*BeaconBadBlob) ProtoMessage() {}
 
-func (x *ExecutionBadBlock) ProtoReflect() protoreflect.Message {
+func (x *BeaconBadBlob) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -986,86 +1213,100 @@ func (x *ExecutionBadBlock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutionBadBlock.ProtoReflect.Descriptor instead.
-func (*ExecutionBadBlock) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeaconBadBlob.ProtoReflect.Descriptor instead.
+func (*BeaconBadBlob) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ExecutionBadBlock) GetId() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetId() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetNode() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetFetchedAt() *timestamppb.Timestamp {
+func (x *BeaconBadBlob) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.FetchedAt
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetBlockHash() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.BlockHash
+		return x.Slot
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetBlockNumber() *wrapperspb.Int64Value {
+func (x *BeaconBadBlob) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.BlockNumber
+		return x.Epoch
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetNetwork() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetBlockRoot() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Network
+		return x.BlockRoot
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetExecutionImplementation() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetNodeVersion() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
-		return x.NodeVersion
+		return x.Network
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetBlockExtraData() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BlockExtraData
+		return x.BeaconImplementation
 	}
 	return nil
 }
 
-type PaginationCursor struct {
+func (x *BeaconBadBlob) GetIndex() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+type ExecutionBlockTrace struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit   int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset  int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Id                      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node                    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,proto3" json:"fetched_at,omitempty"`
+	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=block_number,proto3" json:"block_number,omitempty"`
+	Network                 *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Tracer                  *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	Canonical               *wrapperspb.BoolValue   `protobuf:"bytes,10,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
-func (x *PaginationCursor) Reset() {
-	*x = PaginationCursor{}
+func (x *ExecutionBlockTrace) Reset() {
+	*x = ExecutionBlockTrace{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1073,13 +1314,13 @@ func (x *PaginationCursor) Reset() {
 	}
 }
 
-func (x *PaginationCursor) String() string {
+func (x *ExecutionBlockTrace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationCursor) ProtoMessage() {}
+func (*ExecutionBlockTrace) ProtoMessage() {}
 
-func (x *PaginationCursor) ProtoReflect() protoreflect.Message {
+func (x *ExecutionBlockTrace) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1091,95 +1332,113 @@ func (x *PaginationCursor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationCursor.ProtoReflect.Descriptor instead.
-func (*PaginationCursor) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecutionBlockTrace.ProtoReflect.Descriptor instead.
+func (*ExecutionBlockTrace) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PaginationCursor) GetLimit() int32 {
+func (x *ExecutionBlockTrace) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Limit
+		return x.Id
 	}
-	return 0
+	return nil
 }
 
-func (x *PaginationCursor) GetOffset() int32 {
+func (x *ExecutionBlockTrace) GetNode() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Offset
+		return x.Node
 	}
-	return 0
+	return nil
 }
 
-func (x *PaginationCursor) GetOrderBy() string {
+func (x *ExecutionBlockTrace) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OrderBy
+		return x.FetchedAt
 	}
-	return ""
+	return nil
 }
 
-type GetConfigRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ExecutionBlockTrace) GetBlockHash() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
 }
 
-func (x *GetConfigRequest) Reset() {
-	*x = GetConfigRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecutionBlockTrace) GetBlockNumber() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.BlockNumber
 	}
+	return nil
 }
 
-func (x *GetConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecutionBlockTrace) GetNetwork() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Network
+	}
+	return nil
 }
 
-func (*GetConfigRequest) ProtoMessage() {}
+func (x *ExecutionBlockTrace) GetExecutionImplementation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return nil
+}
 
-func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecutionBlockTrace) GetNodeVersion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NodeVersion
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetConfigRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{7}
+func (x *ExecutionBlockTrace) GetTracer() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Tracer
+	}
+	return nil
 }
 
-type GetConfigResponse struct {
+func (x *ExecutionBlockTrace) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type ExecutionWitness struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Id                      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node                    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,proto3" json:"fetched_at,omitempty"`
+	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=block_number,proto3" json:"block_number,omitempty"`
+	Network                 *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=node_version,proto3" json:"node_version,omitempty"`
 }
 
-func (x *GetConfigResponse) Reset() {
-	*x = GetConfigResponse{}
+func (x *ExecutionWitness) Reset() {
+	*x = ExecutionWitness{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[8]
+		mi := &file_api_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetConfigResponse) String() string {
+func (x *ExecutionWitness) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigResponse) ProtoMessage() {}
+func (*ExecutionWitness) ProtoMessage() {}
 
-func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[8]
+func (x *ExecutionWitness) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,91 +1449,102 @@ func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetConfigResponse) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ExecutionWitness.ProtoReflect.Descriptor instead.
+func (*ExecutionWitness) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetConfigResponse) GetConfig() *Config {
+func (x *ExecutionWitness) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Config
+		return x.Id
 	}
 	return nil
 }
 
-type Config struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Ethereum *EthereumConfig `protobuf:"bytes,1,opt,name=ethereum,proto3" json:"ethereum,omitempty"`
-}
-
-func (x *Config) Reset() {
-	*x = Config{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecutionWitness) GetNode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Node
 	}
+	return nil
 }
 
-func (x *Config) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Config) ProtoMessage() {}
-
-func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecutionWitness) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{9}
+	return nil
 }
 
-func (x *Config) GetEthereum() *EthereumConfig {
+func (x *ExecutionWitness) GetBlockHash() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Ethereum
+		return x.BlockHash
 	}
 	return nil
 }
 
-type EthereumConfig struct {
+func (x *ExecutionWitness) GetBlockNumber() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
+}
+
+func (x *ExecutionWitness) GetNetwork() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ExecutionWitness) GetExecutionImplementation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return nil
+}
+
+func (x *ExecutionWitness) GetNodeVersion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+type ExecutionBadBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *EthereumNetworkConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	Tools  *ToolsConfig           `protobuf:"bytes,2,opt,name=tools,proto3" json:"tools,omitempty"`
+	Id                      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node                    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,proto3" json:"fetched_at,omitempty"`
+	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=block_number,proto3" json:"block_number,omitempty"`
+	Network                 *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	BlockExtraData          *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=block_extra_data,proto3" json:"block_extra_data,omitempty"`
+	HasTrace                *wrapperspb.BoolValue   `protobuf:"bytes,10,opt,name=has_trace,proto3" json:"has_trace,omitempty"`
+	HasIntermediateRoots    *wrapperspb.BoolValue   `protobuf:"bytes,11,opt,name=has_intermediate_roots,proto3" json:"has_intermediate_roots,omitempty"`
 }
 
-func (x *EthereumConfig) Reset() {
-	*x = EthereumConfig{}
+func (x *ExecutionBadBlock) Reset() {
+	*x = ExecutionBadBlock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[10]
+		mi := &file_api_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EthereumConfig) String() string {
+func (x *ExecutionBadBlock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthereumConfig) ProtoMessage() {}
+func (*ExecutionBadBlock) ProtoMessage() {}
 
-func (x *EthereumConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[10]
+func (x *ExecutionBadBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,115 +1555,127 @@ func (x *EthereumConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthereumConfig.ProtoReflect.Descriptor instead.
-func (*EthereumConfig) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ExecutionBadBlock.ProtoReflect.Descriptor instead.
+func (*ExecutionBadBlock) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *EthereumConfig) GetConfig() *EthereumNetworkConfig {
+func (x *ExecutionBadBlock) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Config
+		return x.Id
 	}
 	return nil
 }
 
-func (x *EthereumConfig) GetTools() *ToolsConfig {
+func (x *ExecutionBadBlock) GetNode() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Tools
+		return x.Node
 	}
 	return nil
 }
 
-type EthereumNetworkConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
-	Branch     string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	Path       string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+func (x *ExecutionBadBlock) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
 }
 
-func (x *EthereumNetworkConfig) Reset() {
-	*x = EthereumNetworkConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecutionBadBlock) GetBlockHash() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BlockHash
 	}
+	return nil
 }
 
-func (x *EthereumNetworkConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecutionBadBlock) GetBlockNumber() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
 }
 
-func (*EthereumNetworkConfig) ProtoMessage() {}
+func (x *ExecutionBadBlock) GetNetwork() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
 
-func (x *EthereumNetworkConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecutionBadBlock) GetExecutionImplementation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ExecutionImplementation
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use EthereumNetworkConfig.ProtoReflect.Descriptor instead.
-func (*EthereumNetworkConfig) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{11}
+func (x *ExecutionBadBlock) GetNodeVersion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
 }
 
-func (x *EthereumNetworkConfig) GetRepository() string {
+func (x *ExecutionBadBlock) GetBlockExtraData() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Repository
+		return x.BlockExtraData
 	}
-	return ""
+	return nil
 }
 
-func (x *EthereumNetworkConfig) GetBranch() string {
+func (x *ExecutionBadBlock) GetHasTrace() *wrapperspb.BoolValue {
 	if x != nil {
-		return x.Branch
+		return x.HasTrace
 	}
-	return ""
+	return nil
 }
 
-func (x *EthereumNetworkConfig) GetPath() string {
+func (x *ExecutionBadBlock) GetHasIntermediateRoots() *wrapperspb.BoolValue {
 	if x != nil {
-		return x.Path
+		return x.HasIntermediateRoots
 	}
-	return ""
+	return nil
 }
 
-type ToolsConfig struct {
+type StateDivergence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ncli *GitRepositoryConfig `protobuf:"bytes,1,opt,name=ncli,proto3" json:"ncli,omitempty"`
-	Lcli *GitRepositoryConfig `protobuf:"bytes,2,opt,name=lcli,proto3" json:"lcli,omitempty"`
-	Zcli *ZcliConfig          `protobuf:"bytes,3,opt,name=zcli,proto3" json:"zcli,omitempty"`
-}
-
-func (x *ToolsConfig) Reset() {
-	*x = ToolsConfig{}
+	Id                              *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Network                         *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Slot                            *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                           *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	DetectedAt                      *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=detected_at,proto3" json:"detected_at,omitempty"`
+	BeaconStateId                   *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=beacon_state_id,proto3" json:"beacon_state_id,omitempty"`
+	Node                            *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=node,proto3" json:"node,omitempty"`
+	StateRoot                       *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=state_root,proto3" json:"state_root,omitempty"`
+	BeaconImplementation            *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	NodeVersion                     *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	ConflictingBeaconStateId        *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=conflicting_beacon_state_id,proto3" json:"conflicting_beacon_state_id,omitempty"`
+	ConflictingNode                 *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=conflicting_node,proto3" json:"conflicting_node,omitempty"`
+	ConflictingStateRoot            *wrapperspb.StringValue `protobuf:"bytes,13,opt,name=conflicting_state_root,proto3" json:"conflicting_state_root,omitempty"`
+	ConflictingBeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,14,opt,name=conflicting_beacon_implementation,proto3" json:"conflicting_beacon_implementation,omitempty"`
+	ConflictingNodeVersion          *wrapperspb.StringValue `protobuf:"bytes,15,opt,name=conflicting_node_version,proto3" json:"conflicting_node_version,omitempty"`
+}
+
+func (x *StateDivergence) Reset() {
+	*x = StateDivergence{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[12]
+		mi := &file_api_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ToolsConfig) String() string {
+func (x *StateDivergence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToolsConfig) ProtoMessage() {}
+func (*StateDivergence) ProtoMessage() {}
 
-func (x *ToolsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[12]
+func (x *StateDivergence) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,112 +1686,143 @@ func (x *ToolsConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToolsConfig.ProtoReflect.Descriptor instead.
-func (*ToolsConfig) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use StateDivergence.ProtoReflect.Descriptor instead.
+func (*StateDivergence) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ToolsConfig) GetNcli() *GitRepositoryConfig {
+func (x *StateDivergence) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Ncli
+		return x.Id
 	}
 	return nil
 }
 
-func (x *ToolsConfig) GetLcli() *GitRepositoryConfig {
+func (x *StateDivergence) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Lcli
+		return x.Network
 	}
 	return nil
 }
 
-func (x *ToolsConfig) GetZcli() *ZcliConfig {
+func (x *StateDivergence) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.Zcli
+		return x.Slot
 	}
 	return nil
 }
 
-type GitRepositoryConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *StateDivergence) GetEpoch() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
 
-	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
-	Branch     string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+func (x *StateDivergence) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
 }
 
-func (x *GitRepositoryConfig) Reset() {
-	*x = GitRepositoryConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StateDivergence) GetBeaconStateId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BeaconStateId
 	}
+	return nil
 }
 
-func (x *GitRepositoryConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StateDivergence) GetNode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Node
+	}
+	return nil
 }
 
-func (*GitRepositoryConfig) ProtoMessage() {}
+func (x *StateDivergence) GetStateRoot() *wrapperspb.StringValue {
+	if x != nil {
+		return x.StateRoot
+	}
+	return nil
+}
 
-func (x *GitRepositoryConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StateDivergence) GetBeaconImplementation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BeaconImplementation
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GitRepositoryConfig.ProtoReflect.Descriptor instead.
-func (*GitRepositoryConfig) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{13}
+func (x *StateDivergence) GetNodeVersion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
 }
 
-func (x *GitRepositoryConfig) GetRepository() string {
+func (x *StateDivergence) GetConflictingBeaconStateId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Repository
+		return x.ConflictingBeaconStateId
 	}
-	return ""
+	return nil
 }
 
-func (x *GitRepositoryConfig) GetBranch() string {
+func (x *StateDivergence) GetConflictingNode() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Branch
+		return x.ConflictingNode
 	}
-	return ""
+	return nil
 }
 
-type ZcliConfig struct {
+func (x *StateDivergence) GetConflictingStateRoot() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ConflictingStateRoot
+	}
+	return nil
+}
+
+func (x *StateDivergence) GetConflictingBeaconImplementation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ConflictingBeaconImplementation
+	}
+	return nil
+}
+
+func (x *StateDivergence) GetConflictingNodeVersion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ConflictingNodeVersion
+	}
+	return nil
+}
+
+type PaginationCursor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fork string `protobuf:"bytes,1,opt,name=fork,proto3" json:"fork,omitempty"`
+	Limit   int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset  int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 }
 
-func (x *ZcliConfig) Reset() {
-	*x = ZcliConfig{}
+func (x *PaginationCursor) Reset() {
+	*x = PaginationCursor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[14]
+		mi := &file_api_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ZcliConfig) String() string {
+func (x *PaginationCursor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ZcliConfig) ProtoMessage() {}
+func (*PaginationCursor) ProtoMessage() {}
 
-func (x *ZcliConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[14]
+func (x *PaginationCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,53 +1833,55 @@ func (x *ZcliConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ZcliConfig.ProtoReflect.Descriptor instead.
-func (*ZcliConfig) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use PaginationCursor.ProtoReflect.Descriptor instead.
+func (*PaginationCursor) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ZcliConfig) GetFork() string {
+func (x *PaginationCursor) GetLimit() int32 {
 	if x != nil {
-		return x.Fork
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PaginationCursor) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PaginationCursor) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
 	}
 	return ""
 }
 
-type ListBeaconStateRequest struct {
+type GetConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	StateRoot            string                 `protobuf:"bytes,4,opt,name=state_root,proto3" json:"state_root,omitempty"`
-	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
-	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
-	Before               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
-	Pagination           *PaginationCursor      `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
-	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListBeaconStateRequest) Reset() {
-	*x = ListBeaconStateRequest{}
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[15]
+		mi := &file_api_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBeaconStateRequest) String() string {
+func (x *GetConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconStateRequest) ProtoMessage() {}
+func (*GetConfigRequest) ProtoMessage() {}
 
-func (x *ListBeaconStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[15]
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,113 +1892,131 @@ func (x *ListBeaconStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconStateRequest.ProtoReflect.Descriptor instead.
-func (*ListBeaconStateRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListBeaconStateRequest) GetNode() string {
-	if x != nil {
-		return x.Node
-	}
-	return ""
-}
+type GetConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ListBeaconStateRequest) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
-	}
-	return 0
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *ListBeaconStateRequest) GetEpoch() uint64 {
-	if x != nil {
-		return x.Epoch
+func (x *GetConfigResponse) Reset() {
+	*x = GetConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ListBeaconStateRequest) GetStateRoot() string {
-	if x != nil {
-		return x.StateRoot
-	}
-	return ""
+func (x *GetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListBeaconStateRequest) GetNodeVersion() string {
-	if x != nil {
-		return x.NodeVersion
+func (*GetConfigResponse) ProtoMessage() {}
+
+func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListBeaconStateRequest) GetNetwork() string {
-	if x != nil {
-		return x.Network
-	}
-	return ""
+// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListBeaconStateRequest) GetBefore() *timestamppb.Timestamp {
+func (x *GetConfigResponse) GetConfig() *Config {
 	if x != nil {
-		return x.Before
+		return x.Config
 	}
 	return nil
 }
 
-func (x *ListBeaconStateRequest) GetAfter() *timestamppb.Timestamp {
-	if x != nil {
-		return x.After
-	}
-	return nil
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ethereum *EthereumConfig `protobuf:"bytes,1,opt,name=ethereum,proto3" json:"ethereum,omitempty"`
 }
 
-func (x *ListBeaconStateRequest) GetPagination() *PaginationCursor {
-	if x != nil {
-		return x.Pagination
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ListBeaconStateRequest) GetBeaconImplementation() string {
-	if x != nil {
-		return x.BeaconImplementation
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListBeaconStateRequest) GetId() string {
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Config) GetEthereum() *EthereumConfig {
 	if x != nil {
-		return x.Id
+		return x.Ethereum
 	}
-	return ""
+	return nil
 }
 
-type ListBeaconStateResponse struct {
+type EthereumConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BeaconStates []*BeaconState `protobuf:"bytes,1,rep,name=beacon_states,proto3" json:"beacon_states,omitempty"`
+	Config *EthereumNetworkConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Tools  *ToolsConfig           `protobuf:"bytes,2,opt,name=tools,proto3" json:"tools,omitempty"`
 }
 
-func (x *ListBeaconStateResponse) Reset() {
-	*x = ListBeaconStateResponse{}
+func (x *EthereumConfig) Reset() {
+	*x = EthereumConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[16]
+		mi := &file_api_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBeaconStateResponse) String() string {
+func (x *EthereumConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconStateResponse) ProtoMessage() {}
+func (*EthereumConfig) ProtoMessage() {}
 
-func (x *ListBeaconStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[16]
+func (x *EthereumConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1694,51 +2027,52 @@ func (x *ListBeaconStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconStateResponse.ProtoReflect.Descriptor instead.
-func (*ListBeaconStateResponse) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use EthereumConfig.ProtoReflect.Descriptor instead.
+func (*EthereumConfig) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListBeaconStateResponse) GetBeaconStates() []*BeaconState {
+func (x *EthereumConfig) GetConfig() *EthereumNetworkConfig {
 	if x != nil {
-		return x.BeaconStates
+		return x.Config
 	}
 	return nil
 }
 
-type CountBeaconStateRequest struct {
+func (x *EthereumConfig) GetTools() *ToolsConfig {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+type EthereumNetworkConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	StateRoot            string                 `protobuf:"bytes,4,opt,name=state_root,proto3" json:"state_root,omitempty"`
-	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
-	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation string                 `protobuf:"bytes,7,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
-	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Branch     string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Path       string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *CountBeaconStateRequest) Reset() {
-	*x = CountBeaconStateRequest{}
+func (x *EthereumNetworkConfig) Reset() {
+	*x = EthereumNetworkConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[17]
+		mi := &file_api_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountBeaconStateRequest) String() string {
+func (x *EthereumNetworkConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconStateRequest) ProtoMessage() {}
+func (*EthereumNetworkConfig) ProtoMessage() {}
 
-func (x *CountBeaconStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[17]
+func (x *EthereumNetworkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1749,99 +2083,59 @@ func (x *CountBeaconStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconStateRequest.ProtoReflect.Descriptor instead.
-func (*CountBeaconStateRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use EthereumNetworkConfig.ProtoReflect.Descriptor instead.
+func (*EthereumNetworkConfig) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CountBeaconStateRequest) GetNode() string {
+func (x *EthereumNetworkConfig) GetRepository() string {
 	if x != nil {
-		return x.Node
+		return x.Repository
 	}
 	return ""
 }
 
-func (x *CountBeaconStateRequest) GetSlot() uint64 {
+func (x *EthereumNetworkConfig) GetBranch() string {
 	if x != nil {
-		return x.Slot
+		return x.Branch
 	}
-	return 0
+	return ""
 }
 
-func (x *CountBeaconStateRequest) GetEpoch() uint64 {
+func (x *EthereumNetworkConfig) GetPath() string {
 	if x != nil {
-		return x.Epoch
-	}
-	return 0
-}
-
-func (x *CountBeaconStateRequest) GetStateRoot() string {
-	if x != nil {
-		return x.StateRoot
-	}
-	return ""
-}
-
-func (x *CountBeaconStateRequest) GetNodeVersion() string {
-	if x != nil {
-		return x.NodeVersion
-	}
-	return ""
-}
-
-func (x *CountBeaconStateRequest) GetNetwork() string {
-	if x != nil {
-		return x.Network
-	}
-	return ""
-}
-
-func (x *CountBeaconStateRequest) GetBeaconImplementation() string {
-	if x != nil {
-		return x.BeaconImplementation
+		return x.Path
 	}
 	return ""
 }
 
-func (x *CountBeaconStateRequest) GetBefore() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Before
-	}
-	return nil
-}
-
-func (x *CountBeaconStateRequest) GetAfter() *timestamppb.Timestamp {
-	if x != nil {
-		return x.After
-	}
-	return nil
-}
-
-type CountBeaconStateResponse struct {
+type ToolsConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+	Ncli *GitRepositoryConfig `protobuf:"bytes,1,opt,name=ncli,proto3" json:"ncli,omitempty"`
+	Lcli *GitRepositoryConfig `protobuf:"bytes,2,opt,name=lcli,proto3" json:"lcli,omitempty"`
+	Zcli *ZcliConfig          `protobuf:"bytes,3,opt,name=zcli,proto3" json:"zcli,omitempty"`
 }
 
-func (x *CountBeaconStateResponse) Reset() {
-	*x = CountBeaconStateResponse{}
+func (x *ToolsConfig) Reset() {
+	*x = ToolsConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[18]
+		mi := &file_api_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountBeaconStateResponse) String() string {
+func (x *ToolsConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconStateResponse) ProtoMessage() {}
+func (*ToolsConfig) ProtoMessage() {}
 
-func (x *CountBeaconStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[18]
+func (x *ToolsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1852,43 +2146,58 @@ func (x *CountBeaconStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconStateResponse.ProtoReflect.Descriptor instead.
-func (*CountBeaconStateResponse) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ToolsConfig.ProtoReflect.Descriptor instead.
+func (*ToolsConfig) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CountBeaconStateResponse) GetCount() *wrapperspb.UInt64Value {
+func (x *ToolsConfig) GetNcli() *GitRepositoryConfig {
 	if x != nil {
-		return x.Count
+		return x.Ncli
 	}
 	return nil
 }
 
-type ListUniqueBeaconStateValuesRequest struct {
+func (x *ToolsConfig) GetLcli() *GitRepositoryConfig {
+	if x != nil {
+		return x.Lcli
+	}
+	return nil
+}
+
+func (x *ToolsConfig) GetZcli() *ZcliConfig {
+	if x != nil {
+		return x.Zcli
+	}
+	return nil
+}
+
+type GitRepositoryConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueBeaconStateValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueBeaconStateValuesRequest_Field" json:"fields,omitempty"`
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Branch     string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
 }
 
-func (x *ListUniqueBeaconStateValuesRequest) Reset() {
-	*x = ListUniqueBeaconStateValuesRequest{}
+func (x *GitRepositoryConfig) Reset() {
+	*x = GitRepositoryConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[19]
+		mi := &file_api_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUniqueBeaconStateValuesRequest) String() string {
+func (x *GitRepositoryConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconStateValuesRequest) ProtoMessage() {}
+func (*GitRepositoryConfig) ProtoMessage() {}
 
-func (x *ListUniqueBeaconStateValuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[19]
+func (x *GitRepositoryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1899,49 +2208,50 @@ func (x *ListUniqueBeaconStateValuesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconStateValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconStateValuesRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GitRepositoryConfig.ProtoReflect.Descriptor instead.
+func (*GitRepositoryConfig) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListUniqueBeaconStateValuesRequest) GetFields() []ListUniqueBeaconStateValuesRequest_Field {
+func (x *GitRepositoryConfig) GetRepository() string {
 	if x != nil {
-		return x.Fields
+		return x.Repository
 	}
-	return nil
+	return ""
 }
 
-type ListUniqueBeaconStateValuesResponse struct {
+func (x *GitRepositoryConfig) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type ZcliConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
-	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
-	StateRoot            []string `protobuf:"bytes,4,rep,name=state_root,proto3" json:"state_root,omitempty"`
-	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
-	Network              []string `protobuf:"bytes,6,rep,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation []string `protobuf:"bytes,7,rep,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Fork string `protobuf:"bytes,1,opt,name=fork,proto3" json:"fork,omitempty"`
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) Reset() {
-	*x = ListUniqueBeaconStateValuesResponse{}
+func (x *ZcliConfig) Reset() {
+	*x = ZcliConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[20]
+		mi := &file_api_api_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) String() string {
+func (x *ZcliConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconStateValuesResponse) ProtoMessage() {}
+func (*ZcliConfig) ProtoMessage() {}
 
-func (x *ListUniqueBeaconStateValuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[20]
+func (x *ZcliConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1952,61 +2262,19 @@ func (x *ListUniqueBeaconStateValuesResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconStateValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconStateValuesResponse) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *ListUniqueBeaconStateValuesResponse) GetNode() []string {
-	if x != nil {
-		return x.Node
-	}
-	return nil
-}
-
-func (x *ListUniqueBeaconStateValuesResponse) GetSlot() []uint64 {
-	if x != nil {
-		return x.Slot
-	}
-	return nil
-}
-
-func (x *ListUniqueBeaconStateValuesResponse) GetEpoch() []uint64 {
-	if x != nil {
-		return x.Epoch
-	}
-	return nil
-}
-
-func (x *ListUniqueBeaconStateValuesResponse) GetStateRoot() []string {
-	if x != nil {
-		return x.StateRoot
-	}
-	return nil
-}
-
-func (x *ListUniqueBeaconStateValuesResponse) GetNodeVersion() []string {
-	if x != nil {
-		return x.NodeVersion
-	}
-	return nil
-}
-
-func (x *ListUniqueBeaconStateValuesResponse) GetNetwork() []string {
-	if x != nil {
-		return x.Network
-	}
-	return nil
+// Deprecated: Use ZcliConfig.ProtoReflect.Descriptor instead.
+func (*ZcliConfig) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) GetBeaconImplementation() []string {
+func (x *ZcliConfig) GetFork() string {
 	if x != nil {
-		return x.BeaconImplementation
+		return x.Fork
 	}
-	return nil
+	return ""
 }
 
-type ListBeaconBlockRequest struct {
+type ListBeaconStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2014,7 +2282,7 @@ type ListBeaconBlockRequest struct {
 	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
 	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
+	StateRoot            string                 `protobuf:"bytes,4,opt,name=state_root,proto3" json:"state_root,omitempty"`
 	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
 	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
 	Before               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
@@ -2022,25 +2290,28 @@ type ListBeaconBlockRequest struct {
 	Pagination           *PaginationCursor      `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
 	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Finalized            *wrapperspb.BoolValue  `protobuf:"bytes,12,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	CheckpointEpoch      uint64                 `protobuf:"varint,13,opt,name=checkpoint_epoch,proto3" json:"checkpoint_epoch,omitempty"`
+	Canonical            *wrapperspb.BoolValue  `protobuf:"bytes,14,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
-func (x *ListBeaconBlockRequest) Reset() {
-	*x = ListBeaconBlockRequest{}
+func (x *ListBeaconStateRequest) Reset() {
+	*x = ListBeaconStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[21]
+		mi := &file_api_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBeaconBlockRequest) String() string {
+func (x *ListBeaconStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBlockRequest) ProtoMessage() {}
+func (*ListBeaconStateRequest) ProtoMessage() {}
 
-func (x *ListBeaconBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[21]
+func (x *ListBeaconStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,113 +2322,134 @@ func (x *ListBeaconBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBlockRequest.ProtoReflect.Descriptor instead.
-func (*ListBeaconBlockRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListBeaconStateRequest.ProtoReflect.Descriptor instead.
+func (*ListBeaconStateRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListBeaconBlockRequest) GetNode() string {
+func (x *ListBeaconStateRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
 	return ""
 }
 
-func (x *ListBeaconBlockRequest) GetSlot() uint64 {
+func (x *ListBeaconStateRequest) GetSlot() uint64 {
 	if x != nil {
 		return x.Slot
 	}
 	return 0
 }
 
-func (x *ListBeaconBlockRequest) GetEpoch() uint64 {
+func (x *ListBeaconStateRequest) GetEpoch() uint64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return 0
 }
 
-func (x *ListBeaconBlockRequest) GetBlockRoot() string {
+func (x *ListBeaconStateRequest) GetStateRoot() string {
 	if x != nil {
-		return x.BlockRoot
+		return x.StateRoot
 	}
 	return ""
 }
 
-func (x *ListBeaconBlockRequest) GetNodeVersion() string {
+func (x *ListBeaconStateRequest) GetNodeVersion() string {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return ""
 }
 
-func (x *ListBeaconBlockRequest) GetNetwork() string {
+func (x *ListBeaconStateRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
 	return ""
 }
 
-func (x *ListBeaconBlockRequest) GetBefore() *timestamppb.Timestamp {
+func (x *ListBeaconStateRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-func (x *ListBeaconBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *ListBeaconStateRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
 		return x.After
 	}
 	return nil
 }
 
-func (x *ListBeaconBlockRequest) GetPagination() *PaginationCursor {
+func (x *ListBeaconStateRequest) GetPagination() *PaginationCursor {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-func (x *ListBeaconBlockRequest) GetBeaconImplementation() string {
+func (x *ListBeaconStateRequest) GetBeaconImplementation() string {
 	if x != nil {
 		return x.BeaconImplementation
 	}
 	return ""
 }
 
-func (x *ListBeaconBlockRequest) GetId() string {
+func (x *ListBeaconStateRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListBeaconBlockResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ListBeaconStateRequest) GetFinalized() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Finalized
+	}
+	return nil
+}
 
-	BeaconBlocks []*BeaconBlock `protobuf:"bytes,1,rep,name=beacon_blocks,proto3" json:"beacon_blocks,omitempty"`
+func (x *ListBeaconStateRequest) GetCheckpointEpoch() uint64 {
+	if x != nil {
+		return x.CheckpointEpoch
+	}
+	return 0
 }
 
-func (x *ListBeaconBlockResponse) Reset() {
-	*x = ListBeaconBlockResponse{}
+func (x *ListBeaconStateRequest) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type ListBeaconStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BeaconStates []*BeaconState `protobuf:"bytes,1,rep,name=beacon_states,proto3" json:"beacon_states,omitempty"`
+}
+
+func (x *ListBeaconStateResponse) Reset() {
+	*x = ListBeaconStateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[22]
+		mi := &file_api_api_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBeaconBlockResponse) String() string {
+func (x *ListBeaconStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBlockResponse) ProtoMessage() {}
+func (*ListBeaconStateResponse) ProtoMessage() {}
 
-func (x *ListBeaconBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[22]
+func (x *ListBeaconStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2168,19 +2460,19 @@ func (x *ListBeaconBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBlockResponse.ProtoReflect.Descriptor instead.
-func (*ListBeaconBlockResponse) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListBeaconStateResponse.ProtoReflect.Descriptor instead.
+func (*ListBeaconStateResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListBeaconBlockResponse) GetBeaconBlocks() []*BeaconBlock {
+func (x *ListBeaconStateResponse) GetBeaconStates() []*BeaconState {
 	if x != nil {
-		return x.BeaconBlocks
+		return x.BeaconStates
 	}
 	return nil
 }
 
-type CountBeaconBlockRequest struct {
+type CountBeaconStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2188,31 +2480,34 @@ type CountBeaconBlockRequest struct {
 	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
 	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
+	StateRoot            string                 `protobuf:"bytes,4,opt,name=state_root,proto3" json:"state_root,omitempty"`
 	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
 	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
 	BeaconImplementation string                 `protobuf:"bytes,7,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
 	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
 	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	Finalized            *wrapperspb.BoolValue  `protobuf:"bytes,10,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	CheckpointEpoch      uint64                 `protobuf:"varint,11,opt,name=checkpoint_epoch,proto3" json:"checkpoint_epoch,omitempty"`
+	Canonical            *wrapperspb.BoolValue  `protobuf:"bytes,12,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
-func (x *CountBeaconBlockRequest) Reset() {
-	*x = CountBeaconBlockRequest{}
+func (x *CountBeaconStateRequest) Reset() {
+	*x = CountBeaconStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[23]
+		mi := &file_api_api_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountBeaconBlockRequest) String() string {
+func (x *CountBeaconStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconBlockRequest) ProtoMessage() {}
+func (*CountBeaconStateRequest) ProtoMessage() {}
 
-func (x *CountBeaconBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[23]
+func (x *CountBeaconStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,75 +2518,96 @@ func (x *CountBeaconBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconBlockRequest.ProtoReflect.Descriptor instead.
-func (*CountBeaconBlockRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use CountBeaconStateRequest.ProtoReflect.Descriptor instead.
+func (*CountBeaconStateRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CountBeaconBlockRequest) GetNode() string {
+func (x *CountBeaconStateRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
 	return ""
 }
 
-func (x *CountBeaconBlockRequest) GetSlot() uint64 {
+func (x *CountBeaconStateRequest) GetSlot() uint64 {
 	if x != nil {
 		return x.Slot
 	}
 	return 0
 }
 
-func (x *CountBeaconBlockRequest) GetEpoch() uint64 {
+func (x *CountBeaconStateRequest) GetEpoch() uint64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return 0
 }
 
-func (x *CountBeaconBlockRequest) GetBlockRoot() string {
+func (x *CountBeaconStateRequest) GetStateRoot() string {
 	if x != nil {
-		return x.BlockRoot
+		return x.StateRoot
 	}
 	return ""
 }
 
-func (x *CountBeaconBlockRequest) GetNodeVersion() string {
+func (x *CountBeaconStateRequest) GetNodeVersion() string {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return ""
 }
 
-func (x *CountBeaconBlockRequest) GetNetwork() string {
+func (x *CountBeaconStateRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
 	return ""
 }
 
-func (x *CountBeaconBlockRequest) GetBeaconImplementation() string {
+func (x *CountBeaconStateRequest) GetBeaconImplementation() string {
 	if x != nil {
 		return x.BeaconImplementation
 	}
 	return ""
 }
 
-func (x *CountBeaconBlockRequest) GetBefore() *timestamppb.Timestamp {
+func (x *CountBeaconStateRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-func (x *CountBeaconBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *CountBeaconStateRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
 		return x.After
 	}
 	return nil
 }
 
-type CountBeaconBlockResponse struct {
+func (x *CountBeaconStateRequest) GetFinalized() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Finalized
+	}
+	return nil
+}
+
+func (x *CountBeaconStateRequest) GetCheckpointEpoch() uint64 {
+	if x != nil {
+		return x.CheckpointEpoch
+	}
+	return 0
+}
+
+func (x *CountBeaconStateRequest) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type CountBeaconStateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2299,23 +2615,23 @@ type CountBeaconBlockResponse struct {
 	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *CountBeaconBlockResponse) Reset() {
-	*x = CountBeaconBlockResponse{}
+func (x *CountBeaconStateResponse) Reset() {
+	*x = CountBeaconStateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[24]
+		mi := &file_api_api_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountBeaconBlockResponse) String() string {
+func (x *CountBeaconStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconBlockResponse) ProtoMessage() {}
+func (*CountBeaconStateResponse) ProtoMessage() {}
 
-func (x *CountBeaconBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[24]
+func (x *CountBeaconStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2326,43 +2642,43 @@ func (x *CountBeaconBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconBlockResponse.ProtoReflect.Descriptor instead.
-func (*CountBeaconBlockResponse) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CountBeaconStateResponse.ProtoReflect.Descriptor instead.
+func (*CountBeaconStateResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CountBeaconBlockResponse) GetCount() *wrapperspb.UInt64Value {
+func (x *CountBeaconStateResponse) GetCount() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Count
 	}
 	return nil
 }
 
-type ListUniqueBeaconBlockValuesRequest struct {
+type ListUniqueBeaconStateValuesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueBeaconBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueBeaconBlockValuesRequest_Field" json:"fields,omitempty"`
+	Fields []ListUniqueBeaconStateValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueBeaconStateValuesRequest_Field" json:"fields,omitempty"`
 }
 
-func (x *ListUniqueBeaconBlockValuesRequest) Reset() {
-	*x = ListUniqueBeaconBlockValuesRequest{}
+func (x *ListUniqueBeaconStateValuesRequest) Reset() {
+	*x = ListUniqueBeaconStateValuesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[25]
+		mi := &file_api_api_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUniqueBeaconBlockValuesRequest) String() string {
+func (x *ListUniqueBeaconStateValuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconBlockValuesRequest) ProtoMessage() {}
+func (*ListUniqueBeaconStateValuesRequest) ProtoMessage() {}
 
-func (x *ListUniqueBeaconBlockValuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[25]
+func (x *ListUniqueBeaconStateValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2373,19 +2689,19 @@ func (x *ListUniqueBeaconBlockValuesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconBlockValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBlockValuesRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ListUniqueBeaconStateValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconStateValuesRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ListUniqueBeaconBlockValuesRequest) GetFields() []ListUniqueBeaconBlockValuesRequest_Field {
+func (x *ListUniqueBeaconStateValuesRequest) GetFields() []ListUniqueBeaconStateValuesRequest_Field {
 	if x != nil {
 		return x.Fields
 	}
 	return nil
 }
 
-type ListUniqueBeaconBlockValuesResponse struct {
+type ListUniqueBeaconStateValuesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2393,29 +2709,29 @@ type ListUniqueBeaconBlockValuesResponse struct {
 	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
 	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
 	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,proto3" json:"block_root,omitempty"`
+	StateRoot            []string `protobuf:"bytes,4,rep,name=state_root,proto3" json:"state_root,omitempty"`
 	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
 	Network              []string `protobuf:"bytes,6,rep,name=network,proto3" json:"network,omitempty"`
 	BeaconImplementation []string `protobuf:"bytes,7,rep,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) Reset() {
-	*x = ListUniqueBeaconBlockValuesResponse{}
+func (x *ListUniqueBeaconStateValuesResponse) Reset() {
+	*x = ListUniqueBeaconStateValuesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[26]
+		mi := &file_api_api_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) String() string {
+func (x *ListUniqueBeaconStateValuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconBlockValuesResponse) ProtoMessage() {}
+func (*ListUniqueBeaconStateValuesResponse) ProtoMessage() {}
 
-func (x *ListUniqueBeaconBlockValuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[26]
+func (x *ListUniqueBeaconStateValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,95 +2742,93 @@ func (x *ListUniqueBeaconBlockValuesResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconBlockValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBlockValuesResponse) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ListUniqueBeaconStateValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconStateValuesResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetNode() []string {
+func (x *ListUniqueBeaconStateValuesResponse) GetNode() []string {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetSlot() []uint64 {
+func (x *ListUniqueBeaconStateValuesResponse) GetSlot() []uint64 {
 	if x != nil {
 		return x.Slot
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetEpoch() []uint64 {
+func (x *ListUniqueBeaconStateValuesResponse) GetEpoch() []uint64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetBlockRoot() []string {
+func (x *ListUniqueBeaconStateValuesResponse) GetStateRoot() []string {
 	if x != nil {
-		return x.BlockRoot
+		return x.StateRoot
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetNodeVersion() []string {
+func (x *ListUniqueBeaconStateValuesResponse) GetNodeVersion() []string {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetNetwork() []string {
+func (x *ListUniqueBeaconStateValuesResponse) GetNetwork() []string {
 	if x != nil {
 		return x.Network
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetBeaconImplementation() []string {
+func (x *ListUniqueBeaconStateValuesResponse) GetBeaconImplementation() []string {
 	if x != nil {
 		return x.BeaconImplementation
 	}
 	return nil
 }
 
-type ListBeaconBadBlockRequest struct {
+type ListStateDivergencesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Network              string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
 	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
 	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
-	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
-	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
-	Before               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
-	Pagination           *PaginationCursor      `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
-	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Node                 string                 `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,5,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                   string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListBeaconBadBlockRequest) Reset() {
-	*x = ListBeaconBadBlockRequest{}
+func (x *ListStateDivergencesRequest) Reset() {
+	*x = ListStateDivergencesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[27]
+		mi := &file_api_api_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBeaconBadBlockRequest) String() string {
+func (x *ListStateDivergencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBadBlockRequest) ProtoMessage() {}
+func (*ListStateDivergencesRequest) ProtoMessage() {}
 
-func (x *ListBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[27]
+func (x *ListStateDivergencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2525,113 +2839,99 @@ func (x *ListBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBadBlockRequest.ProtoReflect.Descriptor instead.
-func (*ListBeaconBadBlockRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListStateDivergencesRequest.ProtoReflect.Descriptor instead.
+func (*ListStateDivergencesRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListBeaconBadBlockRequest) GetNode() string {
+func (x *ListStateDivergencesRequest) GetNetwork() string {
 	if x != nil {
-		return x.Node
+		return x.Network
 	}
 	return ""
 }
 
-func (x *ListBeaconBadBlockRequest) GetSlot() uint64 {
+func (x *ListStateDivergencesRequest) GetSlot() uint64 {
 	if x != nil {
 		return x.Slot
 	}
 	return 0
 }
 
-func (x *ListBeaconBadBlockRequest) GetEpoch() uint64 {
+func (x *ListStateDivergencesRequest) GetEpoch() uint64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return 0
 }
 
-func (x *ListBeaconBadBlockRequest) GetBlockRoot() string {
-	if x != nil {
-		return x.BlockRoot
-	}
-	return ""
-}
-
-func (x *ListBeaconBadBlockRequest) GetNodeVersion() string {
+func (x *ListStateDivergencesRequest) GetNode() string {
 	if x != nil {
-		return x.NodeVersion
+		return x.Node
 	}
 	return ""
 }
 
-func (x *ListBeaconBadBlockRequest) GetNetwork() string {
+func (x *ListStateDivergencesRequest) GetBeaconImplementation() string {
 	if x != nil {
-		return x.Network
+		return x.BeaconImplementation
 	}
 	return ""
 }
 
-func (x *ListBeaconBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+func (x *ListStateDivergencesRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *ListStateDivergencesRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
 		return x.After
 	}
 	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetPagination() *PaginationCursor {
+func (x *ListStateDivergencesRequest) GetPagination() *PaginationCursor {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetBeaconImplementation() string {
-	if x != nil {
-		return x.BeaconImplementation
-	}
-	return ""
-}
-
-func (x *ListBeaconBadBlockRequest) GetId() string {
+func (x *ListStateDivergencesRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListBeaconBadBlockResponse struct {
+type ListStateDivergencesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BeaconBadBlocks []*BeaconBadBlock `protobuf:"bytes,1,rep,name=beacon_bad_blocks,proto3" json:"beacon_bad_blocks,omitempty"`
+	StateDivergences []*StateDivergence `protobuf:"bytes,1,rep,name=state_divergences,proto3" json:"state_divergences,omitempty"`
 }
 
-func (x *ListBeaconBadBlockResponse) Reset() {
-	*x = ListBeaconBadBlockResponse{}
+func (x *ListStateDivergencesResponse) Reset() {
+	*x = ListStateDivergencesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[28]
+		mi := &file_api_api_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBeaconBadBlockResponse) String() string {
+func (x *ListStateDivergencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBadBlockResponse) ProtoMessage() {}
+func (*ListStateDivergencesResponse) ProtoMessage() {}
 
-func (x *ListBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[28]
+func (x *ListStateDivergencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2642,19 +2942,19 @@ func (x *ListBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBadBlockResponse.ProtoReflect.Descriptor instead.
-func (*ListBeaconBadBlockResponse) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListStateDivergencesResponse.ProtoReflect.Descriptor instead.
+func (*ListStateDivergencesResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ListBeaconBadBlockResponse) GetBeaconBadBlocks() []*BeaconBadBlock {
+func (x *ListStateDivergencesResponse) GetStateDivergences() []*StateDivergence {
 	if x != nil {
-		return x.BeaconBadBlocks
+		return x.StateDivergences
 	}
 	return nil
 }
 
-type CountBeaconBadBlockRequest struct {
+type ListBeaconBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2665,28 +2965,31 @@ type CountBeaconBadBlockRequest struct {
 	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
 	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
 	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation string                 `protobuf:"bytes,7,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
-	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor      `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Canonical            *wrapperspb.BoolValue  `protobuf:"bytes,12,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
-func (x *CountBeaconBadBlockRequest) Reset() {
-	*x = CountBeaconBadBlockRequest{}
+func (x *ListBeaconBlockRequest) Reset() {
+	*x = ListBeaconBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[29]
+		mi := &file_api_api_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountBeaconBadBlockRequest) String() string {
+func (x *ListBeaconBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconBadBlockRequest) ProtoMessage() {}
+func (*ListBeaconBlockRequest) ProtoMessage() {}
 
-func (x *CountBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[29]
+func (x *ListBeaconBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2697,146 +3000,120 @@ func (x *CountBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconBadBlockRequest.ProtoReflect.Descriptor instead.
-func (*CountBeaconBadBlockRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListBeaconBlockRequest.ProtoReflect.Descriptor instead.
+func (*ListBeaconBlockRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CountBeaconBadBlockRequest) GetNode() string {
+func (x *ListBeaconBlockRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlockRequest) GetSlot() uint64 {
+func (x *ListBeaconBlockRequest) GetSlot() uint64 {
 	if x != nil {
 		return x.Slot
 	}
 	return 0
 }
 
-func (x *CountBeaconBadBlockRequest) GetEpoch() uint64 {
+func (x *ListBeaconBlockRequest) GetEpoch() uint64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return 0
 }
 
-func (x *CountBeaconBadBlockRequest) GetBlockRoot() string {
+func (x *ListBeaconBlockRequest) GetBlockRoot() string {
 	if x != nil {
 		return x.BlockRoot
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlockRequest) GetNodeVersion() string {
+func (x *ListBeaconBlockRequest) GetNodeVersion() string {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlockRequest) GetNetwork() string {
+func (x *ListBeaconBlockRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlockRequest) GetBeaconImplementation() string {
-	if x != nil {
-		return x.BeaconImplementation
-	}
-	return ""
-}
-
-func (x *CountBeaconBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+func (x *ListBeaconBlockRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-func (x *CountBeaconBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *ListBeaconBlockRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
 		return x.After
 	}
 	return nil
 }
 
-type CountBeaconBadBlockResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
-}
-
-func (x *CountBeaconBadBlockResponse) Reset() {
-	*x = CountBeaconBadBlockResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListBeaconBlockRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
 	}
+	return nil
 }
 
-func (x *CountBeaconBadBlockResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CountBeaconBadBlockResponse) ProtoMessage() {}
-
-func (x *CountBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListBeaconBlockRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CountBeaconBadBlockResponse.ProtoReflect.Descriptor instead.
-func (*CountBeaconBadBlockResponse) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{30}
+func (x *ListBeaconBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *CountBeaconBadBlockResponse) GetCount() *wrapperspb.UInt64Value {
+func (x *ListBeaconBlockRequest) GetCanonical() *wrapperspb.BoolValue {
 	if x != nil {
-		return x.Count
+		return x.Canonical
 	}
 	return nil
 }
 
-type ListUniqueBeaconBadBlockValuesRequest struct {
+type ListBeaconBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueBeaconBadBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueBeaconBadBlockValuesRequest_Field" json:"fields,omitempty"`
+	BeaconBlocks []*BeaconBlock `protobuf:"bytes,1,rep,name=beacon_blocks,proto3" json:"beacon_blocks,omitempty"`
 }
 
-func (x *ListUniqueBeaconBadBlockValuesRequest) Reset() {
-	*x = ListUniqueBeaconBadBlockValuesRequest{}
+func (x *ListBeaconBlockResponse) Reset() {
+	*x = ListBeaconBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[31]
+		mi := &file_api_api_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUniqueBeaconBadBlockValuesRequest) String() string {
+func (x *ListBeaconBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconBadBlockValuesRequest) ProtoMessage() {}
+func (*ListBeaconBlockResponse) ProtoMessage() {}
 
-func (x *ListUniqueBeaconBadBlockValuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[31]
+func (x *ListBeaconBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2847,49 +3124,52 @@ func (x *ListUniqueBeaconBadBlockValuesRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconBadBlockValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBadBlockValuesRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ListBeaconBlockResponse.ProtoReflect.Descriptor instead.
+func (*ListBeaconBlockResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ListUniqueBeaconBadBlockValuesRequest) GetFields() []ListUniqueBeaconBadBlockValuesRequest_Field {
+func (x *ListBeaconBlockResponse) GetBeaconBlocks() []*BeaconBlock {
 	if x != nil {
-		return x.Fields
+		return x.BeaconBlocks
 	}
 	return nil
 }
 
-type ListUniqueBeaconBadBlockValuesResponse struct {
+type CountBeaconBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
-	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,proto3" json:"block_root,omitempty"`
-	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
-	Network              []string `protobuf:"bytes,6,rep,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation []string `protobuf:"bytes,7,rep,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,7,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	Canonical            *wrapperspb.BoolValue  `protobuf:"bytes,10,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) Reset() {
-	*x = ListUniqueBeaconBadBlockValuesResponse{}
+func (x *CountBeaconBlockRequest) Reset() {
+	*x = CountBeaconBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[32]
+		mi := &file_api_api_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) String() string {
+func (x *CountBeaconBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconBadBlockValuesResponse) ProtoMessage() {}
+func (*CountBeaconBlockRequest) ProtoMessage() {}
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[32]
+func (x *CountBeaconBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2900,96 +3180,106 @@ func (x *ListUniqueBeaconBadBlockValuesResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconBadBlockValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBadBlockValuesResponse) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CountBeaconBlockRequest.ProtoReflect.Descriptor instead.
+func (*CountBeaconBlockRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetNode() []string {
+func (x *CountBeaconBlockRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetSlot() []uint64 {
+func (x *CountBeaconBlockRequest) GetSlot() uint64 {
 	if x != nil {
 		return x.Slot
 	}
-	return nil
+	return 0
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetEpoch() []uint64 {
+func (x *CountBeaconBlockRequest) GetEpoch() uint64 {
 	if x != nil {
 		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetBlockRoot() []string {
+func (x *CountBeaconBlockRequest) GetBlockRoot() string {
 	if x != nil {
 		return x.BlockRoot
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetNodeVersion() []string {
+func (x *CountBeaconBlockRequest) GetNodeVersion() string {
 	if x != nil {
 		return x.NodeVersion
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetNetwork() []string {
+func (x *CountBeaconBlockRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetBeaconImplementation() []string {
+func (x *CountBeaconBlockRequest) GetBeaconImplementation() string {
 	if x != nil {
 		return x.BeaconImplementation
 	}
+	return ""
+}
+
+func (x *CountBeaconBlockRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
 	return nil
 }
 
-type ListBeaconBadBlobRequest struct {
+func (x *CountBeaconBlockRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountBeaconBlockRequest) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type CountBeaconBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                  `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            string                  `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
-	NodeVersion          string                  `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
-	Network              string                  `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
-	Before               *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
-	Pagination           *PaginationCursor       `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	BeaconImplementation string                  `protobuf:"bytes,10,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
-	Id                   string                  `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
-	Index                *wrapperspb.UInt64Value `protobuf:"bytes,12,opt,name=index,proto3" json:"index,omitempty"`
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *ListBeaconBadBlobRequest) Reset() {
-	*x = ListBeaconBadBlobRequest{}
+func (x *CountBeaconBlockResponse) Reset() {
+	*x = CountBeaconBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[33]
+		mi := &file_api_api_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBeaconBadBlobRequest) String() string {
+func (x *CountBeaconBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBadBlobRequest) ProtoMessage() {}
+func (*CountBeaconBlockResponse) ProtoMessage() {}
 
-func (x *ListBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[33]
+func (x *CountBeaconBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3000,105 +3290,305 @@ func (x *ListBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBadBlobRequest.ProtoReflect.Descriptor instead.
-func (*ListBeaconBadBlobRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use CountBeaconBlockResponse.ProtoReflect.Descriptor instead.
+func (*CountBeaconBlockResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListBeaconBadBlobRequest) GetNode() string {
+func (x *CountBeaconBlockResponse) GetCount() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.Node
+		return x.Count
+	}
+	return nil
+}
+
+type ListUniqueBeaconBlockValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []ListUniqueBeaconBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueBeaconBlockValuesRequest_Field" json:"fields,omitempty"`
+}
+
+func (x *ListUniqueBeaconBlockValuesRequest) Reset() {
+	*x = ListUniqueBeaconBlockValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBeaconBlockValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBeaconBlockValuesRequest) ProtoMessage() {}
+
+func (x *ListUniqueBeaconBlockValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBeaconBlockValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBlockValuesRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListUniqueBeaconBlockValuesRequest) GetFields() []ListUniqueBeaconBlockValuesRequest_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListUniqueBeaconBlockValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              []string `protobuf:"bytes,6,rep,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation []string `protobuf:"bytes,7,rep,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) Reset() {
+	*x = ListUniqueBeaconBlockValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBeaconBlockValuesResponse) ProtoMessage() {}
+
+func (x *ListUniqueBeaconBlockValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBeaconBlockValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBlockValuesResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetNode() []string {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetSlot() []uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetEpoch() []uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetBlockRoot() []string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetBeaconImplementation() []string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return nil
+}
+
+type ListBlobSidecarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor      `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	SidecarType          string                 `protobuf:"bytes,12,opt,name=sidecar_type,proto3" json:"sidecar_type,omitempty"`
+}
+
+func (x *ListBlobSidecarRequest) Reset() {
+	*x = ListBlobSidecarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlobSidecarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlobSidecarRequest) ProtoMessage() {}
+
+func (x *ListBlobSidecarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlobSidecarRequest.ProtoReflect.Descriptor instead.
+func (*ListBlobSidecarRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListBlobSidecarRequest) GetNode() string {
+	if x != nil {
+		return x.Node
 	}
 	return ""
 }
 
-func (x *ListBeaconBadBlobRequest) GetSlot() uint64 {
+func (x *ListBlobSidecarRequest) GetSlot() uint64 {
 	if x != nil {
 		return x.Slot
 	}
 	return 0
 }
 
-func (x *ListBeaconBadBlobRequest) GetEpoch() uint64 {
+func (x *ListBlobSidecarRequest) GetEpoch() uint64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return 0
 }
 
-func (x *ListBeaconBadBlobRequest) GetBlockRoot() string {
+func (x *ListBlobSidecarRequest) GetBlockRoot() string {
 	if x != nil {
 		return x.BlockRoot
 	}
 	return ""
 }
 
-func (x *ListBeaconBadBlobRequest) GetNodeVersion() string {
+func (x *ListBlobSidecarRequest) GetNodeVersion() string {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return ""
 }
 
-func (x *ListBeaconBadBlobRequest) GetNetwork() string {
+func (x *ListBlobSidecarRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
 	return ""
 }
 
-func (x *ListBeaconBadBlobRequest) GetBefore() *timestamppb.Timestamp {
+func (x *ListBlobSidecarRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-func (x *ListBeaconBadBlobRequest) GetAfter() *timestamppb.Timestamp {
+func (x *ListBlobSidecarRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
 		return x.After
 	}
 	return nil
 }
 
-func (x *ListBeaconBadBlobRequest) GetPagination() *PaginationCursor {
+func (x *ListBlobSidecarRequest) GetPagination() *PaginationCursor {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-func (x *ListBeaconBadBlobRequest) GetBeaconImplementation() string {
+func (x *ListBlobSidecarRequest) GetBeaconImplementation() string {
 	if x != nil {
 		return x.BeaconImplementation
 	}
 	return ""
 }
 
-func (x *ListBeaconBadBlobRequest) GetId() string {
+func (x *ListBlobSidecarRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ListBeaconBadBlobRequest) GetIndex() *wrapperspb.UInt64Value {
+func (x *ListBlobSidecarRequest) GetSidecarType() string {
 	if x != nil {
-		return x.Index
+		return x.SidecarType
 	}
-	return nil
+	return ""
 }
 
-type ListBeaconBadBlobResponse struct {
+type ListBlobSidecarResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BeaconBadBlobs []*BeaconBadBlob `protobuf:"bytes,1,rep,name=beacon_bad_blobs,proto3" json:"beacon_bad_blobs,omitempty"`
+	BlobSidecars []*BlobSidecar `protobuf:"bytes,1,rep,name=blob_sidecars,proto3" json:"blob_sidecars,omitempty"`
 }
 
-func (x *ListBeaconBadBlobResponse) Reset() {
-	*x = ListBeaconBadBlobResponse{}
+func (x *ListBlobSidecarResponse) Reset() {
+	*x = ListBlobSidecarResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3106,13 +3596,13 @@ func (x *ListBeaconBadBlobResponse) Reset() {
 	}
 }
 
-func (x *ListBeaconBadBlobResponse) String() string {
+func (x *ListBlobSidecarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBadBlobResponse) ProtoMessage() {}
+func (*ListBlobSidecarResponse) ProtoMessage() {}
 
-func (x *ListBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
+func (x *ListBlobSidecarResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3124,37 +3614,37 @@ func (x *ListBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBadBlobResponse.ProtoReflect.Descriptor instead.
-func (*ListBeaconBadBlobResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBlobSidecarResponse.ProtoReflect.Descriptor instead.
+func (*ListBlobSidecarResponse) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListBeaconBadBlobResponse) GetBeaconBadBlobs() []*BeaconBadBlob {
+func (x *ListBlobSidecarResponse) GetBlobSidecars() []*BlobSidecar {
 	if x != nil {
-		return x.BeaconBadBlobs
+		return x.BlobSidecars
 	}
 	return nil
 }
 
-type CountBeaconBadBlobRequest struct {
+type CountBlobSidecarRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                  `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            string                  `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
-	NodeVersion          string                  `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
-	Network              string                  `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation string                  `protobuf:"bytes,7,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
-	Before               *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
-	Index                *wrapperspb.UInt64Value `protobuf:"bytes,10,opt,name=index,proto3" json:"index,omitempty"`
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,7,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	SidecarType          string                 `protobuf:"bytes,10,opt,name=sidecar_type,proto3" json:"sidecar_type,omitempty"`
 }
 
-func (x *CountBeaconBadBlobRequest) Reset() {
-	*x = CountBeaconBadBlobRequest{}
+func (x *CountBlobSidecarRequest) Reset() {
+	*x = CountBlobSidecarRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3162,13 +3652,13 @@ func (x *CountBeaconBadBlobRequest) Reset() {
 	}
 }
 
-func (x *CountBeaconBadBlobRequest) String() string {
+func (x *CountBlobSidecarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconBadBlobRequest) ProtoMessage() {}
+func (*CountBlobSidecarRequest) ProtoMessage() {}
 
-func (x *CountBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
+func (x *CountBlobSidecarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3180,82 +3670,82 @@ func (x *CountBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconBadBlobRequest.ProtoReflect.Descriptor instead.
-func (*CountBeaconBadBlobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountBlobSidecarRequest.ProtoReflect.Descriptor instead.
+func (*CountBlobSidecarRequest) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CountBeaconBadBlobRequest) GetNode() string {
+func (x *CountBlobSidecarRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlobRequest) GetSlot() uint64 {
+func (x *CountBlobSidecarRequest) GetSlot() uint64 {
 	if x != nil {
 		return x.Slot
 	}
 	return 0
 }
 
-func (x *CountBeaconBadBlobRequest) GetEpoch() uint64 {
+func (x *CountBlobSidecarRequest) GetEpoch() uint64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return 0
 }
 
-func (x *CountBeaconBadBlobRequest) GetBlockRoot() string {
+func (x *CountBlobSidecarRequest) GetBlockRoot() string {
 	if x != nil {
 		return x.BlockRoot
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlobRequest) GetNodeVersion() string {
+func (x *CountBlobSidecarRequest) GetNodeVersion() string {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlobRequest) GetNetwork() string {
+func (x *CountBlobSidecarRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlobRequest) GetBeaconImplementation() string {
+func (x *CountBlobSidecarRequest) GetBeaconImplementation() string {
 	if x != nil {
 		return x.BeaconImplementation
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlobRequest) GetBefore() *timestamppb.Timestamp {
+func (x *CountBlobSidecarRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-func (x *CountBeaconBadBlobRequest) GetAfter() *timestamppb.Timestamp {
+func (x *CountBlobSidecarRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
 		return x.After
 	}
 	return nil
 }
 
-func (x *CountBeaconBadBlobRequest) GetIndex() *wrapperspb.UInt64Value {
+func (x *CountBlobSidecarRequest) GetSidecarType() string {
 	if x != nil {
-		return x.Index
+		return x.SidecarType
 	}
-	return nil
+	return ""
 }
 
-type CountBeaconBadBlobResponse struct {
+type CountBlobSidecarResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3263,8 +3753,8 @@ type CountBeaconBadBlobResponse struct {
 	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *CountBeaconBadBlobResponse) Reset() {
-	*x = CountBeaconBadBlobResponse{}
+func (x *CountBlobSidecarResponse) Reset() {
+	*x = CountBlobSidecarResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3272,13 +3762,13 @@ func (x *CountBeaconBadBlobResponse) Reset() {
 	}
 }
 
-func (x *CountBeaconBadBlobResponse) String() string {
+func (x *CountBlobSidecarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconBadBlobResponse) ProtoMessage() {}
+func (*CountBlobSidecarResponse) ProtoMessage() {}
 
-func (x *CountBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
+func (x *CountBlobSidecarResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3290,28 +3780,28 @@ func (x *CountBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconBadBlobResponse.ProtoReflect.Descriptor instead.
-func (*CountBeaconBadBlobResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountBlobSidecarResponse.ProtoReflect.Descriptor instead.
+func (*CountBlobSidecarResponse) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CountBeaconBadBlobResponse) GetCount() *wrapperspb.UInt64Value {
+func (x *CountBlobSidecarResponse) GetCount() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Count
 	}
 	return nil
 }
 
-type ListUniqueBeaconBadBlobValuesRequest struct {
+type ListUniqueBlobSidecarValuesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueBeaconBadBlobValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueBeaconBadBlobValuesRequest_Field" json:"fields,omitempty"`
+	Fields []ListUniqueBlobSidecarValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueBlobSidecarValuesRequest_Field" json:"fields,omitempty"`
 }
 
-func (x *ListUniqueBeaconBadBlobValuesRequest) Reset() {
-	*x = ListUniqueBeaconBadBlobValuesRequest{}
+func (x *ListUniqueBlobSidecarValuesRequest) Reset() {
+	*x = ListUniqueBlobSidecarValuesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3319,13 +3809,13 @@ func (x *ListUniqueBeaconBadBlobValuesRequest) Reset() {
 	}
 }
 
-func (x *ListUniqueBeaconBadBlobValuesRequest) String() string {
+func (x *ListUniqueBlobSidecarValuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconBadBlobValuesRequest) ProtoMessage() {}
+func (*ListUniqueBlobSidecarValuesRequest) ProtoMessage() {}
 
-func (x *ListUniqueBeaconBadBlobValuesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListUniqueBlobSidecarValuesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3337,19 +3827,19 @@ func (x *ListUniqueBeaconBadBlobValuesRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconBadBlobValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBadBlobValuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUniqueBlobSidecarValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueBlobSidecarValuesRequest) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListUniqueBeaconBadBlobValuesRequest) GetFields() []ListUniqueBeaconBadBlobValuesRequest_Field {
+func (x *ListUniqueBlobSidecarValuesRequest) GetFields() []ListUniqueBlobSidecarValuesRequest_Field {
 	if x != nil {
 		return x.Fields
 	}
 	return nil
 }
 
-type ListUniqueBeaconBadBlobValuesResponse struct {
+type ListUniqueBlobSidecarValuesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3361,11 +3851,11 @@ type ListUniqueBeaconBadBlobValuesResponse struct {
 	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
 	Network              []string `protobuf:"bytes,6,rep,name=network,proto3" json:"network,omitempty"`
 	BeaconImplementation []string `protobuf:"bytes,7,rep,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
-	Index                []uint64 `protobuf:"varint,8,rep,packed,name=index,proto3" json:"index,omitempty"`
+	SidecarType          []string `protobuf:"bytes,8,rep,name=sidecar_type,proto3" json:"sidecar_type,omitempty"`
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) Reset() {
-	*x = ListUniqueBeaconBadBlobValuesResponse{}
+func (x *ListUniqueBlobSidecarValuesResponse) Reset() {
+	*x = ListUniqueBlobSidecarValuesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3373,13 +3863,13 @@ func (x *ListUniqueBeaconBadBlobValuesResponse) Reset() {
 	}
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) String() string {
+func (x *ListUniqueBlobSidecarValuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconBadBlobValuesResponse) ProtoMessage() {}
+func (*ListUniqueBlobSidecarValuesResponse) ProtoMessage() {}
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListUniqueBlobSidecarValuesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3391,86 +3881,87 @@ func (x *ListUniqueBeaconBadBlobValuesResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconBadBlobValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBadBlobValuesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUniqueBlobSidecarValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueBlobSidecarValuesResponse) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetNode() []string {
+func (x *ListUniqueBlobSidecarValuesResponse) GetNode() []string {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetSlot() []uint64 {
+func (x *ListUniqueBlobSidecarValuesResponse) GetSlot() []uint64 {
 	if x != nil {
 		return x.Slot
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetEpoch() []uint64 {
+func (x *ListUniqueBlobSidecarValuesResponse) GetEpoch() []uint64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetBlockRoot() []string {
+func (x *ListUniqueBlobSidecarValuesResponse) GetBlockRoot() []string {
 	if x != nil {
 		return x.BlockRoot
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetNodeVersion() []string {
+func (x *ListUniqueBlobSidecarValuesResponse) GetNodeVersion() []string {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetNetwork() []string {
+func (x *ListUniqueBlobSidecarValuesResponse) GetNetwork() []string {
 	if x != nil {
 		return x.Network
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetBeaconImplementation() []string {
+func (x *ListUniqueBlobSidecarValuesResponse) GetBeaconImplementation() []string {
 	if x != nil {
 		return x.BeaconImplementation
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetIndex() []uint64 {
+func (x *ListUniqueBlobSidecarValuesResponse) GetSidecarType() []string {
 	if x != nil {
-		return x.Index
+		return x.SidecarType
 	}
 	return nil
 }
 
-type ListExecutionBlockTraceRequest struct {
+type ListForkChoiceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,proto3" json:"block_number,omitempty"`
-	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
-	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
-	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
-	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
-	Pagination              *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Id                      string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
-	ExecutionImplementation string                 `protobuf:"bytes,10,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             string                 `protobuf:"bytes,11,opt,name=node_version,proto3" json:"node_version,omitempty"`
-}
-
-func (x *ListExecutionBlockTraceRequest) Reset() {
-	*x = ListExecutionBlockTraceRequest{}
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,4,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,9,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Id                   string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Reason               string                 `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ListForkChoiceRequest) Reset() {
+	*x = ListForkChoiceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3478,13 +3969,13 @@ func (x *ListExecutionBlockTraceRequest) Reset() {
 	}
 }
 
-func (x *ListExecutionBlockTraceRequest) String() string {
+func (x *ListForkChoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExecutionBlockTraceRequest) ProtoMessage() {}
+func (*ListForkChoiceRequest) ProtoMessage() {}
 
-func (x *ListExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
+func (x *ListForkChoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3496,91 +3987,98 @@ func (x *ListExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
-func (*ListExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListForkChoiceRequest.ProtoReflect.Descriptor instead.
+func (*ListForkChoiceRequest) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ListExecutionBlockTraceRequest) GetNode() string {
+func (x *ListForkChoiceRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
 	return ""
 }
 
-func (x *ListExecutionBlockTraceRequest) GetBlockNumber() int64 {
+func (x *ListForkChoiceRequest) GetSlot() uint64 {
 	if x != nil {
-		return x.BlockNumber
+		return x.Slot
 	}
 	return 0
 }
 
-func (x *ListExecutionBlockTraceRequest) GetBlockHash() string {
+func (x *ListForkChoiceRequest) GetEpoch() uint64 {
 	if x != nil {
-		return x.BlockHash
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ListForkChoiceRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
 	}
 	return ""
 }
 
-func (x *ListExecutionBlockTraceRequest) GetNetwork() string {
+func (x *ListForkChoiceRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
 	return ""
 }
 
-func (x *ListExecutionBlockTraceRequest) GetBefore() *timestamppb.Timestamp {
+func (x *ListForkChoiceRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-func (x *ListExecutionBlockTraceRequest) GetAfter() *timestamppb.Timestamp {
+func (x *ListForkChoiceRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
 		return x.After
 	}
 	return nil
 }
 
-func (x *ListExecutionBlockTraceRequest) GetPagination() *PaginationCursor {
+func (x *ListForkChoiceRequest) GetPagination() *PaginationCursor {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-func (x *ListExecutionBlockTraceRequest) GetId() string {
+func (x *ListForkChoiceRequest) GetBeaconImplementation() string {
 	if x != nil {
-		return x.Id
+		return x.BeaconImplementation
 	}
 	return ""
 }
 
-func (x *ListExecutionBlockTraceRequest) GetExecutionImplementation() string {
+func (x *ListForkChoiceRequest) GetId() string {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListExecutionBlockTraceRequest) GetNodeVersion() string {
+func (x *ListForkChoiceRequest) GetReason() string {
 	if x != nil {
-		return x.NodeVersion
+		return x.Reason
 	}
 	return ""
 }
 
-type ListExecutionBlockTraceResponse struct {
+type ListForkChoiceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExecutionBlockTraces []*ExecutionBlockTrace `protobuf:"bytes,1,rep,name=execution_block_traces,proto3" json:"execution_block_traces,omitempty"`
+	ForkChoices []*ForkChoice `protobuf:"bytes,1,rep,name=fork_choices,proto3" json:"fork_choices,omitempty"`
 }
 
-func (x *ListExecutionBlockTraceResponse) Reset() {
-	*x = ListExecutionBlockTraceResponse{}
+func (x *ListForkChoiceResponse) Reset() {
+	*x = ListForkChoiceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3588,13 +4086,13 @@ func (x *ListExecutionBlockTraceResponse) Reset() {
 	}
 }
 
-func (x *ListExecutionBlockTraceResponse) String() string {
+func (x *ListForkChoiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExecutionBlockTraceResponse) ProtoMessage() {}
+func (*ListForkChoiceResponse) ProtoMessage() {}
 
-func (x *ListExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
+func (x *ListForkChoiceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3606,35 +4104,36 @@ func (x *ListExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExecutionBlockTraceResponse.ProtoReflect.Descriptor instead.
-func (*ListExecutionBlockTraceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListForkChoiceResponse.ProtoReflect.Descriptor instead.
+func (*ListForkChoiceResponse) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ListExecutionBlockTraceResponse) GetExecutionBlockTraces() []*ExecutionBlockTrace {
+func (x *ListForkChoiceResponse) GetForkChoices() []*ForkChoice {
 	if x != nil {
-		return x.ExecutionBlockTraces
+		return x.ForkChoices
 	}
 	return nil
 }
 
-type CountExecutionBlockTraceRequest struct {
+type CountForkChoiceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,proto3" json:"block_number,omitempty"`
-	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
-	ExecutionImplementation string                 `protobuf:"bytes,6,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
-	Before                  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
-	After                   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
-	NodeVersion             string                 `protobuf:"bytes,9,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,4,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,6,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	Reason               string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *CountExecutionBlockTraceRequest) Reset() {
-	*x = CountExecutionBlockTraceRequest{}
+func (x *CountForkChoiceRequest) Reset() {
+	*x = CountForkChoiceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3642,13 +4141,13 @@ func (x *CountExecutionBlockTraceRequest) Reset() {
 	}
 }
 
-func (x *CountExecutionBlockTraceRequest) String() string {
+func (x *CountForkChoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountExecutionBlockTraceRequest) ProtoMessage() {}
+func (*CountForkChoiceRequest) ProtoMessage() {}
 
-func (x *CountExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
+func (x *CountForkChoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3660,68 +4159,75 @@ func (x *CountExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
-func (*CountExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountForkChoiceRequest.ProtoReflect.Descriptor instead.
+func (*CountForkChoiceRequest) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *CountExecutionBlockTraceRequest) GetNode() string {
+func (x *CountForkChoiceRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
 	return ""
 }
 
-func (x *CountExecutionBlockTraceRequest) GetBlockNumber() int64 {
+func (x *CountForkChoiceRequest) GetSlot() uint64 {
 	if x != nil {
-		return x.BlockNumber
+		return x.Slot
 	}
 	return 0
 }
 
-func (x *CountExecutionBlockTraceRequest) GetBlockHash() string {
+func (x *CountForkChoiceRequest) GetEpoch() uint64 {
 	if x != nil {
-		return x.BlockHash
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CountForkChoiceRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
 	}
 	return ""
 }
 
-func (x *CountExecutionBlockTraceRequest) GetNetwork() string {
+func (x *CountForkChoiceRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
 	return ""
 }
 
-func (x *CountExecutionBlockTraceRequest) GetExecutionImplementation() string {
+func (x *CountForkChoiceRequest) GetBeaconImplementation() string {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.BeaconImplementation
 	}
 	return ""
 }
 
-func (x *CountExecutionBlockTraceRequest) GetBefore() *timestamppb.Timestamp {
+func (x *CountForkChoiceRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-func (x *CountExecutionBlockTraceRequest) GetAfter() *timestamppb.Timestamp {
+func (x *CountForkChoiceRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
 		return x.After
 	}
 	return nil
 }
 
-func (x *CountExecutionBlockTraceRequest) GetNodeVersion() string {
+func (x *CountForkChoiceRequest) GetReason() string {
 	if x != nil {
-		return x.NodeVersion
+		return x.Reason
 	}
 	return ""
 }
 
-type CountExecutionBlockTraceResponse struct {
+type CountForkChoiceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3729,8 +4235,8 @@ type CountExecutionBlockTraceResponse struct {
 	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *CountExecutionBlockTraceResponse) Reset() {
-	*x = CountExecutionBlockTraceResponse{}
+func (x *CountForkChoiceResponse) Reset() {
+	*x = CountForkChoiceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3738,13 +4244,13 @@ func (x *CountExecutionBlockTraceResponse) Reset() {
 	}
 }
 
-func (x *CountExecutionBlockTraceResponse) String() string {
+func (x *CountForkChoiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountExecutionBlockTraceResponse) ProtoMessage() {}
+func (*CountForkChoiceResponse) ProtoMessage() {}
 
-func (x *CountExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
+func (x *CountForkChoiceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3756,28 +4262,28 @@ func (x *CountExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountExecutionBlockTraceResponse.ProtoReflect.Descriptor instead.
-func (*CountExecutionBlockTraceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountForkChoiceResponse.ProtoReflect.Descriptor instead.
+func (*CountForkChoiceResponse) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CountExecutionBlockTraceResponse) GetCount() *wrapperspb.UInt64Value {
+func (x *CountForkChoiceResponse) GetCount() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Count
 	}
 	return nil
 }
 
-type ListUniqueExecutionBlockTraceValuesRequest struct {
+type ListUniqueForkChoiceValuesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueExecutionBlockTraceValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueExecutionBlockTraceValuesRequest_Field" json:"fields,omitempty"`
+	Fields []ListUniqueForkChoiceValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueForkChoiceValuesRequest_Field" json:"fields,omitempty"`
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesRequest) Reset() {
-	*x = ListUniqueExecutionBlockTraceValuesRequest{}
+func (x *ListUniqueForkChoiceValuesRequest) Reset() {
+	*x = ListUniqueForkChoiceValuesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3785,13 +4291,13 @@ func (x *ListUniqueExecutionBlockTraceValuesRequest) Reset() {
 	}
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesRequest) String() string {
+func (x *ListUniqueForkChoiceValuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueExecutionBlockTraceValuesRequest) ProtoMessage() {}
+func (*ListUniqueForkChoiceValuesRequest) ProtoMessage() {}
 
-func (x *ListUniqueExecutionBlockTraceValuesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListUniqueForkChoiceValuesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3803,33 +4309,34 @@ func (x *ListUniqueExecutionBlockTraceValuesRequest) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueExecutionBlockTraceValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueExecutionBlockTraceValuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUniqueForkChoiceValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueForkChoiceValuesRequest) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesRequest) GetFields() []ListUniqueExecutionBlockTraceValuesRequest_Field {
+func (x *ListUniqueForkChoiceValuesRequest) GetFields() []ListUniqueForkChoiceValuesRequest_Field {
 	if x != nil {
 		return x.Fields
 	}
 	return nil
 }
 
-type ListUniqueExecutionBlockTraceValuesResponse struct {
+type ListUniqueForkChoiceValuesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
-	BlockHash               []string `protobuf:"bytes,2,rep,name=block_hash,proto3" json:"block_hash,omitempty"`
-	BlockNumber             []int64  `protobuf:"varint,3,rep,packed,name=block_number,proto3" json:"block_number,omitempty"`
-	Network                 []string `protobuf:"bytes,4,rep,name=network,proto3" json:"network,omitempty"`
-	NodeVersion             []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
-	ExecutionImplementation []string `protobuf:"bytes,6,rep,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
+	NodeVersion          []string `protobuf:"bytes,4,rep,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              []string `protobuf:"bytes,5,rep,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation []string `protobuf:"bytes,6,rep,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Reason               []string `protobuf:"bytes,7,rep,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) Reset() {
-	*x = ListUniqueExecutionBlockTraceValuesResponse{}
+func (x *ListUniqueForkChoiceValuesResponse) Reset() {
+	*x = ListUniqueForkChoiceValuesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3837,13 +4344,13 @@ func (x *ListUniqueExecutionBlockTraceValuesResponse) Reset() {
 	}
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) String() string {
+func (x *ListUniqueForkChoiceValuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueExecutionBlockTraceValuesResponse) ProtoMessage() {}
+func (*ListUniqueForkChoiceValuesResponse) ProtoMessage() {}
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListUniqueForkChoiceValuesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3855,73 +4362,80 @@ func (x *ListUniqueExecutionBlockTraceValuesResponse) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueExecutionBlockTraceValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueExecutionBlockTraceValuesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUniqueForkChoiceValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueForkChoiceValuesResponse) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNode() []string {
+func (x *ListUniqueForkChoiceValuesResponse) GetNode() []string {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetBlockHash() []string {
+func (x *ListUniqueForkChoiceValuesResponse) GetSlot() []uint64 {
 	if x != nil {
-		return x.BlockHash
+		return x.Slot
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetBlockNumber() []int64 {
+func (x *ListUniqueForkChoiceValuesResponse) GetEpoch() []uint64 {
 	if x != nil {
-		return x.BlockNumber
+		return x.Epoch
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNetwork() []string {
+func (x *ListUniqueForkChoiceValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueForkChoiceValuesResponse) GetNetwork() []string {
 	if x != nil {
 		return x.Network
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNodeVersion() []string {
+func (x *ListUniqueForkChoiceValuesResponse) GetBeaconImplementation() []string {
 	if x != nil {
-		return x.NodeVersion
+		return x.BeaconImplementation
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetExecutionImplementation() []string {
+func (x *ListUniqueForkChoiceValuesResponse) GetReason() []string {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.Reason
 	}
 	return nil
 }
 
-type ListExecutionBadBlockRequest struct {
+type ListBeaconBadBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,proto3" json:"block_number,omitempty"`
-	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
-	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
-	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
-	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
-	Pagination              *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Id                      string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
-	ExecutionImplementation string                 `protobuf:"bytes,10,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             string                 `protobuf:"bytes,11,opt,name=node_version,proto3" json:"node_version,omitempty"`
-	BlockExtraData          string                 `protobuf:"bytes,12,opt,name=block_extra_data,proto3" json:"block_extra_data,omitempty"`
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor      `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListExecutionBadBlockRequest) Reset() {
-	*x = ListExecutionBadBlockRequest{}
+func (x *ListBeaconBadBlockRequest) Reset() {
+	*x = ListBeaconBadBlockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3929,13 +4443,13 @@ func (x *ListExecutionBadBlockRequest) Reset() {
 	}
 }
 
-func (x *ListExecutionBadBlockRequest) String() string {
+func (x *ListBeaconBadBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExecutionBadBlockRequest) ProtoMessage() {}
+func (*ListBeaconBadBlockRequest) ProtoMessage() {}
 
-func (x *ListExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3947,98 +4461,98 @@ func (x *ListExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExecutionBadBlockRequest.ProtoReflect.Descriptor instead.
-func (*ListExecutionBadBlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBeaconBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*ListBeaconBadBlockRequest) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListExecutionBadBlockRequest) GetNode() string {
+func (x *ListBeaconBadBlockRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
 	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetBlockNumber() int64 {
+func (x *ListBeaconBadBlockRequest) GetSlot() uint64 {
 	if x != nil {
-		return x.BlockNumber
+		return x.Slot
 	}
 	return 0
 }
 
-func (x *ListExecutionBadBlockRequest) GetBlockHash() string {
+func (x *ListBeaconBadBlockRequest) GetEpoch() uint64 {
 	if x != nil {
-		return x.BlockHash
+		return x.Epoch
 	}
-	return ""
+	return 0
 }
 
-func (x *ListExecutionBadBlockRequest) GetNetwork() string {
+func (x *ListBeaconBadBlockRequest) GetBlockRoot() string {
 	if x != nil {
-		return x.Network
+		return x.BlockRoot
 	}
 	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+func (x *ListBeaconBadBlockRequest) GetNodeVersion() string {
 	if x != nil {
-		return x.Before
+		return x.NodeVersion
 	}
-	return nil
+	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *ListBeaconBadBlockRequest) GetNetwork() string {
 	if x != nil {
-		return x.After
+		return x.Network
 	}
-	return nil
+	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetPagination() *PaginationCursor {
+func (x *ListBeaconBadBlockRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Pagination
+		return x.Before
 	}
 	return nil
 }
 
-func (x *ListExecutionBadBlockRequest) GetId() string {
+func (x *ListBeaconBadBlockRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.After
 	}
-	return ""
+	return nil
 }
 
-func (x *ListExecutionBadBlockRequest) GetExecutionImplementation() string {
+func (x *ListBeaconBadBlockRequest) GetPagination() *PaginationCursor {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *ListExecutionBadBlockRequest) GetNodeVersion() string {
+func (x *ListBeaconBadBlockRequest) GetBeaconImplementation() string {
 	if x != nil {
-		return x.NodeVersion
+		return x.BeaconImplementation
 	}
 	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetBlockExtraData() string {
+func (x *ListBeaconBadBlockRequest) GetId() string {
 	if x != nil {
-		return x.BlockExtraData
+		return x.Id
 	}
 	return ""
 }
 
-type ListExecutionBadBlockResponse struct {
+type ListBeaconBadBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExecutionBadBlocks []*ExecutionBadBlock `protobuf:"bytes,1,rep,name=execution_bad_blocks,proto3" json:"execution_bad_blocks,omitempty"`
+	BeaconBadBlocks []*BeaconBadBlock `protobuf:"bytes,1,rep,name=beacon_bad_blocks,proto3" json:"beacon_bad_blocks,omitempty"`
 }
 
-func (x *ListExecutionBadBlockResponse) Reset() {
-	*x = ListExecutionBadBlockResponse{}
+func (x *ListBeaconBadBlockResponse) Reset() {
+	*x = ListBeaconBadBlockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4046,13 +4560,13 @@ func (x *ListExecutionBadBlockResponse) Reset() {
 	}
 }
 
-func (x *ListExecutionBadBlockResponse) String() string {
+func (x *ListBeaconBadBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExecutionBadBlockResponse) ProtoMessage() {}
+func (*ListBeaconBadBlockResponse) ProtoMessage() {}
 
-func (x *ListExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *ListBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4064,36 +4578,36 @@ func (x *ListExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExecutionBadBlockResponse.ProtoReflect.Descriptor instead.
-func (*ListExecutionBadBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBeaconBadBlockResponse.ProtoReflect.Descriptor instead.
+func (*ListBeaconBadBlockResponse) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListExecutionBadBlockResponse) GetExecutionBadBlocks() []*ExecutionBadBlock {
+func (x *ListBeaconBadBlockResponse) GetBeaconBadBlocks() []*BeaconBadBlock {
 	if x != nil {
-		return x.ExecutionBadBlocks
+		return x.BeaconBadBlocks
 	}
 	return nil
 }
 
-type CountExecutionBadBlockRequest struct {
+type CountBeaconBadBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,proto3" json:"block_number,omitempty"`
-	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
-	ExecutionImplementation string                 `protobuf:"bytes,6,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
-	Before                  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
-	After                   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
-	NodeVersion             string                 `protobuf:"bytes,9,opt,name=node_version,proto3" json:"node_version,omitempty"`
-	BlockExtraData          string                 `protobuf:"bytes,10,opt,name=block_extra_data,proto3" json:"block_extra_data,omitempty"`
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,7,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
 }
 
-func (x *CountExecutionBadBlockRequest) Reset() {
-	*x = CountExecutionBadBlockRequest{}
+func (x *CountBeaconBadBlockRequest) Reset() {
+	*x = CountBeaconBadBlockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4101,13 +4615,13 @@ func (x *CountExecutionBadBlockRequest) Reset() {
 	}
 }
 
-func (x *CountExecutionBadBlockRequest) String() string {
+func (x *CountBeaconBadBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountExecutionBadBlockRequest) ProtoMessage() {}
+func (*CountBeaconBadBlockRequest) ProtoMessage() {}
 
-func (x *CountExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
+func (x *CountBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4119,75 +4633,75 @@ func (x *CountExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountExecutionBadBlockRequest.ProtoReflect.Descriptor instead.
-func (*CountExecutionBadBlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountBeaconBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*CountBeaconBadBlockRequest) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CountExecutionBadBlockRequest) GetNode() string {
+func (x *CountBeaconBadBlockRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
 	return ""
 }
 
-func (x *CountExecutionBadBlockRequest) GetBlockNumber() int64 {
+func (x *CountBeaconBadBlockRequest) GetSlot() uint64 {
 	if x != nil {
-		return x.BlockNumber
+		return x.Slot
 	}
 	return 0
 }
 
-func (x *CountExecutionBadBlockRequest) GetBlockHash() string {
+func (x *CountBeaconBadBlockRequest) GetEpoch() uint64 {
 	if x != nil {
-		return x.BlockHash
+		return x.Epoch
 	}
-	return ""
+	return 0
 }
 
-func (x *CountExecutionBadBlockRequest) GetNetwork() string {
+func (x *CountBeaconBadBlockRequest) GetBlockRoot() string {
 	if x != nil {
-		return x.Network
+		return x.BlockRoot
 	}
 	return ""
 }
 
-func (x *CountExecutionBadBlockRequest) GetExecutionImplementation() string {
+func (x *CountBeaconBadBlockRequest) GetNodeVersion() string {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.NodeVersion
 	}
 	return ""
 }
 
-func (x *CountExecutionBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+func (x *CountBeaconBadBlockRequest) GetNetwork() string {
 	if x != nil {
-		return x.Before
+		return x.Network
 	}
-	return nil
+	return ""
 }
 
-func (x *CountExecutionBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *CountBeaconBadBlockRequest) GetBeaconImplementation() string {
 	if x != nil {
-		return x.After
+		return x.BeaconImplementation
 	}
-	return nil
+	return ""
 }
 
-func (x *CountExecutionBadBlockRequest) GetNodeVersion() string {
+func (x *CountBeaconBadBlockRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NodeVersion
+		return x.Before
 	}
-	return ""
+	return nil
 }
 
-func (x *CountExecutionBadBlockRequest) GetBlockExtraData() string {
+func (x *CountBeaconBadBlockRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BlockExtraData
+		return x.After
 	}
-	return ""
+	return nil
 }
 
-type CountExecutionBadBlockResponse struct {
+type CountBeaconBadBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -4195,8 +4709,8 @@ type CountExecutionBadBlockResponse struct {
 	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *CountExecutionBadBlockResponse) Reset() {
-	*x = CountExecutionBadBlockResponse{}
+func (x *CountBeaconBadBlockResponse) Reset() {
+	*x = CountBeaconBadBlockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4204,13 +4718,13 @@ func (x *CountExecutionBadBlockResponse) Reset() {
 	}
 }
 
-func (x *CountExecutionBadBlockResponse) String() string {
+func (x *CountBeaconBadBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountExecutionBadBlockResponse) ProtoMessage() {}
+func (*CountBeaconBadBlockResponse) ProtoMessage() {}
 
-func (x *CountExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *CountBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4222,28 +4736,28 @@ func (x *CountExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountExecutionBadBlockResponse.ProtoReflect.Descriptor instead.
-func (*CountExecutionBadBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountBeaconBadBlockResponse.ProtoReflect.Descriptor instead.
+func (*CountBeaconBadBlockResponse) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CountExecutionBadBlockResponse) GetCount() *wrapperspb.UInt64Value {
+func (x *CountBeaconBadBlockResponse) GetCount() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Count
 	}
 	return nil
 }
 
-type ListUniqueExecutionBadBlockValuesRequest struct {
+type ListUniqueBeaconBadBlockValuesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueExecutionBadBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueExecutionBadBlockValuesRequest_Field" json:"fields,omitempty"`
+	Fields []ListUniqueBeaconBadBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueBeaconBadBlockValuesRequest_Field" json:"fields,omitempty"`
 }
 
-func (x *ListUniqueExecutionBadBlockValuesRequest) Reset() {
-	*x = ListUniqueExecutionBadBlockValuesRequest{}
+func (x *ListUniqueBeaconBadBlockValuesRequest) Reset() {
+	*x = ListUniqueBeaconBadBlockValuesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4251,13 +4765,13 @@ func (x *ListUniqueExecutionBadBlockValuesRequest) Reset() {
 	}
 }
 
-func (x *ListUniqueExecutionBadBlockValuesRequest) String() string {
+func (x *ListUniqueBeaconBadBlockValuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueExecutionBadBlockValuesRequest) ProtoMessage() {}
+func (*ListUniqueBeaconBadBlockValuesRequest) ProtoMessage() {}
 
-func (x *ListUniqueExecutionBadBlockValuesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListUniqueBeaconBadBlockValuesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4269,34 +4783,34 @@ func (x *ListUniqueExecutionBadBlockValuesRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueExecutionBadBlockValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueExecutionBadBlockValuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUniqueBeaconBadBlockValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBadBlockValuesRequest) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListUniqueExecutionBadBlockValuesRequest) GetFields() []ListUniqueExecutionBadBlockValuesRequest_Field {
+func (x *ListUniqueBeaconBadBlockValuesRequest) GetFields() []ListUniqueBeaconBadBlockValuesRequest_Field {
 	if x != nil {
 		return x.Fields
 	}
 	return nil
 }
 
-type ListUniqueExecutionBadBlockValuesResponse struct {
+type ListUniqueBeaconBadBlockValuesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
-	BlockHash               []string `protobuf:"bytes,2,rep,name=block_hash,proto3" json:"block_hash,omitempty"`
-	BlockNumber             []int64  `protobuf:"varint,3,rep,packed,name=block_number,proto3" json:"block_number,omitempty"`
-	Network                 []string `protobuf:"bytes,4,rep,name=network,proto3" json:"network,omitempty"`
-	NodeVersion             []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
-	ExecutionImplementation []string `protobuf:"bytes,6,rep,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
-	BlockExtraData          []string `protobuf:"bytes,7,rep,name=block_extra_data,proto3" json:"block_extra_data,omitempty"`
+	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              []string `protobuf:"bytes,6,rep,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation []string `protobuf:"bytes,7,rep,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) Reset() {
-	*x = ListUniqueExecutionBadBlockValuesResponse{}
+func (x *ListUniqueBeaconBadBlockValuesResponse) Reset() {
+	*x = ListUniqueBeaconBadBlockValuesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_api_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4304,13 +4818,13 @@ func (x *ListUniqueExecutionBadBlockValuesResponse) Reset() {
 	}
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) String() string {
+func (x *ListUniqueBeaconBadBlockValuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueExecutionBadBlockValuesResponse) ProtoMessage() {}
+func (*ListUniqueBeaconBadBlockValuesResponse) ProtoMessage() {}
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListUniqueBeaconBadBlockValuesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_api_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4322,95 +4836,2529 @@ func (x *ListUniqueExecutionBadBlockValuesResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueExecutionBadBlockValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueExecutionBadBlockValuesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUniqueBeaconBadBlockValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBadBlockValuesResponse) Descriptor() ([]byte, []int) {
 	return file_api_api_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetNode() []string {
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetNode() []string {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockHash() []string {
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetSlot() []uint64 {
 	if x != nil {
-		return x.BlockHash
+		return x.Slot
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockNumber() []int64 {
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetEpoch() []uint64 {
 	if x != nil {
-		return x.BlockNumber
+		return x.Epoch
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetNetwork() []string {
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetBlockRoot() []string {
 	if x != nil {
-		return x.Network
+		return x.BlockRoot
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetNodeVersion() []string {
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetNodeVersion() []string {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetExecutionImplementation() []string {
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetNetwork() []string {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.Network
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockExtraData() []string {
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetBeaconImplementation() []string {
 	if x != nil {
-		return x.BlockExtraData
+		return x.BeaconImplementation
 	}
 	return nil
 }
 
-var File_api_api_proto protoreflect.FileDescriptor
+type ListBeaconBadBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_api_api_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x03, 0x61, 0x70, 0x69, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x04, 0x0a, 0x0b, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73,
-	0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	Node                 string                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                  `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                  `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                  `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              string                  `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor       `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	BeaconImplementation string                  `protobuf:"bytes,10,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Id                   string                  `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Index                *wrapperspb.UInt64Value `protobuf:"bytes,12,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *ListBeaconBadBlobRequest) Reset() {
+	*x = ListBeaconBadBlobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBeaconBadBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBeaconBadBlobRequest) ProtoMessage() {}
+
+func (x *ListBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBeaconBadBlobRequest.ProtoReflect.Descriptor instead.
+func (*ListBeaconBadBlobRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListBeaconBadBlobRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ListBeaconBadBlobRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ListBeaconBadBlobRequest) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListBeaconBadBlobRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListBeaconBadBlobRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListBeaconBadBlobRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetIndex() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+type ListBeaconBadBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BeaconBadBlobs []*BeaconBadBlob `protobuf:"bytes,1,rep,name=beacon_bad_blobs,proto3" json:"beacon_bad_blobs,omitempty"`
+}
+
+func (x *ListBeaconBadBlobResponse) Reset() {
+	*x = ListBeaconBadBlobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBeaconBadBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBeaconBadBlobResponse) ProtoMessage() {}
+
+func (x *ListBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBeaconBadBlobResponse.ProtoReflect.Descriptor instead.
+func (*ListBeaconBadBlobResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListBeaconBadBlobResponse) GetBeaconBadBlobs() []*BeaconBadBlob {
+	if x != nil {
+		return x.BeaconBadBlobs
+	}
+	return nil
+}
+
+type CountBeaconBadBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                  `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                  `protobuf:"bytes,4,opt,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                  `protobuf:"bytes,5,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              string                  `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation string                  `protobuf:"bytes,7,opt,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Before               *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	Index                *wrapperspb.UInt64Value `protobuf:"bytes,10,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *CountBeaconBadBlobRequest) Reset() {
+	*x = CountBeaconBadBlobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBeaconBadBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBeaconBadBlobRequest) ProtoMessage() {}
+
+func (x *CountBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBeaconBadBlobRequest.ProtoReflect.Descriptor instead.
+func (*CountBeaconBadBlobRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CountBeaconBadBlobRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CountBeaconBadBlobRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CountBeaconBadBlobRequest) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountBeaconBadBlobRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountBeaconBadBlobRequest) GetIndex() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+type CountBeaconBadBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountBeaconBadBlobResponse) Reset() {
+	*x = CountBeaconBadBlobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBeaconBadBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBeaconBadBlobResponse) ProtoMessage() {}
+
+func (x *CountBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBeaconBadBlobResponse.ProtoReflect.Descriptor instead.
+func (*CountBeaconBadBlobResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CountBeaconBadBlobResponse) GetCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListUniqueBeaconBadBlobValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []ListUniqueBeaconBadBlobValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueBeaconBadBlobValuesRequest_Field" json:"fields,omitempty"`
+}
+
+func (x *ListUniqueBeaconBadBlobValuesRequest) Reset() {
+	*x = ListUniqueBeaconBadBlobValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBeaconBadBlobValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBeaconBadBlobValuesRequest) ProtoMessage() {}
+
+func (x *ListUniqueBeaconBadBlobValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBeaconBadBlobValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBadBlobValuesRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListUniqueBeaconBadBlobValuesRequest) GetFields() []ListUniqueBeaconBadBlobValuesRequest_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListUniqueBeaconBadBlobValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,proto3" json:"block_root,omitempty"`
+	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
+	Network              []string `protobuf:"bytes,6,rep,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation []string `protobuf:"bytes,7,rep,name=beacon_implementation,proto3" json:"beacon_implementation,omitempty"`
+	Index                []uint64 `protobuf:"varint,8,rep,packed,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) Reset() {
+	*x = ListUniqueBeaconBadBlobValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBeaconBadBlobValuesResponse) ProtoMessage() {}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBeaconBadBlobValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBadBlobValuesResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetNode() []string {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetSlot() []uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetEpoch() []uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetBlockRoot() []string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetBeaconImplementation() []string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetIndex() []uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+type ListExecutionBlockTraceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
+	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination              *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                      string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,10,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,11,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Tracer                  string                 `protobuf:"bytes,12,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	Canonical               *wrapperspb.BoolValue  `protobuf:"bytes,13,opt,name=canonical,proto3" json:"canonical,omitempty"`
+}
+
+func (x *ListExecutionBlockTraceRequest) Reset() {
+	*x = ListExecutionBlockTraceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExecutionBlockTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionBlockTraceRequest) ProtoMessage() {}
+
+func (x *ListExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListExecutionBlockTraceRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListExecutionBlockTraceRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ListExecutionBlockTraceRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *ListExecutionBlockTraceRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ListExecutionBlockTraceRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListExecutionBlockTraceRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListExecutionBlockTraceRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListExecutionBlockTraceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListExecutionBlockTraceRequest) GetExecutionImplementation() string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return ""
+}
+
+func (x *ListExecutionBlockTraceRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *ListExecutionBlockTraceRequest) GetTracer() string {
+	if x != nil {
+		return x.Tracer
+	}
+	return ""
+}
+
+func (x *ListExecutionBlockTraceRequest) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type ListExecutionBlockTraceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExecutionBlockTraces []*ExecutionBlockTrace `protobuf:"bytes,1,rep,name=execution_block_traces,proto3" json:"execution_block_traces,omitempty"`
+}
+
+func (x *ListExecutionBlockTraceResponse) Reset() {
+	*x = ListExecutionBlockTraceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExecutionBlockTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionBlockTraceResponse) ProtoMessage() {}
+
+func (x *ListExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionBlockTraceResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionBlockTraceResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListExecutionBlockTraceResponse) GetExecutionBlockTraces() []*ExecutionBlockTrace {
+	if x != nil {
+		return x.ExecutionBlockTraces
+	}
+	return nil
+}
+
+type CountExecutionBlockTraceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,6,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	Before                  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After                   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,9,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	Tracer                  string                 `protobuf:"bytes,10,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	Canonical               *wrapperspb.BoolValue  `protobuf:"bytes,11,opt,name=canonical,proto3" json:"canonical,omitempty"`
+}
+
+func (x *CountExecutionBlockTraceRequest) Reset() {
+	*x = CountExecutionBlockTraceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountExecutionBlockTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountExecutionBlockTraceRequest) ProtoMessage() {}
+
+func (x *CountExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
+func (*CountExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CountExecutionBlockTraceRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CountExecutionBlockTraceRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *CountExecutionBlockTraceRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *CountExecutionBlockTraceRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CountExecutionBlockTraceRequest) GetExecutionImplementation() string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return ""
+}
+
+func (x *CountExecutionBlockTraceRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountExecutionBlockTraceRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountExecutionBlockTraceRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *CountExecutionBlockTraceRequest) GetTracer() string {
+	if x != nil {
+		return x.Tracer
+	}
+	return ""
+}
+
+func (x *CountExecutionBlockTraceRequest) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type CountExecutionBlockTraceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountExecutionBlockTraceResponse) Reset() {
+	*x = CountExecutionBlockTraceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountExecutionBlockTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountExecutionBlockTraceResponse) ProtoMessage() {}
+
+func (x *CountExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountExecutionBlockTraceResponse.ProtoReflect.Descriptor instead.
+func (*CountExecutionBlockTraceResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CountExecutionBlockTraceResponse) GetCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListUniqueExecutionBlockTraceValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []ListUniqueExecutionBlockTraceValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueExecutionBlockTraceValuesRequest_Field" json:"fields,omitempty"`
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesRequest) Reset() {
+	*x = ListUniqueExecutionBlockTraceValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueExecutionBlockTraceValuesRequest) ProtoMessage() {}
+
+func (x *ListUniqueExecutionBlockTraceValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueExecutionBlockTraceValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionBlockTraceValuesRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesRequest) GetFields() []ListUniqueExecutionBlockTraceValuesRequest_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListUniqueExecutionBlockTraceValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	BlockHash               []string `protobuf:"bytes,2,rep,name=block_hash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             []int64  `protobuf:"varint,3,rep,packed,name=block_number,proto3" json:"block_number,omitempty"`
+	Network                 []string `protobuf:"bytes,4,rep,name=network,proto3" json:"network,omitempty"`
+	NodeVersion             []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
+	ExecutionImplementation []string `protobuf:"bytes,6,rep,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	Tracer                  []string `protobuf:"bytes,7,rep,name=tracer,proto3" json:"tracer,omitempty"`
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) Reset() {
+	*x = ListUniqueExecutionBlockTraceValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueExecutionBlockTraceValuesResponse) ProtoMessage() {}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueExecutionBlockTraceValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionBlockTraceValuesResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNode() []string {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetBlockHash() []string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetBlockNumber() []int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetExecutionImplementation() []string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetTracer() []string {
+	if x != nil {
+		return x.Tracer
+	}
+	return nil
+}
+
+type DiffExecutionBlockTraceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OtherId string `protobuf:"bytes,2,opt,name=other_id,proto3" json:"other_id,omitempty"`
+}
+
+func (x *DiffExecutionBlockTraceRequest) Reset() {
+	*x = DiffExecutionBlockTraceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffExecutionBlockTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffExecutionBlockTraceRequest) ProtoMessage() {}
+
+func (x *DiffExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
+func (*DiffExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DiffExecutionBlockTraceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiffExecutionBlockTraceRequest) GetOtherId() string {
+	if x != nil {
+		return x.OtherId
+	}
+	return ""
+}
+
+type ExecutionBlockTraceStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pc      uint64 `protobuf:"varint,1,opt,name=pc,proto3" json:"pc,omitempty"`
+	Op      string `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Gas     uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasCost uint64 `protobuf:"varint,4,opt,name=gas_cost,proto3" json:"gas_cost,omitempty"`
+	Depth   uint64 `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (x *ExecutionBlockTraceStep) Reset() {
+	*x = ExecutionBlockTraceStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionBlockTraceStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionBlockTraceStep) ProtoMessage() {}
+
+func (x *ExecutionBlockTraceStep) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionBlockTraceStep.ProtoReflect.Descriptor instead.
+func (*ExecutionBlockTraceStep) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ExecutionBlockTraceStep) GetPc() uint64 {
+	if x != nil {
+		return x.Pc
+	}
+	return 0
+}
+
+func (x *ExecutionBlockTraceStep) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *ExecutionBlockTraceStep) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *ExecutionBlockTraceStep) GetGasCost() uint64 {
+	if x != nil {
+		return x.GasCost
+	}
+	return 0
+}
+
+func (x *ExecutionBlockTraceStep) GetDepth() uint64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type ExecutionBlockTraceValueDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value      string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	OtherValue string `protobuf:"bytes,3,opt,name=other_value,proto3" json:"other_value,omitempty"`
+}
+
+func (x *ExecutionBlockTraceValueDiff) Reset() {
+	*x = ExecutionBlockTraceValueDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionBlockTraceValueDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionBlockTraceValueDiff) ProtoMessage() {}
+
+func (x *ExecutionBlockTraceValueDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionBlockTraceValueDiff.ProtoReflect.Descriptor instead.
+func (*ExecutionBlockTraceValueDiff) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ExecutionBlockTraceValueDiff) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExecutionBlockTraceValueDiff) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ExecutionBlockTraceValueDiff) GetOtherValue() string {
+	if x != nil {
+		return x.OtherValue
+	}
+	return ""
+}
+
+type ExecutionBlockTraceStepDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index           uint64                          `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	DifferingFields []string                        `protobuf:"bytes,2,rep,name=differing_fields,proto3" json:"differing_fields,omitempty"`
+	Step            *ExecutionBlockTraceStep        `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
+	OtherStep       *ExecutionBlockTraceStep        `protobuf:"bytes,4,opt,name=other_step,proto3" json:"other_step,omitempty"`
+	GasDelta        int64                           `protobuf:"varint,5,opt,name=gas_delta,proto3" json:"gas_delta,omitempty"`
+	Stack           []*ExecutionBlockTraceValueDiff `protobuf:"bytes,6,rep,name=stack,proto3" json:"stack,omitempty"`
+	Memory          []*ExecutionBlockTraceValueDiff `protobuf:"bytes,7,rep,name=memory,proto3" json:"memory,omitempty"`
+	Storage         []*ExecutionBlockTraceValueDiff `protobuf:"bytes,8,rep,name=storage,proto3" json:"storage,omitempty"`
+}
+
+func (x *ExecutionBlockTraceStepDiff) Reset() {
+	*x = ExecutionBlockTraceStepDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionBlockTraceStepDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionBlockTraceStepDiff) ProtoMessage() {}
+
+func (x *ExecutionBlockTraceStepDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionBlockTraceStepDiff.ProtoReflect.Descriptor instead.
+func (*ExecutionBlockTraceStepDiff) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ExecutionBlockTraceStepDiff) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ExecutionBlockTraceStepDiff) GetDifferingFields() []string {
+	if x != nil {
+		return x.DifferingFields
+	}
+	return nil
+}
+
+func (x *ExecutionBlockTraceStepDiff) GetStep() *ExecutionBlockTraceStep {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *ExecutionBlockTraceStepDiff) GetOtherStep() *ExecutionBlockTraceStep {
+	if x != nil {
+		return x.OtherStep
+	}
+	return nil
+}
+
+func (x *ExecutionBlockTraceStepDiff) GetGasDelta() int64 {
+	if x != nil {
+		return x.GasDelta
+	}
+	return 0
+}
+
+func (x *ExecutionBlockTraceStepDiff) GetStack() []*ExecutionBlockTraceValueDiff {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+func (x *ExecutionBlockTraceStepDiff) GetMemory() []*ExecutionBlockTraceValueDiff {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *ExecutionBlockTraceStepDiff) GetStorage() []*ExecutionBlockTraceValueDiff {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+type ExecutionBlockTraceTransactionDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionIndex   uint64                       `protobuf:"varint,1,opt,name=transaction_index,proto3" json:"transaction_index,omitempty"`
+	TransactionHash    string                       `protobuf:"bytes,2,opt,name=transaction_hash,proto3" json:"transaction_hash,omitempty"`
+	DifferingFields    []string                     `protobuf:"bytes,3,rep,name=differing_fields,proto3" json:"differing_fields,omitempty"`
+	Gas                uint64                       `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
+	OtherGas           uint64                       `protobuf:"varint,5,opt,name=other_gas,proto3" json:"other_gas,omitempty"`
+	Failed             bool                         `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	OtherFailed        bool                         `protobuf:"varint,7,opt,name=other_failed,proto3" json:"other_failed,omitempty"`
+	ReturnValue        string                       `protobuf:"bytes,8,opt,name=return_value,proto3" json:"return_value,omitempty"`
+	OtherReturnValue   string                       `protobuf:"bytes,9,opt,name=other_return_value,proto3" json:"other_return_value,omitempty"`
+	StepCount          uint64                       `protobuf:"varint,10,opt,name=step_count,proto3" json:"step_count,omitempty"`
+	OtherStepCount     uint64                       `protobuf:"varint,11,opt,name=other_step_count,proto3" json:"other_step_count,omitempty"`
+	FirstDivergentStep *ExecutionBlockTraceStepDiff `protobuf:"bytes,12,opt,name=first_divergent_step,proto3" json:"first_divergent_step,omitempty"`
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) Reset() {
+	*x = ExecutionBlockTraceTransactionDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionBlockTraceTransactionDiff) ProtoMessage() {}
+
+func (x *ExecutionBlockTraceTransactionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionBlockTraceTransactionDiff.ProtoReflect.Descriptor instead.
+func (*ExecutionBlockTraceTransactionDiff) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetTransactionIndex() uint64 {
+	if x != nil {
+		return x.TransactionIndex
+	}
+	return 0
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetDifferingFields() []string {
+	if x != nil {
+		return x.DifferingFields
+	}
+	return nil
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetOtherGas() uint64 {
+	if x != nil {
+		return x.OtherGas
+	}
+	return 0
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetOtherFailed() bool {
+	if x != nil {
+		return x.OtherFailed
+	}
+	return false
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetReturnValue() string {
+	if x != nil {
+		return x.ReturnValue
+	}
+	return ""
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetOtherReturnValue() string {
+	if x != nil {
+		return x.OtherReturnValue
+	}
+	return ""
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetStepCount() uint64 {
+	if x != nil {
+		return x.StepCount
+	}
+	return 0
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetOtherStepCount() uint64 {
+	if x != nil {
+		return x.OtherStepCount
+	}
+	return 0
+}
+
+func (x *ExecutionBlockTraceTransactionDiff) GetFirstDivergentStep() *ExecutionBlockTraceStepDiff {
+	if x != nil {
+		return x.FirstDivergentStep
+	}
+	return nil
+}
+
+type DiffExecutionBlockTraceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trace                 *ExecutionBlockTrace                `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
+	OtherTrace            *ExecutionBlockTrace                `protobuf:"bytes,2,opt,name=other_trace,proto3" json:"other_trace,omitempty"`
+	Identical             bool                                `protobuf:"varint,3,opt,name=identical,proto3" json:"identical,omitempty"`
+	TransactionCount      uint64                              `protobuf:"varint,4,opt,name=transaction_count,proto3" json:"transaction_count,omitempty"`
+	OtherTransactionCount uint64                              `protobuf:"varint,5,opt,name=other_transaction_count,proto3" json:"other_transaction_count,omitempty"`
+	FirstDivergence       *ExecutionBlockTraceTransactionDiff `protobuf:"bytes,6,opt,name=first_divergence,proto3" json:"first_divergence,omitempty"`
+}
+
+func (x *DiffExecutionBlockTraceResponse) Reset() {
+	*x = DiffExecutionBlockTraceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffExecutionBlockTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffExecutionBlockTraceResponse) ProtoMessage() {}
+
+func (x *DiffExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffExecutionBlockTraceResponse.ProtoReflect.Descriptor instead.
+func (*DiffExecutionBlockTraceResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DiffExecutionBlockTraceResponse) GetTrace() *ExecutionBlockTrace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *DiffExecutionBlockTraceResponse) GetOtherTrace() *ExecutionBlockTrace {
+	if x != nil {
+		return x.OtherTrace
+	}
+	return nil
+}
+
+func (x *DiffExecutionBlockTraceResponse) GetIdentical() bool {
+	if x != nil {
+		return x.Identical
+	}
+	return false
+}
+
+func (x *DiffExecutionBlockTraceResponse) GetTransactionCount() uint64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *DiffExecutionBlockTraceResponse) GetOtherTransactionCount() uint64 {
+	if x != nil {
+		return x.OtherTransactionCount
+	}
+	return 0
+}
+
+func (x *DiffExecutionBlockTraceResponse) GetFirstDivergence() *ExecutionBlockTraceTransactionDiff {
+	if x != nil {
+		return x.FirstDivergence
+	}
+	return nil
+}
+
+type ListExecutionWitnessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
+	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination              *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                      string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,10,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,11,opt,name=node_version,proto3" json:"node_version,omitempty"`
+}
+
+func (x *ListExecutionWitnessRequest) Reset() {
+	*x = ListExecutionWitnessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExecutionWitnessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionWitnessRequest) ProtoMessage() {}
+
+func (x *ListExecutionWitnessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionWitnessRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionWitnessRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListExecutionWitnessRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListExecutionWitnessRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ListExecutionWitnessRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *ListExecutionWitnessRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ListExecutionWitnessRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListExecutionWitnessRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListExecutionWitnessRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListExecutionWitnessRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListExecutionWitnessRequest) GetExecutionImplementation() string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return ""
+}
+
+func (x *ListExecutionWitnessRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+type ListExecutionWitnessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExecutionWitnesses []*ExecutionWitness `protobuf:"bytes,1,rep,name=execution_witnesses,proto3" json:"execution_witnesses,omitempty"`
+}
+
+func (x *ListExecutionWitnessResponse) Reset() {
+	*x = ListExecutionWitnessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExecutionWitnessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionWitnessResponse) ProtoMessage() {}
+
+func (x *ListExecutionWitnessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionWitnessResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionWitnessResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListExecutionWitnessResponse) GetExecutionWitnesses() []*ExecutionWitness {
+	if x != nil {
+		return x.ExecutionWitnesses
+	}
+	return nil
+}
+
+type CountExecutionWitnessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,6,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	Before                  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After                   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,9,opt,name=node_version,proto3" json:"node_version,omitempty"`
+}
+
+func (x *CountExecutionWitnessRequest) Reset() {
+	*x = CountExecutionWitnessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountExecutionWitnessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountExecutionWitnessRequest) ProtoMessage() {}
+
+func (x *CountExecutionWitnessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountExecutionWitnessRequest.ProtoReflect.Descriptor instead.
+func (*CountExecutionWitnessRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CountExecutionWitnessRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CountExecutionWitnessRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *CountExecutionWitnessRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *CountExecutionWitnessRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CountExecutionWitnessRequest) GetExecutionImplementation() string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return ""
+}
+
+func (x *CountExecutionWitnessRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountExecutionWitnessRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountExecutionWitnessRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+type CountExecutionWitnessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountExecutionWitnessResponse) Reset() {
+	*x = CountExecutionWitnessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountExecutionWitnessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountExecutionWitnessResponse) ProtoMessage() {}
+
+func (x *CountExecutionWitnessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountExecutionWitnessResponse.ProtoReflect.Descriptor instead.
+func (*CountExecutionWitnessResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CountExecutionWitnessResponse) GetCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListUniqueExecutionWitnessValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []ListUniqueExecutionWitnessValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueExecutionWitnessValuesRequest_Field" json:"fields,omitempty"`
+}
+
+func (x *ListUniqueExecutionWitnessValuesRequest) Reset() {
+	*x = ListUniqueExecutionWitnessValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueExecutionWitnessValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueExecutionWitnessValuesRequest) ProtoMessage() {}
+
+func (x *ListUniqueExecutionWitnessValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueExecutionWitnessValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionWitnessValuesRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListUniqueExecutionWitnessValuesRequest) GetFields() []ListUniqueExecutionWitnessValuesRequest_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListUniqueExecutionWitnessValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	BlockHash               []string `protobuf:"bytes,2,rep,name=block_hash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             []int64  `protobuf:"varint,3,rep,packed,name=block_number,proto3" json:"block_number,omitempty"`
+	Network                 []string `protobuf:"bytes,4,rep,name=network,proto3" json:"network,omitempty"`
+	NodeVersion             []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
+	ExecutionImplementation []string `protobuf:"bytes,6,rep,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+}
+
+func (x *ListUniqueExecutionWitnessValuesResponse) Reset() {
+	*x = ListUniqueExecutionWitnessValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueExecutionWitnessValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueExecutionWitnessValuesResponse) ProtoMessage() {}
+
+func (x *ListUniqueExecutionWitnessValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueExecutionWitnessValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionWitnessValuesResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListUniqueExecutionWitnessValuesResponse) GetNode() []string {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionWitnessValuesResponse) GetBlockHash() []string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionWitnessValuesResponse) GetBlockNumber() []int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionWitnessValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionWitnessValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionWitnessValuesResponse) GetExecutionImplementation() []string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return nil
+}
+
+type ListExecutionBadBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
+	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination              *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                      string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,10,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,11,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	BlockExtraData          string                 `protobuf:"bytes,12,opt,name=block_extra_data,proto3" json:"block_extra_data,omitempty"`
+}
+
+func (x *ListExecutionBadBlockRequest) Reset() {
+	*x = ListExecutionBadBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExecutionBadBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionBadBlockRequest) ProtoMessage() {}
+
+func (x *ListExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionBadBlockRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListExecutionBadBlockRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ListExecutionBadBlockRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListExecutionBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListExecutionBadBlockRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListExecutionBadBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetExecutionImplementation() string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetBlockExtraData() string {
+	if x != nil {
+		return x.BlockExtraData
+	}
+	return ""
+}
+
+type ListExecutionBadBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExecutionBadBlocks []*ExecutionBadBlock `protobuf:"bytes,1,rep,name=execution_bad_blocks,proto3" json:"execution_bad_blocks,omitempty"`
+}
+
+func (x *ListExecutionBadBlockResponse) Reset() {
+	*x = ListExecutionBadBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExecutionBadBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionBadBlockResponse) ProtoMessage() {}
+
+func (x *ListExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionBadBlockResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionBadBlockResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListExecutionBadBlockResponse) GetExecutionBadBlocks() []*ExecutionBadBlock {
+	if x != nil {
+		return x.ExecutionBadBlocks
+	}
+	return nil
+}
+
+type CountExecutionBadBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,6,opt,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	Before                  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After                   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,9,opt,name=node_version,proto3" json:"node_version,omitempty"`
+	BlockExtraData          string                 `protobuf:"bytes,10,opt,name=block_extra_data,proto3" json:"block_extra_data,omitempty"`
+}
+
+func (x *CountExecutionBadBlockRequest) Reset() {
+	*x = CountExecutionBadBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountExecutionBadBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountExecutionBadBlockRequest) ProtoMessage() {}
+
+func (x *CountExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountExecutionBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*CountExecutionBadBlockRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CountExecutionBadBlockRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CountExecutionBadBlockRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *CountExecutionBadBlockRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *CountExecutionBadBlockRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CountExecutionBadBlockRequest) GetExecutionImplementation() string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return ""
+}
+
+func (x *CountExecutionBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountExecutionBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountExecutionBadBlockRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *CountExecutionBadBlockRequest) GetBlockExtraData() string {
+	if x != nil {
+		return x.BlockExtraData
+	}
+	return ""
+}
+
+type CountExecutionBadBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountExecutionBadBlockResponse) Reset() {
+	*x = CountExecutionBadBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountExecutionBadBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountExecutionBadBlockResponse) ProtoMessage() {}
+
+func (x *CountExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountExecutionBadBlockResponse.ProtoReflect.Descriptor instead.
+func (*CountExecutionBadBlockResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CountExecutionBadBlockResponse) GetCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListUniqueExecutionBadBlockValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []ListUniqueExecutionBadBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=api.ListUniqueExecutionBadBlockValuesRequest_Field" json:"fields,omitempty"`
+}
+
+func (x *ListUniqueExecutionBadBlockValuesRequest) Reset() {
+	*x = ListUniqueExecutionBadBlockValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueExecutionBadBlockValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueExecutionBadBlockValuesRequest) ProtoMessage() {}
+
+func (x *ListUniqueExecutionBadBlockValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueExecutionBadBlockValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionBadBlockValuesRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListUniqueExecutionBadBlockValuesRequest) GetFields() []ListUniqueExecutionBadBlockValuesRequest_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListUniqueExecutionBadBlockValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	BlockHash               []string `protobuf:"bytes,2,rep,name=block_hash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             []int64  `protobuf:"varint,3,rep,packed,name=block_number,proto3" json:"block_number,omitempty"`
+	Network                 []string `protobuf:"bytes,4,rep,name=network,proto3" json:"network,omitempty"`
+	NodeVersion             []string `protobuf:"bytes,5,rep,name=node_version,proto3" json:"node_version,omitempty"`
+	ExecutionImplementation []string `protobuf:"bytes,6,rep,name=execution_implementation,proto3" json:"execution_implementation,omitempty"`
+	BlockExtraData          []string `protobuf:"bytes,7,rep,name=block_extra_data,proto3" json:"block_extra_data,omitempty"`
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) Reset() {
+	*x = ListUniqueExecutionBadBlockValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueExecutionBadBlockValuesResponse) ProtoMessage() {}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueExecutionBadBlockValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionBadBlockValuesResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetNode() []string {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockHash() []string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockNumber() []int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetExecutionImplementation() []string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockExtraData() []string {
+	if x != nil {
+		return x.BlockExtraData
+	}
+	return nil
+}
+
+var File_api_api_proto protoreflect.FileDescriptor
+
+var file_api_api_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x03, 0x61, 0x70, 0x69, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x05, 0x0a, 0x0b, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
 	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
 	0x52, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
@@ -4418,76 +7366,272 @@ var file_api_api_proto_rawDesc = []byte{
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x65,
 	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x04, 0x0a, 0x0b, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x48, 0x0a,
+	0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
+	0x6c, 0x22, 0xd5, 0x04, 0x0a, 0x0b, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x30, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x52, 0x0a, 0x15, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09,
+	0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0xdd, 0x04, 0x0a, 0x0b, 0x42, 0x6c,
+	0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12,
-	0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f,
-	0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
-	0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
-	0x6f, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x52, 0x0a,
-	0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x9e, 0x04, 0x0a, 0x0e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x52, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x73, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x92, 0x04, 0x0a, 0x0a, 0x46, 0x6f,
+	0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x52, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9e,
+	0x04, 0x0a, 0x0e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x30, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c,
-	0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x52, 0x0a, 0x15, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x72, 0x6f, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xd1, 0x04, 0x0a, 0x0d, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x62, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x30, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x52, 0x0a, 0x15, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x32, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0xf4, 0x04, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x52,
-	0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0xd1, 0x04, 0x0a, 0x0d, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64,
-	0x42, 0x6c, 0x6f, 0x62, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3f, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x58,
+	0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x18,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72,
+	0x12, 0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x81, 0x04, 0x0a, 0x10, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12,
+	0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x3a, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3f, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x58, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xda,
+	0x05, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
@@ -4497,233 +7641,287 @@ var file_api_api_proto_rawDesc = []byte{
 	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
 	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c,
-	0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x72, 0x6f, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x12, 0x3c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3f,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x58, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x52,
-	0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x84, 0x04, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x2c,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3a,
-	0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
-	0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3f, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a,
+	0x09, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x68, 0x61,
+	0x73, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x16, 0x68, 0x61, 0x73, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x16, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0xaf, 0x08, 0x0a, 0x0f,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x12, 0x58, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x04,
-	0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0b, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x46, 0x0a, 0x0f, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12,
-	0x3c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3f, 0x0a,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36,
-	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x58, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x12, 0x52, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x1b, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5b, 0x0a, 0x10,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x10, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x54, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x16, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x6a, 0x0a, 0x21, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x21, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x18, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a,
+	0x10, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x38,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x39, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x22, 0x6c, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x6f, 0x6f,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54,
+	0x6f, 0x6f, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c,
+	0x73, 0x22, 0x63, 0x0a, 0x15, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x6f, 0x6c, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x63, 0x6c, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04,
+	0x6e, 0x63, 0x6c, 0x69, 0x12, 0x2c, 0x0a, 0x04, 0x6c, 0x63, 0x6c, 0x69, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x6c, 0x63,
+	0x6c, 0x69, 0x12, 0x23, 0x0a, 0x04, 0x7a, 0x63, 0x6c, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x5a, 0x63, 0x6c, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x04, 0x7a, 0x63, 0x6c, 0x69, 0x22, 0x4d, 0x0a, 0x13, 0x47, 0x69, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x20, 0x0a, 0x0a, 0x5a, 0x63, 0x6c, 0x69, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x22, 0xb7, 0x04, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x38, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x39, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65,
-	0x75, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
-	0x75, 0x6d, 0x22, 0x6c, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72,
-	0x65, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f,
-	0x6f, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73,
-	0x22, 0x63, 0x0a, 0x15, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x63, 0x6c, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x6e,
-	0x63, 0x6c, 0x69, 0x12, 0x2c, 0x0a, 0x04, 0x6c, 0x63, 0x6c, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x6c, 0x63, 0x6c,
-	0x69, 0x12, 0x23, 0x0a, 0x04, 0x7a, 0x63, 0x6c, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x5a, 0x63, 0x6c, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x04, 0x7a, 0x63, 0x6c, 0x69, 0x22, 0x4d, 0x0a, 0x13, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a,
-	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a,
-	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x20, 0x0a, 0x0a, 0x5a, 0x63, 0x6c, 0x69, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x22, 0x97, 0x03, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
-	0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32,
-	0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61,
-	0x66, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x62,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x51, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0d,
-	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x73, 0x22, 0xd1, 0x02, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22,
-	0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15,
 	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61,
 	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f,
+	0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63,
+	0x61, 0x6c, 0x22, 0x51, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0xf1, 0x03, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a,
+	0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06,
-	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x22, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x45, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
-	0x74, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x10, 0x02, 0x12, 0x0e,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x10, 0x03, 0x12, 0x10,
-	0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x04,
-	0x12, 0x0b, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x05, 0x12, 0x19, 0x0a,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09,
+	0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x4e, 0x0a, 0x18, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x22, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x45, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x10, 0x02, 0x12,
+	0x0e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x10, 0x03, 0x12,
+	0x10, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10,
+	0x04, 0x12, 0x0b, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x05, 0x12, 0x19,
+	0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x22, 0xf7, 0x01, 0x0a, 0x23, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a,
 	0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x22, 0xf7, 0x01, 0x0a, 0x23, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22,
-	0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15,
-	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x97, 0x03, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xd8, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x62,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x11, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x22, 0xd1, 0x03, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
 	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64,
 	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
@@ -4748,569 +7946,1026 @@ var file_api_api_proto_rawDesc = []byte{
 	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
 	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d,
 	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x17,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22,
-	0xd1, 0x02, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a,
-	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
-	0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66,
-	0x74, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x22, 0x70, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f,
-	0x64, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x10, 0x01, 0x12, 0x09,
-	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x10, 0x06, 0x22, 0xf7, 0x01, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x03,
-	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e,
+	0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x51, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0d, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x8b, 0x03, 0x0a, 0x17, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
 	0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61,
-	0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x1a, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x11, 0x62, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x11, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x5f, 0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0xd4, 0x02, 0x0a, 0x1a,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c,
-	0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06,
-	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74,
-	0x65, 0x72, 0x22, 0x51, 0x0a, 0x1b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x48, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x05, 0x46, 0x69, 0x65,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x63, 0x61,
+	0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x4e, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x10, 0x02, 0x12, 0x0e, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x10, 0x03, 0x12, 0x10, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12,
+	0x0b, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x22, 0xf7, 0x01, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xbb, 0x03, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x35,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x51, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x62, 0x6c,
+	0x6f, 0x62, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x73, 0x22, 0xf5, 0x02, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x62,
+	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x4e, 0x0a, 0x18, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x22, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x45, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65,
 	0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
 	0x73, 0x6c, 0x6f, 0x74, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x10,
 	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x10,
 	0x03, 0x12, 0x10, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
 	0x6e, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x05,
 	0x12, 0x19, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x22, 0xfa, 0x01, 0x0a, 0x26,
-	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
-	0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f,
-	0x6f, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcd, 0x03, 0x0a, 0x18, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x73,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x10, 0x07, 0x22, 0x9b, 0x02,
+	0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x6c, 0x6f, 0x62,
+	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8e, 0x03, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
 	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a,
 	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
-	0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x6f, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
 	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62,
-	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
 	0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73,
 	0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
 	0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62,
 	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x5b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x10, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f,
-	0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x62, 0x52, 0x10, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f,
-	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x22, 0x87, 0x03, 0x0a, 0x19, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
-	0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
-	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74,
-	0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x66,
+	0x6f, 0x72, 0x6b, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0xc8, 0x02, 0x0a, 0x16,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22,
-	0x50, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61,
-	0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0xec, 0x01, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x06, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x22, 0x7b, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x10, 0x01,
-	0x12, 0x09, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x0b, 0x0a,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x62, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x10, 0x07,
-	0x22, 0x8f, 0x02, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c,
-	0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x22, 0x9f, 0x03, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a,
-	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61,
-	0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46,
+	0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6b,
+	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x22, 0x6c, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x10, 0x06, 0x22,
+	0xee, 0x01, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x6f,
+	0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x9a, 0x03, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
+	0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30,
+	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f,
 	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x52, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x22, 0xd8, 0x02, 0x0a, 0x1f, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5f, 0x0a,
+	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x11, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x11, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0xd4,
+	0x02, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64,
-	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x3a,
-	0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65,
-	0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x51, 0x0a, 0x1b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x25, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x48, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x05,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x22, 0xfa,
+	0x01, 0x0a, 0x26, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcd, 0x03, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x5b, 0x0a, 0x19, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x10, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
+	0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x10, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62,
+	0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x22, 0x87, 0x03, 0x0a, 0x19, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x32,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x50, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x7b, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x10, 0x02, 0x12, 0x0e,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x10, 0x03, 0x12, 0x10,
+	0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x04,
+	0x12, 0x0b, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x05, 0x12, 0x19, 0x0a,
+	0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x10, 0x07, 0x22, 0x8f, 0x02, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xf1, 0x03, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30,
-	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
-	0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x20, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xed, 0x01, 0x0a,
-	0x2a, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x06, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12,
+	0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09,
+	0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x73, 0x0a, 0x1f, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x16,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x22, 0xaa,
+	0x03, 0x0a, 0x1f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x72, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x56, 0x0a, 0x20, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xf9, 0x01, 0x0a, 0x2a, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x4d, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x35, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x22, 0x7c, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x10, 0x06, 0x22,
+	0x97, 0x02, 0x0a, 0x2b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x22, 0x4c, 0x0a, 0x1e, 0x44, 0x69, 0x66,
+	0x66, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x7d, 0x0a, 0x17, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x70, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x67, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x68, 0x0a, 0x1c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x9e, 0x03, 0x0a, 0x1b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x74, 0x65, 0x70, 0x44, 0x69, 0x66, 0x66,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x10, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x12, 0x3c, 0x0a, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x74,
+	0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x74,
+	0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x39, 0x0a, 0x06, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x06, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63,
 	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x05, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a,
-	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x10, 0x01, 0x12, 0x10, 0x0a,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x10, 0x02, 0x12,
-	0x0b, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x1c,
-	0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x05, 0x22, 0xff, 0x01, 0x0a,
-	0x2b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22,
-	0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc9,
-	0x03, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x61, 0x6c, 0x75, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x22, 0x8c, 0x04, 0x0a, 0x22, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64, 0x69,
+	0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e,
+	0x0a, 0x12, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x10, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f,
+	0x73, 0x74, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x14, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x65, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x53, 0x74, 0x65, 0x70, 0x44, 0x69, 0x66, 0x66, 0x52, 0x14, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x65, 0x70,
+	0x22, 0xe8, 0x02, 0x0a, 0x1f, 0x44, 0x69, 0x66, 0x66, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x05, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x52, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x2c,
+	0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x17,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x9c, 0x03, 0x0a, 0x1b,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x1c, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x13,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x22, 0xd5, 0x02, 0x0a, 0x1c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x1d, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xe7, 0x01, 0x0a, 0x27, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x10, 0x02, 0x12, 0x0b, 0x0a,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x05, 0x22, 0xfc, 0x01, 0x0a, 0x28, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a,
+	0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc9, 0x03, 0x0a, 0x1c, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6b, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x14, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x22, 0x82, 0x03, 0x0a, 0x1d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62,
-	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73,
-	0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a,
-	0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
-	0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6b, 0x0a, 0x1d, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x14, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x1e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
 	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x82, 0x03, 0x0a, 0x1d, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66,
-	0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x80, 0x02,
+	0x0a, 0x28, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x10, 0x02, 0x12, 0x0b, 0x0a,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x10, 0x06,
+	0x22, 0xa9, 0x02, 0x0a, 0x29, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x2a, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x1e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
-	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x80, 0x02, 0x0a, 0x28, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x4b, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x86, 0x01, 0x0a,
-	0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x10, 0x00,
-	0x12, 0x0e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x10, 0x01,
-	0x12, 0x10, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x10, 0x03, 0x12,
-	0x10, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10,
-	0x04, 0x12, 0x1c, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x05, 0x12,
-	0x14, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x10, 0x06, 0x22, 0xa9, 0x02, 0x0a, 0x29, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x32, 0xfa, 0x0e, 0x0a, 0x03, 0x41, 0x50, 0x49, 0x12, 0x3c, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x1b, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x32, 0xab, 0x17, 0x0a,
+	0x03, 0x41, 0x50, 0x49, 0x12, 0x3c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
 	0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e,
-	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
-	0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x72, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a,
-	0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x1b, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f,
+	0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
+	0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x72, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f,
+	0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6b,
+	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46,
+	0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a,
+	0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
 	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x1e, 0x4c, 0x69,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x1e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69,
 	0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61,
-	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
-	0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x1d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64,
-	0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x1d, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
-	0x12, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x62, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
-	0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61,
-	0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x12,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x62, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
 	0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x66, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
-	0x72, 0x61, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64,
+	0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x66, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74,
 	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x8a, 0x01, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x61, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x8a, 0x01, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63,
 	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x60, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x66, 0x0a, 0x17, 0x44, 0x69, 0x66, 0x66, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2c, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15,
 	0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x61,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63,
+	0x0a, 0x16, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x84, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2d, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36,
-	0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x74, 0x68,
-	0x70, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x70, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6f, 0x6f, 0x72,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6f,
-	0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x84, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x74, 0x68, 0x70, 0x61, 0x6e, 0x64,
+	0x61, 0x6f, 0x70, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6f, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6f, 0x6f, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5325,226 +8980,377 @@ func file_api_api_proto_rawDescGZIP() []byte {
 	return file_api_api_proto_rawDescData
 }
 
-var file_api_api_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_api_api_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_api_api_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_api_api_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
 var file_api_api_proto_goTypes = []interface{}{
 	(ListUniqueBeaconStateValuesRequest_Field)(0),         // 0: api.ListUniqueBeaconStateValuesRequest.Field
 	(ListUniqueBeaconBlockValuesRequest_Field)(0),         // 1: api.ListUniqueBeaconBlockValuesRequest.Field
-	(ListUniqueBeaconBadBlockValuesRequest_Field)(0),      // 2: api.ListUniqueBeaconBadBlockValuesRequest.Field
-	(ListUniqueBeaconBadBlobValuesRequest_Field)(0),       // 3: api.ListUniqueBeaconBadBlobValuesRequest.Field
-	(ListUniqueExecutionBlockTraceValuesRequest_Field)(0), // 4: api.ListUniqueExecutionBlockTraceValuesRequest.Field
-	(ListUniqueExecutionBadBlockValuesRequest_Field)(0),   // 5: api.ListUniqueExecutionBadBlockValuesRequest.Field
-	(*BeaconState)(nil),                                 // 6: api.BeaconState
-	(*BeaconBlock)(nil),                                 // 7: api.BeaconBlock
-	(*BeaconBadBlock)(nil),                              // 8: api.BeaconBadBlock
-	(*BeaconBadBlob)(nil),                               // 9: api.BeaconBadBlob
-	(*ExecutionBlockTrace)(nil),                         // 10: api.ExecutionBlockTrace
-	(*ExecutionBadBlock)(nil),                           // 11: api.ExecutionBadBlock
-	(*PaginationCursor)(nil),                            // 12: api.PaginationCursor
-	(*GetConfigRequest)(nil),                            // 13: api.GetConfigRequest
-	(*GetConfigResponse)(nil),                           // 14: api.GetConfigResponse
-	(*Config)(nil),                                      // 15: api.Config
-	(*EthereumConfig)(nil),                              // 16: api.EthereumConfig
-	(*EthereumNetworkConfig)(nil),                       // 17: api.EthereumNetworkConfig
-	(*ToolsConfig)(nil),                                 // 18: api.ToolsConfig
-	(*GitRepositoryConfig)(nil),                         // 19: api.GitRepositoryConfig
-	(*ZcliConfig)(nil),                                  // 20: api.ZcliConfig
-	(*ListBeaconStateRequest)(nil),                      // 21: api.ListBeaconStateRequest
-	(*ListBeaconStateResponse)(nil),                     // 22: api.ListBeaconStateResponse
-	(*CountBeaconStateRequest)(nil),                     // 23: api.CountBeaconStateRequest
-	(*CountBeaconStateResponse)(nil),                    // 24: api.CountBeaconStateResponse
-	(*ListUniqueBeaconStateValuesRequest)(nil),          // 25: api.ListUniqueBeaconStateValuesRequest
-	(*ListUniqueBeaconStateValuesResponse)(nil),         // 26: api.ListUniqueBeaconStateValuesResponse
-	(*ListBeaconBlockRequest)(nil),                      // 27: api.ListBeaconBlockRequest
-	(*ListBeaconBlockResponse)(nil),                     // 28: api.ListBeaconBlockResponse
-	(*CountBeaconBlockRequest)(nil),                     // 29: api.CountBeaconBlockRequest
-	(*CountBeaconBlockResponse)(nil),                    // 30: api.CountBeaconBlockResponse
-	(*ListUniqueBeaconBlockValuesRequest)(nil),          // 31: api.ListUniqueBeaconBlockValuesRequest
-	(*ListUniqueBeaconBlockValuesResponse)(nil),         // 32: api.ListUniqueBeaconBlockValuesResponse
-	(*ListBeaconBadBlockRequest)(nil),                   // 33: api.ListBeaconBadBlockRequest
-	(*ListBeaconBadBlockResponse)(nil),                  // 34: api.ListBeaconBadBlockResponse
-	(*CountBeaconBadBlockRequest)(nil),                  // 35: api.CountBeaconBadBlockRequest
-	(*CountBeaconBadBlockResponse)(nil),                 // 36: api.CountBeaconBadBlockResponse
-	(*ListUniqueBeaconBadBlockValuesRequest)(nil),       // 37: api.ListUniqueBeaconBadBlockValuesRequest
-	(*ListUniqueBeaconBadBlockValuesResponse)(nil),      // 38: api.ListUniqueBeaconBadBlockValuesResponse
-	(*ListBeaconBadBlobRequest)(nil),                    // 39: api.ListBeaconBadBlobRequest
-	(*ListBeaconBadBlobResponse)(nil),                   // 40: api.ListBeaconBadBlobResponse
-	(*CountBeaconBadBlobRequest)(nil),                   // 41: api.CountBeaconBadBlobRequest
-	(*CountBeaconBadBlobResponse)(nil),                  // 42: api.CountBeaconBadBlobResponse
-	(*ListUniqueBeaconBadBlobValuesRequest)(nil),        // 43: api.ListUniqueBeaconBadBlobValuesRequest
-	(*ListUniqueBeaconBadBlobValuesResponse)(nil),       // 44: api.ListUniqueBeaconBadBlobValuesResponse
-	(*ListExecutionBlockTraceRequest)(nil),              // 45: api.ListExecutionBlockTraceRequest
-	(*ListExecutionBlockTraceResponse)(nil),             // 46: api.ListExecutionBlockTraceResponse
-	(*CountExecutionBlockTraceRequest)(nil),             // 47: api.CountExecutionBlockTraceRequest
-	(*CountExecutionBlockTraceResponse)(nil),            // 48: api.CountExecutionBlockTraceResponse
-	(*ListUniqueExecutionBlockTraceValuesRequest)(nil),  // 49: api.ListUniqueExecutionBlockTraceValuesRequest
-	(*ListUniqueExecutionBlockTraceValuesResponse)(nil), // 50: api.ListUniqueExecutionBlockTraceValuesResponse
-	(*ListExecutionBadBlockRequest)(nil),                // 51: api.ListExecutionBadBlockRequest
-	(*ListExecutionBadBlockResponse)(nil),               // 52: api.ListExecutionBadBlockResponse
-	(*CountExecutionBadBlockRequest)(nil),               // 53: api.CountExecutionBadBlockRequest
-	(*CountExecutionBadBlockResponse)(nil),              // 54: api.CountExecutionBadBlockResponse
-	(*ListUniqueExecutionBadBlockValuesRequest)(nil),    // 55: api.ListUniqueExecutionBadBlockValuesRequest
-	(*ListUniqueExecutionBadBlockValuesResponse)(nil),   // 56: api.ListUniqueExecutionBadBlockValuesResponse
-	(*wrapperspb.StringValue)(nil),                      // 57: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),                       // 58: google.protobuf.Timestamp
-	(*wrapperspb.UInt64Value)(nil),                      // 59: google.protobuf.UInt64Value
-	(*wrapperspb.Int64Value)(nil),                       // 60: google.protobuf.Int64Value
+	(ListUniqueBlobSidecarValuesRequest_Field)(0),         // 2: api.ListUniqueBlobSidecarValuesRequest.Field
+	(ListUniqueForkChoiceValuesRequest_Field)(0),          // 3: api.ListUniqueForkChoiceValuesRequest.Field
+	(ListUniqueBeaconBadBlockValuesRequest_Field)(0),      // 4: api.ListUniqueBeaconBadBlockValuesRequest.Field
+	(ListUniqueBeaconBadBlobValuesRequest_Field)(0),       // 5: api.ListUniqueBeaconBadBlobValuesRequest.Field
+	(ListUniqueExecutionBlockTraceValuesRequest_Field)(0), // 6: api.ListUniqueExecutionBlockTraceValuesRequest.Field
+	(ListUniqueExecutionWitnessValuesRequest_Field)(0),    // 7: api.ListUniqueExecutionWitnessValuesRequest.Field
+	(ListUniqueExecutionBadBlockValuesRequest_Field)(0),   // 8: api.ListUniqueExecutionBadBlockValuesRequest.Field
+	(*BeaconState)(nil),                                 // 9: api.BeaconState
+	(*BeaconBlock)(nil),                                 // 10: api.BeaconBlock
+	(*BlobSidecar)(nil),                                 // 11: api.BlobSidecar
+	(*ForkChoice)(nil),                                  // 12: api.ForkChoice
+	(*BeaconBadBlock)(nil),                              // 13: api.BeaconBadBlock
+	(*BeaconBadBlob)(nil),                               // 14: api.BeaconBadBlob
+	(*ExecutionBlockTrace)(nil),                         // 15: api.ExecutionBlockTrace
+	(*ExecutionWitness)(nil),                            // 16: api.ExecutionWitness
+	(*ExecutionBadBlock)(nil),                           // 17: api.ExecutionBadBlock
+	(*StateDivergence)(nil),                             // 18: api.StateDivergence
+	(*PaginationCursor)(nil),                            // 19: api.PaginationCursor
+	(*GetConfigRequest)(nil),                            // 20: api.GetConfigRequest
+	(*GetConfigResponse)(nil),                           // 21: api.GetConfigResponse
+	(*Config)(nil),                                      // 22: api.Config
+	(*EthereumConfig)(nil),                              // 23: api.EthereumConfig
+	(*EthereumNetworkConfig)(nil),                       // 24: api.EthereumNetworkConfig
+	(*ToolsConfig)(nil),                                 // 25: api.ToolsConfig
+	(*GitRepositoryConfig)(nil),                         // 26: api.GitRepositoryConfig
+	(*ZcliConfig)(nil),                                  // 27: api.ZcliConfig
+	(*ListBeaconStateRequest)(nil),                      // 28: api.ListBeaconStateRequest
+	(*ListBeaconStateResponse)(nil),                     // 29: api.ListBeaconStateResponse
+	(*CountBeaconStateRequest)(nil),                     // 30: api.CountBeaconStateRequest
+	(*CountBeaconStateResponse)(nil),                    // 31: api.CountBeaconStateResponse
+	(*ListUniqueBeaconStateValuesRequest)(nil),          // 32: api.ListUniqueBeaconStateValuesRequest
+	(*ListUniqueBeaconStateValuesResponse)(nil),         // 33: api.ListUniqueBeaconStateValuesResponse
+	(*ListStateDivergencesRequest)(nil),                 // 34: api.ListStateDivergencesRequest
+	(*ListStateDivergencesResponse)(nil),                // 35: api.ListStateDivergencesResponse
+	(*ListBeaconBlockRequest)(nil),                      // 36: api.ListBeaconBlockRequest
+	(*ListBeaconBlockResponse)(nil),                     // 37: api.ListBeaconBlockResponse
+	(*CountBeaconBlockRequest)(nil),                     // 38: api.CountBeaconBlockRequest
+	(*CountBeaconBlockResponse)(nil),                    // 39: api.CountBeaconBlockResponse
+	(*ListUniqueBeaconBlockValuesRequest)(nil),          // 40: api.ListUniqueBeaconBlockValuesRequest
+	(*ListUniqueBeaconBlockValuesResponse)(nil),         // 41: api.ListUniqueBeaconBlockValuesResponse
+	(*ListBlobSidecarRequest)(nil),                      // 42: api.ListBlobSidecarRequest
+	(*ListBlobSidecarResponse)(nil),                     // 43: api.ListBlobSidecarResponse
+	(*CountBlobSidecarRequest)(nil),                     // 44: api.CountBlobSidecarRequest
+	(*CountBlobSidecarResponse)(nil),                    // 45: api.CountBlobSidecarResponse
+	(*ListUniqueBlobSidecarValuesRequest)(nil),          // 46: api.ListUniqueBlobSidecarValuesRequest
+	(*ListUniqueBlobSidecarValuesResponse)(nil),         // 47: api.ListUniqueBlobSidecarValuesResponse
+	(*ListForkChoiceRequest)(nil),                       // 48: api.ListForkChoiceRequest
+	(*ListForkChoiceResponse)(nil),                      // 49: api.ListForkChoiceResponse
+	(*CountForkChoiceRequest)(nil),                      // 50: api.CountForkChoiceRequest
+	(*CountForkChoiceResponse)(nil),                     // 51: api.CountForkChoiceResponse
+	(*ListUniqueForkChoiceValuesRequest)(nil),           // 52: api.ListUniqueForkChoiceValuesRequest
+	(*ListUniqueForkChoiceValuesResponse)(nil),          // 53: api.ListUniqueForkChoiceValuesResponse
+	(*ListBeaconBadBlockRequest)(nil),                   // 54: api.ListBeaconBadBlockRequest
+	(*ListBeaconBadBlockResponse)(nil),                  // 55: api.ListBeaconBadBlockResponse
+	(*CountBeaconBadBlockRequest)(nil),                  // 56: api.CountBeaconBadBlockRequest
+	(*CountBeaconBadBlockResponse)(nil),                 // 57: api.CountBeaconBadBlockResponse
+	(*ListUniqueBeaconBadBlockValuesRequest)(nil),       // 58: api.ListUniqueBeaconBadBlockValuesRequest
+	(*ListUniqueBeaconBadBlockValuesResponse)(nil),      // 59: api.ListUniqueBeaconBadBlockValuesResponse
+	(*ListBeaconBadBlobRequest)(nil),                    // 60: api.ListBeaconBadBlobRequest
+	(*ListBeaconBadBlobResponse)(nil),                   // 61: api.ListBeaconBadBlobResponse
+	(*CountBeaconBadBlobRequest)(nil),                   // 62: api.CountBeaconBadBlobRequest
+	(*CountBeaconBadBlobResponse)(nil),                  // 63: api.CountBeaconBadBlobResponse
+	(*ListUniqueBeaconBadBlobValuesRequest)(nil),        // 64: api.ListUniqueBeaconBadBlobValuesRequest
+	(*ListUniqueBeaconBadBlobValuesResponse)(nil),       // 65: api.ListUniqueBeaconBadBlobValuesResponse
+	(*ListExecutionBlockTraceRequest)(nil),              // 66: api.ListExecutionBlockTraceRequest
+	(*ListExecutionBlockTraceResponse)(nil),             // 67: api.ListExecutionBlockTraceResponse
+	(*CountExecutionBlockTraceRequest)(nil),             // 68: api.CountExecutionBlockTraceRequest
+	(*CountExecutionBlockTraceResponse)(nil),            // 69: api.CountExecutionBlockTraceResponse
+	(*ListUniqueExecutionBlockTraceValuesRequest)(nil),  // 70: api.ListUniqueExecutionBlockTraceValuesRequest
+	(*ListUniqueExecutionBlockTraceValuesResponse)(nil), // 71: api.ListUniqueExecutionBlockTraceValuesResponse
+	(*DiffExecutionBlockTraceRequest)(nil),              // 72: api.DiffExecutionBlockTraceRequest
+	(*ExecutionBlockTraceStep)(nil),                     // 73: api.ExecutionBlockTraceStep
+	(*ExecutionBlockTraceValueDiff)(nil),                // 74: api.ExecutionBlockTraceValueDiff
+	(*ExecutionBlockTraceStepDiff)(nil),                 // 75: api.ExecutionBlockTraceStepDiff
+	(*ExecutionBlockTraceTransactionDiff)(nil),          // 76: api.ExecutionBlockTraceTransactionDiff
+	(*DiffExecutionBlockTraceResponse)(nil),             // 77: api.DiffExecutionBlockTraceResponse
+	(*ListExecutionWitnessRequest)(nil),                 // 78: api.ListExecutionWitnessRequest
+	(*ListExecutionWitnessResponse)(nil),                // 79: api.ListExecutionWitnessResponse
+	(*CountExecutionWitnessRequest)(nil),                // 80: api.CountExecutionWitnessRequest
+	(*CountExecutionWitnessResponse)(nil),               // 81: api.CountExecutionWitnessResponse
+	(*ListUniqueExecutionWitnessValuesRequest)(nil),     // 82: api.ListUniqueExecutionWitnessValuesRequest
+	(*ListUniqueExecutionWitnessValuesResponse)(nil),    // 83: api.ListUniqueExecutionWitnessValuesResponse
+	(*ListExecutionBadBlockRequest)(nil),                // 84: api.ListExecutionBadBlockRequest
+	(*ListExecutionBadBlockResponse)(nil),               // 85: api.ListExecutionBadBlockResponse
+	(*CountExecutionBadBlockRequest)(nil),               // 86: api.CountExecutionBadBlockRequest
+	(*CountExecutionBadBlockResponse)(nil),              // 87: api.CountExecutionBadBlockResponse
+	(*ListUniqueExecutionBadBlockValuesRequest)(nil),    // 88: api.ListUniqueExecutionBadBlockValuesRequest
+	(*ListUniqueExecutionBadBlockValuesResponse)(nil),   // 89: api.ListUniqueExecutionBadBlockValuesResponse
+	(*wrapperspb.StringValue)(nil),                      // 90: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),                       // 91: google.protobuf.Timestamp
+	(*wrapperspb.UInt64Value)(nil),                      // 92: google.protobuf.UInt64Value
+	(*wrapperspb.BoolValue)(nil),                        // 93: google.protobuf.BoolValue
+	(*wrapperspb.Int64Value)(nil),                       // 94: google.protobuf.Int64Value
 }
 var file_api_api_proto_depIdxs = []int32{
-	57,  // 0: api.BeaconState.id:type_name -> google.protobuf.StringValue
-	57,  // 1: api.BeaconState.node:type_name -> google.protobuf.StringValue
-	58,  // 2: api.BeaconState.fetched_at:type_name -> google.protobuf.Timestamp
-	59,  // 3: api.BeaconState.slot:type_name -> google.protobuf.UInt64Value
-	59,  // 4: api.BeaconState.epoch:type_name -> google.protobuf.UInt64Value
-	57,  // 5: api.BeaconState.state_root:type_name -> google.protobuf.StringValue
-	57,  // 6: api.BeaconState.node_version:type_name -> google.protobuf.StringValue
-	57,  // 7: api.BeaconState.network:type_name -> google.protobuf.StringValue
-	57,  // 8: api.BeaconState.beacon_implementation:type_name -> google.protobuf.StringValue
-	57,  // 9: api.BeaconBlock.id:type_name -> google.protobuf.StringValue
-	57,  // 10: api.BeaconBlock.node:type_name -> google.protobuf.StringValue
-	58,  // 11: api.BeaconBlock.fetched_at:type_name -> google.protobuf.Timestamp
-	59,  // 12: api.BeaconBlock.slot:type_name -> google.protobuf.UInt64Value
-	59,  // 13: api.BeaconBlock.epoch:type_name -> google.protobuf.UInt64Value
-	57,  // 14: api.BeaconBlock.block_root:type_name -> google.protobuf.StringValue
-	57,  // 15: api.BeaconBlock.node_version:type_name -> google.protobuf.StringValue
-	57,  // 16: api.BeaconBlock.network:type_name -> google.protobuf.StringValue
-	57,  // 17: api.BeaconBlock.beacon_implementation:type_name -> google.protobuf.StringValue
-	57,  // 18: api.BeaconBadBlock.id:type_name -> google.protobuf.StringValue
-	57,  // 19: api.BeaconBadBlock.node:type_name -> google.protobuf.StringValue
-	58,  // 20: api.BeaconBadBlock.fetched_at:type_name -> google.protobuf.Timestamp
-	59,  // 21: api.BeaconBadBlock.slot:type_name -> google.protobuf.UInt64Value
-	59,  // 22: api.BeaconBadBlock.epoch:type_name -> google.protobuf.UInt64Value
-	57,  // 23: api.BeaconBadBlock.block_root:type_name -> google.protobuf.StringValue
-	57,  // 24: api.BeaconBadBlock.node_version:type_name -> google.protobuf.StringValue
-	57,  // 25: api.BeaconBadBlock.network:type_name -> google.protobuf.StringValue
-	57,  // 26: api.BeaconBadBlock.beacon_implementation:type_name -> google.protobuf.StringValue
-	57,  // 27: api.BeaconBadBlob.id:type_name -> google.protobuf.StringValue
-	57,  // 28: api.BeaconBadBlob.node:type_name -> google.protobuf.StringValue
-	58,  // 29: api.BeaconBadBlob.fetched_at:type_name -> google.protobuf.Timestamp
-	59,  // 30: api.BeaconBadBlob.slot:type_name -> google.protobuf.UInt64Value
-	59,  // 31: api.BeaconBadBlob.epoch:type_name -> google.protobuf.UInt64Value
-	57,  // 32: api.BeaconBadBlob.block_root:type_name -> google.protobuf.StringValue
-	57,  // 33: api.BeaconBadBlob.node_version:type_name -> google.protobuf.StringValue
-	57,  // 34: api.BeaconBadBlob.network:type_name -> google.protobuf.StringValue
-	57,  // 35: api.BeaconBadBlob.beacon_implementation:type_name -> google.protobuf.StringValue
-	59,  // 36: api.BeaconBadBlob.index:type_name -> google.protobuf.UInt64Value
-	57,  // 37: api.ExecutionBlockTrace.id:type_name -> google.protobuf.StringValue
-	57,  // 38: api.ExecutionBlockTrace.node:type_name -> google.protobuf.StringValue
-	58,  // 39: api.ExecutionBlockTrace.fetched_at:type_name -> google.protobuf.Timestamp
-	57,  // 40: api.ExecutionBlockTrace.block_hash:type_name -> google.protobuf.StringValue
-	60,  // 41: api.ExecutionBlockTrace.block_number:type_name -> google.protobuf.Int64Value
-	57,  // 42: api.ExecutionBlockTrace.network:type_name -> google.protobuf.StringValue
-	57,  // 43: api.ExecutionBlockTrace.execution_implementation:type_name -> google.protobuf.StringValue
-	57,  // 44: api.ExecutionBlockTrace.node_version:type_name -> google.protobuf.StringValue
-	57,  // 45: api.ExecutionBadBlock.id:type_name -> google.protobuf.StringValue
-	57,  // 46: api.ExecutionBadBlock.node:type_name -> google.protobuf.StringValue
-	58,  // 47: api.ExecutionBadBlock.fetched_at:type_name -> google.protobuf.Timestamp
-	57,  // 48: api.ExecutionBadBlock.block_hash:type_name -> google.protobuf.StringValue
-	60,  // 49: api.ExecutionBadBlock.block_number:type_name -> google.protobuf.Int64Value
-	57,  // 50: api.ExecutionBadBlock.network:type_name -> google.protobuf.StringValue
-	57,  // 51: api.ExecutionBadBlock.execution_implementation:type_name -> google.protobuf.StringValue
-	57,  // 52: api.ExecutionBadBlock.node_version:type_name -> google.protobuf.StringValue
-	57,  // 53: api.ExecutionBadBlock.block_extra_data:type_name -> google.protobuf.StringValue
-	15,  // 54: api.GetConfigResponse.config:type_name -> api.Config
-	16,  // 55: api.Config.ethereum:type_name -> api.EthereumConfig
-	17,  // 56: api.EthereumConfig.config:type_name -> api.EthereumNetworkConfig
-	18,  // 57: api.EthereumConfig.tools:type_name -> api.ToolsConfig
-	19,  // 58: api.ToolsConfig.ncli:type_name -> api.GitRepositoryConfig
-	19,  // 59: api.ToolsConfig.lcli:type_name -> api.GitRepositoryConfig
-	20,  // 60: api.ToolsConfig.zcli:type_name -> api.ZcliConfig
-	58,  // 61: api.ListBeaconStateRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 62: api.ListBeaconStateRequest.after:type_name -> google.protobuf.Timestamp
-	12,  // 63: api.ListBeaconStateRequest.pagination:type_name -> api.PaginationCursor
-	6,   // 64: api.ListBeaconStateResponse.beacon_states:type_name -> api.BeaconState
-	58,  // 65: api.CountBeaconStateRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 66: api.CountBeaconStateRequest.after:type_name -> google.protobuf.Timestamp
-	59,  // 67: api.CountBeaconStateResponse.count:type_name -> google.protobuf.UInt64Value
-	0,   // 68: api.ListUniqueBeaconStateValuesRequest.fields:type_name -> api.ListUniqueBeaconStateValuesRequest.Field
-	58,  // 69: api.ListBeaconBlockRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 70: api.ListBeaconBlockRequest.after:type_name -> google.protobuf.Timestamp
-	12,  // 71: api.ListBeaconBlockRequest.pagination:type_name -> api.PaginationCursor
-	7,   // 72: api.ListBeaconBlockResponse.beacon_blocks:type_name -> api.BeaconBlock
-	58,  // 73: api.CountBeaconBlockRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 74: api.CountBeaconBlockRequest.after:type_name -> google.protobuf.Timestamp
-	59,  // 75: api.CountBeaconBlockResponse.count:type_name -> google.protobuf.UInt64Value
-	1,   // 76: api.ListUniqueBeaconBlockValuesRequest.fields:type_name -> api.ListUniqueBeaconBlockValuesRequest.Field
-	58,  // 77: api.ListBeaconBadBlockRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 78: api.ListBeaconBadBlockRequest.after:type_name -> google.protobuf.Timestamp
-	12,  // 79: api.ListBeaconBadBlockRequest.pagination:type_name -> api.PaginationCursor
-	8,   // 80: api.ListBeaconBadBlockResponse.beacon_bad_blocks:type_name -> api.BeaconBadBlock
-	58,  // 81: api.CountBeaconBadBlockRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 82: api.CountBeaconBadBlockRequest.after:type_name -> google.protobuf.Timestamp
-	59,  // 83: api.CountBeaconBadBlockResponse.count:type_name -> google.protobuf.UInt64Value
-	2,   // 84: api.ListUniqueBeaconBadBlockValuesRequest.fields:type_name -> api.ListUniqueBeaconBadBlockValuesRequest.Field
-	58,  // 85: api.ListBeaconBadBlobRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 86: api.ListBeaconBadBlobRequest.after:type_name -> google.protobuf.Timestamp
-	12,  // 87: api.ListBeaconBadBlobRequest.pagination:type_name -> api.PaginationCursor
-	59,  // 88: api.ListBeaconBadBlobRequest.index:type_name -> google.protobuf.UInt64Value
-	9,   // 89: api.ListBeaconBadBlobResponse.beacon_bad_blobs:type_name -> api.BeaconBadBlob
-	58,  // 90: api.CountBeaconBadBlobRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 91: api.CountBeaconBadBlobRequest.after:type_name -> google.protobuf.Timestamp
-	59,  // 92: api.CountBeaconBadBlobRequest.index:type_name -> google.protobuf.UInt64Value
-	59,  // 93: api.CountBeaconBadBlobResponse.count:type_name -> google.protobuf.UInt64Value
-	3,   // 94: api.ListUniqueBeaconBadBlobValuesRequest.fields:type_name -> api.ListUniqueBeaconBadBlobValuesRequest.Field
-	58,  // 95: api.ListExecutionBlockTraceRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 96: api.ListExecutionBlockTraceRequest.after:type_name -> google.protobuf.Timestamp
-	12,  // 97: api.ListExecutionBlockTraceRequest.pagination:type_name -> api.PaginationCursor
-	10,  // 98: api.ListExecutionBlockTraceResponse.execution_block_traces:type_name -> api.ExecutionBlockTrace
-	58,  // 99: api.CountExecutionBlockTraceRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 100: api.CountExecutionBlockTraceRequest.after:type_name -> google.protobuf.Timestamp
-	59,  // 101: api.CountExecutionBlockTraceResponse.count:type_name -> google.protobuf.UInt64Value
-	4,   // 102: api.ListUniqueExecutionBlockTraceValuesRequest.fields:type_name -> api.ListUniqueExecutionBlockTraceValuesRequest.Field
-	58,  // 103: api.ListExecutionBadBlockRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 104: api.ListExecutionBadBlockRequest.after:type_name -> google.protobuf.Timestamp
-	12,  // 105: api.ListExecutionBadBlockRequest.pagination:type_name -> api.PaginationCursor
-	11,  // 106: api.ListExecutionBadBlockResponse.execution_bad_blocks:type_name -> api.ExecutionBadBlock
-	58,  // 107: api.CountExecutionBadBlockRequest.before:type_name -> google.protobuf.Timestamp
-	58,  // 108: api.CountExecutionBadBlockRequest.after:type_name -> google.protobuf.Timestamp
-	59,  // 109: api.CountExecutionBadBlockResponse.count:type_name -> google.protobuf.UInt64Value
-	5,   // 110: api.ListUniqueExecutionBadBlockValuesRequest.fields:type_name -> api.ListUniqueExecutionBadBlockValuesRequest.Field
-	13,  // 111: api.API.GetConfig:input_type -> api.GetConfigRequest
-	21,  // 112: api.API.ListBeaconState:input_type -> api.ListBeaconStateRequest
-	23,  // 113: api.API.CountBeaconState:input_type -> api.CountBeaconStateRequest
-	25,  // 114: api.API.ListUniqueBeaconStateValues:input_type -> api.ListUniqueBeaconStateValuesRequest
-	27,  // 115: api.API.ListBeaconBlock:input_type -> api.ListBeaconBlockRequest
-	29,  // 116: api.API.CountBeaconBlock:input_type -> api.CountBeaconBlockRequest
-	31,  // 117: api.API.ListUniqueBeaconBlockValues:input_type -> api.ListUniqueBeaconBlockValuesRequest
-	33,  // 118: api.API.ListBeaconBadBlock:input_type -> api.ListBeaconBadBlockRequest
-	35,  // 119: api.API.CountBeaconBadBlock:input_type -> api.CountBeaconBadBlockRequest
-	37,  // 120: api.API.ListUniqueBeaconBadBlockValues:input_type -> api.ListUniqueBeaconBadBlockValuesRequest
-	39,  // 121: api.API.ListBeaconBadBlob:input_type -> api.ListBeaconBadBlobRequest
-	41,  // 122: api.API.CountBeaconBadBlob:input_type -> api.CountBeaconBadBlobRequest
-	43,  // 123: api.API.ListUniqueBeaconBadBlobValues:input_type -> api.ListUniqueBeaconBadBlobValuesRequest
-	45,  // 124: api.API.ListExecutionBlockTrace:input_type -> api.ListExecutionBlockTraceRequest
-	47,  // 125: api.API.CountExecutionBlockTrace:input_type -> api.CountExecutionBlockTraceRequest
-	49,  // 126: api.API.ListUniqueExecutionBlockTraceValues:input_type -> api.ListUniqueExecutionBlockTraceValuesRequest
-	51,  // 127: api.API.ListExecutionBadBlock:input_type -> api.ListExecutionBadBlockRequest
-	53,  // 128: api.API.CountExecutionBadBlock:input_type -> api.CountExecutionBadBlockRequest
-	55,  // 129: api.API.ListUniqueExecutionBadBlockValues:input_type -> api.ListUniqueExecutionBadBlockValuesRequest
-	14,  // 130: api.API.GetConfig:output_type -> api.GetConfigResponse
-	22,  // 131: api.API.ListBeaconState:output_type -> api.ListBeaconStateResponse
-	24,  // 132: api.API.CountBeaconState:output_type -> api.CountBeaconStateResponse
-	26,  // 133: api.API.ListUniqueBeaconStateValues:output_type -> api.ListUniqueBeaconStateValuesResponse
-	28,  // 134: api.API.ListBeaconBlock:output_type -> api.ListBeaconBlockResponse
-	30,  // 135: api.API.CountBeaconBlock:output_type -> api.CountBeaconBlockResponse
-	32,  // 136: api.API.ListUniqueBeaconBlockValues:output_type -> api.ListUniqueBeaconBlockValuesResponse
-	34,  // 137: api.API.ListBeaconBadBlock:output_type -> api.ListBeaconBadBlockResponse
-	36,  // 138: api.API.CountBeaconBadBlock:output_type -> api.CountBeaconBadBlockResponse
-	38,  // 139: api.API.ListUniqueBeaconBadBlockValues:output_type -> api.ListUniqueBeaconBadBlockValuesResponse
-	40,  // 140: api.API.ListBeaconBadBlob:output_type -> api.ListBeaconBadBlobResponse
-	42,  // 141: api.API.CountBeaconBadBlob:output_type -> api.CountBeaconBadBlobResponse
-	44,  // 142: api.API.ListUniqueBeaconBadBlobValues:output_type -> api.ListUniqueBeaconBadBlobValuesResponse
-	46,  // 143: api.API.ListExecutionBlockTrace:output_type -> api.ListExecutionBlockTraceResponse
-	48,  // 144: api.API.CountExecutionBlockTrace:output_type -> api.CountExecutionBlockTraceResponse
-	50,  // 145: api.API.ListUniqueExecutionBlockTraceValues:output_type -> api.ListUniqueExecutionBlockTraceValuesResponse
-	52,  // 146: api.API.ListExecutionBadBlock:output_type -> api.ListExecutionBadBlockResponse
-	54,  // 147: api.API.CountExecutionBadBlock:output_type -> api.CountExecutionBadBlockResponse
-	56,  // 148: api.API.ListUniqueExecutionBadBlockValues:output_type -> api.ListUniqueExecutionBadBlockValuesResponse
-	130, // [130:149] is the sub-list for method output_type
-	111, // [111:130] is the sub-list for method input_type
-	111, // [111:111] is the sub-list for extension type_name
-	111, // [111:111] is the sub-list for extension extendee
-	0,   // [0:111] is the sub-list for field type_name
+	90,  // 0: api.BeaconState.id:type_name -> google.protobuf.StringValue
+	90,  // 1: api.BeaconState.node:type_name -> google.protobuf.StringValue
+	91,  // 2: api.BeaconState.fetched_at:type_name -> google.protobuf.Timestamp
+	92,  // 3: api.BeaconState.slot:type_name -> google.protobuf.UInt64Value
+	92,  // 4: api.BeaconState.epoch:type_name -> google.protobuf.UInt64Value
+	90,  // 5: api.BeaconState.state_root:type_name -> google.protobuf.StringValue
+	90,  // 6: api.BeaconState.node_version:type_name -> google.protobuf.StringValue
+	90,  // 7: api.BeaconState.network:type_name -> google.protobuf.StringValue
+	90,  // 8: api.BeaconState.beacon_implementation:type_name -> google.protobuf.StringValue
+	93,  // 9: api.BeaconState.finalized:type_name -> google.protobuf.BoolValue
+	92,  // 10: api.BeaconState.checkpoint_epoch:type_name -> google.protobuf.UInt64Value
+	93,  // 11: api.BeaconState.canonical:type_name -> google.protobuf.BoolValue
+	90,  // 12: api.BeaconBlock.id:type_name -> google.protobuf.StringValue
+	90,  // 13: api.BeaconBlock.node:type_name -> google.protobuf.StringValue
+	91,  // 14: api.BeaconBlock.fetched_at:type_name -> google.protobuf.Timestamp
+	92,  // 15: api.BeaconBlock.slot:type_name -> google.protobuf.UInt64Value
+	92,  // 16: api.BeaconBlock.epoch:type_name -> google.protobuf.UInt64Value
+	90,  // 17: api.BeaconBlock.block_root:type_name -> google.protobuf.StringValue
+	90,  // 18: api.BeaconBlock.node_version:type_name -> google.protobuf.StringValue
+	90,  // 19: api.BeaconBlock.network:type_name -> google.protobuf.StringValue
+	90,  // 20: api.BeaconBlock.beacon_implementation:type_name -> google.protobuf.StringValue
+	93,  // 21: api.BeaconBlock.canonical:type_name -> google.protobuf.BoolValue
+	90,  // 22: api.BlobSidecar.id:type_name -> google.protobuf.StringValue
+	90,  // 23: api.BlobSidecar.node:type_name -> google.protobuf.StringValue
+	91,  // 24: api.BlobSidecar.fetched_at:type_name -> google.protobuf.Timestamp
+	92,  // 25: api.BlobSidecar.slot:type_name -> google.protobuf.UInt64Value
+	92,  // 26: api.BlobSidecar.epoch:type_name -> google.protobuf.UInt64Value
+	90,  // 27: api.BlobSidecar.block_root:type_name -> google.protobuf.StringValue
+	90,  // 28: api.BlobSidecar.node_version:type_name -> google.protobuf.StringValue
+	90,  // 29: api.BlobSidecar.network:type_name -> google.protobuf.StringValue
+	90,  // 30: api.BlobSidecar.beacon_implementation:type_name -> google.protobuf.StringValue
+	90,  // 31: api.BlobSidecar.sidecar_type:type_name -> google.protobuf.StringValue
+	90,  // 32: api.ForkChoice.id:type_name -> google.protobuf.StringValue
+	90,  // 33: api.ForkChoice.node:type_name -> google.protobuf.StringValue
+	91,  // 34: api.ForkChoice.fetched_at:type_name -> google.protobuf.Timestamp
+	92,  // 35: api.ForkChoice.slot:type_name -> google.protobuf.UInt64Value
+	92,  // 36: api.ForkChoice.epoch:type_name -> google.protobuf.UInt64Value
+	90,  // 37: api.ForkChoice.node_version:type_name -> google.protobuf.StringValue
+	90,  // 38: api.ForkChoice.network:type_name -> google.protobuf.StringValue
+	90,  // 39: api.ForkChoice.beacon_implementation:type_name -> google.protobuf.StringValue
+	90,  // 40: api.ForkChoice.reason:type_name -> google.protobuf.StringValue
+	90,  // 41: api.BeaconBadBlock.id:type_name -> google.protobuf.StringValue
+	90,  // 42: api.BeaconBadBlock.node:type_name -> google.protobuf.StringValue
+	91,  // 43: api.BeaconBadBlock.fetched_at:type_name -> google.protobuf.Timestamp
+	92,  // 44: api.BeaconBadBlock.slot:type_name -> google.protobuf.UInt64Value
+	92,  // 45: api.BeaconBadBlock.epoch:type_name -> google.protobuf.UInt64Value
+	90,  // 46: api.BeaconBadBlock.block_root:type_name -> google.protobuf.StringValue
+	90,  // 47: api.BeaconBadBlock.node_version:type_name -> google.protobuf.StringValue
+	90,  // 48: api.BeaconBadBlock.network:type_name -> google.protobuf.StringValue
+	90,  // 49: api.BeaconBadBlock.beacon_implementation:type_name -> google.protobuf.StringValue
+	90,  // 50: api.BeaconBadBlob.id:type_name -> google.protobuf.StringValue
+	90,  // 51: api.BeaconBadBlob.node:type_name -> google.protobuf.StringValue
+	91,  // 52: api.BeaconBadBlob.fetched_at:type_name -> google.protobuf.Timestamp
+	92,  // 53: api.BeaconBadBlob.slot:type_name -> google.protobuf.UInt64Value
+	92,  // 54: api.BeaconBadBlob.epoch:type_name -> google.protobuf.UInt64Value
+	90,  // 55: api.BeaconBadBlob.block_root:type_name -> google.protobuf.StringValue
+	90,  // 56: api.BeaconBadBlob.node_version:type_name -> google.protobuf.StringValue
+	90,  // 57: api.BeaconBadBlob.network:type_name -> google.protobuf.StringValue
+	90,  // 58: api.BeaconBadBlob.beacon_implementation:type_name -> google.protobuf.StringValue
+	92,  // 59: api.BeaconBadBlob.index:type_name -> google.protobuf.UInt64Value
+	90,  // 60: api.ExecutionBlockTrace.id:type_name -> google.protobuf.StringValue
+	90,  // 61: api.ExecutionBlockTrace.node:type_name -> google.protobuf.StringValue
+	91,  // 62: api.ExecutionBlockTrace.fetched_at:type_name -> google.protobuf.Timestamp
+	90,  // 63: api.ExecutionBlockTrace.block_hash:type_name -> google.protobuf.StringValue
+	94,  // 64: api.ExecutionBlockTrace.block_number:type_name -> google.protobuf.Int64Value
+	90,  // 65: api.ExecutionBlockTrace.network:type_name -> google.protobuf.StringValue
+	90,  // 66: api.ExecutionBlockTrace.execution_implementation:type_name -> google.protobuf.StringValue
+	90,  // 67: api.ExecutionBlockTrace.node_version:type_name -> google.protobuf.StringValue
+	90,  // 68: api.ExecutionBlockTrace.tracer:type_name -> google.protobuf.StringValue
+	93,  // 69: api.ExecutionBlockTrace.canonical:type_name -> google.protobuf.BoolValue
+	90,  // 70: api.ExecutionWitness.id:type_name -> google.protobuf.StringValue
+	90,  // 71: api.ExecutionWitness.node:type_name -> google.protobuf.StringValue
+	91,  // 72: api.ExecutionWitness.fetched_at:type_name -> google.protobuf.Timestamp
+	90,  // 73: api.ExecutionWitness.block_hash:type_name -> google.protobuf.StringValue
+	94,  // 74: api.ExecutionWitness.block_number:type_name -> google.protobuf.Int64Value
+	90,  // 75: api.ExecutionWitness.network:type_name -> google.protobuf.StringValue
+	90,  // 76: api.ExecutionWitness.execution_implementation:type_name -> google.protobuf.StringValue
+	90,  // 77: api.ExecutionWitness.node_version:type_name -> google.protobuf.StringValue
+	90,  // 78: api.ExecutionBadBlock.id:type_name -> google.protobuf.StringValue
+	90,  // 79: api.ExecutionBadBlock.node:type_name -> google.protobuf.StringValue
+	91,  // 80: api.ExecutionBadBlock.fetched_at:type_name -> google.protobuf.Timestamp
+	90,  // 81: api.ExecutionBadBlock.block_hash:type_name -> google.protobuf.StringValue
+	94,  // 82: api.ExecutionBadBlock.block_number:type_name -> google.protobuf.Int64Value
+	90,  // 83: api.ExecutionBadBlock.network:type_name -> google.protobuf.StringValue
+	90,  // 84: api.ExecutionBadBlock.execution_implementation:type_name -> google.protobuf.StringValue
+	90,  // 85: api.ExecutionBadBlock.node_version:type_name -> google.protobuf.StringValue
+	90,  // 86: api.ExecutionBadBlock.block_extra_data:type_name -> google.protobuf.StringValue
+	93,  // 87: api.ExecutionBadBlock.has_trace:type_name -> google.protobuf.BoolValue
+	93,  // 88: api.ExecutionBadBlock.has_intermediate_roots:type_name -> google.protobuf.BoolValue
+	90,  // 89: api.StateDivergence.id:type_name -> google.protobuf.StringValue
+	90,  // 90: api.StateDivergence.network:type_name -> google.protobuf.StringValue
+	92,  // 91: api.StateDivergence.slot:type_name -> google.protobuf.UInt64Value
+	92,  // 92: api.StateDivergence.epoch:type_name -> google.protobuf.UInt64Value
+	91,  // 93: api.StateDivergence.detected_at:type_name -> google.protobuf.Timestamp
+	90,  // 94: api.StateDivergence.beacon_state_id:type_name -> google.protobuf.StringValue
+	90,  // 95: api.StateDivergence.node:type_name -> google.protobuf.StringValue
+	90,  // 96: api.StateDivergence.state_root:type_name -> google.protobuf.StringValue
+	90,  // 97: api.StateDivergence.beacon_implementation:type_name -> google.protobuf.StringValue
+	90,  // 98: api.StateDivergence.node_version:type_name -> google.protobuf.StringValue
+	90,  // 99: api.StateDivergence.conflicting_beacon_state_id:type_name -> google.protobuf.StringValue
+	90,  // 100: api.StateDivergence.conflicting_node:type_name -> google.protobuf.StringValue
+	90,  // 101: api.StateDivergence.conflicting_state_root:type_name -> google.protobuf.StringValue
+	90,  // 102: api.StateDivergence.conflicting_beacon_implementation:type_name -> google.protobuf.StringValue
+	90,  // 103: api.StateDivergence.conflicting_node_version:type_name -> google.protobuf.StringValue
+	22,  // 104: api.GetConfigResponse.config:type_name -> api.Config
+	23,  // 105: api.Config.ethereum:type_name -> api.EthereumConfig
+	24,  // 106: api.EthereumConfig.config:type_name -> api.EthereumNetworkConfig
+	25,  // 107: api.EthereumConfig.tools:type_name -> api.ToolsConfig
+	26,  // 108: api.ToolsConfig.ncli:type_name -> api.GitRepositoryConfig
+	26,  // 109: api.ToolsConfig.lcli:type_name -> api.GitRepositoryConfig
+	27,  // 110: api.ToolsConfig.zcli:type_name -> api.ZcliConfig
+	91,  // 111: api.ListBeaconStateRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 112: api.ListBeaconStateRequest.after:type_name -> google.protobuf.Timestamp
+	19,  // 113: api.ListBeaconStateRequest.pagination:type_name -> api.PaginationCursor
+	93,  // 114: api.ListBeaconStateRequest.finalized:type_name -> google.protobuf.BoolValue
+	93,  // 115: api.ListBeaconStateRequest.canonical:type_name -> google.protobuf.BoolValue
+	9,   // 116: api.ListBeaconStateResponse.beacon_states:type_name -> api.BeaconState
+	91,  // 117: api.CountBeaconStateRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 118: api.CountBeaconStateRequest.after:type_name -> google.protobuf.Timestamp
+	93,  // 119: api.CountBeaconStateRequest.finalized:type_name -> google.protobuf.BoolValue
+	93,  // 120: api.CountBeaconStateRequest.canonical:type_name -> google.protobuf.BoolValue
+	92,  // 121: api.CountBeaconStateResponse.count:type_name -> google.protobuf.UInt64Value
+	0,   // 122: api.ListUniqueBeaconStateValuesRequest.fields:type_name -> api.ListUniqueBeaconStateValuesRequest.Field
+	91,  // 123: api.ListStateDivergencesRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 124: api.ListStateDivergencesRequest.after:type_name -> google.protobuf.Timestamp
+	19,  // 125: api.ListStateDivergencesRequest.pagination:type_name -> api.PaginationCursor
+	18,  // 126: api.ListStateDivergencesResponse.state_divergences:type_name -> api.StateDivergence
+	91,  // 127: api.ListBeaconBlockRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 128: api.ListBeaconBlockRequest.after:type_name -> google.protobuf.Timestamp
+	19,  // 129: api.ListBeaconBlockRequest.pagination:type_name -> api.PaginationCursor
+	93,  // 130: api.ListBeaconBlockRequest.canonical:type_name -> google.protobuf.BoolValue
+	10,  // 131: api.ListBeaconBlockResponse.beacon_blocks:type_name -> api.BeaconBlock
+	91,  // 132: api.CountBeaconBlockRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 133: api.CountBeaconBlockRequest.after:type_name -> google.protobuf.Timestamp
+	93,  // 134: api.CountBeaconBlockRequest.canonical:type_name -> google.protobuf.BoolValue
+	92,  // 135: api.CountBeaconBlockResponse.count:type_name -> google.protobuf.UInt64Value
+	1,   // 136: api.ListUniqueBeaconBlockValuesRequest.fields:type_name -> api.ListUniqueBeaconBlockValuesRequest.Field
+	91,  // 137: api.ListBlobSidecarRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 138: api.ListBlobSidecarRequest.after:type_name -> google.protobuf.Timestamp
+	19,  // 139: api.ListBlobSidecarRequest.pagination:type_name -> api.PaginationCursor
+	11,  // 140: api.ListBlobSidecarResponse.blob_sidecars:type_name -> api.BlobSidecar
+	91,  // 141: api.CountBlobSidecarRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 142: api.CountBlobSidecarRequest.after:type_name -> google.protobuf.Timestamp
+	92,  // 143: api.CountBlobSidecarResponse.count:type_name -> google.protobuf.UInt64Value
+	2,   // 144: api.ListUniqueBlobSidecarValuesRequest.fields:type_name -> api.ListUniqueBlobSidecarValuesRequest.Field
+	91,  // 145: api.ListForkChoiceRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 146: api.ListForkChoiceRequest.after:type_name -> google.protobuf.Timestamp
+	19,  // 147: api.ListForkChoiceRequest.pagination:type_name -> api.PaginationCursor
+	12,  // 148: api.ListForkChoiceResponse.fork_choices:type_name -> api.ForkChoice
+	91,  // 149: api.CountForkChoiceRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 150: api.CountForkChoiceRequest.after:type_name -> google.protobuf.Timestamp
+	92,  // 151: api.CountForkChoiceResponse.count:type_name -> google.protobuf.UInt64Value
+	3,   // 152: api.ListUniqueForkChoiceValuesRequest.fields:type_name -> api.ListUniqueForkChoiceValuesRequest.Field
+	91,  // 153: api.ListBeaconBadBlockRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 154: api.ListBeaconBadBlockRequest.after:type_name -> google.protobuf.Timestamp
+	19,  // 155: api.ListBeaconBadBlockRequest.pagination:type_name -> api.PaginationCursor
+	13,  // 156: api.ListBeaconBadBlockResponse.beacon_bad_blocks:type_name -> api.BeaconBadBlock
+	91,  // 157: api.CountBeaconBadBlockRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 158: api.CountBeaconBadBlockRequest.after:type_name -> google.protobuf.Timestamp
+	92,  // 159: api.CountBeaconBadBlockResponse.count:type_name -> google.protobuf.UInt64Value
+	4,   // 160: api.ListUniqueBeaconBadBlockValuesRequest.fields:type_name -> api.ListUniqueBeaconBadBlockValuesRequest.Field
+	91,  // 161: api.ListBeaconBadBlobRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 162: api.ListBeaconBadBlobRequest.after:type_name -> google.protobuf.Timestamp
+	19,  // 163: api.ListBeaconBadBlobRequest.pagination:type_name -> api.PaginationCursor
+	92,  // 164: api.ListBeaconBadBlobRequest.index:type_name -> google.protobuf.UInt64Value
+	14,  // 165: api.ListBeaconBadBlobResponse.beacon_bad_blobs:type_name -> api.BeaconBadBlob
+	91,  // 166: api.CountBeaconBadBlobRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 167: api.CountBeaconBadBlobRequest.after:type_name -> google.protobuf.Timestamp
+	92,  // 168: api.CountBeaconBadBlobRequest.index:type_name -> google.protobuf.UInt64Value
+	92,  // 169: api.CountBeaconBadBlobResponse.count:type_name -> google.protobuf.UInt64Value
+	5,   // 170: api.ListUniqueBeaconBadBlobValuesRequest.fields:type_name -> api.ListUniqueBeaconBadBlobValuesRequest.Field
+	91,  // 171: api.ListExecutionBlockTraceRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 172: api.ListExecutionBlockTraceRequest.after:type_name -> google.protobuf.Timestamp
+	19,  // 173: api.ListExecutionBlockTraceRequest.pagination:type_name -> api.PaginationCursor
+	93,  // 174: api.ListExecutionBlockTraceRequest.canonical:type_name -> google.protobuf.BoolValue
+	15,  // 175: api.ListExecutionBlockTraceResponse.execution_block_traces:type_name -> api.ExecutionBlockTrace
+	91,  // 176: api.CountExecutionBlockTraceRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 177: api.CountExecutionBlockTraceRequest.after:type_name -> google.protobuf.Timestamp
+	93,  // 178: api.CountExecutionBlockTraceRequest.canonical:type_name -> google.protobuf.BoolValue
+	92,  // 179: api.CountExecutionBlockTraceResponse.count:type_name -> google.protobuf.UInt64Value
+	6,   // 180: api.ListUniqueExecutionBlockTraceValuesRequest.fields:type_name -> api.ListUniqueExecutionBlockTraceValuesRequest.Field
+	73,  // 181: api.ExecutionBlockTraceStepDiff.step:type_name -> api.ExecutionBlockTraceStep
+	73,  // 182: api.ExecutionBlockTraceStepDiff.other_step:type_name -> api.ExecutionBlockTraceStep
+	74,  // 183: api.ExecutionBlockTraceStepDiff.stack:type_name -> api.ExecutionBlockTraceValueDiff
+	74,  // 184: api.ExecutionBlockTraceStepDiff.memory:type_name -> api.ExecutionBlockTraceValueDiff
+	74,  // 185: api.ExecutionBlockTraceStepDiff.storage:type_name -> api.ExecutionBlockTraceValueDiff
+	75,  // 186: api.ExecutionBlockTraceTransactionDiff.first_divergent_step:type_name -> api.ExecutionBlockTraceStepDiff
+	15,  // 187: api.DiffExecutionBlockTraceResponse.trace:type_name -> api.ExecutionBlockTrace
+	15,  // 188: api.DiffExecutionBlockTraceResponse.other_trace:type_name -> api.ExecutionBlockTrace
+	76,  // 189: api.DiffExecutionBlockTraceResponse.first_divergence:type_name -> api.ExecutionBlockTraceTransactionDiff
+	91,  // 190: api.ListExecutionWitnessRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 191: api.ListExecutionWitnessRequest.after:type_name -> google.protobuf.Timestamp
+	19,  // 192: api.ListExecutionWitnessRequest.pagination:type_name -> api.PaginationCursor
+	16,  // 193: api.ListExecutionWitnessResponse.execution_witnesses:type_name -> api.ExecutionWitness
+	91,  // 194: api.CountExecutionWitnessRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 195: api.CountExecutionWitnessRequest.after:type_name -> google.protobuf.Timestamp
+	92,  // 196: api.CountExecutionWitnessResponse.count:type_name -> google.protobuf.UInt64Value
+	7,   // 197: api.ListUniqueExecutionWitnessValuesRequest.fields:type_name -> api.ListUniqueExecutionWitnessValuesRequest.Field
+	91,  // 198: api.ListExecutionBadBlockRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 199: api.ListExecutionBadBlockRequest.after:type_name -> google.protobuf.Timestamp
+	19,  // 200: api.ListExecutionBadBlockRequest.pagination:type_name -> api.PaginationCursor
+	17,  // 201: api.ListExecutionBadBlockResponse.execution_bad_blocks:type_name -> api.ExecutionBadBlock
+	91,  // 202: api.CountExecutionBadBlockRequest.before:type_name -> google.protobuf.Timestamp
+	91,  // 203: api.CountExecutionBadBlockRequest.after:type_name -> google.protobuf.Timestamp
+	92,  // 204: api.CountExecutionBadBlockResponse.count:type_name -> google.protobuf.UInt64Value
+	8,   // 205: api.ListUniqueExecutionBadBlockValuesRequest.fields:type_name -> api.ListUniqueExecutionBadBlockValuesRequest.Field
+	20,  // 206: api.API.GetConfig:input_type -> api.GetConfigRequest
+	28,  // 207: api.API.ListBeaconState:input_type -> api.ListBeaconStateRequest
+	30,  // 208: api.API.CountBeaconState:input_type -> api.CountBeaconStateRequest
+	32,  // 209: api.API.ListUniqueBeaconStateValues:input_type -> api.ListUniqueBeaconStateValuesRequest
+	34,  // 210: api.API.ListStateDivergences:input_type -> api.ListStateDivergencesRequest
+	36,  // 211: api.API.ListBeaconBlock:input_type -> api.ListBeaconBlockRequest
+	38,  // 212: api.API.CountBeaconBlock:input_type -> api.CountBeaconBlockRequest
+	40,  // 213: api.API.ListUniqueBeaconBlockValues:input_type -> api.ListUniqueBeaconBlockValuesRequest
+	42,  // 214: api.API.ListBlobSidecar:input_type -> api.ListBlobSidecarRequest
+	44,  // 215: api.API.CountBlobSidecar:input_type -> api.CountBlobSidecarRequest
+	46,  // 216: api.API.ListUniqueBlobSidecarValues:input_type -> api.ListUniqueBlobSidecarValuesRequest
+	48,  // 217: api.API.ListForkChoice:input_type -> api.ListForkChoiceRequest
+	50,  // 218: api.API.CountForkChoice:input_type -> api.CountForkChoiceRequest
+	52,  // 219: api.API.ListUniqueForkChoiceValues:input_type -> api.ListUniqueForkChoiceValuesRequest
+	54,  // 220: api.API.ListBeaconBadBlock:input_type -> api.ListBeaconBadBlockRequest
+	56,  // 221: api.API.CountBeaconBadBlock:input_type -> api.CountBeaconBadBlockRequest
+	58,  // 222: api.API.ListUniqueBeaconBadBlockValues:input_type -> api.ListUniqueBeaconBadBlockValuesRequest
+	60,  // 223: api.API.ListBeaconBadBlob:input_type -> api.ListBeaconBadBlobRequest
+	62,  // 224: api.API.CountBeaconBadBlob:input_type -> api.CountBeaconBadBlobRequest
+	64,  // 225: api.API.ListUniqueBeaconBadBlobValues:input_type -> api.ListUniqueBeaconBadBlobValuesRequest
+	66,  // 226: api.API.ListExecutionBlockTrace:input_type -> api.ListExecutionBlockTraceRequest
+	68,  // 227: api.API.CountExecutionBlockTrace:input_type -> api.CountExecutionBlockTraceRequest
+	70,  // 228: api.API.ListUniqueExecutionBlockTraceValues:input_type -> api.ListUniqueExecutionBlockTraceValuesRequest
+	72,  // 229: api.API.DiffExecutionBlockTrace:input_type -> api.DiffExecutionBlockTraceRequest
+	78,  // 230: api.API.ListExecutionWitness:input_type -> api.ListExecutionWitnessRequest
+	80,  // 231: api.API.CountExecutionWitness:input_type -> api.CountExecutionWitnessRequest
+	82,  // 232: api.API.ListUniqueExecutionWitnessValues:input_type -> api.ListUniqueExecutionWitnessValuesRequest
+	84,  // 233: api.API.ListExecutionBadBlock:input_type -> api.ListExecutionBadBlockRequest
+	86,  // 234: api.API.CountExecutionBadBlock:input_type -> api.CountExecutionBadBlockRequest
+	88,  // 235: api.API.ListUniqueExecutionBadBlockValues:input_type -> api.ListUniqueExecutionBadBlockValuesRequest
+	21,  // 236: api.API.GetConfig:output_type -> api.GetConfigResponse
+	29,  // 237: api.API.ListBeaconState:output_type -> api.ListBeaconStateResponse
+	31,  // 238: api.API.CountBeaconState:output_type -> api.CountBeaconStateResponse
+	33,  // 239: api.API.ListUniqueBeaconStateValues:output_type -> api.ListUniqueBeaconStateValuesResponse
+	35,  // 240: api.API.ListStateDivergences:output_type -> api.ListStateDivergencesResponse
+	37,  // 241: api.API.ListBeaconBlock:output_type -> api.ListBeaconBlockResponse
+	39,  // 242: api.API.CountBeaconBlock:output_type -> api.CountBeaconBlockResponse
+	41,  // 243: api.API.ListUniqueBeaconBlockValues:output_type -> api.ListUniqueBeaconBlockValuesResponse
+	43,  // 244: api.API.ListBlobSidecar:output_type -> api.ListBlobSidecarResponse
+	45,  // 245: api.API.CountBlobSidecar:output_type -> api.CountBlobSidecarResponse
+	47,  // 246: api.API.ListUniqueBlobSidecarValues:output_type -> api.ListUniqueBlobSidecarValuesResponse
+	49,  // 247: api.API.ListForkChoice:output_type -> api.ListForkChoiceResponse
+	51,  // 248: api.API.CountForkChoice:output_type -> api.CountForkChoiceResponse
+	53,  // 249: api.API.ListUniqueForkChoiceValues:output_type -> api.ListUniqueForkChoiceValuesResponse
+	55,  // 250: api.API.ListBeaconBadBlock:output_type -> api.ListBeaconBadBlockResponse
+	57,  // 251: api.API.CountBeaconBadBlock:output_type -> api.CountBeaconBadBlockResponse
+	59,  // 252: api.API.ListUniqueBeaconBadBlockValues:output_type -> api.ListUniqueBeaconBadBlockValuesResponse
+	61,  // 253: api.API.ListBeaconBadBlob:output_type -> api.ListBeaconBadBlobResponse
+	63,  // 254: api.API.CountBeaconBadBlob:output_type -> api.CountBeaconBadBlobResponse
+	65,  // 255: api.API.ListUniqueBeaconBadBlobValues:output_type -> api.ListUniqueBeaconBadBlobValuesResponse
+	67,  // 256: api.API.ListExecutionBlockTrace:output_type -> api.ListExecutionBlockTraceResponse
+	69,  // 257: api.API.CountExecutionBlockTrace:output_type -> api.CountExecutionBlockTraceResponse
+	71,  // 258: api.API.ListUniqueExecutionBlockTraceValues:output_type -> api.ListUniqueExecutionBlockTraceValuesResponse
+	77,  // 259: api.API.DiffExecutionBlockTrace:output_type -> api.DiffExecutionBlockTraceResponse
+	79,  // 260: api.API.ListExecutionWitness:output_type -> api.ListExecutionWitnessResponse
+	81,  // 261: api.API.CountExecutionWitness:output_type -> api.CountExecutionWitnessResponse
+	83,  // 262: api.API.ListUniqueExecutionWitnessValues:output_type -> api.ListUniqueExecutionWitnessValuesResponse
+	85,  // 263: api.API.ListExecutionBadBlock:output_type -> api.ListExecutionBadBlockResponse
+	87,  // 264: api.API.CountExecutionBadBlock:output_type -> api.CountExecutionBadBlockResponse
+	89,  // 265: api.API.ListUniqueExecutionBadBlockValues:output_type -> api.ListUniqueExecutionBadBlockValuesResponse
+	236, // [236:266] is the sub-list for method output_type
+	206, // [206:236] is the sub-list for method input_type
+	206, // [206:206] is the sub-list for extension type_name
+	206, // [206:206] is the sub-list for extension extendee
+	0,   // [0:206] is the sub-list for field type_name
 }
 
 func init() { file_api_api_proto_init() }
@@ -5565,8 +9371,212 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeaconBlock); i {
+		file_api_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobSidecar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkChoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconBadBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconBadBlob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionBlockTrace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionWitness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionBadBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateDivergence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaginationCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthereumConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthereumNetworkConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToolsConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitRepositoryConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ZcliConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5577,8 +9587,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeaconBadBlock); i {
+		file_api_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeaconStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5589,8 +9599,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeaconBadBlob); i {
+		file_api_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeaconStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5601,8 +9611,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutionBlockTrace); i {
+		file_api_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBeaconStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5613,8 +9623,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutionBadBlock); i {
+		file_api_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBeaconStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5625,8 +9635,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaginationCursor); i {
+		file_api_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBeaconStateValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5637,8 +9647,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigRequest); i {
+		file_api_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBeaconStateValuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5649,8 +9659,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigResponse); i {
+		file_api_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStateDivergencesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5661,8 +9671,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+		file_api_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStateDivergencesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5673,8 +9683,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthereumConfig); i {
+		file_api_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeaconBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5685,8 +9695,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthereumNetworkConfig); i {
+		file_api_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeaconBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5697,8 +9707,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToolsConfig); i {
+		file_api_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBeaconBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5709,8 +9719,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitRepositoryConfig); i {
+		file_api_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBeaconBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5721,8 +9731,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZcliConfig); i {
+		file_api_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBeaconBlockValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5733,8 +9743,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeaconStateRequest); i {
+		file_api_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBeaconBlockValuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5745,8 +9755,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeaconStateResponse); i {
+		file_api_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlobSidecarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5757,8 +9767,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountBeaconStateRequest); i {
+		file_api_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlobSidecarResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5769,8 +9779,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountBeaconStateResponse); i {
+		file_api_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBlobSidecarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5781,8 +9791,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUniqueBeaconStateValuesRequest); i {
+		file_api_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBlobSidecarResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5793,8 +9803,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUniqueBeaconStateValuesResponse); i {
+		file_api_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBlobSidecarValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5805,8 +9815,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeaconBlockRequest); i {
+		file_api_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBlobSidecarValuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5817,8 +9827,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeaconBlockResponse); i {
+		file_api_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListForkChoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5829,8 +9839,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountBeaconBlockRequest); i {
+		file_api_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListForkChoiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5841,8 +9851,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountBeaconBlockResponse); i {
+		file_api_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountForkChoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5853,8 +9863,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUniqueBeaconBlockValuesRequest); i {
+		file_api_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountForkChoiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5865,8 +9875,8 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUniqueBeaconBlockValuesResponse); i {
+		file_api_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueForkChoiceValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5877,7 +9887,19 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueForkChoiceValuesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBeaconBadBlockRequest); i {
 			case 0:
 				return &v.state
@@ -5889,7 +9911,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBeaconBadBlockResponse); i {
 			case 0:
 				return &v.state
@@ -5901,7 +9923,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountBeaconBadBlockRequest); i {
 			case 0:
 				return &v.state
@@ -5913,7 +9935,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountBeaconBadBlockResponse); i {
 			case 0:
 				return &v.state
@@ -5925,7 +9947,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueBeaconBadBlockValuesRequest); i {
 			case 0:
 				return &v.state
@@ -5937,7 +9959,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueBeaconBadBlockValuesResponse); i {
 			case 0:
 				return &v.state
@@ -5949,7 +9971,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBeaconBadBlobRequest); i {
 			case 0:
 				return &v.state
@@ -5961,7 +9983,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBeaconBadBlobResponse); i {
 			case 0:
 				return &v.state
@@ -5973,7 +9995,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountBeaconBadBlobRequest); i {
 			case 0:
 				return &v.state
@@ -5985,7 +10007,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountBeaconBadBlobResponse); i {
 			case 0:
 				return &v.state
@@ -5997,7 +10019,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueBeaconBadBlobValuesRequest); i {
 			case 0:
 				return &v.state
@@ -6009,7 +10031,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueBeaconBadBlobValuesResponse); i {
 			case 0:
 				return &v.state
@@ -6021,7 +10043,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListExecutionBlockTraceRequest); i {
 			case 0:
 				return &v.state
@@ -6033,7 +10055,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListExecutionBlockTraceResponse); i {
 			case 0:
 				return &v.state
@@ -6045,7 +10067,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountExecutionBlockTraceRequest); i {
 			case 0:
 				return &v.state
@@ -6057,7 +10079,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountExecutionBlockTraceResponse); i {
 			case 0:
 				return &v.state
@@ -6069,7 +10091,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueExecutionBlockTraceValuesRequest); i {
 			case 0:
 				return &v.state
@@ -6081,7 +10103,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueExecutionBlockTraceValuesResponse); i {
 			case 0:
 				return &v.state
@@ -6093,7 +10115,151 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffExecutionBlockTraceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionBlockTraceStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionBlockTraceValueDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionBlockTraceStepDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionBlockTraceTransactionDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffExecutionBlockTraceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExecutionWitnessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExecutionWitnessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountExecutionWitnessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountExecutionWitnessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueExecutionWitnessValuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueExecutionWitnessValuesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListExecutionBadBlockRequest); i {
 			case 0:
 				return &v.state
@@ -6105,7 +10271,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListExecutionBadBlockResponse); i {
 			case 0:
 				return &v.state
@@ -6117,7 +10283,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountExecutionBadBlockRequest); i {
 			case 0:
 				return &v.state
@@ -6129,7 +10295,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountExecutionBadBlockResponse); i {
 			case 0:
 				return &v.state
@@ -6141,7 +10307,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueExecutionBadBlockValuesRequest); i {
 			case 0:
 				return &v.state
@@ -6153,7 +10319,7 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_api_api_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueExecutionBadBlockValuesResponse); i {
 			case 0:
 				return &v.state
@@ -6171,8 +10337,8 @@ func file_api_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_api_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   51,
+			NumEnums:      9,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
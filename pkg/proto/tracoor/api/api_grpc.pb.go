@@ -23,9 +23,16 @@ const (
 	API_ListBeaconState_FullMethodName                     = "/api.API/ListBeaconState"
 	API_CountBeaconState_FullMethodName                    = "/api.API/CountBeaconState"
 	API_ListUniqueBeaconStateValues_FullMethodName         = "/api.API/ListUniqueBeaconStateValues"
+	API_ListStateDivergences_FullMethodName                = "/api.API/ListStateDivergences"
 	API_ListBeaconBlock_FullMethodName                     = "/api.API/ListBeaconBlock"
 	API_CountBeaconBlock_FullMethodName                    = "/api.API/CountBeaconBlock"
 	API_ListUniqueBeaconBlockValues_FullMethodName         = "/api.API/ListUniqueBeaconBlockValues"
+	API_ListBlobSidecar_FullMethodName                     = "/api.API/ListBlobSidecar"
+	API_CountBlobSidecar_FullMethodName                    = "/api.API/CountBlobSidecar"
+	API_ListUniqueBlobSidecarValues_FullMethodName         = "/api.API/ListUniqueBlobSidecarValues"
+	API_ListForkChoice_FullMethodName                      = "/api.API/ListForkChoice"
+	API_CountForkChoice_FullMethodName                     = "/api.API/CountForkChoice"
+	API_ListUniqueForkChoiceValues_FullMethodName          = "/api.API/ListUniqueForkChoiceValues"
 	API_ListBeaconBadBlock_FullMethodName                  = "/api.API/ListBeaconBadBlock"
 	API_CountBeaconBadBlock_FullMethodName                 = "/api.API/CountBeaconBadBlock"
 	API_ListUniqueBeaconBadBlockValues_FullMethodName      = "/api.API/ListUniqueBeaconBadBlockValues"
@@ -35,6 +42,10 @@ const (
 	API_ListExecutionBlockTrace_FullMethodName             = "/api.API/ListExecutionBlockTrace"
 	API_CountExecutionBlockTrace_FullMethodName            = "/api.API/CountExecutionBlockTrace"
 	API_ListUniqueExecutionBlockTraceValues_FullMethodName = "/api.API/ListUniqueExecutionBlockTraceValues"
+	API_DiffExecutionBlockTrace_FullMethodName             = "/api.API/DiffExecutionBlockTrace"
+	API_ListExecutionWitness_FullMethodName                = "/api.API/ListExecutionWitness"
+	API_CountExecutionWitness_FullMethodName               = "/api.API/CountExecutionWitness"
+	API_ListUniqueExecutionWitnessValues_FullMethodName    = "/api.API/ListUniqueExecutionWitnessValues"
 	API_ListExecutionBadBlock_FullMethodName               = "/api.API/ListExecutionBadBlock"
 	API_CountExecutionBadBlock_FullMethodName              = "/api.API/CountExecutionBadBlock"
 	API_ListUniqueExecutionBadBlockValues_FullMethodName   = "/api.API/ListUniqueExecutionBadBlockValues"
@@ -48,9 +59,16 @@ type APIClient interface {
 	ListBeaconState(ctx context.Context, in *ListBeaconStateRequest, opts ...grpc.CallOption) (*ListBeaconStateResponse, error)
 	CountBeaconState(ctx context.Context, in *CountBeaconStateRequest, opts ...grpc.CallOption) (*CountBeaconStateResponse, error)
 	ListUniqueBeaconStateValues(ctx context.Context, in *ListUniqueBeaconStateValuesRequest, opts ...grpc.CallOption) (*ListUniqueBeaconStateValuesResponse, error)
+	ListStateDivergences(ctx context.Context, in *ListStateDivergencesRequest, opts ...grpc.CallOption) (*ListStateDivergencesResponse, error)
 	ListBeaconBlock(ctx context.Context, in *ListBeaconBlockRequest, opts ...grpc.CallOption) (*ListBeaconBlockResponse, error)
 	CountBeaconBlock(ctx context.Context, in *CountBeaconBlockRequest, opts ...grpc.CallOption) (*CountBeaconBlockResponse, error)
 	ListUniqueBeaconBlockValues(ctx context.Context, in *ListUniqueBeaconBlockValuesRequest, opts ...grpc.CallOption) (*ListUniqueBeaconBlockValuesResponse, error)
+	ListBlobSidecar(ctx context.Context, in *ListBlobSidecarRequest, opts ...grpc.CallOption) (*ListBlobSidecarResponse, error)
+	CountBlobSidecar(ctx context.Context, in *CountBlobSidecarRequest, opts ...grpc.CallOption) (*CountBlobSidecarResponse, error)
+	ListUniqueBlobSidecarValues(ctx context.Context, in *ListUniqueBlobSidecarValuesRequest, opts ...grpc.CallOption) (*ListUniqueBlobSidecarValuesResponse, error)
+	ListForkChoice(ctx context.Context, in *ListForkChoiceRequest, opts ...grpc.CallOption) (*ListForkChoiceResponse, error)
+	CountForkChoice(ctx context.Context, in *CountForkChoiceRequest, opts ...grpc.CallOption) (*CountForkChoiceResponse, error)
+	ListUniqueForkChoiceValues(ctx context.Context, in *ListUniqueForkChoiceValuesRequest, opts ...grpc.CallOption) (*ListUniqueForkChoiceValuesResponse, error)
 	ListBeaconBadBlock(ctx context.Context, in *ListBeaconBadBlockRequest, opts ...grpc.CallOption) (*ListBeaconBadBlockResponse, error)
 	CountBeaconBadBlock(ctx context.Context, in *CountBeaconBadBlockRequest, opts ...grpc.CallOption) (*CountBeaconBadBlockResponse, error)
 	ListUniqueBeaconBadBlockValues(ctx context.Context, in *ListUniqueBeaconBadBlockValuesRequest, opts ...grpc.CallOption) (*ListUniqueBeaconBadBlockValuesResponse, error)
@@ -60,6 +78,10 @@ type APIClient interface {
 	ListExecutionBlockTrace(ctx context.Context, in *ListExecutionBlockTraceRequest, opts ...grpc.CallOption) (*ListExecutionBlockTraceResponse, error)
 	CountExecutionBlockTrace(ctx context.Context, in *CountExecutionBlockTraceRequest, opts ...grpc.CallOption) (*CountExecutionBlockTraceResponse, error)
 	ListUniqueExecutionBlockTraceValues(ctx context.Context, in *ListUniqueExecutionBlockTraceValuesRequest, opts ...grpc.CallOption) (*ListUniqueExecutionBlockTraceValuesResponse, error)
+	DiffExecutionBlockTrace(ctx context.Context, in *DiffExecutionBlockTraceRequest, opts ...grpc.CallOption) (*DiffExecutionBlockTraceResponse, error)
+	ListExecutionWitness(ctx context.Context, in *ListExecutionWitnessRequest, opts ...grpc.CallOption) (*ListExecutionWitnessResponse, error)
+	CountExecutionWitness(ctx context.Context, in *CountExecutionWitnessRequest, opts ...grpc.CallOption) (*CountExecutionWitnessResponse, error)
+	ListUniqueExecutionWitnessValues(ctx context.Context, in *ListUniqueExecutionWitnessValuesRequest, opts ...grpc.CallOption) (*ListUniqueExecutionWitnessValuesResponse, error)
 	ListExecutionBadBlock(ctx context.Context, in *ListExecutionBadBlockRequest, opts ...grpc.CallOption) (*ListExecutionBadBlockResponse, error)
 	CountExecutionBadBlock(ctx context.Context, in *CountExecutionBadBlockRequest, opts ...grpc.CallOption) (*CountExecutionBadBlockResponse, error)
 	ListUniqueExecutionBadBlockValues(ctx context.Context, in *ListUniqueExecutionBadBlockValuesRequest, opts ...grpc.CallOption) (*ListUniqueExecutionBadBlockValuesResponse, error)
@@ -109,6 +131,15 @@ func (c *aPIClient) ListUniqueBeaconStateValues(ctx context.Context, in *ListUni
 	return out, nil
 }
 
+func (c *aPIClient) ListStateDivergences(ctx context.Context, in *ListStateDivergencesRequest, opts ...grpc.CallOption) (*ListStateDivergencesResponse, error) {
+	out := new(ListStateDivergencesResponse)
+	err := c.cc.Invoke(ctx, API_ListStateDivergences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) ListBeaconBlock(ctx context.Context, in *ListBeaconBlockRequest, opts ...grpc.CallOption) (*ListBeaconBlockResponse, error) {
 	out := new(ListBeaconBlockResponse)
 	err := c.cc.Invoke(ctx, API_ListBeaconBlock_FullMethodName, in, out, opts...)
@@ -136,6 +167,60 @@ func (c *aPIClient) ListUniqueBeaconBlockValues(ctx context.Context, in *ListUni
 	return out, nil
 }
 
+func (c *aPIClient) ListBlobSidecar(ctx context.Context, in *ListBlobSidecarRequest, opts ...grpc.CallOption) (*ListBlobSidecarResponse, error) {
+	out := new(ListBlobSidecarResponse)
+	err := c.cc.Invoke(ctx, API_ListBlobSidecar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CountBlobSidecar(ctx context.Context, in *CountBlobSidecarRequest, opts ...grpc.CallOption) (*CountBlobSidecarResponse, error) {
+	out := new(CountBlobSidecarResponse)
+	err := c.cc.Invoke(ctx, API_CountBlobSidecar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListUniqueBlobSidecarValues(ctx context.Context, in *ListUniqueBlobSidecarValuesRequest, opts ...grpc.CallOption) (*ListUniqueBlobSidecarValuesResponse, error) {
+	out := new(ListUniqueBlobSidecarValuesResponse)
+	err := c.cc.Invoke(ctx, API_ListUniqueBlobSidecarValues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListForkChoice(ctx context.Context, in *ListForkChoiceRequest, opts ...grpc.CallOption) (*ListForkChoiceResponse, error) {
+	out := new(ListForkChoiceResponse)
+	err := c.cc.Invoke(ctx, API_ListForkChoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CountForkChoice(ctx context.Context, in *CountForkChoiceRequest, opts ...grpc.CallOption) (*CountForkChoiceResponse, error) {
+	out := new(CountForkChoiceResponse)
+	err := c.cc.Invoke(ctx, API_CountForkChoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListUniqueForkChoiceValues(ctx context.Context, in *ListUniqueForkChoiceValuesRequest, opts ...grpc.CallOption) (*ListUniqueForkChoiceValuesResponse, error) {
+	out := new(ListUniqueForkChoiceValuesResponse)
+	err := c.cc.Invoke(ctx, API_ListUniqueForkChoiceValues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) ListBeaconBadBlock(ctx context.Context, in *ListBeaconBadBlockRequest, opts ...grpc.CallOption) (*ListBeaconBadBlockResponse, error) {
 	out := new(ListBeaconBadBlockResponse)
 	err := c.cc.Invoke(ctx, API_ListBeaconBadBlock_FullMethodName, in, out, opts...)
@@ -217,6 +302,42 @@ func (c *aPIClient) ListUniqueExecutionBlockTraceValues(ctx context.Context, in
 	return out, nil
 }
 
+func (c *aPIClient) DiffExecutionBlockTrace(ctx context.Context, in *DiffExecutionBlockTraceRequest, opts ...grpc.CallOption) (*DiffExecutionBlockTraceResponse, error) {
+	out := new(DiffExecutionBlockTraceResponse)
+	err := c.cc.Invoke(ctx, API_DiffExecutionBlockTrace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListExecutionWitness(ctx context.Context, in *ListExecutionWitnessRequest, opts ...grpc.CallOption) (*ListExecutionWitnessResponse, error) {
+	out := new(ListExecutionWitnessResponse)
+	err := c.cc.Invoke(ctx, API_ListExecutionWitness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CountExecutionWitness(ctx context.Context, in *CountExecutionWitnessRequest, opts ...grpc.CallOption) (*CountExecutionWitnessResponse, error) {
+	out := new(CountExecutionWitnessResponse)
+	err := c.cc.Invoke(ctx, API_CountExecutionWitness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListUniqueExecutionWitnessValues(ctx context.Context, in *ListUniqueExecutionWitnessValuesRequest, opts ...grpc.CallOption) (*ListUniqueExecutionWitnessValuesResponse, error) {
+	out := new(ListUniqueExecutionWitnessValuesResponse)
+	err := c.cc.Invoke(ctx, API_ListUniqueExecutionWitnessValues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) ListExecutionBadBlock(ctx context.Context, in *ListExecutionBadBlockRequest, opts ...grpc.CallOption) (*ListExecutionBadBlockResponse, error) {
 	out := new(ListExecutionBadBlockResponse)
 	err := c.cc.Invoke(ctx, API_ListExecutionBadBlock_FullMethodName, in, out, opts...)
@@ -252,9 +373,16 @@ type APIServer interface {
 	ListBeaconState(context.Context, *ListBeaconStateRequest) (*ListBeaconStateResponse, error)
 	CountBeaconState(context.Context, *CountBeaconStateRequest) (*CountBeaconStateResponse, error)
 	ListUniqueBeaconStateValues(context.Context, *ListUniqueBeaconStateValuesRequest) (*ListUniqueBeaconStateValuesResponse, error)
+	ListStateDivergences(context.Context, *ListStateDivergencesRequest) (*ListStateDivergencesResponse, error)
 	ListBeaconBlock(context.Context, *ListBeaconBlockRequest) (*ListBeaconBlockResponse, error)
 	CountBeaconBlock(context.Context, *CountBeaconBlockRequest) (*CountBeaconBlockResponse, error)
 	ListUniqueBeaconBlockValues(context.Context, *ListUniqueBeaconBlockValuesRequest) (*ListUniqueBeaconBlockValuesResponse, error)
+	ListBlobSidecar(context.Context, *ListBlobSidecarRequest) (*ListBlobSidecarResponse, error)
+	CountBlobSidecar(context.Context, *CountBlobSidecarRequest) (*CountBlobSidecarResponse, error)
+	ListUniqueBlobSidecarValues(context.Context, *ListUniqueBlobSidecarValuesRequest) (*ListUniqueBlobSidecarValuesResponse, error)
+	ListForkChoice(context.Context, *ListForkChoiceRequest) (*ListForkChoiceResponse, error)
+	CountForkChoice(context.Context, *CountForkChoiceRequest) (*CountForkChoiceResponse, error)
+	ListUniqueForkChoiceValues(context.Context, *ListUniqueForkChoiceValuesRequest) (*ListUniqueForkChoiceValuesResponse, error)
 	ListBeaconBadBlock(context.Context, *ListBeaconBadBlockRequest) (*ListBeaconBadBlockResponse, error)
 	CountBeaconBadBlock(context.Context, *CountBeaconBadBlockRequest) (*CountBeaconBadBlockResponse, error)
 	ListUniqueBeaconBadBlockValues(context.Context, *ListUniqueBeaconBadBlockValuesRequest) (*ListUniqueBeaconBadBlockValuesResponse, error)
@@ -264,6 +392,10 @@ type APIServer interface {
 	ListExecutionBlockTrace(context.Context, *ListExecutionBlockTraceRequest) (*ListExecutionBlockTraceResponse, error)
 	CountExecutionBlockTrace(context.Context, *CountExecutionBlockTraceRequest) (*CountExecutionBlockTraceResponse, error)
 	ListUniqueExecutionBlockTraceValues(context.Context, *ListUniqueExecutionBlockTraceValuesRequest) (*ListUniqueExecutionBlockTraceValuesResponse, error)
+	DiffExecutionBlockTrace(context.Context, *DiffExecutionBlockTraceRequest) (*DiffExecutionBlockTraceResponse, error)
+	ListExecutionWitness(context.Context, *ListExecutionWitnessRequest) (*ListExecutionWitnessResponse, error)
+	CountExecutionWitness(context.Context, *CountExecutionWitnessRequest) (*CountExecutionWitnessResponse, error)
+	ListUniqueExecutionWitnessValues(context.Context, *ListUniqueExecutionWitnessValuesRequest) (*ListUniqueExecutionWitnessValuesResponse, error)
 	ListExecutionBadBlock(context.Context, *ListExecutionBadBlockRequest) (*ListExecutionBadBlockResponse, error)
 	CountExecutionBadBlock(context.Context, *CountExecutionBadBlockRequest) (*CountExecutionBadBlockResponse, error)
 	ListUniqueExecutionBadBlockValues(context.Context, *ListUniqueExecutionBadBlockValuesRequest) (*ListUniqueExecutionBadBlockValuesResponse, error)
@@ -286,6 +418,9 @@ func (UnimplementedAPIServer) CountBeaconState(context.Context, *CountBeaconStat
 func (UnimplementedAPIServer) ListUniqueBeaconStateValues(context.Context, *ListUniqueBeaconStateValuesRequest) (*ListUniqueBeaconStateValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueBeaconStateValues not implemented")
 }
+func (UnimplementedAPIServer) ListStateDivergences(context.Context, *ListStateDivergencesRequest) (*ListStateDivergencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStateDivergences not implemented")
+}
 func (UnimplementedAPIServer) ListBeaconBlock(context.Context, *ListBeaconBlockRequest) (*ListBeaconBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBeaconBlock not implemented")
 }
@@ -295,6 +430,24 @@ func (UnimplementedAPIServer) CountBeaconBlock(context.Context, *CountBeaconBloc
 func (UnimplementedAPIServer) ListUniqueBeaconBlockValues(context.Context, *ListUniqueBeaconBlockValuesRequest) (*ListUniqueBeaconBlockValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueBeaconBlockValues not implemented")
 }
+func (UnimplementedAPIServer) ListBlobSidecar(context.Context, *ListBlobSidecarRequest) (*ListBlobSidecarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlobSidecar not implemented")
+}
+func (UnimplementedAPIServer) CountBlobSidecar(context.Context, *CountBlobSidecarRequest) (*CountBlobSidecarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountBlobSidecar not implemented")
+}
+func (UnimplementedAPIServer) ListUniqueBlobSidecarValues(context.Context, *ListUniqueBlobSidecarValuesRequest) (*ListUniqueBlobSidecarValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueBlobSidecarValues not implemented")
+}
+func (UnimplementedAPIServer) ListForkChoice(context.Context, *ListForkChoiceRequest) (*ListForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForkChoice not implemented")
+}
+func (UnimplementedAPIServer) CountForkChoice(context.Context, *CountForkChoiceRequest) (*CountForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountForkChoice not implemented")
+}
+func (UnimplementedAPIServer) ListUniqueForkChoiceValues(context.Context, *ListUniqueForkChoiceValuesRequest) (*ListUniqueForkChoiceValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueForkChoiceValues not implemented")
+}
 func (UnimplementedAPIServer) ListBeaconBadBlock(context.Context, *ListBeaconBadBlockRequest) (*ListBeaconBadBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBeaconBadBlock not implemented")
 }
@@ -322,6 +475,18 @@ func (UnimplementedAPIServer) CountExecutionBlockTrace(context.Context, *CountEx
 func (UnimplementedAPIServer) ListUniqueExecutionBlockTraceValues(context.Context, *ListUniqueExecutionBlockTraceValuesRequest) (*ListUniqueExecutionBlockTraceValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueExecutionBlockTraceValues not implemented")
 }
+func (UnimplementedAPIServer) DiffExecutionBlockTrace(context.Context, *DiffExecutionBlockTraceRequest) (*DiffExecutionBlockTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffExecutionBlockTrace not implemented")
+}
+func (UnimplementedAPIServer) ListExecutionWitness(context.Context, *ListExecutionWitnessRequest) (*ListExecutionWitnessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExecutionWitness not implemented")
+}
+func (UnimplementedAPIServer) CountExecutionWitness(context.Context, *CountExecutionWitnessRequest) (*CountExecutionWitnessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountExecutionWitness not implemented")
+}
+func (UnimplementedAPIServer) ListUniqueExecutionWitnessValues(context.Context, *ListUniqueExecutionWitnessValuesRequest) (*ListUniqueExecutionWitnessValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueExecutionWitnessValues not implemented")
+}
 func (UnimplementedAPIServer) ListExecutionBadBlock(context.Context, *ListExecutionBadBlockRequest) (*ListExecutionBadBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListExecutionBadBlock not implemented")
 }
@@ -416,6 +581,24 @@ func _API_ListUniqueBeaconStateValues_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ListStateDivergences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStateDivergencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListStateDivergences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListStateDivergences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListStateDivergences(ctx, req.(*ListStateDivergencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_ListBeaconBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBeaconBlockRequest)
 	if err := dec(in); err != nil {
@@ -470,6 +653,114 @@ func _API_ListUniqueBeaconBlockValues_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ListBlobSidecar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlobSidecarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListBlobSidecar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListBlobSidecar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListBlobSidecar(ctx, req.(*ListBlobSidecarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CountBlobSidecar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountBlobSidecarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CountBlobSidecar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CountBlobSidecar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CountBlobSidecar(ctx, req.(*CountBlobSidecarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListUniqueBlobSidecarValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUniqueBlobSidecarValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListUniqueBlobSidecarValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListUniqueBlobSidecarValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListUniqueBlobSidecarValues(ctx, req.(*ListUniqueBlobSidecarValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForkChoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListForkChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListForkChoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListForkChoice(ctx, req.(*ListForkChoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CountForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountForkChoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CountForkChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CountForkChoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CountForkChoice(ctx, req.(*CountForkChoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListUniqueForkChoiceValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUniqueForkChoiceValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListUniqueForkChoiceValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListUniqueForkChoiceValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListUniqueForkChoiceValues(ctx, req.(*ListUniqueForkChoiceValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_ListBeaconBadBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBeaconBadBlockRequest)
 	if err := dec(in); err != nil {
@@ -632,6 +923,78 @@ func _API_ListUniqueExecutionBlockTraceValues_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_DiffExecutionBlockTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffExecutionBlockTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DiffExecutionBlockTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_DiffExecutionBlockTrace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DiffExecutionBlockTrace(ctx, req.(*DiffExecutionBlockTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListExecutionWitness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExecutionWitnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListExecutionWitness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListExecutionWitness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListExecutionWitness(ctx, req.(*ListExecutionWitnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CountExecutionWitness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountExecutionWitnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CountExecutionWitness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_CountExecutionWitness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CountExecutionWitness(ctx, req.(*CountExecutionWitnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListUniqueExecutionWitnessValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUniqueExecutionWitnessValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListUniqueExecutionWitnessValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ListUniqueExecutionWitnessValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListUniqueExecutionWitnessValues(ctx, req.(*ListUniqueExecutionWitnessValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_ListExecutionBadBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListExecutionBadBlockRequest)
 	if err := dec(in); err != nil {
@@ -709,6 +1072,10 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUniqueBeaconStateValues",
 			Handler:    _API_ListUniqueBeaconStateValues_Handler,
 		},
+		{
+			MethodName: "ListStateDivergences",
+			Handler:    _API_ListStateDivergences_Handler,
+		},
 		{
 			MethodName: "ListBeaconBlock",
 			Handler:    _API_ListBeaconBlock_Handler,
@@ -721,6 +1088,30 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUniqueBeaconBlockValues",
 			Handler:    _API_ListUniqueBeaconBlockValues_Handler,
 		},
+		{
+			MethodName: "ListBlobSidecar",
+			Handler:    _API_ListBlobSidecar_Handler,
+		},
+		{
+			MethodName: "CountBlobSidecar",
+			Handler:    _API_CountBlobSidecar_Handler,
+		},
+		{
+			MethodName: "ListUniqueBlobSidecarValues",
+			Handler:    _API_ListUniqueBlobSidecarValues_Handler,
+		},
+		{
+			MethodName: "ListForkChoice",
+			Handler:    _API_ListForkChoice_Handler,
+		},
+		{
+			MethodName: "CountForkChoice",
+			Handler:    _API_CountForkChoice_Handler,
+		},
+		{
+			MethodName: "ListUniqueForkChoiceValues",
+			Handler:    _API_ListUniqueForkChoiceValues_Handler,
+		},
 		{
 			MethodName: "ListBeaconBadBlock",
 			Handler:    _API_ListBeaconBadBlock_Handler,
@@ -757,6 +1148,22 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUniqueExecutionBlockTraceValues",
 			Handler:    _API_ListUniqueExecutionBlockTraceValues_Handler,
 		},
+		{
+			MethodName: "DiffExecutionBlockTrace",
+			Handler:    _API_DiffExecutionBlockTrace_Handler,
+		},
+		{
+			MethodName: "ListExecutionWitness",
+			Handler:    _API_ListExecutionWitness_Handler,
+		},
+		{
+			MethodName: "CountExecutionWitness",
+			Handler:    _API_CountExecutionWitness_Handler,
+		},
+		{
+			MethodName: "ListUniqueExecutionWitnessValues",
+			Handler:    _API_ListUniqueExecutionWitnessValues_Handler,
+		},
 		{
 			MethodName: "ListExecutionBadBlock",
 			Handler:    _API_ListExecutionBadBlock_Handler,
@@ -15,6 +15,16 @@ func (r *ListBeaconStateRequest) Validate() error {
 	return nil
 }
 
+func (r *ListStateDivergencesRequest) Validate() error {
+	if r.Pagination != nil {
+		if err := r.Pagination.Validate(); err != nil {
+			return fmt.Errorf("invalid pagination: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (r *ListBeaconBlockRequest) Validate() error {
 	if r.Pagination != nil {
 		if err := r.Pagination.Validate(); err != nil {
@@ -25,6 +35,26 @@ func (r *ListBeaconBlockRequest) Validate() error {
 	return nil
 }
 
+func (r *ListBlobSidecarRequest) Validate() error {
+	if r.Pagination != nil {
+		if err := r.Pagination.Validate(); err != nil {
+			return fmt.Errorf("invalid pagination: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *ListForkChoiceRequest) Validate() error {
+	if r.Pagination != nil {
+		if err := r.Pagination.Validate(); err != nil {
+			return fmt.Errorf("invalid pagination: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (r *ListBeaconBadBlockRequest) Validate() error {
 	if r.Pagination != nil {
 		if err := r.Pagination.Validate(); err != nil {
@@ -55,6 +85,36 @@ func (r *ListExecutionBlockTraceRequest) Validate() error {
 	return nil
 }
 
+func (r *ListExecutionWitnessRequest) Validate() error {
+	if r.Pagination != nil {
+		if err := r.Pagination.Validate(); err != nil {
+			return fmt.Errorf("invalid pagination: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *DiffExecutionBlockTraceRequest) Validate() error {
+	if r == nil {
+		return errors.New("request is nil")
+	}
+
+	if r.Id == "" {
+		return errors.New("id is required")
+	}
+
+	if r.OtherId == "" {
+		return errors.New("other_id is required")
+	}
+
+	if r.Id == r.OtherId {
+		return errors.New("id and other_id must be different")
+	}
+
+	return nil
+}
+
 func (r *ListExecutionBadBlockRequest) Validate() error {
 	if r.Pagination != nil {
 		if err := r.Pagination.Validate(); err != nil {
@@ -97,6 +157,30 @@ func (r *ListUniqueBeaconBlockValuesRequest) Validate() error {
 	return nil
 }
 
+func (r *ListUniqueBlobSidecarValuesRequest) Validate() error {
+	if r == nil {
+		return errors.New("request is nil")
+	}
+
+	if len(r.Fields) == 0 {
+		return errors.New("fields is required")
+	}
+
+	return nil
+}
+
+func (r *ListUniqueForkChoiceValuesRequest) Validate() error {
+	if r == nil {
+		return errors.New("request is nil")
+	}
+
+	if len(r.Fields) == 0 {
+		return errors.New("fields is required")
+	}
+
+	return nil
+}
+
 func (r *ListUniqueBeaconBadBlockValuesRequest) Validate() error {
 	if r == nil {
 		return errors.New("request is nil")
@@ -133,6 +217,18 @@ func (r *ListUniqueExecutionBlockTraceValuesRequest) Validate() error {
 	return nil
 }
 
+func (r *ListUniqueExecutionWitnessValuesRequest) Validate() error {
+	if r == nil {
+		return errors.New("request is nil")
+	}
+
+	if len(r.Fields) == 0 {
+		return errors.New("fields is required")
+	}
+
+	return nil
+}
+
 func (r *ListUniqueExecutionBadBlockValuesRequest) Validate() error {
 	if r == nil {
 		return errors.New("request is nil")
@@ -33,6 +33,7 @@ const (
 	ListUniqueExecutionBlockTraceValuesRequest_EXECUTION_IMPLEMENTATION ListUniqueExecutionBlockTraceValuesRequest_Field = 5
 	ListUniqueExecutionBlockTraceValuesRequest_NODE_VERSION             ListUniqueExecutionBlockTraceValuesRequest_Field = 6
 	ListUniqueExecutionBlockTraceValuesRequest_CLIENT_VERSION           ListUniqueExecutionBlockTraceValuesRequest_Field = 7
+	ListUniqueExecutionBlockTraceValuesRequest_TRACER                   ListUniqueExecutionBlockTraceValuesRequest_Field = 8
 )
 
 // Enum value maps for ListUniqueExecutionBlockTraceValuesRequest_Field.
@@ -46,6 +47,7 @@ var (
 		5: "EXECUTION_IMPLEMENTATION",
 		6: "NODE_VERSION",
 		7: "CLIENT_VERSION",
+		8: "TRACER",
 	}
 	ListUniqueExecutionBlockTraceValuesRequest_Field_value = map[string]int32{
 		"NODE":                     0,
@@ -56,6 +58,7 @@ var (
 		"EXECUTION_IMPLEMENTATION": 5,
 		"NODE_VERSION":             6,
 		"CLIENT_VERSION":           7,
+		"TRACER":                   8,
 	}
 )
 
@@ -83,7 +86,68 @@ func (x ListUniqueExecutionBlockTraceValuesRequest_Field) Number() protoreflect.
 
 // Deprecated: Use ListUniqueExecutionBlockTraceValuesRequest_Field.Descriptor instead.
 func (ListUniqueExecutionBlockTraceValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{30, 0}
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{52, 0}
+}
+
+type ListUniqueExecutionWitnessValuesRequest_Field int32
+
+const (
+	ListUniqueExecutionWitnessValuesRequest_NODE                     ListUniqueExecutionWitnessValuesRequest_Field = 0
+	ListUniqueExecutionWitnessValuesRequest_BLOCK_HASH               ListUniqueExecutionWitnessValuesRequest_Field = 1
+	ListUniqueExecutionWitnessValuesRequest_BLOCK_NUMBER             ListUniqueExecutionWitnessValuesRequest_Field = 2
+	ListUniqueExecutionWitnessValuesRequest_LOCATION                 ListUniqueExecutionWitnessValuesRequest_Field = 3
+	ListUniqueExecutionWitnessValuesRequest_NETWORK                  ListUniqueExecutionWitnessValuesRequest_Field = 4
+	ListUniqueExecutionWitnessValuesRequest_EXECUTION_IMPLEMENTATION ListUniqueExecutionWitnessValuesRequest_Field = 5
+	ListUniqueExecutionWitnessValuesRequest_NODE_VERSION             ListUniqueExecutionWitnessValuesRequest_Field = 6
+)
+
+// Enum value maps for ListUniqueExecutionWitnessValuesRequest_Field.
+var (
+	ListUniqueExecutionWitnessValuesRequest_Field_name = map[int32]string{
+		0: "NODE",
+		1: "BLOCK_HASH",
+		2: "BLOCK_NUMBER",
+		3: "LOCATION",
+		4: "NETWORK",
+		5: "EXECUTION_IMPLEMENTATION",
+		6: "NODE_VERSION",
+	}
+	ListUniqueExecutionWitnessValuesRequest_Field_value = map[string]int32{
+		"NODE":                     0,
+		"BLOCK_HASH":               1,
+		"BLOCK_NUMBER":             2,
+		"LOCATION":                 3,
+		"NETWORK":                  4,
+		"EXECUTION_IMPLEMENTATION": 5,
+		"NODE_VERSION":             6,
+	}
+)
+
+func (x ListUniqueExecutionWitnessValuesRequest_Field) Enum() *ListUniqueExecutionWitnessValuesRequest_Field {
+	p := new(ListUniqueExecutionWitnessValuesRequest_Field)
+	*p = x
+	return p
+}
+
+func (x ListUniqueExecutionWitnessValuesRequest_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListUniqueExecutionWitnessValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_indexer_indexer_proto_enumTypes[1].Descriptor()
+}
+
+func (ListUniqueExecutionWitnessValuesRequest_Field) Type() protoreflect.EnumType {
+	return &file_indexer_indexer_proto_enumTypes[1]
+}
+
+func (x ListUniqueExecutionWitnessValuesRequest_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListUniqueExecutionWitnessValuesRequest_Field.Descriptor instead.
+func (ListUniqueExecutionWitnessValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{60, 0}
 }
 
 type ListUniqueBeaconStateValuesRequest_Field int32
@@ -134,11 +198,11 @@ func (x ListUniqueBeaconStateValuesRequest_Field) String() string {
 }
 
 func (ListUniqueBeaconStateValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
-	return file_indexer_indexer_proto_enumTypes[1].Descriptor()
+	return file_indexer_indexer_proto_enumTypes[2].Descriptor()
 }
 
 func (ListUniqueBeaconStateValuesRequest_Field) Type() protoreflect.EnumType {
-	return &file_indexer_indexer_proto_enumTypes[1]
+	return &file_indexer_indexer_proto_enumTypes[2]
 }
 
 func (x ListUniqueBeaconStateValuesRequest_Field) Number() protoreflect.EnumNumber {
@@ -147,7 +211,7 @@ func (x ListUniqueBeaconStateValuesRequest_Field) Number() protoreflect.EnumNumb
 
 // Deprecated: Use ListUniqueBeaconStateValuesRequest_Field.Descriptor instead.
 func (ListUniqueBeaconStateValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{32, 0}
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{62, 0}
 }
 
 type ListUniqueBeaconBlockValuesRequest_Field int32
@@ -198,11 +262,11 @@ func (x ListUniqueBeaconBlockValuesRequest_Field) String() string {
 }
 
 func (ListUniqueBeaconBlockValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
-	return file_indexer_indexer_proto_enumTypes[2].Descriptor()
+	return file_indexer_indexer_proto_enumTypes[3].Descriptor()
 }
 
 func (ListUniqueBeaconBlockValuesRequest_Field) Type() protoreflect.EnumType {
-	return &file_indexer_indexer_proto_enumTypes[2]
+	return &file_indexer_indexer_proto_enumTypes[3]
 }
 
 func (x ListUniqueBeaconBlockValuesRequest_Field) Number() protoreflect.EnumNumber {
@@ -211,7 +275,138 @@ func (x ListUniqueBeaconBlockValuesRequest_Field) Number() protoreflect.EnumNumb
 
 // Deprecated: Use ListUniqueBeaconBlockValuesRequest_Field.Descriptor instead.
 func (ListUniqueBeaconBlockValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{38, 0}
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{70, 0}
+}
+
+type ListUniqueBlobSidecarValuesRequest_Field int32
+
+const (
+	ListUniqueBlobSidecarValuesRequest_NODE                  ListUniqueBlobSidecarValuesRequest_Field = 0
+	ListUniqueBlobSidecarValuesRequest_SLOT                  ListUniqueBlobSidecarValuesRequest_Field = 1
+	ListUniqueBlobSidecarValuesRequest_EPOCH                 ListUniqueBlobSidecarValuesRequest_Field = 2
+	ListUniqueBlobSidecarValuesRequest_BLOCK_ROOT            ListUniqueBlobSidecarValuesRequest_Field = 3
+	ListUniqueBlobSidecarValuesRequest_NODE_VERSION          ListUniqueBlobSidecarValuesRequest_Field = 4
+	ListUniqueBlobSidecarValuesRequest_LOCATION              ListUniqueBlobSidecarValuesRequest_Field = 5
+	ListUniqueBlobSidecarValuesRequest_NETWORK               ListUniqueBlobSidecarValuesRequest_Field = 6
+	ListUniqueBlobSidecarValuesRequest_BEACON_IMPLEMENTATION ListUniqueBlobSidecarValuesRequest_Field = 7
+	ListUniqueBlobSidecarValuesRequest_SIDECAR_TYPE          ListUniqueBlobSidecarValuesRequest_Field = 8
+)
+
+// Enum value maps for ListUniqueBlobSidecarValuesRequest_Field.
+var (
+	ListUniqueBlobSidecarValuesRequest_Field_name = map[int32]string{
+		0: "NODE",
+		1: "SLOT",
+		2: "EPOCH",
+		3: "BLOCK_ROOT",
+		4: "NODE_VERSION",
+		5: "LOCATION",
+		6: "NETWORK",
+		7: "BEACON_IMPLEMENTATION",
+		8: "SIDECAR_TYPE",
+	}
+	ListUniqueBlobSidecarValuesRequest_Field_value = map[string]int32{
+		"NODE":                  0,
+		"SLOT":                  1,
+		"EPOCH":                 2,
+		"BLOCK_ROOT":            3,
+		"NODE_VERSION":          4,
+		"LOCATION":              5,
+		"NETWORK":               6,
+		"BEACON_IMPLEMENTATION": 7,
+		"SIDECAR_TYPE":          8,
+	}
+)
+
+func (x ListUniqueBlobSidecarValuesRequest_Field) Enum() *ListUniqueBlobSidecarValuesRequest_Field {
+	p := new(ListUniqueBlobSidecarValuesRequest_Field)
+	*p = x
+	return p
+}
+
+func (x ListUniqueBlobSidecarValuesRequest_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListUniqueBlobSidecarValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_indexer_indexer_proto_enumTypes[4].Descriptor()
+}
+
+func (ListUniqueBlobSidecarValuesRequest_Field) Type() protoreflect.EnumType {
+	return &file_indexer_indexer_proto_enumTypes[4]
+}
+
+func (x ListUniqueBlobSidecarValuesRequest_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListUniqueBlobSidecarValuesRequest_Field.Descriptor instead.
+func (ListUniqueBlobSidecarValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{76, 0}
+}
+
+type ListUniqueForkChoiceValuesRequest_Field int32
+
+const (
+	ListUniqueForkChoiceValuesRequest_NODE                  ListUniqueForkChoiceValuesRequest_Field = 0
+	ListUniqueForkChoiceValuesRequest_SLOT                  ListUniqueForkChoiceValuesRequest_Field = 1
+	ListUniqueForkChoiceValuesRequest_EPOCH                 ListUniqueForkChoiceValuesRequest_Field = 2
+	ListUniqueForkChoiceValuesRequest_NODE_VERSION          ListUniqueForkChoiceValuesRequest_Field = 3
+	ListUniqueForkChoiceValuesRequest_LOCATION              ListUniqueForkChoiceValuesRequest_Field = 4
+	ListUniqueForkChoiceValuesRequest_NETWORK               ListUniqueForkChoiceValuesRequest_Field = 5
+	ListUniqueForkChoiceValuesRequest_BEACON_IMPLEMENTATION ListUniqueForkChoiceValuesRequest_Field = 6
+	ListUniqueForkChoiceValuesRequest_REASON                ListUniqueForkChoiceValuesRequest_Field = 7
+)
+
+// Enum value maps for ListUniqueForkChoiceValuesRequest_Field.
+var (
+	ListUniqueForkChoiceValuesRequest_Field_name = map[int32]string{
+		0: "NODE",
+		1: "SLOT",
+		2: "EPOCH",
+		3: "NODE_VERSION",
+		4: "LOCATION",
+		5: "NETWORK",
+		6: "BEACON_IMPLEMENTATION",
+		7: "REASON",
+	}
+	ListUniqueForkChoiceValuesRequest_Field_value = map[string]int32{
+		"NODE":                  0,
+		"SLOT":                  1,
+		"EPOCH":                 2,
+		"NODE_VERSION":          3,
+		"LOCATION":              4,
+		"NETWORK":               5,
+		"BEACON_IMPLEMENTATION": 6,
+		"REASON":                7,
+	}
+)
+
+func (x ListUniqueForkChoiceValuesRequest_Field) Enum() *ListUniqueForkChoiceValuesRequest_Field {
+	p := new(ListUniqueForkChoiceValuesRequest_Field)
+	*p = x
+	return p
+}
+
+func (x ListUniqueForkChoiceValuesRequest_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListUniqueForkChoiceValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_indexer_indexer_proto_enumTypes[5].Descriptor()
+}
+
+func (ListUniqueForkChoiceValuesRequest_Field) Type() protoreflect.EnumType {
+	return &file_indexer_indexer_proto_enumTypes[5]
+}
+
+func (x ListUniqueForkChoiceValuesRequest_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListUniqueForkChoiceValuesRequest_Field.Descriptor instead.
+func (ListUniqueForkChoiceValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{82, 0}
 }
 
 type ListUniqueBeaconBadBlockValuesRequest_Field int32
@@ -262,11 +457,11 @@ func (x ListUniqueBeaconBadBlockValuesRequest_Field) String() string {
 }
 
 func (ListUniqueBeaconBadBlockValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
-	return file_indexer_indexer_proto_enumTypes[3].Descriptor()
+	return file_indexer_indexer_proto_enumTypes[6].Descriptor()
 }
 
 func (ListUniqueBeaconBadBlockValuesRequest_Field) Type() protoreflect.EnumType {
-	return &file_indexer_indexer_proto_enumTypes[3]
+	return &file_indexer_indexer_proto_enumTypes[6]
 }
 
 func (x ListUniqueBeaconBadBlockValuesRequest_Field) Number() protoreflect.EnumNumber {
@@ -275,7 +470,7 @@ func (x ListUniqueBeaconBadBlockValuesRequest_Field) Number() protoreflect.EnumN
 
 // Deprecated: Use ListUniqueBeaconBadBlockValuesRequest_Field.Descriptor instead.
 func (ListUniqueBeaconBadBlockValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{44, 0}
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{88, 0}
 }
 
 type ListUniqueBeaconBadBlobValuesRequest_Field int32
@@ -329,11 +524,11 @@ func (x ListUniqueBeaconBadBlobValuesRequest_Field) String() string {
 }
 
 func (ListUniqueBeaconBadBlobValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
-	return file_indexer_indexer_proto_enumTypes[4].Descriptor()
+	return file_indexer_indexer_proto_enumTypes[7].Descriptor()
 }
 
 func (ListUniqueBeaconBadBlobValuesRequest_Field) Type() protoreflect.EnumType {
-	return &file_indexer_indexer_proto_enumTypes[4]
+	return &file_indexer_indexer_proto_enumTypes[7]
 }
 
 func (x ListUniqueBeaconBadBlobValuesRequest_Field) Number() protoreflect.EnumNumber {
@@ -342,7 +537,7 @@ func (x ListUniqueBeaconBadBlobValuesRequest_Field) Number() protoreflect.EnumNu
 
 // Deprecated: Use ListUniqueBeaconBadBlobValuesRequest_Field.Descriptor instead.
 func (ListUniqueBeaconBadBlobValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{50, 0}
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{94, 0}
 }
 
 type ListUniqueExecutionBadBlockValuesRequest_Field int32
@@ -396,11 +591,11 @@ func (x ListUniqueExecutionBadBlockValuesRequest_Field) String() string {
 }
 
 func (ListUniqueExecutionBadBlockValuesRequest_Field) Descriptor() protoreflect.EnumDescriptor {
-	return file_indexer_indexer_proto_enumTypes[5].Descriptor()
+	return file_indexer_indexer_proto_enumTypes[8].Descriptor()
 }
 
 func (ListUniqueExecutionBadBlockValuesRequest_Field) Type() protoreflect.EnumType {
-	return &file_indexer_indexer_proto_enumTypes[5]
+	return &file_indexer_indexer_proto_enumTypes[8]
 }
 
 func (x ListUniqueExecutionBadBlockValuesRequest_Field) Number() protoreflect.EnumNumber {
@@ -409,7 +604,7 @@ func (x ListUniqueExecutionBadBlockValuesRequest_Field) Number() protoreflect.En
 
 // Deprecated: Use ListUniqueExecutionBadBlockValuesRequest_Field.Descriptor instead.
 func (ListUniqueExecutionBadBlockValuesRequest_Field) EnumDescriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{63, 0}
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{107, 0}
 }
 
 type BeaconState struct {
@@ -428,6 +623,11 @@ type BeaconState struct {
 	Network              *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=network,proto3" json:"network,omitempty"`
 	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
 	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	// Finalized is set for states captured as a finalized checkpoint.
+	Finalized       *wrapperspb.BoolValue   `protobuf:"bytes,12,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	CheckpointEpoch *wrapperspb.UInt64Value `protobuf:"bytes,13,opt,name=checkpoint_epoch,json=checkpointEpoch,proto3" json:"checkpoint_epoch,omitempty"`
+	// Canonical is false once the state has been orphaned by a reorg.
+	Canonical *wrapperspb.BoolValue `protobuf:"bytes,14,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
 func (x *BeaconState) Reset() {
@@ -539,6 +739,27 @@ func (x *BeaconState) GetContentEncoding() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *BeaconState) GetFinalized() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Finalized
+	}
+	return nil
+}
+
+func (x *BeaconState) GetCheckpointEpoch() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.CheckpointEpoch
+	}
+	return nil
+}
+
+func (x *BeaconState) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
 type BeaconBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -555,6 +776,8 @@ type BeaconBlock struct {
 	Network              *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=network,proto3" json:"network,omitempty"`
 	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
 	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	// Canonical is false once the block has been orphaned by a reorg.
+	Canonical *wrapperspb.BoolValue `protobuf:"bytes,12,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
 func (x *BeaconBlock) Reset() {
@@ -666,7 +889,14 @@ func (x *BeaconBlock) GetContentEncoding() *wrapperspb.StringValue {
 	return nil
 }
 
-type BeaconBadBlock struct {
+func (x *BeaconBlock) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type BlobSidecar struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -682,10 +912,11 @@ type BeaconBadBlock struct {
 	Network              *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=network,proto3" json:"network,omitempty"`
 	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
 	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	SidecarType          *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=sidecar_type,json=sidecarType,proto3" json:"sidecar_type,omitempty"`
 }
 
-func (x *BeaconBadBlock) Reset() {
-	*x = BeaconBadBlock{}
+func (x *BlobSidecar) Reset() {
+	*x = BlobSidecar{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -693,13 +924,13 @@ func (x *BeaconBadBlock) Reset() {
 	}
 }
 
-func (x *BeaconBadBlock) String() string {
+func (x *BlobSidecar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BeaconBadBlock) ProtoMessage() {}
+func (*BlobSidecar) ProtoMessage() {}
 
-func (x *BeaconBadBlock) ProtoReflect() protoreflect.Message {
+func (x *BlobSidecar) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -711,89 +942,96 @@ func (x *BeaconBadBlock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BeaconBadBlock.ProtoReflect.Descriptor instead.
-func (*BeaconBadBlock) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlobSidecar.ProtoReflect.Descriptor instead.
+func (*BlobSidecar) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *BeaconBadBlock) GetId() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetId() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetNode() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetFetchedAt() *timestamppb.Timestamp {
+func (x *BlobSidecar) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.FetchedAt
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetSlot() *wrapperspb.UInt64Value {
+func (x *BlobSidecar) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Slot
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetEpoch() *wrapperspb.UInt64Value {
+func (x *BlobSidecar) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Epoch
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetBlockRoot() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetBlockRoot() *wrapperspb.StringValue {
 	if x != nil {
 		return x.BlockRoot
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetNodeVersion() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetLocation() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetNetwork() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Network
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetBeaconImplementation() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.BeaconImplementation
 	}
 	return nil
 }
 
-func (x *BeaconBadBlock) GetContentEncoding() *wrapperspb.StringValue {
+func (x *BlobSidecar) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
 		return x.ContentEncoding
 	}
 	return nil
 }
 
-type BeaconBadBlob struct {
+func (x *BlobSidecar) GetSidecarType() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SidecarType
+	}
+	return nil
+}
+
+type ForkChoice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -803,17 +1041,16 @@ type BeaconBadBlob struct {
 	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
 	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
 	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
-	Network              *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-	Index                *wrapperspb.UInt64Value `protobuf:"bytes,11,opt,name=index,proto3" json:"index,omitempty"`
-	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	Reason               *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *BeaconBadBlob) Reset() {
-	*x = BeaconBadBlob{}
+func (x *ForkChoice) Reset() {
+	*x = ForkChoice{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,13 +1058,13 @@ func (x *BeaconBadBlob) Reset() {
 	}
 }
 
-func (x *BeaconBadBlob) String() string {
+func (x *ForkChoice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BeaconBadBlob) ProtoMessage() {}
+func (*ForkChoice) ProtoMessage() {}
 
-func (x *BeaconBadBlob) ProtoReflect() protoreflect.Message {
+func (x *ForkChoice) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -839,114 +1076,108 @@ func (x *BeaconBadBlob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BeaconBadBlob.ProtoReflect.Descriptor instead.
-func (*BeaconBadBlob) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForkChoice.ProtoReflect.Descriptor instead.
+func (*ForkChoice) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *BeaconBadBlob) GetId() *wrapperspb.StringValue {
+func (x *ForkChoice) GetId() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetNode() *wrapperspb.StringValue {
+func (x *ForkChoice) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetFetchedAt() *timestamppb.Timestamp {
+func (x *ForkChoice) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.FetchedAt
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetSlot() *wrapperspb.UInt64Value {
+func (x *ForkChoice) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Slot
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetEpoch() *wrapperspb.UInt64Value {
+func (x *ForkChoice) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Epoch
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetBlockRoot() *wrapperspb.StringValue {
-	if x != nil {
-		return x.BlockRoot
-	}
-	return nil
-}
-
-func (x *BeaconBadBlob) GetNodeVersion() *wrapperspb.StringValue {
+func (x *ForkChoice) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetLocation() *wrapperspb.StringValue {
+func (x *ForkChoice) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetNetwork() *wrapperspb.StringValue {
+func (x *ForkChoice) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Network
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetBeaconImplementation() *wrapperspb.StringValue {
+func (x *ForkChoice) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.BeaconImplementation
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetIndex() *wrapperspb.UInt64Value {
+func (x *ForkChoice) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Index
+		return x.ContentEncoding
 	}
 	return nil
 }
 
-func (x *BeaconBadBlob) GetContentEncoding() *wrapperspb.StringValue {
+func (x *ForkChoice) GetReason() *wrapperspb.StringValue {
 	if x != nil {
-		return x.ContentEncoding
+		return x.Reason
 	}
 	return nil
 }
 
-type ExecutionBlockTrace struct {
+type BeaconBadBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Node                    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
-	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Location                *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Network                 *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
-	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	ContentEncoding         *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	Id                   *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node                 *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
 }
 
-func (x *ExecutionBlockTrace) Reset() {
-	*x = ExecutionBlockTrace{}
+func (x *BeaconBadBlock) Reset() {
+	*x = BeaconBadBlock{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -954,13 +1185,13 @@ func (x *ExecutionBlockTrace) Reset() {
 	}
 }
 
-func (x *ExecutionBlockTrace) String() string {
+func (x *BeaconBadBlock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutionBlockTrace) ProtoMessage() {}
+func (*BeaconBadBlock) ProtoMessage() {}
 
-func (x *ExecutionBlockTrace) ProtoReflect() protoreflect.Message {
+func (x *BeaconBadBlock) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -972,101 +1203,109 @@ func (x *ExecutionBlockTrace) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutionBlockTrace.ProtoReflect.Descriptor instead.
-func (*ExecutionBlockTrace) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeaconBadBlock.ProtoReflect.Descriptor instead.
+func (*BeaconBadBlock) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ExecutionBlockTrace) GetId() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetId() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetNode() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetFetchedAt() *timestamppb.Timestamp {
+func (x *BeaconBadBlock) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.FetchedAt
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetBlockHash() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.BlockHash
+		return x.Slot
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetBlockNumber() *wrapperspb.Int64Value {
+func (x *BeaconBadBlock) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.BlockNumber
+		return x.Epoch
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetLocation() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetBlockRoot() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Location
+		return x.BlockRoot
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetNetwork() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Network
+		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetExecutionImplementation() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.Location
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetNodeVersion() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
-		return x.NodeVersion
+		return x.Network
 	}
 	return nil
 }
 
-func (x *ExecutionBlockTrace) GetContentEncoding() *wrapperspb.StringValue {
+func (x *BeaconBadBlock) GetBeaconImplementation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return nil
+}
+
+func (x *BeaconBadBlock) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
 		return x.ContentEncoding
 	}
 	return nil
 }
 
-type ExecutionBadBlock struct {
+type BeaconBadBlob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Node                    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
-	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Location                *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Network                 *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
-	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	BlockExtraData          *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=block_extra_data,json=blockExtraData,proto3" json:"block_extra_data,omitempty"`
-	ContentEncoding         *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	Id                   *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node                 *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Index                *wrapperspb.UInt64Value `protobuf:"bytes,11,opt,name=index,proto3" json:"index,omitempty"`
+	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
 }
 
-func (x *ExecutionBadBlock) Reset() {
-	*x = ExecutionBadBlock{}
+func (x *BeaconBadBlob) Reset() {
+	*x = BeaconBadBlob{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1074,13 +1313,13 @@ func (x *ExecutionBadBlock) Reset() {
 	}
 }
 
-func (x *ExecutionBadBlock) String() string {
+func (x *BeaconBadBlob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutionBadBlock) ProtoMessage() {}
+func (*BeaconBadBlob) ProtoMessage() {}
 
-func (x *ExecutionBadBlock) ProtoReflect() protoreflect.Message {
+func (x *BeaconBadBlob) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1092,98 +1331,117 @@ func (x *ExecutionBadBlock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutionBadBlock.ProtoReflect.Descriptor instead.
-func (*ExecutionBadBlock) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeaconBadBlob.ProtoReflect.Descriptor instead.
+func (*BeaconBadBlob) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ExecutionBadBlock) GetId() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetId() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetNode() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetFetchedAt() *timestamppb.Timestamp {
+func (x *BeaconBadBlob) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.FetchedAt
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetBlockHash() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.BlockHash
+		return x.Slot
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetBlockNumber() *wrapperspb.Int64Value {
+func (x *BeaconBadBlob) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.BlockNumber
+		return x.Epoch
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetLocation() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetBlockRoot() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Location
+		return x.BlockRoot
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetNetwork() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Network
+		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetExecutionImplementation() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.Location
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetNodeVersion() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
-		return x.NodeVersion
+		return x.Network
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetBlockExtraData() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BlockExtraData
+		return x.BeaconImplementation
 	}
 	return nil
 }
 
-func (x *ExecutionBadBlock) GetContentEncoding() *wrapperspb.StringValue {
+func (x *BeaconBadBlob) GetIndex() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *BeaconBadBlob) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
 		return x.ContentEncoding
 	}
 	return nil
 }
 
-type Config struct {
+type ExecutionBlockTrace struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ethereum *EthereumConfig `protobuf:"bytes,1,opt,name=ethereum,proto3" json:"ethereum,omitempty"`
+	Id                      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node                    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Location                *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network                 *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	ContentEncoding         *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	Tracer                  *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	// Canonical is false once the block has been orphaned by a reorg.
+	Canonical *wrapperspb.BoolValue `protobuf:"bytes,12,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
+func (x *ExecutionBlockTrace) Reset() {
+	*x = ExecutionBlockTrace{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1191,13 +1449,13 @@ func (x *Config) Reset() {
 	}
 }
 
-func (x *Config) String() string {
+func (x *ExecutionBlockTrace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config) ProtoMessage() {}
+func (*ExecutionBlockTrace) ProtoMessage() {}
 
-func (x *Config) ProtoReflect() protoreflect.Message {
+func (x *ExecutionBlockTrace) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1209,108 +1467,129 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecutionBlockTrace.ProtoReflect.Descriptor instead.
+func (*ExecutionBlockTrace) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Config) GetEthereum() *EthereumConfig {
+func (x *ExecutionBlockTrace) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Ethereum
+		return x.Id
 	}
 	return nil
 }
 
-type EthereumConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ExecutionBlockTrace) GetNode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
 
-	Network *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Config  *EthereumNetworkConfig  `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	Tools   *ToolsConfig            `protobuf:"bytes,3,opt,name=tools,proto3" json:"tools,omitempty"`
+func (x *ExecutionBlockTrace) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
 }
 
-func (x *EthereumConfig) Reset() {
-	*x = EthereumConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecutionBlockTrace) GetBlockHash() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BlockHash
 	}
+	return nil
 }
 
-func (x *EthereumConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecutionBlockTrace) GetBlockNumber() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
 }
 
-func (*EthereumConfig) ProtoMessage() {}
+func (x *ExecutionBlockTrace) GetLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
 
-func (x *EthereumConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecutionBlockTrace) GetNetwork() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Network
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use EthereumConfig.ProtoReflect.Descriptor instead.
-func (*EthereumConfig) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{7}
+func (x *ExecutionBlockTrace) GetExecutionImplementation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return nil
 }
 
-func (x *EthereumConfig) GetNetwork() *wrapperspb.StringValue {
+func (x *ExecutionBlockTrace) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Network
+		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *EthereumConfig) GetConfig() *EthereumNetworkConfig {
+func (x *ExecutionBlockTrace) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Config
+		return x.ContentEncoding
 	}
 	return nil
 }
 
-func (x *EthereumConfig) GetTools() *ToolsConfig {
+func (x *ExecutionBlockTrace) GetTracer() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Tools
+		return x.Tracer
 	}
 	return nil
 }
 
-type EthereumNetworkConfig struct {
+func (x *ExecutionBlockTrace) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type ExecutionWitness struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Repository *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
-	Branch     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	Path       *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Id                      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node                    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Location                *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network                 *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	ContentEncoding         *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
 }
 
-func (x *EthereumNetworkConfig) Reset() {
-	*x = EthereumNetworkConfig{}
+func (x *ExecutionWitness) Reset() {
+	*x = ExecutionWitness{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[8]
+		mi := &file_indexer_indexer_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EthereumNetworkConfig) String() string {
+func (x *ExecutionWitness) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthereumNetworkConfig) ProtoMessage() {}
+func (*ExecutionWitness) ProtoMessage() {}
 
-func (x *EthereumNetworkConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[8]
+func (x *ExecutionWitness) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,121 +1600,118 @@ func (x *EthereumNetworkConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthereumNetworkConfig.ProtoReflect.Descriptor instead.
-func (*EthereumNetworkConfig) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ExecutionWitness.ProtoReflect.Descriptor instead.
+func (*ExecutionWitness) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *EthereumNetworkConfig) GetRepository() *wrapperspb.StringValue {
+func (x *ExecutionWitness) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Repository
+		return x.Id
 	}
 	return nil
 }
 
-func (x *EthereumNetworkConfig) GetBranch() *wrapperspb.StringValue {
+func (x *ExecutionWitness) GetNode() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Branch
+		return x.Node
 	}
 	return nil
 }
 
-func (x *EthereumNetworkConfig) GetPath() *wrapperspb.StringValue {
+func (x *ExecutionWitness) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Path
+		return x.FetchedAt
 	}
 	return nil
 }
 
-type ToolsConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Ncli *GitRepositoryConfig `protobuf:"bytes,1,opt,name=ncli,proto3" json:"ncli,omitempty"`
-	Lcli *GitRepositoryConfig `protobuf:"bytes,2,opt,name=lcli,proto3" json:"lcli,omitempty"`
-	Zcli *ZcliConfig          `protobuf:"bytes,3,opt,name=zcli,proto3" json:"zcli,omitempty"`
-}
-
-func (x *ToolsConfig) Reset() {
-	*x = ToolsConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecutionWitness) GetBlockHash() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BlockHash
 	}
+	return nil
 }
 
-func (x *ToolsConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecutionWitness) GetBlockNumber() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
 }
 
-func (*ToolsConfig) ProtoMessage() {}
-
-func (x *ToolsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecutionWitness) GetLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Location
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ToolsConfig.ProtoReflect.Descriptor instead.
-func (*ToolsConfig) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{9}
+func (x *ExecutionWitness) GetNetwork() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Network
+	}
+	return nil
 }
 
-func (x *ToolsConfig) GetNcli() *GitRepositoryConfig {
+func (x *ExecutionWitness) GetExecutionImplementation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Ncli
+		return x.ExecutionImplementation
 	}
 	return nil
 }
 
-func (x *ToolsConfig) GetLcli() *GitRepositoryConfig {
+func (x *ExecutionWitness) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Lcli
+		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *ToolsConfig) GetZcli() *ZcliConfig {
+func (x *ExecutionWitness) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Zcli
+		return x.ContentEncoding
 	}
 	return nil
 }
 
-type GitRepositoryConfig struct {
+type ExecutionBadBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Repository *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
-	Branch     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Id                        *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Node                      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt                 *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	BlockHash                 *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber               *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Location                  *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network                   *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation   *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion               *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	BlockExtraData            *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=block_extra_data,json=blockExtraData,proto3" json:"block_extra_data,omitempty"`
+	ContentEncoding           *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	TraceLocation             *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=trace_location,json=traceLocation,proto3" json:"trace_location,omitempty"`
+	IntermediateRootsLocation *wrapperspb.StringValue `protobuf:"bytes,13,opt,name=intermediate_roots_location,json=intermediateRootsLocation,proto3" json:"intermediate_roots_location,omitempty"`
 }
 
-func (x *GitRepositoryConfig) Reset() {
-	*x = GitRepositoryConfig{}
+func (x *ExecutionBadBlock) Reset() {
+	*x = ExecutionBadBlock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[10]
+		mi := &file_indexer_indexer_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GitRepositoryConfig) String() string {
+func (x *ExecutionBadBlock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitRepositoryConfig) ProtoMessage() {}
+func (*ExecutionBadBlock) ProtoMessage() {}
 
-func (x *GitRepositoryConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[10]
+func (x *ExecutionBadBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1446,135 +1722,141 @@ func (x *GitRepositoryConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitRepositoryConfig.ProtoReflect.Descriptor instead.
-func (*GitRepositoryConfig) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ExecutionBadBlock.ProtoReflect.Descriptor instead.
+func (*ExecutionBadBlock) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GitRepositoryConfig) GetRepository() *wrapperspb.StringValue {
+func (x *ExecutionBadBlock) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Repository
+		return x.Id
 	}
 	return nil
 }
 
-func (x *GitRepositoryConfig) GetBranch() *wrapperspb.StringValue {
+func (x *ExecutionBadBlock) GetNode() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Branch
+		return x.Node
 	}
 	return nil
 }
 
-type ZcliConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Fork *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=fork,proto3" json:"fork,omitempty"`
+func (x *ExecutionBadBlock) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
 }
 
-func (x *ZcliConfig) Reset() {
-	*x = ZcliConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecutionBadBlock) GetBlockHash() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BlockHash
 	}
+	return nil
 }
 
-func (x *ZcliConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecutionBadBlock) GetBlockNumber() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
 }
 
-func (*ZcliConfig) ProtoMessage() {}
-
-func (x *ZcliConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecutionBadBlock) GetLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Location
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ZcliConfig.ProtoReflect.Descriptor instead.
-func (*ZcliConfig) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{11}
+func (x *ExecutionBadBlock) GetNetwork() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Network
+	}
+	return nil
 }
 
-func (x *ZcliConfig) GetFork() *wrapperspb.StringValue {
+func (x *ExecutionBadBlock) GetExecutionImplementation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Fork
+		return x.ExecutionImplementation
 	}
 	return nil
 }
 
-type GetConfigRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ExecutionBadBlock) GetNodeVersion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
 }
 
-func (x *GetConfigRequest) Reset() {
-	*x = GetConfigRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecutionBadBlock) GetBlockExtraData() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BlockExtraData
 	}
+	return nil
 }
 
-func (x *GetConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecutionBadBlock) GetContentEncoding() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ContentEncoding
+	}
+	return nil
 }
 
-func (*GetConfigRequest) ProtoMessage() {}
-
-func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExecutionBadBlock) GetTraceLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.TraceLocation
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetConfigRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{12}
+func (x *ExecutionBadBlock) GetIntermediateRootsLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.IntermediateRootsLocation
+	}
+	return nil
 }
 
-type GetConfigResponse struct {
+type StateDivergence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-}
-
-func (x *GetConfigResponse) Reset() {
-	*x = GetConfigResponse{}
+	Id                              *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Network                         *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Slot                            *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                           *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	DetectedAt                      *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	BeaconStateId                   *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=beacon_state_id,json=beaconStateId,proto3" json:"beacon_state_id,omitempty"`
+	Node                            *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=node,proto3" json:"node,omitempty"`
+	StateRoot                       *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	BeaconImplementation            *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	NodeVersion                     *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	ConflictingBeaconStateId        *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=conflicting_beacon_state_id,json=conflictingBeaconStateId,proto3" json:"conflicting_beacon_state_id,omitempty"`
+	ConflictingNode                 *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=conflicting_node,json=conflictingNode,proto3" json:"conflicting_node,omitempty"`
+	ConflictingStateRoot            *wrapperspb.StringValue `protobuf:"bytes,13,opt,name=conflicting_state_root,json=conflictingStateRoot,proto3" json:"conflicting_state_root,omitempty"`
+	ConflictingBeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,14,opt,name=conflicting_beacon_implementation,json=conflictingBeaconImplementation,proto3" json:"conflicting_beacon_implementation,omitempty"`
+	ConflictingNodeVersion          *wrapperspb.StringValue `protobuf:"bytes,15,opt,name=conflicting_node_version,json=conflictingNodeVersion,proto3" json:"conflicting_node_version,omitempty"`
+}
+
+func (x *StateDivergence) Reset() {
+	*x = StateDivergence{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[13]
+		mi := &file_indexer_indexer_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetConfigResponse) String() string {
+func (x *StateDivergence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigResponse) ProtoMessage() {}
+func (*StateDivergence) ProtoMessage() {}
 
-func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[13]
+func (x *StateDivergence) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,154 +1867,141 @@ func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetConfigResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use StateDivergence.ProtoReflect.Descriptor instead.
+func (*StateDivergence) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetConfigResponse) GetConfig() *Config {
+func (x *StateDivergence) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Config
+		return x.Id
 	}
 	return nil
 }
 
-type GetStorageHandshakeTokenRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Node  string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+func (x *StateDivergence) GetNetwork() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Network
+	}
+	return nil
 }
 
-func (x *GetStorageHandshakeTokenRequest) Reset() {
-	*x = GetStorageHandshakeTokenRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StateDivergence) GetSlot() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Slot
 	}
+	return nil
 }
 
-func (x *GetStorageHandshakeTokenRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StateDivergence) GetEpoch() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
 }
 
-func (*GetStorageHandshakeTokenRequest) ProtoMessage() {}
+func (x *StateDivergence) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
 
-func (x *GetStorageHandshakeTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StateDivergence) GetBeaconStateId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BeaconStateId
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetStorageHandshakeTokenRequest.ProtoReflect.Descriptor instead.
-func (*GetStorageHandshakeTokenRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetStorageHandshakeTokenRequest) GetNode() string {
+func (x *StateDivergence) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
-	return ""
+	return nil
 }
 
-func (x *GetStorageHandshakeTokenRequest) GetToken() string {
+func (x *StateDivergence) GetStateRoot() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Token
+		return x.StateRoot
 	}
-	return ""
+	return nil
 }
 
-type GetStorageHandshakeTokenResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+func (x *StateDivergence) GetBeaconImplementation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return nil
 }
 
-func (x *GetStorageHandshakeTokenResponse) Reset() {
-	*x = GetStorageHandshakeTokenResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StateDivergence) GetNodeVersion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NodeVersion
 	}
+	return nil
 }
 
-func (x *GetStorageHandshakeTokenResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StateDivergence) GetConflictingBeaconStateId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ConflictingBeaconStateId
+	}
+	return nil
 }
 
-func (*GetStorageHandshakeTokenResponse) ProtoMessage() {}
+func (x *StateDivergence) GetConflictingNode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ConflictingNode
+	}
+	return nil
+}
 
-func (x *GetStorageHandshakeTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StateDivergence) GetConflictingStateRoot() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ConflictingStateRoot
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetStorageHandshakeTokenResponse.ProtoReflect.Descriptor instead.
-func (*GetStorageHandshakeTokenResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{15}
+func (x *StateDivergence) GetConflictingBeaconImplementation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ConflictingBeaconImplementation
+	}
+	return nil
 }
 
-func (x *GetStorageHandshakeTokenResponse) GetToken() string {
+func (x *StateDivergence) GetConflictingNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Token
+		return x.ConflictingNodeVersion
 	}
-	return ""
+	return nil
 }
 
-type CreateBeaconStateRequest struct {
+type Config struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
-	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	StateRoot            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
-	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
-	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	Ethereum *EthereumConfig `protobuf:"bytes,1,opt,name=ethereum,proto3" json:"ethereum,omitempty"`
 }
 
-func (x *CreateBeaconStateRequest) Reset() {
-	*x = CreateBeaconStateRequest{}
+func (x *Config) Reset() {
+	*x = Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[16]
+		mi := &file_indexer_indexer_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateBeaconStateRequest) String() string {
+func (x *Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBeaconStateRequest) ProtoMessage() {}
+func (*Config) ProtoMessage() {}
 
-func (x *CreateBeaconStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[16]
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,106 +2012,108 @@ func (x *CreateBeaconStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBeaconStateRequest.ProtoReflect.Descriptor instead.
-func (*CreateBeaconStateRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateBeaconStateRequest) GetNode() *wrapperspb.StringValue {
+func (x *Config) GetEthereum() *EthereumConfig {
 	if x != nil {
-		return x.Node
+		return x.Ethereum
 	}
 	return nil
 }
 
-func (x *CreateBeaconStateRequest) GetFetchedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.FetchedAt
-	}
-	return nil
-}
+type EthereumConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *CreateBeaconStateRequest) GetSlot() *wrapperspb.UInt64Value {
-	if x != nil {
-		return x.Slot
-	}
-	return nil
+	Network *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Config  *EthereumNetworkConfig  `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Tools   *ToolsConfig            `protobuf:"bytes,3,opt,name=tools,proto3" json:"tools,omitempty"`
 }
 
-func (x *CreateBeaconStateRequest) GetEpoch() *wrapperspb.UInt64Value {
-	if x != nil {
-		return x.Epoch
+func (x *EthereumConfig) Reset() {
+	*x = EthereumConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CreateBeaconStateRequest) GetStateRoot() *wrapperspb.StringValue {
-	if x != nil {
-		return x.StateRoot
-	}
-	return nil
+func (x *EthereumConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateBeaconStateRequest) GetNodeVersion() *wrapperspb.StringValue {
-	if x != nil {
-		return x.NodeVersion
+func (*EthereumConfig) ProtoMessage() {}
+
+func (x *EthereumConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateBeaconStateRequest) GetLocation() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Location
-	}
-	return nil
+// Deprecated: Use EthereumConfig.ProtoReflect.Descriptor instead.
+func (*EthereumConfig) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateBeaconStateRequest) GetNetwork() *wrapperspb.StringValue {
+func (x *EthereumConfig) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Network
 	}
 	return nil
 }
 
-func (x *CreateBeaconStateRequest) GetBeaconImplementation() *wrapperspb.StringValue {
+func (x *EthereumConfig) GetConfig() *EthereumNetworkConfig {
 	if x != nil {
-		return x.BeaconImplementation
+		return x.Config
 	}
 	return nil
 }
 
-func (x *CreateBeaconStateRequest) GetContentEncoding() *wrapperspb.StringValue {
+func (x *EthereumConfig) GetTools() *ToolsConfig {
 	if x != nil {
-		return x.ContentEncoding
+		return x.Tools
 	}
 	return nil
 }
 
-type CreateBeaconStateResponse struct {
+type EthereumNetworkConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Repository *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Branch     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Path       *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *CreateBeaconStateResponse) Reset() {
-	*x = CreateBeaconStateResponse{}
+func (x *EthereumNetworkConfig) Reset() {
+	*x = EthereumNetworkConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[17]
+		mi := &file_indexer_indexer_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateBeaconStateResponse) String() string {
+func (x *EthereumNetworkConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBeaconStateResponse) ProtoMessage() {}
+func (*EthereumNetworkConfig) ProtoMessage() {}
 
-func (x *CreateBeaconStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[17]
+func (x *EthereumNetworkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,52 +2124,59 @@ func (x *CreateBeaconStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBeaconStateResponse.ProtoReflect.Descriptor instead.
-func (*CreateBeaconStateResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use EthereumNetworkConfig.ProtoReflect.Descriptor instead.
+func (*EthereumNetworkConfig) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateBeaconStateResponse) GetId() *wrapperspb.StringValue {
+func (x *EthereumNetworkConfig) GetRepository() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Id
+		return x.Repository
 	}
 	return nil
 }
 
-type CreateBeaconBlockRequest struct {
+func (x *EthereumNetworkConfig) GetBranch() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Branch
+	}
+	return nil
+}
+
+func (x *EthereumNetworkConfig) GetPath() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type ToolsConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
-	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
-	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	Ncli *GitRepositoryConfig `protobuf:"bytes,1,opt,name=ncli,proto3" json:"ncli,omitempty"`
+	Lcli *GitRepositoryConfig `protobuf:"bytes,2,opt,name=lcli,proto3" json:"lcli,omitempty"`
+	Zcli *ZcliConfig          `protobuf:"bytes,3,opt,name=zcli,proto3" json:"zcli,omitempty"`
 }
 
-func (x *CreateBeaconBlockRequest) Reset() {
-	*x = CreateBeaconBlockRequest{}
+func (x *ToolsConfig) Reset() {
+	*x = ToolsConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[18]
+		mi := &file_indexer_indexer_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateBeaconBlockRequest) String() string {
+func (x *ToolsConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBeaconBlockRequest) ProtoMessage() {}
+func (*ToolsConfig) ProtoMessage() {}
 
-func (x *CreateBeaconBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[18]
+func (x *ToolsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1909,106 +2187,112 @@ func (x *CreateBeaconBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBeaconBlockRequest.ProtoReflect.Descriptor instead.
-func (*CreateBeaconBlockRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ToolsConfig.ProtoReflect.Descriptor instead.
+func (*ToolsConfig) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateBeaconBlockRequest) GetNode() *wrapperspb.StringValue {
+func (x *ToolsConfig) GetNcli() *GitRepositoryConfig {
 	if x != nil {
-		return x.Node
+		return x.Ncli
 	}
 	return nil
 }
 
-func (x *CreateBeaconBlockRequest) GetFetchedAt() *timestamppb.Timestamp {
+func (x *ToolsConfig) GetLcli() *GitRepositoryConfig {
 	if x != nil {
-		return x.FetchedAt
+		return x.Lcli
 	}
 	return nil
 }
 
-func (x *CreateBeaconBlockRequest) GetSlot() *wrapperspb.UInt64Value {
+func (x *ToolsConfig) GetZcli() *ZcliConfig {
 	if x != nil {
-		return x.Slot
+		return x.Zcli
 	}
 	return nil
 }
 
-func (x *CreateBeaconBlockRequest) GetEpoch() *wrapperspb.UInt64Value {
-	if x != nil {
-		return x.Epoch
-	}
-	return nil
+type GitRepositoryConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repository *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Branch     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
 }
 
-func (x *CreateBeaconBlockRequest) GetBlockRoot() *wrapperspb.StringValue {
-	if x != nil {
-		return x.BlockRoot
+func (x *GitRepositoryConfig) Reset() {
+	*x = GitRepositoryConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CreateBeaconBlockRequest) GetNodeVersion() *wrapperspb.StringValue {
-	if x != nil {
-		return x.NodeVersion
-	}
-	return nil
+func (x *GitRepositoryConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateBeaconBlockRequest) GetLocation() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Location
+func (*GitRepositoryConfig) ProtoMessage() {}
+
+func (x *GitRepositoryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateBeaconBlockRequest) GetNetwork() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Network
-	}
-	return nil
+// Deprecated: Use GitRepositoryConfig.ProtoReflect.Descriptor instead.
+func (*GitRepositoryConfig) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateBeaconBlockRequest) GetBeaconImplementation() *wrapperspb.StringValue {
+func (x *GitRepositoryConfig) GetRepository() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BeaconImplementation
+		return x.Repository
 	}
 	return nil
 }
 
-func (x *CreateBeaconBlockRequest) GetContentEncoding() *wrapperspb.StringValue {
+func (x *GitRepositoryConfig) GetBranch() *wrapperspb.StringValue {
 	if x != nil {
-		return x.ContentEncoding
+		return x.Branch
 	}
 	return nil
 }
 
-type CreateBeaconBlockResponse struct {
+type ZcliConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fork *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=fork,proto3" json:"fork,omitempty"`
 }
 
-func (x *CreateBeaconBlockResponse) Reset() {
-	*x = CreateBeaconBlockResponse{}
+func (x *ZcliConfig) Reset() {
+	*x = ZcliConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[19]
+		mi := &file_indexer_indexer_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateBeaconBlockResponse) String() string {
+func (x *ZcliConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBeaconBlockResponse) ProtoMessage() {}
+func (*ZcliConfig) ProtoMessage() {}
 
-func (x *CreateBeaconBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[19]
+func (x *ZcliConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2019,52 +2303,41 @@ func (x *CreateBeaconBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBeaconBlockResponse.ProtoReflect.Descriptor instead.
-func (*CreateBeaconBlockResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ZcliConfig.ProtoReflect.Descriptor instead.
+func (*ZcliConfig) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateBeaconBlockResponse) GetId() *wrapperspb.StringValue {
+func (x *ZcliConfig) GetFork() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Id
+		return x.Fork
 	}
 	return nil
 }
 
-type CreateBeaconBadBlockRequest struct {
+type GetConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Node                 *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
-	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
-	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
 }
 
-func (x *CreateBeaconBadBlockRequest) Reset() {
-	*x = CreateBeaconBadBlockRequest{}
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[20]
+		mi := &file_indexer_indexer_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateBeaconBadBlockRequest) String() string {
+func (x *GetConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBeaconBadBlockRequest) ProtoMessage() {}
+func (*GetConfigRequest) ProtoMessage() {}
 
-func (x *CreateBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[20]
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2075,106 +2348,36 @@ func (x *CreateBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBeaconBadBlockRequest.ProtoReflect.Descriptor instead.
-func (*CreateBeaconBadBlockRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *CreateBeaconBadBlockRequest) GetNode() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Node
-	}
-	return nil
-}
-
-func (x *CreateBeaconBadBlockRequest) GetFetchedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.FetchedAt
-	}
-	return nil
-}
-
-func (x *CreateBeaconBadBlockRequest) GetSlot() *wrapperspb.UInt64Value {
-	if x != nil {
-		return x.Slot
-	}
-	return nil
-}
-
-func (x *CreateBeaconBadBlockRequest) GetEpoch() *wrapperspb.UInt64Value {
-	if x != nil {
-		return x.Epoch
-	}
-	return nil
-}
-
-func (x *CreateBeaconBadBlockRequest) GetBlockRoot() *wrapperspb.StringValue {
-	if x != nil {
-		return x.BlockRoot
-	}
-	return nil
-}
-
-func (x *CreateBeaconBadBlockRequest) GetNodeVersion() *wrapperspb.StringValue {
-	if x != nil {
-		return x.NodeVersion
-	}
-	return nil
-}
-
-func (x *CreateBeaconBadBlockRequest) GetLocation() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Location
-	}
-	return nil
-}
-
-func (x *CreateBeaconBadBlockRequest) GetNetwork() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Network
-	}
-	return nil
-}
-
-func (x *CreateBeaconBadBlockRequest) GetBeaconImplementation() *wrapperspb.StringValue {
-	if x != nil {
-		return x.BeaconImplementation
-	}
-	return nil
-}
-
-func (x *CreateBeaconBadBlockRequest) GetContentEncoding() *wrapperspb.StringValue {
-	if x != nil {
-		return x.ContentEncoding
-	}
-	return nil
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{16}
 }
 
-type CreateBeaconBadBlockResponse struct {
+type GetConfigResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *CreateBeaconBadBlockResponse) Reset() {
-	*x = CreateBeaconBadBlockResponse{}
+func (x *GetConfigResponse) Reset() {
+	*x = GetConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[21]
+		mi := &file_indexer_indexer_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateBeaconBadBlockResponse) String() string {
+func (x *GetConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBeaconBadBlockResponse) ProtoMessage() {}
+func (*GetConfigResponse) ProtoMessage() {}
 
-func (x *CreateBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[21]
+func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2185,53 +2388,44 @@ func (x *CreateBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBeaconBadBlockResponse.ProtoReflect.Descriptor instead.
-func (*CreateBeaconBadBlockResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreateBeaconBadBlockResponse) GetId() *wrapperspb.StringValue {
+func (x *GetConfigResponse) GetConfig() *Config {
 	if x != nil {
-		return x.Id
+		return x.Config
 	}
 	return nil
 }
 
-type CreateBeaconBadBlobRequest struct {
+type GetStorageHandshakeTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
-	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
-	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-	Index                *wrapperspb.UInt64Value `protobuf:"bytes,10,opt,name=index,proto3" json:"index,omitempty"`
-	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	Node  string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *CreateBeaconBadBlobRequest) Reset() {
-	*x = CreateBeaconBadBlobRequest{}
+func (x *GetStorageHandshakeTokenRequest) Reset() {
+	*x = GetStorageHandshakeTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[22]
+		mi := &file_indexer_indexer_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateBeaconBadBlobRequest) String() string {
+func (x *GetStorageHandshakeTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBeaconBadBlobRequest) ProtoMessage() {}
+func (*GetStorageHandshakeTokenRequest) ProtoMessage() {}
 
-func (x *CreateBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[22]
+func (x *GetStorageHandshakeTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2242,113 +2436,180 @@ func (x *CreateBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBeaconBadBlobRequest.ProtoReflect.Descriptor instead.
-func (*CreateBeaconBadBlobRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetStorageHandshakeTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageHandshakeTokenRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateBeaconBadBlobRequest) GetNode() *wrapperspb.StringValue {
+func (x *GetStorageHandshakeTokenRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateBeaconBadBlobRequest) GetFetchedAt() *timestamppb.Timestamp {
+func (x *GetStorageHandshakeTokenRequest) GetToken() string {
 	if x != nil {
-		return x.FetchedAt
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateBeaconBadBlobRequest) GetSlot() *wrapperspb.UInt64Value {
-	if x != nil {
-		return x.Slot
+type GetStorageHandshakeTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *GetStorageHandshakeTokenResponse) Reset() {
+	*x = GetStorageHandshakeTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CreateBeaconBadBlobRequest) GetEpoch() *wrapperspb.UInt64Value {
-	if x != nil {
-		return x.Epoch
+func (x *GetStorageHandshakeTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageHandshakeTokenResponse) ProtoMessage() {}
+
+func (x *GetStorageHandshakeTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateBeaconBadBlobRequest) GetBlockRoot() *wrapperspb.StringValue {
+// Deprecated: Use GetStorageHandshakeTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetStorageHandshakeTokenResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetStorageHandshakeTokenResponse) GetToken() string {
 	if x != nil {
-		return x.BlockRoot
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateBeaconBadBlobRequest) GetNodeVersion() *wrapperspb.StringValue {
-	if x != nil {
-		return x.NodeVersion
+// MarkOrphanedRequest lists the blocks a node's reorg removed from its
+// canonical chain. Only the node's own artifacts are marked.
+type MarkOrphanedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string   `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Network              string   `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	BlockRoots           []string `protobuf:"bytes,3,rep,name=block_roots,json=blockRoots,proto3" json:"block_roots,omitempty"`
+	StateRoots           []string `protobuf:"bytes,4,rep,name=state_roots,json=stateRoots,proto3" json:"state_roots,omitempty"`
+	ExecutionBlockHashes []string `protobuf:"bytes,5,rep,name=execution_block_hashes,json=executionBlockHashes,proto3" json:"execution_block_hashes,omitempty"`
+}
+
+func (x *MarkOrphanedRequest) Reset() {
+	*x = MarkOrphanedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CreateBeaconBadBlobRequest) GetLocation() *wrapperspb.StringValue {
+func (x *MarkOrphanedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkOrphanedRequest) ProtoMessage() {}
+
+func (x *MarkOrphanedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkOrphanedRequest.ProtoReflect.Descriptor instead.
+func (*MarkOrphanedRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MarkOrphanedRequest) GetNode() string {
 	if x != nil {
-		return x.Location
+		return x.Node
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateBeaconBadBlobRequest) GetNetwork() *wrapperspb.StringValue {
+func (x *MarkOrphanedRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateBeaconBadBlobRequest) GetBeaconImplementation() *wrapperspb.StringValue {
+func (x *MarkOrphanedRequest) GetBlockRoots() []string {
 	if x != nil {
-		return x.BeaconImplementation
+		return x.BlockRoots
 	}
 	return nil
 }
 
-func (x *CreateBeaconBadBlobRequest) GetIndex() *wrapperspb.UInt64Value {
+func (x *MarkOrphanedRequest) GetStateRoots() []string {
 	if x != nil {
-		return x.Index
+		return x.StateRoots
 	}
 	return nil
 }
 
-func (x *CreateBeaconBadBlobRequest) GetContentEncoding() *wrapperspb.StringValue {
+func (x *MarkOrphanedRequest) GetExecutionBlockHashes() []string {
 	if x != nil {
-		return x.ContentEncoding
+		return x.ExecutionBlockHashes
 	}
 	return nil
 }
 
-type CreateBeaconBadBlobResponse struct {
+type MarkOrphanedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BeaconBlocks         *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=beacon_blocks,json=beaconBlocks,proto3" json:"beacon_blocks,omitempty"`
+	BeaconStates         *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=beacon_states,json=beaconStates,proto3" json:"beacon_states,omitempty"`
+	ExecutionBlockTraces *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=execution_block_traces,json=executionBlockTraces,proto3" json:"execution_block_traces,omitempty"`
 }
 
-func (x *CreateBeaconBadBlobResponse) Reset() {
-	*x = CreateBeaconBadBlobResponse{}
+func (x *MarkOrphanedResponse) Reset() {
+	*x = MarkOrphanedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[23]
+		mi := &file_indexer_indexer_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateBeaconBadBlobResponse) String() string {
+func (x *MarkOrphanedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBeaconBadBlobResponse) ProtoMessage() {}
+func (*MarkOrphanedResponse) ProtoMessage() {}
 
-func (x *CreateBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[23]
+func (x *MarkOrphanedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2359,51 +2620,64 @@ func (x *CreateBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBeaconBadBlobResponse.ProtoReflect.Descriptor instead.
-func (*CreateBeaconBadBlobResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use MarkOrphanedResponse.ProtoReflect.Descriptor instead.
+func (*MarkOrphanedResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateBeaconBadBlobResponse) GetId() *wrapperspb.StringValue {
+func (x *MarkOrphanedResponse) GetBeaconBlocks() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.Id
+		return x.BeaconBlocks
 	}
 	return nil
 }
 
-type CreateExecutionBlockTraceRequest struct {
+func (x *MarkOrphanedResponse) GetBeaconStates() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.BeaconStates
+	}
+	return nil
+}
+
+func (x *MarkOrphanedResponse) GetExecutionBlockTraces() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.ExecutionBlockTraces
+	}
+	return nil
+}
+
+// ListIndexedKeysRequest asks for the keys of the artifacts a node indexed
+// since the given time, so an agent can skip work it has already done without
+// asking the indexer about every item.
+type ListIndexedKeysRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
-	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Location                *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
-	Network                 *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
-	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	ContentEncoding         *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	Node    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Network string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Since   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	// limit is the maximum number of keys returned per type.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *CreateExecutionBlockTraceRequest) Reset() {
-	*x = CreateExecutionBlockTraceRequest{}
+func (x *ListIndexedKeysRequest) Reset() {
+	*x = ListIndexedKeysRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[24]
+		mi := &file_indexer_indexer_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateExecutionBlockTraceRequest) String() string {
+func (x *ListIndexedKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExecutionBlockTraceRequest) ProtoMessage() {}
+func (*ListIndexedKeysRequest) ProtoMessage() {}
 
-func (x *CreateExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[24]
+func (x *ListIndexedKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2414,99 +2688,130 @@ func (x *CreateExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
-func (*CreateExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListIndexedKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListIndexedKeysRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateExecutionBlockTraceRequest) GetNode() *wrapperspb.StringValue {
+func (x *ListIndexedKeysRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateExecutionBlockTraceRequest) GetFetchedAt() *timestamppb.Timestamp {
+func (x *ListIndexedKeysRequest) GetNetwork() string {
 	if x != nil {
-		return x.FetchedAt
+		return x.Network
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateExecutionBlockTraceRequest) GetBlockHash() *wrapperspb.StringValue {
+func (x *ListIndexedKeysRequest) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BlockHash
+		return x.Since
 	}
 	return nil
 }
 
-func (x *CreateExecutionBlockTraceRequest) GetBlockNumber() *wrapperspb.Int64Value {
+func (x *ListIndexedKeysRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.BlockNumber
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateExecutionBlockTraceRequest) GetLocation() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Location
+// IndexedKey identifies an indexed artifact. type is the store data type,
+// root is the state root, block root or block hash and variant is the sidecar
+// type or tracer, if the artifact has one.
+type IndexedKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Root    string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Variant string `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+}
+
+func (x *IndexedKey) Reset() {
+	*x = IndexedKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CreateExecutionBlockTraceRequest) GetNetwork() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Network
+func (x *IndexedKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexedKey) ProtoMessage() {}
+
+func (x *IndexedKey) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateExecutionBlockTraceRequest) GetExecutionImplementation() *wrapperspb.StringValue {
+// Deprecated: Use IndexedKey.ProtoReflect.Descriptor instead.
+func (*IndexedKey) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *IndexedKey) GetType() string {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateExecutionBlockTraceRequest) GetNodeVersion() *wrapperspb.StringValue {
+func (x *IndexedKey) GetRoot() string {
 	if x != nil {
-		return x.NodeVersion
+		return x.Root
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateExecutionBlockTraceRequest) GetContentEncoding() *wrapperspb.StringValue {
+func (x *IndexedKey) GetVariant() string {
 	if x != nil {
-		return x.ContentEncoding
+		return x.Variant
 	}
-	return nil
+	return ""
 }
 
-type CreateExecutionBlockTraceResponse struct {
+type ListIndexedKeysResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Keys []*IndexedKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *CreateExecutionBlockTraceResponse) Reset() {
-	*x = CreateExecutionBlockTraceResponse{}
+func (x *ListIndexedKeysResponse) Reset() {
+	*x = ListIndexedKeysResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[25]
+		mi := &file_indexer_indexer_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateExecutionBlockTraceResponse) String() string {
+func (x *ListIndexedKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExecutionBlockTraceResponse) ProtoMessage() {}
+func (*ListIndexedKeysResponse) ProtoMessage() {}
 
-func (x *CreateExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[25]
+func (x *ListIndexedKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2517,53 +2822,50 @@ func (x *CreateExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExecutionBlockTraceResponse.ProtoReflect.Descriptor instead.
-func (*CreateExecutionBlockTraceResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ListIndexedKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListIndexedKeysResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateExecutionBlockTraceResponse) GetId() *wrapperspb.StringValue {
+func (x *ListIndexedKeysResponse) GetKeys() []*IndexedKey {
 	if x != nil {
-		return x.Id
+		return x.Keys
 	}
 	return nil
 }
 
-type ListExecutionBlockTraceRequest struct {
+// Upload requests are streamed by agents that don't have access to the store.
+// The first message of an upload is its metadata, which is indexed as if it
+// was created directly, and the rest are chunks of the data to save at the
+// metadata's location.
+type UploadBeaconStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	Location                string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
-	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
-	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
-	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
-	Pagination              *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Id                      string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
-	ExecutionImplementation string                 `protobuf:"bytes,10,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             string                 `protobuf:"bytes,11,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	// Types that are assignable to Payload:
+	//	*UploadBeaconStateRequest_Metadata
+	//	*UploadBeaconStateRequest_Chunk
+	Payload isUploadBeaconStateRequest_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *ListExecutionBlockTraceRequest) Reset() {
-	*x = ListExecutionBlockTraceRequest{}
+func (x *UploadBeaconStateRequest) Reset() {
+	*x = UploadBeaconStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[26]
+		mi := &file_indexer_indexer_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListExecutionBlockTraceRequest) String() string {
+func (x *UploadBeaconStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExecutionBlockTraceRequest) ProtoMessage() {}
+func (*UploadBeaconStateRequest) ProtoMessage() {}
 
-func (x *ListExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[26]
+func (x *UploadBeaconStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2574,98 +2876,141 @@ func (x *ListExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
-func (*ListExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use UploadBeaconStateRequest.ProtoReflect.Descriptor instead.
+func (*UploadBeaconStateRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListExecutionBlockTraceRequest) GetNode() string {
-	if x != nil {
-		return x.Node
+func (m *UploadBeaconStateRequest) GetPayload() isUploadBeaconStateRequest_Payload {
+	if m != nil {
+		return m.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *ListExecutionBlockTraceRequest) GetBlockNumber() int64 {
-	if x != nil {
-		return x.BlockNumber
+func (x *UploadBeaconStateRequest) GetMetadata() *CreateBeaconStateRequest {
+	if x, ok := x.GetPayload().(*UploadBeaconStateRequest_Metadata); ok {
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-func (x *ListExecutionBlockTraceRequest) GetBlockHash() string {
-	if x != nil {
-		return x.BlockHash
+func (x *UploadBeaconStateRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*UploadBeaconStateRequest_Chunk); ok {
+		return x.Chunk
 	}
-	return ""
+	return nil
 }
 
-func (x *ListExecutionBlockTraceRequest) GetLocation() string {
-	if x != nil {
-		return x.Location
+type isUploadBeaconStateRequest_Payload interface {
+	isUploadBeaconStateRequest_Payload()
+}
+
+type UploadBeaconStateRequest_Metadata struct {
+	Metadata *CreateBeaconStateRequest `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadBeaconStateRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadBeaconStateRequest_Metadata) isUploadBeaconStateRequest_Payload() {}
+
+func (*UploadBeaconStateRequest_Chunk) isUploadBeaconStateRequest_Payload() {}
+
+type UploadBeaconBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*UploadBeaconBlockRequest_Metadata
+	//	*UploadBeaconBlockRequest_Chunk
+	Payload isUploadBeaconBlockRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *UploadBeaconBlockRequest) Reset() {
+	*x = UploadBeaconBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ListExecutionBlockTraceRequest) GetNetwork() string {
-	if x != nil {
-		return x.Network
+func (x *UploadBeaconBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadBeaconBlockRequest) ProtoMessage() {}
+
+func (x *UploadBeaconBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListExecutionBlockTraceRequest) GetBefore() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Before
+// Deprecated: Use UploadBeaconBlockRequest.ProtoReflect.Descriptor instead.
+func (*UploadBeaconBlockRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{26}
+}
+
+func (m *UploadBeaconBlockRequest) GetPayload() isUploadBeaconBlockRequest_Payload {
+	if m != nil {
+		return m.Payload
 	}
 	return nil
 }
 
-func (x *ListExecutionBlockTraceRequest) GetAfter() *timestamppb.Timestamp {
-	if x != nil {
-		return x.After
+func (x *UploadBeaconBlockRequest) GetMetadata() *CreateBeaconBlockRequest {
+	if x, ok := x.GetPayload().(*UploadBeaconBlockRequest_Metadata); ok {
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *ListExecutionBlockTraceRequest) GetPagination() *PaginationCursor {
-	if x != nil {
-		return x.Pagination
+func (x *UploadBeaconBlockRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*UploadBeaconBlockRequest_Chunk); ok {
+		return x.Chunk
 	}
 	return nil
 }
 
-func (x *ListExecutionBlockTraceRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+type isUploadBeaconBlockRequest_Payload interface {
+	isUploadBeaconBlockRequest_Payload()
 }
 
-func (x *ListExecutionBlockTraceRequest) GetExecutionImplementation() string {
-	if x != nil {
-		return x.ExecutionImplementation
-	}
-	return ""
+type UploadBeaconBlockRequest_Metadata struct {
+	Metadata *CreateBeaconBlockRequest `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
 }
 
-func (x *ListExecutionBlockTraceRequest) GetNodeVersion() string {
-	if x != nil {
-		return x.NodeVersion
-	}
-	return ""
+type UploadBeaconBlockRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
 }
 
-type ListExecutionBlockTraceResponse struct {
+func (*UploadBeaconBlockRequest_Metadata) isUploadBeaconBlockRequest_Payload() {}
+
+func (*UploadBeaconBlockRequest_Chunk) isUploadBeaconBlockRequest_Payload() {}
+
+type UploadBlobSidecarRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExecutionBlockTraces []*ExecutionBlockTrace `protobuf:"bytes,1,rep,name=execution_block_traces,json=executionBlockTraces,proto3" json:"execution_block_traces,omitempty"`
+	// Types that are assignable to Payload:
+	//	*UploadBlobSidecarRequest_Metadata
+	//	*UploadBlobSidecarRequest_Chunk
+	Payload isUploadBlobSidecarRequest_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *ListExecutionBlockTraceResponse) Reset() {
-	*x = ListExecutionBlockTraceResponse{}
+func (x *UploadBlobSidecarRequest) Reset() {
+	*x = UploadBlobSidecarRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2673,13 +3018,13 @@ func (x *ListExecutionBlockTraceResponse) Reset() {
 	}
 }
 
-func (x *ListExecutionBlockTraceResponse) String() string {
+func (x *UploadBlobSidecarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExecutionBlockTraceResponse) ProtoMessage() {}
+func (*UploadBlobSidecarRequest) ProtoMessage() {}
 
-func (x *ListExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadBlobSidecarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2691,36 +3036,61 @@ func (x *ListExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExecutionBlockTraceResponse.ProtoReflect.Descriptor instead.
-func (*ListExecutionBlockTraceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadBlobSidecarRequest.ProtoReflect.Descriptor instead.
+func (*UploadBlobSidecarRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ListExecutionBlockTraceResponse) GetExecutionBlockTraces() []*ExecutionBlockTrace {
-	if x != nil {
-		return x.ExecutionBlockTraces
+func (m *UploadBlobSidecarRequest) GetPayload() isUploadBlobSidecarRequest_Payload {
+	if m != nil {
+		return m.Payload
 	}
 	return nil
 }
 
-type CountExecutionBlockTraceRequest struct {
+func (x *UploadBlobSidecarRequest) GetMetadata() *CreateBlobSidecarRequest {
+	if x, ok := x.GetPayload().(*UploadBlobSidecarRequest_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UploadBlobSidecarRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*UploadBlobSidecarRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isUploadBlobSidecarRequest_Payload interface {
+	isUploadBlobSidecarRequest_Payload()
+}
+
+type UploadBlobSidecarRequest_Metadata struct {
+	Metadata *CreateBlobSidecarRequest `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadBlobSidecarRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadBlobSidecarRequest_Metadata) isUploadBlobSidecarRequest_Payload() {}
+
+func (*UploadBlobSidecarRequest_Chunk) isUploadBlobSidecarRequest_Payload() {}
+
+type UploadForkChoiceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	Location                string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
-	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
-	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
-	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
-	ExecutionImplementation string                 `protobuf:"bytes,8,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             string                 `protobuf:"bytes,9,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	// Types that are assignable to Payload:
+	//	*UploadForkChoiceRequest_Metadata
+	//	*UploadForkChoiceRequest_Chunk
+	Payload isUploadForkChoiceRequest_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *CountExecutionBlockTraceRequest) Reset() {
-	*x = CountExecutionBlockTraceRequest{}
+func (x *UploadForkChoiceRequest) Reset() {
+	*x = UploadForkChoiceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2728,13 +3098,13 @@ func (x *CountExecutionBlockTraceRequest) Reset() {
 	}
 }
 
-func (x *CountExecutionBlockTraceRequest) String() string {
+func (x *UploadForkChoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountExecutionBlockTraceRequest) ProtoMessage() {}
+func (*UploadForkChoiceRequest) ProtoMessage() {}
 
-func (x *CountExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
+func (x *UploadForkChoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2746,84 +3116,61 @@ func (x *CountExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
-func (*CountExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadForkChoiceRequest.ProtoReflect.Descriptor instead.
+func (*UploadForkChoiceRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CountExecutionBlockTraceRequest) GetNode() string {
-	if x != nil {
-		return x.Node
-	}
-	return ""
-}
-
-func (x *CountExecutionBlockTraceRequest) GetBlockNumber() int64 {
-	if x != nil {
-		return x.BlockNumber
+func (m *UploadForkChoiceRequest) GetPayload() isUploadForkChoiceRequest_Payload {
+	if m != nil {
+		return m.Payload
 	}
-	return 0
+	return nil
 }
 
-func (x *CountExecutionBlockTraceRequest) GetBlockHash() string {
-	if x != nil {
-		return x.BlockHash
+func (x *UploadForkChoiceRequest) GetMetadata() *CreateForkChoiceRequest {
+	if x, ok := x.GetPayload().(*UploadForkChoiceRequest_Metadata); ok {
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-func (x *CountExecutionBlockTraceRequest) GetLocation() string {
-	if x != nil {
-		return x.Location
+func (x *UploadForkChoiceRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*UploadForkChoiceRequest_Chunk); ok {
+		return x.Chunk
 	}
-	return ""
+	return nil
 }
 
-func (x *CountExecutionBlockTraceRequest) GetNetwork() string {
-	if x != nil {
-		return x.Network
-	}
-	return ""
+type isUploadForkChoiceRequest_Payload interface {
+	isUploadForkChoiceRequest_Payload()
 }
 
-func (x *CountExecutionBlockTraceRequest) GetBefore() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Before
-	}
-	return nil
+type UploadForkChoiceRequest_Metadata struct {
+	Metadata *CreateForkChoiceRequest `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
 }
 
-func (x *CountExecutionBlockTraceRequest) GetAfter() *timestamppb.Timestamp {
-	if x != nil {
-		return x.After
-	}
-	return nil
+type UploadForkChoiceRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
 }
 
-func (x *CountExecutionBlockTraceRequest) GetExecutionImplementation() string {
-	if x != nil {
-		return x.ExecutionImplementation
-	}
-	return ""
-}
+func (*UploadForkChoiceRequest_Metadata) isUploadForkChoiceRequest_Payload() {}
 
-func (x *CountExecutionBlockTraceRequest) GetNodeVersion() string {
-	if x != nil {
-		return x.NodeVersion
-	}
-	return ""
-}
+func (*UploadForkChoiceRequest_Chunk) isUploadForkChoiceRequest_Payload() {}
 
-type CountExecutionBlockTraceResponse struct {
+type UploadBeaconBadBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Types that are assignable to Payload:
+	//	*UploadBeaconBadBlockRequest_Metadata
+	//	*UploadBeaconBadBlockRequest_Chunk
+	Payload isUploadBeaconBadBlockRequest_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *CountExecutionBlockTraceResponse) Reset() {
-	*x = CountExecutionBlockTraceResponse{}
+func (x *UploadBeaconBadBlockRequest) Reset() {
+	*x = UploadBeaconBadBlockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2831,13 +3178,13 @@ func (x *CountExecutionBlockTraceResponse) Reset() {
 	}
 }
 
-func (x *CountExecutionBlockTraceResponse) String() string {
+func (x *UploadBeaconBadBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountExecutionBlockTraceResponse) ProtoMessage() {}
+func (*UploadBeaconBadBlockRequest) ProtoMessage() {}
 
-func (x *CountExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2849,28 +3196,61 @@ func (x *CountExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountExecutionBlockTraceResponse.ProtoReflect.Descriptor instead.
-func (*CountExecutionBlockTraceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadBeaconBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*UploadBeaconBadBlockRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CountExecutionBlockTraceResponse) GetCount() *wrapperspb.UInt64Value {
-	if x != nil {
-		return x.Count
+func (m *UploadBeaconBadBlockRequest) GetPayload() isUploadBeaconBadBlockRequest_Payload {
+	if m != nil {
+		return m.Payload
 	}
 	return nil
 }
 
-type ListUniqueExecutionBlockTraceValuesRequest struct {
+func (x *UploadBeaconBadBlockRequest) GetMetadata() *CreateBeaconBadBlockRequest {
+	if x, ok := x.GetPayload().(*UploadBeaconBadBlockRequest_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UploadBeaconBadBlockRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*UploadBeaconBadBlockRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isUploadBeaconBadBlockRequest_Payload interface {
+	isUploadBeaconBadBlockRequest_Payload()
+}
+
+type UploadBeaconBadBlockRequest_Metadata struct {
+	Metadata *CreateBeaconBadBlockRequest `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadBeaconBadBlockRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadBeaconBadBlockRequest_Metadata) isUploadBeaconBadBlockRequest_Payload() {}
+
+func (*UploadBeaconBadBlockRequest_Chunk) isUploadBeaconBadBlockRequest_Payload() {}
+
+type UploadBeaconBadBlobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueExecutionBlockTraceValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueExecutionBlockTraceValuesRequest_Field" json:"fields,omitempty"`
+	// Types that are assignable to Payload:
+	//	*UploadBeaconBadBlobRequest_Metadata
+	//	*UploadBeaconBadBlobRequest_Chunk
+	Payload isUploadBeaconBadBlobRequest_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesRequest) Reset() {
-	*x = ListUniqueExecutionBlockTraceValuesRequest{}
+func (x *UploadBeaconBadBlobRequest) Reset() {
+	*x = UploadBeaconBadBlobRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2878,13 +3258,13 @@ func (x *ListUniqueExecutionBlockTraceValuesRequest) Reset() {
 	}
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesRequest) String() string {
+func (x *UploadBeaconBadBlobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueExecutionBlockTraceValuesRequest) ProtoMessage() {}
+func (*UploadBeaconBadBlobRequest) ProtoMessage() {}
 
-func (x *ListUniqueExecutionBlockTraceValuesRequest) ProtoReflect() protoreflect.Message {
+func (x *UploadBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2896,35 +3276,61 @@ func (x *ListUniqueExecutionBlockTraceValuesRequest) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueExecutionBlockTraceValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueExecutionBlockTraceValuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadBeaconBadBlobRequest.ProtoReflect.Descriptor instead.
+func (*UploadBeaconBadBlobRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesRequest) GetFields() []ListUniqueExecutionBlockTraceValuesRequest_Field {
-	if x != nil {
-		return x.Fields
+func (m *UploadBeaconBadBlobRequest) GetPayload() isUploadBeaconBadBlobRequest_Payload {
+	if m != nil {
+		return m.Payload
 	}
 	return nil
 }
 
-type ListUniqueExecutionBlockTraceValuesResponse struct {
+func (x *UploadBeaconBadBlobRequest) GetMetadata() *CreateBeaconBadBlobRequest {
+	if x, ok := x.GetPayload().(*UploadBeaconBadBlobRequest_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UploadBeaconBadBlobRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*UploadBeaconBadBlobRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isUploadBeaconBadBlobRequest_Payload interface {
+	isUploadBeaconBadBlobRequest_Payload()
+}
+
+type UploadBeaconBadBlobRequest_Metadata struct {
+	Metadata *CreateBeaconBadBlobRequest `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadBeaconBadBlobRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadBeaconBadBlobRequest_Metadata) isUploadBeaconBadBlobRequest_Payload() {}
+
+func (*UploadBeaconBadBlobRequest_Chunk) isUploadBeaconBadBlobRequest_Payload() {}
+
+type UploadExecutionBlockTraceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
-	BlockHash               []string `protobuf:"bytes,2,rep,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	BlockNumber             []int64  `protobuf:"varint,3,rep,packed,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Location                []string `protobuf:"bytes,4,rep,name=location,proto3" json:"location,omitempty"`
-	Network                 []string `protobuf:"bytes,5,rep,name=network,proto3" json:"network,omitempty"`
-	ExecutionImplementation []string `protobuf:"bytes,6,rep,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             []string `protobuf:"bytes,7,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	ClientVersion           []string `protobuf:"bytes,8,rep,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	// Types that are assignable to Payload:
+	//	*UploadExecutionBlockTraceRequest_Metadata
+	//	*UploadExecutionBlockTraceRequest_Chunk
+	Payload isUploadExecutionBlockTraceRequest_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) Reset() {
-	*x = ListUniqueExecutionBlockTraceValuesResponse{}
+func (x *UploadExecutionBlockTraceRequest) Reset() {
+	*x = UploadExecutionBlockTraceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2932,13 +3338,13 @@ func (x *ListUniqueExecutionBlockTraceValuesResponse) Reset() {
 	}
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) String() string {
+func (x *UploadExecutionBlockTraceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueExecutionBlockTraceValuesResponse) ProtoMessage() {}
+func (*UploadExecutionBlockTraceRequest) ProtoMessage() {}
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2950,77 +3356,61 @@ func (x *ListUniqueExecutionBlockTraceValuesResponse) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueExecutionBlockTraceValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueExecutionBlockTraceValuesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
+func (*UploadExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNode() []string {
-	if x != nil {
-		return x.Node
+func (m *UploadExecutionBlockTraceRequest) GetPayload() isUploadExecutionBlockTraceRequest_Payload {
+	if m != nil {
+		return m.Payload
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetBlockHash() []string {
-	if x != nil {
-		return x.BlockHash
+func (x *UploadExecutionBlockTraceRequest) GetMetadata() *CreateExecutionBlockTraceRequest {
+	if x, ok := x.GetPayload().(*UploadExecutionBlockTraceRequest_Metadata); ok {
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetBlockNumber() []int64 {
-	if x != nil {
-		return x.BlockNumber
+func (x *UploadExecutionBlockTraceRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*UploadExecutionBlockTraceRequest_Chunk); ok {
+		return x.Chunk
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetLocation() []string {
-	if x != nil {
-		return x.Location
-	}
-	return nil
+type isUploadExecutionBlockTraceRequest_Payload interface {
+	isUploadExecutionBlockTraceRequest_Payload()
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNetwork() []string {
-	if x != nil {
-		return x.Network
-	}
-	return nil
+type UploadExecutionBlockTraceRequest_Metadata struct {
+	Metadata *CreateExecutionBlockTraceRequest `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetExecutionImplementation() []string {
-	if x != nil {
-		return x.ExecutionImplementation
-	}
-	return nil
+type UploadExecutionBlockTraceRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
 }
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNodeVersion() []string {
-	if x != nil {
-		return x.NodeVersion
-	}
-	return nil
-}
+func (*UploadExecutionBlockTraceRequest_Metadata) isUploadExecutionBlockTraceRequest_Payload() {}
 
-func (x *ListUniqueExecutionBlockTraceValuesResponse) GetClientVersion() []string {
-	if x != nil {
-		return x.ClientVersion
-	}
-	return nil
-}
+func (*UploadExecutionBlockTraceRequest_Chunk) isUploadExecutionBlockTraceRequest_Payload() {}
 
-type ListUniqueBeaconStateValuesRequest struct {
+type UploadExecutionWitnessRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueBeaconStateValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueBeaconStateValuesRequest_Field" json:"fields,omitempty"`
+	// Types that are assignable to Payload:
+	//	*UploadExecutionWitnessRequest_Metadata
+	//	*UploadExecutionWitnessRequest_Chunk
+	Payload isUploadExecutionWitnessRequest_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *ListUniqueBeaconStateValuesRequest) Reset() {
-	*x = ListUniqueBeaconStateValuesRequest{}
+func (x *UploadExecutionWitnessRequest) Reset() {
+	*x = UploadExecutionWitnessRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3028,13 +3418,13 @@ func (x *ListUniqueBeaconStateValuesRequest) Reset() {
 	}
 }
 
-func (x *ListUniqueBeaconStateValuesRequest) String() string {
+func (x *UploadExecutionWitnessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconStateValuesRequest) ProtoMessage() {}
+func (*UploadExecutionWitnessRequest) ProtoMessage() {}
 
-func (x *ListUniqueBeaconStateValuesRequest) ProtoReflect() protoreflect.Message {
+func (x *UploadExecutionWitnessRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3046,35 +3436,65 @@ func (x *ListUniqueBeaconStateValuesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconStateValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconStateValuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadExecutionWitnessRequest.ProtoReflect.Descriptor instead.
+func (*UploadExecutionWitnessRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListUniqueBeaconStateValuesRequest) GetFields() []ListUniqueBeaconStateValuesRequest_Field {
-	if x != nil {
-		return x.Fields
+func (m *UploadExecutionWitnessRequest) GetPayload() isUploadExecutionWitnessRequest_Payload {
+	if m != nil {
+		return m.Payload
 	}
 	return nil
 }
 
-type ListUniqueBeaconStateValuesResponse struct {
+func (x *UploadExecutionWitnessRequest) GetMetadata() *CreateExecutionWitnessRequest {
+	if x, ok := x.GetPayload().(*UploadExecutionWitnessRequest_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UploadExecutionWitnessRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*UploadExecutionWitnessRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isUploadExecutionWitnessRequest_Payload interface {
+	isUploadExecutionWitnessRequest_Payload()
+}
+
+type UploadExecutionWitnessRequest_Metadata struct {
+	Metadata *CreateExecutionWitnessRequest `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadExecutionWitnessRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadExecutionWitnessRequest_Metadata) isUploadExecutionWitnessRequest_Payload() {}
+
+func (*UploadExecutionWitnessRequest_Chunk) isUploadExecutionWitnessRequest_Payload() {}
+
+// UploadExecutionBadBlockRequest can carry the bad block's trace and
+// intermediate roots after the block itself. A file message starts one of
+// them and names its location, which must match the metadata.
+type UploadExecutionBadBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
-	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
-	StateRoot            []string `protobuf:"bytes,4,rep,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
-	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             []string `protobuf:"bytes,6,rep,name=location,proto3" json:"location,omitempty"`
-	Network              []string `protobuf:"bytes,7,rep,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation []string `protobuf:"bytes,8,rep,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	// Types that are assignable to Payload:
+	//	*UploadExecutionBadBlockRequest_Metadata
+	//	*UploadExecutionBadBlockRequest_Chunk
+	//	*UploadExecutionBadBlockRequest_File
+	Payload isUploadExecutionBadBlockRequest_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) Reset() {
-	*x = ListUniqueBeaconStateValuesResponse{}
+func (x *UploadExecutionBadBlockRequest) Reset() {
+	*x = UploadExecutionBadBlockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3082,13 +3502,13 @@ func (x *ListUniqueBeaconStateValuesResponse) Reset() {
 	}
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) String() string {
+func (x *UploadExecutionBadBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconStateValuesResponse) ProtoMessage() {}
+func (*UploadExecutionBadBlockRequest) ProtoMessage() {}
 
-func (x *ListUniqueBeaconStateValuesResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3100,88 +3520,82 @@ func (x *ListUniqueBeaconStateValuesResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconStateValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconStateValuesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadExecutionBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*UploadExecutionBadBlockRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) GetNode() []string {
-	if x != nil {
-		return x.Node
+func (m *UploadExecutionBadBlockRequest) GetPayload() isUploadExecutionBadBlockRequest_Payload {
+	if m != nil {
+		return m.Payload
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) GetSlot() []uint64 {
-	if x != nil {
-		return x.Slot
+func (x *UploadExecutionBadBlockRequest) GetMetadata() *CreateExecutionBadBlockRequest {
+	if x, ok := x.GetPayload().(*UploadExecutionBadBlockRequest_Metadata); ok {
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) GetEpoch() []uint64 {
-	if x != nil {
-		return x.Epoch
+func (x *UploadExecutionBadBlockRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*UploadExecutionBadBlockRequest_Chunk); ok {
+		return x.Chunk
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) GetStateRoot() []string {
-	if x != nil {
-		return x.StateRoot
+func (x *UploadExecutionBadBlockRequest) GetFile() string {
+	if x, ok := x.GetPayload().(*UploadExecutionBadBlockRequest_File); ok {
+		return x.File
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) GetNodeVersion() []string {
-	if x != nil {
-		return x.NodeVersion
-	}
-	return nil
+type isUploadExecutionBadBlockRequest_Payload interface {
+	isUploadExecutionBadBlockRequest_Payload()
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) GetLocation() []string {
-	if x != nil {
-		return x.Location
-	}
-	return nil
+type UploadExecutionBadBlockRequest_Metadata struct {
+	Metadata *CreateExecutionBadBlockRequest `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) GetNetwork() []string {
-	if x != nil {
-		return x.Network
-	}
-	return nil
+type UploadExecutionBadBlockRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
 }
 
-func (x *ListUniqueBeaconStateValuesResponse) GetBeaconImplementation() []string {
-	if x != nil {
-		return x.BeaconImplementation
-	}
-	return nil
+type UploadExecutionBadBlockRequest_File struct {
+	File string `protobuf:"bytes,3,opt,name=file,proto3,oneof"`
 }
 
-type ListBeaconStateRequest struct {
+func (*UploadExecutionBadBlockRequest_Metadata) isUploadExecutionBadBlockRequest_Payload() {}
+
+func (*UploadExecutionBadBlockRequest_Chunk) isUploadExecutionBadBlockRequest_Payload() {}
+
+func (*UploadExecutionBadBlockRequest_File) isUploadExecutionBadBlockRequest_Payload() {}
+
+type CreateBeaconStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	StateRoot            string                 `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
-	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
-	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
-	Pagination           *PaginationCursor      `protobuf:"bytes,10,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
-	BeaconImplementation string                 `protobuf:"bytes,12,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Node                 *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	StateRoot            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	Finalized            *wrapperspb.BoolValue   `protobuf:"bytes,11,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	CheckpointEpoch      *wrapperspb.UInt64Value `protobuf:"bytes,12,opt,name=checkpoint_epoch,json=checkpointEpoch,proto3" json:"checkpoint_epoch,omitempty"`
 }
 
-func (x *ListBeaconStateRequest) Reset() {
-	*x = ListBeaconStateRequest{}
+func (x *CreateBeaconStateRequest) Reset() {
+	*x = CreateBeaconStateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3189,13 +3603,13 @@ func (x *ListBeaconStateRequest) Reset() {
 	}
 }
 
-func (x *ListBeaconStateRequest) String() string {
+func (x *CreateBeaconStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconStateRequest) ProtoMessage() {}
+func (*CreateBeaconStateRequest) ProtoMessage() {}
 
-func (x *ListBeaconStateRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateBeaconStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3207,105 +3621,105 @@ func (x *ListBeaconStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconStateRequest.ProtoReflect.Descriptor instead.
-func (*ListBeaconStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBeaconStateRequest.ProtoReflect.Descriptor instead.
+func (*CreateBeaconStateRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListBeaconStateRequest) GetNode() string {
+func (x *CreateBeaconStateRequest) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconStateRequest) GetSlot() uint64 {
+func (x *CreateBeaconStateRequest) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+func (x *CreateBeaconStateRequest) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Slot
 	}
-	return 0
+	return nil
 }
 
-func (x *ListBeaconStateRequest) GetEpoch() uint64 {
+func (x *CreateBeaconStateRequest) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Epoch
 	}
-	return 0
+	return nil
 }
 
-func (x *ListBeaconStateRequest) GetStateRoot() string {
+func (x *CreateBeaconStateRequest) GetStateRoot() *wrapperspb.StringValue {
 	if x != nil {
 		return x.StateRoot
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconStateRequest) GetNodeVersion() string {
+func (x *CreateBeaconStateRequest) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NodeVersion
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconStateRequest) GetLocation() string {
+func (x *CreateBeaconStateRequest) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconStateRequest) GetNetwork() string {
+func (x *CreateBeaconStateRequest) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Network
 	}
-	return ""
-}
-
-func (x *ListBeaconStateRequest) GetBefore() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Before
-	}
 	return nil
 }
 
-func (x *ListBeaconStateRequest) GetAfter() *timestamppb.Timestamp {
+func (x *CreateBeaconStateRequest) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.After
+		return x.BeaconImplementation
 	}
 	return nil
 }
 
-func (x *ListBeaconStateRequest) GetPagination() *PaginationCursor {
+func (x *CreateBeaconStateRequest) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Pagination
+		return x.ContentEncoding
 	}
 	return nil
 }
 
-func (x *ListBeaconStateRequest) GetId() string {
+func (x *CreateBeaconStateRequest) GetFinalized() *wrapperspb.BoolValue {
 	if x != nil {
-		return x.Id
+		return x.Finalized
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconStateRequest) GetBeaconImplementation() string {
+func (x *CreateBeaconStateRequest) GetCheckpointEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.BeaconImplementation
+		return x.CheckpointEpoch
 	}
-	return ""
+	return nil
 }
 
-type ListBeaconStateResponse struct {
+type CreateBeaconStateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BeaconStates []*BeaconState `protobuf:"bytes,1,rep,name=beacon_states,json=beaconStates,proto3" json:"beacon_states,omitempty"`
+	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListBeaconStateResponse) Reset() {
-	*x = ListBeaconStateResponse{}
+func (x *CreateBeaconStateResponse) Reset() {
+	*x = CreateBeaconStateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3313,13 +3727,13 @@ func (x *ListBeaconStateResponse) Reset() {
 	}
 }
 
-func (x *ListBeaconStateResponse) String() string {
+func (x *CreateBeaconStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconStateResponse) ProtoMessage() {}
+func (*CreateBeaconStateResponse) ProtoMessage() {}
 
-func (x *ListBeaconStateResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateBeaconStateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3331,38 +3745,37 @@ func (x *ListBeaconStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconStateResponse.ProtoReflect.Descriptor instead.
-func (*ListBeaconStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBeaconStateResponse.ProtoReflect.Descriptor instead.
+func (*CreateBeaconStateResponse) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListBeaconStateResponse) GetBeaconStates() []*BeaconState {
+func (x *CreateBeaconStateResponse) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BeaconStates
+		return x.Id
 	}
 	return nil
 }
 
-type CountBeaconStateRequest struct {
+type CreateBeaconBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	StateRoot            string                 `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
-	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
-	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
-	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Node                 *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
 }
 
-func (x *CountBeaconStateRequest) Reset() {
-	*x = CountBeaconStateRequest{}
+func (x *CreateBeaconBlockRequest) Reset() {
+	*x = CreateBeaconBlockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3370,13 +3783,13 @@ func (x *CountBeaconStateRequest) Reset() {
 	}
 }
 
-func (x *CountBeaconStateRequest) String() string {
+func (x *CreateBeaconBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconStateRequest) ProtoMessage() {}
+func (*CreateBeaconBlockRequest) ProtoMessage() {}
 
-func (x *CountBeaconStateRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateBeaconBlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3388,98 +3801,91 @@ func (x *CountBeaconStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconStateRequest.ProtoReflect.Descriptor instead.
-func (*CountBeaconStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBeaconBlockRequest.ProtoReflect.Descriptor instead.
+func (*CreateBeaconBlockRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CountBeaconStateRequest) GetNode() string {
+func (x *CreateBeaconBlockRequest) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
-	return ""
-}
-
-func (x *CountBeaconStateRequest) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
-	}
-	return 0
+	return nil
 }
 
-func (x *CountBeaconStateRequest) GetEpoch() uint64 {
+func (x *CreateBeaconBlockRequest) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Epoch
+		return x.FetchedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *CountBeaconStateRequest) GetStateRoot() string {
+func (x *CreateBeaconBlockRequest) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.StateRoot
+		return x.Slot
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconStateRequest) GetNodeVersion() string {
+func (x *CreateBeaconBlockRequest) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.NodeVersion
+		return x.Epoch
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconStateRequest) GetLocation() string {
+func (x *CreateBeaconBlockRequest) GetBlockRoot() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Location
+		return x.BlockRoot
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconStateRequest) GetNetwork() string {
+func (x *CreateBeaconBlockRequest) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Network
+		return x.NodeVersion
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconStateRequest) GetBefore() *timestamppb.Timestamp {
+func (x *CreateBeaconBlockRequest) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Before
+		return x.Location
 	}
 	return nil
 }
 
-func (x *CountBeaconStateRequest) GetAfter() *timestamppb.Timestamp {
+func (x *CreateBeaconBlockRequest) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
-		return x.After
+		return x.Network
 	}
 	return nil
 }
 
-func (x *CountBeaconStateRequest) GetBeaconImplementation() string {
+func (x *CreateBeaconBlockRequest) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.BeaconImplementation
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconStateRequest) GetId() string {
+func (x *CreateBeaconBlockRequest) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Id
+		return x.ContentEncoding
 	}
-	return ""
+	return nil
 }
 
-type CountBeaconStateResponse struct {
+type CreateBeaconBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CountBeaconStateResponse) Reset() {
-	*x = CountBeaconStateResponse{}
+func (x *CreateBeaconBlockResponse) Reset() {
+	*x = CreateBeaconBlockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3487,13 +3893,13 @@ func (x *CountBeaconStateResponse) Reset() {
 	}
 }
 
-func (x *CountBeaconStateResponse) String() string {
+func (x *CreateBeaconBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconStateResponse) ProtoMessage() {}
+func (*CreateBeaconBlockResponse) ProtoMessage() {}
 
-func (x *CountBeaconStateResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateBeaconBlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3505,28 +3911,38 @@ func (x *CountBeaconStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconStateResponse.ProtoReflect.Descriptor instead.
-func (*CountBeaconStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBeaconBlockResponse.ProtoReflect.Descriptor instead.
+func (*CreateBeaconBlockResponse) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CountBeaconStateResponse) GetCount() *wrapperspb.UInt64Value {
+func (x *CreateBeaconBlockResponse) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Count
+		return x.Id
 	}
 	return nil
 }
 
-type ListUniqueBeaconBlockValuesRequest struct {
+type CreateBlobSidecarRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueBeaconBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueBeaconBlockValuesRequest_Field" json:"fields,omitempty"`
+	Node                 *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	SidecarType          *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=sidecar_type,json=sidecarType,proto3" json:"sidecar_type,omitempty"`
 }
 
-func (x *ListUniqueBeaconBlockValuesRequest) Reset() {
-	*x = ListUniqueBeaconBlockValuesRequest{}
+func (x *CreateBlobSidecarRequest) Reset() {
+	*x = CreateBlobSidecarRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3534,13 +3950,13 @@ func (x *ListUniqueBeaconBlockValuesRequest) Reset() {
 	}
 }
 
-func (x *ListUniqueBeaconBlockValuesRequest) String() string {
+func (x *CreateBlobSidecarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconBlockValuesRequest) ProtoMessage() {}
+func (*CreateBlobSidecarRequest) ProtoMessage() {}
 
-func (x *ListUniqueBeaconBlockValuesRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateBlobSidecarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3552,142 +3968,154 @@ func (x *ListUniqueBeaconBlockValuesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconBlockValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBlockValuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBlobSidecarRequest.ProtoReflect.Descriptor instead.
+func (*CreateBlobSidecarRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListUniqueBeaconBlockValuesRequest) GetFields() []ListUniqueBeaconBlockValuesRequest_Field {
+func (x *CreateBlobSidecarRequest) GetNode() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Fields
+		return x.Node
 	}
 	return nil
 }
 
-type ListUniqueBeaconBlockValuesResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
-	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             []string `protobuf:"bytes,6,rep,name=location,proto3" json:"location,omitempty"`
-	Network              []string `protobuf:"bytes,7,rep,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation []string `protobuf:"bytes,8,rep,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-}
-
-func (x *ListUniqueBeaconBlockValuesResponse) Reset() {
-	*x = ListUniqueBeaconBlockValuesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[39]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateBlobSidecarRequest) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
 	}
+	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListUniqueBeaconBlockValuesResponse) ProtoMessage() {}
-
-func (x *ListUniqueBeaconBlockValuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[39]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateBlobSidecarRequest) GetSlot() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Slot
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListUniqueBeaconBlockValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBlockValuesResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{39}
+func (x *CreateBlobSidecarRequest) GetEpoch() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetNode() []string {
+func (x *CreateBlobSidecarRequest) GetBlockRoot() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Node
+		return x.BlockRoot
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetSlot() []uint64 {
+func (x *CreateBlobSidecarRequest) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Slot
+		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetEpoch() []uint64 {
+func (x *CreateBlobSidecarRequest) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Epoch
+		return x.Location
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetBlockRoot() []string {
+func (x *CreateBlobSidecarRequest) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BlockRoot
+		return x.Network
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetNodeVersion() []string {
+func (x *CreateBlobSidecarRequest) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.NodeVersion
+		return x.BeaconImplementation
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetLocation() []string {
+func (x *CreateBlobSidecarRequest) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Location
+		return x.ContentEncoding
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetNetwork() []string {
+func (x *CreateBlobSidecarRequest) GetSidecarType() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Network
+		return x.SidecarType
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBlockValuesResponse) GetBeaconImplementation() []string {
+type CreateBlobSidecarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateBlobSidecarResponse) Reset() {
+	*x = CreateBlobSidecarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBlobSidecarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlobSidecarResponse) ProtoMessage() {}
+
+func (x *CreateBlobSidecarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlobSidecarResponse.ProtoReflect.Descriptor instead.
+func (*CreateBlobSidecarResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateBlobSidecarResponse) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BeaconImplementation
+		return x.Id
 	}
 	return nil
 }
 
-type ListBeaconBlockRequest struct {
+type CreateForkChoiceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
-	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
-	Pagination           *PaginationCursor      `protobuf:"bytes,10,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
-	BeaconImplementation string                 `protobuf:"bytes,12,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Node                 *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	Reason               *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ListBeaconBlockRequest) Reset() {
-	*x = ListBeaconBlockRequest{}
+func (x *CreateForkChoiceRequest) Reset() {
+	*x = CreateForkChoiceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3695,13 +4123,13 @@ func (x *ListBeaconBlockRequest) Reset() {
 	}
 }
 
-func (x *ListBeaconBlockRequest) String() string {
+func (x *CreateForkChoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBlockRequest) ProtoMessage() {}
+func (*CreateForkChoiceRequest) ProtoMessage() {}
 
-func (x *ListBeaconBlockRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateForkChoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3713,105 +4141,91 @@ func (x *ListBeaconBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBlockRequest.ProtoReflect.Descriptor instead.
-func (*ListBeaconBlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateForkChoiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateForkChoiceRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ListBeaconBlockRequest) GetNode() string {
+func (x *CreateForkChoiceRequest) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconBlockRequest) GetSlot() uint64 {
+func (x *CreateForkChoiceRequest) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+func (x *CreateForkChoiceRequest) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Slot
 	}
-	return 0
+	return nil
 }
 
-func (x *ListBeaconBlockRequest) GetEpoch() uint64 {
+func (x *CreateForkChoiceRequest) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Epoch
 	}
-	return 0
+	return nil
 }
 
-func (x *ListBeaconBlockRequest) GetBlockRoot() string {
-	if x != nil {
-		return x.BlockRoot
-	}
-	return ""
-}
-
-func (x *ListBeaconBlockRequest) GetNodeVersion() string {
+func (x *CreateForkChoiceRequest) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NodeVersion
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconBlockRequest) GetLocation() string {
+func (x *CreateForkChoiceRequest) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconBlockRequest) GetNetwork() string {
+func (x *CreateForkChoiceRequest) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Network
 	}
-	return ""
-}
-
-func (x *ListBeaconBlockRequest) GetBefore() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Before
-	}
 	return nil
 }
 
-func (x *ListBeaconBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *CreateForkChoiceRequest) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.After
+		return x.BeaconImplementation
 	}
 	return nil
 }
 
-func (x *ListBeaconBlockRequest) GetPagination() *PaginationCursor {
+func (x *CreateForkChoiceRequest) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Pagination
+		return x.ContentEncoding
 	}
 	return nil
 }
 
-func (x *ListBeaconBlockRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ListBeaconBlockRequest) GetBeaconImplementation() string {
+func (x *CreateForkChoiceRequest) GetReason() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BeaconImplementation
+		return x.Reason
 	}
-	return ""
+	return nil
 }
 
-type ListBeaconBlockResponse struct {
+type CreateForkChoiceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BeaconBlocks []*BeaconBlock `protobuf:"bytes,1,rep,name=beacon_blocks,json=beaconBlocks,proto3" json:"beacon_blocks,omitempty"`
+	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListBeaconBlockResponse) Reset() {
-	*x = ListBeaconBlockResponse{}
+func (x *CreateForkChoiceResponse) Reset() {
+	*x = CreateForkChoiceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3819,13 +4233,13 @@ func (x *ListBeaconBlockResponse) Reset() {
 	}
 }
 
-func (x *ListBeaconBlockResponse) String() string {
+func (x *CreateForkChoiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBlockResponse) ProtoMessage() {}
+func (*CreateForkChoiceResponse) ProtoMessage() {}
 
-func (x *ListBeaconBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateForkChoiceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3837,38 +4251,37 @@ func (x *ListBeaconBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBlockResponse.ProtoReflect.Descriptor instead.
-func (*ListBeaconBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateForkChoiceResponse.ProtoReflect.Descriptor instead.
+func (*CreateForkChoiceResponse) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ListBeaconBlockResponse) GetBeaconBlocks() []*BeaconBlock {
+func (x *CreateForkChoiceResponse) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BeaconBlocks
+		return x.Id
 	}
 	return nil
 }
 
-type CountBeaconBlockRequest struct {
+type CreateBeaconBadBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
-	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
-	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Node                 *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
 }
 
-func (x *CountBeaconBlockRequest) Reset() {
-	*x = CountBeaconBlockRequest{}
+func (x *CreateBeaconBadBlockRequest) Reset() {
+	*x = CreateBeaconBadBlockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3876,13 +4289,13 @@ func (x *CountBeaconBlockRequest) Reset() {
 	}
 }
 
-func (x *CountBeaconBlockRequest) String() string {
+func (x *CreateBeaconBadBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconBlockRequest) ProtoMessage() {}
+func (*CreateBeaconBadBlockRequest) ProtoMessage() {}
 
-func (x *CountBeaconBlockRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3894,98 +4307,91 @@ func (x *CountBeaconBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconBlockRequest.ProtoReflect.Descriptor instead.
-func (*CountBeaconBlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBeaconBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*CreateBeaconBadBlockRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CountBeaconBlockRequest) GetNode() string {
+func (x *CreateBeaconBadBlockRequest) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconBlockRequest) GetSlot() uint64 {
+func (x *CreateBeaconBadBlockRequest) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+func (x *CreateBeaconBadBlockRequest) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Slot
 	}
-	return 0
+	return nil
 }
 
-func (x *CountBeaconBlockRequest) GetEpoch() uint64 {
+func (x *CreateBeaconBadBlockRequest) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Epoch
 	}
-	return 0
+	return nil
 }
 
-func (x *CountBeaconBlockRequest) GetBlockRoot() string {
+func (x *CreateBeaconBadBlockRequest) GetBlockRoot() *wrapperspb.StringValue {
 	if x != nil {
 		return x.BlockRoot
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconBlockRequest) GetNodeVersion() string {
+func (x *CreateBeaconBadBlockRequest) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NodeVersion
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconBlockRequest) GetLocation() string {
+func (x *CreateBeaconBadBlockRequest) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Location
 	}
-	return ""
-}
-
-func (x *CountBeaconBlockRequest) GetNetwork() string {
-	if x != nil {
-		return x.Network
-	}
-	return ""
-}
-
-func (x *CountBeaconBlockRequest) GetBefore() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Before
-	}
 	return nil
 }
 
-func (x *CountBeaconBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *CreateBeaconBadBlockRequest) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
-		return x.After
+		return x.Network
 	}
 	return nil
 }
 
-func (x *CountBeaconBlockRequest) GetBeaconImplementation() string {
+func (x *CreateBeaconBadBlockRequest) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.BeaconImplementation
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconBlockRequest) GetId() string {
+func (x *CreateBeaconBadBlockRequest) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Id
+		return x.ContentEncoding
 	}
-	return ""
+	return nil
 }
 
-type CountBeaconBlockResponse struct {
+type CreateBeaconBadBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CountBeaconBlockResponse) Reset() {
-	*x = CountBeaconBlockResponse{}
+func (x *CreateBeaconBadBlockResponse) Reset() {
+	*x = CreateBeaconBadBlockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3993,13 +4399,13 @@ func (x *CountBeaconBlockResponse) Reset() {
 	}
 }
 
-func (x *CountBeaconBlockResponse) String() string {
+func (x *CreateBeaconBadBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconBlockResponse) ProtoMessage() {}
+func (*CreateBeaconBadBlockResponse) ProtoMessage() {}
 
-func (x *CountBeaconBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4011,28 +4417,38 @@ func (x *CountBeaconBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconBlockResponse.ProtoReflect.Descriptor instead.
-func (*CountBeaconBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBeaconBadBlockResponse.ProtoReflect.Descriptor instead.
+func (*CreateBeaconBadBlockResponse) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CountBeaconBlockResponse) GetCount() *wrapperspb.UInt64Value {
+func (x *CreateBeaconBadBlockResponse) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Count
+		return x.Id
 	}
 	return nil
 }
 
-type ListUniqueBeaconBadBlockValuesRequest struct {
+type CreateBeaconBadBlobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueBeaconBadBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueBeaconBadBlockValuesRequest_Field" json:"fields,omitempty"`
+	Node                 *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt            *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	Slot                 *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Network              *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Index                *wrapperspb.UInt64Value `protobuf:"bytes,10,opt,name=index,proto3" json:"index,omitempty"`
+	ContentEncoding      *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
 }
 
-func (x *ListUniqueBeaconBadBlockValuesRequest) Reset() {
-	*x = ListUniqueBeaconBadBlockValuesRequest{}
+func (x *CreateBeaconBadBlobRequest) Reset() {
+	*x = CreateBeaconBadBlobRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4040,13 +4456,13 @@ func (x *ListUniqueBeaconBadBlockValuesRequest) Reset() {
 	}
 }
 
-func (x *ListUniqueBeaconBadBlockValuesRequest) String() string {
+func (x *CreateBeaconBadBlobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconBadBlockValuesRequest) ProtoMessage() {}
+func (*CreateBeaconBadBlobRequest) ProtoMessage() {}
 
-func (x *ListUniqueBeaconBadBlockValuesRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4058,157 +4474,113 @@ func (x *ListUniqueBeaconBadBlockValuesRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconBadBlockValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBadBlockValuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBeaconBadBlobRequest.ProtoReflect.Descriptor instead.
+func (*CreateBeaconBadBlobRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ListUniqueBeaconBadBlockValuesRequest) GetFields() []ListUniqueBeaconBadBlockValuesRequest_Field {
+func (x *CreateBeaconBadBlobRequest) GetNode() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Fields
+		return x.Node
 	}
 	return nil
 }
 
-type ListUniqueBeaconBadBlockValuesResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
-	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             []string `protobuf:"bytes,6,rep,name=location,proto3" json:"location,omitempty"`
-	Network              []string `protobuf:"bytes,7,rep,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation []string `protobuf:"bytes,8,rep,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-}
-
-func (x *ListUniqueBeaconBadBlockValuesResponse) Reset() {
-	*x = ListUniqueBeaconBadBlockValuesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[45]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListUniqueBeaconBadBlockValuesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListUniqueBeaconBadBlockValuesResponse) ProtoMessage() {}
-
-func (x *ListUniqueBeaconBadBlockValuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[45]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListUniqueBeaconBadBlockValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBadBlockValuesResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetNode() []string {
+func (x *CreateBeaconBadBlobRequest) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Node
+		return x.FetchedAt
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetSlot() []uint64 {
+func (x *CreateBeaconBadBlobRequest) GetSlot() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Slot
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetEpoch() []uint64 {
+func (x *CreateBeaconBadBlobRequest) GetEpoch() *wrapperspb.UInt64Value {
 	if x != nil {
 		return x.Epoch
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetBlockRoot() []string {
+func (x *CreateBeaconBadBlobRequest) GetBlockRoot() *wrapperspb.StringValue {
 	if x != nil {
 		return x.BlockRoot
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetNodeVersion() []string {
+func (x *CreateBeaconBadBlobRequest) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetLocation() []string {
+func (x *CreateBeaconBadBlobRequest) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Location
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetNetwork() []string {
+func (x *CreateBeaconBadBlobRequest) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Network
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlockValuesResponse) GetBeaconImplementation() []string {
+func (x *CreateBeaconBadBlobRequest) GetBeaconImplementation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.BeaconImplementation
 	}
 	return nil
 }
 
-type ListBeaconBadBlockRequest struct {
+func (x *CreateBeaconBadBlobRequest) GetIndex() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *CreateBeaconBadBlobRequest) GetContentEncoding() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ContentEncoding
+	}
+	return nil
+}
+
+type CreateBeaconBadBlobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
-	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
-	Pagination           *PaginationCursor      `protobuf:"bytes,10,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
-	BeaconImplementation string                 `protobuf:"bytes,12,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListBeaconBadBlockRequest) Reset() {
-	*x = ListBeaconBadBlockRequest{}
+func (x *CreateBeaconBadBlobResponse) Reset() {
+	*x = CreateBeaconBadBlobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[46]
+		mi := &file_indexer_indexer_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBeaconBadBlockRequest) String() string {
+func (x *CreateBeaconBadBlobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBadBlockRequest) ProtoMessage() {}
+func (*CreateBeaconBadBlobResponse) ProtoMessage() {}
 
-func (x *ListBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[46]
+func (x *CreateBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4219,105 +4591,147 @@ func (x *ListBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBadBlockRequest.ProtoReflect.Descriptor instead.
-func (*ListBeaconBadBlockRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use CreateBeaconBadBlobResponse.ProtoReflect.Descriptor instead.
+func (*CreateBeaconBadBlobResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListBeaconBadBlockRequest) GetNode() string {
+func (x *CreateBeaconBadBlobResponse) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Node
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
+type CreateExecutionBlockTraceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Location                *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Network                 *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	ContentEncoding         *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	Tracer                  *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=tracer,proto3" json:"tracer,omitempty"`
+}
+
+func (x *CreateExecutionBlockTraceRequest) Reset() {
+	*x = CreateExecutionBlockTraceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ListBeaconBadBlockRequest) GetEpoch() uint64 {
+func (x *CreateExecutionBlockTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExecutionBlockTraceRequest) ProtoMessage() {}
+
+func (x *CreateExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
+func (*CreateExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateExecutionBlockTraceRequest) GetNode() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Epoch
+		return x.Node
 	}
-	return 0
+	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetBlockRoot() string {
+func (x *CreateExecutionBlockTraceRequest) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BlockRoot
+		return x.FetchedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetNodeVersion() string {
+func (x *CreateExecutionBlockTraceRequest) GetBlockHash() *wrapperspb.StringValue {
 	if x != nil {
-		return x.NodeVersion
+		return x.BlockHash
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetLocation() string {
+func (x *CreateExecutionBlockTraceRequest) GetBlockNumber() *wrapperspb.Int64Value {
 	if x != nil {
-		return x.Location
+		return x.BlockNumber
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetNetwork() string {
+func (x *CreateExecutionBlockTraceRequest) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Network
+		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+func (x *CreateExecutionBlockTraceRequest) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Before
+		return x.Network
 	}
 	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *CreateExecutionBlockTraceRequest) GetExecutionImplementation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.After
+		return x.ExecutionImplementation
 	}
 	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetPagination() *PaginationCursor {
+func (x *CreateExecutionBlockTraceRequest) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Pagination
+		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetId() string {
+func (x *CreateExecutionBlockTraceRequest) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Id
+		return x.ContentEncoding
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconBadBlockRequest) GetBeaconImplementation() string {
+func (x *CreateExecutionBlockTraceRequest) GetTracer() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BeaconImplementation
+		return x.Tracer
 	}
-	return ""
+	return nil
 }
 
-type ListBeaconBadBlockResponse struct {
+type CreateExecutionBlockTraceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BeaconBadBlocks []*BeaconBadBlock `protobuf:"bytes,1,rep,name=beacon_bad_blocks,json=beaconBadBlocks,proto3" json:"beacon_bad_blocks,omitempty"`
+	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListBeaconBadBlockResponse) Reset() {
-	*x = ListBeaconBadBlockResponse{}
+func (x *CreateExecutionBlockTraceResponse) Reset() {
+	*x = CreateExecutionBlockTraceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4325,13 +4739,13 @@ func (x *ListBeaconBadBlockResponse) Reset() {
 	}
 }
 
-func (x *ListBeaconBadBlockResponse) String() string {
+func (x *CreateExecutionBlockTraceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBadBlockResponse) ProtoMessage() {}
+func (*CreateExecutionBlockTraceResponse) ProtoMessage() {}
 
-func (x *ListBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4343,38 +4757,40 @@ func (x *ListBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBadBlockResponse.ProtoReflect.Descriptor instead.
-func (*ListBeaconBadBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateExecutionBlockTraceResponse.ProtoReflect.Descriptor instead.
+func (*CreateExecutionBlockTraceResponse) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListBeaconBadBlockResponse) GetBeaconBadBlocks() []*BeaconBadBlock {
+func (x *CreateExecutionBlockTraceResponse) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BeaconBadBlocks
+		return x.Id
 	}
 	return nil
 }
 
-type CountBeaconBadBlockRequest struct {
+type ListExecutionBlockTraceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
-	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
-	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Location                string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination              *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                      string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,10,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,11,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Tracer                  string                 `protobuf:"bytes,12,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	Canonical               *wrapperspb.BoolValue  `protobuf:"bytes,13,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
-func (x *CountBeaconBadBlockRequest) Reset() {
-	*x = CountBeaconBadBlockRequest{}
+func (x *ListExecutionBlockTraceRequest) Reset() {
+	*x = ListExecutionBlockTraceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4382,13 +4798,13 @@ func (x *CountBeaconBadBlockRequest) Reset() {
 	}
 }
 
-func (x *CountBeaconBadBlockRequest) String() string {
+func (x *ListExecutionBlockTraceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconBadBlockRequest) ProtoMessage() {}
+func (*ListExecutionBlockTraceRequest) ProtoMessage() {}
 
-func (x *CountBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
+func (x *ListExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4400,160 +4816,127 @@ func (x *CountBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconBadBlockRequest.ProtoReflect.Descriptor instead.
-func (*CountBeaconBadBlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CountBeaconBadBlockRequest) GetNode() string {
+func (x *ListExecutionBlockTraceRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlockRequest) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
-	}
-	return 0
-}
-
-func (x *CountBeaconBadBlockRequest) GetEpoch() uint64 {
+func (x *ListExecutionBlockTraceRequest) GetBlockNumber() int64 {
 	if x != nil {
-		return x.Epoch
+		return x.BlockNumber
 	}
 	return 0
 }
 
-func (x *CountBeaconBadBlockRequest) GetBlockRoot() string {
-	if x != nil {
-		return x.BlockRoot
-	}
-	return ""
-}
-
-func (x *CountBeaconBadBlockRequest) GetNodeVersion() string {
+func (x *ListExecutionBlockTraceRequest) GetBlockHash() string {
 	if x != nil {
-		return x.NodeVersion
+		return x.BlockHash
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlockRequest) GetLocation() string {
+func (x *ListExecutionBlockTraceRequest) GetLocation() string {
 	if x != nil {
 		return x.Location
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlockRequest) GetNetwork() string {
+func (x *ListExecutionBlockTraceRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
 	return ""
 }
 
-func (x *CountBeaconBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+func (x *ListExecutionBlockTraceRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-func (x *CountBeaconBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *ListExecutionBlockTraceRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
 		return x.After
 	}
 	return nil
 }
 
-func (x *CountBeaconBadBlockRequest) GetBeaconImplementation() string {
+func (x *ListExecutionBlockTraceRequest) GetPagination() *PaginationCursor {
 	if x != nil {
-		return x.BeaconImplementation
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconBadBlockRequest) GetId() string {
+func (x *ListExecutionBlockTraceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type CountBeaconBadBlockResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
-}
-
-func (x *CountBeaconBadBlockResponse) Reset() {
-	*x = CountBeaconBadBlockResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[49]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListExecutionBlockTraceRequest) GetExecutionImplementation() string {
+	if x != nil {
+		return x.ExecutionImplementation
 	}
+	return ""
 }
 
-func (x *CountBeaconBadBlockResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CountBeaconBadBlockResponse) ProtoMessage() {}
-
-func (x *CountBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[49]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListExecutionBlockTraceRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CountBeaconBadBlockResponse.ProtoReflect.Descriptor instead.
-func (*CountBeaconBadBlockResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{49}
+func (x *ListExecutionBlockTraceRequest) GetTracer() string {
+	if x != nil {
+		return x.Tracer
+	}
+	return ""
 }
 
-func (x *CountBeaconBadBlockResponse) GetCount() *wrapperspb.UInt64Value {
+func (x *ListExecutionBlockTraceRequest) GetCanonical() *wrapperspb.BoolValue {
 	if x != nil {
-		return x.Count
+		return x.Canonical
 	}
 	return nil
 }
 
-type ListUniqueBeaconBadBlobValuesRequest struct {
+type ListExecutionBlockTraceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueBeaconBadBlobValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueBeaconBadBlobValuesRequest_Field" json:"fields,omitempty"`
+	ExecutionBlockTraces []*ExecutionBlockTrace `protobuf:"bytes,1,rep,name=execution_block_traces,json=executionBlockTraces,proto3" json:"execution_block_traces,omitempty"`
 }
 
-func (x *ListUniqueBeaconBadBlobValuesRequest) Reset() {
-	*x = ListUniqueBeaconBadBlobValuesRequest{}
+func (x *ListExecutionBlockTraceResponse) Reset() {
+	*x = ListExecutionBlockTraceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[50]
+		mi := &file_indexer_indexer_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUniqueBeaconBadBlobValuesRequest) String() string {
+func (x *ListExecutionBlockTraceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconBadBlobValuesRequest) ProtoMessage() {}
+func (*ListExecutionBlockTraceResponse) ProtoMessage() {}
 
-func (x *ListUniqueBeaconBadBlobValuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[50]
+func (x *ListExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4564,51 +4947,53 @@ func (x *ListUniqueBeaconBadBlobValuesRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconBadBlobValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBadBlobValuesRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ListExecutionBlockTraceResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionBlockTraceResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListUniqueBeaconBadBlobValuesRequest) GetFields() []ListUniqueBeaconBadBlobValuesRequest_Field {
+func (x *ListExecutionBlockTraceResponse) GetExecutionBlockTraces() []*ExecutionBlockTrace {
 	if x != nil {
-		return x.Fields
+		return x.ExecutionBlockTraces
 	}
 	return nil
 }
 
-type ListUniqueBeaconBadBlobValuesResponse struct {
+type CountExecutionBlockTraceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
-	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             []string `protobuf:"bytes,6,rep,name=location,proto3" json:"location,omitempty"`
-	Network              []string `protobuf:"bytes,7,rep,name=network,proto3" json:"network,omitempty"`
-	BeaconImplementation []string `protobuf:"bytes,8,rep,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-	Index                []uint64 `protobuf:"varint,9,rep,packed,name=index,proto3" json:"index,omitempty"`
+	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Location                string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,8,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,9,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Tracer                  string                 `protobuf:"bytes,10,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	Canonical               *wrapperspb.BoolValue  `protobuf:"bytes,11,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) Reset() {
-	*x = ListUniqueBeaconBadBlobValuesResponse{}
+func (x *CountExecutionBlockTraceRequest) Reset() {
+	*x = CountExecutionBlockTraceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[51]
+		mi := &file_indexer_indexer_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) String() string {
+func (x *CountExecutionBlockTraceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueBeaconBadBlobValuesResponse) ProtoMessage() {}
+func (*CountExecutionBlockTraceRequest) ProtoMessage() {}
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[51]
+func (x *CountExecutionBlockTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4619,111 +5004,113 @@ func (x *ListUniqueBeaconBadBlobValuesResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueBeaconBadBlobValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueBeaconBadBlobValuesResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use CountExecutionBlockTraceRequest.ProtoReflect.Descriptor instead.
+func (*CountExecutionBlockTraceRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetNode() []string {
+func (x *CountExecutionBlockTraceRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetSlot() []uint64 {
+func (x *CountExecutionBlockTraceRequest) GetBlockNumber() int64 {
 	if x != nil {
-		return x.Slot
+		return x.BlockNumber
 	}
-	return nil
+	return 0
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetEpoch() []uint64 {
+func (x *CountExecutionBlockTraceRequest) GetBlockHash() string {
 	if x != nil {
-		return x.Epoch
+		return x.BlockHash
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetBlockRoot() []string {
+func (x *CountExecutionBlockTraceRequest) GetLocation() string {
 	if x != nil {
-		return x.BlockRoot
+		return x.Location
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetNodeVersion() []string {
+func (x *CountExecutionBlockTraceRequest) GetNetwork() string {
 	if x != nil {
-		return x.NodeVersion
+		return x.Network
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetLocation() []string {
+func (x *CountExecutionBlockTraceRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Location
+		return x.Before
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetNetwork() []string {
+func (x *CountExecutionBlockTraceRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Network
+		return x.After
 	}
 	return nil
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetBeaconImplementation() []string {
+func (x *CountExecutionBlockTraceRequest) GetExecutionImplementation() string {
 	if x != nil {
-		return x.BeaconImplementation
+		return x.ExecutionImplementation
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueBeaconBadBlobValuesResponse) GetIndex() []uint64 {
+func (x *CountExecutionBlockTraceRequest) GetNodeVersion() string {
 	if x != nil {
-		return x.Index
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *CountExecutionBlockTraceRequest) GetTracer() string {
+	if x != nil {
+		return x.Tracer
+	}
+	return ""
+}
+
+func (x *CountExecutionBlockTraceRequest) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
 	}
 	return nil
 }
 
-type ListBeaconBadBlobRequest struct {
+type CountExecutionBlockTraceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                  `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            string                  `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          string                  `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             string                  `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Network              string                  `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
-	Before               *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
-	Pagination           *PaginationCursor       `protobuf:"bytes,10,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Id                   string                  `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
-	BeaconImplementation string                  `protobuf:"bytes,12,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-	Index                *wrapperspb.UInt64Value `protobuf:"bytes,13,opt,name=index,proto3" json:"index,omitempty"`
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *ListBeaconBadBlobRequest) Reset() {
-	*x = ListBeaconBadBlobRequest{}
+func (x *CountExecutionBlockTraceResponse) Reset() {
+	*x = CountExecutionBlockTraceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[52]
+		mi := &file_indexer_indexer_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBeaconBadBlobRequest) String() string {
+func (x *CountExecutionBlockTraceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBadBlobRequest) ProtoMessage() {}
+func (*CountExecutionBlockTraceResponse) ProtoMessage() {}
 
-func (x *ListBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[52]
+func (x *CountExecutionBlockTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4734,112 +5121,83 @@ func (x *ListBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBadBlobRequest.ProtoReflect.Descriptor instead.
-func (*ListBeaconBadBlobRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use CountExecutionBlockTraceResponse.ProtoReflect.Descriptor instead.
+func (*CountExecutionBlockTraceResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ListBeaconBadBlobRequest) GetNode() string {
+func (x *CountExecutionBlockTraceResponse) GetCount() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.Node
+		return x.Count
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBeaconBadBlobRequest) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
-	}
-	return 0
-}
+type ListUniqueExecutionBlockTraceValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ListBeaconBadBlobRequest) GetEpoch() uint64 {
-	if x != nil {
-		return x.Epoch
-	}
-	return 0
+	Fields []ListUniqueExecutionBlockTraceValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueExecutionBlockTraceValuesRequest_Field" json:"fields,omitempty"`
 }
 
-func (x *ListBeaconBadBlobRequest) GetBlockRoot() string {
-	if x != nil {
-		return x.BlockRoot
+func (x *ListUniqueExecutionBlockTraceValuesRequest) Reset() {
+	*x = ListUniqueExecutionBlockTraceValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ListBeaconBadBlobRequest) GetNodeVersion() string {
-	if x != nil {
-		return x.NodeVersion
-	}
-	return ""
+func (x *ListUniqueExecutionBlockTraceValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListBeaconBadBlobRequest) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
-}
-
-func (x *ListBeaconBadBlobRequest) GetNetwork() string {
-	if x != nil {
-		return x.Network
-	}
-	return ""
-}
-
-func (x *ListBeaconBadBlobRequest) GetBefore() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Before
-	}
-	return nil
-}
-
-func (x *ListBeaconBadBlobRequest) GetAfter() *timestamppb.Timestamp {
-	if x != nil {
-		return x.After
-	}
-	return nil
-}
-
-func (x *ListBeaconBadBlobRequest) GetPagination() *PaginationCursor {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
+func (*ListUniqueExecutionBlockTraceValuesRequest) ProtoMessage() {}
 
-func (x *ListBeaconBadBlobRequest) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *ListUniqueExecutionBlockTraceValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListBeaconBadBlobRequest) GetBeaconImplementation() string {
-	if x != nil {
-		return x.BeaconImplementation
-	}
-	return ""
+// Deprecated: Use ListUniqueExecutionBlockTraceValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionBlockTraceValuesRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListBeaconBadBlobRequest) GetIndex() *wrapperspb.UInt64Value {
+func (x *ListUniqueExecutionBlockTraceValuesRequest) GetFields() []ListUniqueExecutionBlockTraceValuesRequest_Field {
 	if x != nil {
-		return x.Index
+		return x.Fields
 	}
 	return nil
 }
 
-type ListBeaconBadBlobResponse struct {
+type ListUniqueExecutionBlockTraceValuesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BeaconBadBlobs []*BeaconBadBlob `protobuf:"bytes,1,rep,name=beacon_bad_blobs,json=beaconBadBlobs,proto3" json:"beacon_bad_blobs,omitempty"`
+	Node                    []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	BlockHash               []string `protobuf:"bytes,2,rep,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             []int64  `protobuf:"varint,3,rep,packed,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Location                []string `protobuf:"bytes,4,rep,name=location,proto3" json:"location,omitempty"`
+	Network                 []string `protobuf:"bytes,5,rep,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation []string `protobuf:"bytes,6,rep,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             []string `protobuf:"bytes,7,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	ClientVersion           []string `protobuf:"bytes,8,rep,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	Tracer                  []string `protobuf:"bytes,9,rep,name=tracer,proto3" json:"tracer,omitempty"`
 }
 
-func (x *ListBeaconBadBlobResponse) Reset() {
-	*x = ListBeaconBadBlobResponse{}
+func (x *ListUniqueExecutionBlockTraceValuesResponse) Reset() {
+	*x = ListUniqueExecutionBlockTraceValuesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4847,13 +5205,13 @@ func (x *ListBeaconBadBlobResponse) Reset() {
 	}
 }
 
-func (x *ListBeaconBadBlobResponse) String() string {
+func (x *ListUniqueExecutionBlockTraceValuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBeaconBadBlobResponse) ProtoMessage() {}
+func (*ListUniqueExecutionBlockTraceValuesResponse) ProtoMessage() {}
 
-func (x *ListBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
+func (x *ListUniqueExecutionBlockTraceValuesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4865,39 +5223,92 @@ func (x *ListBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBeaconBadBlobResponse.ProtoReflect.Descriptor instead.
-func (*ListBeaconBadBlobResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUniqueExecutionBlockTraceValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionBlockTraceValuesResponse) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ListBeaconBadBlobResponse) GetBeaconBadBlobs() []*BeaconBadBlob {
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNode() []string {
 	if x != nil {
-		return x.BeaconBadBlobs
+		return x.Node
 	}
 	return nil
 }
 
-type CountBeaconBadBlobRequest struct {
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetBlockHash() []string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetBlockNumber() []int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetLocation() []string {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetExecutionImplementation() []string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetClientVersion() []string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBlockTraceValuesResponse) GetTracer() []string {
+	if x != nil {
+		return x.Tracer
+	}
+	return nil
+}
+
+type CreateExecutionWitnessRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                 string                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Slot                 uint64                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	Epoch                uint64                  `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	BlockRoot            string                  `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	NodeVersion          string                  `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	Location             string                  `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Network              string                  `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
-	Before               *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
-	After                *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
-	BeaconImplementation string                  `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
-	Id                   string                  `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
-	Index                *wrapperspb.UInt64Value `protobuf:"bytes,12,opt,name=index,proto3" json:"index,omitempty"`
+	Node                    *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Location                *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Network                 *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	ContentEncoding         *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
 }
 
-func (x *CountBeaconBadBlobRequest) Reset() {
-	*x = CountBeaconBadBlobRequest{}
+func (x *CreateExecutionWitnessRequest) Reset() {
+	*x = CreateExecutionWitnessRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4905,13 +5316,13 @@ func (x *CountBeaconBadBlobRequest) Reset() {
 	}
 }
 
-func (x *CountBeaconBadBlobRequest) String() string {
+func (x *CreateExecutionWitnessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconBadBlobRequest) ProtoMessage() {}
+func (*CreateExecutionWitnessRequest) ProtoMessage() {}
 
-func (x *CountBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateExecutionWitnessRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4923,105 +5334,84 @@ func (x *CountBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconBadBlobRequest.ProtoReflect.Descriptor instead.
-func (*CountBeaconBadBlobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateExecutionWitnessRequest.ProtoReflect.Descriptor instead.
+func (*CreateExecutionWitnessRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CountBeaconBadBlobRequest) GetNode() string {
+func (x *CreateExecutionWitnessRequest) GetNode() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Node
 	}
-	return ""
-}
-
-func (x *CountBeaconBadBlobRequest) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
-	}
-	return 0
+	return nil
 }
 
-func (x *CountBeaconBadBlobRequest) GetEpoch() uint64 {
+func (x *CreateExecutionWitnessRequest) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Epoch
+		return x.FetchedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *CountBeaconBadBlobRequest) GetBlockRoot() string {
+func (x *CreateExecutionWitnessRequest) GetBlockHash() *wrapperspb.StringValue {
 	if x != nil {
-		return x.BlockRoot
+		return x.BlockHash
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconBadBlobRequest) GetNodeVersion() string {
+func (x *CreateExecutionWitnessRequest) GetBlockNumber() *wrapperspb.Int64Value {
 	if x != nil {
-		return x.NodeVersion
+		return x.BlockNumber
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconBadBlobRequest) GetLocation() string {
+func (x *CreateExecutionWitnessRequest) GetLocation() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconBadBlobRequest) GetNetwork() string {
+func (x *CreateExecutionWitnessRequest) GetNetwork() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Network
 	}
-	return ""
-}
-
-func (x *CountBeaconBadBlobRequest) GetBefore() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Before
-	}
 	return nil
 }
 
-func (x *CountBeaconBadBlobRequest) GetAfter() *timestamppb.Timestamp {
+func (x *CreateExecutionWitnessRequest) GetExecutionImplementation() *wrapperspb.StringValue {
 	if x != nil {
-		return x.After
+		return x.ExecutionImplementation
 	}
 	return nil
 }
 
-func (x *CountBeaconBadBlobRequest) GetBeaconImplementation() string {
-	if x != nil {
-		return x.BeaconImplementation
-	}
-	return ""
-}
-
-func (x *CountBeaconBadBlobRequest) GetId() string {
+func (x *CreateExecutionWitnessRequest) GetNodeVersion() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Id
+		return x.NodeVersion
 	}
-	return ""
+	return nil
 }
 
-func (x *CountBeaconBadBlobRequest) GetIndex() *wrapperspb.UInt64Value {
+func (x *CreateExecutionWitnessRequest) GetContentEncoding() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Index
+		return x.ContentEncoding
 	}
 	return nil
 }
 
-type CountBeaconBadBlobResponse struct {
+type CreateExecutionWitnessResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CountBeaconBadBlobResponse) Reset() {
-	*x = CountBeaconBadBlobResponse{}
+func (x *CreateExecutionWitnessResponse) Reset() {
+	*x = CreateExecutionWitnessResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5029,13 +5419,13 @@ func (x *CountBeaconBadBlobResponse) Reset() {
 	}
 }
 
-func (x *CountBeaconBadBlobResponse) String() string {
+func (x *CreateExecutionWitnessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBeaconBadBlobResponse) ProtoMessage() {}
+func (*CreateExecutionWitnessResponse) ProtoMessage() {}
 
-func (x *CountBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateExecutionWitnessResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5047,30 +5437,38 @@ func (x *CountBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBeaconBadBlobResponse.ProtoReflect.Descriptor instead.
-func (*CountBeaconBadBlobResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateExecutionWitnessResponse.ProtoReflect.Descriptor instead.
+func (*CreateExecutionWitnessResponse) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CountBeaconBadBlobResponse) GetCount() *wrapperspb.UInt64Value {
+func (x *CreateExecutionWitnessResponse) GetId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Count
+		return x.Id
 	}
 	return nil
 }
 
-type PaginationCursor struct {
+type ListExecutionWitnessRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit   int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset  int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Location                string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination              *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                      string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,10,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,11,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
 }
 
-func (x *PaginationCursor) Reset() {
-	*x = PaginationCursor{}
+func (x *ListExecutionWitnessRequest) Reset() {
+	*x = ListExecutionWitnessRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5078,13 +5476,13 @@ func (x *PaginationCursor) Reset() {
 	}
 }
 
-func (x *PaginationCursor) String() string {
+func (x *ListExecutionWitnessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationCursor) ProtoMessage() {}
+func (*ListExecutionWitnessRequest) ProtoMessage() {}
 
-func (x *PaginationCursor) ProtoReflect() protoreflect.Message {
+func (x *ListExecutionWitnessRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5096,176 +5494,113 @@ func (x *PaginationCursor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationCursor.ProtoReflect.Descriptor instead.
-func (*PaginationCursor) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListExecutionWitnessRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionWitnessRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *PaginationCursor) GetLimit() int32 {
+func (x *ListExecutionWitnessRequest) GetNode() string {
 	if x != nil {
-		return x.Limit
+		return x.Node
 	}
-	return 0
+	return ""
 }
 
-func (x *PaginationCursor) GetOffset() int32 {
+func (x *ListExecutionWitnessRequest) GetBlockNumber() int64 {
 	if x != nil {
-		return x.Offset
+		return x.BlockNumber
 	}
 	return 0
 }
 
-func (x *PaginationCursor) GetOrderBy() string {
+func (x *ListExecutionWitnessRequest) GetBlockHash() string {
 	if x != nil {
-		return x.OrderBy
+		return x.BlockHash
 	}
 	return ""
 }
 
-type CreateExecutionBadBlockRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Node                    *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	FetchedAt               *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
-	BlockHash               *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	BlockNumber             *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Location                *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
-	Network                 *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
-	ExecutionImplementation *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	BlockExtraData          *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=block_extra_data,json=blockExtraData,proto3" json:"block_extra_data,omitempty"`
-	ContentEncoding         *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
-}
-
-func (x *CreateExecutionBadBlockRequest) Reset() {
-	*x = CreateExecutionBadBlockRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[57]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CreateExecutionBadBlockRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateExecutionBadBlockRequest) ProtoMessage() {}
-
-func (x *CreateExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[57]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateExecutionBadBlockRequest.ProtoReflect.Descriptor instead.
-func (*CreateExecutionBadBlockRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *CreateExecutionBadBlockRequest) GetNode() *wrapperspb.StringValue {
+func (x *ListExecutionWitnessRequest) GetLocation() string {
 	if x != nil {
-		return x.Node
+		return x.Location
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateExecutionBadBlockRequest) GetFetchedAt() *timestamppb.Timestamp {
+func (x *ListExecutionWitnessRequest) GetNetwork() string {
 	if x != nil {
-		return x.FetchedAt
+		return x.Network
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateExecutionBadBlockRequest) GetBlockHash() *wrapperspb.StringValue {
+func (x *ListExecutionWitnessRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BlockHash
+		return x.Before
 	}
 	return nil
 }
 
-func (x *CreateExecutionBadBlockRequest) GetBlockNumber() *wrapperspb.Int64Value {
+func (x *ListExecutionWitnessRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BlockNumber
+		return x.After
 	}
 	return nil
 }
 
-func (x *CreateExecutionBadBlockRequest) GetLocation() *wrapperspb.StringValue {
+func (x *ListExecutionWitnessRequest) GetPagination() *PaginationCursor {
 	if x != nil {
-		return x.Location
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *CreateExecutionBadBlockRequest) GetNetwork() *wrapperspb.StringValue {
+func (x *ListExecutionWitnessRequest) GetId() string {
 	if x != nil {
-		return x.Network
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateExecutionBadBlockRequest) GetExecutionImplementation() *wrapperspb.StringValue {
+func (x *ListExecutionWitnessRequest) GetExecutionImplementation() string {
 	if x != nil {
 		return x.ExecutionImplementation
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateExecutionBadBlockRequest) GetNodeVersion() *wrapperspb.StringValue {
+func (x *ListExecutionWitnessRequest) GetNodeVersion() string {
 	if x != nil {
 		return x.NodeVersion
 	}
-	return nil
-}
-
-func (x *CreateExecutionBadBlockRequest) GetBlockExtraData() *wrapperspb.StringValue {
-	if x != nil {
-		return x.BlockExtraData
-	}
-	return nil
-}
-
-func (x *CreateExecutionBadBlockRequest) GetContentEncoding() *wrapperspb.StringValue {
-	if x != nil {
-		return x.ContentEncoding
-	}
-	return nil
+	return ""
 }
 
-type CreateExecutionBadBlockResponse struct {
+type ListExecutionWitnessResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionWitnesses []*ExecutionWitness `protobuf:"bytes,1,rep,name=execution_witnesses,json=executionWitnesses,proto3" json:"execution_witnesses,omitempty"`
 }
 
-func (x *CreateExecutionBadBlockResponse) Reset() {
-	*x = CreateExecutionBadBlockResponse{}
+func (x *ListExecutionWitnessResponse) Reset() {
+	*x = ListExecutionWitnessResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[58]
+		mi := &file_indexer_indexer_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateExecutionBadBlockResponse) String() string {
+func (x *ListExecutionWitnessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExecutionBadBlockResponse) ProtoMessage() {}
+func (*ListExecutionWitnessResponse) ProtoMessage() {}
 
-func (x *CreateExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[58]
+func (x *ListExecutionWitnessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5276,19 +5611,19 @@ func (x *CreateExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExecutionBadBlockResponse.ProtoReflect.Descriptor instead.
-func (*CreateExecutionBadBlockResponse) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use ListExecutionWitnessResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionWitnessResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CreateExecutionBadBlockResponse) GetId() *wrapperspb.StringValue {
+func (x *ListExecutionWitnessResponse) GetExecutionWitnesses() []*ExecutionWitness {
 	if x != nil {
-		return x.Id
+		return x.ExecutionWitnesses
 	}
 	return nil
 }
 
-type ListExecutionBadBlockRequest struct {
+type CountExecutionWitnessRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -5300,30 +5635,27 @@ type ListExecutionBadBlockRequest struct {
 	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
 	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
 	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
-	Pagination              *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Id                      string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
-	ExecutionImplementation string                 `protobuf:"bytes,10,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             string                 `protobuf:"bytes,11,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	BlockExtraData          string                 `protobuf:"bytes,12,opt,name=block_extra_data,json=blockExtraData,proto3" json:"block_extra_data,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,8,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,9,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
 }
 
-func (x *ListExecutionBadBlockRequest) Reset() {
-	*x = ListExecutionBadBlockRequest{}
+func (x *CountExecutionWitnessRequest) Reset() {
+	*x = CountExecutionWitnessRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_indexer_indexer_proto_msgTypes[59]
+		mi := &file_indexer_indexer_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListExecutionBadBlockRequest) String() string {
+func (x *CountExecutionWitnessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExecutionBadBlockRequest) ProtoMessage() {}
+func (*CountExecutionWitnessRequest) ProtoMessage() {}
 
-func (x *ListExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_indexer_indexer_proto_msgTypes[59]
+func (x *CountExecutionWitnessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5334,105 +5666,131 @@ func (x *ListExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExecutionBadBlockRequest.ProtoReflect.Descriptor instead.
-func (*ListExecutionBadBlockRequest) Descriptor() ([]byte, []int) {
-	return file_indexer_indexer_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use CountExecutionWitnessRequest.ProtoReflect.Descriptor instead.
+func (*CountExecutionWitnessRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListExecutionBadBlockRequest) GetNode() string {
+func (x *CountExecutionWitnessRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
 	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetBlockNumber() int64 {
+func (x *CountExecutionWitnessRequest) GetBlockNumber() int64 {
 	if x != nil {
 		return x.BlockNumber
 	}
 	return 0
 }
 
-func (x *ListExecutionBadBlockRequest) GetBlockHash() string {
+func (x *CountExecutionWitnessRequest) GetBlockHash() string {
 	if x != nil {
 		return x.BlockHash
 	}
 	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetLocation() string {
+func (x *CountExecutionWitnessRequest) GetLocation() string {
 	if x != nil {
 		return x.Location
 	}
 	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetNetwork() string {
+func (x *CountExecutionWitnessRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
 	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+func (x *CountExecutionWitnessRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Before
 	}
 	return nil
 }
 
-func (x *ListExecutionBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *CountExecutionWitnessRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
 		return x.After
 	}
 	return nil
 }
 
-func (x *ListExecutionBadBlockRequest) GetPagination() *PaginationCursor {
+func (x *CountExecutionWitnessRequest) GetExecutionImplementation() string {
 	if x != nil {
-		return x.Pagination
+		return x.ExecutionImplementation
 	}
-	return nil
+	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetId() string {
+func (x *CountExecutionWitnessRequest) GetNodeVersion() string {
 	if x != nil {
-		return x.Id
+		return x.NodeVersion
 	}
 	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetExecutionImplementation() string {
-	if x != nil {
-		return x.ExecutionImplementation
+type CountExecutionWitnessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountExecutionWitnessResponse) Reset() {
+	*x = CountExecutionWitnessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ListExecutionBadBlockRequest) GetNodeVersion() string {
-	if x != nil {
-		return x.NodeVersion
+func (x *CountExecutionWitnessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountExecutionWitnessResponse) ProtoMessage() {}
+
+func (x *CountExecutionWitnessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListExecutionBadBlockRequest) GetBlockExtraData() string {
+// Deprecated: Use CountExecutionWitnessResponse.ProtoReflect.Descriptor instead.
+func (*CountExecutionWitnessResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CountExecutionWitnessResponse) GetCount() *wrapperspb.UInt64Value {
 	if x != nil {
-		return x.BlockExtraData
+		return x.Count
 	}
-	return ""
+	return nil
 }
 
-type ListExecutionBadBlockResponse struct {
+type ListUniqueExecutionWitnessValuesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExecutionBadBlocks []*ExecutionBadBlock `protobuf:"bytes,1,rep,name=execution_bad_blocks,json=executionBadBlocks,proto3" json:"execution_bad_blocks,omitempty"`
+	Fields []ListUniqueExecutionWitnessValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueExecutionWitnessValuesRequest_Field" json:"fields,omitempty"`
 }
 
-func (x *ListExecutionBadBlockResponse) Reset() {
-	*x = ListExecutionBadBlockResponse{}
+func (x *ListUniqueExecutionWitnessValuesRequest) Reset() {
+	*x = ListUniqueExecutionWitnessValuesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5440,13 +5798,13 @@ func (x *ListExecutionBadBlockResponse) Reset() {
 	}
 }
 
-func (x *ListExecutionBadBlockResponse) String() string {
+func (x *ListUniqueExecutionWitnessValuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExecutionBadBlockResponse) ProtoMessage() {}
+func (*ListUniqueExecutionWitnessValuesRequest) ProtoMessage() {}
 
-func (x *ListExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *ListUniqueExecutionWitnessValuesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5458,37 +5816,34 @@ func (x *ListExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExecutionBadBlockResponse.ProtoReflect.Descriptor instead.
-func (*ListExecutionBadBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUniqueExecutionWitnessValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionWitnessValuesRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ListExecutionBadBlockResponse) GetExecutionBadBlocks() []*ExecutionBadBlock {
+func (x *ListUniqueExecutionWitnessValuesRequest) GetFields() []ListUniqueExecutionWitnessValuesRequest_Field {
 	if x != nil {
-		return x.ExecutionBadBlocks
+		return x.Fields
 	}
 	return nil
 }
 
-type CountExecutionBadBlockRequest struct {
+type ListUniqueExecutionWitnessValuesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	Location                string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
-	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
-	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
-	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
-	ExecutionImplementation string                 `protobuf:"bytes,8,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             string                 `protobuf:"bytes,9,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	BlockExtraData          string                 `protobuf:"bytes,10,opt,name=block_extra_data,json=blockExtraData,proto3" json:"block_extra_data,omitempty"`
+	Node                    []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	BlockHash               []string `protobuf:"bytes,2,rep,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             []int64  `protobuf:"varint,3,rep,packed,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Location                []string `protobuf:"bytes,4,rep,name=location,proto3" json:"location,omitempty"`
+	Network                 []string `protobuf:"bytes,5,rep,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation []string `protobuf:"bytes,6,rep,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             []string `protobuf:"bytes,7,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
 }
 
-func (x *CountExecutionBadBlockRequest) Reset() {
-	*x = CountExecutionBadBlockRequest{}
+func (x *ListUniqueExecutionWitnessValuesResponse) Reset() {
+	*x = ListUniqueExecutionWitnessValuesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5496,13 +5851,13 @@ func (x *CountExecutionBadBlockRequest) Reset() {
 	}
 }
 
-func (x *CountExecutionBadBlockRequest) String() string {
+func (x *ListUniqueExecutionWitnessValuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountExecutionBadBlockRequest) ProtoMessage() {}
+func (*ListUniqueExecutionWitnessValuesResponse) ProtoMessage() {}
 
-func (x *CountExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
+func (x *ListUniqueExecutionWitnessValuesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5514,91 +5869,70 @@ func (x *CountExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountExecutionBadBlockRequest.ProtoReflect.Descriptor instead.
-func (*CountExecutionBadBlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUniqueExecutionWitnessValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionWitnessValuesResponse) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *CountExecutionBadBlockRequest) GetNode() string {
+func (x *ListUniqueExecutionWitnessValuesResponse) GetNode() []string {
 	if x != nil {
 		return x.Node
 	}
-	return ""
+	return nil
 }
 
-func (x *CountExecutionBadBlockRequest) GetBlockNumber() int64 {
+func (x *ListUniqueExecutionWitnessValuesResponse) GetBlockHash() []string {
 	if x != nil {
-		return x.BlockNumber
+		return x.BlockHash
 	}
-	return 0
+	return nil
 }
 
-func (x *CountExecutionBadBlockRequest) GetBlockHash() string {
+func (x *ListUniqueExecutionWitnessValuesResponse) GetBlockNumber() []int64 {
 	if x != nil {
-		return x.BlockHash
+		return x.BlockNumber
 	}
-	return ""
+	return nil
 }
 
-func (x *CountExecutionBadBlockRequest) GetLocation() string {
+func (x *ListUniqueExecutionWitnessValuesResponse) GetLocation() []string {
 	if x != nil {
 		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *CountExecutionBadBlockRequest) GetNetwork() string {
+func (x *ListUniqueExecutionWitnessValuesResponse) GetNetwork() []string {
 	if x != nil {
 		return x.Network
 	}
-	return ""
+	return nil
 }
 
-func (x *CountExecutionBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+func (x *ListUniqueExecutionWitnessValuesResponse) GetExecutionImplementation() []string {
 	if x != nil {
-		return x.Before
+		return x.ExecutionImplementation
 	}
 	return nil
 }
 
-func (x *CountExecutionBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+func (x *ListUniqueExecutionWitnessValuesResponse) GetNodeVersion() []string {
 	if x != nil {
-		return x.After
+		return x.NodeVersion
 	}
 	return nil
 }
 
-func (x *CountExecutionBadBlockRequest) GetExecutionImplementation() string {
-	if x != nil {
-		return x.ExecutionImplementation
-	}
-	return ""
-}
-
-func (x *CountExecutionBadBlockRequest) GetNodeVersion() string {
-	if x != nil {
-		return x.NodeVersion
-	}
-	return ""
-}
-
-func (x *CountExecutionBadBlockRequest) GetBlockExtraData() string {
-	if x != nil {
-		return x.BlockExtraData
-	}
-	return ""
-}
-
-type CountExecutionBadBlockResponse struct {
+type ListUniqueBeaconStateValuesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+	Fields []ListUniqueBeaconStateValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueBeaconStateValuesRequest_Field" json:"fields,omitempty"`
 }
 
-func (x *CountExecutionBadBlockResponse) Reset() {
-	*x = CountExecutionBadBlockResponse{}
+func (x *ListUniqueBeaconStateValuesRequest) Reset() {
+	*x = ListUniqueBeaconStateValuesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5606,13 +5940,13 @@ func (x *CountExecutionBadBlockResponse) Reset() {
 	}
 }
 
-func (x *CountExecutionBadBlockResponse) String() string {
+func (x *ListUniqueBeaconStateValuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountExecutionBadBlockResponse) ProtoMessage() {}
+func (*ListUniqueBeaconStateValuesRequest) ProtoMessage() {}
 
-func (x *CountExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *ListUniqueBeaconStateValuesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5624,28 +5958,35 @@ func (x *CountExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountExecutionBadBlockResponse.ProtoReflect.Descriptor instead.
-func (*CountExecutionBadBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUniqueBeaconStateValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconStateValuesRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *CountExecutionBadBlockResponse) GetCount() *wrapperspb.UInt64Value {
+func (x *ListUniqueBeaconStateValuesRequest) GetFields() []ListUniqueBeaconStateValuesRequest_Field {
 	if x != nil {
-		return x.Count
+		return x.Fields
 	}
 	return nil
 }
 
-type ListUniqueExecutionBadBlockValuesRequest struct {
+type ListUniqueBeaconStateValuesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []ListUniqueExecutionBadBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueExecutionBadBlockValuesRequest_Field" json:"fields,omitempty"`
+	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
+	StateRoot            []string `protobuf:"bytes,4,rep,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             []string `protobuf:"bytes,6,rep,name=location,proto3" json:"location,omitempty"`
+	Network              []string `protobuf:"bytes,7,rep,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation []string `protobuf:"bytes,8,rep,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
 }
 
-func (x *ListUniqueExecutionBadBlockValuesRequest) Reset() {
-	*x = ListUniqueExecutionBadBlockValuesRequest{}
+func (x *ListUniqueBeaconStateValuesResponse) Reset() {
+	*x = ListUniqueBeaconStateValuesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5653,13 +5994,13 @@ func (x *ListUniqueExecutionBadBlockValuesRequest) Reset() {
 	}
 }
 
-func (x *ListUniqueExecutionBadBlockValuesRequest) String() string {
+func (x *ListUniqueBeaconStateValuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueExecutionBadBlockValuesRequest) ProtoMessage() {}
+func (*ListUniqueBeaconStateValuesResponse) ProtoMessage() {}
 
-func (x *ListUniqueExecutionBadBlockValuesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListUniqueBeaconStateValuesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5671,36 +6012,91 @@ func (x *ListUniqueExecutionBadBlockValuesRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueExecutionBadBlockValuesRequest.ProtoReflect.Descriptor instead.
-func (*ListUniqueExecutionBadBlockValuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUniqueBeaconStateValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconStateValuesResponse) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ListUniqueExecutionBadBlockValuesRequest) GetFields() []ListUniqueExecutionBadBlockValuesRequest_Field {
+func (x *ListUniqueBeaconStateValuesResponse) GetNode() []string {
 	if x != nil {
-		return x.Fields
+		return x.Node
 	}
 	return nil
 }
 
-type ListUniqueExecutionBadBlockValuesResponse struct {
+func (x *ListUniqueBeaconStateValuesResponse) GetSlot() []uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconStateValuesResponse) GetEpoch() []uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconStateValuesResponse) GetStateRoot() []string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconStateValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconStateValuesResponse) GetLocation() []string {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconStateValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconStateValuesResponse) GetBeaconImplementation() []string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return nil
+}
+
+type ListBeaconStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                    []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
-	BlockHash               []string `protobuf:"bytes,2,rep,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	BlockNumber             []int64  `protobuf:"varint,3,rep,packed,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	Location                []string `protobuf:"bytes,4,rep,name=location,proto3" json:"location,omitempty"`
-	Network                 []string `protobuf:"bytes,5,rep,name=network,proto3" json:"network,omitempty"`
-	ExecutionImplementation []string `protobuf:"bytes,6,rep,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
-	NodeVersion             []string `protobuf:"bytes,7,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
-	ClientVersion           []string `protobuf:"bytes,8,rep,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
-	BlockExtraData          []string `protobuf:"bytes,9,rep,name=block_extra_data,json=blockExtraData,proto3" json:"block_extra_data,omitempty"`
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	StateRoot            string                 `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor      `protobuf:"bytes,10,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,12,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Finalized            *wrapperspb.BoolValue  `protobuf:"bytes,13,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	CheckpointEpoch      uint64                 `protobuf:"varint,14,opt,name=checkpoint_epoch,json=checkpointEpoch,proto3" json:"checkpoint_epoch,omitempty"`
+	Canonical            *wrapperspb.BoolValue  `protobuf:"bytes,15,opt,name=canonical,proto3" json:"canonical,omitempty"`
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) Reset() {
-	*x = ListUniqueExecutionBadBlockValuesResponse{}
+func (x *ListBeaconStateRequest) Reset() {
+	*x = ListBeaconStateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_indexer_indexer_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5708,13 +6104,13 @@ func (x *ListUniqueExecutionBadBlockValuesResponse) Reset() {
 	}
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) String() string {
+func (x *ListBeaconStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUniqueExecutionBadBlockValuesResponse) ProtoMessage() {}
+func (*ListBeaconStateRequest) ProtoMessage() {}
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListBeaconStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_indexer_indexer_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5726,96 +6122,3886 @@ func (x *ListUniqueExecutionBadBlockValuesResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUniqueExecutionBadBlockValuesResponse.ProtoReflect.Descriptor instead.
-func (*ListUniqueExecutionBadBlockValuesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBeaconStateRequest.ProtoReflect.Descriptor instead.
+func (*ListBeaconStateRequest) Descriptor() ([]byte, []int) {
 	return file_indexer_indexer_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetNode() []string {
+func (x *ListBeaconStateRequest) GetNode() string {
 	if x != nil {
 		return x.Node
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockHash() []string {
+func (x *ListBeaconStateRequest) GetSlot() uint64 {
 	if x != nil {
-		return x.BlockHash
+		return x.Slot
 	}
-	return nil
+	return 0
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockNumber() []int64 {
+func (x *ListBeaconStateRequest) GetEpoch() uint64 {
 	if x != nil {
-		return x.BlockNumber
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetLocation() []string {
+func (x *ListBeaconStateRequest) GetStateRoot() string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return ""
+}
+
+func (x *ListBeaconStateRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *ListBeaconStateRequest) GetLocation() string {
 	if x != nil {
 		return x.Location
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetNetwork() []string {
+func (x *ListBeaconStateRequest) GetNetwork() string {
 	if x != nil {
 		return x.Network
 	}
+	return ""
+}
+
+func (x *ListBeaconStateRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetExecutionImplementation() []string {
+func (x *ListBeaconStateRequest) GetAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ExecutionImplementation
+		return x.After
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetNodeVersion() []string {
+func (x *ListBeaconStateRequest) GetPagination() *PaginationCursor {
 	if x != nil {
-		return x.NodeVersion
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetClientVersion() []string {
+func (x *ListBeaconStateRequest) GetId() string {
 	if x != nil {
-		return x.ClientVersion
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListBeaconStateRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *ListBeaconStateRequest) GetFinalized() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Finalized
 	}
 	return nil
 }
 
-func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockExtraData() []string {
+func (x *ListBeaconStateRequest) GetCheckpointEpoch() uint64 {
 	if x != nil {
-		return x.BlockExtraData
+		return x.CheckpointEpoch
+	}
+	return 0
+}
+
+func (x *ListBeaconStateRequest) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
 	}
 	return nil
 }
 
-var File_indexer_indexer_proto protoreflect.FileDescriptor
+type ListBeaconStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_indexer_indexer_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x9a, 0x05, 0x0a, 0x0b, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64,
-	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x04,
-	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
+	BeaconStates []*BeaconState `protobuf:"bytes,1,rep,name=beacon_states,json=beaconStates,proto3" json:"beacon_states,omitempty"`
+}
+
+func (x *ListBeaconStateResponse) Reset() {
+	*x = ListBeaconStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBeaconStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBeaconStateResponse) ProtoMessage() {}
+
+func (x *ListBeaconStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBeaconStateResponse.ProtoReflect.Descriptor instead.
+func (*ListBeaconStateResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListBeaconStateResponse) GetBeaconStates() []*BeaconState {
+	if x != nil {
+		return x.BeaconStates
+	}
+	return nil
+}
+
+type CountBeaconStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	StateRoot            string                 `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Finalized            *wrapperspb.BoolValue  `protobuf:"bytes,12,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	CheckpointEpoch      uint64                 `protobuf:"varint,13,opt,name=checkpoint_epoch,json=checkpointEpoch,proto3" json:"checkpoint_epoch,omitempty"`
+	Canonical            *wrapperspb.BoolValue  `protobuf:"bytes,14,opt,name=canonical,proto3" json:"canonical,omitempty"`
+}
+
+func (x *CountBeaconStateRequest) Reset() {
+	*x = CountBeaconStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBeaconStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBeaconStateRequest) ProtoMessage() {}
+
+func (x *CountBeaconStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBeaconStateRequest.ProtoReflect.Descriptor instead.
+func (*CountBeaconStateRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CountBeaconStateRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CountBeaconStateRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CountBeaconStateRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CountBeaconStateRequest) GetStateRoot() string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return ""
+}
+
+func (x *CountBeaconStateRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *CountBeaconStateRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CountBeaconStateRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CountBeaconStateRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountBeaconStateRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountBeaconStateRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *CountBeaconStateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CountBeaconStateRequest) GetFinalized() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Finalized
+	}
+	return nil
+}
+
+func (x *CountBeaconStateRequest) GetCheckpointEpoch() uint64 {
+	if x != nil {
+		return x.CheckpointEpoch
+	}
+	return 0
+}
+
+func (x *CountBeaconStateRequest) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type CountBeaconStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountBeaconStateResponse) Reset() {
+	*x = CountBeaconStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBeaconStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBeaconStateResponse) ProtoMessage() {}
+
+func (x *CountBeaconStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBeaconStateResponse.ProtoReflect.Descriptor instead.
+func (*CountBeaconStateResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CountBeaconStateResponse) GetCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListStateDivergencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network              string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Node                 string                 `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,5,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                   string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListStateDivergencesRequest) Reset() {
+	*x = ListStateDivergencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStateDivergencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStateDivergencesRequest) ProtoMessage() {}
+
+func (x *ListStateDivergencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStateDivergencesRequest.ProtoReflect.Descriptor instead.
+func (*ListStateDivergencesRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListStateDivergencesRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ListStateDivergencesRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ListStateDivergencesRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ListStateDivergencesRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListStateDivergencesRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *ListStateDivergencesRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListStateDivergencesRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListStateDivergencesRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListStateDivergencesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListStateDivergencesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StateDivergences []*StateDivergence `protobuf:"bytes,1,rep,name=state_divergences,json=stateDivergences,proto3" json:"state_divergences,omitempty"`
+}
+
+func (x *ListStateDivergencesResponse) Reset() {
+	*x = ListStateDivergencesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStateDivergencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStateDivergencesResponse) ProtoMessage() {}
+
+func (x *ListStateDivergencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStateDivergencesResponse.ProtoReflect.Descriptor instead.
+func (*ListStateDivergencesResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListStateDivergencesResponse) GetStateDivergences() []*StateDivergence {
+	if x != nil {
+		return x.StateDivergences
+	}
+	return nil
+}
+
+type ListUniqueBeaconBlockValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []ListUniqueBeaconBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueBeaconBlockValuesRequest_Field" json:"fields,omitempty"`
+}
+
+func (x *ListUniqueBeaconBlockValuesRequest) Reset() {
+	*x = ListUniqueBeaconBlockValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBeaconBlockValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBeaconBlockValuesRequest) ProtoMessage() {}
+
+func (x *ListUniqueBeaconBlockValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBeaconBlockValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBlockValuesRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListUniqueBeaconBlockValuesRequest) GetFields() []ListUniqueBeaconBlockValuesRequest_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListUniqueBeaconBlockValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             []string `protobuf:"bytes,6,rep,name=location,proto3" json:"location,omitempty"`
+	Network              []string `protobuf:"bytes,7,rep,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation []string `protobuf:"bytes,8,rep,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) Reset() {
+	*x = ListUniqueBeaconBlockValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBeaconBlockValuesResponse) ProtoMessage() {}
+
+func (x *ListUniqueBeaconBlockValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBeaconBlockValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBlockValuesResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetNode() []string {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetSlot() []uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetEpoch() []uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetBlockRoot() []string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetLocation() []string {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBlockValuesResponse) GetBeaconImplementation() []string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return nil
+}
+
+type ListBeaconBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor      `protobuf:"bytes,10,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,12,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Canonical            *wrapperspb.BoolValue  `protobuf:"bytes,13,opt,name=canonical,proto3" json:"canonical,omitempty"`
+}
+
+func (x *ListBeaconBlockRequest) Reset() {
+	*x = ListBeaconBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBeaconBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBeaconBlockRequest) ProtoMessage() {}
+
+func (x *ListBeaconBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBeaconBlockRequest.ProtoReflect.Descriptor instead.
+func (*ListBeaconBlockRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListBeaconBlockRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListBeaconBlockRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ListBeaconBlockRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ListBeaconBlockRequest) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *ListBeaconBlockRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *ListBeaconBlockRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListBeaconBlockRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ListBeaconBlockRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListBeaconBlockRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListBeaconBlockRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListBeaconBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListBeaconBlockRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *ListBeaconBlockRequest) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type ListBeaconBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BeaconBlocks []*BeaconBlock `protobuf:"bytes,1,rep,name=beacon_blocks,json=beaconBlocks,proto3" json:"beacon_blocks,omitempty"`
+}
+
+func (x *ListBeaconBlockResponse) Reset() {
+	*x = ListBeaconBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBeaconBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBeaconBlockResponse) ProtoMessage() {}
+
+func (x *ListBeaconBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBeaconBlockResponse.ProtoReflect.Descriptor instead.
+func (*ListBeaconBlockResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListBeaconBlockResponse) GetBeaconBlocks() []*BeaconBlock {
+	if x != nil {
+		return x.BeaconBlocks
+	}
+	return nil
+}
+
+type CountBeaconBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Canonical            *wrapperspb.BoolValue  `protobuf:"bytes,12,opt,name=canonical,proto3" json:"canonical,omitempty"`
+}
+
+func (x *CountBeaconBlockRequest) Reset() {
+	*x = CountBeaconBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBeaconBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBeaconBlockRequest) ProtoMessage() {}
+
+func (x *CountBeaconBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBeaconBlockRequest.ProtoReflect.Descriptor instead.
+func (*CountBeaconBlockRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CountBeaconBlockRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CountBeaconBlockRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CountBeaconBlockRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CountBeaconBlockRequest) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *CountBeaconBlockRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *CountBeaconBlockRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CountBeaconBlockRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CountBeaconBlockRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountBeaconBlockRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountBeaconBlockRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *CountBeaconBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CountBeaconBlockRequest) GetCanonical() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Canonical
+	}
+	return nil
+}
+
+type CountBeaconBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountBeaconBlockResponse) Reset() {
+	*x = CountBeaconBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBeaconBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBeaconBlockResponse) ProtoMessage() {}
+
+func (x *CountBeaconBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBeaconBlockResponse.ProtoReflect.Descriptor instead.
+func (*CountBeaconBlockResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CountBeaconBlockResponse) GetCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListUniqueBlobSidecarValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []ListUniqueBlobSidecarValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueBlobSidecarValuesRequest_Field" json:"fields,omitempty"`
+}
+
+func (x *ListUniqueBlobSidecarValuesRequest) Reset() {
+	*x = ListUniqueBlobSidecarValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBlobSidecarValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBlobSidecarValuesRequest) ProtoMessage() {}
+
+func (x *ListUniqueBlobSidecarValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBlobSidecarValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueBlobSidecarValuesRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListUniqueBlobSidecarValuesRequest) GetFields() []ListUniqueBlobSidecarValuesRequest_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListUniqueBlobSidecarValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             []string `protobuf:"bytes,6,rep,name=location,proto3" json:"location,omitempty"`
+	Network              []string `protobuf:"bytes,7,rep,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation []string `protobuf:"bytes,8,rep,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	SidecarType          []string `protobuf:"bytes,9,rep,name=sidecar_type,json=sidecarType,proto3" json:"sidecar_type,omitempty"`
+}
+
+func (x *ListUniqueBlobSidecarValuesResponse) Reset() {
+	*x = ListUniqueBlobSidecarValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBlobSidecarValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBlobSidecarValuesResponse) ProtoMessage() {}
+
+func (x *ListUniqueBlobSidecarValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBlobSidecarValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueBlobSidecarValuesResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListUniqueBlobSidecarValuesResponse) GetNode() []string {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListUniqueBlobSidecarValuesResponse) GetSlot() []uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ListUniqueBlobSidecarValuesResponse) GetEpoch() []uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+func (x *ListUniqueBlobSidecarValuesResponse) GetBlockRoot() []string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *ListUniqueBlobSidecarValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueBlobSidecarValuesResponse) GetLocation() []string {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ListUniqueBlobSidecarValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueBlobSidecarValuesResponse) GetBeaconImplementation() []string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return nil
+}
+
+func (x *ListUniqueBlobSidecarValuesResponse) GetSidecarType() []string {
+	if x != nil {
+		return x.SidecarType
+	}
+	return nil
+}
+
+type ListBlobSidecarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor      `protobuf:"bytes,10,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,12,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	SidecarType          string                 `protobuf:"bytes,13,opt,name=sidecar_type,json=sidecarType,proto3" json:"sidecar_type,omitempty"`
+}
+
+func (x *ListBlobSidecarRequest) Reset() {
+	*x = ListBlobSidecarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlobSidecarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlobSidecarRequest) ProtoMessage() {}
+
+func (x *ListBlobSidecarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlobSidecarRequest.ProtoReflect.Descriptor instead.
+func (*ListBlobSidecarRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListBlobSidecarRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListBlobSidecarRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ListBlobSidecarRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ListBlobSidecarRequest) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *ListBlobSidecarRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *ListBlobSidecarRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListBlobSidecarRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ListBlobSidecarRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListBlobSidecarRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListBlobSidecarRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListBlobSidecarRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListBlobSidecarRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *ListBlobSidecarRequest) GetSidecarType() string {
+	if x != nil {
+		return x.SidecarType
+	}
+	return ""
+}
+
+type ListBlobSidecarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlobSidecars []*BlobSidecar `protobuf:"bytes,1,rep,name=blob_sidecars,json=blobSidecars,proto3" json:"blob_sidecars,omitempty"`
+}
+
+func (x *ListBlobSidecarResponse) Reset() {
+	*x = ListBlobSidecarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlobSidecarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlobSidecarResponse) ProtoMessage() {}
+
+func (x *ListBlobSidecarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlobSidecarResponse.ProtoReflect.Descriptor instead.
+func (*ListBlobSidecarResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListBlobSidecarResponse) GetBlobSidecars() []*BlobSidecar {
+	if x != nil {
+		return x.BlobSidecars
+	}
+	return nil
+}
+
+type CountBlobSidecarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	SidecarType          string                 `protobuf:"bytes,12,opt,name=sidecar_type,json=sidecarType,proto3" json:"sidecar_type,omitempty"`
+}
+
+func (x *CountBlobSidecarRequest) Reset() {
+	*x = CountBlobSidecarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBlobSidecarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBlobSidecarRequest) ProtoMessage() {}
+
+func (x *CountBlobSidecarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBlobSidecarRequest.ProtoReflect.Descriptor instead.
+func (*CountBlobSidecarRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CountBlobSidecarRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CountBlobSidecarRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CountBlobSidecarRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CountBlobSidecarRequest) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *CountBlobSidecarRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *CountBlobSidecarRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CountBlobSidecarRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CountBlobSidecarRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountBlobSidecarRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountBlobSidecarRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *CountBlobSidecarRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CountBlobSidecarRequest) GetSidecarType() string {
+	if x != nil {
+		return x.SidecarType
+	}
+	return ""
+}
+
+type CountBlobSidecarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountBlobSidecarResponse) Reset() {
+	*x = CountBlobSidecarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBlobSidecarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBlobSidecarResponse) ProtoMessage() {}
+
+func (x *CountBlobSidecarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBlobSidecarResponse.ProtoReflect.Descriptor instead.
+func (*CountBlobSidecarResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CountBlobSidecarResponse) GetCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListUniqueForkChoiceValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []ListUniqueForkChoiceValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueForkChoiceValuesRequest_Field" json:"fields,omitempty"`
+}
+
+func (x *ListUniqueForkChoiceValuesRequest) Reset() {
+	*x = ListUniqueForkChoiceValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueForkChoiceValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueForkChoiceValuesRequest) ProtoMessage() {}
+
+func (x *ListUniqueForkChoiceValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueForkChoiceValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueForkChoiceValuesRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListUniqueForkChoiceValuesRequest) GetFields() []ListUniqueForkChoiceValuesRequest_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListUniqueForkChoiceValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
+	NodeVersion          []string `protobuf:"bytes,4,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             []string `protobuf:"bytes,5,rep,name=location,proto3" json:"location,omitempty"`
+	Network              []string `protobuf:"bytes,6,rep,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation []string `protobuf:"bytes,7,rep,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Reason               []string `protobuf:"bytes,8,rep,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ListUniqueForkChoiceValuesResponse) Reset() {
+	*x = ListUniqueForkChoiceValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueForkChoiceValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueForkChoiceValuesResponse) ProtoMessage() {}
+
+func (x *ListUniqueForkChoiceValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueForkChoiceValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueForkChoiceValuesResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListUniqueForkChoiceValuesResponse) GetNode() []string {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListUniqueForkChoiceValuesResponse) GetSlot() []uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ListUniqueForkChoiceValuesResponse) GetEpoch() []uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+func (x *ListUniqueForkChoiceValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueForkChoiceValuesResponse) GetLocation() []string {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ListUniqueForkChoiceValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueForkChoiceValuesResponse) GetBeaconImplementation() []string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return nil
+}
+
+func (x *ListUniqueForkChoiceValuesResponse) GetReason() []string {
+	if x != nil {
+		return x.Reason
+	}
+	return nil
+}
+
+type ListForkChoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,4,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor      `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                   string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,11,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Reason               string                 `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ListForkChoiceRequest) Reset() {
+	*x = ListForkChoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListForkChoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForkChoiceRequest) ProtoMessage() {}
+
+func (x *ListForkChoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForkChoiceRequest.ProtoReflect.Descriptor instead.
+func (*ListForkChoiceRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListForkChoiceRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListForkChoiceRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ListForkChoiceRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ListForkChoiceRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *ListForkChoiceRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListForkChoiceRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ListForkChoiceRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListForkChoiceRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListForkChoiceRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListForkChoiceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListForkChoiceRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *ListForkChoiceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListForkChoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForkChoices []*ForkChoice `protobuf:"bytes,1,rep,name=fork_choices,json=forkChoices,proto3" json:"fork_choices,omitempty"`
+}
+
+func (x *ListForkChoiceResponse) Reset() {
+	*x = ListForkChoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListForkChoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForkChoiceResponse) ProtoMessage() {}
+
+func (x *ListForkChoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForkChoiceResponse.ProtoReflect.Descriptor instead.
+func (*ListForkChoiceResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListForkChoiceResponse) GetForkChoices() []*ForkChoice {
+	if x != nil {
+		return x.ForkChoices
+	}
+	return nil
+}
+
+type CountForkChoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,4,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                 `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,9,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Id                   string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Reason               string                 `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CountForkChoiceRequest) Reset() {
+	*x = CountForkChoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountForkChoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountForkChoiceRequest) ProtoMessage() {}
+
+func (x *CountForkChoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountForkChoiceRequest.ProtoReflect.Descriptor instead.
+func (*CountForkChoiceRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CountForkChoiceRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CountForkChoiceRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CountForkChoiceRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CountForkChoiceRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *CountForkChoiceRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CountForkChoiceRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CountForkChoiceRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountForkChoiceRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountForkChoiceRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *CountForkChoiceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CountForkChoiceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CountForkChoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountForkChoiceResponse) Reset() {
+	*x = CountForkChoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountForkChoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountForkChoiceResponse) ProtoMessage() {}
+
+func (x *CountForkChoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountForkChoiceResponse.ProtoReflect.Descriptor instead.
+func (*CountForkChoiceResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CountForkChoiceResponse) GetCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListUniqueBeaconBadBlockValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []ListUniqueBeaconBadBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueBeaconBadBlockValuesRequest_Field" json:"fields,omitempty"`
+}
+
+func (x *ListUniqueBeaconBadBlockValuesRequest) Reset() {
+	*x = ListUniqueBeaconBadBlockValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBeaconBadBlockValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBeaconBadBlockValuesRequest) ProtoMessage() {}
+
+func (x *ListUniqueBeaconBadBlockValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBeaconBadBlockValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBadBlockValuesRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListUniqueBeaconBadBlockValuesRequest) GetFields() []ListUniqueBeaconBadBlockValuesRequest_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListUniqueBeaconBadBlockValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             []string `protobuf:"bytes,6,rep,name=location,proto3" json:"location,omitempty"`
+	Network              []string `protobuf:"bytes,7,rep,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation []string `protobuf:"bytes,8,rep,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+}
+
+func (x *ListUniqueBeaconBadBlockValuesResponse) Reset() {
+	*x = ListUniqueBeaconBadBlockValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBeaconBadBlockValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBeaconBadBlockValuesResponse) ProtoMessage() {}
+
+func (x *ListUniqueBeaconBadBlockValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBeaconBadBlockValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBadBlockValuesResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetNode() []string {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetSlot() []uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetEpoch() []uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetBlockRoot() []string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetLocation() []string {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlockValuesResponse) GetBeaconImplementation() []string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return nil
+}
+
+type ListBeaconBadBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor      `protobuf:"bytes,10,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,12,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+}
+
+func (x *ListBeaconBadBlockRequest) Reset() {
+	*x = ListBeaconBadBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBeaconBadBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBeaconBadBlockRequest) ProtoMessage() {}
+
+func (x *ListBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBeaconBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*ListBeaconBadBlockRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListBeaconBadBlockRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlockRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ListBeaconBadBlockRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ListBeaconBadBlockRequest) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlockRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlockRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlockRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListBeaconBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListBeaconBadBlockRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListBeaconBadBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlockRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+type ListBeaconBadBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BeaconBadBlocks []*BeaconBadBlock `protobuf:"bytes,1,rep,name=beacon_bad_blocks,json=beaconBadBlocks,proto3" json:"beacon_bad_blocks,omitempty"`
+}
+
+func (x *ListBeaconBadBlockResponse) Reset() {
+	*x = ListBeaconBadBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBeaconBadBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBeaconBadBlockResponse) ProtoMessage() {}
+
+func (x *ListBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBeaconBadBlockResponse.ProtoReflect.Descriptor instead.
+func (*ListBeaconBadBlockResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListBeaconBadBlockResponse) GetBeaconBadBlocks() []*BeaconBadBlock {
+	if x != nil {
+		return x.BeaconBadBlocks
+	}
+	return nil
+}
+
+type CountBeaconBadBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                 `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                 `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                 `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	BeaconImplementation string                 `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Id                   string                 `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CountBeaconBadBlockRequest) Reset() {
+	*x = CountBeaconBadBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBeaconBadBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBeaconBadBlockRequest) ProtoMessage() {}
+
+func (x *CountBeaconBadBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBeaconBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*CountBeaconBadBlockRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CountBeaconBadBlockRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlockRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CountBeaconBadBlockRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CountBeaconBadBlockRequest) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlockRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlockRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlockRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountBeaconBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountBeaconBadBlockRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CountBeaconBadBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountBeaconBadBlockResponse) Reset() {
+	*x = CountBeaconBadBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBeaconBadBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBeaconBadBlockResponse) ProtoMessage() {}
+
+func (x *CountBeaconBadBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBeaconBadBlockResponse.ProtoReflect.Descriptor instead.
+func (*CountBeaconBadBlockResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CountBeaconBadBlockResponse) GetCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListUniqueBeaconBadBlobValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []ListUniqueBeaconBadBlobValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueBeaconBadBlobValuesRequest_Field" json:"fields,omitempty"`
+}
+
+func (x *ListUniqueBeaconBadBlobValuesRequest) Reset() {
+	*x = ListUniqueBeaconBadBlobValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBeaconBadBlobValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBeaconBadBlobValuesRequest) ProtoMessage() {}
+
+func (x *ListUniqueBeaconBadBlobValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBeaconBadBlobValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBadBlobValuesRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListUniqueBeaconBadBlobValuesRequest) GetFields() []ListUniqueBeaconBadBlobValuesRequest_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListUniqueBeaconBadBlobValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	Slot                 []uint64 `protobuf:"varint,2,rep,packed,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                []uint64 `protobuf:"varint,3,rep,packed,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            []string `protobuf:"bytes,4,rep,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          []string `protobuf:"bytes,5,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             []string `protobuf:"bytes,6,rep,name=location,proto3" json:"location,omitempty"`
+	Network              []string `protobuf:"bytes,7,rep,name=network,proto3" json:"network,omitempty"`
+	BeaconImplementation []string `protobuf:"bytes,8,rep,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Index                []uint64 `protobuf:"varint,9,rep,packed,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) Reset() {
+	*x = ListUniqueBeaconBadBlobValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueBeaconBadBlobValuesResponse) ProtoMessage() {}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueBeaconBadBlobValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueBeaconBadBlobValuesResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetNode() []string {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetSlot() []uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetEpoch() []uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetBlockRoot() []string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetLocation() []string {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetBeaconImplementation() []string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return nil
+}
+
+func (x *ListUniqueBeaconBadBlobValuesResponse) GetIndex() []uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+type ListBeaconBadBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                  `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                  `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                  `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                  `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                  `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination           *PaginationCursor       `protobuf:"bytes,10,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                   string                  `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	BeaconImplementation string                  `protobuf:"bytes,12,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Index                *wrapperspb.UInt64Value `protobuf:"bytes,13,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *ListBeaconBadBlobRequest) Reset() {
+	*x = ListBeaconBadBlobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBeaconBadBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBeaconBadBlobRequest) ProtoMessage() {}
+
+func (x *ListBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBeaconBadBlobRequest.ProtoReflect.Descriptor instead.
+func (*ListBeaconBadBlobRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListBeaconBadBlobRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *ListBeaconBadBlobRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ListBeaconBadBlobRequest) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListBeaconBadBlobRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListBeaconBadBlobRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListBeaconBadBlobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *ListBeaconBadBlobRequest) GetIndex() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+type ListBeaconBadBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BeaconBadBlobs []*BeaconBadBlob `protobuf:"bytes,1,rep,name=beacon_bad_blobs,json=beaconBadBlobs,proto3" json:"beacon_bad_blobs,omitempty"`
+}
+
+func (x *ListBeaconBadBlobResponse) Reset() {
+	*x = ListBeaconBadBlobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBeaconBadBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBeaconBadBlobResponse) ProtoMessage() {}
+
+func (x *ListBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBeaconBadBlobResponse.ProtoReflect.Descriptor instead.
+func (*ListBeaconBadBlobResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListBeaconBadBlobResponse) GetBeaconBadBlobs() []*BeaconBadBlob {
+	if x != nil {
+		return x.BeaconBadBlobs
+	}
+	return nil
+}
+
+type CountBeaconBadBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                 string                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Slot                 uint64                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64                  `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockRoot            string                  `protobuf:"bytes,4,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	NodeVersion          string                  `protobuf:"bytes,5,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Location             string                  `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Network              string                  `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	Before               *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After                *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	BeaconImplementation string                  `protobuf:"bytes,10,opt,name=beacon_implementation,json=beaconImplementation,proto3" json:"beacon_implementation,omitempty"`
+	Id                   string                  `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Index                *wrapperspb.UInt64Value `protobuf:"bytes,12,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *CountBeaconBadBlobRequest) Reset() {
+	*x = CountBeaconBadBlobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBeaconBadBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBeaconBadBlobRequest) ProtoMessage() {}
+
+func (x *CountBeaconBadBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBeaconBadBlobRequest.ProtoReflect.Descriptor instead.
+func (*CountBeaconBadBlobRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CountBeaconBadBlobRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *CountBeaconBadBlobRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CountBeaconBadBlobRequest) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountBeaconBadBlobRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountBeaconBadBlobRequest) GetBeaconImplementation() string {
+	if x != nil {
+		return x.BeaconImplementation
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CountBeaconBadBlobRequest) GetIndex() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+type CountBeaconBadBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountBeaconBadBlobResponse) Reset() {
+	*x = CountBeaconBadBlobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBeaconBadBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBeaconBadBlobResponse) ProtoMessage() {}
+
+func (x *CountBeaconBadBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBeaconBadBlobResponse.ProtoReflect.Descriptor instead.
+func (*CountBeaconBadBlobResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CountBeaconBadBlobResponse) GetCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type PaginationCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit   int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset  int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+}
+
+func (x *PaginationCursor) Reset() {
+	*x = PaginationCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginationCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationCursor) ProtoMessage() {}
+
+func (x *PaginationCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationCursor.ProtoReflect.Descriptor instead.
+func (*PaginationCursor) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *PaginationCursor) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PaginationCursor) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PaginationCursor) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type CreateExecutionBadBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	FetchedAt                 *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	BlockHash                 *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber               *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Location                  *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Network                   *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation   *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion               *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	BlockExtraData            *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=block_extra_data,json=blockExtraData,proto3" json:"block_extra_data,omitempty"`
+	ContentEncoding           *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	TraceLocation             *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=trace_location,json=traceLocation,proto3" json:"trace_location,omitempty"`
+	IntermediateRootsLocation *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=intermediate_roots_location,json=intermediateRootsLocation,proto3" json:"intermediate_roots_location,omitempty"`
+}
+
+func (x *CreateExecutionBadBlockRequest) Reset() {
+	*x = CreateExecutionBadBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExecutionBadBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExecutionBadBlockRequest) ProtoMessage() {}
+
+func (x *CreateExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExecutionBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*CreateExecutionBadBlockRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CreateExecutionBadBlockRequest) GetNode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *CreateExecutionBadBlockRequest) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+func (x *CreateExecutionBadBlockRequest) GetBlockHash() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *CreateExecutionBadBlockRequest) GetBlockNumber() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
+}
+
+func (x *CreateExecutionBadBlockRequest) GetLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateExecutionBadBlockRequest) GetNetwork() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *CreateExecutionBadBlockRequest) GetExecutionImplementation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return nil
+}
+
+func (x *CreateExecutionBadBlockRequest) GetNodeVersion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *CreateExecutionBadBlockRequest) GetBlockExtraData() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BlockExtraData
+	}
+	return nil
+}
+
+func (x *CreateExecutionBadBlockRequest) GetContentEncoding() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ContentEncoding
+	}
+	return nil
+}
+
+func (x *CreateExecutionBadBlockRequest) GetTraceLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.TraceLocation
+	}
+	return nil
+}
+
+func (x *CreateExecutionBadBlockRequest) GetIntermediateRootsLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.IntermediateRootsLocation
+	}
+	return nil
+}
+
+type CreateExecutionBadBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateExecutionBadBlockResponse) Reset() {
+	*x = CreateExecutionBadBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExecutionBadBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExecutionBadBlockResponse) ProtoMessage() {}
+
+func (x *CreateExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExecutionBadBlockResponse.ProtoReflect.Descriptor instead.
+func (*CreateExecutionBadBlockResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CreateExecutionBadBlockResponse) GetId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type ListExecutionBadBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Location                string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Pagination              *PaginationCursor      `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id                      string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,10,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,11,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	BlockExtraData          string                 `protobuf:"bytes,12,opt,name=block_extra_data,json=blockExtraData,proto3" json:"block_extra_data,omitempty"`
+}
+
+func (x *ListExecutionBadBlockRequest) Reset() {
+	*x = ListExecutionBadBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExecutionBadBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionBadBlockRequest) ProtoMessage() {}
+
+func (x *ListExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionBadBlockRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ListExecutionBadBlockRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ListExecutionBadBlockRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListExecutionBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListExecutionBadBlockRequest) GetPagination() *PaginationCursor {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListExecutionBadBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetExecutionImplementation() string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *ListExecutionBadBlockRequest) GetBlockExtraData() string {
+	if x != nil {
+		return x.BlockExtraData
+	}
+	return ""
+}
+
+type ListExecutionBadBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExecutionBadBlocks []*ExecutionBadBlock `protobuf:"bytes,1,rep,name=execution_bad_blocks,json=executionBadBlocks,proto3" json:"execution_bad_blocks,omitempty"`
+}
+
+func (x *ListExecutionBadBlockResponse) Reset() {
+	*x = ListExecutionBadBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExecutionBadBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionBadBlockResponse) ProtoMessage() {}
+
+func (x *ListExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionBadBlockResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionBadBlockResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListExecutionBadBlockResponse) GetExecutionBadBlocks() []*ExecutionBadBlock {
+	if x != nil {
+		return x.ExecutionBadBlocks
+	}
+	return nil
+}
+
+type CountExecutionBadBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	BlockNumber             int64                  `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash               string                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Location                string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Network                 string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	Before                  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	ExecutionImplementation string                 `protobuf:"bytes,8,opt,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             string                 `protobuf:"bytes,9,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	BlockExtraData          string                 `protobuf:"bytes,10,opt,name=block_extra_data,json=blockExtraData,proto3" json:"block_extra_data,omitempty"`
+}
+
+func (x *CountExecutionBadBlockRequest) Reset() {
+	*x = CountExecutionBadBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountExecutionBadBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountExecutionBadBlockRequest) ProtoMessage() {}
+
+func (x *CountExecutionBadBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountExecutionBadBlockRequest.ProtoReflect.Descriptor instead.
+func (*CountExecutionBadBlockRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CountExecutionBadBlockRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *CountExecutionBadBlockRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *CountExecutionBadBlockRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *CountExecutionBadBlockRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CountExecutionBadBlockRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *CountExecutionBadBlockRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountExecutionBadBlockRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountExecutionBadBlockRequest) GetExecutionImplementation() string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return ""
+}
+
+func (x *CountExecutionBadBlockRequest) GetNodeVersion() string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return ""
+}
+
+func (x *CountExecutionBadBlockRequest) GetBlockExtraData() string {
+	if x != nil {
+		return x.BlockExtraData
+	}
+	return ""
+}
+
+type CountExecutionBadBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountExecutionBadBlockResponse) Reset() {
+	*x = CountExecutionBadBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountExecutionBadBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountExecutionBadBlockResponse) ProtoMessage() {}
+
+func (x *CountExecutionBadBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountExecutionBadBlockResponse.ProtoReflect.Descriptor instead.
+func (*CountExecutionBadBlockResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CountExecutionBadBlockResponse) GetCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ListUniqueExecutionBadBlockValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []ListUniqueExecutionBadBlockValuesRequest_Field `protobuf:"varint,1,rep,packed,name=fields,proto3,enum=indexer.ListUniqueExecutionBadBlockValuesRequest_Field" json:"fields,omitempty"`
+}
+
+func (x *ListUniqueExecutionBadBlockValuesRequest) Reset() {
+	*x = ListUniqueExecutionBadBlockValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueExecutionBadBlockValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueExecutionBadBlockValuesRequest) ProtoMessage() {}
+
+func (x *ListUniqueExecutionBadBlockValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueExecutionBadBlockValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionBadBlockValuesRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListUniqueExecutionBadBlockValuesRequest) GetFields() []ListUniqueExecutionBadBlockValuesRequest_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListUniqueExecutionBadBlockValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node                    []string `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	BlockHash               []string `protobuf:"bytes,2,rep,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber             []int64  `protobuf:"varint,3,rep,packed,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Location                []string `protobuf:"bytes,4,rep,name=location,proto3" json:"location,omitempty"`
+	Network                 []string `protobuf:"bytes,5,rep,name=network,proto3" json:"network,omitempty"`
+	ExecutionImplementation []string `protobuf:"bytes,6,rep,name=execution_implementation,json=executionImplementation,proto3" json:"execution_implementation,omitempty"`
+	NodeVersion             []string `protobuf:"bytes,7,rep,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	ClientVersion           []string `protobuf:"bytes,8,rep,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	BlockExtraData          []string `protobuf:"bytes,9,rep,name=block_extra_data,json=blockExtraData,proto3" json:"block_extra_data,omitempty"`
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) Reset() {
+	*x = ListUniqueExecutionBadBlockValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_indexer_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueExecutionBadBlockValuesResponse) ProtoMessage() {}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_indexer_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueExecutionBadBlockValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListUniqueExecutionBadBlockValuesResponse) Descriptor() ([]byte, []int) {
+	return file_indexer_indexer_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetNode() []string {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockHash() []string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockNumber() []int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetLocation() []string {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetNetwork() []string {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetExecutionImplementation() []string {
+	if x != nil {
+		return x.ExecutionImplementation
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetNodeVersion() []string {
+	if x != nil {
+		return x.NodeVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetClientVersion() []string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return nil
+}
+
+func (x *ListUniqueExecutionBadBlockValuesResponse) GetBlockExtraData() []string {
+	if x != nil {
+		return x.BlockExtraData
+	}
+	return nil
+}
+
+var File_indexer_indexer_proto protoreflect.FileDescriptor
+
+var file_indexer_indexer_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xd7, 0x06, 0x0a, 0x0b, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
 	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32,
 	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
@@ -5844,285 +10030,633 @@ var file_indexer_indexer_proto_rawDesc = []byte{
 	0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x9a,
-	0x05, 0x0a, 0x0b, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39,
-	0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x38,
+	0x0a, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0xd4, 0x05, 0x0a, 0x0b,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
-	0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e,
+	0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x51, 0x0a, 0x15,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f,
+	0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63,
+	0x61, 0x6c, 0x22, 0xdb, 0x05, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0c,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x51, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
-	0x51, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x9d, 0x05, 0x0a, 0x0e,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39,
-	0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
-	0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20,
+	0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x3f, 0x0a, 0x0c, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0b, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x92, 0x05, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12,
+	0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0c,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x51, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x34, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
-	0x51, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9d, 0x05, 0x0a, 0x0e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xd0, 0x05, 0x0a, 0x0d,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x2c, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a,
-	0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66,
-	0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
-	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3b,
-	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x09, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x51, 0x0a, 0x15, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xd0, 0x05, 0x0a, 0x0d, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x51, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x51,
-	0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xf2, 0x05, 0x0a, 0x13, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x82,
-	0x05, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x57, 0x0a, 0x18, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a,
+	0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0xff, 0x04,
+	0x0a, 0x10, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e,
-	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x38,
-	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x57, 0x0a, 0x18,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x17, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x12, 0x57, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e,
-	0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22,
+	0xeb, 0x06, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x69, 0x6e, 0x67, 0x22, 0xc8, 0x05, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74,
-	0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x38, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x57, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f,
+	0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x10, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x12, 0x57, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d,
-	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x65, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x43, 0x0a, 0x0e, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a,
-	0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x3d,
-	0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x08, 0x65, 0x74, 0x68, 0x65,
-	0x72, 0x65, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x08, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x22, 0xac, 0x01,
-	0x0a, 0x0e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x36, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x2a, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xbd, 0x01, 0x0a,
-	0x15, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x5c, 0x0a, 0x1b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x75, 0x65, 0x52, 0x19, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9f, 0x08,
+	0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3b, 0x0a,
+	0x0b, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x44, 0x0a, 0x0f, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x51, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x9a, 0x01, 0x0a,
-	0x0b, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x04,
-	0x6e, 0x63, 0x6c, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x6e, 0x63, 0x6c, 0x69, 0x12, 0x30,
-	0x0a, 0x04, 0x6c, 0x63, 0x6c, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x6c, 0x63, 0x6c, 0x69,
-	0x12, 0x27, 0x0a, 0x04, 0x7a, 0x63, 0x6c, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x5a, 0x63, 0x6c, 0x69, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x04, 0x7a, 0x63, 0x6c, 0x69, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x47, 0x69,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x1b, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x18, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x69, 0x6e, 0x67, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x52, 0x0a, 0x16, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x68, 0x0a,
+	0x21, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x69, 0x6e, 0x67, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x3d, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x08, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x22, 0xac,
+	0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x36, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xbd, 0x01,
+	0x0a, 0x15, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12,
-	0x34, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x62,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x3e, 0x0a, 0x0a, 0x5a, 0x63, 0x6c, 0x69, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x04, 0x66, 0x6f, 0x72, 0x6b, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
-	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4b, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x38, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf9,
-	0x04, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53,
+	0x6c, 0x75, 0x65, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x9a, 0x01,
+	0x0a, 0x0b, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a,
+	0x04, 0x6e, 0x63, 0x6c, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x6e, 0x63, 0x6c, 0x69, 0x12,
+	0x30, 0x0a, 0x04, 0x6c, 0x63, 0x6c, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x6c, 0x63, 0x6c,
+	0x69, 0x12, 0x27, 0x0a, 0x04, 0x7a, 0x63, 0x6c, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x5a, 0x63, 0x6c, 0x69, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x7a, 0x63, 0x6c, 0x69, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x47,
+	0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x34, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x3e, 0x0a, 0x0a, 0x5a, 0x63, 0x6c, 0x69, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x27, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4b, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x38, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xbb, 0x01, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xf0, 0x01,
+	0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x16,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73,
+	0x22, 0x8e, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x4e, 0x0a, 0x0a, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x22, 0x42, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x7e, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x7e, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x7e, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x7c, 0x0a, 0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46,
+	0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42,
+	0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x1a, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
+	0x8e, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x22, 0x88, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x44, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x1e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xfc,
+	0x05, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e,
 	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
@@ -6161,58 +10695,66 @@ var file_indexer_indexer_proto_rawDesc = []byte{
 	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
 	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x49, 0x0a, 0x19, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x49, 0x0a,
+	0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0xf9, 0x04, 0x0a, 0x18, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0xf9, 0x04, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f,
-	0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
-	0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f,
-	0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x51, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x51, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
-	0x67, 0x22, 0x49, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0xfc, 0x04, 0x0a,
-	0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04,
+	0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x49, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xba, 0x05, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04,
 	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
 	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39,
@@ -6250,141 +10792,393 @@ var file_indexer_indexer_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
 	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x4c, 0x0a, 0x1c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0xaf, 0x05, 0x0a, 0x1a, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
-	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65,
-	0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x0c, 0x73,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0b, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x49, 0x0a, 0x19,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0xf1, 0x04, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63,
-	0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x51, 0x0a, 0x15, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x18, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0xfc, 0x04, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x0a, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f,
-	0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x65, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x51, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x4c, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xaf, 0x05, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30,
+	0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x12, 0x32, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x51, 0x0a, 0x15, 0x62,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32,
-	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x4b, 0x0a, 0x1b, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe1, 0x04, 0x0a, 0x20, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
-	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
-	0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x51, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x47, 0x0a, 0x10, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x4b, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x97, 0x05, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x57, 0x0a, 0x18, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65,
-	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x57, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x22, 0x51, 0x0a, 0x21, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8d,
+	0x04, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x51, 0x0a, 0x21,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39,
+	0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x75,
+	0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
 	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x65, 0x12, 0x52, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52,
+	0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x73, 0x22, 0xc3, 0x03, 0x0a, 0x1f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x18, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f,
+	0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x72, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x56, 0x0a, 0x20, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xa0, 0x02, 0x0a, 0x2a, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x51, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x39, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4c,
+	0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54,
+	0x57, 0x4f, 0x52, 0x4b, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x52,
+	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54,
+	0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x52,
+	0x41, 0x43, 0x45, 0x52, 0x10, 0x08, 0x22, 0xd6, 0x02, 0x0a, 0x2b, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x39, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x22,
+	0xde, 0x04, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0xbb, 0x03, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32,
-	0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x57, 0x0a,
+	0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x17, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x22, 0x4e, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61,
-	0x66, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x39, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x75, 0x0a,
-	0x1f, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x52, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x14,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72,
-	0x61, 0x63, 0x65, 0x73, 0x22, 0xf1, 0x02, 0x0a, 0x1f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0xb8, 0x03, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a,
+	0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xee, 0x02, 0x0a, 0x1c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c,
 	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
@@ -6404,74 +11198,314 @@ var file_indexer_indexer_proto_rawDesc = []byte{
 	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78,
 	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x20, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
-	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
-	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x94, 0x02, 0x0a, 0x2a, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61,
-	0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x51, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x39, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x1d, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf9, 0x01,
+	0x0a, 0x27, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x7e, 0x0a, 0x05, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0c,
+	0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
+	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58, 0x45,
+	0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x22, 0x94, 0x02, 0x0a, 0x28, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x39, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xef, 0x01, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04,
-	0x4e, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x48, 0x41, 0x53, 0x48, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
-	0x4b, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x05, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
-	0x4e, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x56, 0x45,
-	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x22, 0xbe, 0x02, 0x0a, 0x2b, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03,
-	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x64, 0x73, 0x22, 0x7e, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4c, 0x4f, 0x54, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f,
+	0x44, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08,
+	0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45,
+	0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x45, 0x41, 0x43, 0x4f,
+	0x4e, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x07, 0x22, 0x90, 0x02, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e,
+	0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd3, 0x04, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x54, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0c, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x22, 0x99, 0x04, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x12, 0x39, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38,
+	0x0a, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x4e, 0x0a,
+	0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdb, 0x02,
+	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30,
+	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x65, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x22, 0x7e, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4c, 0x4f, 0x54, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c,
+	0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0c,
+	0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07,
+	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x45, 0x41,
+	0x43, 0x4f, 0x4e, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x07, 0x22, 0x90, 0x02, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xee, 0x03, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38,
+	0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x63,
+	0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x54, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x0c, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0xb4,
+	0x03, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
+	0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
 	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21,
-	0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xef, 0x01, 0x0a, 0x22, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x49, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x31, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x7e, 0x0a, 0x05, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x53, 0x4c, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x50, 0x4f, 0x43, 0x48,
-	0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x54,
-	0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49,
-	0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x06, 0x12,
-	0x19, 0x0a, 0x15, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d,
-	0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x22, 0x90, 0x02, 0x0a, 0x23, 0x4c,
-	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x63,
+	0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f,
+	0x6e, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x4e, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x82, 0x02, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x4c, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x03,
+	0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05,
+	0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x06, 0x12, 0x19, 0x0a,
+	0x15, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e,
+	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x49, 0x44, 0x45,
+	0x43, 0x41, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x08, 0x22, 0xb3, 0x02, 0x0a, 0x23, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
 	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
 	0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12,
 	0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
 	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
@@ -6480,15 +11514,208 @@ var file_indexer_indexer_proto_rawDesc = []byte{
 	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63,
 	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49,
-	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb4, 0x03,
-	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0xd7, 0x03, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x54, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73,
+	0x22, 0x9d, 0x03, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x4e, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xe9, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46,
+	0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x22, 0x7a, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44,
+	0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4c, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45,
+	0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57,
+	0x4f, 0x52, 0x4b, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f,
+	0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06,
+	0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x10, 0x07, 0x22, 0x88, 0x02, 0x0a,
+	0x22, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xac, 0x03, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f,
+	0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x0b, 0x66, 0x6f, 0x72,
+	0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0xf2, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30,
+	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4d, 0x0a,
+	0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf5, 0x01, 0x0a,
+	0x25, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x22, 0x7e, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4c, 0x4f, 0x54, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c,
+	0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0c,
+	0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07,
+	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x45, 0x41,
+	0x43, 0x4f, 0x4e, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x07, 0x22, 0x93, 0x02, 0x0a, 0x26, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x03, 0x0a, 0x19, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
 	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74,
 	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
-	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
 	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64,
 	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
@@ -6508,19 +11735,20 @@ var file_indexer_indexer_proto_rawDesc = []byte{
 	0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
 	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
 	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x39, 0x0a, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x62, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0xfa, 0x02, 0x0a, 0x17, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0xfd, 0x02, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
 	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14,
 	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f,
-	0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
 	0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
 	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56,
 	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
@@ -6537,505 +11765,332 @@ var file_indexer_indexer_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
 	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xef, 0x01, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49,
-	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x31,
-	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x7e, 0x0a, 0x05, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x53, 0x4c, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10,
-	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10,
-	0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
-	0x4e, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x06, 0x12, 0x19,
-	0x0a, 0x15, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45,
-	0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x22, 0x90, 0x02, 0x0a, 0x23, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x1b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x24, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
+	0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61,
+	0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x22, 0x89, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x44, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4c, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44,
+	0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4c,
+	0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54,
+	0x57, 0x4f, 0x52, 0x4b, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e,
+	0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x07, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x08, 0x22, 0xa8, 0x02, 0x0a,
+	0x25, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x33, 0x0a, 0x15,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xea, 0x03, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30,
+	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x32, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x5d, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x40, 0x0a, 0x10, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
+	0x6c, 0x6f, 0x62, 0x52, 0x0e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x62, 0x73, 0x22, 0xb0, 0x03, 0x0a, 0x19, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
 	0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21,
 	0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
 	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d,
-	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb4, 0x03, 0x0a,
-	0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
-	0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a,
-	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
-	0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66,
-	0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73,
-	0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33,
-	0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
-	0x0a, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x62, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0xfa, 0x02, 0x0a, 0x17, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f,
-	0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62,
-	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
-	0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65,
-	0x72, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a,
+	0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf5, 0x01, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x4c, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
-	0x32, 0x34, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x7e,
-	0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4c, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45,
-	0x50, 0x4f, 0x43, 0x48, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x52, 0x4f, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56,
-	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
-	0x4b, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x49, 0x4d,
-	0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x22, 0x93,
-	0x02, 0x0a, 0x26, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04,
-	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f,
-	0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
-	0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14,
-	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x03, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
-	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05,
-	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x61,
-	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x11,
-	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x0f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x22, 0xfd, 0x02, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d,
-	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66,
-	0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x15,
-	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x51, 0x0a, 0x1b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a,
-	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x33, 0x2e,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x05, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x08,
-	0x0a, 0x04, 0x53, 0x4c, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x50, 0x4f, 0x43,
-	0x48, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x4f, 0x4f,
-	0x54, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53,
-	0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x06,
-	0x12, 0x19, 0x0a, 0x15, 0x42, 0x45, 0x41, 0x43, 0x4f, 0x4e, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45,
-	0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x09, 0x0a, 0x05, 0x49,
-	0x4e, 0x44, 0x45, 0x58, 0x10, 0x08, 0x22, 0xa8, 0x02, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
-	0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d,
-	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x22, 0xea, 0x03, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
-	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65,
-	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
-	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x5d,
-	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x10, 0x62,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x0e, 0x62,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x22, 0xb0, 0x03,
-	0x0a, 0x19, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64,
-	0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62,
-	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x15, 0x62, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6d,
-	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
-	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x22, 0x50, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
-	0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x10, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22,
-	0xa7, 0x05, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
-	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x50, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a, 0x0c,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x10, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0xca, 0x06, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x57,
-	0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x17,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f,
-	0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x4f, 0x0a, 0x1f, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x57, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46,
+	0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe3, 0x03, 0x0a, 0x1c, 0x4c,
-	0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61,
-	0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61,
-	0x22, 0x6d, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4c, 0x0a, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
-	0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x12, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22,
-	0x99, 0x03, 0x0a, 0x1d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a,
-	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
-	0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x43, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a, 0x1b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x19, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xe3, 0x03, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66,
-	0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21,
-	0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x1e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0xa6, 0x02, 0x0a, 0x28, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f,
-	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x37,
-	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22,
-	0xa8, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44,
-	0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x41, 0x53,
-	0x48, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4e, 0x55, 0x4d,
-	0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x04,
-	0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4d,
-	0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x10,
-	0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x06,
-	0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49,
-	0x4f, 0x4e, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x58,
-	0x54, 0x52, 0x41, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x08, 0x22, 0xe6, 0x02, 0x0a, 0x29, 0x4c,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x39, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x22, 0x6d, 0x0a, 0x1d, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x99, 0x03, 0x0a, 0x1d, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x18,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
+	0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x1e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa6, 0x02, 0x0a, 0x28, 0x4c,
 	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
 	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x39, 0x0a, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x32, 0xf8, 0x15, 0x0a, 0x07, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12,
-	0x44, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x2e, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x28, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59,
-	0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x20, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0c,
+	0x0a, 0x08, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
+	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58, 0x45,
+	0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x44, 0x45, 0x5f,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x49,
+	0x45, 0x4e, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x14, 0x0a,
+	0x10, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x10, 0x08, 0x22, 0xe6, 0x02, 0x0a, 0x29, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x39, 0x0a,
+	0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x32, 0x83, 0x29, 0x0a,
+	0x07, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x61, 0x6e, 0x64,
+	0x73, 0x68, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48,
+	0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61,
+	0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x7a, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
 	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x64,
 	0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
@@ -7061,125 +12116,271 @@ var file_indexer_indexer_proto_rawDesc = []byte{
 	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
 	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
 	0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x22, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83,
-	0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x12, 0x2e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x23, 0x2e, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x21, 0x2e,
+	0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62,
+	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x20, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x62,
+	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x6c,
+	0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x59, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x0f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x65, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
+	0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x2e,
 	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x23, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a,
+	0x1e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x2e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
 	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x22, 0x2e, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x19, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x21, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
 	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x6e, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71,
+	0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
 	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x71, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
-	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x92, 0x01, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x92, 0x01, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
 	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72,
-	0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x34, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x6b, 0x0a, 0x16, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63,
+	0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x12, 0x26, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x24, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6e, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x27, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63,
 	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x68, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x16, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43,
 	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x8c, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3a,
-	0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x74, 0x68,
-	0x70, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x70, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6f, 0x6f, 0x72,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6f,
-	0x6f, 0x72, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8c, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x31, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x4f, 0x72,
+	0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1f, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x5e, 0x0a,
+	0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x5e, 0x0a,
+	0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x12, 0x21, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x5b, 0x0a,
+	0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x12, 0x20, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x67, 0x0a, 0x14, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42,
+	0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x28, 0x01, 0x12, 0x64, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x76, 0x0a, 0x19, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
+	0x01, 0x12, 0x6d, 0x0a, 0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x26, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
+	0x12, 0x70, 0x0a, 0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x27, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x28, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x65, 0x74, 0x68, 0x70, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x70, 0x73, 0x2f, 0x74, 0x72, 0x61,
+	0x63, 0x6f, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74,
+	0x72, 0x61, 0x63, 0x6f, 0x6f, 0x72, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7194,339 +12395,579 @@ func file_indexer_indexer_proto_rawDescGZIP() []byte {
 	return file_indexer_indexer_proto_rawDescData
 }
 
-var file_indexer_indexer_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_indexer_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_indexer_indexer_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_indexer_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 109)
 var file_indexer_indexer_proto_goTypes = []interface{}{
 	(ListUniqueExecutionBlockTraceValuesRequest_Field)(0), // 0: indexer.ListUniqueExecutionBlockTraceValuesRequest.Field
-	(ListUniqueBeaconStateValuesRequest_Field)(0),         // 1: indexer.ListUniqueBeaconStateValuesRequest.Field
-	(ListUniqueBeaconBlockValuesRequest_Field)(0),         // 2: indexer.ListUniqueBeaconBlockValuesRequest.Field
-	(ListUniqueBeaconBadBlockValuesRequest_Field)(0),      // 3: indexer.ListUniqueBeaconBadBlockValuesRequest.Field
-	(ListUniqueBeaconBadBlobValuesRequest_Field)(0),       // 4: indexer.ListUniqueBeaconBadBlobValuesRequest.Field
-	(ListUniqueExecutionBadBlockValuesRequest_Field)(0),   // 5: indexer.ListUniqueExecutionBadBlockValuesRequest.Field
-	(*BeaconState)(nil),                                 // 6: indexer.BeaconState
-	(*BeaconBlock)(nil),                                 // 7: indexer.BeaconBlock
-	(*BeaconBadBlock)(nil),                              // 8: indexer.BeaconBadBlock
-	(*BeaconBadBlob)(nil),                               // 9: indexer.BeaconBadBlob
-	(*ExecutionBlockTrace)(nil),                         // 10: indexer.ExecutionBlockTrace
-	(*ExecutionBadBlock)(nil),                           // 11: indexer.ExecutionBadBlock
-	(*Config)(nil),                                      // 12: indexer.Config
-	(*EthereumConfig)(nil),                              // 13: indexer.EthereumConfig
-	(*EthereumNetworkConfig)(nil),                       // 14: indexer.EthereumNetworkConfig
-	(*ToolsConfig)(nil),                                 // 15: indexer.ToolsConfig
-	(*GitRepositoryConfig)(nil),                         // 16: indexer.GitRepositoryConfig
-	(*ZcliConfig)(nil),                                  // 17: indexer.ZcliConfig
-	(*GetConfigRequest)(nil),                            // 18: indexer.GetConfigRequest
-	(*GetConfigResponse)(nil),                           // 19: indexer.GetConfigResponse
-	(*GetStorageHandshakeTokenRequest)(nil),             // 20: indexer.GetStorageHandshakeTokenRequest
-	(*GetStorageHandshakeTokenResponse)(nil),            // 21: indexer.GetStorageHandshakeTokenResponse
-	(*CreateBeaconStateRequest)(nil),                    // 22: indexer.CreateBeaconStateRequest
-	(*CreateBeaconStateResponse)(nil),                   // 23: indexer.CreateBeaconStateResponse
-	(*CreateBeaconBlockRequest)(nil),                    // 24: indexer.CreateBeaconBlockRequest
-	(*CreateBeaconBlockResponse)(nil),                   // 25: indexer.CreateBeaconBlockResponse
-	(*CreateBeaconBadBlockRequest)(nil),                 // 26: indexer.CreateBeaconBadBlockRequest
-	(*CreateBeaconBadBlockResponse)(nil),                // 27: indexer.CreateBeaconBadBlockResponse
-	(*CreateBeaconBadBlobRequest)(nil),                  // 28: indexer.CreateBeaconBadBlobRequest
-	(*CreateBeaconBadBlobResponse)(nil),                 // 29: indexer.CreateBeaconBadBlobResponse
-	(*CreateExecutionBlockTraceRequest)(nil),            // 30: indexer.CreateExecutionBlockTraceRequest
-	(*CreateExecutionBlockTraceResponse)(nil),           // 31: indexer.CreateExecutionBlockTraceResponse
-	(*ListExecutionBlockTraceRequest)(nil),              // 32: indexer.ListExecutionBlockTraceRequest
-	(*ListExecutionBlockTraceResponse)(nil),             // 33: indexer.ListExecutionBlockTraceResponse
-	(*CountExecutionBlockTraceRequest)(nil),             // 34: indexer.CountExecutionBlockTraceRequest
-	(*CountExecutionBlockTraceResponse)(nil),            // 35: indexer.CountExecutionBlockTraceResponse
-	(*ListUniqueExecutionBlockTraceValuesRequest)(nil),  // 36: indexer.ListUniqueExecutionBlockTraceValuesRequest
-	(*ListUniqueExecutionBlockTraceValuesResponse)(nil), // 37: indexer.ListUniqueExecutionBlockTraceValuesResponse
-	(*ListUniqueBeaconStateValuesRequest)(nil),          // 38: indexer.ListUniqueBeaconStateValuesRequest
-	(*ListUniqueBeaconStateValuesResponse)(nil),         // 39: indexer.ListUniqueBeaconStateValuesResponse
-	(*ListBeaconStateRequest)(nil),                      // 40: indexer.ListBeaconStateRequest
-	(*ListBeaconStateResponse)(nil),                     // 41: indexer.ListBeaconStateResponse
-	(*CountBeaconStateRequest)(nil),                     // 42: indexer.CountBeaconStateRequest
-	(*CountBeaconStateResponse)(nil),                    // 43: indexer.CountBeaconStateResponse
-	(*ListUniqueBeaconBlockValuesRequest)(nil),          // 44: indexer.ListUniqueBeaconBlockValuesRequest
-	(*ListUniqueBeaconBlockValuesResponse)(nil),         // 45: indexer.ListUniqueBeaconBlockValuesResponse
-	(*ListBeaconBlockRequest)(nil),                      // 46: indexer.ListBeaconBlockRequest
-	(*ListBeaconBlockResponse)(nil),                     // 47: indexer.ListBeaconBlockResponse
-	(*CountBeaconBlockRequest)(nil),                     // 48: indexer.CountBeaconBlockRequest
-	(*CountBeaconBlockResponse)(nil),                    // 49: indexer.CountBeaconBlockResponse
-	(*ListUniqueBeaconBadBlockValuesRequest)(nil),       // 50: indexer.ListUniqueBeaconBadBlockValuesRequest
-	(*ListUniqueBeaconBadBlockValuesResponse)(nil),      // 51: indexer.ListUniqueBeaconBadBlockValuesResponse
-	(*ListBeaconBadBlockRequest)(nil),                   // 52: indexer.ListBeaconBadBlockRequest
-	(*ListBeaconBadBlockResponse)(nil),                  // 53: indexer.ListBeaconBadBlockResponse
-	(*CountBeaconBadBlockRequest)(nil),                  // 54: indexer.CountBeaconBadBlockRequest
-	(*CountBeaconBadBlockResponse)(nil),                 // 55: indexer.CountBeaconBadBlockResponse
-	(*ListUniqueBeaconBadBlobValuesRequest)(nil),        // 56: indexer.ListUniqueBeaconBadBlobValuesRequest
-	(*ListUniqueBeaconBadBlobValuesResponse)(nil),       // 57: indexer.ListUniqueBeaconBadBlobValuesResponse
-	(*ListBeaconBadBlobRequest)(nil),                    // 58: indexer.ListBeaconBadBlobRequest
-	(*ListBeaconBadBlobResponse)(nil),                   // 59: indexer.ListBeaconBadBlobResponse
-	(*CountBeaconBadBlobRequest)(nil),                   // 60: indexer.CountBeaconBadBlobRequest
-	(*CountBeaconBadBlobResponse)(nil),                  // 61: indexer.CountBeaconBadBlobResponse
-	(*PaginationCursor)(nil),                            // 62: indexer.PaginationCursor
-	(*CreateExecutionBadBlockRequest)(nil),              // 63: indexer.CreateExecutionBadBlockRequest
-	(*CreateExecutionBadBlockResponse)(nil),             // 64: indexer.CreateExecutionBadBlockResponse
-	(*ListExecutionBadBlockRequest)(nil),                // 65: indexer.ListExecutionBadBlockRequest
-	(*ListExecutionBadBlockResponse)(nil),               // 66: indexer.ListExecutionBadBlockResponse
-	(*CountExecutionBadBlockRequest)(nil),               // 67: indexer.CountExecutionBadBlockRequest
-	(*CountExecutionBadBlockResponse)(nil),              // 68: indexer.CountExecutionBadBlockResponse
-	(*ListUniqueExecutionBadBlockValuesRequest)(nil),    // 69: indexer.ListUniqueExecutionBadBlockValuesRequest
-	(*ListUniqueExecutionBadBlockValuesResponse)(nil),   // 70: indexer.ListUniqueExecutionBadBlockValuesResponse
-	(*wrapperspb.StringValue)(nil),                      // 71: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),                       // 72: google.protobuf.Timestamp
-	(*wrapperspb.UInt64Value)(nil),                      // 73: google.protobuf.UInt64Value
-	(*wrapperspb.Int64Value)(nil),                       // 74: google.protobuf.Int64Value
+	(ListUniqueExecutionWitnessValuesRequest_Field)(0),    // 1: indexer.ListUniqueExecutionWitnessValuesRequest.Field
+	(ListUniqueBeaconStateValuesRequest_Field)(0),         // 2: indexer.ListUniqueBeaconStateValuesRequest.Field
+	(ListUniqueBeaconBlockValuesRequest_Field)(0),         // 3: indexer.ListUniqueBeaconBlockValuesRequest.Field
+	(ListUniqueBlobSidecarValuesRequest_Field)(0),         // 4: indexer.ListUniqueBlobSidecarValuesRequest.Field
+	(ListUniqueForkChoiceValuesRequest_Field)(0),          // 5: indexer.ListUniqueForkChoiceValuesRequest.Field
+	(ListUniqueBeaconBadBlockValuesRequest_Field)(0),      // 6: indexer.ListUniqueBeaconBadBlockValuesRequest.Field
+	(ListUniqueBeaconBadBlobValuesRequest_Field)(0),       // 7: indexer.ListUniqueBeaconBadBlobValuesRequest.Field
+	(ListUniqueExecutionBadBlockValuesRequest_Field)(0),   // 8: indexer.ListUniqueExecutionBadBlockValuesRequest.Field
+	(*BeaconState)(nil),                                 // 9: indexer.BeaconState
+	(*BeaconBlock)(nil),                                 // 10: indexer.BeaconBlock
+	(*BlobSidecar)(nil),                                 // 11: indexer.BlobSidecar
+	(*ForkChoice)(nil),                                  // 12: indexer.ForkChoice
+	(*BeaconBadBlock)(nil),                              // 13: indexer.BeaconBadBlock
+	(*BeaconBadBlob)(nil),                               // 14: indexer.BeaconBadBlob
+	(*ExecutionBlockTrace)(nil),                         // 15: indexer.ExecutionBlockTrace
+	(*ExecutionWitness)(nil),                            // 16: indexer.ExecutionWitness
+	(*ExecutionBadBlock)(nil),                           // 17: indexer.ExecutionBadBlock
+	(*StateDivergence)(nil),                             // 18: indexer.StateDivergence
+	(*Config)(nil),                                      // 19: indexer.Config
+	(*EthereumConfig)(nil),                              // 20: indexer.EthereumConfig
+	(*EthereumNetworkConfig)(nil),                       // 21: indexer.EthereumNetworkConfig
+	(*ToolsConfig)(nil),                                 // 22: indexer.ToolsConfig
+	(*GitRepositoryConfig)(nil),                         // 23: indexer.GitRepositoryConfig
+	(*ZcliConfig)(nil),                                  // 24: indexer.ZcliConfig
+	(*GetConfigRequest)(nil),                            // 25: indexer.GetConfigRequest
+	(*GetConfigResponse)(nil),                           // 26: indexer.GetConfigResponse
+	(*GetStorageHandshakeTokenRequest)(nil),             // 27: indexer.GetStorageHandshakeTokenRequest
+	(*GetStorageHandshakeTokenResponse)(nil),            // 28: indexer.GetStorageHandshakeTokenResponse
+	(*MarkOrphanedRequest)(nil),                         // 29: indexer.MarkOrphanedRequest
+	(*MarkOrphanedResponse)(nil),                        // 30: indexer.MarkOrphanedResponse
+	(*ListIndexedKeysRequest)(nil),                      // 31: indexer.ListIndexedKeysRequest
+	(*IndexedKey)(nil),                                  // 32: indexer.IndexedKey
+	(*ListIndexedKeysResponse)(nil),                     // 33: indexer.ListIndexedKeysResponse
+	(*UploadBeaconStateRequest)(nil),                    // 34: indexer.UploadBeaconStateRequest
+	(*UploadBeaconBlockRequest)(nil),                    // 35: indexer.UploadBeaconBlockRequest
+	(*UploadBlobSidecarRequest)(nil),                    // 36: indexer.UploadBlobSidecarRequest
+	(*UploadForkChoiceRequest)(nil),                     // 37: indexer.UploadForkChoiceRequest
+	(*UploadBeaconBadBlockRequest)(nil),                 // 38: indexer.UploadBeaconBadBlockRequest
+	(*UploadBeaconBadBlobRequest)(nil),                  // 39: indexer.UploadBeaconBadBlobRequest
+	(*UploadExecutionBlockTraceRequest)(nil),            // 40: indexer.UploadExecutionBlockTraceRequest
+	(*UploadExecutionWitnessRequest)(nil),               // 41: indexer.UploadExecutionWitnessRequest
+	(*UploadExecutionBadBlockRequest)(nil),              // 42: indexer.UploadExecutionBadBlockRequest
+	(*CreateBeaconStateRequest)(nil),                    // 43: indexer.CreateBeaconStateRequest
+	(*CreateBeaconStateResponse)(nil),                   // 44: indexer.CreateBeaconStateResponse
+	(*CreateBeaconBlockRequest)(nil),                    // 45: indexer.CreateBeaconBlockRequest
+	(*CreateBeaconBlockResponse)(nil),                   // 46: indexer.CreateBeaconBlockResponse
+	(*CreateBlobSidecarRequest)(nil),                    // 47: indexer.CreateBlobSidecarRequest
+	(*CreateBlobSidecarResponse)(nil),                   // 48: indexer.CreateBlobSidecarResponse
+	(*CreateForkChoiceRequest)(nil),                     // 49: indexer.CreateForkChoiceRequest
+	(*CreateForkChoiceResponse)(nil),                    // 50: indexer.CreateForkChoiceResponse
+	(*CreateBeaconBadBlockRequest)(nil),                 // 51: indexer.CreateBeaconBadBlockRequest
+	(*CreateBeaconBadBlockResponse)(nil),                // 52: indexer.CreateBeaconBadBlockResponse
+	(*CreateBeaconBadBlobRequest)(nil),                  // 53: indexer.CreateBeaconBadBlobRequest
+	(*CreateBeaconBadBlobResponse)(nil),                 // 54: indexer.CreateBeaconBadBlobResponse
+	(*CreateExecutionBlockTraceRequest)(nil),            // 55: indexer.CreateExecutionBlockTraceRequest
+	(*CreateExecutionBlockTraceResponse)(nil),           // 56: indexer.CreateExecutionBlockTraceResponse
+	(*ListExecutionBlockTraceRequest)(nil),              // 57: indexer.ListExecutionBlockTraceRequest
+	(*ListExecutionBlockTraceResponse)(nil),             // 58: indexer.ListExecutionBlockTraceResponse
+	(*CountExecutionBlockTraceRequest)(nil),             // 59: indexer.CountExecutionBlockTraceRequest
+	(*CountExecutionBlockTraceResponse)(nil),            // 60: indexer.CountExecutionBlockTraceResponse
+	(*ListUniqueExecutionBlockTraceValuesRequest)(nil),  // 61: indexer.ListUniqueExecutionBlockTraceValuesRequest
+	(*ListUniqueExecutionBlockTraceValuesResponse)(nil), // 62: indexer.ListUniqueExecutionBlockTraceValuesResponse
+	(*CreateExecutionWitnessRequest)(nil),               // 63: indexer.CreateExecutionWitnessRequest
+	(*CreateExecutionWitnessResponse)(nil),              // 64: indexer.CreateExecutionWitnessResponse
+	(*ListExecutionWitnessRequest)(nil),                 // 65: indexer.ListExecutionWitnessRequest
+	(*ListExecutionWitnessResponse)(nil),                // 66: indexer.ListExecutionWitnessResponse
+	(*CountExecutionWitnessRequest)(nil),                // 67: indexer.CountExecutionWitnessRequest
+	(*CountExecutionWitnessResponse)(nil),               // 68: indexer.CountExecutionWitnessResponse
+	(*ListUniqueExecutionWitnessValuesRequest)(nil),     // 69: indexer.ListUniqueExecutionWitnessValuesRequest
+	(*ListUniqueExecutionWitnessValuesResponse)(nil),    // 70: indexer.ListUniqueExecutionWitnessValuesResponse
+	(*ListUniqueBeaconStateValuesRequest)(nil),          // 71: indexer.ListUniqueBeaconStateValuesRequest
+	(*ListUniqueBeaconStateValuesResponse)(nil),         // 72: indexer.ListUniqueBeaconStateValuesResponse
+	(*ListBeaconStateRequest)(nil),                      // 73: indexer.ListBeaconStateRequest
+	(*ListBeaconStateResponse)(nil),                     // 74: indexer.ListBeaconStateResponse
+	(*CountBeaconStateRequest)(nil),                     // 75: indexer.CountBeaconStateRequest
+	(*CountBeaconStateResponse)(nil),                    // 76: indexer.CountBeaconStateResponse
+	(*ListStateDivergencesRequest)(nil),                 // 77: indexer.ListStateDivergencesRequest
+	(*ListStateDivergencesResponse)(nil),                // 78: indexer.ListStateDivergencesResponse
+	(*ListUniqueBeaconBlockValuesRequest)(nil),          // 79: indexer.ListUniqueBeaconBlockValuesRequest
+	(*ListUniqueBeaconBlockValuesResponse)(nil),         // 80: indexer.ListUniqueBeaconBlockValuesResponse
+	(*ListBeaconBlockRequest)(nil),                      // 81: indexer.ListBeaconBlockRequest
+	(*ListBeaconBlockResponse)(nil),                     // 82: indexer.ListBeaconBlockResponse
+	(*CountBeaconBlockRequest)(nil),                     // 83: indexer.CountBeaconBlockRequest
+	(*CountBeaconBlockResponse)(nil),                    // 84: indexer.CountBeaconBlockResponse
+	(*ListUniqueBlobSidecarValuesRequest)(nil),          // 85: indexer.ListUniqueBlobSidecarValuesRequest
+	(*ListUniqueBlobSidecarValuesResponse)(nil),         // 86: indexer.ListUniqueBlobSidecarValuesResponse
+	(*ListBlobSidecarRequest)(nil),                      // 87: indexer.ListBlobSidecarRequest
+	(*ListBlobSidecarResponse)(nil),                     // 88: indexer.ListBlobSidecarResponse
+	(*CountBlobSidecarRequest)(nil),                     // 89: indexer.CountBlobSidecarRequest
+	(*CountBlobSidecarResponse)(nil),                    // 90: indexer.CountBlobSidecarResponse
+	(*ListUniqueForkChoiceValuesRequest)(nil),           // 91: indexer.ListUniqueForkChoiceValuesRequest
+	(*ListUniqueForkChoiceValuesResponse)(nil),          // 92: indexer.ListUniqueForkChoiceValuesResponse
+	(*ListForkChoiceRequest)(nil),                       // 93: indexer.ListForkChoiceRequest
+	(*ListForkChoiceResponse)(nil),                      // 94: indexer.ListForkChoiceResponse
+	(*CountForkChoiceRequest)(nil),                      // 95: indexer.CountForkChoiceRequest
+	(*CountForkChoiceResponse)(nil),                     // 96: indexer.CountForkChoiceResponse
+	(*ListUniqueBeaconBadBlockValuesRequest)(nil),       // 97: indexer.ListUniqueBeaconBadBlockValuesRequest
+	(*ListUniqueBeaconBadBlockValuesResponse)(nil),      // 98: indexer.ListUniqueBeaconBadBlockValuesResponse
+	(*ListBeaconBadBlockRequest)(nil),                   // 99: indexer.ListBeaconBadBlockRequest
+	(*ListBeaconBadBlockResponse)(nil),                  // 100: indexer.ListBeaconBadBlockResponse
+	(*CountBeaconBadBlockRequest)(nil),                  // 101: indexer.CountBeaconBadBlockRequest
+	(*CountBeaconBadBlockResponse)(nil),                 // 102: indexer.CountBeaconBadBlockResponse
+	(*ListUniqueBeaconBadBlobValuesRequest)(nil),        // 103: indexer.ListUniqueBeaconBadBlobValuesRequest
+	(*ListUniqueBeaconBadBlobValuesResponse)(nil),       // 104: indexer.ListUniqueBeaconBadBlobValuesResponse
+	(*ListBeaconBadBlobRequest)(nil),                    // 105: indexer.ListBeaconBadBlobRequest
+	(*ListBeaconBadBlobResponse)(nil),                   // 106: indexer.ListBeaconBadBlobResponse
+	(*CountBeaconBadBlobRequest)(nil),                   // 107: indexer.CountBeaconBadBlobRequest
+	(*CountBeaconBadBlobResponse)(nil),                  // 108: indexer.CountBeaconBadBlobResponse
+	(*PaginationCursor)(nil),                            // 109: indexer.PaginationCursor
+	(*CreateExecutionBadBlockRequest)(nil),              // 110: indexer.CreateExecutionBadBlockRequest
+	(*CreateExecutionBadBlockResponse)(nil),             // 111: indexer.CreateExecutionBadBlockResponse
+	(*ListExecutionBadBlockRequest)(nil),                // 112: indexer.ListExecutionBadBlockRequest
+	(*ListExecutionBadBlockResponse)(nil),               // 113: indexer.ListExecutionBadBlockResponse
+	(*CountExecutionBadBlockRequest)(nil),               // 114: indexer.CountExecutionBadBlockRequest
+	(*CountExecutionBadBlockResponse)(nil),              // 115: indexer.CountExecutionBadBlockResponse
+	(*ListUniqueExecutionBadBlockValuesRequest)(nil),    // 116: indexer.ListUniqueExecutionBadBlockValuesRequest
+	(*ListUniqueExecutionBadBlockValuesResponse)(nil),   // 117: indexer.ListUniqueExecutionBadBlockValuesResponse
+	(*wrapperspb.StringValue)(nil),                      // 118: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),                       // 119: google.protobuf.Timestamp
+	(*wrapperspb.UInt64Value)(nil),                      // 120: google.protobuf.UInt64Value
+	(*wrapperspb.BoolValue)(nil),                        // 121: google.protobuf.BoolValue
+	(*wrapperspb.Int64Value)(nil),                       // 122: google.protobuf.Int64Value
 }
 var file_indexer_indexer_proto_depIdxs = []int32{
-	71,  // 0: indexer.BeaconState.id:type_name -> google.protobuf.StringValue
-	71,  // 1: indexer.BeaconState.node:type_name -> google.protobuf.StringValue
-	72,  // 2: indexer.BeaconState.fetched_at:type_name -> google.protobuf.Timestamp
-	73,  // 3: indexer.BeaconState.slot:type_name -> google.protobuf.UInt64Value
-	73,  // 4: indexer.BeaconState.epoch:type_name -> google.protobuf.UInt64Value
-	71,  // 5: indexer.BeaconState.state_root:type_name -> google.protobuf.StringValue
-	71,  // 6: indexer.BeaconState.node_version:type_name -> google.protobuf.StringValue
-	71,  // 7: indexer.BeaconState.location:type_name -> google.protobuf.StringValue
-	71,  // 8: indexer.BeaconState.network:type_name -> google.protobuf.StringValue
-	71,  // 9: indexer.BeaconState.beacon_implementation:type_name -> google.protobuf.StringValue
-	71,  // 10: indexer.BeaconState.content_encoding:type_name -> google.protobuf.StringValue
-	71,  // 11: indexer.BeaconBlock.id:type_name -> google.protobuf.StringValue
-	71,  // 12: indexer.BeaconBlock.node:type_name -> google.protobuf.StringValue
-	72,  // 13: indexer.BeaconBlock.fetched_at:type_name -> google.protobuf.Timestamp
-	73,  // 14: indexer.BeaconBlock.slot:type_name -> google.protobuf.UInt64Value
-	73,  // 15: indexer.BeaconBlock.epoch:type_name -> google.protobuf.UInt64Value
-	71,  // 16: indexer.BeaconBlock.block_root:type_name -> google.protobuf.StringValue
-	71,  // 17: indexer.BeaconBlock.node_version:type_name -> google.protobuf.StringValue
-	71,  // 18: indexer.BeaconBlock.location:type_name -> google.protobuf.StringValue
-	71,  // 19: indexer.BeaconBlock.network:type_name -> google.protobuf.StringValue
-	71,  // 20: indexer.BeaconBlock.beacon_implementation:type_name -> google.protobuf.StringValue
-	71,  // 21: indexer.BeaconBlock.content_encoding:type_name -> google.protobuf.StringValue
-	71,  // 22: indexer.BeaconBadBlock.id:type_name -> google.protobuf.StringValue
-	71,  // 23: indexer.BeaconBadBlock.node:type_name -> google.protobuf.StringValue
-	72,  // 24: indexer.BeaconBadBlock.fetched_at:type_name -> google.protobuf.Timestamp
-	73,  // 25: indexer.BeaconBadBlock.slot:type_name -> google.protobuf.UInt64Value
-	73,  // 26: indexer.BeaconBadBlock.epoch:type_name -> google.protobuf.UInt64Value
-	71,  // 27: indexer.BeaconBadBlock.block_root:type_name -> google.protobuf.StringValue
-	71,  // 28: indexer.BeaconBadBlock.node_version:type_name -> google.protobuf.StringValue
-	71,  // 29: indexer.BeaconBadBlock.location:type_name -> google.protobuf.StringValue
-	71,  // 30: indexer.BeaconBadBlock.network:type_name -> google.protobuf.StringValue
-	71,  // 31: indexer.BeaconBadBlock.beacon_implementation:type_name -> google.protobuf.StringValue
-	71,  // 32: indexer.BeaconBadBlock.content_encoding:type_name -> google.protobuf.StringValue
-	71,  // 33: indexer.BeaconBadBlob.id:type_name -> google.protobuf.StringValue
-	71,  // 34: indexer.BeaconBadBlob.node:type_name -> google.protobuf.StringValue
-	72,  // 35: indexer.BeaconBadBlob.fetched_at:type_name -> google.protobuf.Timestamp
-	73,  // 36: indexer.BeaconBadBlob.slot:type_name -> google.protobuf.UInt64Value
-	73,  // 37: indexer.BeaconBadBlob.epoch:type_name -> google.protobuf.UInt64Value
-	71,  // 38: indexer.BeaconBadBlob.block_root:type_name -> google.protobuf.StringValue
-	71,  // 39: indexer.BeaconBadBlob.node_version:type_name -> google.protobuf.StringValue
-	71,  // 40: indexer.BeaconBadBlob.location:type_name -> google.protobuf.StringValue
-	71,  // 41: indexer.BeaconBadBlob.network:type_name -> google.protobuf.StringValue
-	71,  // 42: indexer.BeaconBadBlob.beacon_implementation:type_name -> google.protobuf.StringValue
-	73,  // 43: indexer.BeaconBadBlob.index:type_name -> google.protobuf.UInt64Value
-	71,  // 44: indexer.BeaconBadBlob.content_encoding:type_name -> google.protobuf.StringValue
-	71,  // 45: indexer.ExecutionBlockTrace.id:type_name -> google.protobuf.StringValue
-	71,  // 46: indexer.ExecutionBlockTrace.node:type_name -> google.protobuf.StringValue
-	72,  // 47: indexer.ExecutionBlockTrace.fetched_at:type_name -> google.protobuf.Timestamp
-	71,  // 48: indexer.ExecutionBlockTrace.block_hash:type_name -> google.protobuf.StringValue
-	74,  // 49: indexer.ExecutionBlockTrace.block_number:type_name -> google.protobuf.Int64Value
-	71,  // 50: indexer.ExecutionBlockTrace.location:type_name -> google.protobuf.StringValue
-	71,  // 51: indexer.ExecutionBlockTrace.network:type_name -> google.protobuf.StringValue
-	71,  // 52: indexer.ExecutionBlockTrace.execution_implementation:type_name -> google.protobuf.StringValue
-	71,  // 53: indexer.ExecutionBlockTrace.node_version:type_name -> google.protobuf.StringValue
-	71,  // 54: indexer.ExecutionBlockTrace.content_encoding:type_name -> google.protobuf.StringValue
-	71,  // 55: indexer.ExecutionBadBlock.id:type_name -> google.protobuf.StringValue
-	71,  // 56: indexer.ExecutionBadBlock.node:type_name -> google.protobuf.StringValue
-	72,  // 57: indexer.ExecutionBadBlock.fetched_at:type_name -> google.protobuf.Timestamp
-	71,  // 58: indexer.ExecutionBadBlock.block_hash:type_name -> google.protobuf.StringValue
-	74,  // 59: indexer.ExecutionBadBlock.block_number:type_name -> google.protobuf.Int64Value
-	71,  // 60: indexer.ExecutionBadBlock.location:type_name -> google.protobuf.StringValue
-	71,  // 61: indexer.ExecutionBadBlock.network:type_name -> google.protobuf.StringValue
-	71,  // 62: indexer.ExecutionBadBlock.execution_implementation:type_name -> google.protobuf.StringValue
-	71,  // 63: indexer.ExecutionBadBlock.node_version:type_name -> google.protobuf.StringValue
-	71,  // 64: indexer.ExecutionBadBlock.block_extra_data:type_name -> google.protobuf.StringValue
-	71,  // 65: indexer.ExecutionBadBlock.content_encoding:type_name -> google.protobuf.StringValue
-	13,  // 66: indexer.Config.ethereum:type_name -> indexer.EthereumConfig
-	71,  // 67: indexer.EthereumConfig.network:type_name -> google.protobuf.StringValue
-	14,  // 68: indexer.EthereumConfig.config:type_name -> indexer.EthereumNetworkConfig
-	15,  // 69: indexer.EthereumConfig.tools:type_name -> indexer.ToolsConfig
-	71,  // 70: indexer.EthereumNetworkConfig.repository:type_name -> google.protobuf.StringValue
-	71,  // 71: indexer.EthereumNetworkConfig.branch:type_name -> google.protobuf.StringValue
-	71,  // 72: indexer.EthereumNetworkConfig.path:type_name -> google.protobuf.StringValue
-	16,  // 73: indexer.ToolsConfig.ncli:type_name -> indexer.GitRepositoryConfig
-	16,  // 74: indexer.ToolsConfig.lcli:type_name -> indexer.GitRepositoryConfig
-	17,  // 75: indexer.ToolsConfig.zcli:type_name -> indexer.ZcliConfig
-	71,  // 76: indexer.GitRepositoryConfig.repository:type_name -> google.protobuf.StringValue
-	71,  // 77: indexer.GitRepositoryConfig.branch:type_name -> google.protobuf.StringValue
-	71,  // 78: indexer.ZcliConfig.fork:type_name -> google.protobuf.StringValue
-	12,  // 79: indexer.GetConfigResponse.config:type_name -> indexer.Config
-	71,  // 80: indexer.CreateBeaconStateRequest.node:type_name -> google.protobuf.StringValue
-	72,  // 81: indexer.CreateBeaconStateRequest.fetched_at:type_name -> google.protobuf.Timestamp
-	73,  // 82: indexer.CreateBeaconStateRequest.slot:type_name -> google.protobuf.UInt64Value
-	73,  // 83: indexer.CreateBeaconStateRequest.epoch:type_name -> google.protobuf.UInt64Value
-	71,  // 84: indexer.CreateBeaconStateRequest.state_root:type_name -> google.protobuf.StringValue
-	71,  // 85: indexer.CreateBeaconStateRequest.node_version:type_name -> google.protobuf.StringValue
-	71,  // 86: indexer.CreateBeaconStateRequest.location:type_name -> google.protobuf.StringValue
-	71,  // 87: indexer.CreateBeaconStateRequest.network:type_name -> google.protobuf.StringValue
-	71,  // 88: indexer.CreateBeaconStateRequest.beacon_implementation:type_name -> google.protobuf.StringValue
-	71,  // 89: indexer.CreateBeaconStateRequest.content_encoding:type_name -> google.protobuf.StringValue
-	71,  // 90: indexer.CreateBeaconStateResponse.id:type_name -> google.protobuf.StringValue
-	71,  // 91: indexer.CreateBeaconBlockRequest.node:type_name -> google.protobuf.StringValue
-	72,  // 92: indexer.CreateBeaconBlockRequest.fetched_at:type_name -> google.protobuf.Timestamp
-	73,  // 93: indexer.CreateBeaconBlockRequest.slot:type_name -> google.protobuf.UInt64Value
-	73,  // 94: indexer.CreateBeaconBlockRequest.epoch:type_name -> google.protobuf.UInt64Value
-	71,  // 95: indexer.CreateBeaconBlockRequest.block_root:type_name -> google.protobuf.StringValue
-	71,  // 96: indexer.CreateBeaconBlockRequest.node_version:type_name -> google.protobuf.StringValue
-	71,  // 97: indexer.CreateBeaconBlockRequest.location:type_name -> google.protobuf.StringValue
-	71,  // 98: indexer.CreateBeaconBlockRequest.network:type_name -> google.protobuf.StringValue
-	71,  // 99: indexer.CreateBeaconBlockRequest.beacon_implementation:type_name -> google.protobuf.StringValue
-	71,  // 100: indexer.CreateBeaconBlockRequest.content_encoding:type_name -> google.protobuf.StringValue
-	71,  // 101: indexer.CreateBeaconBlockResponse.id:type_name -> google.protobuf.StringValue
-	71,  // 102: indexer.CreateBeaconBadBlockRequest.node:type_name -> google.protobuf.StringValue
-	72,  // 103: indexer.CreateBeaconBadBlockRequest.fetched_at:type_name -> google.protobuf.Timestamp
-	73,  // 104: indexer.CreateBeaconBadBlockRequest.slot:type_name -> google.protobuf.UInt64Value
-	73,  // 105: indexer.CreateBeaconBadBlockRequest.epoch:type_name -> google.protobuf.UInt64Value
-	71,  // 106: indexer.CreateBeaconBadBlockRequest.block_root:type_name -> google.protobuf.StringValue
-	71,  // 107: indexer.CreateBeaconBadBlockRequest.node_version:type_name -> google.protobuf.StringValue
-	71,  // 108: indexer.CreateBeaconBadBlockRequest.location:type_name -> google.protobuf.StringValue
-	71,  // 109: indexer.CreateBeaconBadBlockRequest.network:type_name -> google.protobuf.StringValue
-	71,  // 110: indexer.CreateBeaconBadBlockRequest.beacon_implementation:type_name -> google.protobuf.StringValue
-	71,  // 111: indexer.CreateBeaconBadBlockRequest.content_encoding:type_name -> google.protobuf.StringValue
-	71,  // 112: indexer.CreateBeaconBadBlockResponse.id:type_name -> google.protobuf.StringValue
-	71,  // 113: indexer.CreateBeaconBadBlobRequest.node:type_name -> google.protobuf.StringValue
-	72,  // 114: indexer.CreateBeaconBadBlobRequest.fetched_at:type_name -> google.protobuf.Timestamp
-	73,  // 115: indexer.CreateBeaconBadBlobRequest.slot:type_name -> google.protobuf.UInt64Value
-	73,  // 116: indexer.CreateBeaconBadBlobRequest.epoch:type_name -> google.protobuf.UInt64Value
-	71,  // 117: indexer.CreateBeaconBadBlobRequest.block_root:type_name -> google.protobuf.StringValue
-	71,  // 118: indexer.CreateBeaconBadBlobRequest.node_version:type_name -> google.protobuf.StringValue
-	71,  // 119: indexer.CreateBeaconBadBlobRequest.location:type_name -> google.protobuf.StringValue
-	71,  // 120: indexer.CreateBeaconBadBlobRequest.network:type_name -> google.protobuf.StringValue
-	71,  // 121: indexer.CreateBeaconBadBlobRequest.beacon_implementation:type_name -> google.protobuf.StringValue
-	73,  // 122: indexer.CreateBeaconBadBlobRequest.index:type_name -> google.protobuf.UInt64Value
-	71,  // 123: indexer.CreateBeaconBadBlobRequest.content_encoding:type_name -> google.protobuf.StringValue
-	71,  // 124: indexer.CreateBeaconBadBlobResponse.id:type_name -> google.protobuf.StringValue
-	71,  // 125: indexer.CreateExecutionBlockTraceRequest.node:type_name -> google.protobuf.StringValue
-	72,  // 126: indexer.CreateExecutionBlockTraceRequest.fetched_at:type_name -> google.protobuf.Timestamp
-	71,  // 127: indexer.CreateExecutionBlockTraceRequest.block_hash:type_name -> google.protobuf.StringValue
-	74,  // 128: indexer.CreateExecutionBlockTraceRequest.block_number:type_name -> google.protobuf.Int64Value
-	71,  // 129: indexer.CreateExecutionBlockTraceRequest.location:type_name -> google.protobuf.StringValue
-	71,  // 130: indexer.CreateExecutionBlockTraceRequest.network:type_name -> google.protobuf.StringValue
-	71,  // 131: indexer.CreateExecutionBlockTraceRequest.execution_implementation:type_name -> google.protobuf.StringValue
-	71,  // 132: indexer.CreateExecutionBlockTraceRequest.node_version:type_name -> google.protobuf.StringValue
-	71,  // 133: indexer.CreateExecutionBlockTraceRequest.content_encoding:type_name -> google.protobuf.StringValue
-	71,  // 134: indexer.CreateExecutionBlockTraceResponse.id:type_name -> google.protobuf.StringValue
-	72,  // 135: indexer.ListExecutionBlockTraceRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 136: indexer.ListExecutionBlockTraceRequest.after:type_name -> google.protobuf.Timestamp
-	62,  // 137: indexer.ListExecutionBlockTraceRequest.pagination:type_name -> indexer.PaginationCursor
-	10,  // 138: indexer.ListExecutionBlockTraceResponse.execution_block_traces:type_name -> indexer.ExecutionBlockTrace
-	72,  // 139: indexer.CountExecutionBlockTraceRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 140: indexer.CountExecutionBlockTraceRequest.after:type_name -> google.protobuf.Timestamp
-	73,  // 141: indexer.CountExecutionBlockTraceResponse.count:type_name -> google.protobuf.UInt64Value
-	0,   // 142: indexer.ListUniqueExecutionBlockTraceValuesRequest.fields:type_name -> indexer.ListUniqueExecutionBlockTraceValuesRequest.Field
-	1,   // 143: indexer.ListUniqueBeaconStateValuesRequest.fields:type_name -> indexer.ListUniqueBeaconStateValuesRequest.Field
-	72,  // 144: indexer.ListBeaconStateRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 145: indexer.ListBeaconStateRequest.after:type_name -> google.protobuf.Timestamp
-	62,  // 146: indexer.ListBeaconStateRequest.pagination:type_name -> indexer.PaginationCursor
-	6,   // 147: indexer.ListBeaconStateResponse.beacon_states:type_name -> indexer.BeaconState
-	72,  // 148: indexer.CountBeaconStateRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 149: indexer.CountBeaconStateRequest.after:type_name -> google.protobuf.Timestamp
-	73,  // 150: indexer.CountBeaconStateResponse.count:type_name -> google.protobuf.UInt64Value
-	2,   // 151: indexer.ListUniqueBeaconBlockValuesRequest.fields:type_name -> indexer.ListUniqueBeaconBlockValuesRequest.Field
-	72,  // 152: indexer.ListBeaconBlockRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 153: indexer.ListBeaconBlockRequest.after:type_name -> google.protobuf.Timestamp
-	62,  // 154: indexer.ListBeaconBlockRequest.pagination:type_name -> indexer.PaginationCursor
-	7,   // 155: indexer.ListBeaconBlockResponse.beacon_blocks:type_name -> indexer.BeaconBlock
-	72,  // 156: indexer.CountBeaconBlockRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 157: indexer.CountBeaconBlockRequest.after:type_name -> google.protobuf.Timestamp
-	73,  // 158: indexer.CountBeaconBlockResponse.count:type_name -> google.protobuf.UInt64Value
-	3,   // 159: indexer.ListUniqueBeaconBadBlockValuesRequest.fields:type_name -> indexer.ListUniqueBeaconBadBlockValuesRequest.Field
-	72,  // 160: indexer.ListBeaconBadBlockRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 161: indexer.ListBeaconBadBlockRequest.after:type_name -> google.protobuf.Timestamp
-	62,  // 162: indexer.ListBeaconBadBlockRequest.pagination:type_name -> indexer.PaginationCursor
-	8,   // 163: indexer.ListBeaconBadBlockResponse.beacon_bad_blocks:type_name -> indexer.BeaconBadBlock
-	72,  // 164: indexer.CountBeaconBadBlockRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 165: indexer.CountBeaconBadBlockRequest.after:type_name -> google.protobuf.Timestamp
-	73,  // 166: indexer.CountBeaconBadBlockResponse.count:type_name -> google.protobuf.UInt64Value
-	4,   // 167: indexer.ListUniqueBeaconBadBlobValuesRequest.fields:type_name -> indexer.ListUniqueBeaconBadBlobValuesRequest.Field
-	72,  // 168: indexer.ListBeaconBadBlobRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 169: indexer.ListBeaconBadBlobRequest.after:type_name -> google.protobuf.Timestamp
-	62,  // 170: indexer.ListBeaconBadBlobRequest.pagination:type_name -> indexer.PaginationCursor
-	73,  // 171: indexer.ListBeaconBadBlobRequest.index:type_name -> google.protobuf.UInt64Value
-	9,   // 172: indexer.ListBeaconBadBlobResponse.beacon_bad_blobs:type_name -> indexer.BeaconBadBlob
-	72,  // 173: indexer.CountBeaconBadBlobRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 174: indexer.CountBeaconBadBlobRequest.after:type_name -> google.protobuf.Timestamp
-	73,  // 175: indexer.CountBeaconBadBlobRequest.index:type_name -> google.protobuf.UInt64Value
-	73,  // 176: indexer.CountBeaconBadBlobResponse.count:type_name -> google.protobuf.UInt64Value
-	71,  // 177: indexer.CreateExecutionBadBlockRequest.node:type_name -> google.protobuf.StringValue
-	72,  // 178: indexer.CreateExecutionBadBlockRequest.fetched_at:type_name -> google.protobuf.Timestamp
-	71,  // 179: indexer.CreateExecutionBadBlockRequest.block_hash:type_name -> google.protobuf.StringValue
-	74,  // 180: indexer.CreateExecutionBadBlockRequest.block_number:type_name -> google.protobuf.Int64Value
-	71,  // 181: indexer.CreateExecutionBadBlockRequest.location:type_name -> google.protobuf.StringValue
-	71,  // 182: indexer.CreateExecutionBadBlockRequest.network:type_name -> google.protobuf.StringValue
-	71,  // 183: indexer.CreateExecutionBadBlockRequest.execution_implementation:type_name -> google.protobuf.StringValue
-	71,  // 184: indexer.CreateExecutionBadBlockRequest.node_version:type_name -> google.protobuf.StringValue
-	71,  // 185: indexer.CreateExecutionBadBlockRequest.block_extra_data:type_name -> google.protobuf.StringValue
-	71,  // 186: indexer.CreateExecutionBadBlockRequest.content_encoding:type_name -> google.protobuf.StringValue
-	71,  // 187: indexer.CreateExecutionBadBlockResponse.id:type_name -> google.protobuf.StringValue
-	72,  // 188: indexer.ListExecutionBadBlockRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 189: indexer.ListExecutionBadBlockRequest.after:type_name -> google.protobuf.Timestamp
-	62,  // 190: indexer.ListExecutionBadBlockRequest.pagination:type_name -> indexer.PaginationCursor
-	11,  // 191: indexer.ListExecutionBadBlockResponse.execution_bad_blocks:type_name -> indexer.ExecutionBadBlock
-	72,  // 192: indexer.CountExecutionBadBlockRequest.before:type_name -> google.protobuf.Timestamp
-	72,  // 193: indexer.CountExecutionBadBlockRequest.after:type_name -> google.protobuf.Timestamp
-	73,  // 194: indexer.CountExecutionBadBlockResponse.count:type_name -> google.protobuf.UInt64Value
-	5,   // 195: indexer.ListUniqueExecutionBadBlockValuesRequest.fields:type_name -> indexer.ListUniqueExecutionBadBlockValuesRequest.Field
-	18,  // 196: indexer.Indexer.GetConfig:input_type -> indexer.GetConfigRequest
-	20,  // 197: indexer.Indexer.GetStorageHandshakeToken:input_type -> indexer.GetStorageHandshakeTokenRequest
-	22,  // 198: indexer.Indexer.CreateBeaconState:input_type -> indexer.CreateBeaconStateRequest
-	40,  // 199: indexer.Indexer.ListBeaconState:input_type -> indexer.ListBeaconStateRequest
-	42,  // 200: indexer.Indexer.CountBeaconState:input_type -> indexer.CountBeaconStateRequest
-	38,  // 201: indexer.Indexer.ListUniqueBeaconStateValues:input_type -> indexer.ListUniqueBeaconStateValuesRequest
-	24,  // 202: indexer.Indexer.CreateBeaconBlock:input_type -> indexer.CreateBeaconBlockRequest
-	46,  // 203: indexer.Indexer.ListBeaconBlock:input_type -> indexer.ListBeaconBlockRequest
-	48,  // 204: indexer.Indexer.CountBeaconBlock:input_type -> indexer.CountBeaconBlockRequest
-	44,  // 205: indexer.Indexer.ListUniqueBeaconBlockValues:input_type -> indexer.ListUniqueBeaconBlockValuesRequest
-	26,  // 206: indexer.Indexer.CreateBeaconBadBlock:input_type -> indexer.CreateBeaconBadBlockRequest
-	52,  // 207: indexer.Indexer.ListBeaconBadBlock:input_type -> indexer.ListBeaconBadBlockRequest
-	54,  // 208: indexer.Indexer.CountBeaconBadBlock:input_type -> indexer.CountBeaconBadBlockRequest
-	50,  // 209: indexer.Indexer.ListUniqueBeaconBadBlockValues:input_type -> indexer.ListUniqueBeaconBadBlockValuesRequest
-	28,  // 210: indexer.Indexer.CreateBeaconBadBlob:input_type -> indexer.CreateBeaconBadBlobRequest
-	58,  // 211: indexer.Indexer.ListBeaconBadBlob:input_type -> indexer.ListBeaconBadBlobRequest
-	60,  // 212: indexer.Indexer.CountBeaconBadBlob:input_type -> indexer.CountBeaconBadBlobRequest
-	56,  // 213: indexer.Indexer.ListUniqueBeaconBadBlobValues:input_type -> indexer.ListUniqueBeaconBadBlobValuesRequest
-	30,  // 214: indexer.Indexer.CreateExecutionBlockTrace:input_type -> indexer.CreateExecutionBlockTraceRequest
-	32,  // 215: indexer.Indexer.ListExecutionBlockTrace:input_type -> indexer.ListExecutionBlockTraceRequest
-	34,  // 216: indexer.Indexer.CountExecutionBlockTrace:input_type -> indexer.CountExecutionBlockTraceRequest
-	36,  // 217: indexer.Indexer.ListUniqueExecutionBlockTraceValues:input_type -> indexer.ListUniqueExecutionBlockTraceValuesRequest
-	63,  // 218: indexer.Indexer.CreateExecutionBadBlock:input_type -> indexer.CreateExecutionBadBlockRequest
-	65,  // 219: indexer.Indexer.ListExecutionBadBlock:input_type -> indexer.ListExecutionBadBlockRequest
-	67,  // 220: indexer.Indexer.CountExecutionBadBlock:input_type -> indexer.CountExecutionBadBlockRequest
-	69,  // 221: indexer.Indexer.ListUniqueExecutionBadBlockValues:input_type -> indexer.ListUniqueExecutionBadBlockValuesRequest
-	19,  // 222: indexer.Indexer.GetConfig:output_type -> indexer.GetConfigResponse
-	21,  // 223: indexer.Indexer.GetStorageHandshakeToken:output_type -> indexer.GetStorageHandshakeTokenResponse
-	23,  // 224: indexer.Indexer.CreateBeaconState:output_type -> indexer.CreateBeaconStateResponse
-	41,  // 225: indexer.Indexer.ListBeaconState:output_type -> indexer.ListBeaconStateResponse
-	43,  // 226: indexer.Indexer.CountBeaconState:output_type -> indexer.CountBeaconStateResponse
-	39,  // 227: indexer.Indexer.ListUniqueBeaconStateValues:output_type -> indexer.ListUniqueBeaconStateValuesResponse
-	25,  // 228: indexer.Indexer.CreateBeaconBlock:output_type -> indexer.CreateBeaconBlockResponse
-	47,  // 229: indexer.Indexer.ListBeaconBlock:output_type -> indexer.ListBeaconBlockResponse
-	49,  // 230: indexer.Indexer.CountBeaconBlock:output_type -> indexer.CountBeaconBlockResponse
-	45,  // 231: indexer.Indexer.ListUniqueBeaconBlockValues:output_type -> indexer.ListUniqueBeaconBlockValuesResponse
-	27,  // 232: indexer.Indexer.CreateBeaconBadBlock:output_type -> indexer.CreateBeaconBadBlockResponse
-	53,  // 233: indexer.Indexer.ListBeaconBadBlock:output_type -> indexer.ListBeaconBadBlockResponse
-	55,  // 234: indexer.Indexer.CountBeaconBadBlock:output_type -> indexer.CountBeaconBadBlockResponse
-	51,  // 235: indexer.Indexer.ListUniqueBeaconBadBlockValues:output_type -> indexer.ListUniqueBeaconBadBlockValuesResponse
-	29,  // 236: indexer.Indexer.CreateBeaconBadBlob:output_type -> indexer.CreateBeaconBadBlobResponse
-	59,  // 237: indexer.Indexer.ListBeaconBadBlob:output_type -> indexer.ListBeaconBadBlobResponse
-	61,  // 238: indexer.Indexer.CountBeaconBadBlob:output_type -> indexer.CountBeaconBadBlobResponse
-	57,  // 239: indexer.Indexer.ListUniqueBeaconBadBlobValues:output_type -> indexer.ListUniqueBeaconBadBlobValuesResponse
-	31,  // 240: indexer.Indexer.CreateExecutionBlockTrace:output_type -> indexer.CreateExecutionBlockTraceResponse
-	33,  // 241: indexer.Indexer.ListExecutionBlockTrace:output_type -> indexer.ListExecutionBlockTraceResponse
-	35,  // 242: indexer.Indexer.CountExecutionBlockTrace:output_type -> indexer.CountExecutionBlockTraceResponse
-	37,  // 243: indexer.Indexer.ListUniqueExecutionBlockTraceValues:output_type -> indexer.ListUniqueExecutionBlockTraceValuesResponse
-	64,  // 244: indexer.Indexer.CreateExecutionBadBlock:output_type -> indexer.CreateExecutionBadBlockResponse
-	66,  // 245: indexer.Indexer.ListExecutionBadBlock:output_type -> indexer.ListExecutionBadBlockResponse
-	68,  // 246: indexer.Indexer.CountExecutionBadBlock:output_type -> indexer.CountExecutionBadBlockResponse
-	70,  // 247: indexer.Indexer.ListUniqueExecutionBadBlockValues:output_type -> indexer.ListUniqueExecutionBadBlockValuesResponse
-	222, // [222:248] is the sub-list for method output_type
-	196, // [196:222] is the sub-list for method input_type
-	196, // [196:196] is the sub-list for extension type_name
-	196, // [196:196] is the sub-list for extension extendee
-	0,   // [0:196] is the sub-list for field type_name
+	118, // 0: indexer.BeaconState.id:type_name -> google.protobuf.StringValue
+	118, // 1: indexer.BeaconState.node:type_name -> google.protobuf.StringValue
+	119, // 2: indexer.BeaconState.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 3: indexer.BeaconState.slot:type_name -> google.protobuf.UInt64Value
+	120, // 4: indexer.BeaconState.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 5: indexer.BeaconState.state_root:type_name -> google.protobuf.StringValue
+	118, // 6: indexer.BeaconState.node_version:type_name -> google.protobuf.StringValue
+	118, // 7: indexer.BeaconState.location:type_name -> google.protobuf.StringValue
+	118, // 8: indexer.BeaconState.network:type_name -> google.protobuf.StringValue
+	118, // 9: indexer.BeaconState.beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 10: indexer.BeaconState.content_encoding:type_name -> google.protobuf.StringValue
+	121, // 11: indexer.BeaconState.finalized:type_name -> google.protobuf.BoolValue
+	120, // 12: indexer.BeaconState.checkpoint_epoch:type_name -> google.protobuf.UInt64Value
+	121, // 13: indexer.BeaconState.canonical:type_name -> google.protobuf.BoolValue
+	118, // 14: indexer.BeaconBlock.id:type_name -> google.protobuf.StringValue
+	118, // 15: indexer.BeaconBlock.node:type_name -> google.protobuf.StringValue
+	119, // 16: indexer.BeaconBlock.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 17: indexer.BeaconBlock.slot:type_name -> google.protobuf.UInt64Value
+	120, // 18: indexer.BeaconBlock.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 19: indexer.BeaconBlock.block_root:type_name -> google.protobuf.StringValue
+	118, // 20: indexer.BeaconBlock.node_version:type_name -> google.protobuf.StringValue
+	118, // 21: indexer.BeaconBlock.location:type_name -> google.protobuf.StringValue
+	118, // 22: indexer.BeaconBlock.network:type_name -> google.protobuf.StringValue
+	118, // 23: indexer.BeaconBlock.beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 24: indexer.BeaconBlock.content_encoding:type_name -> google.protobuf.StringValue
+	121, // 25: indexer.BeaconBlock.canonical:type_name -> google.protobuf.BoolValue
+	118, // 26: indexer.BlobSidecar.id:type_name -> google.protobuf.StringValue
+	118, // 27: indexer.BlobSidecar.node:type_name -> google.protobuf.StringValue
+	119, // 28: indexer.BlobSidecar.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 29: indexer.BlobSidecar.slot:type_name -> google.protobuf.UInt64Value
+	120, // 30: indexer.BlobSidecar.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 31: indexer.BlobSidecar.block_root:type_name -> google.protobuf.StringValue
+	118, // 32: indexer.BlobSidecar.node_version:type_name -> google.protobuf.StringValue
+	118, // 33: indexer.BlobSidecar.location:type_name -> google.protobuf.StringValue
+	118, // 34: indexer.BlobSidecar.network:type_name -> google.protobuf.StringValue
+	118, // 35: indexer.BlobSidecar.beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 36: indexer.BlobSidecar.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 37: indexer.BlobSidecar.sidecar_type:type_name -> google.protobuf.StringValue
+	118, // 38: indexer.ForkChoice.id:type_name -> google.protobuf.StringValue
+	118, // 39: indexer.ForkChoice.node:type_name -> google.protobuf.StringValue
+	119, // 40: indexer.ForkChoice.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 41: indexer.ForkChoice.slot:type_name -> google.protobuf.UInt64Value
+	120, // 42: indexer.ForkChoice.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 43: indexer.ForkChoice.node_version:type_name -> google.protobuf.StringValue
+	118, // 44: indexer.ForkChoice.location:type_name -> google.protobuf.StringValue
+	118, // 45: indexer.ForkChoice.network:type_name -> google.protobuf.StringValue
+	118, // 46: indexer.ForkChoice.beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 47: indexer.ForkChoice.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 48: indexer.ForkChoice.reason:type_name -> google.protobuf.StringValue
+	118, // 49: indexer.BeaconBadBlock.id:type_name -> google.protobuf.StringValue
+	118, // 50: indexer.BeaconBadBlock.node:type_name -> google.protobuf.StringValue
+	119, // 51: indexer.BeaconBadBlock.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 52: indexer.BeaconBadBlock.slot:type_name -> google.protobuf.UInt64Value
+	120, // 53: indexer.BeaconBadBlock.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 54: indexer.BeaconBadBlock.block_root:type_name -> google.protobuf.StringValue
+	118, // 55: indexer.BeaconBadBlock.node_version:type_name -> google.protobuf.StringValue
+	118, // 56: indexer.BeaconBadBlock.location:type_name -> google.protobuf.StringValue
+	118, // 57: indexer.BeaconBadBlock.network:type_name -> google.protobuf.StringValue
+	118, // 58: indexer.BeaconBadBlock.beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 59: indexer.BeaconBadBlock.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 60: indexer.BeaconBadBlob.id:type_name -> google.protobuf.StringValue
+	118, // 61: indexer.BeaconBadBlob.node:type_name -> google.protobuf.StringValue
+	119, // 62: indexer.BeaconBadBlob.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 63: indexer.BeaconBadBlob.slot:type_name -> google.protobuf.UInt64Value
+	120, // 64: indexer.BeaconBadBlob.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 65: indexer.BeaconBadBlob.block_root:type_name -> google.protobuf.StringValue
+	118, // 66: indexer.BeaconBadBlob.node_version:type_name -> google.protobuf.StringValue
+	118, // 67: indexer.BeaconBadBlob.location:type_name -> google.protobuf.StringValue
+	118, // 68: indexer.BeaconBadBlob.network:type_name -> google.protobuf.StringValue
+	118, // 69: indexer.BeaconBadBlob.beacon_implementation:type_name -> google.protobuf.StringValue
+	120, // 70: indexer.BeaconBadBlob.index:type_name -> google.protobuf.UInt64Value
+	118, // 71: indexer.BeaconBadBlob.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 72: indexer.ExecutionBlockTrace.id:type_name -> google.protobuf.StringValue
+	118, // 73: indexer.ExecutionBlockTrace.node:type_name -> google.protobuf.StringValue
+	119, // 74: indexer.ExecutionBlockTrace.fetched_at:type_name -> google.protobuf.Timestamp
+	118, // 75: indexer.ExecutionBlockTrace.block_hash:type_name -> google.protobuf.StringValue
+	122, // 76: indexer.ExecutionBlockTrace.block_number:type_name -> google.protobuf.Int64Value
+	118, // 77: indexer.ExecutionBlockTrace.location:type_name -> google.protobuf.StringValue
+	118, // 78: indexer.ExecutionBlockTrace.network:type_name -> google.protobuf.StringValue
+	118, // 79: indexer.ExecutionBlockTrace.execution_implementation:type_name -> google.protobuf.StringValue
+	118, // 80: indexer.ExecutionBlockTrace.node_version:type_name -> google.protobuf.StringValue
+	118, // 81: indexer.ExecutionBlockTrace.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 82: indexer.ExecutionBlockTrace.tracer:type_name -> google.protobuf.StringValue
+	121, // 83: indexer.ExecutionBlockTrace.canonical:type_name -> google.protobuf.BoolValue
+	118, // 84: indexer.ExecutionWitness.id:type_name -> google.protobuf.StringValue
+	118, // 85: indexer.ExecutionWitness.node:type_name -> google.protobuf.StringValue
+	119, // 86: indexer.ExecutionWitness.fetched_at:type_name -> google.protobuf.Timestamp
+	118, // 87: indexer.ExecutionWitness.block_hash:type_name -> google.protobuf.StringValue
+	122, // 88: indexer.ExecutionWitness.block_number:type_name -> google.protobuf.Int64Value
+	118, // 89: indexer.ExecutionWitness.location:type_name -> google.protobuf.StringValue
+	118, // 90: indexer.ExecutionWitness.network:type_name -> google.protobuf.StringValue
+	118, // 91: indexer.ExecutionWitness.execution_implementation:type_name -> google.protobuf.StringValue
+	118, // 92: indexer.ExecutionWitness.node_version:type_name -> google.protobuf.StringValue
+	118, // 93: indexer.ExecutionWitness.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 94: indexer.ExecutionBadBlock.id:type_name -> google.protobuf.StringValue
+	118, // 95: indexer.ExecutionBadBlock.node:type_name -> google.protobuf.StringValue
+	119, // 96: indexer.ExecutionBadBlock.fetched_at:type_name -> google.protobuf.Timestamp
+	118, // 97: indexer.ExecutionBadBlock.block_hash:type_name -> google.protobuf.StringValue
+	122, // 98: indexer.ExecutionBadBlock.block_number:type_name -> google.protobuf.Int64Value
+	118, // 99: indexer.ExecutionBadBlock.location:type_name -> google.protobuf.StringValue
+	118, // 100: indexer.ExecutionBadBlock.network:type_name -> google.protobuf.StringValue
+	118, // 101: indexer.ExecutionBadBlock.execution_implementation:type_name -> google.protobuf.StringValue
+	118, // 102: indexer.ExecutionBadBlock.node_version:type_name -> google.protobuf.StringValue
+	118, // 103: indexer.ExecutionBadBlock.block_extra_data:type_name -> google.protobuf.StringValue
+	118, // 104: indexer.ExecutionBadBlock.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 105: indexer.ExecutionBadBlock.trace_location:type_name -> google.protobuf.StringValue
+	118, // 106: indexer.ExecutionBadBlock.intermediate_roots_location:type_name -> google.protobuf.StringValue
+	118, // 107: indexer.StateDivergence.id:type_name -> google.protobuf.StringValue
+	118, // 108: indexer.StateDivergence.network:type_name -> google.protobuf.StringValue
+	120, // 109: indexer.StateDivergence.slot:type_name -> google.protobuf.UInt64Value
+	120, // 110: indexer.StateDivergence.epoch:type_name -> google.protobuf.UInt64Value
+	119, // 111: indexer.StateDivergence.detected_at:type_name -> google.protobuf.Timestamp
+	118, // 112: indexer.StateDivergence.beacon_state_id:type_name -> google.protobuf.StringValue
+	118, // 113: indexer.StateDivergence.node:type_name -> google.protobuf.StringValue
+	118, // 114: indexer.StateDivergence.state_root:type_name -> google.protobuf.StringValue
+	118, // 115: indexer.StateDivergence.beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 116: indexer.StateDivergence.node_version:type_name -> google.protobuf.StringValue
+	118, // 117: indexer.StateDivergence.conflicting_beacon_state_id:type_name -> google.protobuf.StringValue
+	118, // 118: indexer.StateDivergence.conflicting_node:type_name -> google.protobuf.StringValue
+	118, // 119: indexer.StateDivergence.conflicting_state_root:type_name -> google.protobuf.StringValue
+	118, // 120: indexer.StateDivergence.conflicting_beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 121: indexer.StateDivergence.conflicting_node_version:type_name -> google.protobuf.StringValue
+	20,  // 122: indexer.Config.ethereum:type_name -> indexer.EthereumConfig
+	118, // 123: indexer.EthereumConfig.network:type_name -> google.protobuf.StringValue
+	21,  // 124: indexer.EthereumConfig.config:type_name -> indexer.EthereumNetworkConfig
+	22,  // 125: indexer.EthereumConfig.tools:type_name -> indexer.ToolsConfig
+	118, // 126: indexer.EthereumNetworkConfig.repository:type_name -> google.protobuf.StringValue
+	118, // 127: indexer.EthereumNetworkConfig.branch:type_name -> google.protobuf.StringValue
+	118, // 128: indexer.EthereumNetworkConfig.path:type_name -> google.protobuf.StringValue
+	23,  // 129: indexer.ToolsConfig.ncli:type_name -> indexer.GitRepositoryConfig
+	23,  // 130: indexer.ToolsConfig.lcli:type_name -> indexer.GitRepositoryConfig
+	24,  // 131: indexer.ToolsConfig.zcli:type_name -> indexer.ZcliConfig
+	118, // 132: indexer.GitRepositoryConfig.repository:type_name -> google.protobuf.StringValue
+	118, // 133: indexer.GitRepositoryConfig.branch:type_name -> google.protobuf.StringValue
+	118, // 134: indexer.ZcliConfig.fork:type_name -> google.protobuf.StringValue
+	19,  // 135: indexer.GetConfigResponse.config:type_name -> indexer.Config
+	120, // 136: indexer.MarkOrphanedResponse.beacon_blocks:type_name -> google.protobuf.UInt64Value
+	120, // 137: indexer.MarkOrphanedResponse.beacon_states:type_name -> google.protobuf.UInt64Value
+	120, // 138: indexer.MarkOrphanedResponse.execution_block_traces:type_name -> google.protobuf.UInt64Value
+	119, // 139: indexer.ListIndexedKeysRequest.since:type_name -> google.protobuf.Timestamp
+	32,  // 140: indexer.ListIndexedKeysResponse.keys:type_name -> indexer.IndexedKey
+	43,  // 141: indexer.UploadBeaconStateRequest.metadata:type_name -> indexer.CreateBeaconStateRequest
+	45,  // 142: indexer.UploadBeaconBlockRequest.metadata:type_name -> indexer.CreateBeaconBlockRequest
+	47,  // 143: indexer.UploadBlobSidecarRequest.metadata:type_name -> indexer.CreateBlobSidecarRequest
+	49,  // 144: indexer.UploadForkChoiceRequest.metadata:type_name -> indexer.CreateForkChoiceRequest
+	51,  // 145: indexer.UploadBeaconBadBlockRequest.metadata:type_name -> indexer.CreateBeaconBadBlockRequest
+	53,  // 146: indexer.UploadBeaconBadBlobRequest.metadata:type_name -> indexer.CreateBeaconBadBlobRequest
+	55,  // 147: indexer.UploadExecutionBlockTraceRequest.metadata:type_name -> indexer.CreateExecutionBlockTraceRequest
+	63,  // 148: indexer.UploadExecutionWitnessRequest.metadata:type_name -> indexer.CreateExecutionWitnessRequest
+	110, // 149: indexer.UploadExecutionBadBlockRequest.metadata:type_name -> indexer.CreateExecutionBadBlockRequest
+	118, // 150: indexer.CreateBeaconStateRequest.node:type_name -> google.protobuf.StringValue
+	119, // 151: indexer.CreateBeaconStateRequest.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 152: indexer.CreateBeaconStateRequest.slot:type_name -> google.protobuf.UInt64Value
+	120, // 153: indexer.CreateBeaconStateRequest.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 154: indexer.CreateBeaconStateRequest.state_root:type_name -> google.protobuf.StringValue
+	118, // 155: indexer.CreateBeaconStateRequest.node_version:type_name -> google.protobuf.StringValue
+	118, // 156: indexer.CreateBeaconStateRequest.location:type_name -> google.protobuf.StringValue
+	118, // 157: indexer.CreateBeaconStateRequest.network:type_name -> google.protobuf.StringValue
+	118, // 158: indexer.CreateBeaconStateRequest.beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 159: indexer.CreateBeaconStateRequest.content_encoding:type_name -> google.protobuf.StringValue
+	121, // 160: indexer.CreateBeaconStateRequest.finalized:type_name -> google.protobuf.BoolValue
+	120, // 161: indexer.CreateBeaconStateRequest.checkpoint_epoch:type_name -> google.protobuf.UInt64Value
+	118, // 162: indexer.CreateBeaconStateResponse.id:type_name -> google.protobuf.StringValue
+	118, // 163: indexer.CreateBeaconBlockRequest.node:type_name -> google.protobuf.StringValue
+	119, // 164: indexer.CreateBeaconBlockRequest.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 165: indexer.CreateBeaconBlockRequest.slot:type_name -> google.protobuf.UInt64Value
+	120, // 166: indexer.CreateBeaconBlockRequest.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 167: indexer.CreateBeaconBlockRequest.block_root:type_name -> google.protobuf.StringValue
+	118, // 168: indexer.CreateBeaconBlockRequest.node_version:type_name -> google.protobuf.StringValue
+	118, // 169: indexer.CreateBeaconBlockRequest.location:type_name -> google.protobuf.StringValue
+	118, // 170: indexer.CreateBeaconBlockRequest.network:type_name -> google.protobuf.StringValue
+	118, // 171: indexer.CreateBeaconBlockRequest.beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 172: indexer.CreateBeaconBlockRequest.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 173: indexer.CreateBeaconBlockResponse.id:type_name -> google.protobuf.StringValue
+	118, // 174: indexer.CreateBlobSidecarRequest.node:type_name -> google.protobuf.StringValue
+	119, // 175: indexer.CreateBlobSidecarRequest.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 176: indexer.CreateBlobSidecarRequest.slot:type_name -> google.protobuf.UInt64Value
+	120, // 177: indexer.CreateBlobSidecarRequest.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 178: indexer.CreateBlobSidecarRequest.block_root:type_name -> google.protobuf.StringValue
+	118, // 179: indexer.CreateBlobSidecarRequest.node_version:type_name -> google.protobuf.StringValue
+	118, // 180: indexer.CreateBlobSidecarRequest.location:type_name -> google.protobuf.StringValue
+	118, // 181: indexer.CreateBlobSidecarRequest.network:type_name -> google.protobuf.StringValue
+	118, // 182: indexer.CreateBlobSidecarRequest.beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 183: indexer.CreateBlobSidecarRequest.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 184: indexer.CreateBlobSidecarRequest.sidecar_type:type_name -> google.protobuf.StringValue
+	118, // 185: indexer.CreateBlobSidecarResponse.id:type_name -> google.protobuf.StringValue
+	118, // 186: indexer.CreateForkChoiceRequest.node:type_name -> google.protobuf.StringValue
+	119, // 187: indexer.CreateForkChoiceRequest.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 188: indexer.CreateForkChoiceRequest.slot:type_name -> google.protobuf.UInt64Value
+	120, // 189: indexer.CreateForkChoiceRequest.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 190: indexer.CreateForkChoiceRequest.node_version:type_name -> google.protobuf.StringValue
+	118, // 191: indexer.CreateForkChoiceRequest.location:type_name -> google.protobuf.StringValue
+	118, // 192: indexer.CreateForkChoiceRequest.network:type_name -> google.protobuf.StringValue
+	118, // 193: indexer.CreateForkChoiceRequest.beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 194: indexer.CreateForkChoiceRequest.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 195: indexer.CreateForkChoiceRequest.reason:type_name -> google.protobuf.StringValue
+	118, // 196: indexer.CreateForkChoiceResponse.id:type_name -> google.protobuf.StringValue
+	118, // 197: indexer.CreateBeaconBadBlockRequest.node:type_name -> google.protobuf.StringValue
+	119, // 198: indexer.CreateBeaconBadBlockRequest.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 199: indexer.CreateBeaconBadBlockRequest.slot:type_name -> google.protobuf.UInt64Value
+	120, // 200: indexer.CreateBeaconBadBlockRequest.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 201: indexer.CreateBeaconBadBlockRequest.block_root:type_name -> google.protobuf.StringValue
+	118, // 202: indexer.CreateBeaconBadBlockRequest.node_version:type_name -> google.protobuf.StringValue
+	118, // 203: indexer.CreateBeaconBadBlockRequest.location:type_name -> google.protobuf.StringValue
+	118, // 204: indexer.CreateBeaconBadBlockRequest.network:type_name -> google.protobuf.StringValue
+	118, // 205: indexer.CreateBeaconBadBlockRequest.beacon_implementation:type_name -> google.protobuf.StringValue
+	118, // 206: indexer.CreateBeaconBadBlockRequest.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 207: indexer.CreateBeaconBadBlockResponse.id:type_name -> google.protobuf.StringValue
+	118, // 208: indexer.CreateBeaconBadBlobRequest.node:type_name -> google.protobuf.StringValue
+	119, // 209: indexer.CreateBeaconBadBlobRequest.fetched_at:type_name -> google.protobuf.Timestamp
+	120, // 210: indexer.CreateBeaconBadBlobRequest.slot:type_name -> google.protobuf.UInt64Value
+	120, // 211: indexer.CreateBeaconBadBlobRequest.epoch:type_name -> google.protobuf.UInt64Value
+	118, // 212: indexer.CreateBeaconBadBlobRequest.block_root:type_name -> google.protobuf.StringValue
+	118, // 213: indexer.CreateBeaconBadBlobRequest.node_version:type_name -> google.protobuf.StringValue
+	118, // 214: indexer.CreateBeaconBadBlobRequest.location:type_name -> google.protobuf.StringValue
+	118, // 215: indexer.CreateBeaconBadBlobRequest.network:type_name -> google.protobuf.StringValue
+	118, // 216: indexer.CreateBeaconBadBlobRequest.beacon_implementation:type_name -> google.protobuf.StringValue
+	120, // 217: indexer.CreateBeaconBadBlobRequest.index:type_name -> google.protobuf.UInt64Value
+	118, // 218: indexer.CreateBeaconBadBlobRequest.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 219: indexer.CreateBeaconBadBlobResponse.id:type_name -> google.protobuf.StringValue
+	118, // 220: indexer.CreateExecutionBlockTraceRequest.node:type_name -> google.protobuf.StringValue
+	119, // 221: indexer.CreateExecutionBlockTraceRequest.fetched_at:type_name -> google.protobuf.Timestamp
+	118, // 222: indexer.CreateExecutionBlockTraceRequest.block_hash:type_name -> google.protobuf.StringValue
+	122, // 223: indexer.CreateExecutionBlockTraceRequest.block_number:type_name -> google.protobuf.Int64Value
+	118, // 224: indexer.CreateExecutionBlockTraceRequest.location:type_name -> google.protobuf.StringValue
+	118, // 225: indexer.CreateExecutionBlockTraceRequest.network:type_name -> google.protobuf.StringValue
+	118, // 226: indexer.CreateExecutionBlockTraceRequest.execution_implementation:type_name -> google.protobuf.StringValue
+	118, // 227: indexer.CreateExecutionBlockTraceRequest.node_version:type_name -> google.protobuf.StringValue
+	118, // 228: indexer.CreateExecutionBlockTraceRequest.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 229: indexer.CreateExecutionBlockTraceRequest.tracer:type_name -> google.protobuf.StringValue
+	118, // 230: indexer.CreateExecutionBlockTraceResponse.id:type_name -> google.protobuf.StringValue
+	119, // 231: indexer.ListExecutionBlockTraceRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 232: indexer.ListExecutionBlockTraceRequest.after:type_name -> google.protobuf.Timestamp
+	109, // 233: indexer.ListExecutionBlockTraceRequest.pagination:type_name -> indexer.PaginationCursor
+	121, // 234: indexer.ListExecutionBlockTraceRequest.canonical:type_name -> google.protobuf.BoolValue
+	15,  // 235: indexer.ListExecutionBlockTraceResponse.execution_block_traces:type_name -> indexer.ExecutionBlockTrace
+	119, // 236: indexer.CountExecutionBlockTraceRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 237: indexer.CountExecutionBlockTraceRequest.after:type_name -> google.protobuf.Timestamp
+	121, // 238: indexer.CountExecutionBlockTraceRequest.canonical:type_name -> google.protobuf.BoolValue
+	120, // 239: indexer.CountExecutionBlockTraceResponse.count:type_name -> google.protobuf.UInt64Value
+	0,   // 240: indexer.ListUniqueExecutionBlockTraceValuesRequest.fields:type_name -> indexer.ListUniqueExecutionBlockTraceValuesRequest.Field
+	118, // 241: indexer.CreateExecutionWitnessRequest.node:type_name -> google.protobuf.StringValue
+	119, // 242: indexer.CreateExecutionWitnessRequest.fetched_at:type_name -> google.protobuf.Timestamp
+	118, // 243: indexer.CreateExecutionWitnessRequest.block_hash:type_name -> google.protobuf.StringValue
+	122, // 244: indexer.CreateExecutionWitnessRequest.block_number:type_name -> google.protobuf.Int64Value
+	118, // 245: indexer.CreateExecutionWitnessRequest.location:type_name -> google.protobuf.StringValue
+	118, // 246: indexer.CreateExecutionWitnessRequest.network:type_name -> google.protobuf.StringValue
+	118, // 247: indexer.CreateExecutionWitnessRequest.execution_implementation:type_name -> google.protobuf.StringValue
+	118, // 248: indexer.CreateExecutionWitnessRequest.node_version:type_name -> google.protobuf.StringValue
+	118, // 249: indexer.CreateExecutionWitnessRequest.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 250: indexer.CreateExecutionWitnessResponse.id:type_name -> google.protobuf.StringValue
+	119, // 251: indexer.ListExecutionWitnessRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 252: indexer.ListExecutionWitnessRequest.after:type_name -> google.protobuf.Timestamp
+	109, // 253: indexer.ListExecutionWitnessRequest.pagination:type_name -> indexer.PaginationCursor
+	16,  // 254: indexer.ListExecutionWitnessResponse.execution_witnesses:type_name -> indexer.ExecutionWitness
+	119, // 255: indexer.CountExecutionWitnessRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 256: indexer.CountExecutionWitnessRequest.after:type_name -> google.protobuf.Timestamp
+	120, // 257: indexer.CountExecutionWitnessResponse.count:type_name -> google.protobuf.UInt64Value
+	1,   // 258: indexer.ListUniqueExecutionWitnessValuesRequest.fields:type_name -> indexer.ListUniqueExecutionWitnessValuesRequest.Field
+	2,   // 259: indexer.ListUniqueBeaconStateValuesRequest.fields:type_name -> indexer.ListUniqueBeaconStateValuesRequest.Field
+	119, // 260: indexer.ListBeaconStateRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 261: indexer.ListBeaconStateRequest.after:type_name -> google.protobuf.Timestamp
+	109, // 262: indexer.ListBeaconStateRequest.pagination:type_name -> indexer.PaginationCursor
+	121, // 263: indexer.ListBeaconStateRequest.finalized:type_name -> google.protobuf.BoolValue
+	121, // 264: indexer.ListBeaconStateRequest.canonical:type_name -> google.protobuf.BoolValue
+	9,   // 265: indexer.ListBeaconStateResponse.beacon_states:type_name -> indexer.BeaconState
+	119, // 266: indexer.CountBeaconStateRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 267: indexer.CountBeaconStateRequest.after:type_name -> google.protobuf.Timestamp
+	121, // 268: indexer.CountBeaconStateRequest.finalized:type_name -> google.protobuf.BoolValue
+	121, // 269: indexer.CountBeaconStateRequest.canonical:type_name -> google.protobuf.BoolValue
+	120, // 270: indexer.CountBeaconStateResponse.count:type_name -> google.protobuf.UInt64Value
+	119, // 271: indexer.ListStateDivergencesRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 272: indexer.ListStateDivergencesRequest.after:type_name -> google.protobuf.Timestamp
+	109, // 273: indexer.ListStateDivergencesRequest.pagination:type_name -> indexer.PaginationCursor
+	18,  // 274: indexer.ListStateDivergencesResponse.state_divergences:type_name -> indexer.StateDivergence
+	3,   // 275: indexer.ListUniqueBeaconBlockValuesRequest.fields:type_name -> indexer.ListUniqueBeaconBlockValuesRequest.Field
+	119, // 276: indexer.ListBeaconBlockRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 277: indexer.ListBeaconBlockRequest.after:type_name -> google.protobuf.Timestamp
+	109, // 278: indexer.ListBeaconBlockRequest.pagination:type_name -> indexer.PaginationCursor
+	121, // 279: indexer.ListBeaconBlockRequest.canonical:type_name -> google.protobuf.BoolValue
+	10,  // 280: indexer.ListBeaconBlockResponse.beacon_blocks:type_name -> indexer.BeaconBlock
+	119, // 281: indexer.CountBeaconBlockRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 282: indexer.CountBeaconBlockRequest.after:type_name -> google.protobuf.Timestamp
+	121, // 283: indexer.CountBeaconBlockRequest.canonical:type_name -> google.protobuf.BoolValue
+	120, // 284: indexer.CountBeaconBlockResponse.count:type_name -> google.protobuf.UInt64Value
+	4,   // 285: indexer.ListUniqueBlobSidecarValuesRequest.fields:type_name -> indexer.ListUniqueBlobSidecarValuesRequest.Field
+	119, // 286: indexer.ListBlobSidecarRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 287: indexer.ListBlobSidecarRequest.after:type_name -> google.protobuf.Timestamp
+	109, // 288: indexer.ListBlobSidecarRequest.pagination:type_name -> indexer.PaginationCursor
+	11,  // 289: indexer.ListBlobSidecarResponse.blob_sidecars:type_name -> indexer.BlobSidecar
+	119, // 290: indexer.CountBlobSidecarRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 291: indexer.CountBlobSidecarRequest.after:type_name -> google.protobuf.Timestamp
+	120, // 292: indexer.CountBlobSidecarResponse.count:type_name -> google.protobuf.UInt64Value
+	5,   // 293: indexer.ListUniqueForkChoiceValuesRequest.fields:type_name -> indexer.ListUniqueForkChoiceValuesRequest.Field
+	119, // 294: indexer.ListForkChoiceRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 295: indexer.ListForkChoiceRequest.after:type_name -> google.protobuf.Timestamp
+	109, // 296: indexer.ListForkChoiceRequest.pagination:type_name -> indexer.PaginationCursor
+	12,  // 297: indexer.ListForkChoiceResponse.fork_choices:type_name -> indexer.ForkChoice
+	119, // 298: indexer.CountForkChoiceRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 299: indexer.CountForkChoiceRequest.after:type_name -> google.protobuf.Timestamp
+	120, // 300: indexer.CountForkChoiceResponse.count:type_name -> google.protobuf.UInt64Value
+	6,   // 301: indexer.ListUniqueBeaconBadBlockValuesRequest.fields:type_name -> indexer.ListUniqueBeaconBadBlockValuesRequest.Field
+	119, // 302: indexer.ListBeaconBadBlockRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 303: indexer.ListBeaconBadBlockRequest.after:type_name -> google.protobuf.Timestamp
+	109, // 304: indexer.ListBeaconBadBlockRequest.pagination:type_name -> indexer.PaginationCursor
+	13,  // 305: indexer.ListBeaconBadBlockResponse.beacon_bad_blocks:type_name -> indexer.BeaconBadBlock
+	119, // 306: indexer.CountBeaconBadBlockRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 307: indexer.CountBeaconBadBlockRequest.after:type_name -> google.protobuf.Timestamp
+	120, // 308: indexer.CountBeaconBadBlockResponse.count:type_name -> google.protobuf.UInt64Value
+	7,   // 309: indexer.ListUniqueBeaconBadBlobValuesRequest.fields:type_name -> indexer.ListUniqueBeaconBadBlobValuesRequest.Field
+	119, // 310: indexer.ListBeaconBadBlobRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 311: indexer.ListBeaconBadBlobRequest.after:type_name -> google.protobuf.Timestamp
+	109, // 312: indexer.ListBeaconBadBlobRequest.pagination:type_name -> indexer.PaginationCursor
+	120, // 313: indexer.ListBeaconBadBlobRequest.index:type_name -> google.protobuf.UInt64Value
+	14,  // 314: indexer.ListBeaconBadBlobResponse.beacon_bad_blobs:type_name -> indexer.BeaconBadBlob
+	119, // 315: indexer.CountBeaconBadBlobRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 316: indexer.CountBeaconBadBlobRequest.after:type_name -> google.protobuf.Timestamp
+	120, // 317: indexer.CountBeaconBadBlobRequest.index:type_name -> google.protobuf.UInt64Value
+	120, // 318: indexer.CountBeaconBadBlobResponse.count:type_name -> google.protobuf.UInt64Value
+	118, // 319: indexer.CreateExecutionBadBlockRequest.node:type_name -> google.protobuf.StringValue
+	119, // 320: indexer.CreateExecutionBadBlockRequest.fetched_at:type_name -> google.protobuf.Timestamp
+	118, // 321: indexer.CreateExecutionBadBlockRequest.block_hash:type_name -> google.protobuf.StringValue
+	122, // 322: indexer.CreateExecutionBadBlockRequest.block_number:type_name -> google.protobuf.Int64Value
+	118, // 323: indexer.CreateExecutionBadBlockRequest.location:type_name -> google.protobuf.StringValue
+	118, // 324: indexer.CreateExecutionBadBlockRequest.network:type_name -> google.protobuf.StringValue
+	118, // 325: indexer.CreateExecutionBadBlockRequest.execution_implementation:type_name -> google.protobuf.StringValue
+	118, // 326: indexer.CreateExecutionBadBlockRequest.node_version:type_name -> google.protobuf.StringValue
+	118, // 327: indexer.CreateExecutionBadBlockRequest.block_extra_data:type_name -> google.protobuf.StringValue
+	118, // 328: indexer.CreateExecutionBadBlockRequest.content_encoding:type_name -> google.protobuf.StringValue
+	118, // 329: indexer.CreateExecutionBadBlockRequest.trace_location:type_name -> google.protobuf.StringValue
+	118, // 330: indexer.CreateExecutionBadBlockRequest.intermediate_roots_location:type_name -> google.protobuf.StringValue
+	118, // 331: indexer.CreateExecutionBadBlockResponse.id:type_name -> google.protobuf.StringValue
+	119, // 332: indexer.ListExecutionBadBlockRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 333: indexer.ListExecutionBadBlockRequest.after:type_name -> google.protobuf.Timestamp
+	109, // 334: indexer.ListExecutionBadBlockRequest.pagination:type_name -> indexer.PaginationCursor
+	17,  // 335: indexer.ListExecutionBadBlockResponse.execution_bad_blocks:type_name -> indexer.ExecutionBadBlock
+	119, // 336: indexer.CountExecutionBadBlockRequest.before:type_name -> google.protobuf.Timestamp
+	119, // 337: indexer.CountExecutionBadBlockRequest.after:type_name -> google.protobuf.Timestamp
+	120, // 338: indexer.CountExecutionBadBlockResponse.count:type_name -> google.protobuf.UInt64Value
+	8,   // 339: indexer.ListUniqueExecutionBadBlockValuesRequest.fields:type_name -> indexer.ListUniqueExecutionBadBlockValuesRequest.Field
+	25,  // 340: indexer.Indexer.GetConfig:input_type -> indexer.GetConfigRequest
+	27,  // 341: indexer.Indexer.GetStorageHandshakeToken:input_type -> indexer.GetStorageHandshakeTokenRequest
+	43,  // 342: indexer.Indexer.CreateBeaconState:input_type -> indexer.CreateBeaconStateRequest
+	73,  // 343: indexer.Indexer.ListBeaconState:input_type -> indexer.ListBeaconStateRequest
+	75,  // 344: indexer.Indexer.CountBeaconState:input_type -> indexer.CountBeaconStateRequest
+	71,  // 345: indexer.Indexer.ListUniqueBeaconStateValues:input_type -> indexer.ListUniqueBeaconStateValuesRequest
+	77,  // 346: indexer.Indexer.ListStateDivergences:input_type -> indexer.ListStateDivergencesRequest
+	45,  // 347: indexer.Indexer.CreateBeaconBlock:input_type -> indexer.CreateBeaconBlockRequest
+	81,  // 348: indexer.Indexer.ListBeaconBlock:input_type -> indexer.ListBeaconBlockRequest
+	83,  // 349: indexer.Indexer.CountBeaconBlock:input_type -> indexer.CountBeaconBlockRequest
+	79,  // 350: indexer.Indexer.ListUniqueBeaconBlockValues:input_type -> indexer.ListUniqueBeaconBlockValuesRequest
+	47,  // 351: indexer.Indexer.CreateBlobSidecar:input_type -> indexer.CreateBlobSidecarRequest
+	87,  // 352: indexer.Indexer.ListBlobSidecar:input_type -> indexer.ListBlobSidecarRequest
+	89,  // 353: indexer.Indexer.CountBlobSidecar:input_type -> indexer.CountBlobSidecarRequest
+	85,  // 354: indexer.Indexer.ListUniqueBlobSidecarValues:input_type -> indexer.ListUniqueBlobSidecarValuesRequest
+	49,  // 355: indexer.Indexer.CreateForkChoice:input_type -> indexer.CreateForkChoiceRequest
+	93,  // 356: indexer.Indexer.ListForkChoice:input_type -> indexer.ListForkChoiceRequest
+	95,  // 357: indexer.Indexer.CountForkChoice:input_type -> indexer.CountForkChoiceRequest
+	91,  // 358: indexer.Indexer.ListUniqueForkChoiceValues:input_type -> indexer.ListUniqueForkChoiceValuesRequest
+	51,  // 359: indexer.Indexer.CreateBeaconBadBlock:input_type -> indexer.CreateBeaconBadBlockRequest
+	99,  // 360: indexer.Indexer.ListBeaconBadBlock:input_type -> indexer.ListBeaconBadBlockRequest
+	101, // 361: indexer.Indexer.CountBeaconBadBlock:input_type -> indexer.CountBeaconBadBlockRequest
+	97,  // 362: indexer.Indexer.ListUniqueBeaconBadBlockValues:input_type -> indexer.ListUniqueBeaconBadBlockValuesRequest
+	53,  // 363: indexer.Indexer.CreateBeaconBadBlob:input_type -> indexer.CreateBeaconBadBlobRequest
+	105, // 364: indexer.Indexer.ListBeaconBadBlob:input_type -> indexer.ListBeaconBadBlobRequest
+	107, // 365: indexer.Indexer.CountBeaconBadBlob:input_type -> indexer.CountBeaconBadBlobRequest
+	103, // 366: indexer.Indexer.ListUniqueBeaconBadBlobValues:input_type -> indexer.ListUniqueBeaconBadBlobValuesRequest
+	55,  // 367: indexer.Indexer.CreateExecutionBlockTrace:input_type -> indexer.CreateExecutionBlockTraceRequest
+	57,  // 368: indexer.Indexer.ListExecutionBlockTrace:input_type -> indexer.ListExecutionBlockTraceRequest
+	59,  // 369: indexer.Indexer.CountExecutionBlockTrace:input_type -> indexer.CountExecutionBlockTraceRequest
+	61,  // 370: indexer.Indexer.ListUniqueExecutionBlockTraceValues:input_type -> indexer.ListUniqueExecutionBlockTraceValuesRequest
+	63,  // 371: indexer.Indexer.CreateExecutionWitness:input_type -> indexer.CreateExecutionWitnessRequest
+	65,  // 372: indexer.Indexer.ListExecutionWitness:input_type -> indexer.ListExecutionWitnessRequest
+	67,  // 373: indexer.Indexer.CountExecutionWitness:input_type -> indexer.CountExecutionWitnessRequest
+	69,  // 374: indexer.Indexer.ListUniqueExecutionWitnessValues:input_type -> indexer.ListUniqueExecutionWitnessValuesRequest
+	110, // 375: indexer.Indexer.CreateExecutionBadBlock:input_type -> indexer.CreateExecutionBadBlockRequest
+	112, // 376: indexer.Indexer.ListExecutionBadBlock:input_type -> indexer.ListExecutionBadBlockRequest
+	114, // 377: indexer.Indexer.CountExecutionBadBlock:input_type -> indexer.CountExecutionBadBlockRequest
+	116, // 378: indexer.Indexer.ListUniqueExecutionBadBlockValues:input_type -> indexer.ListUniqueExecutionBadBlockValuesRequest
+	29,  // 379: indexer.Indexer.MarkOrphaned:input_type -> indexer.MarkOrphanedRequest
+	31,  // 380: indexer.Indexer.ListIndexedKeys:input_type -> indexer.ListIndexedKeysRequest
+	34,  // 381: indexer.Indexer.UploadBeaconState:input_type -> indexer.UploadBeaconStateRequest
+	35,  // 382: indexer.Indexer.UploadBeaconBlock:input_type -> indexer.UploadBeaconBlockRequest
+	36,  // 383: indexer.Indexer.UploadBlobSidecar:input_type -> indexer.UploadBlobSidecarRequest
+	37,  // 384: indexer.Indexer.UploadForkChoice:input_type -> indexer.UploadForkChoiceRequest
+	38,  // 385: indexer.Indexer.UploadBeaconBadBlock:input_type -> indexer.UploadBeaconBadBlockRequest
+	39,  // 386: indexer.Indexer.UploadBeaconBadBlob:input_type -> indexer.UploadBeaconBadBlobRequest
+	40,  // 387: indexer.Indexer.UploadExecutionBlockTrace:input_type -> indexer.UploadExecutionBlockTraceRequest
+	41,  // 388: indexer.Indexer.UploadExecutionWitness:input_type -> indexer.UploadExecutionWitnessRequest
+	42,  // 389: indexer.Indexer.UploadExecutionBadBlock:input_type -> indexer.UploadExecutionBadBlockRequest
+	26,  // 390: indexer.Indexer.GetConfig:output_type -> indexer.GetConfigResponse
+	28,  // 391: indexer.Indexer.GetStorageHandshakeToken:output_type -> indexer.GetStorageHandshakeTokenResponse
+	44,  // 392: indexer.Indexer.CreateBeaconState:output_type -> indexer.CreateBeaconStateResponse
+	74,  // 393: indexer.Indexer.ListBeaconState:output_type -> indexer.ListBeaconStateResponse
+	76,  // 394: indexer.Indexer.CountBeaconState:output_type -> indexer.CountBeaconStateResponse
+	72,  // 395: indexer.Indexer.ListUniqueBeaconStateValues:output_type -> indexer.ListUniqueBeaconStateValuesResponse
+	78,  // 396: indexer.Indexer.ListStateDivergences:output_type -> indexer.ListStateDivergencesResponse
+	46,  // 397: indexer.Indexer.CreateBeaconBlock:output_type -> indexer.CreateBeaconBlockResponse
+	82,  // 398: indexer.Indexer.ListBeaconBlock:output_type -> indexer.ListBeaconBlockResponse
+	84,  // 399: indexer.Indexer.CountBeaconBlock:output_type -> indexer.CountBeaconBlockResponse
+	80,  // 400: indexer.Indexer.ListUniqueBeaconBlockValues:output_type -> indexer.ListUniqueBeaconBlockValuesResponse
+	48,  // 401: indexer.Indexer.CreateBlobSidecar:output_type -> indexer.CreateBlobSidecarResponse
+	88,  // 402: indexer.Indexer.ListBlobSidecar:output_type -> indexer.ListBlobSidecarResponse
+	90,  // 403: indexer.Indexer.CountBlobSidecar:output_type -> indexer.CountBlobSidecarResponse
+	86,  // 404: indexer.Indexer.ListUniqueBlobSidecarValues:output_type -> indexer.ListUniqueBlobSidecarValuesResponse
+	50,  // 405: indexer.Indexer.CreateForkChoice:output_type -> indexer.CreateForkChoiceResponse
+	94,  // 406: indexer.Indexer.ListForkChoice:output_type -> indexer.ListForkChoiceResponse
+	96,  // 407: indexer.Indexer.CountForkChoice:output_type -> indexer.CountForkChoiceResponse
+	92,  // 408: indexer.Indexer.ListUniqueForkChoiceValues:output_type -> indexer.ListUniqueForkChoiceValuesResponse
+	52,  // 409: indexer.Indexer.CreateBeaconBadBlock:output_type -> indexer.CreateBeaconBadBlockResponse
+	100, // 410: indexer.Indexer.ListBeaconBadBlock:output_type -> indexer.ListBeaconBadBlockResponse
+	102, // 411: indexer.Indexer.CountBeaconBadBlock:output_type -> indexer.CountBeaconBadBlockResponse
+	98,  // 412: indexer.Indexer.ListUniqueBeaconBadBlockValues:output_type -> indexer.ListUniqueBeaconBadBlockValuesResponse
+	54,  // 413: indexer.Indexer.CreateBeaconBadBlob:output_type -> indexer.CreateBeaconBadBlobResponse
+	106, // 414: indexer.Indexer.ListBeaconBadBlob:output_type -> indexer.ListBeaconBadBlobResponse
+	108, // 415: indexer.Indexer.CountBeaconBadBlob:output_type -> indexer.CountBeaconBadBlobResponse
+	104, // 416: indexer.Indexer.ListUniqueBeaconBadBlobValues:output_type -> indexer.ListUniqueBeaconBadBlobValuesResponse
+	56,  // 417: indexer.Indexer.CreateExecutionBlockTrace:output_type -> indexer.CreateExecutionBlockTraceResponse
+	58,  // 418: indexer.Indexer.ListExecutionBlockTrace:output_type -> indexer.ListExecutionBlockTraceResponse
+	60,  // 419: indexer.Indexer.CountExecutionBlockTrace:output_type -> indexer.CountExecutionBlockTraceResponse
+	62,  // 420: indexer.Indexer.ListUniqueExecutionBlockTraceValues:output_type -> indexer.ListUniqueExecutionBlockTraceValuesResponse
+	64,  // 421: indexer.Indexer.CreateExecutionWitness:output_type -> indexer.CreateExecutionWitnessResponse
+	66,  // 422: indexer.Indexer.ListExecutionWitness:output_type -> indexer.ListExecutionWitnessResponse
+	68,  // 423: indexer.Indexer.CountExecutionWitness:output_type -> indexer.CountExecutionWitnessResponse
+	70,  // 424: indexer.Indexer.ListUniqueExecutionWitnessValues:output_type -> indexer.ListUniqueExecutionWitnessValuesResponse
+	111, // 425: indexer.Indexer.CreateExecutionBadBlock:output_type -> indexer.CreateExecutionBadBlockResponse
+	113, // 426: indexer.Indexer.ListExecutionBadBlock:output_type -> indexer.ListExecutionBadBlockResponse
+	115, // 427: indexer.Indexer.CountExecutionBadBlock:output_type -> indexer.CountExecutionBadBlockResponse
+	117, // 428: indexer.Indexer.ListUniqueExecutionBadBlockValues:output_type -> indexer.ListUniqueExecutionBadBlockValuesResponse
+	30,  // 429: indexer.Indexer.MarkOrphaned:output_type -> indexer.MarkOrphanedResponse
+	33,  // 430: indexer.Indexer.ListIndexedKeys:output_type -> indexer.ListIndexedKeysResponse
+	44,  // 431: indexer.Indexer.UploadBeaconState:output_type -> indexer.CreateBeaconStateResponse
+	46,  // 432: indexer.Indexer.UploadBeaconBlock:output_type -> indexer.CreateBeaconBlockResponse
+	48,  // 433: indexer.Indexer.UploadBlobSidecar:output_type -> indexer.CreateBlobSidecarResponse
+	50,  // 434: indexer.Indexer.UploadForkChoice:output_type -> indexer.CreateForkChoiceResponse
+	52,  // 435: indexer.Indexer.UploadBeaconBadBlock:output_type -> indexer.CreateBeaconBadBlockResponse
+	54,  // 436: indexer.Indexer.UploadBeaconBadBlob:output_type -> indexer.CreateBeaconBadBlobResponse
+	56,  // 437: indexer.Indexer.UploadExecutionBlockTrace:output_type -> indexer.CreateExecutionBlockTraceResponse
+	64,  // 438: indexer.Indexer.UploadExecutionWitness:output_type -> indexer.CreateExecutionWitnessResponse
+	111, // 439: indexer.Indexer.UploadExecutionBadBlock:output_type -> indexer.CreateExecutionBadBlockResponse
+	390, // [390:440] is the sub-list for method output_type
+	340, // [340:390] is the sub-list for method input_type
+	340, // [340:340] is the sub-list for extension type_name
+	340, // [340:340] is the sub-list for extension extendee
+	0,   // [0:340] is the sub-list for field type_name
 }
 
 func init() { file_indexer_indexer_proto_init() }
@@ -7547,8 +12988,440 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeaconBlock); i {
+		file_indexer_indexer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobSidecar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkChoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconBadBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconBadBlob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionBlockTrace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionWitness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionBadBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateDivergence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthereumConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthereumNetworkConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToolsConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitRepositoryConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ZcliConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStorageHandshakeTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStorageHandshakeTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkOrphanedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkOrphanedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIndexedKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexedKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIndexedKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadBeaconStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadBeaconBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadBlobSidecarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadForkChoiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadBeaconBadBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadBeaconBadBlobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadExecutionBlockTraceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadExecutionWitnessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadExecutionBadBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBeaconStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBeaconStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBeaconBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBeaconBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7559,8 +13432,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeaconBadBlock); i {
+		file_indexer_indexer_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBlobSidecarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7571,8 +13444,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeaconBadBlob); i {
+		file_indexer_indexer_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBlobSidecarResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7583,8 +13456,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutionBlockTrace); i {
+		file_indexer_indexer_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateForkChoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7595,8 +13468,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutionBadBlock); i {
+		file_indexer_indexer_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateForkChoiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7607,8 +13480,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+		file_indexer_indexer_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBeaconBadBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7619,8 +13492,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthereumConfig); i {
+		file_indexer_indexer_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBeaconBadBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7631,8 +13504,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthereumNetworkConfig); i {
+		file_indexer_indexer_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBeaconBadBlobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7643,8 +13516,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToolsConfig); i {
+		file_indexer_indexer_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBeaconBadBlobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7655,8 +13528,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitRepositoryConfig); i {
+		file_indexer_indexer_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExecutionBlockTraceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7667,8 +13540,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZcliConfig); i {
+		file_indexer_indexer_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExecutionBlockTraceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7679,8 +13552,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigRequest); i {
+		file_indexer_indexer_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExecutionBlockTraceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7691,8 +13564,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigResponse); i {
+		file_indexer_indexer_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExecutionBlockTraceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7703,8 +13576,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStorageHandshakeTokenRequest); i {
+		file_indexer_indexer_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountExecutionBlockTraceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7715,8 +13588,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStorageHandshakeTokenResponse); i {
+		file_indexer_indexer_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountExecutionBlockTraceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7727,8 +13600,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBeaconStateRequest); i {
+		file_indexer_indexer_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueExecutionBlockTraceValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7739,8 +13612,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBeaconStateResponse); i {
+		file_indexer_indexer_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueExecutionBlockTraceValuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7751,8 +13624,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBeaconBlockRequest); i {
+		file_indexer_indexer_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExecutionWitnessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7763,8 +13636,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBeaconBlockResponse); i {
+		file_indexer_indexer_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExecutionWitnessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7775,8 +13648,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBeaconBadBlockRequest); i {
+		file_indexer_indexer_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExecutionWitnessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7787,8 +13660,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBeaconBadBlockResponse); i {
+		file_indexer_indexer_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExecutionWitnessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7799,8 +13672,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBeaconBadBlobRequest); i {
+		file_indexer_indexer_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountExecutionWitnessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7811,8 +13684,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBeaconBadBlobResponse); i {
+		file_indexer_indexer_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountExecutionWitnessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7823,8 +13696,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateExecutionBlockTraceRequest); i {
+		file_indexer_indexer_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueExecutionWitnessValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7835,8 +13708,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateExecutionBlockTraceResponse); i {
+		file_indexer_indexer_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueExecutionWitnessValuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7847,8 +13720,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListExecutionBlockTraceRequest); i {
+		file_indexer_indexer_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBeaconStateValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7859,8 +13732,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListExecutionBlockTraceResponse); i {
+		file_indexer_indexer_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBeaconStateValuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7871,8 +13744,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountExecutionBlockTraceRequest); i {
+		file_indexer_indexer_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeaconStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7883,8 +13756,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountExecutionBlockTraceResponse); i {
+		file_indexer_indexer_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeaconStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7895,8 +13768,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUniqueExecutionBlockTraceValuesRequest); i {
+		file_indexer_indexer_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBeaconStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7907,8 +13780,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUniqueExecutionBlockTraceValuesResponse); i {
+		file_indexer_indexer_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBeaconStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7919,8 +13792,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUniqueBeaconStateValuesRequest); i {
+		file_indexer_indexer_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStateDivergencesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7931,8 +13804,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUniqueBeaconStateValuesResponse); i {
+		file_indexer_indexer_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStateDivergencesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7943,8 +13816,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeaconStateRequest); i {
+		file_indexer_indexer_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBeaconBlockValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7955,8 +13828,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeaconStateResponse); i {
+		file_indexer_indexer_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBeaconBlockValuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7967,8 +13840,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountBeaconStateRequest); i {
+		file_indexer_indexer_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeaconBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7979,8 +13852,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountBeaconStateResponse); i {
+		file_indexer_indexer_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeaconBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7991,8 +13864,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUniqueBeaconBlockValuesRequest); i {
+		file_indexer_indexer_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBeaconBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8003,8 +13876,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUniqueBeaconBlockValuesResponse); i {
+		file_indexer_indexer_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBeaconBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8015,8 +13888,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeaconBlockRequest); i {
+		file_indexer_indexer_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBlobSidecarValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8027,8 +13900,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeaconBlockResponse); i {
+		file_indexer_indexer_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueBlobSidecarValuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8039,8 +13912,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountBeaconBlockRequest); i {
+		file_indexer_indexer_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlobSidecarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8051,8 +13924,8 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountBeaconBlockResponse); i {
+		file_indexer_indexer_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlobSidecarResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8063,7 +13936,103 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBlobSidecarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountBlobSidecarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueForkChoiceValuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueForkChoiceValuesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListForkChoiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListForkChoiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountForkChoiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountForkChoiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_indexer_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueBeaconBadBlockValuesRequest); i {
 			case 0:
 				return &v.state
@@ -8075,7 +14044,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueBeaconBadBlockValuesResponse); i {
 			case 0:
 				return &v.state
@@ -8087,7 +14056,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBeaconBadBlockRequest); i {
 			case 0:
 				return &v.state
@@ -8099,7 +14068,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBeaconBadBlockResponse); i {
 			case 0:
 				return &v.state
@@ -8111,7 +14080,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountBeaconBadBlockRequest); i {
 			case 0:
 				return &v.state
@@ -8123,7 +14092,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountBeaconBadBlockResponse); i {
 			case 0:
 				return &v.state
@@ -8135,7 +14104,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueBeaconBadBlobValuesRequest); i {
 			case 0:
 				return &v.state
@@ -8147,7 +14116,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueBeaconBadBlobValuesResponse); i {
 			case 0:
 				return &v.state
@@ -8159,7 +14128,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBeaconBadBlobRequest); i {
 			case 0:
 				return &v.state
@@ -8171,7 +14140,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBeaconBadBlobResponse); i {
 			case 0:
 				return &v.state
@@ -8183,7 +14152,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountBeaconBadBlobRequest); i {
 			case 0:
 				return &v.state
@@ -8195,7 +14164,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountBeaconBadBlobResponse); i {
 			case 0:
 				return &v.state
@@ -8207,7 +14176,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PaginationCursor); i {
 			case 0:
 				return &v.state
@@ -8219,7 +14188,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateExecutionBadBlockRequest); i {
 			case 0:
 				return &v.state
@@ -8231,7 +14200,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateExecutionBadBlockResponse); i {
 			case 0:
 				return &v.state
@@ -8243,7 +14212,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListExecutionBadBlockRequest); i {
 			case 0:
 				return &v.state
@@ -8255,7 +14224,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListExecutionBadBlockResponse); i {
 			case 0:
 				return &v.state
@@ -8267,7 +14236,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountExecutionBadBlockRequest); i {
 			case 0:
 				return &v.state
@@ -8279,7 +14248,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountExecutionBadBlockResponse); i {
 			case 0:
 				return &v.state
@@ -8291,7 +14260,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueExecutionBadBlockValuesRequest); i {
 			case 0:
 				return &v.state
@@ -8303,7 +14272,7 @@ func file_indexer_indexer_proto_init() {
 				return nil
 			}
 		}
-		file_indexer_indexer_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_indexer_indexer_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUniqueExecutionBadBlockValuesResponse); i {
 			case 0:
 				return &v.state
@@ -8316,13 +14285,50 @@ func file_indexer_indexer_proto_init() {
 			}
 		}
 	}
+	file_indexer_indexer_proto_msgTypes[25].OneofWrappers = []interface{}{
+		(*UploadBeaconStateRequest_Metadata)(nil),
+		(*UploadBeaconStateRequest_Chunk)(nil),
+	}
+	file_indexer_indexer_proto_msgTypes[26].OneofWrappers = []interface{}{
+		(*UploadBeaconBlockRequest_Metadata)(nil),
+		(*UploadBeaconBlockRequest_Chunk)(nil),
+	}
+	file_indexer_indexer_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*UploadBlobSidecarRequest_Metadata)(nil),
+		(*UploadBlobSidecarRequest_Chunk)(nil),
+	}
+	file_indexer_indexer_proto_msgTypes[28].OneofWrappers = []interface{}{
+		(*UploadForkChoiceRequest_Metadata)(nil),
+		(*UploadForkChoiceRequest_Chunk)(nil),
+	}
+	file_indexer_indexer_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*UploadBeaconBadBlockRequest_Metadata)(nil),
+		(*UploadBeaconBadBlockRequest_Chunk)(nil),
+	}
+	file_indexer_indexer_proto_msgTypes[30].OneofWrappers = []interface{}{
+		(*UploadBeaconBadBlobRequest_Metadata)(nil),
+		(*UploadBeaconBadBlobRequest_Chunk)(nil),
+	}
+	file_indexer_indexer_proto_msgTypes[31].OneofWrappers = []interface{}{
+		(*UploadExecutionBlockTraceRequest_Metadata)(nil),
+		(*UploadExecutionBlockTraceRequest_Chunk)(nil),
+	}
+	file_indexer_indexer_proto_msgTypes[32].OneofWrappers = []interface{}{
+		(*UploadExecutionWitnessRequest_Metadata)(nil),
+		(*UploadExecutionWitnessRequest_Chunk)(nil),
+	}
+	file_indexer_indexer_proto_msgTypes[33].OneofWrappers = []interface{}{
+		(*UploadExecutionBadBlockRequest_Metadata)(nil),
+		(*UploadExecutionBadBlockRequest_Chunk)(nil),
+		(*UploadExecutionBadBlockRequest_File)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_indexer_indexer_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   65,
+			NumEnums:      9,
+			NumMessages:   109,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,10 +25,19 @@ const (
 	Indexer_ListBeaconState_FullMethodName                     = "/indexer.Indexer/ListBeaconState"
 	Indexer_CountBeaconState_FullMethodName                    = "/indexer.Indexer/CountBeaconState"
 	Indexer_ListUniqueBeaconStateValues_FullMethodName         = "/indexer.Indexer/ListUniqueBeaconStateValues"
+	Indexer_ListStateDivergences_FullMethodName                = "/indexer.Indexer/ListStateDivergences"
 	Indexer_CreateBeaconBlock_FullMethodName                   = "/indexer.Indexer/CreateBeaconBlock"
 	Indexer_ListBeaconBlock_FullMethodName                     = "/indexer.Indexer/ListBeaconBlock"
 	Indexer_CountBeaconBlock_FullMethodName                    = "/indexer.Indexer/CountBeaconBlock"
 	Indexer_ListUniqueBeaconBlockValues_FullMethodName         = "/indexer.Indexer/ListUniqueBeaconBlockValues"
+	Indexer_CreateBlobSidecar_FullMethodName                   = "/indexer.Indexer/CreateBlobSidecar"
+	Indexer_ListBlobSidecar_FullMethodName                     = "/indexer.Indexer/ListBlobSidecar"
+	Indexer_CountBlobSidecar_FullMethodName                    = "/indexer.Indexer/CountBlobSidecar"
+	Indexer_ListUniqueBlobSidecarValues_FullMethodName         = "/indexer.Indexer/ListUniqueBlobSidecarValues"
+	Indexer_CreateForkChoice_FullMethodName                    = "/indexer.Indexer/CreateForkChoice"
+	Indexer_ListForkChoice_FullMethodName                      = "/indexer.Indexer/ListForkChoice"
+	Indexer_CountForkChoice_FullMethodName                     = "/indexer.Indexer/CountForkChoice"
+	Indexer_ListUniqueForkChoiceValues_FullMethodName          = "/indexer.Indexer/ListUniqueForkChoiceValues"
 	Indexer_CreateBeaconBadBlock_FullMethodName                = "/indexer.Indexer/CreateBeaconBadBlock"
 	Indexer_ListBeaconBadBlock_FullMethodName                  = "/indexer.Indexer/ListBeaconBadBlock"
 	Indexer_CountBeaconBadBlock_FullMethodName                 = "/indexer.Indexer/CountBeaconBadBlock"
@@ -41,10 +50,25 @@ const (
 	Indexer_ListExecutionBlockTrace_FullMethodName             = "/indexer.Indexer/ListExecutionBlockTrace"
 	Indexer_CountExecutionBlockTrace_FullMethodName            = "/indexer.Indexer/CountExecutionBlockTrace"
 	Indexer_ListUniqueExecutionBlockTraceValues_FullMethodName = "/indexer.Indexer/ListUniqueExecutionBlockTraceValues"
+	Indexer_CreateExecutionWitness_FullMethodName              = "/indexer.Indexer/CreateExecutionWitness"
+	Indexer_ListExecutionWitness_FullMethodName                = "/indexer.Indexer/ListExecutionWitness"
+	Indexer_CountExecutionWitness_FullMethodName               = "/indexer.Indexer/CountExecutionWitness"
+	Indexer_ListUniqueExecutionWitnessValues_FullMethodName    = "/indexer.Indexer/ListUniqueExecutionWitnessValues"
 	Indexer_CreateExecutionBadBlock_FullMethodName             = "/indexer.Indexer/CreateExecutionBadBlock"
 	Indexer_ListExecutionBadBlock_FullMethodName               = "/indexer.Indexer/ListExecutionBadBlock"
 	Indexer_CountExecutionBadBlock_FullMethodName              = "/indexer.Indexer/CountExecutionBadBlock"
 	Indexer_ListUniqueExecutionBadBlockValues_FullMethodName   = "/indexer.Indexer/ListUniqueExecutionBadBlockValues"
+	Indexer_MarkOrphaned_FullMethodName                        = "/indexer.Indexer/MarkOrphaned"
+	Indexer_ListIndexedKeys_FullMethodName                     = "/indexer.Indexer/ListIndexedKeys"
+	Indexer_UploadBeaconState_FullMethodName                   = "/indexer.Indexer/UploadBeaconState"
+	Indexer_UploadBeaconBlock_FullMethodName                   = "/indexer.Indexer/UploadBeaconBlock"
+	Indexer_UploadBlobSidecar_FullMethodName                   = "/indexer.Indexer/UploadBlobSidecar"
+	Indexer_UploadForkChoice_FullMethodName                    = "/indexer.Indexer/UploadForkChoice"
+	Indexer_UploadBeaconBadBlock_FullMethodName                = "/indexer.Indexer/UploadBeaconBadBlock"
+	Indexer_UploadBeaconBadBlob_FullMethodName                 = "/indexer.Indexer/UploadBeaconBadBlob"
+	Indexer_UploadExecutionBlockTrace_FullMethodName           = "/indexer.Indexer/UploadExecutionBlockTrace"
+	Indexer_UploadExecutionWitness_FullMethodName              = "/indexer.Indexer/UploadExecutionWitness"
+	Indexer_UploadExecutionBadBlock_FullMethodName             = "/indexer.Indexer/UploadExecutionBadBlock"
 )
 
 // IndexerClient is the client API for Indexer service.
@@ -58,11 +82,22 @@ type IndexerClient interface {
 	ListBeaconState(ctx context.Context, in *ListBeaconStateRequest, opts ...grpc.CallOption) (*ListBeaconStateResponse, error)
 	CountBeaconState(ctx context.Context, in *CountBeaconStateRequest, opts ...grpc.CallOption) (*CountBeaconStateResponse, error)
 	ListUniqueBeaconStateValues(ctx context.Context, in *ListUniqueBeaconStateValuesRequest, opts ...grpc.CallOption) (*ListUniqueBeaconStateValuesResponse, error)
+	ListStateDivergences(ctx context.Context, in *ListStateDivergencesRequest, opts ...grpc.CallOption) (*ListStateDivergencesResponse, error)
 	// BeaconBlock
 	CreateBeaconBlock(ctx context.Context, in *CreateBeaconBlockRequest, opts ...grpc.CallOption) (*CreateBeaconBlockResponse, error)
 	ListBeaconBlock(ctx context.Context, in *ListBeaconBlockRequest, opts ...grpc.CallOption) (*ListBeaconBlockResponse, error)
 	CountBeaconBlock(ctx context.Context, in *CountBeaconBlockRequest, opts ...grpc.CallOption) (*CountBeaconBlockResponse, error)
 	ListUniqueBeaconBlockValues(ctx context.Context, in *ListUniqueBeaconBlockValuesRequest, opts ...grpc.CallOption) (*ListUniqueBeaconBlockValuesResponse, error)
+	// BlobSidecar
+	CreateBlobSidecar(ctx context.Context, in *CreateBlobSidecarRequest, opts ...grpc.CallOption) (*CreateBlobSidecarResponse, error)
+	ListBlobSidecar(ctx context.Context, in *ListBlobSidecarRequest, opts ...grpc.CallOption) (*ListBlobSidecarResponse, error)
+	CountBlobSidecar(ctx context.Context, in *CountBlobSidecarRequest, opts ...grpc.CallOption) (*CountBlobSidecarResponse, error)
+	ListUniqueBlobSidecarValues(ctx context.Context, in *ListUniqueBlobSidecarValuesRequest, opts ...grpc.CallOption) (*ListUniqueBlobSidecarValuesResponse, error)
+	// ForkChoice
+	CreateForkChoice(ctx context.Context, in *CreateForkChoiceRequest, opts ...grpc.CallOption) (*CreateForkChoiceResponse, error)
+	ListForkChoice(ctx context.Context, in *ListForkChoiceRequest, opts ...grpc.CallOption) (*ListForkChoiceResponse, error)
+	CountForkChoice(ctx context.Context, in *CountForkChoiceRequest, opts ...grpc.CallOption) (*CountForkChoiceResponse, error)
+	ListUniqueForkChoiceValues(ctx context.Context, in *ListUniqueForkChoiceValuesRequest, opts ...grpc.CallOption) (*ListUniqueForkChoiceValuesResponse, error)
 	// BeaconBadBlock
 	CreateBeaconBadBlock(ctx context.Context, in *CreateBeaconBadBlockRequest, opts ...grpc.CallOption) (*CreateBeaconBadBlockResponse, error)
 	ListBeaconBadBlock(ctx context.Context, in *ListBeaconBadBlockRequest, opts ...grpc.CallOption) (*ListBeaconBadBlockResponse, error)
@@ -78,11 +113,31 @@ type IndexerClient interface {
 	ListExecutionBlockTrace(ctx context.Context, in *ListExecutionBlockTraceRequest, opts ...grpc.CallOption) (*ListExecutionBlockTraceResponse, error)
 	CountExecutionBlockTrace(ctx context.Context, in *CountExecutionBlockTraceRequest, opts ...grpc.CallOption) (*CountExecutionBlockTraceResponse, error)
 	ListUniqueExecutionBlockTraceValues(ctx context.Context, in *ListUniqueExecutionBlockTraceValuesRequest, opts ...grpc.CallOption) (*ListUniqueExecutionBlockTraceValuesResponse, error)
+	// ExecutionWitness
+	CreateExecutionWitness(ctx context.Context, in *CreateExecutionWitnessRequest, opts ...grpc.CallOption) (*CreateExecutionWitnessResponse, error)
+	ListExecutionWitness(ctx context.Context, in *ListExecutionWitnessRequest, opts ...grpc.CallOption) (*ListExecutionWitnessResponse, error)
+	CountExecutionWitness(ctx context.Context, in *CountExecutionWitnessRequest, opts ...grpc.CallOption) (*CountExecutionWitnessResponse, error)
+	ListUniqueExecutionWitnessValues(ctx context.Context, in *ListUniqueExecutionWitnessValuesRequest, opts ...grpc.CallOption) (*ListUniqueExecutionWitnessValuesResponse, error)
 	// ExecutionBadBlock
 	CreateExecutionBadBlock(ctx context.Context, in *CreateExecutionBadBlockRequest, opts ...grpc.CallOption) (*CreateExecutionBadBlockResponse, error)
 	ListExecutionBadBlock(ctx context.Context, in *ListExecutionBadBlockRequest, opts ...grpc.CallOption) (*ListExecutionBadBlockResponse, error)
 	CountExecutionBadBlock(ctx context.Context, in *CountExecutionBadBlockRequest, opts ...grpc.CallOption) (*CountExecutionBadBlockResponse, error)
 	ListUniqueExecutionBadBlockValues(ctx context.Context, in *ListUniqueExecutionBadBlockValuesRequest, opts ...grpc.CallOption) (*ListUniqueExecutionBadBlockValuesResponse, error)
+	// Reorgs
+	MarkOrphaned(ctx context.Context, in *MarkOrphanedRequest, opts ...grpc.CallOption) (*MarkOrphanedResponse, error)
+	// Agent index cache
+	ListIndexedKeys(ctx context.Context, in *ListIndexedKeysRequest, opts ...grpc.CallOption) (*ListIndexedKeysResponse, error)
+	// Uploads, for agents that don't have access to the store. The server saves
+	// the data to its store and indexes it in one step.
+	UploadBeaconState(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadBeaconStateClient, error)
+	UploadBeaconBlock(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadBeaconBlockClient, error)
+	UploadBlobSidecar(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadBlobSidecarClient, error)
+	UploadForkChoice(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadForkChoiceClient, error)
+	UploadBeaconBadBlock(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadBeaconBadBlockClient, error)
+	UploadBeaconBadBlob(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadBeaconBadBlobClient, error)
+	UploadExecutionBlockTrace(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadExecutionBlockTraceClient, error)
+	UploadExecutionWitness(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadExecutionWitnessClient, error)
+	UploadExecutionBadBlock(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadExecutionBadBlockClient, error)
 }
 
 type indexerClient struct {
@@ -147,6 +202,15 @@ func (c *indexerClient) ListUniqueBeaconStateValues(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *indexerClient) ListStateDivergences(ctx context.Context, in *ListStateDivergencesRequest, opts ...grpc.CallOption) (*ListStateDivergencesResponse, error) {
+	out := new(ListStateDivergencesResponse)
+	err := c.cc.Invoke(ctx, Indexer_ListStateDivergences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexerClient) CreateBeaconBlock(ctx context.Context, in *CreateBeaconBlockRequest, opts ...grpc.CallOption) (*CreateBeaconBlockResponse, error) {
 	out := new(CreateBeaconBlockResponse)
 	err := c.cc.Invoke(ctx, Indexer_CreateBeaconBlock_FullMethodName, in, out, opts...)
@@ -183,6 +247,78 @@ func (c *indexerClient) ListUniqueBeaconBlockValues(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *indexerClient) CreateBlobSidecar(ctx context.Context, in *CreateBlobSidecarRequest, opts ...grpc.CallOption) (*CreateBlobSidecarResponse, error) {
+	out := new(CreateBlobSidecarResponse)
+	err := c.cc.Invoke(ctx, Indexer_CreateBlobSidecar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListBlobSidecar(ctx context.Context, in *ListBlobSidecarRequest, opts ...grpc.CallOption) (*ListBlobSidecarResponse, error) {
+	out := new(ListBlobSidecarResponse)
+	err := c.cc.Invoke(ctx, Indexer_ListBlobSidecar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) CountBlobSidecar(ctx context.Context, in *CountBlobSidecarRequest, opts ...grpc.CallOption) (*CountBlobSidecarResponse, error) {
+	out := new(CountBlobSidecarResponse)
+	err := c.cc.Invoke(ctx, Indexer_CountBlobSidecar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListUniqueBlobSidecarValues(ctx context.Context, in *ListUniqueBlobSidecarValuesRequest, opts ...grpc.CallOption) (*ListUniqueBlobSidecarValuesResponse, error) {
+	out := new(ListUniqueBlobSidecarValuesResponse)
+	err := c.cc.Invoke(ctx, Indexer_ListUniqueBlobSidecarValues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) CreateForkChoice(ctx context.Context, in *CreateForkChoiceRequest, opts ...grpc.CallOption) (*CreateForkChoiceResponse, error) {
+	out := new(CreateForkChoiceResponse)
+	err := c.cc.Invoke(ctx, Indexer_CreateForkChoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListForkChoice(ctx context.Context, in *ListForkChoiceRequest, opts ...grpc.CallOption) (*ListForkChoiceResponse, error) {
+	out := new(ListForkChoiceResponse)
+	err := c.cc.Invoke(ctx, Indexer_ListForkChoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) CountForkChoice(ctx context.Context, in *CountForkChoiceRequest, opts ...grpc.CallOption) (*CountForkChoiceResponse, error) {
+	out := new(CountForkChoiceResponse)
+	err := c.cc.Invoke(ctx, Indexer_CountForkChoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListUniqueForkChoiceValues(ctx context.Context, in *ListUniqueForkChoiceValuesRequest, opts ...grpc.CallOption) (*ListUniqueForkChoiceValuesResponse, error) {
+	out := new(ListUniqueForkChoiceValuesResponse)
+	err := c.cc.Invoke(ctx, Indexer_ListUniqueForkChoiceValues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexerClient) CreateBeaconBadBlock(ctx context.Context, in *CreateBeaconBadBlockRequest, opts ...grpc.CallOption) (*CreateBeaconBadBlockResponse, error) {
 	out := new(CreateBeaconBadBlockResponse)
 	err := c.cc.Invoke(ctx, Indexer_CreateBeaconBadBlock_FullMethodName, in, out, opts...)
@@ -291,6 +427,42 @@ func (c *indexerClient) ListUniqueExecutionBlockTraceValues(ctx context.Context,
 	return out, nil
 }
 
+func (c *indexerClient) CreateExecutionWitness(ctx context.Context, in *CreateExecutionWitnessRequest, opts ...grpc.CallOption) (*CreateExecutionWitnessResponse, error) {
+	out := new(CreateExecutionWitnessResponse)
+	err := c.cc.Invoke(ctx, Indexer_CreateExecutionWitness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListExecutionWitness(ctx context.Context, in *ListExecutionWitnessRequest, opts ...grpc.CallOption) (*ListExecutionWitnessResponse, error) {
+	out := new(ListExecutionWitnessResponse)
+	err := c.cc.Invoke(ctx, Indexer_ListExecutionWitness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) CountExecutionWitness(ctx context.Context, in *CountExecutionWitnessRequest, opts ...grpc.CallOption) (*CountExecutionWitnessResponse, error) {
+	out := new(CountExecutionWitnessResponse)
+	err := c.cc.Invoke(ctx, Indexer_CountExecutionWitness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListUniqueExecutionWitnessValues(ctx context.Context, in *ListUniqueExecutionWitnessValuesRequest, opts ...grpc.CallOption) (*ListUniqueExecutionWitnessValuesResponse, error) {
+	out := new(ListUniqueExecutionWitnessValuesResponse)
+	err := c.cc.Invoke(ctx, Indexer_ListUniqueExecutionWitnessValues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexerClient) CreateExecutionBadBlock(ctx context.Context, in *CreateExecutionBadBlockRequest, opts ...grpc.CallOption) (*CreateExecutionBadBlockResponse, error) {
 	out := new(CreateExecutionBadBlockResponse)
 	err := c.cc.Invoke(ctx, Indexer_CreateExecutionBadBlock_FullMethodName, in, out, opts...)
@@ -327,6 +499,330 @@ func (c *indexerClient) ListUniqueExecutionBadBlockValues(ctx context.Context, i
 	return out, nil
 }
 
+func (c *indexerClient) MarkOrphaned(ctx context.Context, in *MarkOrphanedRequest, opts ...grpc.CallOption) (*MarkOrphanedResponse, error) {
+	out := new(MarkOrphanedResponse)
+	err := c.cc.Invoke(ctx, Indexer_MarkOrphaned_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) ListIndexedKeys(ctx context.Context, in *ListIndexedKeysRequest, opts ...grpc.CallOption) (*ListIndexedKeysResponse, error) {
+	out := new(ListIndexedKeysResponse)
+	err := c.cc.Invoke(ctx, Indexer_ListIndexedKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) UploadBeaconState(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadBeaconStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[0], Indexer_UploadBeaconState_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerUploadBeaconStateClient{stream}
+	return x, nil
+}
+
+type Indexer_UploadBeaconStateClient interface {
+	Send(*UploadBeaconStateRequest) error
+	CloseAndRecv() (*CreateBeaconStateResponse, error)
+	grpc.ClientStream
+}
+
+type indexerUploadBeaconStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerUploadBeaconStateClient) Send(m *UploadBeaconStateRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *indexerUploadBeaconStateClient) CloseAndRecv() (*CreateBeaconStateResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateBeaconStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) UploadBeaconBlock(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadBeaconBlockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[1], Indexer_UploadBeaconBlock_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerUploadBeaconBlockClient{stream}
+	return x, nil
+}
+
+type Indexer_UploadBeaconBlockClient interface {
+	Send(*UploadBeaconBlockRequest) error
+	CloseAndRecv() (*CreateBeaconBlockResponse, error)
+	grpc.ClientStream
+}
+
+type indexerUploadBeaconBlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerUploadBeaconBlockClient) Send(m *UploadBeaconBlockRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *indexerUploadBeaconBlockClient) CloseAndRecv() (*CreateBeaconBlockResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateBeaconBlockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) UploadBlobSidecar(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadBlobSidecarClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[2], Indexer_UploadBlobSidecar_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerUploadBlobSidecarClient{stream}
+	return x, nil
+}
+
+type Indexer_UploadBlobSidecarClient interface {
+	Send(*UploadBlobSidecarRequest) error
+	CloseAndRecv() (*CreateBlobSidecarResponse, error)
+	grpc.ClientStream
+}
+
+type indexerUploadBlobSidecarClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerUploadBlobSidecarClient) Send(m *UploadBlobSidecarRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *indexerUploadBlobSidecarClient) CloseAndRecv() (*CreateBlobSidecarResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateBlobSidecarResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) UploadForkChoice(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadForkChoiceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[3], Indexer_UploadForkChoice_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerUploadForkChoiceClient{stream}
+	return x, nil
+}
+
+type Indexer_UploadForkChoiceClient interface {
+	Send(*UploadForkChoiceRequest) error
+	CloseAndRecv() (*CreateForkChoiceResponse, error)
+	grpc.ClientStream
+}
+
+type indexerUploadForkChoiceClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerUploadForkChoiceClient) Send(m *UploadForkChoiceRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *indexerUploadForkChoiceClient) CloseAndRecv() (*CreateForkChoiceResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateForkChoiceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) UploadBeaconBadBlock(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadBeaconBadBlockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[4], Indexer_UploadBeaconBadBlock_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerUploadBeaconBadBlockClient{stream}
+	return x, nil
+}
+
+type Indexer_UploadBeaconBadBlockClient interface {
+	Send(*UploadBeaconBadBlockRequest) error
+	CloseAndRecv() (*CreateBeaconBadBlockResponse, error)
+	grpc.ClientStream
+}
+
+type indexerUploadBeaconBadBlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerUploadBeaconBadBlockClient) Send(m *UploadBeaconBadBlockRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *indexerUploadBeaconBadBlockClient) CloseAndRecv() (*CreateBeaconBadBlockResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateBeaconBadBlockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) UploadBeaconBadBlob(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadBeaconBadBlobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[5], Indexer_UploadBeaconBadBlob_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerUploadBeaconBadBlobClient{stream}
+	return x, nil
+}
+
+type Indexer_UploadBeaconBadBlobClient interface {
+	Send(*UploadBeaconBadBlobRequest) error
+	CloseAndRecv() (*CreateBeaconBadBlobResponse, error)
+	grpc.ClientStream
+}
+
+type indexerUploadBeaconBadBlobClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerUploadBeaconBadBlobClient) Send(m *UploadBeaconBadBlobRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *indexerUploadBeaconBadBlobClient) CloseAndRecv() (*CreateBeaconBadBlobResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateBeaconBadBlobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) UploadExecutionBlockTrace(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadExecutionBlockTraceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[6], Indexer_UploadExecutionBlockTrace_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerUploadExecutionBlockTraceClient{stream}
+	return x, nil
+}
+
+type Indexer_UploadExecutionBlockTraceClient interface {
+	Send(*UploadExecutionBlockTraceRequest) error
+	CloseAndRecv() (*CreateExecutionBlockTraceResponse, error)
+	grpc.ClientStream
+}
+
+type indexerUploadExecutionBlockTraceClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerUploadExecutionBlockTraceClient) Send(m *UploadExecutionBlockTraceRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *indexerUploadExecutionBlockTraceClient) CloseAndRecv() (*CreateExecutionBlockTraceResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateExecutionBlockTraceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) UploadExecutionWitness(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadExecutionWitnessClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[7], Indexer_UploadExecutionWitness_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerUploadExecutionWitnessClient{stream}
+	return x, nil
+}
+
+type Indexer_UploadExecutionWitnessClient interface {
+	Send(*UploadExecutionWitnessRequest) error
+	CloseAndRecv() (*CreateExecutionWitnessResponse, error)
+	grpc.ClientStream
+}
+
+type indexerUploadExecutionWitnessClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerUploadExecutionWitnessClient) Send(m *UploadExecutionWitnessRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *indexerUploadExecutionWitnessClient) CloseAndRecv() (*CreateExecutionWitnessResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateExecutionWitnessResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) UploadExecutionBadBlock(ctx context.Context, opts ...grpc.CallOption) (Indexer_UploadExecutionBadBlockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[8], Indexer_UploadExecutionBadBlock_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerUploadExecutionBadBlockClient{stream}
+	return x, nil
+}
+
+type Indexer_UploadExecutionBadBlockClient interface {
+	Send(*UploadExecutionBadBlockRequest) error
+	CloseAndRecv() (*CreateExecutionBadBlockResponse, error)
+	grpc.ClientStream
+}
+
+type indexerUploadExecutionBadBlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerUploadExecutionBadBlockClient) Send(m *UploadExecutionBadBlockRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *indexerUploadExecutionBadBlockClient) CloseAndRecv() (*CreateExecutionBadBlockResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateExecutionBadBlockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // IndexerServer is the server API for Indexer service.
 // All implementations must embed UnimplementedIndexerServer
 // for forward compatibility
@@ -338,11 +834,22 @@ type IndexerServer interface {
 	ListBeaconState(context.Context, *ListBeaconStateRequest) (*ListBeaconStateResponse, error)
 	CountBeaconState(context.Context, *CountBeaconStateRequest) (*CountBeaconStateResponse, error)
 	ListUniqueBeaconStateValues(context.Context, *ListUniqueBeaconStateValuesRequest) (*ListUniqueBeaconStateValuesResponse, error)
+	ListStateDivergences(context.Context, *ListStateDivergencesRequest) (*ListStateDivergencesResponse, error)
 	// BeaconBlock
 	CreateBeaconBlock(context.Context, *CreateBeaconBlockRequest) (*CreateBeaconBlockResponse, error)
 	ListBeaconBlock(context.Context, *ListBeaconBlockRequest) (*ListBeaconBlockResponse, error)
 	CountBeaconBlock(context.Context, *CountBeaconBlockRequest) (*CountBeaconBlockResponse, error)
 	ListUniqueBeaconBlockValues(context.Context, *ListUniqueBeaconBlockValuesRequest) (*ListUniqueBeaconBlockValuesResponse, error)
+	// BlobSidecar
+	CreateBlobSidecar(context.Context, *CreateBlobSidecarRequest) (*CreateBlobSidecarResponse, error)
+	ListBlobSidecar(context.Context, *ListBlobSidecarRequest) (*ListBlobSidecarResponse, error)
+	CountBlobSidecar(context.Context, *CountBlobSidecarRequest) (*CountBlobSidecarResponse, error)
+	ListUniqueBlobSidecarValues(context.Context, *ListUniqueBlobSidecarValuesRequest) (*ListUniqueBlobSidecarValuesResponse, error)
+	// ForkChoice
+	CreateForkChoice(context.Context, *CreateForkChoiceRequest) (*CreateForkChoiceResponse, error)
+	ListForkChoice(context.Context, *ListForkChoiceRequest) (*ListForkChoiceResponse, error)
+	CountForkChoice(context.Context, *CountForkChoiceRequest) (*CountForkChoiceResponse, error)
+	ListUniqueForkChoiceValues(context.Context, *ListUniqueForkChoiceValuesRequest) (*ListUniqueForkChoiceValuesResponse, error)
 	// BeaconBadBlock
 	CreateBeaconBadBlock(context.Context, *CreateBeaconBadBlockRequest) (*CreateBeaconBadBlockResponse, error)
 	ListBeaconBadBlock(context.Context, *ListBeaconBadBlockRequest) (*ListBeaconBadBlockResponse, error)
@@ -358,11 +865,31 @@ type IndexerServer interface {
 	ListExecutionBlockTrace(context.Context, *ListExecutionBlockTraceRequest) (*ListExecutionBlockTraceResponse, error)
 	CountExecutionBlockTrace(context.Context, *CountExecutionBlockTraceRequest) (*CountExecutionBlockTraceResponse, error)
 	ListUniqueExecutionBlockTraceValues(context.Context, *ListUniqueExecutionBlockTraceValuesRequest) (*ListUniqueExecutionBlockTraceValuesResponse, error)
+	// ExecutionWitness
+	CreateExecutionWitness(context.Context, *CreateExecutionWitnessRequest) (*CreateExecutionWitnessResponse, error)
+	ListExecutionWitness(context.Context, *ListExecutionWitnessRequest) (*ListExecutionWitnessResponse, error)
+	CountExecutionWitness(context.Context, *CountExecutionWitnessRequest) (*CountExecutionWitnessResponse, error)
+	ListUniqueExecutionWitnessValues(context.Context, *ListUniqueExecutionWitnessValuesRequest) (*ListUniqueExecutionWitnessValuesResponse, error)
 	// ExecutionBadBlock
 	CreateExecutionBadBlock(context.Context, *CreateExecutionBadBlockRequest) (*CreateExecutionBadBlockResponse, error)
 	ListExecutionBadBlock(context.Context, *ListExecutionBadBlockRequest) (*ListExecutionBadBlockResponse, error)
 	CountExecutionBadBlock(context.Context, *CountExecutionBadBlockRequest) (*CountExecutionBadBlockResponse, error)
 	ListUniqueExecutionBadBlockValues(context.Context, *ListUniqueExecutionBadBlockValuesRequest) (*ListUniqueExecutionBadBlockValuesResponse, error)
+	// Reorgs
+	MarkOrphaned(context.Context, *MarkOrphanedRequest) (*MarkOrphanedResponse, error)
+	// Agent index cache
+	ListIndexedKeys(context.Context, *ListIndexedKeysRequest) (*ListIndexedKeysResponse, error)
+	// Uploads, for agents that don't have access to the store. The server saves
+	// the data to its store and indexes it in one step.
+	UploadBeaconState(Indexer_UploadBeaconStateServer) error
+	UploadBeaconBlock(Indexer_UploadBeaconBlockServer) error
+	UploadBlobSidecar(Indexer_UploadBlobSidecarServer) error
+	UploadForkChoice(Indexer_UploadForkChoiceServer) error
+	UploadBeaconBadBlock(Indexer_UploadBeaconBadBlockServer) error
+	UploadBeaconBadBlob(Indexer_UploadBeaconBadBlobServer) error
+	UploadExecutionBlockTrace(Indexer_UploadExecutionBlockTraceServer) error
+	UploadExecutionWitness(Indexer_UploadExecutionWitnessServer) error
+	UploadExecutionBadBlock(Indexer_UploadExecutionBadBlockServer) error
 	mustEmbedUnimplementedIndexerServer()
 }
 
@@ -388,6 +915,9 @@ func (UnimplementedIndexerServer) CountBeaconState(context.Context, *CountBeacon
 func (UnimplementedIndexerServer) ListUniqueBeaconStateValues(context.Context, *ListUniqueBeaconStateValuesRequest) (*ListUniqueBeaconStateValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueBeaconStateValues not implemented")
 }
+func (UnimplementedIndexerServer) ListStateDivergences(context.Context, *ListStateDivergencesRequest) (*ListStateDivergencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStateDivergences not implemented")
+}
 func (UnimplementedIndexerServer) CreateBeaconBlock(context.Context, *CreateBeaconBlockRequest) (*CreateBeaconBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBeaconBlock not implemented")
 }
@@ -400,6 +930,30 @@ func (UnimplementedIndexerServer) CountBeaconBlock(context.Context, *CountBeacon
 func (UnimplementedIndexerServer) ListUniqueBeaconBlockValues(context.Context, *ListUniqueBeaconBlockValuesRequest) (*ListUniqueBeaconBlockValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueBeaconBlockValues not implemented")
 }
+func (UnimplementedIndexerServer) CreateBlobSidecar(context.Context, *CreateBlobSidecarRequest) (*CreateBlobSidecarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlobSidecar not implemented")
+}
+func (UnimplementedIndexerServer) ListBlobSidecar(context.Context, *ListBlobSidecarRequest) (*ListBlobSidecarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlobSidecar not implemented")
+}
+func (UnimplementedIndexerServer) CountBlobSidecar(context.Context, *CountBlobSidecarRequest) (*CountBlobSidecarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountBlobSidecar not implemented")
+}
+func (UnimplementedIndexerServer) ListUniqueBlobSidecarValues(context.Context, *ListUniqueBlobSidecarValuesRequest) (*ListUniqueBlobSidecarValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueBlobSidecarValues not implemented")
+}
+func (UnimplementedIndexerServer) CreateForkChoice(context.Context, *CreateForkChoiceRequest) (*CreateForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateForkChoice not implemented")
+}
+func (UnimplementedIndexerServer) ListForkChoice(context.Context, *ListForkChoiceRequest) (*ListForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForkChoice not implemented")
+}
+func (UnimplementedIndexerServer) CountForkChoice(context.Context, *CountForkChoiceRequest) (*CountForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountForkChoice not implemented")
+}
+func (UnimplementedIndexerServer) ListUniqueForkChoiceValues(context.Context, *ListUniqueForkChoiceValuesRequest) (*ListUniqueForkChoiceValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueForkChoiceValues not implemented")
+}
 func (UnimplementedIndexerServer) CreateBeaconBadBlock(context.Context, *CreateBeaconBadBlockRequest) (*CreateBeaconBadBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBeaconBadBlock not implemented")
 }
@@ -436,6 +990,18 @@ func (UnimplementedIndexerServer) CountExecutionBlockTrace(context.Context, *Cou
 func (UnimplementedIndexerServer) ListUniqueExecutionBlockTraceValues(context.Context, *ListUniqueExecutionBlockTraceValuesRequest) (*ListUniqueExecutionBlockTraceValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueExecutionBlockTraceValues not implemented")
 }
+func (UnimplementedIndexerServer) CreateExecutionWitness(context.Context, *CreateExecutionWitnessRequest) (*CreateExecutionWitnessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExecutionWitness not implemented")
+}
+func (UnimplementedIndexerServer) ListExecutionWitness(context.Context, *ListExecutionWitnessRequest) (*ListExecutionWitnessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExecutionWitness not implemented")
+}
+func (UnimplementedIndexerServer) CountExecutionWitness(context.Context, *CountExecutionWitnessRequest) (*CountExecutionWitnessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountExecutionWitness not implemented")
+}
+func (UnimplementedIndexerServer) ListUniqueExecutionWitnessValues(context.Context, *ListUniqueExecutionWitnessValuesRequest) (*ListUniqueExecutionWitnessValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueExecutionWitnessValues not implemented")
+}
 func (UnimplementedIndexerServer) CreateExecutionBadBlock(context.Context, *CreateExecutionBadBlockRequest) (*CreateExecutionBadBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateExecutionBadBlock not implemented")
 }
@@ -448,6 +1014,39 @@ func (UnimplementedIndexerServer) CountExecutionBadBlock(context.Context, *Count
 func (UnimplementedIndexerServer) ListUniqueExecutionBadBlockValues(context.Context, *ListUniqueExecutionBadBlockValuesRequest) (*ListUniqueExecutionBadBlockValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUniqueExecutionBadBlockValues not implemented")
 }
+func (UnimplementedIndexerServer) MarkOrphaned(context.Context, *MarkOrphanedRequest) (*MarkOrphanedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkOrphaned not implemented")
+}
+func (UnimplementedIndexerServer) ListIndexedKeys(context.Context, *ListIndexedKeysRequest) (*ListIndexedKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexedKeys not implemented")
+}
+func (UnimplementedIndexerServer) UploadBeaconState(Indexer_UploadBeaconStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadBeaconState not implemented")
+}
+func (UnimplementedIndexerServer) UploadBeaconBlock(Indexer_UploadBeaconBlockServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadBeaconBlock not implemented")
+}
+func (UnimplementedIndexerServer) UploadBlobSidecar(Indexer_UploadBlobSidecarServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadBlobSidecar not implemented")
+}
+func (UnimplementedIndexerServer) UploadForkChoice(Indexer_UploadForkChoiceServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadForkChoice not implemented")
+}
+func (UnimplementedIndexerServer) UploadBeaconBadBlock(Indexer_UploadBeaconBadBlockServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadBeaconBadBlock not implemented")
+}
+func (UnimplementedIndexerServer) UploadBeaconBadBlob(Indexer_UploadBeaconBadBlobServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadBeaconBadBlob not implemented")
+}
+func (UnimplementedIndexerServer) UploadExecutionBlockTrace(Indexer_UploadExecutionBlockTraceServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadExecutionBlockTrace not implemented")
+}
+func (UnimplementedIndexerServer) UploadExecutionWitness(Indexer_UploadExecutionWitnessServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadExecutionWitness not implemented")
+}
+func (UnimplementedIndexerServer) UploadExecutionBadBlock(Indexer_UploadExecutionBadBlockServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadExecutionBadBlock not implemented")
+}
 func (UnimplementedIndexerServer) mustEmbedUnimplementedIndexerServer() {}
 
 // UnsafeIndexerServer may be embedded to opt out of forward compatibility for this service.
@@ -564,79 +1163,241 @@ func _Indexer_ListUniqueBeaconStateValues_Handler(srv interface{}, ctx context.C
 		FullMethod: Indexer_ListUniqueBeaconStateValues_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexerServer).ListUniqueBeaconStateValues(ctx, req.(*ListUniqueBeaconStateValuesRequest))
+		return srv.(IndexerServer).ListUniqueBeaconStateValues(ctx, req.(*ListUniqueBeaconStateValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListStateDivergences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStateDivergencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListStateDivergences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListStateDivergences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListStateDivergences(ctx, req.(*ListStateDivergencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_CreateBeaconBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBeaconBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).CreateBeaconBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_CreateBeaconBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).CreateBeaconBlock(ctx, req.(*CreateBeaconBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListBeaconBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBeaconBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListBeaconBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListBeaconBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListBeaconBlock(ctx, req.(*ListBeaconBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_CountBeaconBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountBeaconBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).CountBeaconBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_CountBeaconBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).CountBeaconBlock(ctx, req.(*CountBeaconBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListUniqueBeaconBlockValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUniqueBeaconBlockValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListUniqueBeaconBlockValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListUniqueBeaconBlockValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListUniqueBeaconBlockValues(ctx, req.(*ListUniqueBeaconBlockValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_CreateBlobSidecar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBlobSidecarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).CreateBlobSidecar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_CreateBlobSidecar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).CreateBlobSidecar(ctx, req.(*CreateBlobSidecarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListBlobSidecar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlobSidecarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListBlobSidecar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListBlobSidecar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListBlobSidecar(ctx, req.(*ListBlobSidecarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_CountBlobSidecar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountBlobSidecarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).CountBlobSidecar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_CountBlobSidecar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).CountBlobSidecar(ctx, req.(*CountBlobSidecarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListUniqueBlobSidecarValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUniqueBlobSidecarValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListUniqueBlobSidecarValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListUniqueBlobSidecarValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListUniqueBlobSidecarValues(ctx, req.(*ListUniqueBlobSidecarValuesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Indexer_CreateBeaconBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBeaconBlockRequest)
+func _Indexer_CreateForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateForkChoiceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexerServer).CreateBeaconBlock(ctx, in)
+		return srv.(IndexerServer).CreateForkChoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Indexer_CreateBeaconBlock_FullMethodName,
+		FullMethod: Indexer_CreateForkChoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexerServer).CreateBeaconBlock(ctx, req.(*CreateBeaconBlockRequest))
+		return srv.(IndexerServer).CreateForkChoice(ctx, req.(*CreateForkChoiceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Indexer_ListBeaconBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBeaconBlockRequest)
+func _Indexer_ListForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForkChoiceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexerServer).ListBeaconBlock(ctx, in)
+		return srv.(IndexerServer).ListForkChoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Indexer_ListBeaconBlock_FullMethodName,
+		FullMethod: Indexer_ListForkChoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexerServer).ListBeaconBlock(ctx, req.(*ListBeaconBlockRequest))
+		return srv.(IndexerServer).ListForkChoice(ctx, req.(*ListForkChoiceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Indexer_CountBeaconBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountBeaconBlockRequest)
+func _Indexer_CountForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountForkChoiceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexerServer).CountBeaconBlock(ctx, in)
+		return srv.(IndexerServer).CountForkChoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Indexer_CountBeaconBlock_FullMethodName,
+		FullMethod: Indexer_CountForkChoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexerServer).CountBeaconBlock(ctx, req.(*CountBeaconBlockRequest))
+		return srv.(IndexerServer).CountForkChoice(ctx, req.(*CountForkChoiceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Indexer_ListUniqueBeaconBlockValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUniqueBeaconBlockValuesRequest)
+func _Indexer_ListUniqueForkChoiceValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUniqueForkChoiceValuesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IndexerServer).ListUniqueBeaconBlockValues(ctx, in)
+		return srv.(IndexerServer).ListUniqueForkChoiceValues(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Indexer_ListUniqueBeaconBlockValues_FullMethodName,
+		FullMethod: Indexer_ListUniqueForkChoiceValues_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IndexerServer).ListUniqueBeaconBlockValues(ctx, req.(*ListUniqueBeaconBlockValuesRequest))
+		return srv.(IndexerServer).ListUniqueForkChoiceValues(ctx, req.(*ListUniqueForkChoiceValuesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -857,6 +1618,78 @@ func _Indexer_ListUniqueExecutionBlockTraceValues_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Indexer_CreateExecutionWitness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExecutionWitnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).CreateExecutionWitness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_CreateExecutionWitness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).CreateExecutionWitness(ctx, req.(*CreateExecutionWitnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListExecutionWitness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExecutionWitnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListExecutionWitness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListExecutionWitness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListExecutionWitness(ctx, req.(*ListExecutionWitnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_CountExecutionWitness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountExecutionWitnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).CountExecutionWitness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_CountExecutionWitness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).CountExecutionWitness(ctx, req.(*CountExecutionWitnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListUniqueExecutionWitnessValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUniqueExecutionWitnessValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListUniqueExecutionWitnessValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListUniqueExecutionWitnessValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListUniqueExecutionWitnessValues(ctx, req.(*ListUniqueExecutionWitnessValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Indexer_CreateExecutionBadBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateExecutionBadBlockRequest)
 	if err := dec(in); err != nil {
@@ -929,6 +1762,276 @@ func _Indexer_ListUniqueExecutionBadBlockValues_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Indexer_MarkOrphaned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkOrphanedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).MarkOrphaned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_MarkOrphaned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).MarkOrphaned(ctx, req.(*MarkOrphanedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_ListIndexedKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndexedKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).ListIndexedKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_ListIndexedKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).ListIndexedKeys(ctx, req.(*ListIndexedKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_UploadBeaconState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IndexerServer).UploadBeaconState(&indexerUploadBeaconStateServer{stream})
+}
+
+type Indexer_UploadBeaconStateServer interface {
+	SendAndClose(*CreateBeaconStateResponse) error
+	Recv() (*UploadBeaconStateRequest, error)
+	grpc.ServerStream
+}
+
+type indexerUploadBeaconStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerUploadBeaconStateServer) SendAndClose(m *CreateBeaconStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *indexerUploadBeaconStateServer) Recv() (*UploadBeaconStateRequest, error) {
+	m := new(UploadBeaconStateRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Indexer_UploadBeaconBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IndexerServer).UploadBeaconBlock(&indexerUploadBeaconBlockServer{stream})
+}
+
+type Indexer_UploadBeaconBlockServer interface {
+	SendAndClose(*CreateBeaconBlockResponse) error
+	Recv() (*UploadBeaconBlockRequest, error)
+	grpc.ServerStream
+}
+
+type indexerUploadBeaconBlockServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerUploadBeaconBlockServer) SendAndClose(m *CreateBeaconBlockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *indexerUploadBeaconBlockServer) Recv() (*UploadBeaconBlockRequest, error) {
+	m := new(UploadBeaconBlockRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Indexer_UploadBlobSidecar_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IndexerServer).UploadBlobSidecar(&indexerUploadBlobSidecarServer{stream})
+}
+
+type Indexer_UploadBlobSidecarServer interface {
+	SendAndClose(*CreateBlobSidecarResponse) error
+	Recv() (*UploadBlobSidecarRequest, error)
+	grpc.ServerStream
+}
+
+type indexerUploadBlobSidecarServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerUploadBlobSidecarServer) SendAndClose(m *CreateBlobSidecarResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *indexerUploadBlobSidecarServer) Recv() (*UploadBlobSidecarRequest, error) {
+	m := new(UploadBlobSidecarRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Indexer_UploadForkChoice_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IndexerServer).UploadForkChoice(&indexerUploadForkChoiceServer{stream})
+}
+
+type Indexer_UploadForkChoiceServer interface {
+	SendAndClose(*CreateForkChoiceResponse) error
+	Recv() (*UploadForkChoiceRequest, error)
+	grpc.ServerStream
+}
+
+type indexerUploadForkChoiceServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerUploadForkChoiceServer) SendAndClose(m *CreateForkChoiceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *indexerUploadForkChoiceServer) Recv() (*UploadForkChoiceRequest, error) {
+	m := new(UploadForkChoiceRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Indexer_UploadBeaconBadBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IndexerServer).UploadBeaconBadBlock(&indexerUploadBeaconBadBlockServer{stream})
+}
+
+type Indexer_UploadBeaconBadBlockServer interface {
+	SendAndClose(*CreateBeaconBadBlockResponse) error
+	Recv() (*UploadBeaconBadBlockRequest, error)
+	grpc.ServerStream
+}
+
+type indexerUploadBeaconBadBlockServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerUploadBeaconBadBlockServer) SendAndClose(m *CreateBeaconBadBlockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *indexerUploadBeaconBadBlockServer) Recv() (*UploadBeaconBadBlockRequest, error) {
+	m := new(UploadBeaconBadBlockRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Indexer_UploadBeaconBadBlob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IndexerServer).UploadBeaconBadBlob(&indexerUploadBeaconBadBlobServer{stream})
+}
+
+type Indexer_UploadBeaconBadBlobServer interface {
+	SendAndClose(*CreateBeaconBadBlobResponse) error
+	Recv() (*UploadBeaconBadBlobRequest, error)
+	grpc.ServerStream
+}
+
+type indexerUploadBeaconBadBlobServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerUploadBeaconBadBlobServer) SendAndClose(m *CreateBeaconBadBlobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *indexerUploadBeaconBadBlobServer) Recv() (*UploadBeaconBadBlobRequest, error) {
+	m := new(UploadBeaconBadBlobRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Indexer_UploadExecutionBlockTrace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IndexerServer).UploadExecutionBlockTrace(&indexerUploadExecutionBlockTraceServer{stream})
+}
+
+type Indexer_UploadExecutionBlockTraceServer interface {
+	SendAndClose(*CreateExecutionBlockTraceResponse) error
+	Recv() (*UploadExecutionBlockTraceRequest, error)
+	grpc.ServerStream
+}
+
+type indexerUploadExecutionBlockTraceServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerUploadExecutionBlockTraceServer) SendAndClose(m *CreateExecutionBlockTraceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *indexerUploadExecutionBlockTraceServer) Recv() (*UploadExecutionBlockTraceRequest, error) {
+	m := new(UploadExecutionBlockTraceRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Indexer_UploadExecutionWitness_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IndexerServer).UploadExecutionWitness(&indexerUploadExecutionWitnessServer{stream})
+}
+
+type Indexer_UploadExecutionWitnessServer interface {
+	SendAndClose(*CreateExecutionWitnessResponse) error
+	Recv() (*UploadExecutionWitnessRequest, error)
+	grpc.ServerStream
+}
+
+type indexerUploadExecutionWitnessServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerUploadExecutionWitnessServer) SendAndClose(m *CreateExecutionWitnessResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *indexerUploadExecutionWitnessServer) Recv() (*UploadExecutionWitnessRequest, error) {
+	m := new(UploadExecutionWitnessRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Indexer_UploadExecutionBadBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IndexerServer).UploadExecutionBadBlock(&indexerUploadExecutionBadBlockServer{stream})
+}
+
+type Indexer_UploadExecutionBadBlockServer interface {
+	SendAndClose(*CreateExecutionBadBlockResponse) error
+	Recv() (*UploadExecutionBadBlockRequest, error)
+	grpc.ServerStream
+}
+
+type indexerUploadExecutionBadBlockServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerUploadExecutionBadBlockServer) SendAndClose(m *CreateExecutionBadBlockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *indexerUploadExecutionBadBlockServer) Recv() (*UploadExecutionBadBlockRequest, error) {
+	m := new(UploadExecutionBadBlockRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Indexer_ServiceDesc is the grpc.ServiceDesc for Indexer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -960,6 +2063,10 @@ var Indexer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUniqueBeaconStateValues",
 			Handler:    _Indexer_ListUniqueBeaconStateValues_Handler,
 		},
+		{
+			MethodName: "ListStateDivergences",
+			Handler:    _Indexer_ListStateDivergences_Handler,
+		},
 		{
 			MethodName: "CreateBeaconBlock",
 			Handler:    _Indexer_CreateBeaconBlock_Handler,
@@ -976,6 +2083,38 @@ var Indexer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUniqueBeaconBlockValues",
 			Handler:    _Indexer_ListUniqueBeaconBlockValues_Handler,
 		},
+		{
+			MethodName: "CreateBlobSidecar",
+			Handler:    _Indexer_CreateBlobSidecar_Handler,
+		},
+		{
+			MethodName: "ListBlobSidecar",
+			Handler:    _Indexer_ListBlobSidecar_Handler,
+		},
+		{
+			MethodName: "CountBlobSidecar",
+			Handler:    _Indexer_CountBlobSidecar_Handler,
+		},
+		{
+			MethodName: "ListUniqueBlobSidecarValues",
+			Handler:    _Indexer_ListUniqueBlobSidecarValues_Handler,
+		},
+		{
+			MethodName: "CreateForkChoice",
+			Handler:    _Indexer_CreateForkChoice_Handler,
+		},
+		{
+			MethodName: "ListForkChoice",
+			Handler:    _Indexer_ListForkChoice_Handler,
+		},
+		{
+			MethodName: "CountForkChoice",
+			Handler:    _Indexer_CountForkChoice_Handler,
+		},
+		{
+			MethodName: "ListUniqueForkChoiceValues",
+			Handler:    _Indexer_ListUniqueForkChoiceValues_Handler,
+		},
 		{
 			MethodName: "CreateBeaconBadBlock",
 			Handler:    _Indexer_CreateBeaconBadBlock_Handler,
@@ -1024,6 +2163,22 @@ var Indexer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUniqueExecutionBlockTraceValues",
 			Handler:    _Indexer_ListUniqueExecutionBlockTraceValues_Handler,
 		},
+		{
+			MethodName: "CreateExecutionWitness",
+			Handler:    _Indexer_CreateExecutionWitness_Handler,
+		},
+		{
+			MethodName: "ListExecutionWitness",
+			Handler:    _Indexer_ListExecutionWitness_Handler,
+		},
+		{
+			MethodName: "CountExecutionWitness",
+			Handler:    _Indexer_CountExecutionWitness_Handler,
+		},
+		{
+			MethodName: "ListUniqueExecutionWitnessValues",
+			Handler:    _Indexer_ListUniqueExecutionWitnessValues_Handler,
+		},
 		{
 			MethodName: "CreateExecutionBadBlock",
 			Handler:    _Indexer_CreateExecutionBadBlock_Handler,
@@ -1040,7 +2195,61 @@ var Indexer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUniqueExecutionBadBlockValues",
 			Handler:    _Indexer_ListUniqueExecutionBadBlockValues_Handler,
 		},
+		{
+			MethodName: "MarkOrphaned",
+			Handler:    _Indexer_MarkOrphaned_Handler,
+		},
+		{
+			MethodName: "ListIndexedKeys",
+			Handler:    _Indexer_ListIndexedKeys_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadBeaconState",
+			Handler:       _Indexer_UploadBeaconState_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadBeaconBlock",
+			Handler:       _Indexer_UploadBeaconBlock_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadBlobSidecar",
+			Handler:       _Indexer_UploadBlobSidecar_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadForkChoice",
+			Handler:       _Indexer_UploadForkChoice_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadBeaconBadBlock",
+			Handler:       _Indexer_UploadBeaconBadBlock_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadBeaconBadBlob",
+			Handler:       _Indexer_UploadBeaconBadBlob_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadExecutionBlockTrace",
+			Handler:       _Indexer_UploadExecutionBlockTrace_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadExecutionWitness",
+			Handler:       _Indexer_UploadExecutionWitness_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadExecutionBadBlock",
+			Handler:       _Indexer_UploadExecutionBadBlock_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "indexer/indexer.proto",
 }
@@ -58,6 +58,10 @@ func (req *CreateBeaconStateRequest) Validate() error {
 		return fmt.Errorf("beacon implementation is required")
 	}
 
+	if req.GetFinalized().GetValue() && req.GetCheckpointEpoch() == nil {
+		return fmt.Errorf("checkpoint epoch is required for finalized states")
+	}
+
 	return nil
 }
 
@@ -141,6 +145,142 @@ func (r *ListUniqueBeaconBlockValuesRequest) Validate() error {
 	return nil
 }
 
+func (s *BlobSidecar) Validate() error {
+	if s == nil {
+		return errors.New("blob sidecar is nil")
+	}
+
+	if s.GetEpoch() == nil {
+		return errors.New("epoch is required")
+	}
+
+	if s.GetSlot() == nil {
+		return errors.New("slot is required")
+	}
+
+	if s.GetBlockRoot().Value == "" {
+		return errors.New("block root is required")
+	}
+
+	if s.GetId() == nil {
+		return errors.New("id is required")
+	}
+
+	if s.GetBeaconImplementation().GetValue() == "" {
+		return errors.New("beacon implementation is required")
+	}
+
+	return nil
+}
+
+func (req *CreateBlobSidecarRequest) Validate() error {
+	if req.GetLocation().GetValue() == "" {
+		return fmt.Errorf("location is required")
+	}
+
+	if req.GetNode().GetValue() == "" {
+		return fmt.Errorf("node is required")
+	}
+
+	if req.Epoch == nil {
+		return fmt.Errorf("epoch is required")
+	}
+
+	if req.Slot == nil {
+		return fmt.Errorf("slot is required")
+	}
+
+	if req.GetBlockRoot().Value == "" {
+		return fmt.Errorf("block root is required")
+	}
+
+	if req.GetBeaconImplementation().Value == "" {
+		return fmt.Errorf("beacon implementation is required")
+	}
+
+	if req.GetSidecarType().GetValue() == "" {
+		return fmt.Errorf("sidecar type is required")
+	}
+
+	return nil
+}
+
+func (r *ListUniqueBlobSidecarValuesRequest) Validate() error {
+	if r == nil {
+		return errors.New("request is nil")
+	}
+
+	if len(r.Fields) == 0 {
+		return errors.New("fields is required")
+	}
+
+	return nil
+}
+
+func (s *ForkChoice) Validate() error {
+	if s == nil {
+		return errors.New("fork choice is nil")
+	}
+
+	if s.GetEpoch() == nil {
+		return errors.New("epoch is required")
+	}
+
+	if s.GetSlot() == nil {
+		return errors.New("slot is required")
+	}
+
+	if s.GetId() == nil {
+		return errors.New("id is required")
+	}
+
+	if s.GetBeaconImplementation().GetValue() == "" {
+		return errors.New("beacon implementation is required")
+	}
+
+	return nil
+}
+
+func (req *CreateForkChoiceRequest) Validate() error {
+	if req.GetLocation().GetValue() == "" {
+		return fmt.Errorf("location is required")
+	}
+
+	if req.GetNode().GetValue() == "" {
+		return fmt.Errorf("node is required")
+	}
+
+	if req.Epoch == nil {
+		return fmt.Errorf("epoch is required")
+	}
+
+	if req.Slot == nil {
+		return fmt.Errorf("slot is required")
+	}
+
+	if req.GetBeaconImplementation().GetValue() == "" {
+		return fmt.Errorf("beacon implementation is required")
+	}
+
+	if req.GetReason().GetValue() == "" {
+		return fmt.Errorf("reason is required")
+	}
+
+	return nil
+}
+
+func (r *ListUniqueForkChoiceValuesRequest) Validate() error {
+	if r == nil {
+		return errors.New("request is nil")
+	}
+
+	if len(r.Fields) == 0 {
+		return errors.New("fields is required")
+	}
+
+	return nil
+}
+
 func (s *BeaconBadBlock) Validate() error {
 	if s == nil {
 		return errors.New("beacon bad block is nil")
@@ -377,6 +517,86 @@ func (r *ListUniqueExecutionBlockTraceValuesRequest) Validate() error {
 	return nil
 }
 
+func (req *CreateExecutionWitnessRequest) Validate() error {
+	if req.GetLocation().GetValue() == "" {
+		return fmt.Errorf("location is required")
+	}
+
+	if req.GetNode().GetValue() == "" {
+		return fmt.Errorf("node is required")
+	}
+
+	if req.GetBlockHash().GetValue() == "" {
+		return fmt.Errorf("block_hash is required")
+	}
+
+	if req.GetBlockNumber() == nil {
+		return fmt.Errorf("block_number is required")
+	}
+
+	if req.GetExecutionImplementation().GetValue() == "" {
+		return fmt.Errorf("execution_implementation is required")
+	}
+
+	if req.GetNodeVersion().GetValue() == "" {
+		return fmt.Errorf("node_version is required")
+	}
+
+	if req.GetNetwork().GetValue() == "" {
+		return fmt.Errorf("network is required")
+	}
+
+	return nil
+}
+
+func (t *ExecutionWitness) Validate() error {
+	if t == nil {
+		return errors.New("execution witness is nil")
+	}
+
+	if t.GetLocation().GetValue() == "" {
+		return fmt.Errorf("location is required")
+	}
+
+	if t.GetNode().GetValue() == "" {
+		return fmt.Errorf("node is required")
+	}
+
+	if t.GetBlockHash().GetValue() == "" {
+		return fmt.Errorf("block_hash is required")
+	}
+
+	if t.GetBlockNumber() == nil {
+		return fmt.Errorf("block_number is required")
+	}
+
+	if t.GetExecutionImplementation().GetValue() == "" {
+		return fmt.Errorf("execution_implementation is required")
+	}
+
+	if t.GetNodeVersion().GetValue() == "" {
+		return fmt.Errorf("node_version is required")
+	}
+
+	if t.GetNetwork().GetValue() == "" {
+		return fmt.Errorf("network is required")
+	}
+
+	return nil
+}
+
+func (r *ListUniqueExecutionWitnessValuesRequest) Validate() error {
+	if r == nil {
+		return errors.New("request is nil")
+	}
+
+	if len(r.Fields) == 0 {
+		return errors.New("fields is required")
+	}
+
+	return nil
+}
+
 func (req *CreateExecutionBadBlockRequest) Validate() error {
 	if req.GetLocation().GetValue() == "" {
 		return fmt.Errorf("location is required")
@@ -452,3 +672,43 @@ func (r *ListUniqueExecutionBadBlockValuesRequest) Validate() error {
 
 	return nil
 }
+
+func (r *MarkOrphanedRequest) Validate() error {
+	if r == nil {
+		return errors.New("request is nil")
+	}
+
+	if r.GetNode() == "" {
+		return errors.New("node is required")
+	}
+
+	if r.GetNetwork() == "" {
+		return errors.New("network is required")
+	}
+
+	if len(r.GetBlockRoots()) == 0 && len(r.GetStateRoots()) == 0 && len(r.GetExecutionBlockHashes()) == 0 {
+		return errors.New("at least one block root, state root or execution block hash is required")
+	}
+
+	return nil
+}
+
+func (r *ListIndexedKeysRequest) Validate() error {
+	if r == nil {
+		return errors.New("request is nil")
+	}
+
+	if r.GetNode() == "" {
+		return errors.New("node is required")
+	}
+
+	if r.GetNetwork() == "" {
+		return errors.New("network is required")
+	}
+
+	if r.GetSince() == nil {
+		return errors.New("since is required")
+	}
+
+	return nil
+}
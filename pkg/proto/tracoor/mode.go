@@ -3,7 +3,8 @@ package tracoor
 type Mode string
 
 const (
-	ModeUnknown Mode = ""
-	ModeAgent   Mode = "agent"
-	ModeServer  Mode = "server"
+	ModeUnknown     Mode = ""
+	ModeAgent       Mode = "agent"
+	ModeServer      Mode = "server"
+	ModeEngineProxy Mode = "engine-proxy"
 )
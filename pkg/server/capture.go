@@ -0,0 +1,252 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+	"github.com/ethpandaops/tracoor/pkg/mime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sirupsen/logrus"
+)
+
+// captureTypes are the captures agents can be asked for.
+var captureTypes = map[string]bool{
+	"beacon_state":          true,
+	"execution_block_trace": true,
+	"fork_choice":           true,
+}
+
+// maxCaptureRequestSize limits the size of a forwarded capture request.
+const maxCaptureRequestSize = 64 * 1024
+
+type CaptureConfig struct {
+	// AuthorizationToken is required as a bearer token on capture requests. It
+	// must be set if any agents are configured.
+	AuthorizationToken string `yaml:"authorizationToken"`
+	// Timeout is how long to wait for an agent to capture.
+	Timeout human.Duration `yaml:"timeout" default:"3m"`
+	// Agents are the agents capture requests are forwarded to.
+	Agents []CaptureAgent `yaml:"agents"`
+}
+
+// CaptureAgent is an agent's capture API.
+type CaptureAgent struct {
+	// Name is the name of the agent.
+	Name string `yaml:"name"`
+	// Addr is the base URL of the agent's capture API.
+	Addr string `yaml:"addr"`
+	// AuthorizationToken is sent as a bearer token if set.
+	AuthorizationToken string `yaml:"authorizationToken"`
+}
+
+func (c *CaptureConfig) Validate() error {
+	names := make(map[string]bool, len(c.Agents))
+
+	for _, agent := range c.Agents {
+		if agent.Name == "" {
+			return errors.New("capture.agents.name is required")
+		}
+
+		if agent.Addr == "" {
+			return fmt.Errorf("capture.agents.addr is required for agent %s", agent.Name)
+		}
+
+		if names[agent.Name] {
+			return fmt.Errorf("duplicate capture agent %s", agent.Name)
+		}
+
+		names[agent.Name] = true
+	}
+
+	if len(c.Agents) > 0 && c.Timeout.Duration <= 0 {
+		return errors.New("capture.timeout must be greater than 0")
+	}
+
+	// The capture API is served on the public gateway, so it's never left open.
+	if len(c.Agents) > 0 && c.AuthorizationToken == "" {
+		return errors.New("capture.authorizationToken is required when capture.agents are configured")
+	}
+
+	return nil
+}
+
+// CaptureResult is the result of forwarding a capture request to an agent.
+type CaptureResult struct {
+	Node  string   `json:"node"`
+	IDs   []string `json:"ids,omitempty"`
+	Error string   `json:"error,omitempty"`
+}
+
+// CaptureForwarder forwards capture requests to the configured agents, so data
+// can be captured from every client at once.
+type CaptureForwarder struct {
+	log    logrus.FieldLogger
+	config *CaptureConfig
+	mux    *runtime.ServeMux
+	client *http.Client
+}
+
+func NewCaptureForwarder(log logrus.FieldLogger, config *CaptureConfig, mux *runtime.ServeMux) *CaptureForwarder {
+	return &CaptureForwarder{
+		log:    log.WithField("component", "capture_forwarder"),
+		config: config,
+		mux:    mux,
+		client: &http.Client{Timeout: config.Timeout.Duration},
+	}
+}
+
+func (c *CaptureForwarder) Start() error {
+	if err := c.mux.HandlePath("POST", "/capture/{type}", c.captureHandler); err != nil {
+		return fmt.Errorf("failed to register capture handler: %v", err)
+	}
+
+	return nil
+}
+
+// captureHandler forwards the request to every agent, or only the agents named
+// in the node query parameter, and returns each agent's result.
+func (c *CaptureForwarder) captureHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	if !c.authorized(r) {
+		c.writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+
+		return
+	}
+
+	captureType := pathParams["type"]
+	if !captureTypes[captureType] {
+		c.writeJSON(w, http.StatusNotFound, map[string]string{"error": fmt.Sprintf("unknown capture type %s", captureType)})
+
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxCaptureRequestSize))
+	if err != nil {
+		c.writeJSON(w, http.StatusBadRequest, map[string]string{"error": "failed to read request"})
+
+		return
+	}
+
+	agents, err := c.selectAgents(r.URL.Query()["node"])
+	if err != nil {
+		c.writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+
+		return
+	}
+
+	results := make([]*CaptureResult, len(agents))
+
+	var wg sync.WaitGroup
+
+	for idx, agent := range agents {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[idx] = c.forward(r.Context(), agent, captureType, body)
+		}()
+	}
+
+	wg.Wait()
+
+	c.writeJSON(w, http.StatusOK, map[string]any{"results": results})
+}
+
+func (c *CaptureForwarder) selectAgents(names []string) ([]CaptureAgent, error) {
+	if len(names) == 0 {
+		return c.config.Agents, nil
+	}
+
+	agents := make([]CaptureAgent, 0, len(names))
+
+	for _, name := range names {
+		found := false
+
+		for _, agent := range c.config.Agents {
+			if agent.Name == name {
+				agents = append(agents, agent)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("unknown agent %s", name)
+		}
+	}
+
+	return agents, nil
+}
+
+func (c *CaptureForwarder) forward(ctx context.Context, agent CaptureAgent, captureType string, body []byte) *CaptureResult {
+	result := &CaptureResult{Node: agent.Name}
+
+	url := fmt.Sprintf("%s/capture/%s", strings.TrimSuffix(agent.Addr, "/"), captureType)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		result.Error = err.Error()
+
+		return result
+	}
+
+	req.Header.Set("Content-Type", string(mime.ContentTypeJSON))
+
+	if agent.AuthorizationToken != "" {
+		req.Header.Set("Authorization", "Bearer "+agent.AuthorizationToken)
+	}
+
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		c.log.WithError(err).WithField("agent", agent.Name).Warn("Failed to forward capture request")
+
+		result.Error = fmt.Sprintf("failed to reach agent: %v", err)
+
+		return result
+	}
+
+	defer rsp.Body.Close()
+
+	if err := json.NewDecoder(rsp.Body).Decode(result); err != nil {
+		result.Error = fmt.Sprintf("unexpected response from agent (status %d)", rsp.StatusCode)
+	}
+
+	// The result is attributed to the configured agent, whatever it calls itself.
+	result.Node = agent.Name
+
+	if result.Error == "" && rsp.StatusCode != http.StatusOK {
+		result.Error = fmt.Sprintf("agent responded with status %d", rsp.StatusCode)
+	}
+
+	return result
+}
+
+func (c *CaptureForwarder) authorized(r *http.Request) bool {
+	if c.config.AuthorizationToken == "" {
+		return false
+	}
+
+	provided := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(c.config.AuthorizationToken)) == 1
+}
+
+func (c *CaptureForwarder) writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", string(mime.ContentTypeJSON))
+
+	w.WriteHeader(statusCode)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		c.log.WithError(err).Error("Failed to write capture response")
+	}
+}
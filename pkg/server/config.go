@@ -33,6 +33,8 @@ type Config struct {
 	Services service.Config `yaml:"services"`
 	// Ethereum is the ethereum network configuration.
 	Ethereum ethereum.Config `yaml:"ethereum"`
+	// Capture configures forwarding on-demand capture requests to agents.
+	Capture CaptureConfig `yaml:"capture"`
 }
 
 func (c *Config) Validate() error {
@@ -52,5 +54,9 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.Capture.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/ethpandaops/tracoor/pkg/compression"
 	"github.com/ethpandaops/tracoor/pkg/mime"
@@ -53,6 +55,14 @@ func (d *ObjectDownloader) Start() error {
 		return fmt.Errorf("failed to register beacon block download handler: %v", err)
 	}
 
+	if err := d.mux.HandlePath("GET", "/download/blob_sidecar/{id}", d.blobSidecarHandler); err != nil {
+		return fmt.Errorf("failed to register blob sidecar download handler: %v", err)
+	}
+
+	if err := d.mux.HandlePath("GET", "/download/fork_choice/{id}", d.forkChoiceHandler); err != nil {
+		return fmt.Errorf("failed to register fork choice download handler: %v", err)
+	}
+
 	if err := d.mux.HandlePath("GET", "/download/beacon_bad_block/{id}", d.beaconBadBlockHandler); err != nil {
 		return fmt.Errorf("failed to register beacon bad block download handler: %v", err)
 	}
@@ -65,10 +75,22 @@ func (d *ObjectDownloader) Start() error {
 		return fmt.Errorf("failed to register execution block trace download handler: %v", err)
 	}
 
+	if err := d.mux.HandlePath("GET", "/download/execution_witness/{id}", d.executionWitnessHandler); err != nil {
+		return fmt.Errorf("failed to register execution witness download handler: %v", err)
+	}
+
 	if err := d.mux.HandlePath("GET", "/download/execution_bad_block/{id}", d.executionBadBlock); err != nil {
 		return fmt.Errorf("failed to register execution block trace download handler: %v", err)
 	}
 
+	if err := d.mux.HandlePath("GET", "/download/execution_bad_block/{id}/trace", d.executionBadBlockTrace); err != nil {
+		return fmt.Errorf("failed to register execution bad block trace download handler: %v", err)
+	}
+
+	if err := d.mux.HandlePath("GET", "/download/execution_bad_block/{id}/intermediate_roots", d.executionBadBlockIntermediateRoots); err != nil {
+		return fmt.Errorf("failed to register execution bad block intermediate roots download handler: %v", err)
+	}
+
 	return nil
 }
 
@@ -110,7 +132,9 @@ func (d *ObjectDownloader) beaconStateHandler(w http.ResponseWriter, r *http.Req
 
 	state := resp.BeaconStates[0]
 
-	if d.store.PreferURLs() {
+	algo := compression.GetItemCompressionAlgorithm(state.Location.Value, state.ContentEncoding.GetValue())
+
+	if d.store.PreferURLs() && acceptsEncoding(r, algo) {
 		var itemURL string
 
 		itemURL, err = d.store.GetBeaconStateURL(ctx, &tStore.GetURLParams{
@@ -139,25 +163,7 @@ func (d *ObjectDownloader) beaconStateHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	w.Header().Set("Content-Type", string(mime.GetContentTypeFromExtension(filepath.Ext(state.Location.Value))))
-
-	filename := filepath.Base(state.Location.Value)
-
-	algo, err := compression.GetCompressionAlgorithmFromContentEncoding(state.ContentEncoding.GetValue())
-	if err == nil {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
-	} else if compression.HasCompressionExtension(state.Location.Value, algo) {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
-
-		filename = compression.RemoveExtension(filename)
-	}
-
-	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
-
-	_, err = w.Write(*data)
-	if err != nil {
-		d.writeJSONError(w, "Failed to write response", http.StatusInternalServerError)
-	}
+	d.writeItem(w, r, state.Location.Value, algo, data)
 }
 
 func (d *ObjectDownloader) beaconBlockHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
@@ -198,7 +204,9 @@ func (d *ObjectDownloader) beaconBlockHandler(w http.ResponseWriter, r *http.Req
 
 	block := resp.BeaconBlocks[0]
 
-	if d.store.PreferURLs() {
+	algo := compression.GetItemCompressionAlgorithm(block.Location.Value, block.ContentEncoding.GetValue())
+
+	if d.store.PreferURLs() && acceptsEncoding(r, algo) {
 		var itemURL string
 
 		itemURL, err = d.store.GetBeaconBlockURL(ctx, &tStore.GetURLParams{
@@ -227,25 +235,151 @@ func (d *ObjectDownloader) beaconBlockHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	w.Header().Set("Content-Type", string(mime.GetContentTypeFromExtension(filepath.Ext(block.Location.Value))))
+	d.writeItem(w, r, block.Location.Value, algo, data)
+}
 
-	filename := filepath.Base(block.Location.Value)
+func (d *ObjectDownloader) blobSidecarHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := r.Context()
 
-	algo, err := compression.GetCompressionAlgorithmFromContentEncoding(block.ContentEncoding.GetValue())
-	if err == nil {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
-	} else if compression.HasCompressionExtension(block.Location.Value, algo) {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
+	id := pathParams["id"]
+	if id == "" {
+		d.writeJSONError(w, "No ID provided", http.StatusBadRequest)
 
-		filename = compression.RemoveExtension(filename)
+		return
 	}
 
-	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	resp, err := d.indexer.ListBlobSidecar(ctx, &indexer.ListBlobSidecarRequest{
+		Id: id,
+		Pagination: &indexer.PaginationCursor{
+			Limit: 1,
+		},
+	})
+	if err != nil {
+		d.log.WithError(err).Errorf("Failed to list blob sidecars for ID %s", id)
+
+		d.writeJSONError(w, "Failed to list blob sidecars", http.StatusInternalServerError)
+
+		return
+	}
+
+	if len(resp.BlobSidecars) == 0 {
+		d.writeJSONError(w, "No blob sidecars found", http.StatusNotFound)
+
+		return
+	}
 
-	_, err = w.Write(*data)
+	if len(resp.BlobSidecars) > 1 {
+		d.writeJSONError(w, "More than one blob sidecar found", http.StatusInternalServerError)
+
+		return
+	}
+
+	sidecar := resp.BlobSidecars[0]
+
+	algo := compression.GetItemCompressionAlgorithm(sidecar.Location.Value, sidecar.ContentEncoding.GetValue())
+
+	if d.store.PreferURLs() && acceptsEncoding(r, algo) {
+		var itemURL string
+
+		itemURL, err = d.store.GetBlobSidecarURL(ctx, &tStore.GetURLParams{
+			Location:        sidecar.Location.Value,
+			Expiry:          3600,
+			ContentEncoding: sidecar.ContentEncoding.GetValue(),
+		})
+		if err != nil {
+			d.log.WithError(err).Errorf("Failed to get URL for blob sidecar ID %s", id)
+			d.writeJSONError(w, "Failed to get URL for item", http.StatusInternalServerError)
+
+			return
+		}
+
+		http.Redirect(w, r, itemURL, http.StatusTemporaryRedirect)
+
+		return
+	}
+
+	data, err := d.store.GetBlobSidecar(ctx, sidecar.Location.Value)
 	if err != nil {
-		d.writeJSONError(w, "Failed to write response", http.StatusInternalServerError)
+		d.log.WithError(err).Errorf("Failed to get blob sidecar from store for ID %s from %s", id, sidecar.Location.Value)
+
+		d.writeJSONError(w, "Failed to get blob sidecar", http.StatusInternalServerError)
+
+		return
+	}
+
+	d.writeItem(w, r, sidecar.Location.Value, algo, data)
+}
+
+func (d *ObjectDownloader) forkChoiceHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := r.Context()
+
+	id := pathParams["id"]
+	if id == "" {
+		d.writeJSONError(w, "No ID provided", http.StatusBadRequest)
+
+		return
+	}
+
+	resp, err := d.indexer.ListForkChoice(ctx, &indexer.ListForkChoiceRequest{
+		Id: id,
+		Pagination: &indexer.PaginationCursor{
+			Limit: 1,
+		},
+	})
+	if err != nil {
+		d.log.WithError(err).Errorf("Failed to list fork choices for ID %s", id)
+
+		d.writeJSONError(w, "Failed to list fork choices", http.StatusInternalServerError)
+
+		return
+	}
+
+	if len(resp.ForkChoices) == 0 {
+		d.writeJSONError(w, "No fork choices found", http.StatusNotFound)
+
+		return
+	}
+
+	if len(resp.ForkChoices) > 1 {
+		d.writeJSONError(w, "More than one fork choice found", http.StatusInternalServerError)
+
+		return
+	}
+
+	forkChoice := resp.ForkChoices[0]
+
+	algo := compression.GetItemCompressionAlgorithm(forkChoice.Location.Value, forkChoice.ContentEncoding.GetValue())
+
+	if d.store.PreferURLs() && acceptsEncoding(r, algo) {
+		var itemURL string
+
+		itemURL, err = d.store.GetForkChoiceURL(ctx, &tStore.GetURLParams{
+			Location:        forkChoice.Location.Value,
+			Expiry:          3600,
+			ContentEncoding: forkChoice.ContentEncoding.GetValue(),
+		})
+		if err != nil {
+			d.log.WithError(err).Errorf("Failed to get URL for fork choice ID %s", id)
+			d.writeJSONError(w, "Failed to get URL for item", http.StatusInternalServerError)
+
+			return
+		}
+
+		http.Redirect(w, r, itemURL, http.StatusTemporaryRedirect)
+
+		return
+	}
+
+	data, err := d.store.GetForkChoice(ctx, forkChoice.Location.Value)
+	if err != nil {
+		d.log.WithError(err).Errorf("Failed to get fork choice from store for ID %s from %s", id, forkChoice.Location.Value)
+
+		d.writeJSONError(w, "Failed to get fork choice", http.StatusInternalServerError)
+
+		return
 	}
+
+	d.writeItem(w, r, forkChoice.Location.Value, algo, data)
 }
 
 func (d *ObjectDownloader) beaconBadBlockHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
@@ -286,7 +420,9 @@ func (d *ObjectDownloader) beaconBadBlockHandler(w http.ResponseWriter, r *http.
 
 	block := resp.BeaconBadBlocks[0]
 
-	if d.store.PreferURLs() {
+	algo := compression.GetItemCompressionAlgorithm(block.Location.Value, block.ContentEncoding.GetValue())
+
+	if d.store.PreferURLs() && acceptsEncoding(r, algo) {
 		var itemURL string
 
 		itemURL, err = d.store.GetBeaconBadBlockURL(ctx, &tStore.GetURLParams{
@@ -315,25 +451,7 @@ func (d *ObjectDownloader) beaconBadBlockHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
-	w.Header().Set("Content-Type", string(mime.GetContentTypeFromExtension(filepath.Ext(block.Location.Value))))
-
-	filename := filepath.Base(block.Location.Value)
-
-	algo, err := compression.GetCompressionAlgorithmFromContentEncoding(block.ContentEncoding.GetValue())
-	if err == nil {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
-	} else if compression.HasCompressionExtension(block.Location.Value, algo) {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
-
-		filename = compression.RemoveExtension(filename)
-	}
-
-	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
-
-	_, err = w.Write(*data)
-	if err != nil {
-		d.writeJSONError(w, "Failed to write response", http.StatusInternalServerError)
-	}
+	d.writeItem(w, r, block.Location.Value, algo, data)
 }
 
 func (d *ObjectDownloader) beaconBadBlobHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
@@ -374,7 +492,9 @@ func (d *ObjectDownloader) beaconBadBlobHandler(w http.ResponseWriter, r *http.R
 
 	blob := resp.BeaconBadBlobs[0]
 
-	if d.store.PreferURLs() {
+	algo := compression.GetItemCompressionAlgorithm(blob.Location.Value, blob.ContentEncoding.GetValue())
+
+	if d.store.PreferURLs() && acceptsEncoding(r, algo) {
 		var itemURL string
 
 		itemURL, err = d.store.GetBeaconBadBlobURL(ctx, &tStore.GetURLParams{
@@ -403,25 +523,7 @@ func (d *ObjectDownloader) beaconBadBlobHandler(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	w.Header().Set("Content-Type", string(mime.GetContentTypeFromExtension(filepath.Ext(blob.Location.Value))))
-
-	filename := filepath.Base(blob.Location.Value)
-
-	algo, err := compression.GetCompressionAlgorithmFromContentEncoding(blob.ContentEncoding.GetValue())
-	if err == nil {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
-	} else if compression.HasCompressionExtension(blob.Location.Value, algo) {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
-
-		filename = compression.RemoveExtension(filename)
-	}
-
-	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
-
-	_, err = w.Write(*data)
-	if err != nil {
-		d.writeJSONError(w, "Failed to write response", http.StatusInternalServerError)
-	}
+	d.writeItem(w, r, blob.Location.Value, algo, data)
 }
 
 func (d *ObjectDownloader) executionBlockTraceHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
@@ -462,7 +564,9 @@ func (d *ObjectDownloader) executionBlockTraceHandler(w http.ResponseWriter, r *
 
 	state := resp.ExecutionBlockTraces[0]
 
-	if d.store.PreferURLs() {
+	algo := compression.GetItemCompressionAlgorithm(state.Location.Value, state.ContentEncoding.GetValue())
+
+	if d.store.PreferURLs() && acceptsEncoding(r, algo) {
 		var itemURL string
 
 		itemURL, err = d.store.GetExecutionBlockTraceURL(ctx, &tStore.GetURLParams{
@@ -491,28 +595,108 @@ func (d *ObjectDownloader) executionBlockTraceHandler(w http.ResponseWriter, r *
 		return
 	}
 
-	w.Header().Set("Content-Type", string(mime.GetContentTypeFromExtension(filepath.Ext(state.Location.Value))))
+	d.writeItem(w, r, state.Location.Value, algo, data)
+}
+
+func (d *ObjectDownloader) executionWitnessHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := r.Context()
+
+	id := pathParams["id"]
+	if id == "" {
+		d.writeJSONError(w, "No ID provided", http.StatusBadRequest)
+
+		return
+	}
+
+	resp, err := d.indexer.ListExecutionWitness(ctx, &indexer.ListExecutionWitnessRequest{
+		Id: id,
+		Pagination: &indexer.PaginationCursor{
+			Limit: 1,
+		},
+	})
+	if err != nil {
+		d.log.WithError(err).Errorf("Failed to list execution witness for ID %s", id)
 
-	filename := filepath.Base(state.Location.Value)
+		d.writeJSONError(w, "Failed to list execution witness", http.StatusInternalServerError)
 
-	algo, err := compression.GetCompressionAlgorithmFromContentEncoding(state.ContentEncoding.GetValue())
-	if err == nil {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
-	} else if compression.HasCompressionExtension(state.Location.Value, algo) {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
+		return
+	}
 
-		filename = compression.RemoveExtension(filename)
+	if len(resp.ExecutionWitnesses) == 0 {
+		d.writeJSONError(w, "No execution witness found", http.StatusNotFound)
+
+		return
 	}
 
-	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	if len(resp.ExecutionWitnesses) > 1 {
+		d.writeJSONError(w, "More than one execution witness found", http.StatusInternalServerError)
 
-	_, err = w.Write(*data)
+		return
+	}
+
+	witness := resp.ExecutionWitnesses[0]
+
+	algo := compression.GetItemCompressionAlgorithm(witness.Location.Value, witness.ContentEncoding.GetValue())
+
+	if d.store.PreferURLs() && acceptsEncoding(r, algo) {
+		var itemURL string
+
+		itemURL, err = d.store.GetExecutionWitnessURL(ctx, &tStore.GetURLParams{
+			Location:        witness.Location.Value,
+			Expiry:          3600,
+			ContentEncoding: witness.ContentEncoding.GetValue(),
+		})
+		if err != nil {
+			d.log.WithError(err).Errorf("Failed to get URL for execution witness ID %s", id)
+			d.writeJSONError(w, "Failed to get URL for item", http.StatusInternalServerError)
+
+			return
+		}
+
+		http.Redirect(w, r, itemURL, http.StatusTemporaryRedirect)
+
+		return
+	}
+
+	data, err := d.store.GetExecutionWitness(ctx, witness.Location.Value)
 	if err != nil {
-		d.writeJSONError(w, "Failed to write response", http.StatusInternalServerError)
+		d.log.WithError(err).Errorf("Failed to get execution witness from store for ID %s from %s", id, witness.Location.Value)
+
+		d.writeJSONError(w, "Failed to get execution witness", http.StatusInternalServerError)
+
+		return
 	}
+
+	d.writeItem(w, r, witness.Location.Value, algo, data)
 }
 
 func (d *ObjectDownloader) executionBadBlock(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	d.serveExecutionBadBlockItem(w, r, pathParams, "execution bad block", func(block *indexer.ExecutionBadBlock) string {
+		return block.GetLocation().GetValue()
+	})
+}
+
+func (d *ObjectDownloader) executionBadBlockTrace(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	d.serveExecutionBadBlockItem(w, r, pathParams, "execution bad block trace", func(block *indexer.ExecutionBadBlock) string {
+		return block.GetTraceLocation().GetValue()
+	})
+}
+
+func (d *ObjectDownloader) executionBadBlockIntermediateRoots(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	d.serveExecutionBadBlockItem(w, r, pathParams, "execution bad block intermediate roots", func(block *indexer.ExecutionBadBlock) string {
+		return block.GetIntermediateRootsLocation().GetValue()
+	})
+}
+
+// serveExecutionBadBlockItem serves the item of an execution bad block that
+// location selects. The block and the traces captured alongside it share a row.
+func (d *ObjectDownloader) serveExecutionBadBlockItem(
+	w http.ResponseWriter,
+	r *http.Request,
+	pathParams map[string]string,
+	name string,
+	location func(block *indexer.ExecutionBadBlock) string,
+) {
 	ctx := r.Context()
 
 	id := pathParams["id"]
@@ -548,15 +732,24 @@ func (d *ObjectDownloader) executionBadBlock(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	state := resp.ExecutionBadBlocks[0]
+	block := resp.ExecutionBadBlocks[0]
+
+	itemLocation := location(block)
+	if itemLocation == "" {
+		d.writeJSONError(w, fmt.Sprintf("No %s found", name), http.StatusNotFound)
+
+		return
+	}
+
+	algo := compression.GetItemCompressionAlgorithm(itemLocation, block.ContentEncoding.GetValue())
 
-	if d.store.PreferURLs() {
+	if d.store.PreferURLs() && acceptsEncoding(r, algo) {
 		var itemURL string
 
 		itemURL, err = d.store.GetExecutionBadBlockURL(ctx, &tStore.GetURLParams{
-			Location:        state.Location.Value,
+			Location:        itemLocation,
 			Expiry:          3600,
-			ContentEncoding: state.ContentEncoding.GetValue(),
+			ContentEncoding: block.ContentEncoding.GetValue(),
 		})
 		if err != nil {
 			d.log.WithError(err).Errorf("Failed to get URL for bad block ID %s", id)
@@ -570,36 +763,91 @@ func (d *ObjectDownloader) executionBadBlock(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	data, err := d.store.GetExecutionBadBlock(ctx, state.Location.Value)
+	data, err := d.store.GetExecutionBadBlock(ctx, itemLocation)
 	if err != nil {
-		d.log.WithError(err).Errorf("Failed to get execution bad block from store for ID %s from %s", id, state.Location.Value)
+		d.log.WithError(err).Errorf("Failed to get %s from store for ID %s from %s", name, id, itemLocation)
 
-		d.writeJSONError(w, "Failed to get execution bad block", http.StatusInternalServerError)
+		d.writeJSONError(w, fmt.Sprintf("Failed to get %s", name), http.StatusInternalServerError)
 
 		return
 	}
 
-	w.Header().Set("Content-Type", string(mime.GetContentTypeFromExtension(filepath.Ext(state.Location.Value))))
+	d.writeItem(w, r, itemLocation, algo, data)
+}
 
-	filename := filepath.Base(state.Location.Value)
+// writeItem writes an item fetched from the store. Items compressed with an
+// encoding the client doesn't accept are decompressed first.
+func (d *ObjectDownloader) writeItem(w http.ResponseWriter, r *http.Request, location string, algo *compression.CompressionAlgorithm, data *[]byte) {
+	filename := compression.RemoveExtension(filepath.Base(location))
 
-	algo, err := compression.GetCompressionAlgorithmFromContentEncoding(state.ContentEncoding.GetValue())
-	if err == nil {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
-	} else if compression.HasAnyCompressionExtension(state.Location.Value) {
-		w.Header().Set("Content-Encoding", algo.ContentEncoding)
+	if algo != nil && !acceptsEncoding(r, algo) {
+		decompressed, err := d.compressor.DecompressWithAlgorithm(data, algo)
+		if err != nil {
+			d.log.WithError(err).Errorf("Failed to decompress %s", location)
+			d.writeJSONError(w, "Failed to decompress item", http.StatusInternalServerError)
 
-		filename = compression.RemoveExtension(filename)
+			return
+		}
+
+		data = &decompressed
+		algo = nil
+	}
+
+	w.Header().Set("Content-Type", string(mime.GetContentTypeFromExtension(filepath.Ext(filename))))
+
+	if algo != nil {
+		w.Header().Set("Content-Encoding", algo.ContentEncoding)
 	}
 
 	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
 
-	_, err = w.Write(*data)
-	if err != nil {
+	if _, err := w.Write(*data); err != nil {
 		d.writeJSONError(w, "Failed to write response", http.StatusInternalServerError)
 	}
 }
 
+// acceptsEncoding reports whether the client accepts items compressed with the
+// algorithm. Clients that don't send Accept-Encoding accept any encoding.
+func acceptsEncoding(r *http.Request, algo *compression.CompressionAlgorithm) bool {
+	if algo == nil || algo == compression.None {
+		return true
+	}
+
+	values := r.Header.Values("Accept-Encoding")
+	if len(values) == 0 {
+		return true
+	}
+
+	// An explicit entry for the encoding takes precedence over a wildcard.
+	wildcard := false
+
+	for _, value := range values {
+		for _, coding := range strings.Split(value, ",") {
+			name, params, _ := strings.Cut(coding, ";")
+
+			name = strings.TrimSpace(name)
+
+			accepted := true
+
+			if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+				if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+					accepted = false
+				}
+			}
+
+			if strings.EqualFold(name, algo.ContentEncoding) {
+				return accepted
+			}
+
+			if name == "*" {
+				wildcard = accepted
+			}
+		}
+	}
+
+	return wildcard
+}
+
 func (d *ObjectDownloader) writeJSONError(w http.ResponseWriter, message string, statusCode int) {
 	w.Header().Set("Content-Type", string(mime.ContentTypeJSON))
 
@@ -23,8 +23,10 @@ type BeaconBlock struct {
 	BeaconImplementation string
 	NodeVersion          string `gorm:"not null;default:''"`
 	ContentEncoding      string `gorm:"not null;default:''"`
-	Location             string `gorm:"not null;default:''"`
+	Location             string `gorm:"not null;default:'';index:idx_beacon_block_location,where:deleted_at IS NULL"`
 	Network              string `gorm:"not null;default:'';index;index:idx_beacon_block_node_slot_blockroot_network_fetchedat,where:deleted_at IS NULL,priority:4;index:idx_beacon_block_network,where:deleted_at IS NULL;index:idx_beacon_block_network,where:deleted_at IS NULL;index:idx_beacon_block_fetchedat_network,where:deleted_at IS NULL,priority:2"`
+	// Canonical is false once a reorg has orphaned the block.
+	Canonical bool `gorm:"not null;default:true;index"`
 }
 
 type BeaconBlockFilter struct {
@@ -39,6 +41,7 @@ type BeaconBlockFilter struct {
 	Location             *string
 	Network              *string
 	BeaconImplementation *string
+	Canonical            *bool
 }
 
 func (f *BeaconBlockFilter) AddID(id string) {
@@ -85,6 +88,10 @@ func (f *BeaconBlockFilter) AddBeaconImplementation(beaconImplementation string)
 	f.BeaconImplementation = &beaconImplementation
 }
 
+func (f *BeaconBlockFilter) AddCanonical(canonical bool) {
+	f.Canonical = &canonical
+}
+
 func (f *BeaconBlockFilter) Validate() error {
 	if f.ID == nil &&
 		f.Node == nil &&
@@ -96,7 +103,8 @@ func (f *BeaconBlockFilter) Validate() error {
 		f.NodeVersion == nil &&
 		f.Location == nil &&
 		f.BeaconImplementation == nil &&
-		f.Network == nil {
+		f.Network == nil &&
+		f.Canonical == nil {
 		return errors.New("no filter specified")
 	}
 
@@ -148,6 +156,10 @@ func (f *BeaconBlockFilter) ApplyToQuery(query *gorm.DB) (*gorm.DB, error) {
 		query = query.Where("beacon_implementation = ?", f.BeaconImplementation)
 	}
 
+	if f.Canonical != nil {
+		query = query.Where("canonical = ?", f.Canonical)
+	}
+
 	return query, nil
 }
 
@@ -163,6 +175,21 @@ func (i *Indexer) InsertBeaconBlock(ctx context.Context, block *BeaconBlock) err
 	return result.Error
 }
 
+// InsertBeaconBlockReference inserts a beacon block once checkObject confirms its
+// object is in the store. Retention can't delete the object in between, as
+// it's locked until the beacon block is inserted.
+func (i *Indexer) InsertBeaconBlockReference(ctx context.Context, block *BeaconBlock, checkObject func() error) error {
+	operation := OperationInsertBeaconBlock
+	i.metrics.ObserveOperation(operation)
+
+	err := i.insertObjectReference(ctx, block.Location, block, checkObject)
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+	}
+
+	return err
+}
+
 func (i *Indexer) RemoveBeaconBlock(ctx context.Context, id string) error {
 	operation := OperationDeleteBeaconBlock
 
@@ -353,6 +380,22 @@ func (i *Indexer) DeleteBeaconBlock(ctx context.Context, id string) error {
 	return nil
 }
 
+// DeleteBeaconBlockReference deletes a beacon block, deleting its object with
+// deleteObject first if no other beacon block references it. Content addressed
+// objects are shared by nodes, so they're kept until their last reference is
+// deleted.
+func (i *Indexer) DeleteBeaconBlockReference(ctx context.Context, block *BeaconBlock, deleteObject func() error) error {
+	operation := OperationDeleteBeaconBlock
+	i.metrics.ObserveOperation(operation)
+
+	err := i.deleteObjectReference(ctx, &BeaconBlock{}, block.ID, block.Location, deleteObject)
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+	}
+
+	return err
+}
+
 func (i *Indexer) UpdateBeaconBlock(ctx context.Context, block *BeaconBlock) error {
 	operation := OperationUpdateBeaconBlock
 
@@ -381,3 +424,27 @@ func (i *Indexer) UpdateBeaconBlock(ctx context.Context, block *BeaconBlock) err
 
 	return nil
 }
+
+// MarkBeaconBlocksOrphaned flags a node's beacon blocks matching the given block roots as
+// no longer canonical and returns how many were updated.
+func (i *Indexer) MarkBeaconBlocksOrphaned(ctx context.Context, node, network string, blockRoots []string) (int64, error) {
+	operation := OperationMarkBeaconBlocksOrphaned
+
+	i.metrics.ObserveOperation(operation)
+
+	if len(blockRoots) == 0 {
+		return 0, nil
+	}
+
+	result := i.db.WithContext(ctx).
+		Model(&BeaconBlock{}).
+		Where("node = ? AND network = ? AND block_root IN ?", node, network, blockRoots).
+		Update("canonical", false)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -318,3 +318,43 @@ func TestBeaconBlockIndividualFilters(t *testing.T) {
 		}
 	})
 }
+
+func TestMarkBeaconBlocksOrphaned(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	orphaned := generateRandomBeaconBlock()
+	canonical := generateRandomBeaconBlock()
+	canonical.Node = orphaned.Node
+	canonical.Network = orphaned.Network
+
+	assert.NoError(t, indexer.InsertBeaconBlock(ctx, orphaned))
+	assert.NoError(t, indexer.InsertBeaconBlock(ctx, canonical))
+
+	updated, err := indexer.MarkBeaconBlocksOrphaned(ctx, orphaned.Node, orphaned.Network, []string{orphaned.BlockRoot})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), updated)
+
+	// Other nodes' copies of the same block are left alone.
+	updated, err = indexer.MarkBeaconBlocksOrphaned(ctx, "another-node", orphaned.Network, []string{canonical.BlockRoot})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), updated)
+
+	filter := &BeaconBlockFilter{}
+	filter.AddNode(orphaned.Node)
+	filter.AddCanonical(false)
+
+	results, err := indexer.ListBeaconBlock(ctx, filter, &PaginationCursor{})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, orphaned.ID, results[0].ID)
+
+	filter.AddCanonical(true)
+
+	results, err = indexer.ListBeaconBlock(ctx, filter, &PaginationCursor{})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, canonical.ID, results[0].ID)
+}
@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"strings"
 	"time"
@@ -23,8 +24,13 @@ type BeaconState struct {
 	BeaconImplementation string
 	NodeVersion          string `gorm:"not null;default:''"`
 	ContentEncoding      string `gorm:"not null;default:''"`
-	Location             string `gorm:"not null;default:''"`
+	Location             string `gorm:"not null;default:'';index:idx_beacon_state_location,where:deleted_at IS NULL"`
 	Network              string `gorm:"not null;default:'';index;index:idx_beacon_state_node_slot_stateroot_network_fetchedat,where:deleted_at IS NULL,priority:4;index:idx_beacon_state_network,where:deleted_at IS NULL;index:idx_beacon_state_network,where:deleted_at IS NULL;index:idx_beacon_state_fetchedat_network,where:deleted_at IS NULL,priority:2"`
+	// Finalized is set when the state was captured as a finalized checkpoint.
+	Finalized       bool `gorm:"not null;default:false;index"`
+	CheckpointEpoch sql.NullInt64
+	// Canonical is false once a reorg has orphaned the state.
+	Canonical bool `gorm:"not null;default:true;index"`
 }
 
 type BeaconStateFilter struct {
@@ -39,6 +45,9 @@ type BeaconStateFilter struct {
 	Location             *string
 	Network              *string
 	BeaconImplementation *string
+	Finalized            *bool
+	CheckpointEpoch      *uint64
+	Canonical            *bool
 }
 
 func (f *BeaconStateFilter) AddID(id string) {
@@ -85,6 +94,18 @@ func (f *BeaconStateFilter) AddBeaconImplementation(beaconImplementation string)
 	f.BeaconImplementation = &beaconImplementation
 }
 
+func (f *BeaconStateFilter) AddFinalized(finalized bool) {
+	f.Finalized = &finalized
+}
+
+func (f *BeaconStateFilter) AddCheckpointEpoch(epoch uint64) {
+	f.CheckpointEpoch = &epoch
+}
+
+func (f *BeaconStateFilter) AddCanonical(canonical bool) {
+	f.Canonical = &canonical
+}
+
 func (f *BeaconStateFilter) Validate() error {
 	if f.ID == nil &&
 		f.Node == nil &&
@@ -96,7 +117,10 @@ func (f *BeaconStateFilter) Validate() error {
 		f.NodeVersion == nil &&
 		f.Location == nil &&
 		f.BeaconImplementation == nil &&
-		f.Network == nil {
+		f.Network == nil &&
+		f.Finalized == nil &&
+		f.CheckpointEpoch == nil &&
+		f.Canonical == nil {
 		return errors.New("no filter specified")
 	}
 
@@ -148,6 +172,18 @@ func (f *BeaconStateFilter) ApplyToQuery(query *gorm.DB) (*gorm.DB, error) {
 		query = query.Where("beacon_implementation = ?", f.BeaconImplementation)
 	}
 
+	if f.Finalized != nil {
+		query = query.Where("finalized = ?", f.Finalized)
+	}
+
+	if f.CheckpointEpoch != nil {
+		query = query.Where("checkpoint_epoch = ?", f.CheckpointEpoch)
+	}
+
+	if f.Canonical != nil {
+		query = query.Where("canonical = ?", f.Canonical)
+	}
+
 	return query, nil
 }
 
@@ -163,6 +199,21 @@ func (i *Indexer) InsertBeaconState(ctx context.Context, state *BeaconState) err
 	return result.Error
 }
 
+// InsertBeaconStateReference inserts a beacon state once checkObject confirms its
+// object is in the store. Retention can't delete the object in between, as
+// it's locked until the beacon state is inserted.
+func (i *Indexer) InsertBeaconStateReference(ctx context.Context, state *BeaconState, checkObject func() error) error {
+	operation := OperationInsertBeaconState
+	i.metrics.ObserveOperation(operation)
+
+	err := i.insertObjectReference(ctx, state.Location, state, checkObject)
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+	}
+
+	return err
+}
+
 func (i *Indexer) RemoveBeaconState(ctx context.Context, id string) error {
 	operation := OperationDeleteBeaconState
 
@@ -353,6 +404,22 @@ func (i *Indexer) DeleteBeaconState(ctx context.Context, id string) error {
 	return nil
 }
 
+// DeleteBeaconStateReference deletes a beacon state, deleting its object with
+// deleteObject first if no other beacon state references it. Content addressed
+// objects are shared by nodes, so they're kept until their last reference is
+// deleted.
+func (i *Indexer) DeleteBeaconStateReference(ctx context.Context, state *BeaconState, deleteObject func() error) error {
+	operation := OperationDeleteBeaconState
+	i.metrics.ObserveOperation(operation)
+
+	err := i.deleteObjectReference(ctx, &BeaconState{}, state.ID, state.Location, deleteObject)
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+	}
+
+	return err
+}
+
 func (i *Indexer) UpdateBeaconState(ctx context.Context, state *BeaconState) error {
 	operation := OperationUpdateBeaconState
 
@@ -381,3 +448,27 @@ func (i *Indexer) UpdateBeaconState(ctx context.Context, state *BeaconState) err
 
 	return nil
 }
+
+// MarkBeaconStatesOrphaned flags a node's beacon states matching the given state roots as
+// no longer canonical and returns how many were updated.
+func (i *Indexer) MarkBeaconStatesOrphaned(ctx context.Context, node, network string, stateRoots []string) (int64, error) {
+	operation := OperationMarkBeaconStatesOrphaned
+
+	i.metrics.ObserveOperation(operation)
+
+	if len(stateRoots) == 0 {
+		return 0, nil
+	}
+
+	result := i.db.WithContext(ctx).
+		Model(&BeaconState{}).
+		Where("node = ? AND network = ? AND state_root IN ?", node, network, stateRoots).
+		Update("canonical", false)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
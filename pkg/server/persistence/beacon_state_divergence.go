@@ -0,0 +1,262 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BeaconStateDivergence records two nodes on the same network disagreeing on
+// the state root for a slot. The "conflicting" side is the previously indexed
+// state that the newly indexed state was compared against.
+type BeaconStateDivergence struct {
+	gorm.Model
+	ID string `gorm:"primaryKey"`
+	// PairKey identifies the pair of states regardless of which side was indexed
+	// first, so a pair is only recorded once. It's set on insert.
+	PairKey string `gorm:"not null;default:'';uniqueIndex:idx_beacon_state_divergence_pair,where:deleted_at IS NULL AND pair_key <> ''"`
+	Network string `gorm:"not null;default:'';index:idx_beacon_state_divergence_network_slot,where:deleted_at IS NULL,priority:1"`
+	// We have to use int64 here as SQLite doesn't support uint64.
+	Slot       int64     `gorm:"index:idx_beacon_state_divergence_network_slot,where:deleted_at IS NULL,priority:2"`
+	Epoch      int64     `gorm:"index"`
+	DetectedAt time.Time `gorm:"index:idx_beacon_state_divergence_detectedat,where:deleted_at IS NULL"`
+
+	BeaconStateID        string `gorm:"not null;default:''"`
+	Node                 string `gorm:"not null;default:''"`
+	StateRoot            string `gorm:"not null;default:''"`
+	BeaconImplementation string `gorm:"not null;default:''"`
+	NodeVersion          string `gorm:"not null;default:''"`
+
+	ConflictingBeaconStateID        string `gorm:"not null;default:''"`
+	ConflictingNode                 string `gorm:"not null;default:''"`
+	ConflictingStateRoot            string `gorm:"not null;default:''"`
+	ConflictingBeaconImplementation string `gorm:"not null;default:''"`
+	ConflictingNodeVersion          string `gorm:"not null;default:''"`
+}
+
+type BeaconStateDivergenceFilter struct {
+	ID                   *string
+	Network              *string
+	Slot                 *uint64
+	Epoch                *uint64
+	Node                 *string
+	BeaconImplementation *string
+	Before               *time.Time
+	After                *time.Time
+}
+
+func (f *BeaconStateDivergenceFilter) AddID(id string) {
+	f.ID = &id
+}
+
+func (f *BeaconStateDivergenceFilter) AddNetwork(network string) {
+	f.Network = &network
+}
+
+func (f *BeaconStateDivergenceFilter) AddSlot(slot uint64) {
+	f.Slot = &slot
+}
+
+func (f *BeaconStateDivergenceFilter) AddEpoch(epoch uint64) {
+	f.Epoch = &epoch
+}
+
+// AddNode matches divergences where the node sits on either side.
+func (f *BeaconStateDivergenceFilter) AddNode(node string) {
+	f.Node = &node
+}
+
+// AddBeaconImplementation matches divergences where the implementation sits on
+// either side.
+func (f *BeaconStateDivergenceFilter) AddBeaconImplementation(beaconImplementation string) {
+	f.BeaconImplementation = &beaconImplementation
+}
+
+func (f *BeaconStateDivergenceFilter) AddBefore(before time.Time) {
+	f.Before = &before
+}
+
+func (f *BeaconStateDivergenceFilter) AddAfter(after time.Time) {
+	f.After = &after
+}
+
+func (f *BeaconStateDivergenceFilter) ApplyToQuery(query *gorm.DB) (*gorm.DB, error) {
+	if f.ID != nil {
+		query = query.Where("id = ?", f.ID)
+	}
+
+	if f.Network != nil {
+		query = query.Where("network = ?", f.Network)
+	}
+
+	if f.Slot != nil {
+		query = query.Where("slot = ?", f.Slot)
+	}
+
+	if f.Epoch != nil {
+		query = query.Where("epoch = ?", f.Epoch)
+	}
+
+	if f.Node != nil {
+		query = query.Where("node = ? OR conflicting_node = ?", f.Node, f.Node)
+	}
+
+	if f.BeaconImplementation != nil {
+		query = query.Where("beacon_implementation = ? OR conflicting_beacon_implementation = ?", f.BeaconImplementation, f.BeaconImplementation)
+	}
+
+	if f.Before != nil {
+		query = query.Where("detected_at <= ?", timestampFormatForDB(*f.Before))
+	}
+
+	if f.After != nil {
+		query = query.Where("detected_at >= ?", timestampFormatForDB(*f.After))
+	}
+
+	return query, nil
+}
+
+// beaconStateDivergencePairKey returns the same key for a pair of states
+// whichever side was indexed first.
+func beaconStateDivergencePairKey(a, b string) string {
+	if a > b {
+		a, b = b, a
+	}
+
+	return a + "/" + b
+}
+
+// InsertBeaconStateDivergence records the divergence and returns false if the
+// pair of states was already recorded.
+func (i *Indexer) InsertBeaconStateDivergence(ctx context.Context, divergence *BeaconStateDivergence) (bool, error) {
+	operation := OperationInsertBeaconStateDivergence
+
+	i.metrics.ObserveOperation(operation)
+
+	divergence.PairKey = beaconStateDivergencePairKey(divergence.BeaconStateID, divergence.ConflictingBeaconStateID)
+
+	result := i.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(divergence)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (i *Indexer) CountBeaconStateDivergence(ctx context.Context, filter *BeaconStateDivergenceFilter) (int64, error) {
+	operation := OperationCountBeaconStateDivergence
+
+	i.metrics.ObserveOperation(operation)
+
+	var count int64
+
+	query := i.db.WithContext(ctx).Model(&BeaconStateDivergence{})
+
+	query, err := filter.ApplyToQuery(query)
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return 0, err
+	}
+
+	result := query.Count(&count)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return 0, result.Error
+	}
+
+	return count, nil
+}
+
+func (i *Indexer) ListBeaconStateDivergence(ctx context.Context, filter *BeaconStateDivergenceFilter, page *PaginationCursor) ([]*BeaconStateDivergence, error) {
+	operation := OperationListBeaconStateDivergence
+
+	i.metrics.ObserveOperation(operation)
+
+	var divergences []*BeaconStateDivergence
+
+	query := i.db.WithContext(ctx).Model(&BeaconStateDivergence{})
+
+	if page != nil {
+		query = page.ApplyOffsetLimit(query)
+
+		// Divergences have no fetched_at column so we can't use the default ordering.
+		if page.OrderBy != "" {
+			query = query.Order(page.OrderBy)
+		} else {
+			query = query.Order("detected_at DESC")
+		}
+	}
+
+	query, err := filter.ApplyToQuery(query)
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return nil, err
+	}
+
+	result := query.Find(&divergences)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return nil, result.Error
+	}
+
+	return divergences, nil
+}
+
+func (i *Indexer) DeleteBeaconStateDivergence(ctx context.Context, id string) error {
+	operation := OperationDeleteBeaconStateDivergence
+
+	i.metrics.ObserveOperation(operation)
+
+	result := i.db.WithContext(ctx).Unscoped().Where("id = ?", id).Delete(&BeaconStateDivergence{})
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		i.metrics.ObserveOperationError(operation)
+
+		return errors.New("beacon state divergence not found")
+	}
+
+	return nil
+}
+
+// DeleteOrphanedBeaconStateDivergences deletes the divergences involving a node's
+// beacon states matching the given state roots, as states from an orphaned fork
+// are expected to differ. It returns how many were deleted.
+func (i *Indexer) DeleteOrphanedBeaconStateDivergences(ctx context.Context, node, network string, stateRoots []string) (int64, error) {
+	operation := OperationDeleteOrphanedBeaconStateDivergences
+
+	i.metrics.ObserveOperation(operation)
+
+	if len(stateRoots) == 0 {
+		return 0, nil
+	}
+
+	result := i.db.WithContext(ctx).
+		Unscoped().
+		Where("network = ?", network).
+		Where(
+			i.db.Where("node = ? AND state_root IN ?", node, stateRoots).
+				Or("conflicting_node = ? AND conflicting_state_root IN ?", node, stateRoots),
+		).
+		Delete(&BeaconStateDivergence{})
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,172 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func generateRandomBeaconStateDivergence() *BeaconStateDivergence {
+	return &BeaconStateDivergence{
+		ID:                              generateRandomString(10),
+		Network:                         generateRandomString(5),
+		Slot:                            generateRandomInt64(),
+		Epoch:                           generateRandomInt64(),
+		DetectedAt:                      time.Now(),
+		BeaconStateID:                   generateRandomString(10),
+		Node:                            generateRandomString(5),
+		StateRoot:                       generateRandomString(32),
+		BeaconImplementation:            generateRandomString(15),
+		NodeVersion:                     generateRandomString(8),
+		ConflictingBeaconStateID:        generateRandomString(10),
+		ConflictingNode:                 generateRandomString(5),
+		ConflictingStateRoot:            generateRandomString(32),
+		ConflictingBeaconImplementation: generateRandomString(15),
+		ConflictingNodeVersion:          generateRandomString(8),
+	}
+}
+
+func TestCountBeaconStateDivergence(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	divergence := generateRandomBeaconStateDivergence()
+
+	_, err = indexer.InsertBeaconStateDivergence(ctx, divergence)
+	assert.NoError(t, err)
+
+	count, err := indexer.CountBeaconStateDivergence(ctx, &BeaconStateDivergenceFilter{ID: &divergence.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestDeleteBeaconStateDivergence(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	divergence := generateRandomBeaconStateDivergence()
+
+	_, err = indexer.InsertBeaconStateDivergence(ctx, divergence)
+	assert.NoError(t, err)
+
+	err = indexer.DeleteBeaconStateDivergence(ctx, divergence.ID)
+	assert.NoError(t, err)
+
+	err = indexer.DeleteBeaconStateDivergence(ctx, divergence.ID)
+	assert.Error(t, err)
+}
+
+func TestInsertBeaconStateDivergence_DuplicatePair(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	divergence := generateRandomBeaconStateDivergence()
+
+	inserted, err := indexer.InsertBeaconStateDivergence(ctx, divergence)
+	assert.NoError(t, err)
+	assert.True(t, inserted)
+
+	// The same pair recorded from the other side, e.g. by a concurrent insert.
+	reversed := generateRandomBeaconStateDivergence()
+	reversed.BeaconStateID = divergence.ConflictingBeaconStateID
+	reversed.ConflictingBeaconStateID = divergence.BeaconStateID
+
+	inserted, err = indexer.InsertBeaconStateDivergence(ctx, reversed)
+	assert.NoError(t, err)
+	assert.False(t, inserted)
+
+	count, err := indexer.CountBeaconStateDivergence(ctx, &BeaconStateDivergenceFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestDeleteOrphanedBeaconStateDivergences(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	divergence := generateRandomBeaconStateDivergence()
+	conflicting := generateRandomBeaconStateDivergence()
+	conflicting.Network = divergence.Network
+	other := generateRandomBeaconStateDivergence()
+
+	for _, d := range []*BeaconStateDivergence{divergence, conflicting, other} {
+		_, err = indexer.InsertBeaconStateDivergence(ctx, d)
+		assert.NoError(t, err)
+	}
+
+	deleted, err := indexer.DeleteOrphanedBeaconStateDivergences(ctx, divergence.Node, divergence.Network, []string{divergence.StateRoot})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	// The node can also sit on the conflicting side.
+	deleted, err = indexer.DeleteOrphanedBeaconStateDivergences(ctx, conflicting.ConflictingNode, conflicting.Network, []string{conflicting.ConflictingStateRoot})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	count, err := indexer.CountBeaconStateDivergence(ctx, &BeaconStateDivergenceFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestBeaconStateDivergenceIndividualFilters(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	divergence := generateRandomBeaconStateDivergence()
+
+	if _, err := indexer.InsertBeaconStateDivergence(context.Background(), divergence); err != nil {
+		t.Fatal(err)
+	}
+
+	// A second divergence that shouldn't match any of the filters below.
+	if _, err := indexer.InsertBeaconStateDivergence(context.Background(), generateRandomBeaconStateDivergence()); err != nil {
+		t.Fatal(err)
+	}
+
+	slot := uint64(divergence.Slot)
+	epoch := uint64(divergence.Epoch)
+	before := time.Now().Add(time.Minute)
+
+	testCases := []struct {
+		name   string
+		filter BeaconStateDivergenceFilter
+		want   int
+	}{
+		{"ID", BeaconStateDivergenceFilter{ID: &divergence.ID}, 1},
+		{"Network", BeaconStateDivergenceFilter{Network: &divergence.Network}, 1},
+		{"Slot", BeaconStateDivergenceFilter{Slot: &slot}, 1},
+		{"Epoch", BeaconStateDivergenceFilter{Epoch: &epoch}, 1},
+		{"Node", BeaconStateDivergenceFilter{Node: &divergence.Node}, 1},
+		{"ConflictingNode", BeaconStateDivergenceFilter{Node: &divergence.ConflictingNode}, 1},
+		{"BeaconImplementation", BeaconStateDivergenceFilter{BeaconImplementation: &divergence.BeaconImplementation}, 1},
+		{"ConflictingBeaconImplementation", BeaconStateDivergenceFilter{BeaconImplementation: &divergence.ConflictingBeaconImplementation}, 1},
+		{"NetworkAndConflictingNode", BeaconStateDivergenceFilter{Network: &divergence.Network, Node: &divergence.ConflictingNode}, 1},
+		{"Before", BeaconStateDivergenceFilter{Before: &before}, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			divergences, err := indexer.ListBeaconStateDivergence(context.Background(), &tc.filter, &PaginationCursor{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(divergences) != tc.want {
+				t.Fatalf("expected %d beacon state divergences, got %d", tc.want, len(divergences))
+			}
+
+			if tc.want == 1 && divergences[0].ID != divergence.ID {
+				t.Fatalf("expected ID %s, got %s", divergence.ID, divergences[0].ID)
+			}
+		})
+	}
+}
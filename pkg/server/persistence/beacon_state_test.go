@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -317,3 +318,83 @@ func TestBeaconStateIndividualFilters(t *testing.T) {
 		}
 	})
 }
+
+func TestBeaconStateFinalizedFilters(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	checkpointEpoch := int64(10)
+
+	finalized := generateRandomBeaconState()
+	finalized.Finalized = true
+	finalized.CheckpointEpoch = sql.NullInt64{Int64: checkpointEpoch, Valid: true}
+
+	assert.NoError(t, indexer.InsertBeaconState(ctx, finalized))
+	assert.NoError(t, indexer.InsertBeaconState(ctx, generateRandomBeaconState()))
+
+	filter := &BeaconStateFilter{}
+	filter.AddFinalized(true)
+
+	states, err := indexer.ListBeaconState(ctx, filter, &PaginationCursor{})
+	assert.NoError(t, err)
+	assert.Len(t, states, 1)
+	assert.Equal(t, finalized.ID, states[0].ID)
+	assert.Equal(t, checkpointEpoch, states[0].CheckpointEpoch.Int64)
+
+	filter = &BeaconStateFilter{}
+	filter.AddFinalized(false)
+
+	states, err = indexer.ListBeaconState(ctx, filter, &PaginationCursor{})
+	assert.NoError(t, err)
+	assert.Len(t, states, 1)
+	assert.NotEqual(t, finalized.ID, states[0].ID)
+
+	filter = &BeaconStateFilter{}
+	filter.AddCheckpointEpoch(uint64(checkpointEpoch))
+
+	count, err := indexer.CountBeaconState(ctx, filter)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestMarkBeaconStatesOrphaned(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	orphaned := generateRandomBeaconState()
+	canonical := generateRandomBeaconState()
+	canonical.Node = orphaned.Node
+	canonical.Network = orphaned.Network
+
+	assert.NoError(t, indexer.InsertBeaconState(ctx, orphaned))
+	assert.NoError(t, indexer.InsertBeaconState(ctx, canonical))
+
+	updated, err := indexer.MarkBeaconStatesOrphaned(ctx, orphaned.Node, orphaned.Network, []string{orphaned.StateRoot})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), updated)
+
+	// Other nodes' copies of the same block are left alone.
+	updated, err = indexer.MarkBeaconStatesOrphaned(ctx, "another-node", orphaned.Network, []string{canonical.StateRoot})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), updated)
+
+	filter := &BeaconStateFilter{}
+	filter.AddNode(orphaned.Node)
+	filter.AddCanonical(false)
+
+	results, err := indexer.ListBeaconState(ctx, filter, &PaginationCursor{})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, orphaned.ID, results[0].ID)
+
+	filter.AddCanonical(true)
+
+	results, err = indexer.ListBeaconState(ctx, filter, &PaginationCursor{})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, canonical.ID, results[0].ID)
+}
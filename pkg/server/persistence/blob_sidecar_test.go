@@ -0,0 +1,322 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func generateRandomBlobSidecar() *BlobSidecar {
+	return &BlobSidecar{
+		ID:                   generateRandomString(10),
+		Node:                 generateRandomString(5),
+		Slot:                 generateRandomInt64(),
+		Epoch:                generateRandomInt64(),
+		BlockRoot:            generateRandomString(32),
+		SidecarType:          "blob_sidecar",
+		FetchedAt:            time.Now(),
+		BeaconImplementation: generateRandomString(15),
+		NodeVersion:          generateRandomString(8),
+		Location:             generateRandomString(10),
+		Network:              generateRandomString(5),
+	}
+}
+
+func TestInsertBlobSidecar(t *testing.T) {
+	indexer, mock, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	sidecar := generateRandomBlobSidecar()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WithArgs(
+		sqlmock.AnyArg(), sqlmock.AnyArg(), nil, sidecar.ID, sidecar.Node, sidecar.Slot, sidecar.Epoch,
+		sidecar.BlockRoot, sidecar.FetchedAt, sidecar.BeaconImplementation, sidecar.NodeVersion,
+		sidecar.Location, sidecar.Network,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = indexer.InsertBlobSidecar(ctx, sidecar)
+	assert.NoError(t, err)
+}
+
+func TestRemoveBlobSidecar(t *testing.T) {
+	indexer, mock, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	id := testID
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM").WithArgs(id).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = indexer.RemoveBlobSidecar(ctx, id)
+	assert.NoError(t, err)
+}
+
+func TestCountBlobSidecar(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	sidecar := generateRandomBlobSidecar()
+
+	err = indexer.InsertBlobSidecar(ctx, sidecar)
+	assert.NoError(t, err)
+
+	filter := &BlobSidecarFilter{
+		ID: &sidecar.ID,
+	}
+
+	count, err := indexer.CountBlobSidecar(ctx, filter)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestListBlobSidecar(t *testing.T) {
+	indexer, mock, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	sidecar := generateRandomBlobSidecar()
+
+	err = indexer.InsertBlobSidecar(ctx, sidecar)
+	assert.NoError(t, err)
+
+	filter := &BlobSidecarFilter{
+		ID: &sidecar.ID,
+	}
+	page := &PaginationCursor{}
+
+	mock.ExpectQuery("SELECT \\* FROM").WithArgs(filter.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "node"}).AddRow(testID, "test-node"))
+
+	sidecars, err := indexer.ListBlobSidecar(ctx, filter, page)
+	assert.NoError(t, err)
+	assert.Len(t, sidecars, 1)
+	assert.Equal(t, sidecar.ID, sidecars[0].ID)
+	assert.Equal(t, sidecar.Node, sidecars[0].Node)
+}
+
+func TestUpdateBlobSidecar(t *testing.T) {
+	indexer, mock, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	sidecar := generateRandomBlobSidecar()
+
+	err = indexer.InsertBlobSidecar(ctx, sidecar)
+	assert.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE").WithArgs(
+		sidecar.ID, sidecar.Node, sidecar.Slot, sidecar.Epoch, sidecar.BlockRoot, sidecar.FetchedAt,
+		sidecar.BeaconImplementation, sidecar.NodeVersion, sidecar.Location, sidecar.Network,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = indexer.UpdateBlobSidecar(ctx, sidecar)
+	assert.NoError(t, err)
+}
+
+//nolint:gocyclo // Test is long but manageable
+func TestBlobSidecarFilters(t *testing.T) {
+	t.Run("By random combinations", func(t *testing.T) {
+		indexer, _, err := NewMockIndexer()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Add 10000 random blob sidecars
+		for i := 0; i < 10000; i++ {
+			id := uuid.New().String()
+			node := fmt.Sprintf("node-%d", i)
+			slot := int64(rand.Intn(1000))
+			epoch := int64(rand.Intn(100))
+			blockRoot := fmt.Sprintf("blockRoot-%d", rand.Intn(100))
+			nodeVersion := fmt.Sprintf("version%d", rand.Intn(10))
+			location := fmt.Sprintf("location%d", rand.Intn(10))
+			network := fmt.Sprintf("network%d", rand.Intn(10))
+			beaconImplementation := fmt.Sprintf("implementation%d", rand.Intn(10))
+
+			blobSidecar := &BlobSidecar{
+				ID:                   id,
+				Node:                 node,
+				Slot:                 slot,
+				Epoch:                epoch,
+				BlockRoot:            blockRoot,
+				FetchedAt:            time.Now(),
+				NodeVersion:          nodeVersion,
+				Location:             location,
+				Network:              network,
+				BeaconImplementation: beaconImplementation,
+			}
+
+			err = indexer.InsertBlobSidecar(context.Background(), blobSidecar)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// List blob sidecars with random filters
+		for i := 0; i < 5000; i++ {
+			var filter BlobSidecarFilter
+
+			if rand.Intn(2) == 1 {
+				filter.AddID(uuid.New().String())
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddNode(fmt.Sprintf("node-%d", rand.Intn(100)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddBefore(time.Now())
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddAfter(time.Now().Add(-24 * time.Hour))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddSlot(uint64(rand.Intn(1000)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddEpoch(uint64(rand.Intn(100)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddBlockRoot(fmt.Sprintf("blockRoot-%d", rand.Intn(100)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddNodeVersion(fmt.Sprintf("version%d", rand.Intn(10)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddLocation(fmt.Sprintf("location%d", rand.Intn(10)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddNetwork(fmt.Sprintf("network%d", rand.Intn(10)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddBeaconImplementation(fmt.Sprintf("implementation%d", rand.Intn(10)))
+			}
+
+			blobSidecars, err := indexer.ListBlobSidecar(context.Background(), &filter, &PaginationCursor{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, blobSidecar := range blobSidecars {
+				if filter.ID != nil && *filter.ID != blobSidecar.ID {
+					t.Fatalf("expected ID %s, got %s", *filter.ID, blobSidecar.ID)
+				}
+
+				if filter.Node != nil && *filter.Node != blobSidecar.Node {
+					t.Fatalf("expected Node %s, got %s", *filter.Node, blobSidecar.Node)
+				}
+
+				if filter.Before != nil && blobSidecar.FetchedAt.After(*filter.Before) {
+					t.Fatalf("expected FetchedAt before %s, got %s", *filter.Before, blobSidecar.FetchedAt)
+				}
+
+				if filter.After != nil && blobSidecar.FetchedAt.Before(*filter.After) {
+					t.Fatalf("expected FetchedAt after %s, got %s", *filter.After, blobSidecar.FetchedAt)
+				}
+
+				if filter.Slot != nil && *filter.Slot != uint64(blobSidecar.Slot) {
+					t.Fatalf("expected Slot %d, got %d", *filter.Slot, blobSidecar.Slot)
+				}
+
+				if filter.Epoch != nil && *filter.Epoch != uint64(blobSidecar.Epoch) {
+					t.Fatalf("expected Epoch %d, got %d", *filter.Epoch, blobSidecar.Epoch)
+				}
+
+				if filter.BlockRoot != nil && *filter.BlockRoot != blobSidecar.BlockRoot {
+					t.Fatalf("expected BlockRoot %s, got %s", *filter.BlockRoot, blobSidecar.BlockRoot)
+				}
+
+				if filter.NodeVersion != nil && *filter.NodeVersion != blobSidecar.NodeVersion {
+					t.Fatalf("expected NodeVersion %s, got %s", *filter.NodeVersion, blobSidecar.NodeVersion)
+				}
+
+				if filter.Location != nil && *filter.Location != blobSidecar.Location {
+					t.Fatalf("expected Location %s, got %s", *filter.Location, blobSidecar.Location)
+				}
+
+				if filter.Network != nil && *filter.Network != blobSidecar.Network {
+					t.Fatalf("expected Network %s, got %s", *filter.Network, blobSidecar.Network)
+				}
+
+				if filter.BeaconImplementation != nil && *filter.BeaconImplementation != blobSidecar.BeaconImplementation {
+					t.Fatalf("expected BeaconImplementation %s, got %s", *filter.BeaconImplementation, blobSidecar.BeaconImplementation)
+				}
+			}
+		}
+	})
+}
+
+func TestBlobSidecarIndividualFilters(t *testing.T) {
+	t.Run("By individual attributes", func(t *testing.T) {
+		indexer, _, err := NewMockIndexer()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		blobSidecar := generateRandomBlobSidecar()
+
+		err = indexer.InsertBlobSidecar(context.Background(), blobSidecar)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		slot := uint64(blobSidecar.Slot)
+		epoch := uint64(blobSidecar.Epoch)
+
+		// Test filters individually
+		testCases := []struct {
+			name   string
+			filter BlobSidecarFilter
+		}{
+			{"ID", BlobSidecarFilter{ID: &blobSidecar.ID}},
+			{"Node", BlobSidecarFilter{Node: &blobSidecar.Node}},
+			{"Slot", BlobSidecarFilter{Slot: &slot}},
+			{"Epoch", BlobSidecarFilter{Epoch: &epoch}},
+			{"BlockRoot", BlobSidecarFilter{BlockRoot: &blobSidecar.BlockRoot}},
+			{"SidecarType", BlobSidecarFilter{SidecarType: &blobSidecar.SidecarType}},
+			{"NodeVersion", BlobSidecarFilter{NodeVersion: &blobSidecar.NodeVersion}},
+			{"Location", BlobSidecarFilter{Location: &blobSidecar.Location}},
+			{"Network", BlobSidecarFilter{Network: &blobSidecar.Network}},
+			{"BeaconImplementation", BlobSidecarFilter{BeaconImplementation: &blobSidecar.BeaconImplementation}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				blobSidecars, err := indexer.ListBlobSidecar(context.Background(), &tc.filter, &PaginationCursor{})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if len(blobSidecars) != 1 {
+					t.Fatalf("expected 1 blob sidecar, got %d", len(blobSidecars))
+				}
+
+				if blobSidecars[0].ID != blobSidecar.ID {
+					t.Fatalf("expected ID %s, got %s", blobSidecar.ID, blobSidecars[0].ID)
+				}
+			})
+		}
+	})
+}
@@ -15,6 +15,9 @@ const (
 	KeyLocation                = "location"
 	KeyFetchedAt               = "fetched_at"
 	KeyBeaconImplementation    = "beacon_implementation"
+	KeyTracer                  = "tracer"
+	KeySidecarType             = "sidecar_type"
+	KeyReason                  = "reason"
 )
 
 //nolint:tagliatelle // requires snake.
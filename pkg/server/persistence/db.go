@@ -75,11 +75,26 @@ func (i *Indexer) Start(ctx context.Context) error {
 		return perrors.Wrap(err, "failed to auto migrate beacon state")
 	}
 
+	err = i.db.AutoMigrate(&BeaconStateDivergence{})
+	if err != nil {
+		return perrors.Wrap(err, "failed to auto migrate beacon state divergence")
+	}
+
 	err = i.db.AutoMigrate(&BeaconBlock{})
 	if err != nil {
 		return perrors.Wrap(err, "failed to auto migrate beacon block")
 	}
 
+	err = i.db.AutoMigrate(&BlobSidecar{})
+	if err != nil {
+		return perrors.Wrap(err, "failed to auto migrate blob sidecar")
+	}
+
+	err = i.db.AutoMigrate(&ForkChoice{})
+	if err != nil {
+		return perrors.Wrap(err, "failed to auto migrate fork choice")
+	}
+
 	err = i.db.AutoMigrate(&BeaconBadBlock{})
 	if err != nil {
 		return perrors.Wrap(err, "failed to auto migrate beacon bad block")
@@ -95,6 +110,11 @@ func (i *Indexer) Start(ctx context.Context) error {
 		return perrors.Wrap(err, "failed to auto migrate execution block trace")
 	}
 
+	err = i.db.AutoMigrate(&ExecutionWitness{})
+	if err != nil {
+		return perrors.Wrap(err, "failed to auto migrate execution witness")
+	}
+
 	err = i.db.AutoMigrate(&ExecutionBadBlock{})
 	if err != nil {
 		return perrors.Wrap(err, "failed to auto migrate execution bad block")
@@ -110,6 +130,11 @@ func (i *Indexer) Start(ctx context.Context) error {
 		return perrors.Wrap(err, "failed to auto migrate permanent block")
 	}
 
+	err = i.db.AutoMigrate(&StoredObjectLock{})
+	if err != nil {
+		return perrors.Wrap(err, "failed to auto migrate stored object lock")
+	}
+
 	return nil
 }
 
@@ -23,6 +23,10 @@ type ExecutionBadBlock struct {
 	BlockHash               string `gorm:"not null;default:'';index;index:iidx_execution_bad_block_node_blockhash_fetchedat_network,where:deleted_at IS NULL,priority:2"`
 	BlockNumber             sql.NullInt64
 	BlockExtraData          sql.NullString
+	// TraceLocation and IntermediateRootsLocation are empty if the node
+	// didn't support tracing the bad block.
+	TraceLocation             string `gorm:"not null;default:''"`
+	IntermediateRootsLocation string `gorm:"not null;default:''"`
 }
 
 type ExecutionBadBlockFilter struct {
@@ -21,6 +21,11 @@ type ExecutionBlockTrace struct {
 	Network                 string `gorm:"not null;default:'';index;index:idx_execution_block_trace_node_blockhash_fetchedat_network,where:deleted_at IS NULL,priority:4;index:idx_execution_block_trace_network,where:deleted_at IS NULL;index:idx_execution_block_trace_fetchedat_network,where:deleted_at IS NULL,priority:2"`
 	BlockHash               string `gorm:"not null;default:'';index;index:idx_execution_block_trace_node_blockhash_fetchedat_network,where:deleted_at IS NULL,priority:2"`
 	BlockNumber             int64
+	// Tracer is the debug_traceBlockByHash tracer that produced the trace. Traces
+	// indexed before tracers were configurable were all produced by the struct logger.
+	Tracer string `gorm:"not null;default:'structLogger';index"`
+	// Canonical is false once a reorg has orphaned the block.
+	Canonical bool `gorm:"not null;default:true;index"`
 }
 
 type ExecutionBlockTraceFilter struct {
@@ -34,6 +39,8 @@ type ExecutionBlockTraceFilter struct {
 	ExecutionImplementation *string
 	BlockHash               *string
 	BlockNumber             *int64
+	Tracer                  *string
+	Canonical               *bool
 }
 
 func (f *ExecutionBlockTraceFilter) AddID(id string) {
@@ -76,6 +83,14 @@ func (f *ExecutionBlockTraceFilter) AddBlockNumber(number int64) {
 	f.BlockNumber = &number
 }
 
+func (f *ExecutionBlockTraceFilter) AddTracer(tracer string) {
+	f.Tracer = &tracer
+}
+
+func (f *ExecutionBlockTraceFilter) AddCanonical(canonical bool) {
+	f.Canonical = &canonical
+}
+
 func (f *ExecutionBlockTraceFilter) Validate() error {
 	if f.ID == nil &&
 		f.Node == nil &&
@@ -86,7 +101,9 @@ func (f *ExecutionBlockTraceFilter) Validate() error {
 		f.ExecutionImplementation == nil &&
 		f.NodeVersion == nil &&
 		f.Location == nil &&
-		f.Network == nil {
+		f.Network == nil &&
+		f.Tracer == nil &&
+		f.Canonical == nil {
 		return errors.New("no filter specified")
 	}
 
@@ -134,6 +151,14 @@ func (f *ExecutionBlockTraceFilter) ApplyToQuery(query *gorm.DB) (*gorm.DB, erro
 		query = query.Where("execution_implementation = ?", f.ExecutionImplementation)
 	}
 
+	if f.Tracer != nil {
+		query = query.Where("tracer = ?", f.Tracer)
+	}
+
+	if f.Canonical != nil {
+		query = query.Where("canonical = ?", f.Canonical)
+	}
+
 	return query, nil
 }
 
@@ -229,6 +254,7 @@ type DistinctExecutionBlockTraceValueResults struct {
 	Network                 []string
 	ExecutionImplementation []string
 	NodeVersion             []string
+	Tracer                  []string
 }
 
 //nolint:errcheck // casting fine here.
@@ -245,6 +271,7 @@ func (i *Indexer) DistinctExecutionBlockTraceValues(ctx context.Context, fields
 		Network:                 make([]string, 0),
 		ExecutionImplementation: make([]string, 0),
 		NodeVersion:             make([]string, 0),
+		Tracer:                  make([]string, 0),
 	}
 	query := i.db.WithContext(ctx).Model(&ExecutionBlockTrace{}).Select(fields).Group(strings.Join(fields, ", ")).Limit(1000)
 
@@ -294,6 +321,8 @@ func (i *Indexer) DistinctExecutionBlockTraceValues(ctx context.Context, fields
 					results.ExecutionImplementation = append(results.ExecutionImplementation, values[i].(string))
 				case KeyNodeVersion:
 					results.NodeVersion = append(results.NodeVersion, values[i].(string))
+				case KeyTracer:
+					results.Tracer = append(results.Tracer, values[i].(string))
 				}
 
 				valueSets[field][values[i]] = true
@@ -309,3 +338,27 @@ func (i *Indexer) DistinctExecutionBlockTraceValues(ctx context.Context, fields
 
 	return results, nil
 }
+
+// MarkExecutionBlockTracesOrphaned flags a node's execution block traces matching the given block hashs as
+// no longer canonical and returns how many were updated.
+func (i *Indexer) MarkExecutionBlockTracesOrphaned(ctx context.Context, node, network string, blockHashes []string) (int64, error) {
+	operation := OperationMarkExecutionBlockTracesOrphaned
+
+	i.metrics.ObserveOperation(operation)
+
+	if len(blockHashes) == 0 {
+		return 0, nil
+	}
+
+	result := i.db.WithContext(ctx).
+		Model(&ExecutionBlockTrace{}).
+		Where("node = ? AND network = ? AND block_hash IN ?", node, network, blockHashes).
+		Update("canonical", false)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -23,6 +23,7 @@ func generateRandomExecutionBlockTrace() *ExecutionBlockTrace {
 		Network:                 generateRandomString(5),
 		BlockHash:               generateRandomString(64),
 		BlockNumber:             generateRandomInt64(),
+		Tracer:                  generateRandomString(10),
 	}
 }
 
@@ -103,6 +104,26 @@ func TestListExecutionBlockTrace(t *testing.T) {
 	assert.Equal(t, trace.Node, traces[0].Node)
 }
 
+func TestExecutionBlockTraceDefaultTracer(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	trace := generateRandomExecutionBlockTrace()
+	trace.Tracer = ""
+
+	err = indexer.InsertExecutionBlockTrace(ctx, trace)
+	assert.NoError(t, err)
+
+	tracer := "structLogger"
+
+	traces, err := indexer.ListExecutionBlockTrace(ctx, &ExecutionBlockTraceFilter{ID: &trace.ID, Tracer: &tracer}, &PaginationCursor{})
+	assert.NoError(t, err)
+	assert.Len(t, traces, 1)
+	assert.Equal(t, tracer, traces[0].Tracer)
+}
+
 //nolint:gocyclo // Test is long but manageable
 func TestExecutionBlockTraceFilters(t *testing.T) {
 	t.Run("By individual attributes", func(t *testing.T) {
@@ -131,6 +152,7 @@ func TestExecutionBlockTraceFilters(t *testing.T) {
 			{"Location", ExecutionBlockTraceFilter{Location: &trace.Location}},
 			{"Network", ExecutionBlockTraceFilter{Network: &trace.Network}},
 			{"ExecutionImplementation", ExecutionBlockTraceFilter{ExecutionImplementation: &trace.ExecutionImplementation}},
+			{"Tracer", ExecutionBlockTraceFilter{Tracer: &trace.Tracer}},
 		}
 
 		for _, tc := range testCases {
@@ -280,3 +302,43 @@ func TestExecutionBlockTraceFilters(t *testing.T) {
 		}
 	})
 }
+
+func TestMarkExecutionBlockTracesOrphaned(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	orphaned := generateRandomExecutionBlockTrace()
+	canonical := generateRandomExecutionBlockTrace()
+	canonical.Node = orphaned.Node
+	canonical.Network = orphaned.Network
+
+	assert.NoError(t, indexer.InsertExecutionBlockTrace(ctx, orphaned))
+	assert.NoError(t, indexer.InsertExecutionBlockTrace(ctx, canonical))
+
+	updated, err := indexer.MarkExecutionBlockTracesOrphaned(ctx, orphaned.Node, orphaned.Network, []string{orphaned.BlockHash})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), updated)
+
+	// Other nodes' copies of the same block are left alone.
+	updated, err = indexer.MarkExecutionBlockTracesOrphaned(ctx, "another-node", orphaned.Network, []string{canonical.BlockHash})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), updated)
+
+	filter := &ExecutionBlockTraceFilter{}
+	filter.AddNode(orphaned.Node)
+	filter.AddCanonical(false)
+
+	results, err := indexer.ListExecutionBlockTrace(ctx, filter, &PaginationCursor{})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, orphaned.ID, results[0].ID)
+
+	filter.AddCanonical(true)
+
+	results, err = indexer.ListExecutionBlockTrace(ctx, filter, &PaginationCursor{})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, canonical.ID, results[0].ID)
+}
@@ -0,0 +1,311 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ExecutionWitness struct {
+	gorm.Model
+	ID                      string    `gorm:"primaryKey"`
+	Node                    string    `gorm:"index;index:idx_execution_witness_node_blockhash_fetchedat_network,where:deleted_at IS NULL,priority:1"`
+	FetchedAt               time.Time `gorm:"index;index:idx_execution_witness_node_blockhash_fetchedat_network,where:deleted_at IS NULL,priority:3;index:idx_execution_witness_fetchedat,where:deleted_at IS NULL;index:idx_execution_witness_fetchedat_network,where:deleted_at IS NULL,priority:1"`
+	ExecutionImplementation string
+	NodeVersion             string `gorm:"not null;default:''"`
+	Location                string `gorm:"not null;default:''"`
+	ContentEncoding         string `gorm:"not null;default:''"`
+	Network                 string `gorm:"not null;default:'';index;index:idx_execution_witness_node_blockhash_fetchedat_network,where:deleted_at IS NULL,priority:4;index:idx_execution_witness_network,where:deleted_at IS NULL;index:idx_execution_witness_fetchedat_network,where:deleted_at IS NULL,priority:2"`
+	BlockHash               string `gorm:"not null;default:'';index;index:idx_execution_witness_node_blockhash_fetchedat_network,where:deleted_at IS NULL,priority:2"`
+	BlockNumber             int64
+}
+
+type ExecutionWitnessFilter struct {
+	ID                      *string
+	Node                    *string
+	Before                  *time.Time
+	After                   *time.Time
+	NodeVersion             *string
+	Location                *string
+	Network                 *string
+	ExecutionImplementation *string
+	BlockHash               *string
+	BlockNumber             *int64
+}
+
+func (f *ExecutionWitnessFilter) AddID(id string) {
+	f.ID = &id
+}
+
+func (f *ExecutionWitnessFilter) AddNode(node string) {
+	f.Node = &node
+}
+
+func (f *ExecutionWitnessFilter) AddBefore(before time.Time) {
+	f.Before = &before
+}
+
+func (f *ExecutionWitnessFilter) AddAfter(after time.Time) {
+	f.After = &after
+}
+
+func (f *ExecutionWitnessFilter) AddNodeVersion(nodeVersion string) {
+	f.NodeVersion = &nodeVersion
+}
+
+func (f *ExecutionWitnessFilter) AddLocation(location string) {
+	f.Location = &location
+}
+
+func (f *ExecutionWitnessFilter) AddNetwork(network string) {
+	f.Network = &network
+}
+
+func (f *ExecutionWitnessFilter) AddExecutionImplementation(impl string) {
+	f.ExecutionImplementation = &impl
+}
+
+func (f *ExecutionWitnessFilter) AddBlockHash(hash string) {
+	f.BlockHash = &hash
+}
+
+func (f *ExecutionWitnessFilter) AddBlockNumber(number int64) {
+	f.BlockNumber = &number
+}
+
+func (f *ExecutionWitnessFilter) Validate() error {
+	if f.ID == nil &&
+		f.Node == nil &&
+		f.Before == nil &&
+		f.After == nil &&
+		f.BlockHash == nil &&
+		f.BlockNumber == nil &&
+		f.ExecutionImplementation == nil &&
+		f.NodeVersion == nil &&
+		f.Location == nil &&
+		f.Network == nil {
+		return errors.New("no filter specified")
+	}
+
+	return nil
+}
+
+func (f *ExecutionWitnessFilter) ApplyToQuery(query *gorm.DB) (*gorm.DB, error) {
+	if f.ID != nil {
+		query = query.Where("id = ?", f.ID)
+	}
+
+	if f.Node != nil {
+		query = query.Where("node = ?", f.Node)
+	}
+
+	if f.Before != nil {
+		query = query.Where("fetched_at <= ?", timestampFormatForDB(*f.Before))
+	}
+
+	if f.After != nil {
+		query = query.Where("fetched_at >= ?", timestampFormatForDB(*f.After))
+	}
+
+	if f.BlockHash != nil {
+		query = query.Where("block_hash = ?", f.BlockHash)
+	}
+
+	if f.BlockNumber != nil {
+		query = query.Where("block_number = ?", f.BlockNumber)
+	}
+
+	if f.NodeVersion != nil {
+		query = query.Where("node_version = ?", f.NodeVersion)
+	}
+
+	if f.Location != nil {
+		query = query.Where("location = ?", f.Location)
+	}
+
+	if f.Network != nil {
+		query = query.Where("network = ?", f.Network)
+	}
+
+	if f.ExecutionImplementation != nil {
+		query = query.Where("execution_implementation = ?", f.ExecutionImplementation)
+	}
+
+	return query, nil
+}
+
+func (i *Indexer) InsertExecutionWitness(ctx context.Context, trace *ExecutionWitness) error {
+	operation := OperationInsertExecutionWitness
+	i.metrics.ObserveOperation(operation)
+
+	result := i.db.WithContext(ctx).Create(trace)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+	}
+
+	return result.Error
+}
+
+func (i *Indexer) DeleteExecutionWitness(ctx context.Context, id string) error {
+	operation := OperationDeleteExecutionWitness
+
+	i.metrics.ObserveOperation(operation)
+
+	result := i.db.WithContext(ctx).Unscoped().Where("id = ?", id).Delete(&ExecutionWitness{})
+
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+	}
+
+	return result.Error
+}
+
+func (i *Indexer) CountExecutionWitness(ctx context.Context, filter *ExecutionWitnessFilter) (int64, error) {
+	operation := OperationCountExecutionWitness
+
+	i.metrics.ObserveOperation(operation)
+
+	var count int64
+
+	query := i.db.WithContext(ctx).Model(&ExecutionWitness{})
+
+	query, err := filter.ApplyToQuery(query)
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return 0, err
+	}
+
+	result := query.Count(&count)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return 0, result.Error
+	}
+
+	return count, nil
+}
+
+func (i *Indexer) ListExecutionWitness(ctx context.Context, filter *ExecutionWitnessFilter, page *PaginationCursor) ([]*ExecutionWitness, error) {
+	operation := OperationListExecutionWitness
+
+	i.metrics.ObserveOperation(operation)
+
+	var executionWitnesses []*ExecutionWitness
+
+	query := i.db.WithContext(ctx).Model(&ExecutionWitness{})
+
+	if page != nil {
+		query = page.ApplyOffsetLimit(query)
+
+		query = page.ApplyOrderBy(query)
+	}
+
+	query, err := filter.ApplyToQuery(query)
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return nil, err
+	}
+
+	result := query.Find(&executionWitnesses)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return nil, result.Error
+	}
+
+	return executionWitnesses, nil
+}
+
+type DistinctExecutionWitnessValueResults struct {
+	Node                    []string
+	BlockHash               []string
+	BlockNumber             []int64
+	Location                []string
+	Network                 []string
+	ExecutionImplementation []string
+	NodeVersion             []string
+}
+
+//nolint:errcheck // casting fine here.
+func (i *Indexer) DistinctExecutionWitnessValues(ctx context.Context, fields []string) (*DistinctExecutionWitnessValueResults, error) {
+	operation := OperationDistinctValues
+
+	i.metrics.ObserveOperation(operation)
+
+	results := &DistinctExecutionWitnessValueResults{
+		Node:                    make([]string, 0),
+		BlockHash:               make([]string, 0),
+		BlockNumber:             make([]int64, 0),
+		Location:                make([]string, 0),
+		Network:                 make([]string, 0),
+		ExecutionImplementation: make([]string, 0),
+		NodeVersion:             make([]string, 0),
+	}
+	query := i.db.WithContext(ctx).Model(&ExecutionWitness{}).Select(fields).Group(strings.Join(fields, ", ")).Limit(1000)
+
+	rows, err := query.Rows()
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	valueSets := make(map[string]map[interface{}]bool)
+	for _, field := range fields {
+		valueSets[field] = make(map[interface{}]bool)
+	}
+
+	var values []interface{}
+	for rows.Next() {
+		values = make([]interface{}, len(fields))
+		valuePtrs := make([]interface{}, len(fields))
+
+		for i := range values {
+			valuePtrs[i] = &values[i]
+		}
+
+		err := rows.Scan(valuePtrs...)
+		if err != nil {
+			i.metrics.ObserveOperationError(operation)
+
+			return nil, err
+		}
+
+		for i, field := range fields {
+			if !valueSets[field][values[i]] {
+				switch field {
+				case KeyNode:
+					results.Node = append(results.Node, values[i].(string))
+				case KeyBlockHash:
+					results.BlockHash = append(results.BlockHash, values[i].(string))
+				case KeyBlockNumber:
+					results.BlockNumber = append(results.BlockNumber, values[i].(int64))
+				case KeyLocation:
+					results.Location = append(results.Location, values[i].(string))
+				case KeyNetwork:
+					results.Network = append(results.Network, values[i].(string))
+				case KeyExecutionImplementation:
+					results.ExecutionImplementation = append(results.ExecutionImplementation, values[i].(string))
+				case KeyNodeVersion:
+					results.NodeVersion = append(results.NodeVersion, values[i].(string))
+				}
+
+				valueSets[field][values[i]] = true
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,282 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func generateRandomExecutionWitness() *ExecutionWitness {
+	return &ExecutionWitness{
+		ID:                      generateRandomString(10),
+		Node:                    generateRandomString(5),
+		FetchedAt:               time.Now(),
+		ExecutionImplementation: generateRandomString(15),
+		NodeVersion:             generateRandomString(8),
+		Location:                generateRandomString(10),
+		Network:                 generateRandomString(5),
+		BlockHash:               generateRandomString(64),
+		BlockNumber:             generateRandomInt64(),
+	}
+}
+
+func TestInsertExecutionWitness(t *testing.T) {
+	indexer, mock, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	witness := generateRandomExecutionWitness()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WithArgs(
+		sqlmock.AnyArg(), sqlmock.AnyArg(), nil, witness.ID, witness.Node, witness.FetchedAt, witness.ExecutionImplementation,
+		witness.NodeVersion, witness.Location, witness.Network, witness.BlockHash, witness.BlockNumber,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = indexer.InsertExecutionWitness(ctx, witness)
+	assert.NoError(t, err)
+}
+
+func TestRemoveExecutionWitness(t *testing.T) {
+	indexer, mock, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	id := testID
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM").WithArgs(id).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = indexer.DeleteExecutionWitness(ctx, id)
+	assert.NoError(t, err)
+}
+
+func TestCountExecutionWitness(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	witness := generateRandomExecutionWitness()
+
+	err = indexer.InsertExecutionWitness(ctx, witness)
+	assert.NoError(t, err)
+
+	filter := &ExecutionWitnessFilter{
+		ID: &witness.ID,
+	}
+
+	count, err := indexer.CountExecutionWitness(ctx, filter)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestListExecutionWitness(t *testing.T) {
+	indexer, mock, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	witness := generateRandomExecutionWitness()
+
+	err = indexer.InsertExecutionWitness(ctx, witness)
+	assert.NoError(t, err)
+
+	filter := &ExecutionWitnessFilter{
+		ID: &witness.ID,
+	}
+	page := &PaginationCursor{}
+
+	mock.ExpectQuery("SELECT \\* FROM").WithArgs(filter.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "node"}).AddRow("test-id", "test-node"))
+
+	witnesses, err := indexer.ListExecutionWitness(ctx, filter, page)
+	assert.NoError(t, err)
+	assert.Len(t, witnesses, 1)
+	assert.Equal(t, witness.ID, witnesses[0].ID)
+	assert.Equal(t, witness.Node, witnesses[0].Node)
+}
+
+//nolint:gocyclo // Test is long but manageable
+func TestExecutionWitnessFilters(t *testing.T) {
+	t.Run("By individual attributes", func(t *testing.T) {
+		indexer, _, err := NewMockIndexer()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		witness := generateRandomExecutionWitness()
+
+		err = indexer.InsertExecutionWitness(context.Background(), witness)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Test filters individually
+		testCases := []struct {
+			name   string
+			filter ExecutionWitnessFilter
+		}{
+			{"ID", ExecutionWitnessFilter{ID: &witness.ID}},
+			{"Node", ExecutionWitnessFilter{Node: &witness.Node}},
+			{"BlockHash", ExecutionWitnessFilter{BlockHash: &witness.BlockHash}},
+			{"BlockNumber", ExecutionWitnessFilter{BlockNumber: &witness.BlockNumber}},
+			{"NodeVersion", ExecutionWitnessFilter{NodeVersion: &witness.NodeVersion}},
+			{"Location", ExecutionWitnessFilter{Location: &witness.Location}},
+			{"Network", ExecutionWitnessFilter{Network: &witness.Network}},
+			{"ExecutionImplementation", ExecutionWitnessFilter{ExecutionImplementation: &witness.ExecutionImplementation}},
+		}
+
+		for _, tc := range testCases {
+			tcc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				witnesses, err := indexer.ListExecutionWitness(context.Background(), &tcc.filter, &PaginationCursor{})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if len(witnesses) != 1 {
+					t.Fatalf("expected 1 execution block witness, got %d", len(witnesses))
+				}
+
+				if witnesses[0].ID != witness.ID {
+					t.Fatalf("expected ID %s, got %s", witness.ID, witnesses[0].ID)
+				}
+			})
+		}
+	})
+	t.Run("By random combinations", func(t *testing.T) {
+		indexer, _, err := NewMockIndexer()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Add 10000 random execution block witnesses
+		for i := 0; i < 10000; i++ {
+			id := uuid.New().String()
+			node := fmt.Sprintf("node-%d", i)
+			blockHash := fmt.Sprintf("blockHash-%d", rand.Intn(100))
+			blockNumber := int64(rand.Intn(1000))
+			nodeVersion := fmt.Sprintf("version%d", rand.Intn(10))
+			location := fmt.Sprintf("location%d", rand.Intn(10))
+			network := fmt.Sprintf("network%d", rand.Intn(10))
+			executionImplementation := fmt.Sprintf("implementation%d", rand.Intn(10))
+
+			executionBlockWitness := &ExecutionWitness{
+				ID:                      id,
+				Node:                    node,
+				BlockHash:               blockHash,
+				BlockNumber:             blockNumber,
+				NodeVersion:             nodeVersion,
+				Location:                location,
+				Network:                 network,
+				ExecutionImplementation: executionImplementation,
+				FetchedAt:               time.Now(),
+			}
+
+			err = indexer.InsertExecutionWitness(context.Background(), executionBlockWitness)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// List execution block witnesses with random filters
+		for i := 0; i < 5000; i++ {
+			var filter ExecutionWitnessFilter
+
+			if rand.Intn(2) == 1 {
+				filter.AddID(uuid.New().String())
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddNode(fmt.Sprintf("node-%d", rand.Intn(100)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddBefore(time.Now())
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddAfter(time.Now().Add(-24 * time.Hour))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddBlockHash(fmt.Sprintf("blockHash-%d", rand.Intn(100)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddBlockNumber(int64(rand.Intn(1000)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddNodeVersion(fmt.Sprintf("version%d", rand.Intn(10)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddLocation(fmt.Sprintf("location%d", rand.Intn(10)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddNetwork(fmt.Sprintf("network%d", rand.Intn(10)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddExecutionImplementation(fmt.Sprintf("implementation%d", rand.Intn(10)))
+			}
+
+			executionBlockWitnesss, err := indexer.ListExecutionWitness(context.Background(), &filter, &PaginationCursor{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, executionBlockWitness := range executionBlockWitnesss {
+				if filter.ID != nil && *filter.ID != executionBlockWitness.ID {
+					t.Fatalf("expected ID %s, got %s", *filter.ID, executionBlockWitness.ID)
+				}
+
+				if filter.Node != nil && *filter.Node != executionBlockWitness.Node {
+					t.Fatalf("expected Node %s, got %s", *filter.Node, executionBlockWitness.Node)
+				}
+
+				if filter.Before != nil && executionBlockWitness.FetchedAt.After(*filter.Before) {
+					t.Fatalf("expected FetchedAt before %s, got %s", *filter.Before, executionBlockWitness.FetchedAt)
+				}
+
+				if filter.After != nil && executionBlockWitness.FetchedAt.Before(*filter.After) {
+					t.Fatalf("expected FetchedAt after %s, got %s", *filter.After, executionBlockWitness.FetchedAt)
+				}
+
+				if filter.BlockHash != nil && *filter.BlockHash != executionBlockWitness.BlockHash {
+					t.Fatalf("expected BlockHash %s, got %s", *filter.BlockHash, executionBlockWitness.BlockHash)
+				}
+
+				if filter.BlockNumber != nil && *filter.BlockNumber != executionBlockWitness.BlockNumber {
+					t.Fatalf("expected BlockNumber %d, got %d", *filter.BlockNumber, executionBlockWitness.BlockNumber)
+				}
+
+				if filter.NodeVersion != nil && *filter.NodeVersion != executionBlockWitness.NodeVersion {
+					t.Fatalf("expected NodeVersion %s, got %s", *filter.NodeVersion, executionBlockWitness.NodeVersion)
+				}
+
+				if filter.Location != nil && *filter.Location != executionBlockWitness.Location {
+					t.Fatalf("expected Location %s, got %s", *filter.Location, executionBlockWitness.Location)
+				}
+
+				if filter.Network != nil && *filter.Network != executionBlockWitness.Network {
+					t.Fatalf("expected Network %s, got %s", *filter.Network, executionBlockWitness.Network)
+				}
+
+				if filter.ExecutionImplementation != nil && *filter.ExecutionImplementation != executionBlockWitness.ExecutionImplementation {
+					t.Fatalf("expected ExecutionImplementation %s, got %s", *filter.ExecutionImplementation, executionBlockWitness.ExecutionImplementation)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,384 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ForkChoice struct {
+	gorm.Model
+	ID   string `gorm:"primaryKey"`
+	Node string `gorm:"index;index:idx_fork_choice_node_slot_network_fetchedat,where:deleted_at IS NULL,priority:1"`
+	// We have to use int64 here as SQLite doesn't support uint64. This sucks
+	// but slot 9223372036854775808 is probably around the heat death
+	// of the universe so we should be OK.
+	Slot  int64 `gorm:"index:idx_fork_choice_slot,where:deleted_at IS NULL;index;index:idx_fork_choice_node_slot_network_fetchedat,where:deleted_at IS NULL,priority:2"`
+	Epoch int64
+	// Reason is what caused the fork choice to be captured, e.g. chain_reorg.
+	Reason               string    `gorm:"not null;default:'';index"`
+	FetchedAt            time.Time `gorm:"index;index:idx_fork_choice_node_slot_network_fetchedat,where:deleted_at IS NULL,priority:4;index:idx_fork_choice_fetchedat,where:deleted_at IS NULL;index:idx_fork_choice_fetchedat_network,where:deleted_at IS NULL,priority:1"`
+	BeaconImplementation string
+	NodeVersion          string `gorm:"not null;default:''"`
+	ContentEncoding      string `gorm:"not null;default:''"`
+	Location             string `gorm:"not null;default:''"`
+	Network              string `gorm:"not null;default:'';index;index:idx_fork_choice_node_slot_network_fetchedat,where:deleted_at IS NULL,priority:3;index:idx_fork_choice_network,where:deleted_at IS NULL;index:idx_fork_choice_fetchedat_network,where:deleted_at IS NULL,priority:2"`
+}
+
+type ForkChoiceFilter struct {
+	ID                   *string
+	Node                 *string
+	Before               *time.Time
+	After                *time.Time
+	Slot                 *uint64
+	Epoch                *uint64
+	Reason               *string
+	NodeVersion          *string
+	Location             *string
+	Network              *string
+	BeaconImplementation *string
+}
+
+func (f *ForkChoiceFilter) AddID(id string) {
+	f.ID = &id
+}
+
+func (f *ForkChoiceFilter) AddNode(node string) {
+	f.Node = &node
+}
+
+func (f *ForkChoiceFilter) AddBefore(before time.Time) {
+	f.Before = &before
+}
+
+func (f *ForkChoiceFilter) AddAfter(after time.Time) {
+	f.After = &after
+}
+
+func (f *ForkChoiceFilter) AddSlot(slot uint64) {
+	f.Slot = &slot
+}
+
+func (f *ForkChoiceFilter) AddEpoch(epoch uint64) {
+	f.Epoch = &epoch
+}
+
+func (f *ForkChoiceFilter) AddReason(reason string) {
+	f.Reason = &reason
+}
+
+func (f *ForkChoiceFilter) AddNodeVersion(nodeVersion string) {
+	f.NodeVersion = &nodeVersion
+}
+
+func (f *ForkChoiceFilter) AddLocation(location string) {
+	f.Location = &location
+}
+
+func (f *ForkChoiceFilter) AddNetwork(network string) {
+	f.Network = &network
+}
+
+func (f *ForkChoiceFilter) AddBeaconImplementation(beaconImplementation string) {
+	f.BeaconImplementation = &beaconImplementation
+}
+
+func (f *ForkChoiceFilter) Validate() error {
+	if f.ID == nil &&
+		f.Node == nil &&
+		f.Before == nil &&
+		f.After == nil &&
+		f.Slot == nil &&
+		f.Epoch == nil &&
+		f.Reason == nil &&
+		f.NodeVersion == nil &&
+		f.Location == nil &&
+		f.BeaconImplementation == nil &&
+		f.Network == nil {
+		return errors.New("no filter specified")
+	}
+
+	return nil
+}
+
+func (f *ForkChoiceFilter) ApplyToQuery(query *gorm.DB) (*gorm.DB, error) {
+	if f.ID != nil {
+		query = query.Where("id = ?", f.ID)
+	}
+
+	if f.Node != nil {
+		query = query.Where("node = ?", f.Node)
+	}
+
+	if f.Before != nil {
+		query = query.Where("fetched_at <= ?", timestampFormatForDB(*f.Before))
+	}
+
+	if f.After != nil {
+		query = query.Where("fetched_at >= ?", timestampFormatForDB(*f.After))
+	}
+
+	if f.Slot != nil {
+		query = query.Where("slot = ?", f.Slot)
+	}
+
+	if f.Epoch != nil {
+		query = query.Where("epoch = ?", f.Epoch)
+	}
+
+	if f.Reason != nil {
+		query = query.Where("reason = ?", f.Reason)
+	}
+
+	if f.NodeVersion != nil {
+		query = query.Where("node_version = ?", f.NodeVersion)
+	}
+
+	if f.Location != nil {
+		query = query.Where("location = ?", f.Location)
+	}
+
+	if f.Network != nil {
+		query = query.Where("network = ?", f.Network)
+	}
+
+	if f.BeaconImplementation != nil {
+		query = query.Where("beacon_implementation = ?", f.BeaconImplementation)
+	}
+
+	return query, nil
+}
+
+func (i *Indexer) InsertForkChoice(ctx context.Context, block *ForkChoice) error {
+	operation := OperationInsertForkChoice
+	i.metrics.ObserveOperation(operation)
+
+	result := i.db.WithContext(ctx).Create(block)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+	}
+
+	return result.Error
+}
+
+func (i *Indexer) RemoveForkChoice(ctx context.Context, id string) error {
+	operation := OperationDeleteForkChoice
+
+	i.metrics.ObserveOperation(operation)
+
+	result := i.db.WithContext(ctx).Unscoped().Where("id = ?", id).Delete(&ForkChoice{})
+
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+	}
+
+	return result.Error
+}
+
+func (i *Indexer) CountForkChoice(ctx context.Context, filter *ForkChoiceFilter) (int64, error) {
+	operation := OperationCountForkChoice
+
+	i.metrics.ObserveOperation(operation)
+
+	var count int64
+
+	query := i.db.WithContext(ctx).Model(&ForkChoice{})
+
+	query, err := filter.ApplyToQuery(query)
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return 0, err
+	}
+
+	result := query.Count(&count)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return 0, result.Error
+	}
+
+	return count, nil
+}
+
+func (i *Indexer) ListForkChoice(ctx context.Context, filter *ForkChoiceFilter, page *PaginationCursor) ([]*ForkChoice, error) {
+	operation := OperationListForkChoice
+
+	i.metrics.ObserveOperation(operation)
+
+	var ForkChoices []*ForkChoice
+
+	query := i.db.WithContext(ctx).Model(&ForkChoice{})
+
+	if page != nil {
+		query = page.ApplyOffsetLimit(query)
+
+		query = page.ApplyOrderBy(query)
+	}
+
+	query, err := filter.ApplyToQuery(query)
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return nil, err
+	}
+
+	result := query.Find(&ForkChoices)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return nil, result.Error
+	}
+
+	return ForkChoices, nil
+}
+
+type DistinctForkChoiceValueResults struct {
+	Node                 []string
+	Slot                 []uint64
+	Epoch                []uint64
+	Reason               []string
+	NodeVersion          []string
+	Location             []string
+	Network              []string
+	BeaconImplementation []string
+}
+
+//nolint:errcheck // casting fine here.
+func (i *Indexer) DistinctForkChoiceValues(ctx context.Context, fields []string) (*DistinctForkChoiceValueResults, error) {
+	operation := OperationDistinctValues
+
+	i.metrics.ObserveOperation(operation)
+
+	results := &DistinctForkChoiceValueResults{
+		Node:                 make([]string, 0),
+		Slot:                 make([]uint64, 0),
+		Epoch:                make([]uint64, 0),
+		Reason:               make([]string, 0),
+		NodeVersion:          make([]string, 0),
+		Location:             make([]string, 0),
+		Network:              make([]string, 0),
+		BeaconImplementation: make([]string, 0),
+	}
+	query := i.db.WithContext(ctx).Model(&ForkChoice{}).Select(fields).Group(strings.Join(fields, ", ")).Limit(1000)
+
+	rows, err := query.Rows()
+	if err != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	valueSets := make(map[string]map[interface{}]bool)
+	for _, field := range fields {
+		valueSets[field] = make(map[interface{}]bool)
+	}
+
+	var values []interface{}
+	for rows.Next() {
+		values = make([]interface{}, len(fields))
+		valuePtrs := make([]interface{}, len(fields))
+
+		for i := range values {
+			valuePtrs[i] = &values[i]
+		}
+
+		err := rows.Scan(valuePtrs...)
+		if err != nil {
+			i.metrics.ObserveOperationError(operation)
+
+			return nil, err
+		}
+
+		for i, field := range fields {
+			if !valueSets[field][values[i]] {
+				switch field {
+				case KeyNode:
+					results.Node = append(results.Node, values[i].(string))
+				case KeySlot:
+					//nolint:gosec // not worried about int64 overflow here
+					results.Slot = append(results.Slot, uint64(values[i].(int64)))
+				case KeyEpoch:
+					//nolint:gosec // not worried about int64 overflow here
+					results.Epoch = append(results.Epoch, uint64(values[i].(int64)))
+				case KeyReason:
+					results.Reason = append(results.Reason, values[i].(string))
+				case KeyNodeVersion:
+					results.NodeVersion = append(results.NodeVersion, values[i].(string))
+				case KeyLocation:
+					results.Location = append(results.Location, values[i].(string))
+				case KeyNetwork:
+					results.Network = append(results.Network, values[i].(string))
+				case KeyBeaconImplementation:
+					results.BeaconImplementation = append(results.BeaconImplementation, values[i].(string))
+				}
+
+				valueSets[field][values[i]] = true
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (i *Indexer) DeleteForkChoice(ctx context.Context, id string) error {
+	operation := OperationDeleteForkChoice
+
+	i.metrics.ObserveOperation(operation)
+
+	query := i.db.WithContext(ctx)
+
+	result := query.Unscoped().Where("id = ?", id).Delete(&ForkChoice{})
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		i.metrics.ObserveOperationError(operation)
+
+		return errors.New("fork choice not found")
+	}
+
+	return nil
+}
+
+func (i *Indexer) UpdateForkChoice(ctx context.Context, block *ForkChoice) error {
+	operation := OperationUpdateForkChoice
+
+	i.metrics.ObserveOperation(operation)
+
+	query := i.db.WithContext(ctx)
+
+	result := query.Save(block)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		i.metrics.ObserveOperationError(operation)
+
+		return errors.New("fork choice not found")
+	}
+
+	if result.RowsAffected != 1 {
+		i.metrics.ObserveOperationError(operation)
+
+		return errors.New("fork choice update affected more than one row")
+	}
+
+	return nil
+}
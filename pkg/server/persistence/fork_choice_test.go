@@ -0,0 +1,310 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func generateRandomForkChoice() *ForkChoice {
+	return &ForkChoice{
+		ID:                   generateRandomString(10),
+		Node:                 generateRandomString(5),
+		Slot:                 generateRandomInt64(),
+		Epoch:                generateRandomInt64(),
+		Reason:               "chain_reorg",
+		FetchedAt:            time.Now(),
+		BeaconImplementation: generateRandomString(15),
+		NodeVersion:          generateRandomString(8),
+		Location:             generateRandomString(10),
+		Network:              generateRandomString(5),
+	}
+}
+
+func TestInsertForkChoice(t *testing.T) {
+	indexer, mock, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	forkChoice := generateRandomForkChoice()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WithArgs(
+		sqlmock.AnyArg(), sqlmock.AnyArg(), nil, forkChoice.ID, forkChoice.Node, forkChoice.Slot, forkChoice.Epoch,
+		forkChoice.FetchedAt, forkChoice.BeaconImplementation, forkChoice.NodeVersion,
+		forkChoice.Location, forkChoice.Network,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = indexer.InsertForkChoice(ctx, forkChoice)
+	assert.NoError(t, err)
+}
+
+func TestRemoveForkChoice(t *testing.T) {
+	indexer, mock, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	id := testID
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM").WithArgs(id).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = indexer.RemoveForkChoice(ctx, id)
+	assert.NoError(t, err)
+}
+
+func TestCountForkChoice(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	forkChoice := generateRandomForkChoice()
+
+	err = indexer.InsertForkChoice(ctx, forkChoice)
+	assert.NoError(t, err)
+
+	filter := &ForkChoiceFilter{
+		ID: &forkChoice.ID,
+	}
+
+	count, err := indexer.CountForkChoice(ctx, filter)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestListForkChoice(t *testing.T) {
+	indexer, mock, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	forkChoice := generateRandomForkChoice()
+
+	err = indexer.InsertForkChoice(ctx, forkChoice)
+	assert.NoError(t, err)
+
+	filter := &ForkChoiceFilter{
+		ID: &forkChoice.ID,
+	}
+	page := &PaginationCursor{}
+
+	mock.ExpectQuery("SELECT \\* FROM").WithArgs(filter.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "node"}).AddRow(testID, "test-node"))
+
+	forkChoices, err := indexer.ListForkChoice(ctx, filter, page)
+	assert.NoError(t, err)
+	assert.Len(t, forkChoices, 1)
+	assert.Equal(t, forkChoice.ID, forkChoices[0].ID)
+	assert.Equal(t, forkChoice.Node, forkChoices[0].Node)
+}
+
+func TestUpdateForkChoice(t *testing.T) {
+	indexer, mock, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	forkChoice := generateRandomForkChoice()
+
+	err = indexer.InsertForkChoice(ctx, forkChoice)
+	assert.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE").WithArgs(
+		forkChoice.ID, forkChoice.Node, forkChoice.Slot, forkChoice.Epoch, forkChoice.FetchedAt,
+		forkChoice.BeaconImplementation, forkChoice.NodeVersion, forkChoice.Location, forkChoice.Network,
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = indexer.UpdateForkChoice(ctx, forkChoice)
+	assert.NoError(t, err)
+}
+
+//nolint:gocyclo // Test is long but manageable
+func TestForkChoiceFilters(t *testing.T) {
+	t.Run("By random combinations", func(t *testing.T) {
+		indexer, _, err := NewMockIndexer()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Add 10000 random fork choices
+		for i := 0; i < 10000; i++ {
+			id := uuid.New().String()
+			node := fmt.Sprintf("node-%d", i)
+			slot := int64(rand.Intn(1000))
+			epoch := int64(rand.Intn(100))
+			nodeVersion := fmt.Sprintf("version%d", rand.Intn(10))
+			location := fmt.Sprintf("location%d", rand.Intn(10))
+			network := fmt.Sprintf("network%d", rand.Intn(10))
+			beaconImplementation := fmt.Sprintf("implementation%d", rand.Intn(10))
+
+			forkChoice := &ForkChoice{
+				ID:                   id,
+				Node:                 node,
+				Slot:                 slot,
+				Epoch:                epoch,
+				FetchedAt:            time.Now(),
+				NodeVersion:          nodeVersion,
+				Location:             location,
+				Network:              network,
+				BeaconImplementation: beaconImplementation,
+			}
+
+			err = indexer.InsertForkChoice(context.Background(), forkChoice)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// List fork choices with random filters
+		for i := 0; i < 5000; i++ {
+			var filter ForkChoiceFilter
+
+			if rand.Intn(2) == 1 {
+				filter.AddID(uuid.New().String())
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddNode(fmt.Sprintf("node-%d", rand.Intn(100)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddBefore(time.Now())
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddAfter(time.Now().Add(-24 * time.Hour))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddSlot(uint64(rand.Intn(1000)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddEpoch(uint64(rand.Intn(100)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddNodeVersion(fmt.Sprintf("version%d", rand.Intn(10)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddLocation(fmt.Sprintf("location%d", rand.Intn(10)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddNetwork(fmt.Sprintf("network%d", rand.Intn(10)))
+			}
+
+			if rand.Intn(2) == 1 {
+				filter.AddBeaconImplementation(fmt.Sprintf("implementation%d", rand.Intn(10)))
+			}
+
+			forkChoices, err := indexer.ListForkChoice(context.Background(), &filter, &PaginationCursor{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, forkChoice := range forkChoices {
+				if filter.ID != nil && *filter.ID != forkChoice.ID {
+					t.Fatalf("expected ID %s, got %s", *filter.ID, forkChoice.ID)
+				}
+
+				if filter.Node != nil && *filter.Node != forkChoice.Node {
+					t.Fatalf("expected Node %s, got %s", *filter.Node, forkChoice.Node)
+				}
+
+				if filter.Before != nil && forkChoice.FetchedAt.After(*filter.Before) {
+					t.Fatalf("expected FetchedAt before %s, got %s", *filter.Before, forkChoice.FetchedAt)
+				}
+
+				if filter.After != nil && forkChoice.FetchedAt.Before(*filter.After) {
+					t.Fatalf("expected FetchedAt after %s, got %s", *filter.After, forkChoice.FetchedAt)
+				}
+
+				if filter.Slot != nil && *filter.Slot != uint64(forkChoice.Slot) {
+					t.Fatalf("expected Slot %d, got %d", *filter.Slot, forkChoice.Slot)
+				}
+
+				if filter.Epoch != nil && *filter.Epoch != uint64(forkChoice.Epoch) {
+					t.Fatalf("expected Epoch %d, got %d", *filter.Epoch, forkChoice.Epoch)
+				}
+
+				if filter.NodeVersion != nil && *filter.NodeVersion != forkChoice.NodeVersion {
+					t.Fatalf("expected NodeVersion %s, got %s", *filter.NodeVersion, forkChoice.NodeVersion)
+				}
+
+				if filter.Location != nil && *filter.Location != forkChoice.Location {
+					t.Fatalf("expected Location %s, got %s", *filter.Location, forkChoice.Location)
+				}
+
+				if filter.Network != nil && *filter.Network != forkChoice.Network {
+					t.Fatalf("expected Network %s, got %s", *filter.Network, forkChoice.Network)
+				}
+
+				if filter.BeaconImplementation != nil && *filter.BeaconImplementation != forkChoice.BeaconImplementation {
+					t.Fatalf("expected BeaconImplementation %s, got %s", *filter.BeaconImplementation, forkChoice.BeaconImplementation)
+				}
+			}
+		}
+	})
+}
+
+func TestForkChoiceIndividualFilters(t *testing.T) {
+	t.Run("By individual attributes", func(t *testing.T) {
+		indexer, _, err := NewMockIndexer()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		forkChoice := generateRandomForkChoice()
+
+		err = indexer.InsertForkChoice(context.Background(), forkChoice)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		slot := uint64(forkChoice.Slot)
+		epoch := uint64(forkChoice.Epoch)
+
+		// Test filters individually
+		testCases := []struct {
+			name   string
+			filter ForkChoiceFilter
+		}{
+			{"ID", ForkChoiceFilter{ID: &forkChoice.ID}},
+			{"Node", ForkChoiceFilter{Node: &forkChoice.Node}},
+			{"Slot", ForkChoiceFilter{Slot: &slot}},
+			{"Epoch", ForkChoiceFilter{Epoch: &epoch}},
+			{"Reason", ForkChoiceFilter{Reason: &forkChoice.Reason}},
+			{"NodeVersion", ForkChoiceFilter{NodeVersion: &forkChoice.NodeVersion}},
+			{"Location", ForkChoiceFilter{Location: &forkChoice.Location}},
+			{"Network", ForkChoiceFilter{Network: &forkChoice.Network}},
+			{"BeaconImplementation", ForkChoiceFilter{BeaconImplementation: &forkChoice.BeaconImplementation}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				forkChoices, err := indexer.ListForkChoice(context.Background(), &tc.filter, &PaginationCursor{})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if len(forkChoices) != 1 {
+					t.Fatalf("expected 1 fork choice, got %d", len(forkChoices))
+				}
+
+				if forkChoices[0].ID != forkChoice.ID {
+					t.Fatalf("expected ID %s, got %s", forkChoice.ID, forkChoices[0].ID)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package persistence
+
+import (
+	"context"
+	"time"
+)
+
+// IndexedKey identifies an artifact a node has indexed. Root is the state
+// root, block root or block hash of the artifact and Variant is its sidecar
+// type or tracer, if it has one.
+type IndexedKey struct {
+	Root    string
+	Variant string
+}
+
+// ListBeaconStateKeys returns the state roots of the beacon states a node indexed since the given time.
+func (i *Indexer) ListBeaconStateKeys(ctx context.Context, node, network string, since time.Time, limit int) ([]IndexedKey, error) {
+	return i.listIndexedKeys(ctx, OperationListBeaconStateKeys, &BeaconState{}, "state_root", "", node, network, since, limit)
+}
+
+// ListBeaconBlockKeys returns the block roots of the beacon blocks a node indexed since the given time.
+func (i *Indexer) ListBeaconBlockKeys(ctx context.Context, node, network string, since time.Time, limit int) ([]IndexedKey, error) {
+	return i.listIndexedKeys(ctx, OperationListBeaconBlockKeys, &BeaconBlock{}, "block_root", "", node, network, since, limit)
+}
+
+// ListBlobSidecarKeys returns the block roots and sidecar types of the sidecars a node indexed since the given time.
+func (i *Indexer) ListBlobSidecarKeys(ctx context.Context, node, network string, since time.Time, limit int) ([]IndexedKey, error) {
+	return i.listIndexedKeys(ctx, OperationListBlobSidecarKeys, &BlobSidecar{}, "block_root", "sidecar_type", node, network, since, limit)
+}
+
+// ListExecutionBlockTraceKeys returns the block hashes and tracers of the execution block traces a node indexed since the given time.
+func (i *Indexer) ListExecutionBlockTraceKeys(ctx context.Context, node, network string, since time.Time, limit int) ([]IndexedKey, error) {
+	return i.listIndexedKeys(ctx, OperationListExecutionBlockTraceKeys, &ExecutionBlockTrace{}, "block_hash", "tracer", node, network, since, limit)
+}
+
+// ListExecutionWitnessKeys returns the block hashes of the execution witnesses a node indexed since the given time.
+func (i *Indexer) ListExecutionWitnessKeys(ctx context.Context, node, network string, since time.Time, limit int) ([]IndexedKey, error) {
+	return i.listIndexedKeys(ctx, OperationListExecutionWitnessKeys, &ExecutionWitness{}, "block_hash", "", node, network, since, limit)
+}
+
+// listIndexedKeys selects only the key columns of a node's most recently
+// fetched artifacts so the result stays small.
+func (i *Indexer) listIndexedKeys(ctx context.Context, operation Operation, model any, rootColumn, variantColumn, node, network string, since time.Time, limit int) ([]IndexedKey, error) {
+	i.metrics.ObserveOperation(operation)
+
+	columns := rootColumn + " AS root"
+	if variantColumn != "" {
+		columns += ", " + variantColumn + " AS variant"
+	}
+
+	var keys []IndexedKey
+
+	result := i.db.WithContext(ctx).
+		Model(model).
+		Select(columns).
+		Where("node = ? AND network = ? AND fetched_at >= ?", node, network, timestampFormatForDB(since)).
+		Order("fetched_at DESC").
+		Limit(limit).
+		Scan(&keys)
+	if result.Error != nil {
+		i.metrics.ObserveOperationError(operation)
+
+		return nil, result.Error
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListBeaconBlockKeys(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	recent := generateRandomBeaconBlock()
+
+	old := generateRandomBeaconBlock()
+	old.Node = recent.Node
+	old.Network = recent.Network
+	old.FetchedAt = time.Now().Add(-2 * time.Hour)
+
+	other := generateRandomBeaconBlock()
+	other.Network = recent.Network
+
+	assert.NoError(t, indexer.InsertBeaconBlock(ctx, recent))
+	assert.NoError(t, indexer.InsertBeaconBlock(ctx, old))
+	assert.NoError(t, indexer.InsertBeaconBlock(ctx, other))
+
+	// Only the node's own blocks fetched since the given time are returned.
+	keys, err := indexer.ListBeaconBlockKeys(ctx, recent.Node, recent.Network, time.Now().Add(-time.Hour), 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []IndexedKey{{Root: recent.BlockRoot}}, keys)
+}
+
+func TestListExecutionBlockTraceKeys(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	first := generateRandomExecutionBlockTrace()
+	first.FetchedAt = time.Now().Add(-time.Minute)
+
+	second := generateRandomExecutionBlockTrace()
+	second.Node = first.Node
+	second.Network = first.Network
+
+	assert.NoError(t, indexer.InsertExecutionBlockTrace(ctx, first))
+	assert.NoError(t, indexer.InsertExecutionBlockTrace(ctx, second))
+
+	keys, err := indexer.ListExecutionBlockTraceKeys(ctx, first.Node, first.Network, time.Now().Add(-time.Hour), 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []IndexedKey{
+		{Root: second.BlockHash, Variant: second.Tracer},
+		{Root: first.BlockHash, Variant: first.Tracer},
+	}, keys)
+
+	// The most recently fetched keys are kept when limited.
+	keys, err = indexer.ListExecutionBlockTraceKeys(ctx, first.Node, first.Network, time.Now().Add(-time.Hour), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []IndexedKey{{Root: second.BlockHash, Variant: second.Tracer}}, keys)
+}
@@ -5,17 +5,40 @@ type Operation string
 const (
 	OperationDistinctValues Operation = "distinct_values"
 
-	OperationInsertBeaconState Operation = "insert_beacon_state_metadata"
-	OperationDeleteBeaconState Operation = "delete_beacon_state_metadata"
-	OperationCountBeaconState  Operation = "count_beacon_state_metadata"
-	OperationListBeaconState   Operation = "list_beacon_state_metadata"
-	OperationUpdateBeaconState Operation = "update_beacon_state_metadata"
-
-	OperationInsertBeaconBlock Operation = "insert_beacon_block_metadata"
-	OperationDeleteBeaconBlock Operation = "delete_beacon_block_metadata"
-	OperationCountBeaconBlock  Operation = "count_beacon_block_metadata"
-	OperationListBeaconBlock   Operation = "list_beacon_block_metadata"
-	OperationUpdateBeaconBlock Operation = "update_beacon_block_metadata"
+	OperationInsertBeaconState        Operation = "insert_beacon_state_metadata"
+	OperationDeleteBeaconState        Operation = "delete_beacon_state_metadata"
+	OperationCountBeaconState         Operation = "count_beacon_state_metadata"
+	OperationListBeaconState          Operation = "list_beacon_state_metadata"
+	OperationUpdateBeaconState        Operation = "update_beacon_state_metadata"
+	OperationMarkBeaconStatesOrphaned Operation = "mark_beacon_states_orphaned"
+	OperationListBeaconStateKeys      Operation = "list_beacon_state_keys"
+
+	OperationInsertBeaconStateDivergence          Operation = "insert_beacon_state_divergence"
+	OperationDeleteBeaconStateDivergence          Operation = "delete_beacon_state_divergence"
+	OperationCountBeaconStateDivergence           Operation = "count_beacon_state_divergence"
+	OperationListBeaconStateDivergence            Operation = "list_beacon_state_divergence"
+	OperationDeleteOrphanedBeaconStateDivergences Operation = "delete_orphaned_beacon_state_divergences"
+
+	OperationInsertBeaconBlock        Operation = "insert_beacon_block_metadata"
+	OperationDeleteBeaconBlock        Operation = "delete_beacon_block_metadata"
+	OperationCountBeaconBlock         Operation = "count_beacon_block_metadata"
+	OperationListBeaconBlock          Operation = "list_beacon_block_metadata"
+	OperationUpdateBeaconBlock        Operation = "update_beacon_block_metadata"
+	OperationMarkBeaconBlocksOrphaned Operation = "mark_beacon_blocks_orphaned"
+	OperationListBeaconBlockKeys      Operation = "list_beacon_block_keys"
+
+	OperationInsertBlobSidecar   Operation = "insert_blob_sidecar_metadata"
+	OperationDeleteBlobSidecar   Operation = "delete_blob_sidecar_metadata"
+	OperationCountBlobSidecar    Operation = "count_blob_sidecar_metadata"
+	OperationListBlobSidecar     Operation = "list_blob_sidecar_metadata"
+	OperationUpdateBlobSidecar   Operation = "update_blob_sidecar_metadata"
+	OperationListBlobSidecarKeys Operation = "list_blob_sidecar_keys"
+
+	OperationInsertForkChoice Operation = "insert_fork_choice_metadata"
+	OperationDeleteForkChoice Operation = "delete_fork_choice_metadata"
+	OperationCountForkChoice  Operation = "count_fork_choice_metadata"
+	OperationListForkChoice   Operation = "list_fork_choice_metadata"
+	OperationUpdateForkChoice Operation = "update_fork_choice_metadata"
 
 	OperationInsertBeaconBadBlock Operation = "insert_beacon_bad_block_metadata"
 	OperationDeleteBeaconBadBlock Operation = "delete_beacon_bad_block_metadata"
@@ -29,11 +52,19 @@ const (
 	OperationListBeaconBadBlob   Operation = "list_beacon_bad_blob_metadata"
 	OperationUpdateBeaconBadBlob Operation = "update_beacon_bad_blob_metadata"
 
-	OperationInsertExecutionBlockTrace Operation = "insert_execution_block_trace"
-	OperationDeleteExecutionBlockTrace Operation = "delete_execution_block_trace"
-	OperationCountExecutionBlockTrace  Operation = "count_execution_block_trace"
-	OperationListExecutionBlockTrace   Operation = "list_execution_block_trace"
-	OperationUpdateExecutionBlockTrace Operation = "update_execution_block_trace"
+	OperationInsertExecutionBlockTrace        Operation = "insert_execution_block_trace"
+	OperationDeleteExecutionBlockTrace        Operation = "delete_execution_block_trace"
+	OperationCountExecutionBlockTrace         Operation = "count_execution_block_trace"
+	OperationListExecutionBlockTrace          Operation = "list_execution_block_trace"
+	OperationUpdateExecutionBlockTrace        Operation = "update_execution_block_trace"
+	OperationMarkExecutionBlockTracesOrphaned Operation = "mark_execution_block_traces_orphaned"
+	OperationListExecutionBlockTraceKeys      Operation = "list_execution_block_trace_keys"
+
+	OperationInsertExecutionWitness   Operation = "insert_execution_witness"
+	OperationDeleteExecutionWitness   Operation = "delete_execution_witness"
+	OperationCountExecutionWitness    Operation = "count_execution_witness"
+	OperationListExecutionWitness     Operation = "list_execution_witness"
+	OperationListExecutionWitnessKeys Operation = "list_execution_witness_keys"
 
 	OperationInsertExecutionBadBlock Operation = "insert_execution_bad_block"
 	OperationDeleteExecutionBadBlock Operation = "delete_execution_bad_block"
@@ -0,0 +1,71 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StoredObjectLock is locked while the references to an object in the store
+// change. Content addressed objects are shared by the entries of every node,
+// so an entry can't be added while retention deletes the object's last one.
+type StoredObjectLock struct {
+	Location  string `gorm:"primaryKey"`
+	UpdatedAt time.Time
+}
+
+// lockStoredObject locks the object at location until tx ends. Writing the
+// lock's row blocks other transactions that lock the same object.
+func lockStoredObject(tx *gorm.DB, location string) error {
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "location"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at"}),
+	}).Create(&StoredObjectLock{Location: location, UpdatedAt: time.Now()}).Error
+}
+
+// insertObjectReference inserts an entry that references the object at
+// location once checkObject confirms the object is stored.
+func (i *Indexer) insertObjectReference(ctx context.Context, location string, entry any, checkObject func() error) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockStoredObject(tx, location); err != nil {
+			return err
+		}
+
+		if err := checkObject(); err != nil {
+			return err
+		}
+
+		return tx.Create(entry).Error
+	})
+}
+
+// deleteObjectReference deletes the entry of model with id. The object at
+// location is deleted first with deleteObject if no other entry references
+// it, and the entry is kept if that fails.
+func (i *Indexer) deleteObjectReference(ctx context.Context, model any, id, location string, deleteObject func() error) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockStoredObject(tx, location); err != nil {
+			return err
+		}
+
+		var references int64
+
+		if err := tx.Model(model).Where("location = ? AND id <> ?", location, id).Count(&references).Error; err != nil {
+			return err
+		}
+
+		if references == 0 {
+			if err := deleteObject(); err != nil {
+				return err
+			}
+
+			if err := tx.Where("location = ?", location).Delete(&StoredObjectLock{}).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Unscoped().Where("id = ?", id).Delete(model).Error
+	})
+}
@@ -0,0 +1,113 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsertBeaconStateReference_ObjectMissing(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	state := generateRandomBeaconState()
+	errMissing := errors.New("missing")
+
+	err = indexer.InsertBeaconStateReference(ctx, state, func() error {
+		return errMissing
+	})
+	assert.ErrorIs(t, err, errMissing)
+
+	count, err := indexer.CountBeaconState(ctx, &BeaconStateFilter{ID: &state.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestDeleteBeaconStateReference(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	stored := func() error { return nil }
+
+	// Two nodes reference the same shared object.
+	first := generateRandomBeaconState()
+	second := generateRandomBeaconState()
+	second.Location = first.Location
+
+	assert.NoError(t, indexer.InsertBeaconStateReference(ctx, first, stored))
+	assert.NoError(t, indexer.InsertBeaconStateReference(ctx, second, stored))
+
+	deleted := 0
+	deleteObject := func() error {
+		deleted++
+
+		return nil
+	}
+
+	// The object is kept while the second state references it.
+	assert.NoError(t, indexer.DeleteBeaconStateReference(ctx, first, deleteObject))
+	assert.Equal(t, 0, deleted)
+
+	count, err := indexer.CountBeaconState(ctx, &BeaconStateFilter{Location: &first.Location})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// The state is kept if its object can't be deleted, so it's retried.
+	errDelete := errors.New("delete failed")
+
+	err = indexer.DeleteBeaconStateReference(ctx, second, func() error { return errDelete })
+	assert.ErrorIs(t, err, errDelete)
+
+	count, err = indexer.CountBeaconState(ctx, &BeaconStateFilter{ID: &second.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// The object is deleted along with its last reference.
+	assert.NoError(t, indexer.DeleteBeaconStateReference(ctx, second, deleteObject))
+	assert.Equal(t, 1, deleted)
+
+	count, err = indexer.CountBeaconState(ctx, &BeaconStateFilter{Location: &first.Location})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestDeleteBeaconBlockReference(t *testing.T) {
+	indexer, _, err := NewMockIndexer()
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	stored := func() error { return nil }
+
+	first := generateRandomBeaconBlock()
+	second := generateRandomBeaconBlock()
+	second.Location = first.Location
+
+	assert.NoError(t, indexer.InsertBeaconBlockReference(ctx, first, stored))
+	assert.NoError(t, indexer.InsertBeaconBlockReference(ctx, second, stored))
+
+	deleted := 0
+	deleteObject := func() error {
+		deleted++
+
+		return nil
+	}
+
+	assert.NoError(t, indexer.DeleteBeaconBlockReference(ctx, first, deleteObject))
+	assert.Equal(t, 0, deleted)
+
+	assert.NoError(t, indexer.DeleteBeaconBlockReference(ctx, second, deleteObject))
+	assert.Equal(t, 1, deleted)
+
+	// A block indexed after the object was deleted only sees it's missing.
+	third := generateRandomBeaconBlock()
+	third.Location = first.Location
+
+	errMissing := errors.New("missing")
+
+	err = indexer.InsertBeaconBlockReference(ctx, third, func() error { return errMissing })
+	assert.ErrorIs(t, err, errMissing)
+}
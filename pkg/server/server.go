@@ -305,6 +305,14 @@ func (x *Server) startGrpcGateway(ctx context.Context) error {
 		return fmt.Errorf("failed to start object downloader: %v", err)
 	}
 
+	if len(x.config.Capture.Agents) > 0 {
+		forwarder := NewCaptureForwarder(x.log, &x.config.Capture, mux)
+
+		if err := forwarder.Start(); err != nil {
+			return fmt.Errorf("failed to start capture forwarder: %v", err)
+		}
+	}
+
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
 	err := gw.RegisterAPIHandlerFromEndpoint(ctx, mux, x.config.Addr, opts)
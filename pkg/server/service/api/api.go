@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ethpandaops/tracoor/pkg/compression"
 	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/api"
 	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
 	"github.com/ethpandaops/tracoor/pkg/store"
@@ -27,16 +28,19 @@ type API struct {
 
 	indexer indexer.IndexerClient
 
+	compressor *compression.Compressor
+
 	grpcConn string
 	grpcOpts []grpc.DialOption
 }
 
 func NewAPI(ctx context.Context, log logrus.FieldLogger, conf *Config, st store.Store, grpcConn string, grpcOpts []grpc.DialOption) (*API, error) {
 	e := &API{
-		log:      log.WithField("server/module", ServiceType),
-		grpcConn: grpcConn,
-		grpcOpts: grpcOpts,
-		store:    st,
+		log:        log.WithField("server/module", ServiceType),
+		grpcConn:   grpcConn,
+		grpcOpts:   grpcOpts,
+		store:      st,
+		compressor: compression.NewCompressor(),
 	}
 
 	return e, nil
@@ -126,6 +130,9 @@ func (i *API) ListBeaconState(ctx context.Context, req *api.ListBeaconStateReque
 		Before:               req.Before,
 		After:                req.After,
 		BeaconImplementation: req.BeaconImplementation,
+		Finalized:            req.Finalized,
+		CheckpointEpoch:      req.CheckpointEpoch,
+		Canonical:            req.Canonical,
 
 		Pagination: pagination,
 	}
@@ -147,6 +154,9 @@ func (i *API) ListBeaconState(ctx context.Context, req *api.ListBeaconStateReque
 			Network:              state.Network,
 			FetchedAt:            state.FetchedAt,
 			BeaconImplementation: state.BeaconImplementation,
+			Finalized:            state.Finalized,
+			CheckpointEpoch:      state.CheckpointEpoch,
+			Canonical:            state.Canonical,
 		}
 	}
 
@@ -166,6 +176,9 @@ func (i *API) CountBeaconState(ctx context.Context, req *api.CountBeaconStateReq
 		BeaconImplementation: req.BeaconImplementation,
 		Before:               req.Before,
 		After:                req.After,
+		Finalized:            req.Finalized,
+		CheckpointEpoch:      req.CheckpointEpoch,
+		Canonical:            req.Canonical,
 	}
 
 	resp, err := i.indexer.CountBeaconState(ctx, rq)
@@ -233,6 +246,69 @@ func (i *API) ListUniqueBeaconStateValues(ctx context.Context, req *api.ListUniq
 	return response, nil
 }
 
+func (i *API) ListStateDivergences(ctx context.Context, req *api.ListStateDivergencesRequest) (*api.ListStateDivergencesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid request: %w", err).Error())
+	}
+
+	pagination := &indexer.PaginationCursor{
+		Limit:   100,
+		Offset:  0,
+		OrderBy: "detected_at DESC",
+	}
+
+	if req.Pagination != nil {
+		pagination = &indexer.PaginationCursor{
+			Limit:   req.Pagination.Limit,
+			Offset:  req.Pagination.Offset,
+			OrderBy: req.Pagination.OrderBy,
+		}
+	}
+
+	rq := &indexer.ListStateDivergencesRequest{
+		Id:                   req.Id,
+		Network:              req.Network,
+		Slot:                 req.Slot,
+		Epoch:                req.Epoch,
+		Node:                 req.Node,
+		BeaconImplementation: req.BeaconImplementation,
+		Before:               req.Before,
+		After:                req.After,
+
+		Pagination: pagination,
+	}
+
+	resp, err := i.indexer.ListStateDivergences(ctx, rq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to list state divergences: %w", err).Error())
+	}
+
+	protoDivergences := make([]*api.StateDivergence, len(resp.StateDivergences))
+	for i, divergence := range resp.StateDivergences {
+		protoDivergences[i] = &api.StateDivergence{
+			Id:                              divergence.Id,
+			Network:                         divergence.Network,
+			Slot:                            divergence.Slot,
+			Epoch:                           divergence.Epoch,
+			DetectedAt:                      divergence.DetectedAt,
+			BeaconStateId:                   divergence.BeaconStateId,
+			Node:                            divergence.Node,
+			StateRoot:                       divergence.StateRoot,
+			BeaconImplementation:            divergence.BeaconImplementation,
+			NodeVersion:                     divergence.NodeVersion,
+			ConflictingBeaconStateId:        divergence.ConflictingBeaconStateId,
+			ConflictingNode:                 divergence.ConflictingNode,
+			ConflictingStateRoot:            divergence.ConflictingStateRoot,
+			ConflictingBeaconImplementation: divergence.ConflictingBeaconImplementation,
+			ConflictingNodeVersion:          divergence.ConflictingNodeVersion,
+		}
+	}
+
+	return &api.ListStateDivergencesResponse{
+		StateDivergences: protoDivergences,
+	}, nil
+}
+
 func (i *API) ListBeaconBlock(ctx context.Context, req *api.ListBeaconBlockRequest) (*api.ListBeaconBlockResponse, error) {
 	pagination := &indexer.PaginationCursor{
 		Limit:   100,
@@ -259,6 +335,7 @@ func (i *API) ListBeaconBlock(ctx context.Context, req *api.ListBeaconBlockReque
 		Before:               req.Before,
 		After:                req.After,
 		BeaconImplementation: req.BeaconImplementation,
+		Canonical:            req.Canonical,
 
 		Pagination: pagination,
 	}
@@ -280,6 +357,7 @@ func (i *API) ListBeaconBlock(ctx context.Context, req *api.ListBeaconBlockReque
 			Network:              block.Network,
 			FetchedAt:            block.FetchedAt,
 			BeaconImplementation: block.BeaconImplementation,
+			Canonical:            block.Canonical,
 		}
 	}
 
@@ -299,6 +377,7 @@ func (i *API) CountBeaconBlock(ctx context.Context, req *api.CountBeaconBlockReq
 		BeaconImplementation: req.BeaconImplementation,
 		Before:               req.Before,
 		After:                req.After,
+		Canonical:            req.Canonical,
 	}
 
 	resp, err := i.indexer.CountBeaconBlock(ctx, rq)
@@ -366,6 +445,278 @@ func (i *API) ListUniqueBeaconBlockValues(ctx context.Context, req *api.ListUniq
 	return response, nil
 }
 
+func (i *API) ListBlobSidecar(ctx context.Context, req *api.ListBlobSidecarRequest) (*api.ListBlobSidecarResponse, error) {
+	pagination := &indexer.PaginationCursor{
+		Limit:   100,
+		Offset:  0,
+		OrderBy: "fetched_at DESC",
+	}
+
+	if req.Pagination != nil {
+		pagination = &indexer.PaginationCursor{
+			Limit:   req.Pagination.Limit,
+			Offset:  req.Pagination.Offset,
+			OrderBy: req.Pagination.OrderBy,
+		}
+	}
+
+	rq := &indexer.ListBlobSidecarRequest{
+		Id:                   req.Id,
+		Node:                 req.Node,
+		Slot:                 req.Slot,
+		Epoch:                req.Epoch,
+		BlockRoot:            req.BlockRoot,
+		NodeVersion:          req.NodeVersion,
+		Network:              req.Network,
+		Before:               req.Before,
+		After:                req.After,
+		BeaconImplementation: req.BeaconImplementation,
+		SidecarType:          req.SidecarType,
+
+		Pagination: pagination,
+	}
+
+	resp, err := i.indexer.ListBlobSidecar(ctx, rq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to list blob sidecars: %w", err).Error())
+	}
+
+	protoBlobSidecars := make([]*api.BlobSidecar, len(resp.BlobSidecars))
+	for i, sidecar := range resp.BlobSidecars {
+		protoBlobSidecars[i] = &api.BlobSidecar{
+			Id:                   sidecar.Id,
+			Node:                 sidecar.Node,
+			Slot:                 sidecar.Slot,
+			Epoch:                sidecar.Epoch,
+			BlockRoot:            sidecar.BlockRoot,
+			NodeVersion:          sidecar.NodeVersion,
+			Network:              sidecar.Network,
+			FetchedAt:            sidecar.FetchedAt,
+			BeaconImplementation: sidecar.BeaconImplementation,
+			SidecarType:          sidecar.SidecarType,
+		}
+	}
+
+	return &api.ListBlobSidecarResponse{
+		BlobSidecars: protoBlobSidecars,
+	}, nil
+}
+
+func (i *API) CountBlobSidecar(ctx context.Context, req *api.CountBlobSidecarRequest) (*api.CountBlobSidecarResponse, error) {
+	rq := &indexer.CountBlobSidecarRequest{
+		Node:                 req.Node,
+		Slot:                 req.Slot,
+		Epoch:                req.Epoch,
+		BlockRoot:            req.BlockRoot,
+		NodeVersion:          req.NodeVersion,
+		Network:              req.Network,
+		BeaconImplementation: req.BeaconImplementation,
+		Before:               req.Before,
+		After:                req.After,
+		SidecarType:          req.SidecarType,
+	}
+
+	resp, err := i.indexer.CountBlobSidecar(ctx, rq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to count blob sidecars: %w", err).Error())
+	}
+
+	return &api.CountBlobSidecarResponse{
+		Count: wrapperspb.UInt64(resp.GetCount().GetValue()),
+	}, nil
+}
+
+func (i *API) ListUniqueBlobSidecarValues(ctx context.Context, req *api.ListUniqueBlobSidecarValuesRequest) (*api.ListUniqueBlobSidecarValuesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid request: %w", err).Error())
+	}
+
+	// Create our "indexer" equivalent structs
+	rq := indexer.ListUniqueBlobSidecarValuesRequest{
+		Fields: []indexer.ListUniqueBlobSidecarValuesRequest_Field{},
+	}
+
+	for _, field := range req.Fields {
+		var f indexer.ListUniqueBlobSidecarValuesRequest_Field
+
+		switch field {
+		case api.ListUniqueBlobSidecarValuesRequest_node:
+			f = indexer.ListUniqueBlobSidecarValuesRequest_NODE
+		case api.ListUniqueBlobSidecarValuesRequest_node_version:
+			f = indexer.ListUniqueBlobSidecarValuesRequest_NODE_VERSION
+		case api.ListUniqueBlobSidecarValuesRequest_network:
+			f = indexer.ListUniqueBlobSidecarValuesRequest_NETWORK
+		case api.ListUniqueBlobSidecarValuesRequest_slot:
+			f = indexer.ListUniqueBlobSidecarValuesRequest_SLOT
+		case api.ListUniqueBlobSidecarValuesRequest_epoch:
+			f = indexer.ListUniqueBlobSidecarValuesRequest_EPOCH
+		case api.ListUniqueBlobSidecarValuesRequest_block_root:
+			f = indexer.ListUniqueBlobSidecarValuesRequest_BLOCK_ROOT
+		case api.ListUniqueBlobSidecarValuesRequest_beacon_implementation:
+			f = indexer.ListUniqueBlobSidecarValuesRequest_BEACON_IMPLEMENTATION
+		case api.ListUniqueBlobSidecarValuesRequest_sidecar_type:
+			f = indexer.ListUniqueBlobSidecarValuesRequest_SIDECAR_TYPE
+		default:
+			return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid field: %s", field.String()).Error())
+		}
+
+		rq.Fields = append(rq.Fields, f)
+	}
+
+	// Call the indexer
+	resp, err := i.indexer.ListUniqueBlobSidecarValues(ctx, &rq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to list unique blob sidecar values: %w", err).Error())
+	}
+
+	// Convert the response
+	response := &api.ListUniqueBlobSidecarValuesResponse{
+		Node:                 resp.Node,
+		Slot:                 resp.Slot,
+		Epoch:                resp.Epoch,
+		BlockRoot:            resp.BlockRoot,
+		NodeVersion:          resp.NodeVersion,
+		Network:              resp.Network,
+		BeaconImplementation: resp.BeaconImplementation,
+		SidecarType:          resp.SidecarType,
+	}
+
+	return response, nil
+}
+
+func (i *API) ListForkChoice(ctx context.Context, req *api.ListForkChoiceRequest) (*api.ListForkChoiceResponse, error) {
+	pagination := &indexer.PaginationCursor{
+		Limit:   100,
+		Offset:  0,
+		OrderBy: "fetched_at DESC",
+	}
+
+	if req.Pagination != nil {
+		pagination = &indexer.PaginationCursor{
+			Limit:   req.Pagination.Limit,
+			Offset:  req.Pagination.Offset,
+			OrderBy: req.Pagination.OrderBy,
+		}
+	}
+
+	rq := &indexer.ListForkChoiceRequest{
+		Id:                   req.Id,
+		Node:                 req.Node,
+		Slot:                 req.Slot,
+		Epoch:                req.Epoch,
+		NodeVersion:          req.NodeVersion,
+		Network:              req.Network,
+		Before:               req.Before,
+		After:                req.After,
+		BeaconImplementation: req.BeaconImplementation,
+		Reason:               req.Reason,
+
+		Pagination: pagination,
+	}
+
+	resp, err := i.indexer.ListForkChoice(ctx, rq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to list fork choices: %w", err).Error())
+	}
+
+	protoForkChoices := make([]*api.ForkChoice, len(resp.ForkChoices))
+	for i, forkChoice := range resp.ForkChoices {
+		protoForkChoices[i] = &api.ForkChoice{
+			Id:                   forkChoice.Id,
+			Node:                 forkChoice.Node,
+			Slot:                 forkChoice.Slot,
+			Epoch:                forkChoice.Epoch,
+			NodeVersion:          forkChoice.NodeVersion,
+			Network:              forkChoice.Network,
+			FetchedAt:            forkChoice.FetchedAt,
+			BeaconImplementation: forkChoice.BeaconImplementation,
+			Reason:               forkChoice.Reason,
+		}
+	}
+
+	return &api.ListForkChoiceResponse{
+		ForkChoices: protoForkChoices,
+	}, nil
+}
+
+func (i *API) CountForkChoice(ctx context.Context, req *api.CountForkChoiceRequest) (*api.CountForkChoiceResponse, error) {
+	rq := &indexer.CountForkChoiceRequest{
+		Node:                 req.Node,
+		Slot:                 req.Slot,
+		Epoch:                req.Epoch,
+		NodeVersion:          req.NodeVersion,
+		Network:              req.Network,
+		BeaconImplementation: req.BeaconImplementation,
+		Before:               req.Before,
+		After:                req.After,
+		Reason:               req.Reason,
+	}
+
+	resp, err := i.indexer.CountForkChoice(ctx, rq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to count fork choices: %w", err).Error())
+	}
+
+	return &api.CountForkChoiceResponse{
+		Count: wrapperspb.UInt64(resp.GetCount().GetValue()),
+	}, nil
+}
+
+func (i *API) ListUniqueForkChoiceValues(ctx context.Context, req *api.ListUniqueForkChoiceValuesRequest) (*api.ListUniqueForkChoiceValuesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid request: %w", err).Error())
+	}
+
+	// Create our "indexer" equivalent structs
+	rq := indexer.ListUniqueForkChoiceValuesRequest{
+		Fields: []indexer.ListUniqueForkChoiceValuesRequest_Field{},
+	}
+
+	for _, field := range req.Fields {
+		var f indexer.ListUniqueForkChoiceValuesRequest_Field
+
+		switch field {
+		case api.ListUniqueForkChoiceValuesRequest_node:
+			f = indexer.ListUniqueForkChoiceValuesRequest_NODE
+		case api.ListUniqueForkChoiceValuesRequest_node_version:
+			f = indexer.ListUniqueForkChoiceValuesRequest_NODE_VERSION
+		case api.ListUniqueForkChoiceValuesRequest_network:
+			f = indexer.ListUniqueForkChoiceValuesRequest_NETWORK
+		case api.ListUniqueForkChoiceValuesRequest_slot:
+			f = indexer.ListUniqueForkChoiceValuesRequest_SLOT
+		case api.ListUniqueForkChoiceValuesRequest_epoch:
+			f = indexer.ListUniqueForkChoiceValuesRequest_EPOCH
+		case api.ListUniqueForkChoiceValuesRequest_beacon_implementation:
+			f = indexer.ListUniqueForkChoiceValuesRequest_BEACON_IMPLEMENTATION
+		case api.ListUniqueForkChoiceValuesRequest_reason:
+			f = indexer.ListUniqueForkChoiceValuesRequest_REASON
+		default:
+			return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid field: %s", field.String()).Error())
+		}
+
+		rq.Fields = append(rq.Fields, f)
+	}
+
+	// Call the indexer
+	resp, err := i.indexer.ListUniqueForkChoiceValues(ctx, &rq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to list unique fork choice values: %w", err).Error())
+	}
+
+	// Convert the response
+	response := &api.ListUniqueForkChoiceValuesResponse{
+		Node:                 resp.Node,
+		Slot:                 resp.Slot,
+		Epoch:                resp.Epoch,
+		NodeVersion:          resp.NodeVersion,
+		Network:              resp.Network,
+		BeaconImplementation: resp.BeaconImplementation,
+		Reason:               resp.Reason,
+	}
+
+	return response, nil
+}
+
 func (i *API) ListBeaconBadBlock(ctx context.Context, req *api.ListBeaconBadBlockRequest) (*api.ListBeaconBadBlockResponse, error) {
 	pagination := &indexer.PaginationCursor{
 		Limit:   100,
@@ -661,6 +1012,8 @@ func (i *API) ListExecutionBlockTrace(ctx context.Context, req *api.ListExecutio
 		Before:      req.Before,
 		After:       req.After,
 		Id:          req.Id,
+		Tracer:      req.Tracer,
+		Canonical:   req.Canonical,
 
 		Pagination: pagination,
 	}
@@ -681,6 +1034,8 @@ func (i *API) ListExecutionBlockTrace(ctx context.Context, req *api.ListExecutio
 			Network:                 trace.Network,
 			ExecutionImplementation: trace.ExecutionImplementation,
 			NodeVersion:             trace.NodeVersion,
+			Tracer:                  trace.Tracer,
+			Canonical:               trace.Canonical,
 		}
 	}
 
@@ -699,6 +1054,8 @@ func (i *API) CountExecutionBlockTrace(ctx context.Context, req *api.CountExecut
 		After:                   req.After,
 		ExecutionImplementation: req.ExecutionImplementation,
 		NodeVersion:             req.NodeVersion,
+		Tracer:                  req.Tracer,
+		Canonical:               req.Canonical,
 	}
 
 	resp, err := i.indexer.CountExecutionBlockTrace(ctx, rq)
@@ -737,6 +1094,8 @@ func (i *API) ListUniqueExecutionBlockTraceValues(ctx context.Context, req *api.
 			f = indexer.ListUniqueExecutionBlockTraceValuesRequest_EXECUTION_IMPLEMENTATION
 		case api.ListUniqueExecutionBlockTraceValuesRequest_node_version:
 			f = indexer.ListUniqueExecutionBlockTraceValuesRequest_NODE_VERSION
+		case api.ListUniqueExecutionBlockTraceValuesRequest_tracer:
+			f = indexer.ListUniqueExecutionBlockTraceValuesRequest_TRACER
 		default:
 			return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid field: %s", field.String()).Error())
 		}
@@ -758,6 +1117,135 @@ func (i *API) ListUniqueExecutionBlockTraceValues(ctx context.Context, req *api.
 		Network:                 resp.Network,
 		ExecutionImplementation: resp.ExecutionImplementation,
 		NodeVersion:             resp.NodeVersion,
+		Tracer:                  resp.Tracer,
+	}
+
+	return response, nil
+}
+
+func (i *API) ListExecutionWitness(ctx context.Context, req *api.ListExecutionWitnessRequest) (*api.ListExecutionWitnessResponse, error) {
+	pagination := &indexer.PaginationCursor{
+		Limit:   100,
+		Offset:  0,
+		OrderBy: "fetched_at DESC",
+	}
+
+	if req.Pagination != nil {
+		pagination = &indexer.PaginationCursor{
+			Limit:   req.Pagination.Limit,
+			Offset:  req.Pagination.Offset,
+			OrderBy: req.Pagination.OrderBy,
+		}
+	}
+
+	rq := &indexer.ListExecutionWitnessRequest{
+		Node:        req.Node,
+		BlockNumber: req.BlockNumber,
+		BlockHash:   req.BlockHash,
+		Network:     req.Network,
+		Before:      req.Before,
+		After:       req.After,
+		Id:          req.Id,
+
+		ExecutionImplementation: req.ExecutionImplementation,
+		NodeVersion:             req.NodeVersion,
+
+		Pagination: pagination,
+	}
+
+	resp, err := i.indexer.ListExecutionWitness(ctx, rq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to list execution witnesses: %w", err).Error())
+	}
+
+	protoExecutionWitnesses := make([]*api.ExecutionWitness, len(resp.ExecutionWitnesses))
+	for i, witness := range resp.ExecutionWitnesses {
+		protoExecutionWitnesses[i] = &api.ExecutionWitness{
+			Id:                      witness.Id,
+			Node:                    witness.Node,
+			FetchedAt:               witness.FetchedAt,
+			BlockHash:               witness.BlockHash,
+			BlockNumber:             witness.BlockNumber,
+			Network:                 witness.Network,
+			ExecutionImplementation: witness.ExecutionImplementation,
+			NodeVersion:             witness.NodeVersion,
+		}
+	}
+
+	return &api.ListExecutionWitnessResponse{
+		ExecutionWitnesses: protoExecutionWitnesses,
+	}, nil
+}
+
+func (i *API) CountExecutionWitness(ctx context.Context, req *api.CountExecutionWitnessRequest) (*api.CountExecutionWitnessResponse, error) {
+	rq := &indexer.CountExecutionWitnessRequest{
+		Node:                    req.Node,
+		BlockNumber:             req.BlockNumber,
+		BlockHash:               req.BlockHash,
+		Network:                 req.Network,
+		Before:                  req.Before,
+		After:                   req.After,
+		ExecutionImplementation: req.ExecutionImplementation,
+		NodeVersion:             req.NodeVersion,
+	}
+
+	resp, err := i.indexer.CountExecutionWitness(ctx, rq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to count execution witnesses: %w", err).Error())
+	}
+
+	return &api.CountExecutionWitnessResponse{
+		Count: wrapperspb.UInt64(resp.GetCount().GetValue()),
+	}, nil
+}
+
+func (i *API) ListUniqueExecutionWitnessValues(ctx context.Context, req *api.ListUniqueExecutionWitnessValuesRequest) (*api.ListUniqueExecutionWitnessValuesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid request: %w", err).Error())
+	}
+
+	// Create our "indexer" equivalent structs
+	rq := indexer.ListUniqueExecutionWitnessValuesRequest{
+		Fields: []indexer.ListUniqueExecutionWitnessValuesRequest_Field{},
+	}
+
+	for _, field := range req.Fields {
+		var f indexer.ListUniqueExecutionWitnessValuesRequest_Field
+
+		switch field {
+		case api.ListUniqueExecutionWitnessValuesRequest_node:
+			f = indexer.ListUniqueExecutionWitnessValuesRequest_NODE
+		case api.ListUniqueExecutionWitnessValuesRequest_block_hash:
+			f = indexer.ListUniqueExecutionWitnessValuesRequest_BLOCK_HASH
+		case api.ListUniqueExecutionWitnessValuesRequest_block_number:
+			f = indexer.ListUniqueExecutionWitnessValuesRequest_BLOCK_NUMBER
+		case api.ListUniqueExecutionWitnessValuesRequest_network:
+			f = indexer.ListUniqueExecutionWitnessValuesRequest_NETWORK
+		case api.ListUniqueExecutionWitnessValuesRequest_execution_implementation:
+			f = indexer.ListUniqueExecutionWitnessValuesRequest_EXECUTION_IMPLEMENTATION
+		case api.ListUniqueExecutionWitnessValuesRequest_node_version:
+			f = indexer.ListUniqueExecutionWitnessValuesRequest_NODE_VERSION
+		default:
+			return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid field: %s", field.String()).Error())
+		}
+
+		rq.Fields = append(rq.Fields, f)
+	}
+
+	// Call the indexer
+	resp, err := i.indexer.ListUniqueExecutionWitnessValues(ctx, &rq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to list unique execution witness values: %w", err).Error())
+	}
+
+	// Convert the response
+	response := &api.ListUniqueExecutionWitnessValuesResponse{
+		Node:                    resp.Node,
+		BlockHash:               resp.BlockHash,
+		BlockNumber:             resp.BlockNumber,
+		Network:                 resp.Network,
+		ExecutionImplementation: resp.ExecutionImplementation,
+		NodeVersion:             resp.NodeVersion,
 	}
 
 	return response, nil
@@ -808,6 +1296,8 @@ func (i *API) ListExecutionBadBlock(ctx context.Context, req *api.ListExecutionB
 			ExecutionImplementation: trace.ExecutionImplementation,
 			NodeVersion:             trace.NodeVersion,
 			BlockExtraData:          trace.BlockExtraData,
+			HasTrace:                wrapperspb.Bool(trace.GetTraceLocation().GetValue() != ""),
+			HasIntermediateRoots:    wrapperspb.Bool(trace.GetIntermediateRootsLocation().GetValue() != ""),
 		}
 	}
 
@@ -0,0 +1,218 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/api"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"github.com/ethpandaops/tracoor/pkg/tracediff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *API) DiffExecutionBlockTrace(ctx context.Context, req *api.DiffExecutionBlockTraceRequest) (*api.DiffExecutionBlockTraceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Errorf("invalid request: %w", err).Error())
+	}
+
+	trace, err := i.getExecutionBlockTrace(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	other, err := i.getExecutionBlockTrace(ctx, req.OtherId)
+	if err != nil {
+		return nil, err
+	}
+
+	if trace.GetBlockHash().GetValue() != other.GetBlockHash().GetValue() {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf(
+			"execution block traces are for different blocks: %s and %s",
+			trace.GetBlockHash().GetValue(),
+			other.GetBlockHash().GetValue(),
+		))
+	}
+
+	// Only struct logger traces carry opcode level detail that can be diffed.
+	for _, t := range []*indexer.ExecutionBlockTrace{trace, other} {
+		if tracer := t.GetTracer().GetValue(); tracer != "" && tracer != tracediff.StructLoggerTracer {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf(
+				"execution block trace %s was produced by the %s tracer, only %s traces can be diffed",
+				t.GetId().GetValue(),
+				tracer,
+				tracediff.StructLoggerTracer,
+			))
+		}
+	}
+
+	data, err := i.openExecutionBlockTrace(ctx, trace)
+	if err != nil {
+		return nil, err
+	}
+
+	defer data.Close()
+
+	otherData, err := i.openExecutionBlockTrace(ctx, other)
+	if err != nil {
+		return nil, err
+	}
+
+	defer otherData.Close()
+
+	result, err := tracediff.Diff(data, otherData)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to diff execution block traces: %w", err).Error())
+	}
+
+	return &api.DiffExecutionBlockTraceResponse{
+		Trace:                 executionBlockTraceToAPI(trace),
+		OtherTrace:            executionBlockTraceToAPI(other),
+		Identical:             result.Identical,
+		TransactionCount:      uint64(result.TransactionCount),
+		OtherTransactionCount: uint64(result.OtherTransactionCount),
+		FirstDivergence:       transactionDiffToAPI(result.FirstDivergence),
+	}, nil
+}
+
+func (i *API) getExecutionBlockTrace(ctx context.Context, id string) (*indexer.ExecutionBlockTrace, error) {
+	resp, err := i.indexer.ListExecutionBlockTrace(ctx, &indexer.ListExecutionBlockTraceRequest{
+		Id: id,
+		Pagination: &indexer.PaginationCursor{
+			Limit: 1,
+		},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to list execution block traces: %w", err).Error())
+	}
+
+	if len(resp.ExecutionBlockTraces) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("execution block trace %s not found", id))
+	}
+
+	return resp.ExecutionBlockTraces[0], nil
+}
+
+// openExecutionBlockTrace returns a stream of the decompressed trace. Traces can
+// be hundreds of megabytes, so they're decoded as they're read from the store.
+func (i *API) openExecutionBlockTrace(ctx context.Context, trace *indexer.ExecutionBlockTrace) (io.ReadCloser, error) {
+	data, err := i.store.GetStream(ctx, store.BlockTraceDataType, trace.GetLocation().GetValue())
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to get execution block trace %s from store: %w", trace.GetId().GetValue(), err).Error())
+	}
+
+	algo := compression.GetItemCompressionAlgorithm(trace.GetLocation().GetValue(), trace.GetContentEncoding().GetValue())
+	if algo == nil {
+		algo = compression.None
+	}
+
+	decompressed, err := i.compressor.DecompressStream(data, algo)
+	if err != nil {
+		data.Close()
+
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to decompress execution block trace %s: %w", trace.GetId().GetValue(), err).Error())
+	}
+
+	return &readCloser{Reader: decompressed, closers: []io.Closer{decompressed, data}}, nil
+}
+
+// readCloser closes every closer when it's closed.
+type readCloser struct {
+	io.Reader
+
+	closers []io.Closer
+}
+
+func (r *readCloser) Close() error {
+	var errs []error
+
+	for _, c := range r.closers {
+		errs = append(errs, c.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+func executionBlockTraceToAPI(trace *indexer.ExecutionBlockTrace) *api.ExecutionBlockTrace {
+	return &api.ExecutionBlockTrace{
+		Id:                      trace.Id,
+		Node:                    trace.Node,
+		FetchedAt:               trace.FetchedAt,
+		BlockHash:               trace.BlockHash,
+		BlockNumber:             trace.BlockNumber,
+		Network:                 trace.Network,
+		ExecutionImplementation: trace.ExecutionImplementation,
+		NodeVersion:             trace.NodeVersion,
+		Tracer:                  trace.Tracer,
+	}
+}
+
+func transactionDiffToAPI(diff *tracediff.TransactionDiff) *api.ExecutionBlockTraceTransactionDiff {
+	if diff == nil {
+		return nil
+	}
+
+	return &api.ExecutionBlockTraceTransactionDiff{
+		TransactionIndex:   uint64(diff.TransactionIndex),
+		TransactionHash:    diff.TransactionHash,
+		DifferingFields:    diff.DifferingFields,
+		Gas:                diff.Gas,
+		OtherGas:           diff.OtherGas,
+		Failed:             diff.Failed,
+		OtherFailed:        diff.OtherFailed,
+		ReturnValue:        diff.ReturnValue,
+		OtherReturnValue:   diff.OtherReturnValue,
+		StepCount:          uint64(diff.StepCount),
+		OtherStepCount:     uint64(diff.OtherStepCount),
+		FirstDivergentStep: stepDiffToAPI(diff.FirstDivergentStep),
+	}
+}
+
+func stepDiffToAPI(diff *tracediff.StepDiff) *api.ExecutionBlockTraceStepDiff {
+	if diff == nil {
+		return nil
+	}
+
+	return &api.ExecutionBlockTraceStepDiff{
+		Index:           uint64(diff.Index),
+		DifferingFields: diff.DifferingFields,
+		Step:            stepToAPI(diff.Step),
+		OtherStep:       stepToAPI(diff.OtherStep),
+		GasDelta:        diff.GasDelta,
+		Stack:           valueDiffsToAPI(diff.Stack),
+		Memory:          valueDiffsToAPI(diff.Memory),
+		Storage:         valueDiffsToAPI(diff.Storage),
+	}
+}
+
+func stepToAPI(step *tracediff.Step) *api.ExecutionBlockTraceStep {
+	if step == nil {
+		return nil
+	}
+
+	return &api.ExecutionBlockTraceStep{
+		Pc:      step.PC,
+		Op:      step.Op,
+		Gas:     step.Gas,
+		GasCost: step.GasCost,
+		Depth:   step.Depth,
+	}
+}
+
+func valueDiffsToAPI(diffs []tracediff.ValueDiff) []*api.ExecutionBlockTraceValueDiff {
+	out := make([]*api.ExecutionBlockTraceValueDiff, len(diffs))
+
+	for idx, diff := range diffs {
+		out[idx] = &api.ExecutionBlockTraceValueDiff{
+			Key:        diff.Key,
+			Value:      diff.Value,
+			OtherValue: diff.OtherValue,
+		}
+	}
+
+	return out
+}
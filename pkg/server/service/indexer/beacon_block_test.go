@@ -11,6 +11,8 @@ import (
 	"github.com/ethpandaops/tracoor/pkg/compression"
 	pindexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
 	"github.com/ethpandaops/tracoor/pkg/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -31,6 +33,24 @@ func createRandomBeaconBlockRequest() *pindexer.CreateBeaconBlockRequest {
 	}
 }
 
+// createStoredBeaconBlockRequest returns a random request whose block is saved
+// to the store, since the indexer only indexes stored blocks.
+func createStoredBeaconBlockRequest(ctx context.Context, t *testing.T, index *Indexer) *pindexer.CreateBeaconBlockRequest {
+	t.Helper()
+
+	req := createRandomBeaconBlockRequest()
+	data := []byte("block")
+
+	if _, err := index.Store().SaveBeaconBlock(ctx, &store.SaveParams{
+		Data:     &data,
+		Location: req.GetLocation().GetValue(),
+	}); err != nil {
+		t.Fatalf("failed to save beacon block: %v", err)
+	}
+
+	return req
+}
+
 func TestIndexerBeaconBlockCount(t *testing.T) {
 	ctx := context.Background()
 	config := Config{}
@@ -47,7 +67,7 @@ func TestIndexerBeaconBlockCount(t *testing.T) {
 	}()
 
 	t.Run("Counting", func(t *testing.T) {
-		_, err := index.CreateBeaconBlock(ctx, createRandomBeaconBlockRequest())
+		_, err := index.CreateBeaconBlock(ctx, createStoredBeaconBlockRequest(ctx, t, index))
 		if err != nil {
 			t.Fatalf("failed to create beacon state: %v", err)
 		}
@@ -163,14 +183,14 @@ func TestIndexerBeaconBlock(t *testing.T) {
 	}()
 
 	t.Run("Creating", func(t *testing.T) {
-		_, err := index.CreateBeaconBlock(ctx, createRandomBeaconBlockRequest())
+		_, err := index.CreateBeaconBlock(ctx, createStoredBeaconBlockRequest(ctx, t, index))
 		if err != nil {
 			t.Fatalf("failed to create beacon state: %v", err)
 		}
 	})
 
 	t.Run("Creating returns a valid ID", func(t *testing.T) {
-		rsp, err := index.CreateBeaconBlock(ctx, createRandomBeaconBlockRequest())
+		rsp, err := index.CreateBeaconBlock(ctx, createStoredBeaconBlockRequest(ctx, t, index))
 		if err != nil {
 			t.Fatalf("failed to create beacon state: %v", err)
 		}
@@ -181,7 +201,7 @@ func TestIndexerBeaconBlock(t *testing.T) {
 	})
 
 	t.Run("Handles duplicates", func(t *testing.T) {
-		req := createRandomBeaconBlockRequest()
+		req := createStoredBeaconBlockRequest(ctx, t, index)
 
 		rsp, err := index.CreateBeaconBlock(ctx, req)
 		if err != nil {
@@ -193,13 +213,13 @@ func TestIndexerBeaconBlock(t *testing.T) {
 		}
 
 		_, err = index.CreateBeaconBlock(ctx, req)
-		if err != nil && err.Error() != beaconStateExistsStr {
-			t.Fatal("expected error to be 'beacon state already exists'")
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected an AlreadyExists error, got %v", err)
 		}
 	})
 
 	t.Run("Basic Listing", func(t *testing.T) {
-		req := createRandomBeaconBlockRequest()
+		req := createStoredBeaconBlockRequest(ctx, t, index)
 
 		resp, err := index.CreateBeaconBlock(ctx, req)
 		if err != nil {
@@ -217,7 +237,7 @@ func TestIndexerBeaconBlock(t *testing.T) {
 	})
 
 	t.Run("Can list by filters", func(t *testing.T) {
-		req := createRandomBeaconBlockRequest()
+		req := createStoredBeaconBlockRequest(ctx, t, index)
 
 		resp, err := index.CreateBeaconBlock(ctx, req)
 		if err != nil {
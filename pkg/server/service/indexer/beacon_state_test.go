@@ -11,6 +11,8 @@ import (
 	"github.com/ethpandaops/tracoor/pkg/compression"
 	pindexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
 	"github.com/ethpandaops/tracoor/pkg/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -29,6 +31,24 @@ func createRandomBeaconStateRequest() *pindexer.CreateBeaconStateRequest {
 	}
 }
 
+// createStoredBeaconStateRequest returns a random request whose state is saved
+// to the store, since the indexer only indexes stored states.
+func createStoredBeaconStateRequest(ctx context.Context, t *testing.T, index *Indexer) *pindexer.CreateBeaconStateRequest {
+	t.Helper()
+
+	req := createRandomBeaconStateRequest()
+	data := []byte("state")
+
+	if _, err := index.Store().SaveBeaconState(ctx, &store.SaveParams{
+		Data:     &data,
+		Location: req.GetLocation().GetValue(),
+	}); err != nil {
+		t.Fatalf("failed to save beacon state: %v", err)
+	}
+
+	return req
+}
+
 func TestIndexerBeaconStateCount(t *testing.T) {
 	ctx := context.Background()
 	config := Config{}
@@ -45,7 +65,7 @@ func TestIndexerBeaconStateCount(t *testing.T) {
 	}()
 
 	t.Run("Counting", func(t *testing.T) {
-		_, err := index.CreateBeaconState(ctx, createRandomBeaconStateRequest())
+		_, err := index.CreateBeaconState(ctx, createStoredBeaconStateRequest(ctx, t, index))
 		if err != nil {
 			t.Fatalf("failed to create beacon state: %v", err)
 		}
@@ -161,14 +181,14 @@ func TestIndexerBeaconState(t *testing.T) {
 	}()
 
 	t.Run("Creating", func(t *testing.T) {
-		_, err := index.CreateBeaconState(ctx, createRandomBeaconStateRequest())
+		_, err := index.CreateBeaconState(ctx, createStoredBeaconStateRequest(ctx, t, index))
 		if err != nil {
 			t.Fatalf("failed to create beacon state: %v", err)
 		}
 	})
 
 	t.Run("Creating returns a valid ID", func(t *testing.T) {
-		rsp, err := index.CreateBeaconState(ctx, createRandomBeaconStateRequest())
+		rsp, err := index.CreateBeaconState(ctx, createStoredBeaconStateRequest(ctx, t, index))
 		if err != nil {
 			t.Fatalf("failed to create beacon state: %v", err)
 		}
@@ -179,7 +199,7 @@ func TestIndexerBeaconState(t *testing.T) {
 	})
 
 	t.Run("Handles duplicates", func(t *testing.T) {
-		req := createRandomBeaconStateRequest()
+		req := createStoredBeaconStateRequest(ctx, t, index)
 
 		rsp, err := index.CreateBeaconState(ctx, req)
 		if err != nil {
@@ -191,13 +211,13 @@ func TestIndexerBeaconState(t *testing.T) {
 		}
 
 		_, err = index.CreateBeaconState(ctx, req)
-		if err != nil && err.Error() != "beacon state already exists" {
-			t.Fatal("expected error to be 'beacon state already exists'")
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected an AlreadyExists error, got %v", err)
 		}
 	})
 
 	t.Run("Basic Listing", func(t *testing.T) {
-		req := createRandomBeaconStateRequest()
+		req := createStoredBeaconStateRequest(ctx, t, index)
 
 		resp, err := index.CreateBeaconState(ctx, req)
 		if err != nil {
@@ -215,7 +235,7 @@ func TestIndexerBeaconState(t *testing.T) {
 	})
 
 	t.Run("Can list by filters", func(t *testing.T) {
-		req := createRandomBeaconStateRequest()
+		req := createStoredBeaconStateRequest(ctx, t, index)
 
 		resp, err := index.CreateBeaconState(ctx, req)
 		if err != nil {
@@ -403,3 +423,200 @@ func TestIndexerBeaconState(t *testing.T) {
 		})
 	})
 }
+
+func TestIndexerBeaconStateDivergence(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	majority := createStoredBeaconStateRequest(ctx, t, index)
+
+	agreeing := createStoredBeaconStateRequest(ctx, t, index)
+	agreeing.Network = majority.Network
+	agreeing.Slot = majority.Slot
+	agreeing.StateRoot = majority.StateRoot
+	agreeing.BeaconImplementation = majority.BeaconImplementation
+	agreeing.NodeVersion = majority.NodeVersion
+
+	minority := createStoredBeaconStateRequest(ctx, t, index)
+	minority.Network = majority.Network
+	minority.Slot = majority.Slot
+
+	for _, req := range []*pindexer.CreateBeaconStateRequest{majority, agreeing} {
+		if _, err := index.CreateBeaconState(ctx, req); err != nil {
+			t.Fatalf("failed to create beacon state: %v", err)
+		}
+	}
+
+	rsp, err := index.ListStateDivergences(ctx, &pindexer.ListStateDivergencesRequest{Network: majority.GetNetwork().GetValue()})
+	if err != nil {
+		t.Fatalf("failed to list state divergences: %v", err)
+	}
+
+	if len(rsp.StateDivergences) != 0 {
+		t.Fatalf("expected 0 state divergences, got %d", len(rsp.StateDivergences))
+	}
+
+	if _, err := index.CreateBeaconState(ctx, minority); err != nil {
+		t.Fatalf("failed to create beacon state: %v", err)
+	}
+
+	rsp, err = index.ListStateDivergences(ctx, &pindexer.ListStateDivergencesRequest{Network: majority.GetNetwork().GetValue()})
+	if err != nil {
+		t.Fatalf("failed to list state divergences: %v", err)
+	}
+
+	// Both majority nodes share the same root, implementation and version so only
+	// a single divergence should be recorded.
+	if len(rsp.StateDivergences) != 1 {
+		t.Fatalf("expected 1 state divergence, got %d", len(rsp.StateDivergences))
+	}
+
+	divergence := rsp.StateDivergences[0]
+
+	if divergence.GetStateRoot().GetValue() != minority.GetStateRoot().GetValue() {
+		t.Fatalf("expected state root %s, got %s", minority.GetStateRoot().GetValue(), divergence.GetStateRoot().GetValue())
+	}
+
+	if divergence.GetConflictingStateRoot().GetValue() != majority.GetStateRoot().GetValue() {
+		t.Fatalf("expected conflicting state root %s, got %s", majority.GetStateRoot().GetValue(), divergence.GetConflictingStateRoot().GetValue())
+	}
+
+	if divergence.GetConflictingBeaconImplementation().GetValue() != majority.GetBeaconImplementation().GetValue() {
+		t.Fatalf("expected conflicting beacon implementation %s, got %s", majority.GetBeaconImplementation().GetValue(), divergence.GetConflictingBeaconImplementation().GetValue())
+	}
+
+	// Once a reorg orphans the minority state its divergence goes away.
+	if _, err := index.MarkOrphaned(ctx, &pindexer.MarkOrphanedRequest{
+		Node:       minority.GetNode().GetValue(),
+		Network:    minority.GetNetwork().GetValue(),
+		StateRoots: []string{minority.GetStateRoot().GetValue()},
+	}); err != nil {
+		t.Fatalf("failed to mark orphaned: %v", err)
+	}
+
+	rsp, err = index.ListStateDivergences(ctx, &pindexer.ListStateDivergencesRequest{Network: majority.GetNetwork().GetValue()})
+	if err != nil {
+		t.Fatalf("failed to list state divergences: %v", err)
+	}
+
+	if len(rsp.StateDivergences) != 0 {
+		t.Fatalf("expected 0 state divergences after the reorg, got %d", len(rsp.StateDivergences))
+	}
+}
+
+func TestIndexerFinalizedBeaconState(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	t.Run("Creating requires a checkpoint epoch", func(t *testing.T) {
+		req := createRandomBeaconStateRequest()
+		req.Finalized = wrapperspb.Bool(true)
+
+		if _, err := index.CreateBeaconState(ctx, req); err == nil {
+			t.Fatal("expected error when checkpoint epoch is missing")
+		}
+	})
+
+	t.Run("Marks an already indexed state as finalized", func(t *testing.T) {
+		data := []byte("state")
+
+		location, err := index.Store().SaveBeaconState(ctx, &store.SaveParams{
+			Data:     &data,
+			Location: generateRandomString(10),
+		})
+		if err != nil {
+			t.Fatalf("failed to save beacon state: %v", err)
+		}
+
+		req := createRandomBeaconStateRequest()
+		req.Location = wrapperspb.String(location)
+
+		created, err := index.CreateBeaconState(ctx, req)
+		if err != nil {
+			t.Fatalf("failed to create beacon state: %v", err)
+		}
+
+		req.Finalized = wrapperspb.Bool(true)
+		req.CheckpointEpoch = wrapperspb.UInt64(req.Epoch.Value)
+
+		finalized, err := index.CreateBeaconState(ctx, req)
+		if err != nil {
+			t.Fatalf("failed to mark beacon state as finalized: %v", err)
+		}
+
+		if finalized.Id.Value != created.Id.Value {
+			t.Fatalf("expected ID %s, got %s", created.Id.Value, finalized.Id.Value)
+		}
+
+		rsp, err := index.ListBeaconState(ctx, &pindexer.ListBeaconStateRequest{Id: created.Id.Value})
+		if err != nil {
+			t.Fatalf("failed to list beacon states: %v", err)
+		}
+
+		if len(rsp.BeaconStates) != 1 {
+			t.Fatalf("expected 1 beacon state, got %d", len(rsp.BeaconStates))
+		}
+
+		if !rsp.BeaconStates[0].GetFinalized().GetValue() {
+			t.Fatal("expected beacon state to be finalized")
+		}
+
+		if rsp.BeaconStates[0].GetCheckpointEpoch().GetValue() != req.Epoch.Value {
+			t.Fatalf("expected checkpoint epoch %d, got %d", req.Epoch.Value, rsp.BeaconStates[0].GetCheckpointEpoch().GetValue())
+		}
+
+		if _, err := index.CreateBeaconState(ctx, req); err == nil {
+			t.Fatal("expected error when the finalized state is already indexed")
+		}
+	})
+
+	t.Run("Can list by finalized", func(t *testing.T) {
+		req := createStoredBeaconStateRequest(ctx, t, index)
+		req.Finalized = wrapperspb.Bool(true)
+		req.CheckpointEpoch = wrapperspb.UInt64(req.Epoch.Value)
+
+		if _, err := index.CreateBeaconState(ctx, req); err != nil {
+			t.Fatalf("failed to create beacon state: %v", err)
+		}
+
+		rsp, err := index.CountBeaconState(ctx, &pindexer.CountBeaconStateRequest{Finalized: wrapperspb.Bool(true)})
+		if err != nil {
+			t.Fatalf("failed to count finalized beacon states: %v", err)
+		}
+
+		if rsp.Count.Value != 2 {
+			t.Fatalf("expected 2 finalized beacon states, got %d", rsp.Count.Value)
+		}
+
+		list, err := index.ListBeaconState(ctx, &pindexer.ListBeaconStateRequest{CheckpointEpoch: req.Epoch.Value})
+		if err != nil {
+			t.Fatalf("failed to list beacon states by checkpoint epoch: %v", err)
+		}
+
+		if len(list.BeaconStates) != 1 {
+			t.Fatalf("expected 1 beacon state, got %d", len(list.BeaconStates))
+		}
+	})
+}
@@ -0,0 +1,207 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	pindexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const blobSidecarExistsStr = "rpc error: code = AlreadyExists desc = blob sidecars already indexed"
+
+func createRandomBlobSidecarRequest() *pindexer.CreateBlobSidecarRequest {
+	return &pindexer.CreateBlobSidecarRequest{
+		Node:                 wrapperspb.String(generateRandomString(5)),
+		Slot:                 wrapperspb.UInt64(uint64(generateRandomInt64())),
+		Epoch:                wrapperspb.UInt64(uint64(generateRandomInt64())),
+		BlockRoot:            wrapperspb.String(generateRandomString(32)),
+		FetchedAt:            timestamppb.Now(),
+		BeaconImplementation: wrapperspb.String(generateRandomString(15)),
+		NodeVersion:          wrapperspb.String(generateRandomString(8)),
+		Location:             wrapperspb.String(generateRandomString(10)),
+		Network:              wrapperspb.String(generateRandomString(5)),
+		SidecarType:          wrapperspb.String("blob_sidecar"),
+	}
+}
+
+func TestIndexerBlobSidecarCount(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	t.Run("Counting", func(t *testing.T) {
+		_, err := index.CreateBlobSidecar(ctx, createRandomBlobSidecarRequest())
+		if err != nil {
+			t.Fatalf("failed to create blob sidecar: %v", err)
+		}
+
+		rsp, err := index.CountBlobSidecar(ctx, &pindexer.CountBlobSidecarRequest{})
+		if err != nil {
+			t.Fatalf("failed to count blob sidecar: %v", err)
+		}
+
+		if rsp.Count.Value != uint64(1) {
+			t.Fatalf("expected 1 blob sidecar, got %d", rsp.Count.Value)
+		}
+	})
+}
+
+func TestIndexerBlobSidecarDownloading(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	t.Run("Downloading", func(t *testing.T) {
+		data := []byte("sidecars")
+
+		compressor := compression.NewCompressor()
+
+		compressedData, err := compressor.Compress(&data, compression.Gzip)
+		if err != nil {
+			t.Fatalf("failed to compress data: %v", err)
+		}
+
+		location, err := index.Store().SaveBlobSidecar(ctx, &store.SaveParams{
+			Data:            &compressedData,
+			Location:        "data.ssz",
+			ContentEncoding: compression.Gzip.ContentEncoding,
+		})
+		if err != nil {
+			t.Fatalf("failed to save blob sidecar: %v", err)
+		}
+
+		req := createRandomBlobSidecarRequest()
+		req.Location = wrapperspb.String(location)
+
+		resp, err := index.CreateBlobSidecar(ctx, req)
+		if err != nil {
+			t.Fatalf("failed to create blob sidecar: %v", err)
+		}
+
+		rsp, err := index.ListBlobSidecar(ctx, &pindexer.ListBlobSidecarRequest{Id: resp.Id.Value})
+		if err != nil {
+			t.Fatalf("failed to get blob sidecar: %v", err)
+		}
+
+		url, err := index.Store().GetBlobSidecarURL(ctx, &store.GetURLParams{
+			Location:        rsp.BlobSidecars[0].Location.GetValue(),
+			Expiry:          60,
+			ContentEncoding: rsp.BlobSidecars[0].ContentEncoding.GetValue(),
+		})
+		if err != nil {
+			t.Fatalf("failed to get blob sidecar URL: %v", err)
+		}
+
+		if url == "" {
+			t.Fatalf("expected URL to not be empty")
+		}
+	})
+}
+
+func TestIndexerBlobSidecar(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	t.Run("Creating requires a sidecar type", func(t *testing.T) {
+		req := createRandomBlobSidecarRequest()
+		req.SidecarType = nil
+
+		if _, err := index.CreateBlobSidecar(ctx, req); err == nil {
+			t.Fatal("expected error when sidecar type is missing")
+		}
+	})
+
+	t.Run("Handles duplicates", func(t *testing.T) {
+		req := createRandomBlobSidecarRequest()
+
+		rsp, err := index.CreateBlobSidecar(ctx, req)
+		if err != nil {
+			t.Fatalf("failed to create blob sidecar: %v", err)
+		}
+
+		if rsp.Id == nil {
+			t.Fatalf("expected ID to not be empty")
+		}
+
+		_, err = index.CreateBlobSidecar(ctx, req)
+		if err != nil && err.Error() != blobSidecarExistsStr {
+			t.Fatal("expected error to be 'blob sidecars already indexed'")
+		}
+	})
+
+	t.Run("Sidecar types of the same block are stored separately", func(t *testing.T) {
+		req := createRandomBlobSidecarRequest()
+
+		if _, err := index.CreateBlobSidecar(ctx, req); err != nil {
+			t.Fatalf("failed to create blob sidecar: %v", err)
+		}
+
+		req.SidecarType = wrapperspb.String("data_column_sidecar")
+
+		if _, err := index.CreateBlobSidecar(ctx, req); err != nil {
+			t.Fatalf("failed to create data column sidecar: %v", err)
+		}
+
+		rsp, err := index.ListBlobSidecar(ctx, &pindexer.ListBlobSidecarRequest{
+			BlockRoot:   req.BlockRoot.Value,
+			SidecarType: "data_column_sidecar",
+		})
+		if err != nil {
+			t.Fatalf("failed to list blob sidecars by sidecar type: %v", err)
+		}
+
+		if len(rsp.BlobSidecars) != 1 {
+			t.Fatalf("expected 1 data column sidecar, got %d", len(rsp.BlobSidecars))
+		}
+	})
+
+	t.Run("Can list unique sidecar types", func(t *testing.T) {
+		rsp, err := index.ListUniqueBlobSidecarValues(ctx, &pindexer.ListUniqueBlobSidecarValuesRequest{
+			Fields: []pindexer.ListUniqueBlobSidecarValuesRequest_Field{
+				pindexer.ListUniqueBlobSidecarValuesRequest_SIDECAR_TYPE,
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to list unique blob sidecar values: %v", err)
+		}
+
+		if len(rsp.SidecarType) != 2 {
+			t.Fatalf("expected 2 sidecar types, got %d", len(rsp.SidecarType))
+		}
+	})
+}
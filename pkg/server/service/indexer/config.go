@@ -1,21 +1,72 @@
 package indexer
 
-import "github.com/ethpandaops/beacon/pkg/human"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethpandaops/beacon/pkg/human"
+)
 
 type RetentionConfig struct {
-	BeaconStates         human.Duration `yaml:"beaconStates" default:"30m"`
-	BeaconBlocks         human.Duration `yaml:"beaconBlocks" default:"30m"`
-	BeaconBadBlocks      human.Duration `yaml:"beaconBadBlocks" default:"312480m"` // 6 months
-	BeaconBadBlobs       human.Duration `yaml:"beaconBadBlobs" default:"312480m"`  // 6 months
-	ExecutionBlockTraces human.Duration `yaml:"executionBlockTraces" default:"30m"`
-	ExecutionBadBlocks   human.Duration `yaml:"executionBadBlocks" default:"312480m"` // 6 months
+	BeaconStates          human.Duration `yaml:"beaconStates" default:"30m"`
+	FinalizedBeaconStates human.Duration `yaml:"finalizedBeaconStates" default:"10080m"` // 1 week
+	BeaconBlocks          human.Duration `yaml:"beaconBlocks" default:"30m"`
+	BlobSidecars          human.Duration `yaml:"blobSidecars" default:"30m"`
+	ForkChoices           human.Duration `yaml:"forkChoices" default:"10080m"`      // 1 week
+	BeaconBadBlocks       human.Duration `yaml:"beaconBadBlocks" default:"312480m"` // 6 months
+	BeaconBadBlobs        human.Duration `yaml:"beaconBadBlobs" default:"312480m"`  // 6 months
+	ExecutionBlockTraces  human.Duration `yaml:"executionBlockTraces" default:"30m"`
+	ExecutionWitnesses    human.Duration `yaml:"executionWitnesses" default:"30m"`
+	ExecutionBadBlocks    human.Duration `yaml:"executionBadBlocks" default:"312480m"` // 6 months
+	StateDivergences      human.Duration `yaml:"stateDivergences" default:"312480m"`   // 6 months
+}
+
+// UploadConfig configures uploads from agents with the server store type.
+type UploadConfig struct {
+	// Tokens are the bearer tokens agents upload with. Uploads are rejected
+	// if there are none.
+	Tokens []UploadToken `yaml:"tokens"`
+}
+
+// UploadToken lets a single node upload. The node of every upload made with
+// the token must match.
+type UploadToken struct {
+	Node  string `yaml:"node"`
+	Token string `yaml:"token"`
+}
+
+func (c *UploadConfig) Validate() error {
+	seen := make(map[string]bool, len(c.Tokens))
+
+	for _, token := range c.Tokens {
+		if token.Node == "" {
+			return errors.New("uploads.tokens: node is required")
+		}
+
+		if token.Token == "" {
+			return fmt.Errorf("uploads.tokens: token is required for node %s", token.Node)
+		}
+
+		if seen[token.Token] {
+			return fmt.Errorf("uploads.tokens: token for node %s is not unique", token.Node)
+		}
+
+		seen[token.Token] = true
+	}
+
+	return nil
 }
 
 type Config struct {
 	Retention      RetentionConfig      `yaml:"retention"`
 	PermanentStore PermanentStoreConfig `yaml:"permanentStore"`
+	Uploads        UploadConfig         `yaml:"uploads"`
 }
 
 func (c *Config) Validate() error {
+	if err := c.Uploads.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
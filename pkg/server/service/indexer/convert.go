@@ -10,7 +10,7 @@ import (
 )
 
 func ProtoBeaconStateToDBBeaconState(bs *indexer.BeaconState) *persistence.BeaconState {
-	return &persistence.BeaconState{
+	state := &persistence.BeaconState{
 		ID:   bs.GetId().GetValue(),
 		Node: bs.GetNode().GetValue(),
 		//nolint:gosec // not worried about int64 overflow here
@@ -24,11 +24,20 @@ func ProtoBeaconStateToDBBeaconState(bs *indexer.BeaconState) *persistence.Beaco
 		ContentEncoding:      bs.GetContentEncoding().GetValue(),
 		Network:              bs.GetNetwork().GetValue(),
 		BeaconImplementation: bs.GetBeaconImplementation().GetValue(),
+		Finalized:            bs.GetFinalized().GetValue(),
+		Canonical:            bs.GetCanonical() == nil || bs.GetCanonical().GetValue(),
 	}
+
+	if bs.GetCheckpointEpoch() != nil {
+		//nolint:gosec // not worried about int64 overflow here
+		state.CheckpointEpoch = sql.NullInt64{Int64: int64(bs.GetCheckpointEpoch().GetValue()), Valid: true}
+	}
+
+	return state
 }
 
 func DBBeaconStateToProtoBeaconState(bs *persistence.BeaconState) *indexer.BeaconState {
-	return &indexer.BeaconState{
+	state := &indexer.BeaconState{
 		Id:   &wrapperspb.StringValue{Value: bs.ID},
 		Node: &wrapperspb.StringValue{Value: bs.Node},
 		//nolint:gosec // not worried about int64 overflow here
@@ -42,6 +51,37 @@ func DBBeaconStateToProtoBeaconState(bs *persistence.BeaconState) *indexer.Beaco
 		ContentEncoding:      &wrapperspb.StringValue{Value: bs.ContentEncoding},
 		Network:              &wrapperspb.StringValue{Value: bs.Network},
 		BeaconImplementation: &wrapperspb.StringValue{Value: bs.BeaconImplementation},
+		Finalized:            &wrapperspb.BoolValue{Value: bs.Finalized},
+		Canonical:            &wrapperspb.BoolValue{Value: bs.Canonical},
+	}
+
+	if bs.CheckpointEpoch.Valid {
+		//nolint:gosec // not worried about int64 overflow here
+		state.CheckpointEpoch = &wrapperspb.UInt64Value{Value: uint64(bs.CheckpointEpoch.Int64)}
+	}
+
+	return state
+}
+
+func DBBeaconStateDivergenceToProtoStateDivergence(d *persistence.BeaconStateDivergence) *indexer.StateDivergence {
+	return &indexer.StateDivergence{
+		Id:      &wrapperspb.StringValue{Value: d.ID},
+		Network: &wrapperspb.StringValue{Value: d.Network},
+		//nolint:gosec // not worried about int64 overflow here
+		Slot: &wrapperspb.UInt64Value{Value: uint64(d.Slot)},
+		//nolint:gosec // not worried about int64 overflow here
+		Epoch:                           &wrapperspb.UInt64Value{Value: uint64(d.Epoch)},
+		DetectedAt:                      timestamppb.New(d.DetectedAt),
+		BeaconStateId:                   &wrapperspb.StringValue{Value: d.BeaconStateID},
+		Node:                            &wrapperspb.StringValue{Value: d.Node},
+		StateRoot:                       &wrapperspb.StringValue{Value: d.StateRoot},
+		BeaconImplementation:            &wrapperspb.StringValue{Value: d.BeaconImplementation},
+		NodeVersion:                     &wrapperspb.StringValue{Value: d.NodeVersion},
+		ConflictingBeaconStateId:        &wrapperspb.StringValue{Value: d.ConflictingBeaconStateID},
+		ConflictingNode:                 &wrapperspb.StringValue{Value: d.ConflictingNode},
+		ConflictingStateRoot:            &wrapperspb.StringValue{Value: d.ConflictingStateRoot},
+		ConflictingBeaconImplementation: &wrapperspb.StringValue{Value: d.ConflictingBeaconImplementation},
+		ConflictingNodeVersion:          &wrapperspb.StringValue{Value: d.ConflictingNodeVersion},
 	}
 }
 
@@ -60,6 +100,7 @@ func ProtoBeaconBlockToDBBeaconBlock(bs *indexer.BeaconBlock) *persistence.Beaco
 		ContentEncoding:      bs.GetContentEncoding().GetValue(),
 		Network:              bs.GetNetwork().GetValue(),
 		BeaconImplementation: bs.GetBeaconImplementation().GetValue(),
+		Canonical:            bs.GetCanonical() == nil || bs.GetCanonical().GetValue(),
 	}
 }
 
@@ -78,6 +119,81 @@ func DBBeaconBlockToProtoBeaconBlock(bs *persistence.BeaconBlock) *indexer.Beaco
 		Network:              &wrapperspb.StringValue{Value: bs.Network},
 		ContentEncoding:      &wrapperspb.StringValue{Value: bs.ContentEncoding},
 		BeaconImplementation: &wrapperspb.StringValue{Value: bs.BeaconImplementation},
+		Canonical:            &wrapperspb.BoolValue{Value: bs.Canonical},
+	}
+}
+
+func ProtoBlobSidecarToDBBlobSidecar(bs *indexer.BlobSidecar) *persistence.BlobSidecar {
+	return &persistence.BlobSidecar{
+		ID:   bs.GetId().GetValue(),
+		Node: bs.GetNode().GetValue(),
+		//nolint:gosec // not worried about int64 overflow here
+		Slot: int64(bs.GetSlot().GetValue()),
+		//nolint:gosec // not worried about int64 overflow here
+		Epoch:                int64(bs.GetEpoch().GetValue()),
+		BlockRoot:            bs.GetBlockRoot().GetValue(),
+		FetchedAt:            bs.GetFetchedAt().AsTime(),
+		NodeVersion:          bs.GetNodeVersion().GetValue(),
+		Location:             bs.GetLocation().GetValue(),
+		ContentEncoding:      bs.GetContentEncoding().GetValue(),
+		Network:              bs.GetNetwork().GetValue(),
+		BeaconImplementation: bs.GetBeaconImplementation().GetValue(),
+		SidecarType:          bs.GetSidecarType().GetValue(),
+	}
+}
+
+func DBBlobSidecarToProtoBlobSidecar(bs *persistence.BlobSidecar) *indexer.BlobSidecar {
+	return &indexer.BlobSidecar{
+		Id:   &wrapperspb.StringValue{Value: bs.ID},
+		Node: &wrapperspb.StringValue{Value: bs.Node},
+		//nolint:gosec // not worried about int64 overflow here
+		Slot: &wrapperspb.UInt64Value{Value: uint64(bs.Slot)},
+		//nolint:gosec // not worried about int64 overflow here
+		Epoch:                &wrapperspb.UInt64Value{Value: uint64(bs.Epoch)},
+		BlockRoot:            &wrapperspb.StringValue{Value: bs.BlockRoot},
+		FetchedAt:            timestamppb.New(bs.FetchedAt),
+		NodeVersion:          &wrapperspb.StringValue{Value: bs.NodeVersion},
+		Location:             &wrapperspb.StringValue{Value: bs.Location},
+		Network:              &wrapperspb.StringValue{Value: bs.Network},
+		ContentEncoding:      &wrapperspb.StringValue{Value: bs.ContentEncoding},
+		BeaconImplementation: &wrapperspb.StringValue{Value: bs.BeaconImplementation},
+		SidecarType:          &wrapperspb.StringValue{Value: bs.SidecarType},
+	}
+}
+
+func ProtoForkChoiceToDBForkChoice(fc *indexer.ForkChoice) *persistence.ForkChoice {
+	return &persistence.ForkChoice{
+		ID:   fc.GetId().GetValue(),
+		Node: fc.GetNode().GetValue(),
+		//nolint:gosec // not worried about int64 overflow here
+		Slot: int64(fc.GetSlot().GetValue()),
+		//nolint:gosec // not worried about int64 overflow here
+		Epoch:                int64(fc.GetEpoch().GetValue()),
+		FetchedAt:            fc.GetFetchedAt().AsTime(),
+		NodeVersion:          fc.GetNodeVersion().GetValue(),
+		Location:             fc.GetLocation().GetValue(),
+		ContentEncoding:      fc.GetContentEncoding().GetValue(),
+		Network:              fc.GetNetwork().GetValue(),
+		BeaconImplementation: fc.GetBeaconImplementation().GetValue(),
+		Reason:               fc.GetReason().GetValue(),
+	}
+}
+
+func DBForkChoiceToProtoForkChoice(fc *persistence.ForkChoice) *indexer.ForkChoice {
+	return &indexer.ForkChoice{
+		Id:   &wrapperspb.StringValue{Value: fc.ID},
+		Node: &wrapperspb.StringValue{Value: fc.Node},
+		//nolint:gosec // not worried about int64 overflow here
+		Slot: &wrapperspb.UInt64Value{Value: uint64(fc.Slot)},
+		//nolint:gosec // not worried about int64 overflow here
+		Epoch:                &wrapperspb.UInt64Value{Value: uint64(fc.Epoch)},
+		FetchedAt:            timestamppb.New(fc.FetchedAt),
+		NodeVersion:          &wrapperspb.StringValue{Value: fc.NodeVersion},
+		Location:             &wrapperspb.StringValue{Value: fc.Location},
+		Network:              &wrapperspb.StringValue{Value: fc.Network},
+		ContentEncoding:      &wrapperspb.StringValue{Value: fc.ContentEncoding},
+		BeaconImplementation: &wrapperspb.StringValue{Value: fc.BeaconImplementation},
+		Reason:               &wrapperspb.StringValue{Value: fc.Reason},
 	}
 }
 
@@ -168,7 +284,9 @@ func ProtoExecutionBlockTraceToDBExecutionBlockTrace(eb *indexer.ExecutionBlockT
 		Network:                 eb.GetNetwork().GetValue(),
 		ContentEncoding:         eb.GetContentEncoding().GetValue(),
 		ExecutionImplementation: eb.GetExecutionImplementation().GetValue(),
+		Tracer:                  eb.GetTracer().GetValue(),
 		ID:                      eb.GetId().GetValue(),
+		Canonical:               eb.GetCanonical() == nil || eb.GetCanonical().GetValue(),
 	}
 }
 
@@ -183,14 +301,16 @@ func DBExecutionBlockTraceToProtoExecutionBlockTrace(eb *persistence.ExecutionBl
 		Network:                 &wrapperspb.StringValue{Value: eb.Network},
 		ExecutionImplementation: &wrapperspb.StringValue{Value: eb.ExecutionImplementation},
 		ContentEncoding:         &wrapperspb.StringValue{Value: eb.ContentEncoding},
+		Tracer:                  &wrapperspb.StringValue{Value: eb.Tracer},
 		Id:                      &wrapperspb.StringValue{Value: eb.ID},
+		Canonical:               &wrapperspb.BoolValue{Value: eb.Canonical},
 	}
 }
 
-func ProtoExecutionBadBlockToDBExecutionBadBlock(eb *indexer.ExecutionBadBlock) *persistence.ExecutionBadBlock {
-	return &persistence.ExecutionBadBlock{
+func ProtoExecutionWitnessToDBExecutionWitness(eb *indexer.ExecutionWitness) *persistence.ExecutionWitness {
+	return &persistence.ExecutionWitness{
 		BlockHash:               eb.GetBlockHash().GetValue(),
-		BlockNumber:             sql.NullInt64{Int64: eb.GetBlockNumber().GetValue(), Valid: true},
+		BlockNumber:             eb.GetBlockNumber().GetValue(),
 		Node:                    eb.GetNode().GetValue(),
 		NodeVersion:             eb.GetNodeVersion().GetValue(),
 		FetchedAt:               eb.GetFetchedAt().AsTime(),
@@ -199,14 +319,13 @@ func ProtoExecutionBadBlockToDBExecutionBadBlock(eb *indexer.ExecutionBadBlock)
 		ContentEncoding:         eb.GetContentEncoding().GetValue(),
 		ExecutionImplementation: eb.GetExecutionImplementation().GetValue(),
 		ID:                      eb.GetId().GetValue(),
-		BlockExtraData:          sql.NullString{String: eb.GetBlockExtraData().GetValue(), Valid: true},
 	}
 }
 
-func DBExecutionBadBlockToProtoExecutionBadBlock(eb *persistence.ExecutionBadBlock) *indexer.ExecutionBadBlock {
-	return &indexer.ExecutionBadBlock{
+func DBExecutionWitnessToProtoExecutionWitness(eb *persistence.ExecutionWitness) *indexer.ExecutionWitness {
+	return &indexer.ExecutionWitness{
 		BlockHash:               &wrapperspb.StringValue{Value: eb.BlockHash},
-		BlockNumber:             &wrapperspb.Int64Value{Value: eb.BlockNumber.Int64},
+		BlockNumber:             &wrapperspb.Int64Value{Value: eb.BlockNumber},
 		Node:                    &wrapperspb.StringValue{Value: eb.Node},
 		NodeVersion:             &wrapperspb.StringValue{Value: eb.NodeVersion},
 		FetchedAt:               timestamppb.New(eb.FetchedAt),
@@ -215,7 +334,42 @@ func DBExecutionBadBlockToProtoExecutionBadBlock(eb *persistence.ExecutionBadBlo
 		ExecutionImplementation: &wrapperspb.StringValue{Value: eb.ExecutionImplementation},
 		ContentEncoding:         &wrapperspb.StringValue{Value: eb.ContentEncoding},
 		Id:                      &wrapperspb.StringValue{Value: eb.ID},
-		BlockExtraData:          &wrapperspb.StringValue{Value: eb.BlockExtraData.String},
+	}
+}
+
+func ProtoExecutionBadBlockToDBExecutionBadBlock(eb *indexer.ExecutionBadBlock) *persistence.ExecutionBadBlock {
+	return &persistence.ExecutionBadBlock{
+		BlockHash:                 eb.GetBlockHash().GetValue(),
+		BlockNumber:               sql.NullInt64{Int64: eb.GetBlockNumber().GetValue(), Valid: true},
+		Node:                      eb.GetNode().GetValue(),
+		NodeVersion:               eb.GetNodeVersion().GetValue(),
+		FetchedAt:                 eb.GetFetchedAt().AsTime(),
+		Location:                  eb.GetLocation().GetValue(),
+		Network:                   eb.GetNetwork().GetValue(),
+		ContentEncoding:           eb.GetContentEncoding().GetValue(),
+		ExecutionImplementation:   eb.GetExecutionImplementation().GetValue(),
+		ID:                        eb.GetId().GetValue(),
+		BlockExtraData:            sql.NullString{String: eb.GetBlockExtraData().GetValue(), Valid: true},
+		TraceLocation:             eb.GetTraceLocation().GetValue(),
+		IntermediateRootsLocation: eb.GetIntermediateRootsLocation().GetValue(),
+	}
+}
+
+func DBExecutionBadBlockToProtoExecutionBadBlock(eb *persistence.ExecutionBadBlock) *indexer.ExecutionBadBlock {
+	return &indexer.ExecutionBadBlock{
+		BlockHash:                 &wrapperspb.StringValue{Value: eb.BlockHash},
+		BlockNumber:               &wrapperspb.Int64Value{Value: eb.BlockNumber.Int64},
+		Node:                      &wrapperspb.StringValue{Value: eb.Node},
+		NodeVersion:               &wrapperspb.StringValue{Value: eb.NodeVersion},
+		FetchedAt:                 timestamppb.New(eb.FetchedAt),
+		Location:                  &wrapperspb.StringValue{Value: eb.Location},
+		Network:                   &wrapperspb.StringValue{Value: eb.Network},
+		ExecutionImplementation:   &wrapperspb.StringValue{Value: eb.ExecutionImplementation},
+		ContentEncoding:           &wrapperspb.StringValue{Value: eb.ContentEncoding},
+		Id:                        &wrapperspb.StringValue{Value: eb.ID},
+		BlockExtraData:            &wrapperspb.StringValue{Value: eb.BlockExtraData.String},
+		TraceLocation:             &wrapperspb.StringValue{Value: eb.TraceLocation},
+		IntermediateRootsLocation: &wrapperspb.StringValue{Value: eb.IntermediateRootsLocation},
 	}
 }
 
@@ -0,0 +1,113 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethpandaops/tracoor/pkg/server/persistence"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// divergenceComparisonPageSize is how many states are compared per query.
+const divergenceComparisonPageSize = 1000
+
+// detectBeaconStateDivergence compares a newly indexed beacon state against the
+// canonical states other nodes have indexed for the same network and slot, and
+// records a divergence for every distinct state root/implementation/version that
+// disagrees. States from orphaned forks are expected to differ, so they're never
+// compared, and divergences are deleted once either side is orphaned.
+func (i *Indexer) detectBeaconStateDivergence(ctx context.Context, state *persistence.BeaconState) error {
+	filter := &persistence.BeaconStateFilter{}
+
+	filter.AddNetwork(state.Network)
+	//nolint:gosec // not worried about int64 overflow here
+	filter.AddSlot(uint64(state.Slot))
+	filter.AddCanonical(true)
+
+	var others []*persistence.BeaconState
+
+	for offset := 0; ; offset += divergenceComparisonPageSize {
+		page, err := i.db.ListBeaconState(ctx, filter, &persistence.PaginationCursor{Limit: divergenceComparisonPageSize, Offset: offset, OrderBy: "fetched_at ASC"})
+		if err != nil {
+			return err
+		}
+
+		others = append(others, page...)
+
+		if len(page) < divergenceComparisonPageSize {
+			break
+		}
+	}
+
+	compared := 0
+	seen := make(map[string]bool)
+
+	for _, other := range others {
+		if other.ID == state.ID || other.Node == state.Node {
+			continue
+		}
+
+		compared++
+
+		if other.StateRoot == state.StateRoot {
+			continue
+		}
+
+		// Only record one divergence per distinct "side" so a single minority client
+		// doesn't produce a row for every node that agrees with the majority.
+		key := other.StateRoot + "/" + other.BeaconImplementation + "/" + other.NodeVersion
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		divergence := &persistence.BeaconStateDivergence{
+			ID:                              uuid.New().String(),
+			Network:                         state.Network,
+			Slot:                            state.Slot,
+			Epoch:                           state.Epoch,
+			DetectedAt:                      time.Now(),
+			BeaconStateID:                   state.ID,
+			Node:                            state.Node,
+			StateRoot:                       state.StateRoot,
+			BeaconImplementation:            state.BeaconImplementation,
+			NodeVersion:                     state.NodeVersion,
+			ConflictingBeaconStateID:        other.ID,
+			ConflictingNode:                 other.Node,
+			ConflictingStateRoot:            other.StateRoot,
+			ConflictingBeaconImplementation: other.BeaconImplementation,
+			ConflictingNodeVersion:          other.NodeVersion,
+		}
+
+		inserted, err := i.db.InsertBeaconStateDivergence(ctx, divergence)
+		if err != nil {
+			return err
+		}
+
+		// The other state's own check already recorded this pair.
+		if !inserted {
+			continue
+		}
+
+		i.metrics.IncrementStateDivergences(state.Network, state.BeaconImplementation, other.BeaconImplementation)
+
+		i.log.WithFields(logrus.Fields{
+			KeyNetwork:                          state.Network,
+			KeySlot:                             state.Slot,
+			KeyNode:                             state.Node,
+			KeyStateRoot:                        state.StateRoot,
+			KeyBeaconImplementation:             state.BeaconImplementation,
+			KeyNodeVersion:                      state.NodeVersion,
+			"conflicting_node":                  other.Node,
+			"conflicting_state_root":            other.StateRoot,
+			"conflicting_beacon_implementation": other.BeaconImplementation,
+			"conflicting_node_version":          other.NodeVersion,
+		}).Warn("Beacon state root divergence detected")
+	}
+
+	i.metrics.IncrementStateComparisons(state.Network, compared)
+
+	return nil
+}
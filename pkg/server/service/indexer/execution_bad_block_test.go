@@ -9,6 +9,8 @@ import (
 	"github.com/ethpandaops/tracoor/pkg/compression"
 	pindexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
 	"github.com/ethpandaops/tracoor/pkg/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -105,8 +107,8 @@ func TestIndexerExecutionBadBlock(t *testing.T) {
 		}
 
 		_, err = index.CreateExecutionBadBlock(ctx, req)
-		if err != nil && err.Error() != "execution bad block already exists" {
-			t.Fatal("expected error to be 'execution bad block already exists'")
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected an AlreadyExists error, got %v", err)
 		}
 	})
 
@@ -128,6 +130,35 @@ func TestIndexerExecutionBadBlock(t *testing.T) {
 		}
 	})
 
+	t.Run("Links trace locations", func(t *testing.T) {
+		req := createRandomExecutionBadBlockRequest()
+		req.TraceLocation = wrapperspb.String(generateRandomString(10))
+
+		resp, err := index.CreateExecutionBadBlock(ctx, req)
+		if err != nil {
+			t.Fatalf("failed to create execution bad block: %v", err)
+		}
+
+		rsp, err := index.ListExecutionBadBlock(ctx, &pindexer.ListExecutionBadBlockRequest{Id: resp.Id.Value})
+		if err != nil {
+			t.Fatalf("failed to get execution bad block: %v", err)
+		}
+
+		if len(rsp.ExecutionBadBlocks) != 1 {
+			t.Fatalf("expected 1 execution bad block, got %d", len(rsp.ExecutionBadBlocks))
+		}
+
+		block := rsp.ExecutionBadBlocks[0]
+
+		if block.GetTraceLocation().GetValue() != req.TraceLocation.Value {
+			t.Fatalf("expected trace location %s, got %s", req.TraceLocation.Value, block.GetTraceLocation().GetValue())
+		}
+
+		if block.GetIntermediateRootsLocation().GetValue() != "" {
+			t.Fatalf("expected no intermediate roots location, got %s", block.GetIntermediateRootsLocation().GetValue())
+		}
+	})
+
 	t.Run("Can list by filters", func(t *testing.T) {
 		req := createRandomExecutionBadBlockRequest()
 
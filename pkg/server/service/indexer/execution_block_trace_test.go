@@ -9,6 +9,8 @@ import (
 	"github.com/ethpandaops/tracoor/pkg/compression"
 	pindexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
 	"github.com/ethpandaops/tracoor/pkg/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -23,6 +25,7 @@ func createRandomExecutionBlockTraceRequest() *pindexer.CreateExecutionBlockTrac
 		Network:                 wrapperspb.String(generateRandomString(5)),
 		ExecutionImplementation: wrapperspb.String(generateRandomString(15)),
 		NodeVersion:             wrapperspb.String(generateRandomString(8)),
+		Tracer:                  wrapperspb.String(generateRandomString(10)),
 	}
 }
 
@@ -104,8 +107,8 @@ func TestIndexerExecutionBlockTrace(t *testing.T) {
 		}
 
 		_, err = index.CreateExecutionBlockTrace(ctx, req)
-		if err != nil && err.Error() != "execution block trace already exists" {
-			t.Fatal("expected error to be 'execution block trace already exists'")
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected an AlreadyExists error, got %v", err)
 		}
 	})
 
@@ -239,6 +242,25 @@ func TestIndexerExecutionBlockTrace(t *testing.T) {
 				t.Fatal("expected at least one execution block trace filtered by node version")
 			}
 		})
+
+		t.Run("by Tracer", func(t *testing.T) {
+			filterReq := &pindexer.ListExecutionBlockTraceRequest{
+				Tracer: req.Tracer.Value,
+			}
+
+			rsp, err := index.ListExecutionBlockTrace(ctx, filterReq)
+			if err != nil {
+				t.Fatalf("failed to list execution block traces by tracer: %v", err)
+			}
+
+			if len(rsp.ExecutionBlockTraces) != 1 {
+				t.Fatalf("expected one execution block trace filtered by tracer, got %d", len(rsp.ExecutionBlockTraces))
+			}
+
+			if rsp.ExecutionBlockTraces[0].GetTracer().GetValue() != req.Tracer.Value {
+				t.Fatalf("expected tracer %s, got %s", req.Tracer.Value, rsp.ExecutionBlockTraces[0].GetTracer().GetValue())
+			}
+		})
 	})
 }
 
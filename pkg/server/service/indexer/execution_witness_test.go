@@ -0,0 +1,329 @@
+package indexer
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	pindexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func createRandomExecutionWitnessRequest() *pindexer.CreateExecutionWitnessRequest {
+	return &pindexer.CreateExecutionWitnessRequest{
+		Node:                    wrapperspb.String(generateRandomString(5)),
+		FetchedAt:               timestamppb.Now(),
+		BlockHash:               wrapperspb.String(generateRandomString(64)),
+		BlockNumber:             wrapperspb.Int64(generateRandomInt64()),
+		Location:                wrapperspb.String(generateRandomString(10)),
+		Network:                 wrapperspb.String(generateRandomString(5)),
+		ExecutionImplementation: wrapperspb.String(generateRandomString(15)),
+		NodeVersion:             wrapperspb.String(generateRandomString(8)),
+	}
+}
+
+func TestIndexerExecutionWitnessCount(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	t.Run("Counting", func(t *testing.T) {
+		_, err := index.CreateExecutionWitness(ctx, createRandomExecutionWitnessRequest())
+		if err != nil {
+			t.Fatalf("failed to create execution witness: %v", err)
+		}
+
+		rsp, err := index.CountExecutionWitness(ctx, &pindexer.CountExecutionWitnessRequest{})
+		if err != nil {
+			t.Fatalf("failed to count execution witness: %v", err)
+		}
+
+		if rsp.Count.Value != uint64(1) {
+			t.Fatalf("expected 1 execution witness, got %d", rsp.Count.Value)
+		}
+	})
+}
+
+func TestIndexerExecutionWitness(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	t.Run("Creating", func(t *testing.T) {
+		_, err := index.CreateExecutionWitness(ctx, createRandomExecutionWitnessRequest())
+		if err != nil {
+			t.Fatalf("failed to create execution witness: %v", err)
+		}
+	})
+
+	t.Run("Creating returns a valid ID", func(t *testing.T) {
+		rsp, err := index.CreateExecutionWitness(ctx, createRandomExecutionWitnessRequest())
+		if err != nil {
+			t.Fatalf("failed to create execution witness: %v", err)
+		}
+
+		if rsp.Id == nil {
+			t.Fatalf("expected ID to not be empty")
+		}
+	})
+
+	t.Run("Handles duplicates", func(t *testing.T) {
+		req := createRandomExecutionWitnessRequest()
+
+		rsp, err := index.CreateExecutionWitness(ctx, req)
+		if err != nil {
+			t.Fatalf("failed to create execution witness: %v", err)
+		}
+
+		if rsp.Id == nil {
+			t.Fatalf("expected ID to not be empty")
+		}
+
+		_, err = index.CreateExecutionWitness(ctx, req)
+		if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected an AlreadyExists error, got %v", err)
+		}
+	})
+
+	t.Run("Basic Listing", func(t *testing.T) {
+		req := createRandomExecutionWitnessRequest()
+
+		resp, err := index.CreateExecutionWitness(ctx, req)
+		if err != nil {
+			t.Fatalf("failed to create execution witness: %v", err)
+		}
+
+		rsp, err := index.ListExecutionWitness(ctx, &pindexer.ListExecutionWitnessRequest{Id: resp.Id.Value})
+		if err != nil {
+			t.Fatalf("failed to get execution witness: %v", err)
+		}
+
+		if len(rsp.ExecutionWitnesses) != 1 {
+			t.Fatalf("expected 1 execution witness, got %d", len(rsp.ExecutionWitnesses))
+		}
+	})
+
+	t.Run("Can list by filters", func(t *testing.T) {
+		req := createRandomExecutionWitnessRequest()
+
+		_, err := index.CreateExecutionWitness(ctx, req)
+		if err != nil {
+			t.Fatalf("failed to create execution witness: %v", err)
+		}
+
+		t.Run("by Node", func(t *testing.T) {
+			filterReq := &pindexer.ListExecutionWitnessRequest{
+				Node: req.Node.Value,
+			}
+
+			rsp, err := index.ListExecutionWitness(ctx, filterReq)
+			if err != nil {
+				t.Fatalf("failed to list execution witnesses by node: %v", err)
+			}
+
+			if len(rsp.ExecutionWitnesses) == 0 {
+				t.Fatal("expected at least one execution witness filtered by node")
+			}
+		})
+
+		t.Run("by BlockNumber", func(t *testing.T) {
+			filterReq := &pindexer.ListExecutionWitnessRequest{
+				BlockNumber: req.BlockNumber.Value,
+			}
+
+			rsp, err := index.ListExecutionWitness(ctx, filterReq)
+			if err != nil {
+				t.Fatalf("failed to list execution witnesses by block number: %v", err)
+			}
+
+			if len(rsp.ExecutionWitnesses) == 0 {
+				t.Fatal("expected at least one execution witness filtered by block number")
+			}
+		})
+
+		t.Run("by BlockHash", func(t *testing.T) {
+			filterReq := &pindexer.ListExecutionWitnessRequest{
+				BlockHash: req.BlockHash.Value,
+			}
+
+			rsp, err := index.ListExecutionWitness(ctx, filterReq)
+			if err != nil {
+				t.Fatalf("failed to list execution witnesses by block hash: %v", err)
+			}
+
+			if len(rsp.ExecutionWitnesses) == 0 {
+				t.Fatal("expected at least one execution witness filtered by block hash")
+			}
+		})
+
+		t.Run("by Location", func(t *testing.T) {
+			filterReq := &pindexer.ListExecutionWitnessRequest{
+				Location: req.Location.Value,
+			}
+
+			rsp, err := index.ListExecutionWitness(ctx, filterReq)
+			if err != nil {
+				t.Fatalf("failed to list execution witnesses by location: %v", err)
+			}
+
+			if len(rsp.ExecutionWitnesses) == 0 {
+				t.Fatal("expected at least one execution witness filtered by location")
+			}
+		})
+
+		t.Run("by Network", func(t *testing.T) {
+			filterReq := &pindexer.ListExecutionWitnessRequest{
+				Network: req.Network.Value,
+			}
+
+			rsp, err := index.ListExecutionWitness(ctx, filterReq)
+			if err != nil {
+				t.Fatalf("failed to list execution witnesses by network: %v", err)
+			}
+
+			if len(rsp.ExecutionWitnesses) == 0 {
+				t.Fatal("expected at least one execution witness filtered by network")
+			}
+		})
+
+		t.Run("by ExecutionImplementation", func(t *testing.T) {
+			filterReq := &pindexer.ListExecutionWitnessRequest{
+				ExecutionImplementation: req.ExecutionImplementation.Value,
+			}
+
+			rsp, err := index.ListExecutionWitness(ctx, filterReq)
+			if err != nil {
+				t.Fatalf("failed to list execution witnesses by execution implementation: %v", err)
+			}
+
+			if len(rsp.ExecutionWitnesses) == 0 {
+				t.Fatal("expected at least one execution witness filtered by execution implementation")
+			}
+		})
+
+		t.Run("by NodeVersion", func(t *testing.T) {
+			filterReq := &pindexer.ListExecutionWitnessRequest{
+				NodeVersion: req.NodeVersion.Value,
+			}
+
+			rsp, err := index.ListExecutionWitness(ctx, filterReq)
+			if err != nil {
+				t.Fatalf("failed to list execution witnesses by node version: %v", err)
+			}
+
+			if len(rsp.ExecutionWitnesses) == 0 {
+				t.Fatal("expected at least one execution witness filtered by node version")
+			}
+		})
+	})
+}
+
+func TestIndexerExecutionWitnessDownloading(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	t.Run("Downloading", func(t *testing.T) {
+		data := []byte("{\"key\": \"value\"}")
+
+		compressor := compression.NewCompressor()
+
+		compressedData, err := compressor.Compress(&data, compression.Gzip)
+		if err != nil {
+			t.Fatalf("failed to compress data: %v", err)
+		}
+
+		location, err := index.Store().SaveExecutionWitness(ctx, &store.SaveParams{
+			Data:            &compressedData,
+			Location:        "data.json",
+			ContentEncoding: compression.Gzip.ContentEncoding,
+		})
+		if err != nil {
+			t.Fatalf("failed to save execution witness: %v", err)
+		}
+
+		req := createRandomExecutionWitnessRequest()
+		req.Location = wrapperspb.String(location)
+
+		resp, err := index.CreateExecutionWitness(ctx, req)
+		if err != nil {
+			t.Fatalf("failed to create execution witness: %v", err)
+		}
+
+		// List it
+		rsp, err := index.ListExecutionWitness(ctx, &pindexer.ListExecutionWitnessRequest{Id: resp.Id.Value})
+		if err != nil {
+			t.Fatalf("failed to get execution witness: %v", err)
+		}
+
+		url, err := index.Store().GetExecutionWitnessURL(ctx, &store.GetURLParams{
+			Location:        rsp.ExecutionWitnesses[0].Location.GetValue(),
+			Expiry:          60,
+			ContentEncoding: rsp.ExecutionWitnesses[0].ContentEncoding.GetValue(),
+		})
+		if err != nil {
+			t.Fatalf("failed to get execution witness URL: %v", err)
+		}
+
+		if url == "" {
+			t.Fatalf("expected URL to not be empty")
+		}
+
+		// Download it via http
+		if resp, err := http.Get(url); err != nil {
+			t.Fatalf("failed to download execution witness: %v", err)
+		} else {
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("expected status code to be 200, got %d", resp.StatusCode)
+			}
+
+			// Check the body contents
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("failed to read response body: %v", err)
+			}
+
+			if len(body) == 0 {
+				t.Fatalf("expected body to not be empty")
+			}
+		}
+	})
+}
@@ -0,0 +1,198 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	pindexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func createRandomForkChoiceRequest() *pindexer.CreateForkChoiceRequest {
+	return &pindexer.CreateForkChoiceRequest{
+		Node:                 wrapperspb.String(generateRandomString(5)),
+		Slot:                 wrapperspb.UInt64(uint64(generateRandomInt64())),
+		Epoch:                wrapperspb.UInt64(uint64(generateRandomInt64())),
+		FetchedAt:            timestamppb.Now(),
+		BeaconImplementation: wrapperspb.String(generateRandomString(15)),
+		NodeVersion:          wrapperspb.String(generateRandomString(8)),
+		Location:             wrapperspb.String(generateRandomString(10)),
+		Network:              wrapperspb.String(generateRandomString(5)),
+		Reason:               wrapperspb.String("chain_reorg"),
+	}
+}
+
+func TestIndexerForkChoiceCount(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	t.Run("Counting", func(t *testing.T) {
+		_, err := index.CreateForkChoice(ctx, createRandomForkChoiceRequest())
+		if err != nil {
+			t.Fatalf("failed to create fork choice: %v", err)
+		}
+
+		rsp, err := index.CountForkChoice(ctx, &pindexer.CountForkChoiceRequest{})
+		if err != nil {
+			t.Fatalf("failed to count fork choice: %v", err)
+		}
+
+		if rsp.Count.Value != uint64(1) {
+			t.Fatalf("expected 1 fork choice, got %d", rsp.Count.Value)
+		}
+	})
+}
+
+func TestIndexerForkChoiceDownloading(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	t.Run("Downloading", func(t *testing.T) {
+		data := []byte("{\"key\": \"value\"}")
+
+		compressor := compression.NewCompressor()
+
+		compressedData, err := compressor.Compress(&data, compression.Gzip)
+		if err != nil {
+			t.Fatalf("failed to compress data: %v", err)
+		}
+
+		location, err := index.Store().SaveForkChoice(ctx, &store.SaveParams{
+			Data:            &compressedData,
+			Location:        "data.json",
+			ContentEncoding: compression.Gzip.ContentEncoding,
+		})
+		if err != nil {
+			t.Fatalf("failed to save fork choice: %v", err)
+		}
+
+		req := createRandomForkChoiceRequest()
+		req.Location = wrapperspb.String(location)
+
+		resp, err := index.CreateForkChoice(ctx, req)
+		if err != nil {
+			t.Fatalf("failed to create fork choice: %v", err)
+		}
+
+		rsp, err := index.ListForkChoice(ctx, &pindexer.ListForkChoiceRequest{Id: resp.Id.Value})
+		if err != nil {
+			t.Fatalf("failed to get fork choice: %v", err)
+		}
+
+		url, err := index.Store().GetForkChoiceURL(ctx, &store.GetURLParams{
+			Location:        rsp.ForkChoices[0].Location.GetValue(),
+			Expiry:          60,
+			ContentEncoding: rsp.ForkChoices[0].ContentEncoding.GetValue(),
+		})
+		if err != nil {
+			t.Fatalf("failed to get fork choice URL: %v", err)
+		}
+
+		if url == "" {
+			t.Fatalf("expected URL to not be empty")
+		}
+	})
+}
+
+func TestIndexerForkChoice(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	t.Run("Creating requires a reason", func(t *testing.T) {
+		req := createRandomForkChoiceRequest()
+		req.Reason = nil
+
+		if _, err := index.CreateForkChoice(ctx, req); err == nil {
+			t.Fatal("expected error when reason is missing")
+		}
+	})
+
+	t.Run("Repeated captures of the same slot are stored separately", func(t *testing.T) {
+		req := createRandomForkChoiceRequest()
+
+		if _, err := index.CreateForkChoice(ctx, req); err != nil {
+			t.Fatalf("failed to create fork choice: %v", err)
+		}
+
+		req.FetchedAt = timestamppb.Now()
+		req.Reason = wrapperspb.String("manual")
+
+		if _, err := index.CreateForkChoice(ctx, req); err != nil {
+			t.Fatalf("failed to create second fork choice: %v", err)
+		}
+
+		rsp, err := index.ListForkChoice(ctx, &pindexer.ListForkChoiceRequest{
+			Node: req.Node.Value,
+			Slot: req.Slot.Value,
+		})
+		if err != nil {
+			t.Fatalf("failed to list fork choices: %v", err)
+		}
+
+		if len(rsp.ForkChoices) != 2 {
+			t.Fatalf("expected 2 fork choices, got %d", len(rsp.ForkChoices))
+		}
+	})
+
+	t.Run("Can list by reason", func(t *testing.T) {
+		rsp, err := index.ListForkChoice(ctx, &pindexer.ListForkChoiceRequest{Reason: "manual"})
+		if err != nil {
+			t.Fatalf("failed to list fork choices by reason: %v", err)
+		}
+
+		if len(rsp.ForkChoices) != 1 {
+			t.Fatalf("expected 1 fork choice, got %d", len(rsp.ForkChoices))
+		}
+	})
+
+	t.Run("Can list unique reasons", func(t *testing.T) {
+		rsp, err := index.ListUniqueForkChoiceValues(ctx, &pindexer.ListUniqueForkChoiceValuesRequest{
+			Fields: []pindexer.ListUniqueForkChoiceValuesRequest_Field{
+				pindexer.ListUniqueForkChoiceValuesRequest_REASON,
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to list unique fork choice values: %v", err)
+		}
+
+		if len(rsp.Reason) != 2 {
+			t.Fatalf("expected 2 reasons, got %d", len(rsp.Reason))
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/server/persistence"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultIndexedKeysLimit = 10000
+	maxIndexedKeysLimit     = 50000
+)
+
+// ListIndexedKeys returns the keys of the beacon states, beacon blocks, blob
+// sidecars, execution block traces and execution witnesses a node indexed
+// since the given time. Agents use it to seed their index cache on startup.
+func (i *Indexer) ListIndexedKeys(ctx context.Context, req *indexer.ListIndexedKeysRequest) (*indexer.ListIndexedKeysResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultIndexedKeysLimit
+	}
+
+	if limit > maxIndexedKeysLimit {
+		limit = maxIndexedKeysLimit
+	}
+
+	lists := []struct {
+		dataType store.DataType
+		list     func(ctx context.Context, node, network string, since time.Time, limit int) ([]persistence.IndexedKey, error)
+	}{
+		{store.BeaconStateDataType, i.db.ListBeaconStateKeys},
+		{store.BeaconBlockDataType, i.db.ListBeaconBlockKeys},
+		{store.BlobSidecarDataType, i.db.ListBlobSidecarKeys},
+		{store.BlockTraceDataType, i.db.ListExecutionBlockTraceKeys},
+		{store.ExecutionWitnessDataType, i.db.ListExecutionWitnessKeys},
+	}
+
+	rsp := &indexer.ListIndexedKeysResponse{}
+
+	for _, l := range lists {
+		keys, err := l.list(ctx, req.GetNode(), req.GetNetwork(), req.GetSince().AsTime(), limit)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		for _, key := range keys {
+			rsp.Keys = append(rsp.Keys, &indexer.IndexedKey{
+				Type:    string(l.dataType),
+				Root:    key.Root,
+				Variant: key.Variant,
+			})
+		}
+	}
+
+	i.log.WithFields(logrus.Fields{
+		KeyNode:    req.GetNode(),
+		KeyNetwork: req.GetNetwork(),
+		"keys":     len(rsp.Keys),
+	}).Debug("Listed indexed keys")
+
+	return rsp, nil
+}
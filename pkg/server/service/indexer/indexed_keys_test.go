@@ -0,0 +1,99 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pindexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestIndexerListIndexedKeys(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	node := generateRandomString(5)
+	network := generateRandomString(5)
+
+	block := createStoredBeaconBlockRequest(ctx, t, index)
+	block.Node = wrapperspb.String(node)
+	block.Network = wrapperspb.String(network)
+
+	trace := createRandomExecutionBlockTraceRequest()
+	trace.Node = wrapperspb.String(node)
+	trace.Network = wrapperspb.String(network)
+	trace.Tracer = wrapperspb.String("callTracer")
+
+	if _, err := index.CreateBeaconBlock(ctx, block); err != nil {
+		t.Fatalf("failed to create beacon block: %v", err)
+	}
+
+	if _, err := index.CreateExecutionBlockTrace(ctx, trace); err != nil {
+		t.Fatalf("failed to create execution block trace: %v", err)
+	}
+
+	t.Run("Requires since", func(t *testing.T) {
+		if _, err := index.ListIndexedKeys(ctx, &pindexer.ListIndexedKeysRequest{Node: node, Network: network}); err == nil {
+			t.Fatal("expected error when since is missing")
+		}
+	})
+
+	t.Run("Lists the node's keys", func(t *testing.T) {
+		rsp, err := index.ListIndexedKeys(ctx, &pindexer.ListIndexedKeysRequest{
+			Node:    node,
+			Network: network,
+			Since:   timestamppb.New(time.Now().Add(-time.Hour)),
+		})
+		if err != nil {
+			t.Fatalf("failed to list indexed keys: %v", err)
+		}
+
+		if len(rsp.Keys) != 2 {
+			t.Fatalf("expected 2 keys, got %d", len(rsp.Keys))
+		}
+
+		for _, key := range rsp.Keys {
+			switch key.Type {
+			case string(store.BeaconBlockDataType):
+				if key.Root != block.BlockRoot.Value {
+					t.Fatalf("expected block root %s, got %s", block.BlockRoot.Value, key.Root)
+				}
+			case string(store.BlockTraceDataType):
+				if key.Root != trace.BlockHash.Value || key.Variant != "callTracer" {
+					t.Fatalf("unexpected execution block trace key %v", key)
+				}
+			default:
+				t.Fatalf("unexpected key type %s", key.Type)
+			}
+		}
+	})
+
+	t.Run("Excludes other nodes", func(t *testing.T) {
+		rsp, err := index.ListIndexedKeys(ctx, &pindexer.ListIndexedKeysRequest{
+			Node:    "another-node",
+			Network: network,
+			Since:   timestamppb.New(time.Now().Add(-time.Hour)),
+		})
+		if err != nil {
+			t.Fatalf("failed to list indexed keys: %v", err)
+		}
+
+		if len(rsp.Keys) != 0 {
+			t.Fatalf("expected no keys, got %d", len(rsp.Keys))
+		}
+	})
+}
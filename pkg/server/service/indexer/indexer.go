@@ -2,12 +2,14 @@ package indexer
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
 	"github.com/ethpandaops/tracoor/pkg/server/ethereum"
 	"github.com/ethpandaops/tracoor/pkg/server/persistence"
 	"github.com/ethpandaops/tracoor/pkg/store"
+	"github.com/ethpandaops/tracoor/pkg/tracediff"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -33,6 +35,9 @@ const (
 	KeyLocation                = "location"
 	KeyFetchedAt               = "fetched_at"
 	KeyBeaconImplementation    = "beacon_implementation"
+	KeyTracer                  = "tracer"
+	KeySidecarType             = "sidecar_type"
+	KeyReason                  = "reason"
 )
 
 type Indexer struct {
@@ -49,6 +54,8 @@ type Indexer struct {
 	ethereumConfig *ethereum.Config
 
 	permanentStore *PermanentStore
+
+	metrics *Metrics
 }
 
 func NewIndexer(ctx context.Context, log logrus.FieldLogger, conf *Config, db *persistence.Indexer, st store.Store, ethereumConfig *ethereum.Config) (*Indexer, error) {
@@ -67,11 +74,29 @@ func NewIndexer(ctx context.Context, log logrus.FieldLogger, conf *Config, db *p
 		config:         conf,
 		ethereumConfig: ethereumConfig,
 		permanentStore: permanentStore,
+		metrics:        GetMetricsInstance("tracoor_server_indexer"),
 	}
 
 	return i, nil
 }
 
+// errNotStored is returned when an item is indexed before it's saved to the store.
+var errNotStored = errors.New("not found in the store")
+
+// checkStored returns errNotStored if nothing is saved at location.
+func (i *Indexer) checkStored(ctx context.Context, location string) error {
+	exists, err := i.store.Exists(ctx, location)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errNotStored
+	}
+
+	return nil
+}
+
 func (i *Indexer) Start(ctx context.Context, grpcServer *grpc.Server) error {
 	i.log.Info("Starting module")
 
@@ -165,35 +190,19 @@ func (i *Indexer) CreateBeaconState(ctx context.Context, req *indexer.CreateBeac
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Check the store for the state
-	exists, err := i.store.Exists(ctx, req.GetLocation().GetValue())
+	existing, err := i.indexedBeaconState(ctx, req)
 	if err != nil {
-		i.log.
-			WithError(err).
-			WithField(KeyLocation, req.GetLocation().GetValue()).
-			WithField(KeyNode, req.GetNode().GetValue()).
-			Error("Failed to index a beacon state because the state could not be found in the store. Check that the agent and server are pointed at the same storage backend.")
-
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	if exists {
-		// Check if the state is already indexed
-		filter := &persistence.BeaconStateFilter{}
-
-		filter.AddNetwork(req.GetNetwork().GetValue())
-		filter.AddSlot(req.GetSlot().GetValue())
-		filter.AddStateRoot(req.GetStateRoot().GetValue())
-		filter.AddNode(req.GetNode().GetValue())
-
-		states, err := i.db.ListBeaconState(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
-		}
-
-		if len(states) > 0 {
+	if existing != nil {
+		if !req.GetFinalized().GetValue() {
 			return nil, status.Error(codes.AlreadyExists, "beacon state already indexed")
 		}
+
+		// The state was captured before it was finalized, so flag the
+		// existing entry instead of indexing it twice.
+		return i.markBeaconStateFinalized(ctx, existing, req.GetCheckpointEpoch().GetValue())
 	}
 
 	// Create the state
@@ -209,6 +218,8 @@ func (i *Indexer) CreateBeaconState(ctx context.Context, req *indexer.CreateBeac
 		Location:             req.GetLocation(),
 		FetchedAt:            req.GetFetchedAt(),
 		BeaconImplementation: req.GetBeaconImplementation(),
+		Finalized:            req.GetFinalized(),
+		CheckpointEpoch:      req.GetCheckpointEpoch(),
 	}
 
 	if err := state.Validate(); err != nil {
@@ -228,12 +239,28 @@ func (i *Indexer) CreateBeaconState(ctx context.Context, req *indexer.CreateBeac
 		KeyBeaconImplementation: req.GetBeaconImplementation().GetValue(),
 	}
 
-	if err := i.db.InsertBeaconState(ctx, ProtoBeaconStateToDBBeaconState(state)); err != nil {
+	dbState := ProtoBeaconStateToDBBeaconState(state)
+
+	if err := i.db.InsertBeaconStateReference(ctx, dbState, func() error {
+		return i.checkStored(ctx, dbState.Location)
+	}); err != nil {
+		if errors.Is(err, errNotStored) {
+			i.log.
+				WithFields(logFields).
+				Error("Failed to index a beacon state because the state could not be found in the store. Check that the agent and server are pointed at the same storage backend.")
+
+			return nil, status.Error(codes.FailedPrecondition, "beacon state not found in the store")
+		}
+
 		i.log.WithError(err).WithFields(logFields).Error("Failed to index state")
 
 		return nil, status.Error(codes.Internal, "failed to index state")
 	}
 
+	if err := i.detectBeaconStateDivergence(ctx, dbState); err != nil {
+		i.log.WithError(err).WithFields(logFields).Error("Failed to check beacon state for divergence")
+	}
+
 	i.log.WithFields(logFields).WithField("id", state.GetId().GetValue()).Debug("Indexed beacon state")
 
 	return &indexer.CreateBeaconStateResponse{
@@ -241,6 +268,57 @@ func (i *Indexer) CreateBeaconState(ctx context.Context, req *indexer.CreateBeac
 	}, nil
 }
 
+// indexedBeaconState returns the node's existing entry for the beacon state,
+// or nil if it isn't indexed yet.
+func (i *Indexer) indexedBeaconState(ctx context.Context, req *indexer.CreateBeaconStateRequest) (*persistence.BeaconState, error) {
+	filter := &persistence.BeaconStateFilter{}
+
+	filter.AddNetwork(req.GetNetwork().GetValue())
+	filter.AddSlot(req.GetSlot().GetValue())
+	filter.AddStateRoot(req.GetStateRoot().GetValue())
+	filter.AddNode(req.GetNode().GetValue())
+
+	states, err := i.db.ListBeaconState(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(states) == 0 {
+		return nil, nil
+	}
+
+	return states[0], nil
+}
+
+func (i *Indexer) markBeaconStateFinalized(ctx context.Context, state *persistence.BeaconState, checkpointEpoch uint64) (*indexer.CreateBeaconStateResponse, error) {
+	//nolint:gosec // not worried about int64 overflow here
+	epoch := int64(checkpointEpoch)
+
+	if state.Finalized && state.CheckpointEpoch.Valid && state.CheckpointEpoch.Int64 == epoch {
+		return nil, status.Error(codes.AlreadyExists, "beacon state already indexed")
+	}
+
+	state.Finalized = true
+	state.CheckpointEpoch = sql.NullInt64{Int64: epoch, Valid: true}
+
+	if err := i.db.UpdateBeaconState(ctx, state); err != nil {
+		i.log.WithError(err).WithField("id", state.ID).Error("Failed to mark beacon state as finalized")
+
+		return nil, status.Error(codes.Internal, "failed to mark state as finalized")
+	}
+
+	i.log.
+		WithField("id", state.ID).
+		WithField(KeyNode, state.Node).
+		WithField(KeySlot, state.Slot).
+		WithField("checkpoint_epoch", checkpointEpoch).
+		Debug("Marked beacon state as finalized")
+
+	return &indexer.CreateBeaconStateResponse{
+		Id: wrapperspb.String(state.ID),
+	}, nil
+}
+
 func (i *Indexer) ListBeaconState(ctx context.Context, req *indexer.ListBeaconStateRequest) (*indexer.ListBeaconStateResponse, error) {
 	filter := &persistence.BeaconStateFilter{}
 
@@ -288,6 +366,18 @@ func (i *Indexer) ListBeaconState(ctx context.Context, req *indexer.ListBeaconSt
 		filter.AddBeaconImplementation(req.BeaconImplementation)
 	}
 
+	if req.Finalized != nil {
+		filter.AddFinalized(req.Finalized.GetValue())
+	}
+
+	if req.CheckpointEpoch != 0 {
+		filter.AddCheckpointEpoch(req.CheckpointEpoch)
+	}
+
+	if req.Canonical != nil {
+		filter.AddCanonical(req.Canonical.GetValue())
+	}
+
 	pagination := &persistence.PaginationCursor{
 		Limit:   1000,
 		Offset:  0,
@@ -360,6 +450,18 @@ func (i *Indexer) CountBeaconState(ctx context.Context, req *indexer.CountBeacon
 		filter.AddBeaconImplementation(req.BeaconImplementation)
 	}
 
+	if req.Finalized != nil {
+		filter.AddFinalized(req.Finalized.GetValue())
+	}
+
+	if req.CheckpointEpoch != 0 {
+		filter.AddCheckpointEpoch(req.CheckpointEpoch)
+	}
+
+	if req.Canonical != nil {
+		filter.AddCanonical(req.Canonical.GetValue())
+	}
+
 	beaconStates, err := i.db.CountBeaconState(ctx, filter)
 	if err != nil {
 		return nil, err
@@ -418,40 +520,73 @@ func (i *Indexer) ListUniqueBeaconStateValues(ctx context.Context, req *indexer.
 	return response, nil
 }
 
-func (i *Indexer) CreateBeaconBlock(ctx context.Context, req *indexer.CreateBeaconBlockRequest) (*indexer.CreateBeaconBlockResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+func (i *Indexer) ListStateDivergences(ctx context.Context, req *indexer.ListStateDivergencesRequest) (*indexer.ListStateDivergencesResponse, error) {
+	filter := &persistence.BeaconStateDivergenceFilter{}
+
+	if req.Id != "" {
+		filter.AddID(req.Id)
 	}
 
-	// Check the store for the block
-	exists, err := i.store.Exists(ctx, req.GetLocation().GetValue())
-	if err != nil {
-		i.log.
-			WithError(err).
-			WithField(KeyLocation, req.GetLocation().GetValue()).
-			WithField(KeyNode, req.GetNode().GetValue()).
-			Error("Failed to index a beacon block because the block could not be found in the store. Check that the agent and server are pointed at the same storage backend.")
+	if req.Network != "" {
+		filter.AddNetwork(req.Network)
+	}
 
-		return nil, status.Error(codes.Internal, err.Error())
+	if req.Slot != 0 {
+		filter.AddSlot(req.Slot)
 	}
 
-	if exists {
-		// Check if the block is already indexed
-		filter := &persistence.BeaconBlockFilter{}
+	if req.Epoch != 0 {
+		filter.AddEpoch(req.Epoch)
+	}
 
-		filter.AddNetwork(req.GetNetwork().GetValue())
-		filter.AddSlot(req.GetSlot().GetValue())
-		filter.AddBlockRoot(req.GetBlockRoot().GetValue())
-		filter.AddNode(req.GetNode().GetValue())
+	if req.Node != "" {
+		filter.AddNode(req.Node)
+	}
 
-		blocks, err := i.db.ListBeaconBlock(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+	if req.BeaconImplementation != "" {
+		filter.AddBeaconImplementation(req.BeaconImplementation)
+	}
 
-		if len(blocks) > 0 {
-			return nil, status.Error(codes.AlreadyExists, "beacon block already indexed")
-		}
+	if req.Before != nil {
+		filter.AddBefore(req.Before.AsTime())
+	}
+
+	if req.After != nil {
+		filter.AddAfter(req.After.AsTime())
+	}
+
+	pagination := &persistence.PaginationCursor{
+		Limit:   1000,
+		Offset:  0,
+		OrderBy: "detected_at DESC",
+	}
+
+	if req.Pagination != nil {
+		pagination = ProtoPaginationCursorToDBPaginationCursor(req.Pagination)
+	}
+
+	divergences, err := i.db.ListBeaconStateDivergence(ctx, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	protoDivergences := make([]*indexer.StateDivergence, len(divergences))
+	for i, divergence := range divergences {
+		protoDivergences[i] = DBBeaconStateDivergenceToProtoStateDivergence(divergence)
+	}
+
+	return &indexer.ListStateDivergencesResponse{
+		StateDivergences: protoDivergences,
+	}, nil
+}
+
+func (i *Indexer) CreateBeaconBlock(ctx context.Context, req *indexer.CreateBeaconBlockRequest) (*indexer.CreateBeaconBlockResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := i.checkBeaconBlockNotIndexed(ctx, req); err != nil {
+		return nil, err
 	}
 
 	// Create the block
@@ -486,7 +621,17 @@ func (i *Indexer) CreateBeaconBlock(ctx context.Context, req *indexer.CreateBeac
 		KeyBeaconImplementation: req.GetBeaconImplementation().GetValue(),
 	}
 
-	if err := i.db.InsertBeaconBlock(ctx, ProtoBeaconBlockToDBBeaconBlock(block)); err != nil {
+	if err := i.db.InsertBeaconBlockReference(ctx, ProtoBeaconBlockToDBBeaconBlock(block), func() error {
+		return i.checkStored(ctx, req.GetLocation().GetValue())
+	}); err != nil {
+		if errors.Is(err, errNotStored) {
+			i.log.
+				WithFields(logFields).
+				Error("Failed to index a beacon block because the block could not be found in the store. Check that the agent and server are pointed at the same storage backend.")
+
+			return nil, status.Error(codes.FailedPrecondition, "beacon block not found in the store")
+		}
+
 		i.log.WithError(err).WithFields(logFields).Error("Failed to index block")
 
 		return nil, status.Error(codes.Internal, "failed to index block")
@@ -507,6 +652,28 @@ func (i *Indexer) CreateBeaconBlock(ctx context.Context, req *indexer.CreateBeac
 	}, nil
 }
 
+// checkBeaconBlockNotIndexed returns an AlreadyExists error if the node already
+// indexed the beacon block.
+func (i *Indexer) checkBeaconBlockNotIndexed(ctx context.Context, req *indexer.CreateBeaconBlockRequest) error {
+	filter := &persistence.BeaconBlockFilter{}
+
+	filter.AddNetwork(req.GetNetwork().GetValue())
+	filter.AddSlot(req.GetSlot().GetValue())
+	filter.AddBlockRoot(req.GetBlockRoot().GetValue())
+	filter.AddNode(req.GetNode().GetValue())
+
+	blocks, err := i.db.ListBeaconBlock(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if len(blocks) > 0 {
+		return status.Error(codes.AlreadyExists, "beacon block already indexed")
+	}
+
+	return nil
+}
+
 func (i *Indexer) ListBeaconBlock(ctx context.Context, req *indexer.ListBeaconBlockRequest) (*indexer.ListBeaconBlockResponse, error) {
 	filter := &persistence.BeaconBlockFilter{}
 
@@ -554,6 +721,10 @@ func (i *Indexer) ListBeaconBlock(ctx context.Context, req *indexer.ListBeaconBl
 		filter.AddBeaconImplementation(req.BeaconImplementation)
 	}
 
+	if req.Canonical != nil {
+		filter.AddCanonical(req.Canonical.GetValue())
+	}
+
 	pagination := &persistence.PaginationCursor{
 		Limit:   1000,
 		Offset:  0,
@@ -626,6 +797,10 @@ func (i *Indexer) CountBeaconBlock(ctx context.Context, req *indexer.CountBeacon
 		filter.AddBeaconImplementation(req.BeaconImplementation)
 	}
 
+	if req.Canonical != nil {
+		filter.AddCanonical(req.Canonical.GetValue())
+	}
+
 	beaconBlocks, err := i.db.CountBeaconBlock(ctx, filter)
 	if err != nil {
 		return nil, err
@@ -684,44 +859,31 @@ func (i *Indexer) ListUniqueBeaconBlockValues(ctx context.Context, req *indexer.
 	return response, nil
 }
 
-func (i *Indexer) CreateBeaconBadBlock(ctx context.Context, req *indexer.CreateBeaconBadBlockRequest) (*indexer.CreateBeaconBadBlockResponse, error) {
+func (i *Indexer) CreateBlobSidecar(ctx context.Context, req *indexer.CreateBlobSidecarRequest) (*indexer.CreateBlobSidecarResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Check the store for the bad block
+	// Check the store for the blob sidecars
 	exists, err := i.store.Exists(ctx, req.GetLocation().GetValue())
 	if err != nil {
 		i.log.
 			WithError(err).
 			WithField(KeyLocation, req.GetLocation().GetValue()).
 			WithField(KeyNode, req.GetNode().GetValue()).
-			Error("Failed to index a beacon block because the bad block could not be found in the store. Check that the agent and server are pointed at the same storage backend.")
+			Error("Failed to index blob sidecars because they could not be found in the store. Check that the agent and server are pointed at the same storage backend.")
 
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	if exists {
-		// Check if the bad block is already indexed
-		filter := &persistence.BeaconBadBlockFilter{}
-
-		filter.AddNetwork(req.GetNetwork().GetValue())
-		filter.AddSlot(req.GetSlot().GetValue())
-		filter.AddBlockRoot(req.GetBlockRoot().GetValue())
-		filter.AddNode(req.GetNode().GetValue())
-
-		badBlocks, err := i.db.ListBeaconBadBlock(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
-		}
-
-		if len(badBlocks) > 0 {
-			return nil, status.Error(codes.AlreadyExists, "beacon block already indexed")
+		if err := i.checkBlobSidecarNotIndexed(ctx, req); err != nil {
+			return nil, err
 		}
 	}
 
-	// Create the bad block
-	badBlock := &indexer.BeaconBadBlock{
+	// Create the blob sidecar
+	sidecar := &indexer.BlobSidecar{
 		Id:                   wrapperspb.String(uuid.New().String()),
 		Node:                 req.GetNode(),
 		Network:              req.GetNetwork(),
@@ -733,9 +895,10 @@ func (i *Indexer) CreateBeaconBadBlock(ctx context.Context, req *indexer.CreateB
 		Location:             req.GetLocation(),
 		FetchedAt:            req.GetFetchedAt(),
 		BeaconImplementation: req.GetBeaconImplementation(),
+		SidecarType:          req.GetSidecarType(),
 	}
 
-	if err := badBlock.Validate(); err != nil {
+	if err := sidecar.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -750,23 +913,47 @@ func (i *Indexer) CreateBeaconBadBlock(ctx context.Context, req *indexer.CreateB
 		KeyLocation:             req.GetLocation().GetValue(),
 		KeyFetchedAt:            req.GetFetchedAt().AsTime(),
 		KeyBeaconImplementation: req.GetBeaconImplementation().GetValue(),
+		KeySidecarType:          req.GetSidecarType().GetValue(),
 	}
 
-	if err := i.db.InsertBeaconBadBlock(ctx, ProtoBeaconBadBlockToDBBeaconBadBlock(badBlock)); err != nil {
-		i.log.WithError(err).WithFields(logFields).Error("Failed to index bad block")
+	if err := i.db.InsertBlobSidecar(ctx, ProtoBlobSidecarToDBBlobSidecar(sidecar)); err != nil {
+		i.log.WithError(err).WithFields(logFields).Error("Failed to index blob sidecars")
 
-		return nil, status.Error(codes.Internal, "failed to index bad block")
+		return nil, status.Error(codes.Internal, "failed to index blob sidecars")
 	}
 
-	i.log.WithFields(logFields).WithField("id", badBlock.GetId().GetValue()).Debug("Indexed beacon block")
+	i.log.WithFields(logFields).WithField("id", sidecar.GetId().GetValue()).Debug("Indexed blob sidecars")
 
-	return &indexer.CreateBeaconBadBlockResponse{
-		Id: badBlock.GetId(),
+	return &indexer.CreateBlobSidecarResponse{
+		Id: sidecar.GetId(),
 	}, nil
 }
 
-func (i *Indexer) ListBeaconBadBlock(ctx context.Context, req *indexer.ListBeaconBadBlockRequest) (*indexer.ListBeaconBadBlockResponse, error) {
-	filter := &persistence.BeaconBadBlockFilter{}
+// checkBlobSidecarNotIndexed returns an AlreadyExists error if the node already
+// indexed the blob sidecars.
+func (i *Indexer) checkBlobSidecarNotIndexed(ctx context.Context, req *indexer.CreateBlobSidecarRequest) error {
+	filter := &persistence.BlobSidecarFilter{}
+
+	filter.AddNetwork(req.GetNetwork().GetValue())
+	filter.AddSlot(req.GetSlot().GetValue())
+	filter.AddBlockRoot(req.GetBlockRoot().GetValue())
+	filter.AddNode(req.GetNode().GetValue())
+	filter.AddSidecarType(req.GetSidecarType().GetValue())
+
+	sidecars, err := i.db.ListBlobSidecar(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if len(sidecars) > 0 {
+		return status.Error(codes.AlreadyExists, "blob sidecars already indexed")
+	}
+
+	return nil
+}
+
+func (i *Indexer) ListBlobSidecar(ctx context.Context, req *indexer.ListBlobSidecarRequest) (*indexer.ListBlobSidecarResponse, error) {
+	filter := &persistence.BlobSidecarFilter{}
 
 	if req.Id != "" {
 		filter.AddID(req.Id)
@@ -812,6 +999,10 @@ func (i *Indexer) ListBeaconBadBlock(ctx context.Context, req *indexer.ListBeaco
 		filter.AddBeaconImplementation(req.BeaconImplementation)
 	}
 
+	if req.SidecarType != "" {
+		filter.AddSidecarType(req.SidecarType)
+	}
+
 	pagination := &persistence.PaginationCursor{
 		Limit:   1000,
 		Offset:  0,
@@ -822,23 +1013,23 @@ func (i *Indexer) ListBeaconBadBlock(ctx context.Context, req *indexer.ListBeaco
 		pagination = ProtoPaginationCursorToDBPaginationCursor(req.Pagination)
 	}
 
-	beaconBlocks, err := i.db.ListBeaconBadBlock(ctx, filter, pagination)
+	blobSidecars, err := i.db.ListBlobSidecar(ctx, filter, pagination)
 	if err != nil {
 		return nil, err
 	}
 
-	protoBeaconBadBlocks := make([]*indexer.BeaconBadBlock, len(beaconBlocks))
-	for i, badBlock := range beaconBlocks {
-		protoBeaconBadBlocks[i] = DBBeaconBadBlockToProtoBeaconBadBlock(badBlock)
+	protoBlobSidecars := make([]*indexer.BlobSidecar, len(blobSidecars))
+	for i, sidecar := range blobSidecars {
+		protoBlobSidecars[i] = DBBlobSidecarToProtoBlobSidecar(sidecar)
 	}
 
-	return &indexer.ListBeaconBadBlockResponse{
-		BeaconBadBlocks: protoBeaconBadBlocks,
+	return &indexer.ListBlobSidecarResponse{
+		BlobSidecars: protoBlobSidecars,
 	}, nil
 }
 
-func (i *Indexer) CountBeaconBadBlock(ctx context.Context, req *indexer.CountBeaconBadBlockRequest) (*indexer.CountBeaconBadBlockResponse, error) {
-	filter := &persistence.BeaconBadBlockFilter{}
+func (i *Indexer) CountBlobSidecar(ctx context.Context, req *indexer.CountBlobSidecarRequest) (*indexer.CountBlobSidecarResponse, error) {
+	filter := &persistence.BlobSidecarFilter{}
 
 	if req.Id != "" {
 		filter.AddID(req.Id)
@@ -884,18 +1075,22 @@ func (i *Indexer) CountBeaconBadBlock(ctx context.Context, req *indexer.CountBea
 		filter.AddBeaconImplementation(req.BeaconImplementation)
 	}
 
-	beaconBlocks, err := i.db.CountBeaconBadBlock(ctx, filter)
+	if req.SidecarType != "" {
+		filter.AddSidecarType(req.SidecarType)
+	}
+
+	blobSidecars, err := i.db.CountBlobSidecar(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return &indexer.CountBeaconBadBlockResponse{
+	return &indexer.CountBlobSidecarResponse{
 		//nolint:gosec // not worried about int64 overflow here
-		Count: wrapperspb.UInt64(uint64(beaconBlocks)),
+		Count: wrapperspb.UInt64(uint64(blobSidecars)),
 	}, nil
 }
 
-func (i *Indexer) ListUniqueBeaconBadBlockValues(ctx context.Context, req *indexer.ListUniqueBeaconBadBlockValuesRequest) (*indexer.ListUniqueBeaconBadBlockValuesResponse, error) {
+func (i *Indexer) ListUniqueBlobSidecarValues(ctx context.Context, req *indexer.ListUniqueBlobSidecarValuesRequest) (*indexer.ListUniqueBlobSidecarValuesResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
@@ -904,31 +1099,33 @@ func (i *Indexer) ListUniqueBeaconBadBlockValues(ctx context.Context, req *index
 
 	for idx, field := range req.Fields {
 		switch field {
-		case indexer.ListUniqueBeaconBadBlockValuesRequest_NODE:
+		case indexer.ListUniqueBlobSidecarValuesRequest_NODE:
 			fields[idx] = KeyNode
-		case indexer.ListUniqueBeaconBadBlockValuesRequest_SLOT:
+		case indexer.ListUniqueBlobSidecarValuesRequest_SLOT:
 			fields[idx] = KeySlot
-		case indexer.ListUniqueBeaconBadBlockValuesRequest_EPOCH:
+		case indexer.ListUniqueBlobSidecarValuesRequest_EPOCH:
 			fields[idx] = KeyEpoch
-		case indexer.ListUniqueBeaconBadBlockValuesRequest_BLOCK_ROOT:
+		case indexer.ListUniqueBlobSidecarValuesRequest_BLOCK_ROOT:
 			fields[idx] = KeyBlockRoot
-		case indexer.ListUniqueBeaconBadBlockValuesRequest_NODE_VERSION:
+		case indexer.ListUniqueBlobSidecarValuesRequest_NODE_VERSION:
 			fields[idx] = KeyNodeVersion
-		case indexer.ListUniqueBeaconBadBlockValuesRequest_LOCATION:
+		case indexer.ListUniqueBlobSidecarValuesRequest_LOCATION:
 			fields[idx] = KeyLocation
-		case indexer.ListUniqueBeaconBadBlockValuesRequest_NETWORK:
+		case indexer.ListUniqueBlobSidecarValuesRequest_NETWORK:
 			fields[idx] = KeyNetwork
-		case indexer.ListUniqueBeaconBadBlockValuesRequest_BEACON_IMPLEMENTATION:
+		case indexer.ListUniqueBlobSidecarValuesRequest_BEACON_IMPLEMENTATION:
 			fields[idx] = KeyBeaconImplementation
+		case indexer.ListUniqueBlobSidecarValuesRequest_SIDECAR_TYPE:
+			fields[idx] = KeySidecarType
 		}
 	}
 
-	distinctValues, err := i.db.DistinctBeaconBadBlockValues(ctx, fields)
+	distinctValues, err := i.db.DistinctBlobSidecarValues(ctx, fields)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &indexer.ListUniqueBeaconBadBlockValuesResponse{
+	response := &indexer.ListUniqueBlobSidecarValuesResponse{
 		Node:                 distinctValues.Node,
 		Slot:                 distinctValues.Slot,
 		Epoch:                distinctValues.Epoch,
@@ -937,65 +1134,34 @@ func (i *Indexer) ListUniqueBeaconBadBlockValues(ctx context.Context, req *index
 		Location:             distinctValues.Location,
 		Network:              distinctValues.Network,
 		BeaconImplementation: distinctValues.BeaconImplementation,
+		SidecarType:          distinctValues.SidecarType,
 	}
 
 	return response, nil
 }
 
-func (i *Indexer) CreateBeaconBadBlob(ctx context.Context, req *indexer.CreateBeaconBadBlobRequest) (*indexer.CreateBeaconBadBlobResponse, error) {
+func (i *Indexer) CreateForkChoice(ctx context.Context, req *indexer.CreateForkChoiceRequest) (*indexer.CreateForkChoiceResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Check the store for the bad blob
-	exists, err := i.store.Exists(ctx, req.GetLocation().GetValue())
-	if err != nil {
-		i.log.
-			WithError(err).
-			WithField(KeyLocation, req.GetLocation().GetValue()).
-			WithField(KeyNode, req.GetNode().GetValue()).
-			Error("Failed to index a beacon blob because the bad blob could not be found in the store. Check that the agent and server are pointed at the same storage backend.")
-
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	if exists {
-		// Check if the bad blob is already indexed
-		filter := &persistence.BeaconBadBlobFilter{}
-
-		filter.AddNetwork(req.GetNetwork().GetValue())
-		filter.AddSlot(req.GetSlot().GetValue())
-		filter.AddBlockRoot(req.GetBlockRoot().GetValue())
-		filter.AddIndex(req.GetIndex().GetValue())
-		filter.AddNode(req.GetNode().GetValue())
-
-		badBlobs, err := i.db.ListBeaconBadBlob(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
-		}
-
-		if len(badBlobs) > 0 {
-			return nil, status.Error(codes.AlreadyExists, "beacon blob already indexed")
-		}
-	}
-
-	// Create the bad blob
-	badBlob := &indexer.BeaconBadBlob{
+	// Each capture is a snapshot of the node's view at that moment, so unlike
+	// blocks there's no need to check for duplicates.
+	forkChoice := &indexer.ForkChoice{
 		Id:                   wrapperspb.String(uuid.New().String()),
 		Node:                 req.GetNode(),
 		Network:              req.GetNetwork(),
 		Slot:                 req.GetSlot(),
 		Epoch:                req.GetEpoch(),
-		BlockRoot:            req.GetBlockRoot(),
 		NodeVersion:          req.GetNodeVersion(),
 		ContentEncoding:      req.GetContentEncoding(),
 		Location:             req.GetLocation(),
 		FetchedAt:            req.GetFetchedAt(),
 		BeaconImplementation: req.GetBeaconImplementation(),
-		Index:                req.GetIndex(),
+		Reason:               req.GetReason(),
 	}
 
-	if err := badBlob.Validate(); err != nil {
+	if err := forkChoice.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -1004,30 +1170,29 @@ func (i *Indexer) CreateBeaconBadBlob(ctx context.Context, req *indexer.CreateBe
 		KeyNetwork:              req.GetNetwork().GetValue(),
 		KeySlot:                 req.GetSlot().GetValue(),
 		KeyEpoch:                req.GetEpoch().GetValue(),
-		KeyBlockRoot:            req.GetBlockRoot().GetValue(),
 		KeyNodeVersion:          req.GetNodeVersion().GetValue(),
 		KeyContentEncoding:      req.GetContentEncoding().GetValue(),
 		KeyLocation:             req.GetLocation().GetValue(),
 		KeyFetchedAt:            req.GetFetchedAt().AsTime(),
 		KeyBeaconImplementation: req.GetBeaconImplementation().GetValue(),
-		"index":                 req.GetIndex().GetValue(),
+		KeyReason:               req.GetReason().GetValue(),
 	}
 
-	if err := i.db.InsertBeaconBadBlob(ctx, ProtoBeaconBadBlobToDBBeaconBadBlob(badBlob)); err != nil {
-		i.log.WithError(err).WithFields(logFields).Error("Failed to index bad blob")
+	if err := i.db.InsertForkChoice(ctx, ProtoForkChoiceToDBForkChoice(forkChoice)); err != nil {
+		i.log.WithError(err).WithFields(logFields).Error("Failed to index fork choice")
 
-		return nil, status.Error(codes.Internal, "failed to index bad blob")
+		return nil, status.Error(codes.Internal, "failed to index fork choice")
 	}
 
-	i.log.WithFields(logFields).WithField("id", badBlob.GetId().GetValue()).Debug("Indexed beacon blob")
+	i.log.WithFields(logFields).WithField("id", forkChoice.GetId().GetValue()).Debug("Indexed fork choice")
 
-	return &indexer.CreateBeaconBadBlobResponse{
-		Id: badBlob.GetId(),
+	return &indexer.CreateForkChoiceResponse{
+		Id: forkChoice.GetId(),
 	}, nil
 }
 
-func (i *Indexer) ListBeaconBadBlob(ctx context.Context, req *indexer.ListBeaconBadBlobRequest) (*indexer.ListBeaconBadBlobResponse, error) {
-	filter := &persistence.BeaconBadBlobFilter{}
+func (i *Indexer) ListForkChoice(ctx context.Context, req *indexer.ListForkChoiceRequest) (*indexer.ListForkChoiceResponse, error) {
+	filter := &persistence.ForkChoiceFilter{}
 
 	if req.Id != "" {
 		filter.AddID(req.Id)
@@ -1045,10 +1210,6 @@ func (i *Indexer) ListBeaconBadBlob(ctx context.Context, req *indexer.ListBeacon
 		filter.AddEpoch(req.Epoch)
 	}
 
-	if req.BlockRoot != "" {
-		filter.AddBlockRoot(req.BlockRoot)
-	}
-
 	if req.NodeVersion != "" {
 		filter.AddNodeVersion(req.NodeVersion)
 	}
@@ -1073,8 +1234,8 @@ func (i *Indexer) ListBeaconBadBlob(ctx context.Context, req *indexer.ListBeacon
 		filter.AddBeaconImplementation(req.BeaconImplementation)
 	}
 
-	if req.Index != nil {
-		filter.AddIndex(req.Index.GetValue())
+	if req.Reason != "" {
+		filter.AddReason(req.Reason)
 	}
 
 	pagination := &persistence.PaginationCursor{
@@ -1087,23 +1248,23 @@ func (i *Indexer) ListBeaconBadBlob(ctx context.Context, req *indexer.ListBeacon
 		pagination = ProtoPaginationCursorToDBPaginationCursor(req.Pagination)
 	}
 
-	beaconBlobs, err := i.db.ListBeaconBadBlob(ctx, filter, pagination)
+	forkChoices, err := i.db.ListForkChoice(ctx, filter, pagination)
 	if err != nil {
 		return nil, err
 	}
 
-	protoBeaconBadBlobs := make([]*indexer.BeaconBadBlob, len(beaconBlobs))
-	for i, badBlob := range beaconBlobs {
-		protoBeaconBadBlobs[i] = DBBeaconBadBlobToProtoBeaconBadBlob(badBlob)
+	protoForkChoices := make([]*indexer.ForkChoice, len(forkChoices))
+	for i, forkChoice := range forkChoices {
+		protoForkChoices[i] = DBForkChoiceToProtoForkChoice(forkChoice)
 	}
 
-	return &indexer.ListBeaconBadBlobResponse{
-		BeaconBadBlobs: protoBeaconBadBlobs,
+	return &indexer.ListForkChoiceResponse{
+		ForkChoices: protoForkChoices,
 	}, nil
 }
 
-func (i *Indexer) CountBeaconBadBlob(ctx context.Context, req *indexer.CountBeaconBadBlobRequest) (*indexer.CountBeaconBadBlobResponse, error) {
-	filter := &persistence.BeaconBadBlobFilter{}
+func (i *Indexer) CountForkChoice(ctx context.Context, req *indexer.CountForkChoiceRequest) (*indexer.CountForkChoiceResponse, error) {
+	filter := &persistence.ForkChoiceFilter{}
 
 	if req.Id != "" {
 		filter.AddID(req.Id)
@@ -1121,10 +1282,6 @@ func (i *Indexer) CountBeaconBadBlob(ctx context.Context, req *indexer.CountBeac
 		filter.AddEpoch(req.Epoch)
 	}
 
-	if req.BlockRoot != "" {
-		filter.AddBlockRoot(req.BlockRoot)
-	}
-
 	if req.NodeVersion != "" {
 		filter.AddNodeVersion(req.NodeVersion)
 	}
@@ -1149,22 +1306,22 @@ func (i *Indexer) CountBeaconBadBlob(ctx context.Context, req *indexer.CountBeac
 		filter.AddBeaconImplementation(req.BeaconImplementation)
 	}
 
-	if req.Index != nil {
-		filter.AddIndex(req.Index.GetValue())
+	if req.Reason != "" {
+		filter.AddReason(req.Reason)
 	}
 
-	beaconBlobs, err := i.db.CountBeaconBadBlob(ctx, filter)
+	forkChoices, err := i.db.CountForkChoice(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return &indexer.CountBeaconBadBlobResponse{
+	return &indexer.CountForkChoiceResponse{
 		//nolint:gosec // not worried about int64 overflow here
-		Count: wrapperspb.UInt64(uint64(beaconBlobs)),
+		Count: wrapperspb.UInt64(uint64(forkChoices)),
 	}, nil
 }
 
-func (i *Indexer) ListUniqueBeaconBadBlobValues(ctx context.Context, req *indexer.ListUniqueBeaconBadBlobValuesRequest) (*indexer.ListUniqueBeaconBadBlobValuesResponse, error) {
+func (i *Indexer) ListUniqueForkChoiceValues(ctx context.Context, req *indexer.ListUniqueForkChoiceValuesRequest) (*indexer.ListUniqueForkChoiceValuesResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
@@ -1173,7 +1330,552 @@ func (i *Indexer) ListUniqueBeaconBadBlobValues(ctx context.Context, req *indexe
 
 	for idx, field := range req.Fields {
 		switch field {
-		case indexer.ListUniqueBeaconBadBlobValuesRequest_NODE:
+		case indexer.ListUniqueForkChoiceValuesRequest_NODE:
+			fields[idx] = KeyNode
+		case indexer.ListUniqueForkChoiceValuesRequest_SLOT:
+			fields[idx] = KeySlot
+		case indexer.ListUniqueForkChoiceValuesRequest_EPOCH:
+			fields[idx] = KeyEpoch
+		case indexer.ListUniqueForkChoiceValuesRequest_NODE_VERSION:
+			fields[idx] = KeyNodeVersion
+		case indexer.ListUniqueForkChoiceValuesRequest_LOCATION:
+			fields[idx] = KeyLocation
+		case indexer.ListUniqueForkChoiceValuesRequest_NETWORK:
+			fields[idx] = KeyNetwork
+		case indexer.ListUniqueForkChoiceValuesRequest_BEACON_IMPLEMENTATION:
+			fields[idx] = KeyBeaconImplementation
+		case indexer.ListUniqueForkChoiceValuesRequest_REASON:
+			fields[idx] = KeyReason
+		}
+	}
+
+	distinctValues, err := i.db.DistinctForkChoiceValues(ctx, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &indexer.ListUniqueForkChoiceValuesResponse{
+		Node:                 distinctValues.Node,
+		Slot:                 distinctValues.Slot,
+		Epoch:                distinctValues.Epoch,
+		NodeVersion:          distinctValues.NodeVersion,
+		Location:             distinctValues.Location,
+		Network:              distinctValues.Network,
+		BeaconImplementation: distinctValues.BeaconImplementation,
+		Reason:               distinctValues.Reason,
+	}
+
+	return response, nil
+}
+
+func (i *Indexer) CreateBeaconBadBlock(ctx context.Context, req *indexer.CreateBeaconBadBlockRequest) (*indexer.CreateBeaconBadBlockResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Check the store for the bad block
+	exists, err := i.store.Exists(ctx, req.GetLocation().GetValue())
+	if err != nil {
+		i.log.
+			WithError(err).
+			WithField(KeyLocation, req.GetLocation().GetValue()).
+			WithField(KeyNode, req.GetNode().GetValue()).
+			Error("Failed to index a beacon block because the bad block could not be found in the store. Check that the agent and server are pointed at the same storage backend.")
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if exists {
+		if err := i.checkBeaconBadBlockNotIndexed(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the bad block
+	badBlock := &indexer.BeaconBadBlock{
+		Id:                   wrapperspb.String(uuid.New().String()),
+		Node:                 req.GetNode(),
+		Network:              req.GetNetwork(),
+		Slot:                 req.GetSlot(),
+		Epoch:                req.GetEpoch(),
+		BlockRoot:            req.GetBlockRoot(),
+		NodeVersion:          req.GetNodeVersion(),
+		ContentEncoding:      req.GetContentEncoding(),
+		Location:             req.GetLocation(),
+		FetchedAt:            req.GetFetchedAt(),
+		BeaconImplementation: req.GetBeaconImplementation(),
+	}
+
+	if err := badBlock.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	logFields := logrus.Fields{
+		KeyNode:                 req.GetNode().GetValue(),
+		KeyNetwork:              req.GetNetwork().GetValue(),
+		KeySlot:                 req.GetSlot().GetValue(),
+		KeyEpoch:                req.GetEpoch().GetValue(),
+		KeyBlockRoot:            req.GetBlockRoot().GetValue(),
+		KeyNodeVersion:          req.GetNodeVersion().GetValue(),
+		KeyContentEncoding:      req.GetContentEncoding().GetValue(),
+		KeyLocation:             req.GetLocation().GetValue(),
+		KeyFetchedAt:            req.GetFetchedAt().AsTime(),
+		KeyBeaconImplementation: req.GetBeaconImplementation().GetValue(),
+	}
+
+	if err := i.db.InsertBeaconBadBlock(ctx, ProtoBeaconBadBlockToDBBeaconBadBlock(badBlock)); err != nil {
+		i.log.WithError(err).WithFields(logFields).Error("Failed to index bad block")
+
+		return nil, status.Error(codes.Internal, "failed to index bad block")
+	}
+
+	i.log.WithFields(logFields).WithField("id", badBlock.GetId().GetValue()).Debug("Indexed beacon block")
+
+	return &indexer.CreateBeaconBadBlockResponse{
+		Id: badBlock.GetId(),
+	}, nil
+}
+
+// checkBeaconBadBlockNotIndexed returns an AlreadyExists error if the node already
+// indexed the beacon bad block.
+func (i *Indexer) checkBeaconBadBlockNotIndexed(ctx context.Context, req *indexer.CreateBeaconBadBlockRequest) error {
+	filter := &persistence.BeaconBadBlockFilter{}
+
+	filter.AddNetwork(req.GetNetwork().GetValue())
+	filter.AddSlot(req.GetSlot().GetValue())
+	filter.AddBlockRoot(req.GetBlockRoot().GetValue())
+	filter.AddNode(req.GetNode().GetValue())
+
+	badBlocks, err := i.db.ListBeaconBadBlock(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if len(badBlocks) > 0 {
+		return status.Error(codes.AlreadyExists, "beacon block already indexed")
+	}
+
+	return nil
+}
+
+func (i *Indexer) ListBeaconBadBlock(ctx context.Context, req *indexer.ListBeaconBadBlockRequest) (*indexer.ListBeaconBadBlockResponse, error) {
+	filter := &persistence.BeaconBadBlockFilter{}
+
+	if req.Id != "" {
+		filter.AddID(req.Id)
+	}
+
+	if req.Node != "" {
+		filter.AddNode(req.Node)
+	}
+
+	if req.Slot != 0 {
+		filter.AddSlot(req.Slot)
+	}
+
+	if req.Epoch != 0 {
+		filter.AddEpoch(req.Epoch)
+	}
+
+	if req.BlockRoot != "" {
+		filter.AddBlockRoot(req.BlockRoot)
+	}
+
+	if req.NodeVersion != "" {
+		filter.AddNodeVersion(req.NodeVersion)
+	}
+
+	if req.Location != "" {
+		filter.AddLocation(req.Location)
+	}
+
+	if req.Network != "" {
+		filter.AddNetwork(req.Network)
+	}
+
+	if req.Before != nil {
+		filter.AddBefore(req.Before.AsTime())
+	}
+
+	if req.After != nil {
+		filter.AddAfter(req.After.AsTime())
+	}
+
+	if req.BeaconImplementation != "" {
+		filter.AddBeaconImplementation(req.BeaconImplementation)
+	}
+
+	pagination := &persistence.PaginationCursor{
+		Limit:   1000,
+		Offset:  0,
+		OrderBy: "fetched_at DESC",
+	}
+
+	if req.Pagination != nil {
+		pagination = ProtoPaginationCursorToDBPaginationCursor(req.Pagination)
+	}
+
+	beaconBlocks, err := i.db.ListBeaconBadBlock(ctx, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	protoBeaconBadBlocks := make([]*indexer.BeaconBadBlock, len(beaconBlocks))
+	for i, badBlock := range beaconBlocks {
+		protoBeaconBadBlocks[i] = DBBeaconBadBlockToProtoBeaconBadBlock(badBlock)
+	}
+
+	return &indexer.ListBeaconBadBlockResponse{
+		BeaconBadBlocks: protoBeaconBadBlocks,
+	}, nil
+}
+
+func (i *Indexer) CountBeaconBadBlock(ctx context.Context, req *indexer.CountBeaconBadBlockRequest) (*indexer.CountBeaconBadBlockResponse, error) {
+	filter := &persistence.BeaconBadBlockFilter{}
+
+	if req.Id != "" {
+		filter.AddID(req.Id)
+	}
+
+	if req.Node != "" {
+		filter.AddNode(req.Node)
+	}
+
+	if req.Slot != 0 {
+		filter.AddSlot(req.Slot)
+	}
+
+	if req.Epoch != 0 {
+		filter.AddEpoch(req.Epoch)
+	}
+
+	if req.BlockRoot != "" {
+		filter.AddBlockRoot(req.BlockRoot)
+	}
+
+	if req.NodeVersion != "" {
+		filter.AddNodeVersion(req.NodeVersion)
+	}
+
+	if req.Location != "" {
+		filter.AddLocation(req.Location)
+	}
+
+	if req.Network != "" {
+		filter.AddNetwork(req.Network)
+	}
+
+	if req.Before != nil {
+		filter.AddBefore(req.Before.AsTime())
+	}
+
+	if req.After != nil {
+		filter.AddAfter(req.After.AsTime())
+	}
+
+	if req.BeaconImplementation != "" {
+		filter.AddBeaconImplementation(req.BeaconImplementation)
+	}
+
+	beaconBlocks, err := i.db.CountBeaconBadBlock(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexer.CountBeaconBadBlockResponse{
+		//nolint:gosec // not worried about int64 overflow here
+		Count: wrapperspb.UInt64(uint64(beaconBlocks)),
+	}, nil
+}
+
+func (i *Indexer) ListUniqueBeaconBadBlockValues(ctx context.Context, req *indexer.ListUniqueBeaconBadBlockValuesRequest) (*indexer.ListUniqueBeaconBadBlockValuesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, len(req.Fields))
+
+	for idx, field := range req.Fields {
+		switch field {
+		case indexer.ListUniqueBeaconBadBlockValuesRequest_NODE:
+			fields[idx] = KeyNode
+		case indexer.ListUniqueBeaconBadBlockValuesRequest_SLOT:
+			fields[idx] = KeySlot
+		case indexer.ListUniqueBeaconBadBlockValuesRequest_EPOCH:
+			fields[idx] = KeyEpoch
+		case indexer.ListUniqueBeaconBadBlockValuesRequest_BLOCK_ROOT:
+			fields[idx] = KeyBlockRoot
+		case indexer.ListUniqueBeaconBadBlockValuesRequest_NODE_VERSION:
+			fields[idx] = KeyNodeVersion
+		case indexer.ListUniqueBeaconBadBlockValuesRequest_LOCATION:
+			fields[idx] = KeyLocation
+		case indexer.ListUniqueBeaconBadBlockValuesRequest_NETWORK:
+			fields[idx] = KeyNetwork
+		case indexer.ListUniqueBeaconBadBlockValuesRequest_BEACON_IMPLEMENTATION:
+			fields[idx] = KeyBeaconImplementation
+		}
+	}
+
+	distinctValues, err := i.db.DistinctBeaconBadBlockValues(ctx, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &indexer.ListUniqueBeaconBadBlockValuesResponse{
+		Node:                 distinctValues.Node,
+		Slot:                 distinctValues.Slot,
+		Epoch:                distinctValues.Epoch,
+		BlockRoot:            distinctValues.BlockRoot,
+		NodeVersion:          distinctValues.NodeVersion,
+		Location:             distinctValues.Location,
+		Network:              distinctValues.Network,
+		BeaconImplementation: distinctValues.BeaconImplementation,
+	}
+
+	return response, nil
+}
+
+func (i *Indexer) CreateBeaconBadBlob(ctx context.Context, req *indexer.CreateBeaconBadBlobRequest) (*indexer.CreateBeaconBadBlobResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Check the store for the bad blob
+	exists, err := i.store.Exists(ctx, req.GetLocation().GetValue())
+	if err != nil {
+		i.log.
+			WithError(err).
+			WithField(KeyLocation, req.GetLocation().GetValue()).
+			WithField(KeyNode, req.GetNode().GetValue()).
+			Error("Failed to index a beacon blob because the bad blob could not be found in the store. Check that the agent and server are pointed at the same storage backend.")
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if exists {
+		if err := i.checkBeaconBadBlobNotIndexed(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the bad blob
+	badBlob := &indexer.BeaconBadBlob{
+		Id:                   wrapperspb.String(uuid.New().String()),
+		Node:                 req.GetNode(),
+		Network:              req.GetNetwork(),
+		Slot:                 req.GetSlot(),
+		Epoch:                req.GetEpoch(),
+		BlockRoot:            req.GetBlockRoot(),
+		NodeVersion:          req.GetNodeVersion(),
+		ContentEncoding:      req.GetContentEncoding(),
+		Location:             req.GetLocation(),
+		FetchedAt:            req.GetFetchedAt(),
+		BeaconImplementation: req.GetBeaconImplementation(),
+		Index:                req.GetIndex(),
+	}
+
+	if err := badBlob.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	logFields := logrus.Fields{
+		KeyNode:                 req.GetNode().GetValue(),
+		KeyNetwork:              req.GetNetwork().GetValue(),
+		KeySlot:                 req.GetSlot().GetValue(),
+		KeyEpoch:                req.GetEpoch().GetValue(),
+		KeyBlockRoot:            req.GetBlockRoot().GetValue(),
+		KeyNodeVersion:          req.GetNodeVersion().GetValue(),
+		KeyContentEncoding:      req.GetContentEncoding().GetValue(),
+		KeyLocation:             req.GetLocation().GetValue(),
+		KeyFetchedAt:            req.GetFetchedAt().AsTime(),
+		KeyBeaconImplementation: req.GetBeaconImplementation().GetValue(),
+		"index":                 req.GetIndex().GetValue(),
+	}
+
+	if err := i.db.InsertBeaconBadBlob(ctx, ProtoBeaconBadBlobToDBBeaconBadBlob(badBlob)); err != nil {
+		i.log.WithError(err).WithFields(logFields).Error("Failed to index bad blob")
+
+		return nil, status.Error(codes.Internal, "failed to index bad blob")
+	}
+
+	i.log.WithFields(logFields).WithField("id", badBlob.GetId().GetValue()).Debug("Indexed beacon blob")
+
+	return &indexer.CreateBeaconBadBlobResponse{
+		Id: badBlob.GetId(),
+	}, nil
+}
+
+// checkBeaconBadBlobNotIndexed returns an AlreadyExists error if the node already
+// indexed the beacon bad blob.
+func (i *Indexer) checkBeaconBadBlobNotIndexed(ctx context.Context, req *indexer.CreateBeaconBadBlobRequest) error {
+	filter := &persistence.BeaconBadBlobFilter{}
+
+	filter.AddNetwork(req.GetNetwork().GetValue())
+	filter.AddSlot(req.GetSlot().GetValue())
+	filter.AddBlockRoot(req.GetBlockRoot().GetValue())
+	filter.AddIndex(req.GetIndex().GetValue())
+	filter.AddNode(req.GetNode().GetValue())
+
+	badBlobs, err := i.db.ListBeaconBadBlob(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if len(badBlobs) > 0 {
+		return status.Error(codes.AlreadyExists, "beacon blob already indexed")
+	}
+
+	return nil
+}
+
+func (i *Indexer) ListBeaconBadBlob(ctx context.Context, req *indexer.ListBeaconBadBlobRequest) (*indexer.ListBeaconBadBlobResponse, error) {
+	filter := &persistence.BeaconBadBlobFilter{}
+
+	if req.Id != "" {
+		filter.AddID(req.Id)
+	}
+
+	if req.Node != "" {
+		filter.AddNode(req.Node)
+	}
+
+	if req.Slot != 0 {
+		filter.AddSlot(req.Slot)
+	}
+
+	if req.Epoch != 0 {
+		filter.AddEpoch(req.Epoch)
+	}
+
+	if req.BlockRoot != "" {
+		filter.AddBlockRoot(req.BlockRoot)
+	}
+
+	if req.NodeVersion != "" {
+		filter.AddNodeVersion(req.NodeVersion)
+	}
+
+	if req.Location != "" {
+		filter.AddLocation(req.Location)
+	}
+
+	if req.Network != "" {
+		filter.AddNetwork(req.Network)
+	}
+
+	if req.Before != nil {
+		filter.AddBefore(req.Before.AsTime())
+	}
+
+	if req.After != nil {
+		filter.AddAfter(req.After.AsTime())
+	}
+
+	if req.BeaconImplementation != "" {
+		filter.AddBeaconImplementation(req.BeaconImplementation)
+	}
+
+	if req.Index != nil {
+		filter.AddIndex(req.Index.GetValue())
+	}
+
+	pagination := &persistence.PaginationCursor{
+		Limit:   1000,
+		Offset:  0,
+		OrderBy: "fetched_at DESC",
+	}
+
+	if req.Pagination != nil {
+		pagination = ProtoPaginationCursorToDBPaginationCursor(req.Pagination)
+	}
+
+	beaconBlobs, err := i.db.ListBeaconBadBlob(ctx, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	protoBeaconBadBlobs := make([]*indexer.BeaconBadBlob, len(beaconBlobs))
+	for i, badBlob := range beaconBlobs {
+		protoBeaconBadBlobs[i] = DBBeaconBadBlobToProtoBeaconBadBlob(badBlob)
+	}
+
+	return &indexer.ListBeaconBadBlobResponse{
+		BeaconBadBlobs: protoBeaconBadBlobs,
+	}, nil
+}
+
+func (i *Indexer) CountBeaconBadBlob(ctx context.Context, req *indexer.CountBeaconBadBlobRequest) (*indexer.CountBeaconBadBlobResponse, error) {
+	filter := &persistence.BeaconBadBlobFilter{}
+
+	if req.Id != "" {
+		filter.AddID(req.Id)
+	}
+
+	if req.Node != "" {
+		filter.AddNode(req.Node)
+	}
+
+	if req.Slot != 0 {
+		filter.AddSlot(req.Slot)
+	}
+
+	if req.Epoch != 0 {
+		filter.AddEpoch(req.Epoch)
+	}
+
+	if req.BlockRoot != "" {
+		filter.AddBlockRoot(req.BlockRoot)
+	}
+
+	if req.NodeVersion != "" {
+		filter.AddNodeVersion(req.NodeVersion)
+	}
+
+	if req.Location != "" {
+		filter.AddLocation(req.Location)
+	}
+
+	if req.Network != "" {
+		filter.AddNetwork(req.Network)
+	}
+
+	if req.Before != nil {
+		filter.AddBefore(req.Before.AsTime())
+	}
+
+	if req.After != nil {
+		filter.AddAfter(req.After.AsTime())
+	}
+
+	if req.BeaconImplementation != "" {
+		filter.AddBeaconImplementation(req.BeaconImplementation)
+	}
+
+	if req.Index != nil {
+		filter.AddIndex(req.Index.GetValue())
+	}
+
+	beaconBlobs, err := i.db.CountBeaconBadBlob(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexer.CountBeaconBadBlobResponse{
+		//nolint:gosec // not worried about int64 overflow here
+		Count: wrapperspb.UInt64(uint64(beaconBlobs)),
+	}, nil
+}
+
+func (i *Indexer) ListUniqueBeaconBadBlobValues(ctx context.Context, req *indexer.ListUniqueBeaconBadBlobValuesRequest) (*indexer.ListUniqueBeaconBadBlobValuesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, len(req.Fields))
+
+	for idx, field := range req.Fields {
+		switch field {
+		case indexer.ListUniqueBeaconBadBlobValuesRequest_NODE:
 			fields[idx] = KeyNode
 		case indexer.ListUniqueBeaconBadBlobValuesRequest_SLOT:
 			fields[idx] = KeySlot
@@ -1219,6 +1921,10 @@ func (i *Indexer) CreateExecutionBlockTrace(ctx context.Context, req *indexer.Cr
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if err := i.checkExecutionBlockTraceNotIndexed(ctx, req); err != nil {
+		return nil, err
+	}
+
 	// Create the execution block trace
 	trace := &indexer.ExecutionBlockTrace{
 		Id:                      wrapperspb.String(uuid.New().String()),
@@ -1230,6 +1936,8 @@ func (i *Indexer) CreateExecutionBlockTrace(ctx context.Context, req *indexer.Cr
 		Network:                 req.GetNetwork(),
 		ExecutionImplementation: req.GetExecutionImplementation(),
 		NodeVersion:             req.GetNodeVersion(),
+		ContentEncoding:         req.GetContentEncoding(),
+		Tracer:                  req.GetTracer(),
 	}
 
 	if err := trace.Validate(); err != nil {
@@ -1241,11 +1949,13 @@ func (i *Indexer) CreateExecutionBlockTrace(ctx context.Context, req *indexer.Cr
 	}
 
 	logFields := logrus.Fields{
-		KeyNode:        req.GetNode().GetValue(),
-		KeyNetwork:     req.GetNetwork().GetValue(),
-		KeyNodeVersion: req.GetNodeVersion().GetValue(),
-		KeyLocation:    req.GetLocation().GetValue(),
-		KeyFetchedAt:   req.GetFetchedAt().AsTime(),
+		KeyNode:            req.GetNode().GetValue(),
+		KeyNetwork:         req.GetNetwork().GetValue(),
+		KeyNodeVersion:     req.GetNodeVersion().GetValue(),
+		KeyLocation:        req.GetLocation().GetValue(),
+		KeyFetchedAt:       req.GetFetchedAt().AsTime(),
+		KeyContentEncoding: req.GetContentEncoding().GetValue(),
+		KeyTracer:          req.GetTracer().GetValue(),
 	}
 
 	i.log.WithFields(logFields).WithField("id", trace.GetId().GetValue()).Debug("Indexed execution block trace")
@@ -1255,6 +1965,33 @@ func (i *Indexer) CreateExecutionBlockTrace(ctx context.Context, req *indexer.Cr
 	}, nil
 }
 
+// checkExecutionBlockTraceNotIndexed returns an AlreadyExists error if the node already
+// indexed the execution block trace.
+func (i *Indexer) checkExecutionBlockTraceNotIndexed(ctx context.Context, req *indexer.CreateExecutionBlockTraceRequest) error {
+	tracer := req.GetTracer().GetValue()
+	if tracer == "" {
+		tracer = tracediff.StructLoggerTracer
+	}
+
+	filter := &persistence.ExecutionBlockTraceFilter{}
+
+	filter.AddNetwork(req.GetNetwork().GetValue())
+	filter.AddBlockHash(req.GetBlockHash().GetValue())
+	filter.AddNode(req.GetNode().GetValue())
+	filter.AddTracer(tracer)
+
+	traces, err := i.db.ListExecutionBlockTrace(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if len(traces) > 0 {
+		return status.Error(codes.AlreadyExists, "execution block trace already indexed")
+	}
+
+	return nil
+}
+
 func (i *Indexer) ListExecutionBlockTrace(ctx context.Context, req *indexer.ListExecutionBlockTraceRequest) (*indexer.ListExecutionBlockTraceResponse, error) {
 	filter := &persistence.ExecutionBlockTraceFilter{}
 
@@ -1298,6 +2035,14 @@ func (i *Indexer) ListExecutionBlockTrace(ctx context.Context, req *indexer.List
 		filter.AddNodeVersion(req.NodeVersion)
 	}
 
+	if req.Tracer != "" {
+		filter.AddTracer(req.Tracer)
+	}
+
+	if req.Canonical != nil {
+		filter.AddCanonical(req.Canonical.GetValue())
+	}
+
 	pagination := &persistence.PaginationCursor{
 		Limit:   1000,
 		Offset:  0,
@@ -1362,6 +2107,14 @@ func (i *Indexer) CountExecutionBlockTrace(ctx context.Context, req *indexer.Cou
 		filter.AddNodeVersion(req.NodeVersion)
 	}
 
+	if req.Tracer != "" {
+		filter.AddTracer(req.Tracer)
+	}
+
+	if req.Canonical != nil {
+		filter.AddCanonical(req.Canonical.GetValue())
+	}
+
 	executionBlockTraces, err := i.db.CountExecutionBlockTrace(ctx, filter)
 	if err != nil {
 		return nil, err
@@ -1396,6 +2149,8 @@ func (i *Indexer) ListUniqueExecutionBlockTraceValues(ctx context.Context, req *
 			fields[idx] = KeyExecutionImplementation
 		case indexer.ListUniqueExecutionBlockTraceValuesRequest_NODE_VERSION:
 			fields[idx] = KeyNodeVersion
+		case indexer.ListUniqueExecutionBlockTraceValuesRequest_TRACER:
+			fields[idx] = KeyTracer
 		}
 	}
 
@@ -1412,29 +2167,266 @@ func (i *Indexer) ListUniqueExecutionBlockTraceValues(ctx context.Context, req *
 		Network:                 distinctValues.Network,
 		ExecutionImplementation: distinctValues.ExecutionImplementation,
 		NodeVersion:             distinctValues.NodeVersion,
+		Tracer:                  distinctValues.Tracer,
 	}
 
 	return response, nil
 }
 
-func (i *Indexer) CreateExecutionBadBlock(ctx context.Context, req *indexer.CreateExecutionBadBlockRequest) (*indexer.CreateExecutionBadBlockResponse, error) {
+func (i *Indexer) CreateExecutionWitness(ctx context.Context, req *indexer.CreateExecutionWitnessRequest) (*indexer.CreateExecutionWitnessResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Create the execution bad block
-	block := &indexer.ExecutionBadBlock{
+	if err := i.checkExecutionWitnessNotIndexed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	// Create the execution witness
+	witness := &indexer.ExecutionWitness{
 		Id:                      wrapperspb.String(uuid.New().String()),
 		Node:                    req.GetNode(),
 		FetchedAt:               req.GetFetchedAt(),
 		BlockHash:               req.GetBlockHash(),
 		BlockNumber:             req.GetBlockNumber(),
-		BlockExtraData:          req.GetBlockExtraData(),
 		Location:                req.GetLocation(),
-		ContentEncoding:         req.GetContentEncoding(),
 		Network:                 req.GetNetwork(),
 		ExecutionImplementation: req.GetExecutionImplementation(),
 		NodeVersion:             req.GetNodeVersion(),
+		ContentEncoding:         req.GetContentEncoding(),
+	}
+
+	if err := witness.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := i.db.InsertExecutionWitness(ctx, ProtoExecutionWitnessToDBExecutionWitness(witness)); err != nil {
+		return nil, status.Error(codes.Internal, "failed to insert execution witness")
+	}
+
+	logFields := logrus.Fields{
+		KeyNode:            req.GetNode().GetValue(),
+		KeyNetwork:         req.GetNetwork().GetValue(),
+		KeyNodeVersion:     req.GetNodeVersion().GetValue(),
+		KeyLocation:        req.GetLocation().GetValue(),
+		KeyFetchedAt:       req.GetFetchedAt().AsTime(),
+		KeyContentEncoding: req.GetContentEncoding().GetValue(),
+	}
+
+	i.log.WithFields(logFields).WithField("id", witness.GetId().GetValue()).Debug("Indexed execution witness")
+
+	return &indexer.CreateExecutionWitnessResponse{
+		Id: witness.GetId(),
+	}, nil
+}
+
+// checkExecutionWitnessNotIndexed returns an AlreadyExists error if the node already
+// indexed the execution witness.
+func (i *Indexer) checkExecutionWitnessNotIndexed(ctx context.Context, req *indexer.CreateExecutionWitnessRequest) error {
+	filter := &persistence.ExecutionWitnessFilter{}
+
+	filter.AddNetwork(req.GetNetwork().GetValue())
+	filter.AddBlockHash(req.GetBlockHash().GetValue())
+	filter.AddNode(req.GetNode().GetValue())
+
+	witnesses, err := i.db.ListExecutionWitness(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if len(witnesses) > 0 {
+		return status.Error(codes.AlreadyExists, "execution witness already indexed")
+	}
+
+	return nil
+}
+
+func (i *Indexer) ListExecutionWitness(ctx context.Context, req *indexer.ListExecutionWitnessRequest) (*indexer.ListExecutionWitnessResponse, error) {
+	filter := &persistence.ExecutionWitnessFilter{}
+
+	if req.Id != "" {
+		filter.AddID(req.Id)
+	}
+
+	if req.Node != "" {
+		filter.AddNode(req.Node)
+	}
+
+	if req.BlockNumber != 0 {
+		filter.AddBlockNumber(req.BlockNumber)
+	}
+
+	if req.BlockHash != "" {
+		filter.AddBlockHash(req.BlockHash)
+	}
+
+	if req.Location != "" {
+		filter.AddLocation(req.Location)
+	}
+
+	if req.Network != "" {
+		filter.AddNetwork(req.Network)
+	}
+
+	if req.Before != nil {
+		filter.AddBefore(req.Before.AsTime())
+	}
+
+	if req.After != nil {
+		filter.AddAfter(req.After.AsTime())
+	}
+
+	if req.ExecutionImplementation != "" {
+		filter.AddExecutionImplementation(req.ExecutionImplementation)
+	}
+
+	if req.NodeVersion != "" {
+		filter.AddNodeVersion(req.NodeVersion)
+	}
+
+	pagination := &persistence.PaginationCursor{
+		Limit:   1000,
+		Offset:  0,
+		OrderBy: "fetched_at DESC",
+	}
+
+	if req.Pagination != nil {
+		pagination = ProtoPaginationCursorToDBPaginationCursor(req.Pagination)
+	}
+
+	executionWitnesses, err := i.db.ListExecutionWitness(ctx, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	protoExecutionWitnesses := make([]*indexer.ExecutionWitness, len(executionWitnesses))
+	for i, witness := range executionWitnesses {
+		protoExecutionWitnesses[i] = DBExecutionWitnessToProtoExecutionWitness(witness)
+	}
+
+	return &indexer.ListExecutionWitnessResponse{
+		ExecutionWitnesses: protoExecutionWitnesses,
+	}, nil
+}
+
+func (i *Indexer) CountExecutionWitness(ctx context.Context, req *indexer.CountExecutionWitnessRequest) (*indexer.CountExecutionWitnessResponse, error) {
+	filter := &persistence.ExecutionWitnessFilter{}
+
+	if req.Node != "" {
+		filter.AddNode(req.Node)
+	}
+
+	if req.BlockNumber != 0 {
+		filter.AddBlockNumber(req.BlockNumber)
+	}
+
+	if req.BlockHash != "" {
+		filter.AddBlockHash(req.BlockHash)
+	}
+
+	if req.Location != "" {
+		filter.AddLocation(req.Location)
+	}
+
+	if req.Network != "" {
+		filter.AddNetwork(req.Network)
+	}
+
+	if req.Before != nil {
+		filter.AddBefore(req.Before.AsTime())
+	}
+
+	if req.After != nil {
+		filter.AddAfter(req.After.AsTime())
+	}
+
+	if req.ExecutionImplementation != "" {
+		filter.AddExecutionImplementation(req.ExecutionImplementation)
+	}
+
+	if req.NodeVersion != "" {
+		filter.AddNodeVersion(req.NodeVersion)
+	}
+
+	executionWitnesses, err := i.db.CountExecutionWitness(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexer.CountExecutionWitnessResponse{
+		//nolint:gosec // not worried about int64 overflow here
+		Count: wrapperspb.UInt64(uint64(executionWitnesses)),
+	}, nil
+}
+
+func (i *Indexer) ListUniqueExecutionWitnessValues(ctx context.Context, req *indexer.ListUniqueExecutionWitnessValuesRequest) (*indexer.ListUniqueExecutionWitnessValuesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, len(req.Fields))
+
+	for idx, field := range req.Fields {
+		switch field {
+		case indexer.ListUniqueExecutionWitnessValuesRequest_NODE:
+			fields[idx] = KeyNode
+		case indexer.ListUniqueExecutionWitnessValuesRequest_BLOCK_HASH:
+			fields[idx] = KeyBlockHash
+		case indexer.ListUniqueExecutionWitnessValuesRequest_BLOCK_NUMBER:
+			fields[idx] = KeyBlockNumber
+		case indexer.ListUniqueExecutionWitnessValuesRequest_LOCATION:
+			fields[idx] = KeyLocation
+		case indexer.ListUniqueExecutionWitnessValuesRequest_NETWORK:
+			fields[idx] = KeyNetwork
+		case indexer.ListUniqueExecutionWitnessValuesRequest_EXECUTION_IMPLEMENTATION:
+			fields[idx] = KeyExecutionImplementation
+		case indexer.ListUniqueExecutionWitnessValuesRequest_NODE_VERSION:
+			fields[idx] = KeyNodeVersion
+		}
+	}
+
+	distinctValues, err := i.db.DistinctExecutionWitnessValues(ctx, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &indexer.ListUniqueExecutionWitnessValuesResponse{
+		Node:                    distinctValues.Node,
+		BlockHash:               distinctValues.BlockHash,
+		BlockNumber:             distinctValues.BlockNumber,
+		Location:                distinctValues.Location,
+		Network:                 distinctValues.Network,
+		ExecutionImplementation: distinctValues.ExecutionImplementation,
+		NodeVersion:             distinctValues.NodeVersion,
+	}
+
+	return response, nil
+}
+
+func (i *Indexer) CreateExecutionBadBlock(ctx context.Context, req *indexer.CreateExecutionBadBlockRequest) (*indexer.CreateExecutionBadBlockResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := i.checkExecutionBadBlockNotIndexed(ctx, req); err != nil {
+		return nil, err
+	}
+
+	// Create the execution bad block
+	block := &indexer.ExecutionBadBlock{
+		Id:                        wrapperspb.String(uuid.New().String()),
+		Node:                      req.GetNode(),
+		FetchedAt:                 req.GetFetchedAt(),
+		BlockHash:                 req.GetBlockHash(),
+		BlockNumber:               req.GetBlockNumber(),
+		BlockExtraData:            req.GetBlockExtraData(),
+		Location:                  req.GetLocation(),
+		ContentEncoding:           req.GetContentEncoding(),
+		Network:                   req.GetNetwork(),
+		ExecutionImplementation:   req.GetExecutionImplementation(),
+		NodeVersion:               req.GetNodeVersion(),
+		TraceLocation:             req.GetTraceLocation(),
+		IntermediateRootsLocation: req.GetIntermediateRootsLocation(),
 	}
 
 	if err := block.Validate(); err != nil {
@@ -1461,6 +2453,25 @@ func (i *Indexer) CreateExecutionBadBlock(ctx context.Context, req *indexer.Crea
 	}, nil
 }
 
+func (i *Indexer) checkExecutionBadBlockNotIndexed(ctx context.Context, req *indexer.CreateExecutionBadBlockRequest) error {
+	filter := &persistence.ExecutionBadBlockFilter{}
+
+	filter.AddNetwork(req.GetNetwork().GetValue())
+	filter.AddBlockHash(req.GetBlockHash().GetValue())
+	filter.AddNode(req.GetNode().GetValue())
+
+	blocks, err := i.db.ListExecutionBadBlock(ctx, filter, &persistence.PaginationCursor{Limit: 1, Offset: 0})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if len(blocks) > 0 {
+		return status.Error(codes.AlreadyExists, "execution bad block already indexed")
+	}
+
+	return nil
+}
+
 func (i *Indexer) ListExecutionBadBlock(ctx context.Context, req *indexer.ListExecutionBadBlockRequest) (*indexer.ListExecutionBadBlockResponse, error) {
 	filter := &persistence.ExecutionBadBlockFilter{}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/ethpandaops/beacon/pkg/human"
 	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
 	"github.com/ethpandaops/tracoor/pkg/server/persistence"
+	"github.com/ethpandaops/tracoor/pkg/store"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -157,3 +158,106 @@ func TestIndexerBeaconBlockExpiration(t *testing.T) {
 		t.Fatalf("beacon block was deleted during cleanup")
 	}
 }
+
+func TestIndexerSharedBeaconStateExpiration(t *testing.T) {
+	ctx := context.Background()
+	config := Config{
+		Retention: RetentionConfig{
+			BeaconStates: human.Duration{Duration: 30 * time.Minute},
+		},
+	}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if cerr := cleanup(); cerr != nil {
+			t.Fatalf("failed to cleanup: %v", cerr)
+		}
+	}()
+
+	data := []byte("state")
+
+	insert := func(node, location string, fetchedAt time.Time) string {
+		state := &indexer.BeaconState{
+			Id:                   wrapperspb.String(uuid.New().String()),
+			Node:                 wrapperspb.String(node),
+			Network:              wrapperspb.String("test-network"),
+			Slot:                 wrapperspb.UInt64(1),
+			Epoch:                wrapperspb.UInt64(0),
+			StateRoot:            wrapperspb.String("test-state-root"),
+			NodeVersion:          wrapperspb.String("test-node-version"),
+			Location:             wrapperspb.String(location),
+			FetchedAt:            timestamppb.New(fetchedAt),
+			BeaconImplementation: wrapperspb.String("test-implementation"),
+		}
+
+		if ierr := index.db.InsertBeaconState(ctx, ProtoBeaconStateToDBBeaconState(state)); ierr != nil {
+			t.Fatalf("failed to insert beacon state: %v", ierr)
+		}
+
+		return state.Id.GetValue()
+	}
+
+	// One node's reference to the shared state has expired, the other's hasn't.
+	sharedLocation := "beacon_states/test-network/roots/shared.ssz.gz"
+
+	if _, serr := index.Store().SaveBeaconState(ctx, &store.SaveParams{Data: &data, Location: sharedLocation}); serr != nil {
+		t.Fatalf("failed to save beacon state: %v", serr)
+	}
+
+	expiredID := insert("node-a", sharedLocation, time.Now().Add(-time.Hour))
+	insert("node-b", sharedLocation, time.Now())
+
+	// Every reference to the other shared state has expired.
+	expiredLocation := "beacon_states/test-network/roots/expired.ssz.gz"
+
+	if _, serr := index.Store().SaveBeaconState(ctx, &store.SaveParams{Data: &data, Location: expiredLocation}); serr != nil {
+		t.Fatalf("failed to save beacon state: %v", serr)
+	}
+
+	insert("node-a", expiredLocation, time.Now().Add(-time.Hour))
+	insert("node-b", expiredLocation, time.Now().Add(-time.Hour))
+
+	if perr := index.purgeOldBeaconStates(ctx, false); perr != nil {
+		t.Fatalf("failed to purge old beacon states: %v", perr)
+	}
+
+	count, err := index.db.CountBeaconState(ctx, &persistence.BeaconStateFilter{ID: &expiredID})
+	if err != nil {
+		t.Fatalf("failed to count beacon states: %v", err)
+	}
+
+	if count != 0 {
+		t.Fatalf("expired reference to the shared beacon state was not deleted")
+	}
+
+	exists, err := index.Store().Exists(ctx, sharedLocation)
+	if err != nil {
+		t.Fatalf("failed to check store: %v", err)
+	}
+
+	if !exists {
+		t.Fatalf("shared beacon state was deleted while still referenced")
+	}
+
+	exists, err = index.Store().Exists(ctx, expiredLocation)
+	if err != nil {
+		t.Fatalf("failed to check store: %v", err)
+	}
+
+	if exists {
+		t.Fatalf("shared beacon state was not deleted with its last reference")
+	}
+
+	count, err = index.db.CountBeaconState(ctx, &persistence.BeaconStateFilter{Location: &expiredLocation})
+	if err != nil {
+		t.Fatalf("failed to count beacon states: %v", err)
+	}
+
+	if count != 0 {
+		t.Fatalf("expected every reference to the expired beacon state to be deleted, got %d", count)
+	}
+}
@@ -1,10 +1,47 @@
 package indexer
 
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
 type Metrics struct {
+	stateComparisons *prometheus.CounterVec
+	stateDivergences *prometheus.CounterVec
+}
+
+var (
+	metricsInstance *Metrics
+	metricsOnce     sync.Once
+)
+
+func GetMetricsInstance(namespace string) *Metrics {
+	metricsOnce.Do(func() {
+		metricsInstance = &Metrics{
+			stateComparisons: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "beacon_state_comparisons_count",
+				Help:      "The number of beacon state roots compared against other nodes for the same slot",
+			}, []string{"network"}),
+			stateDivergences: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "beacon_state_divergences_count",
+				Help:      "The number of beacon state root divergences detected between nodes",
+			}, []string{"network", "beacon_implementation", "conflicting_beacon_implementation"}),
+		}
+
+		prometheus.MustRegister(metricsInstance.stateComparisons)
+		prometheus.MustRegister(metricsInstance.stateDivergences)
+	})
+
+	return metricsInstance
 }
 
-func NewMetrics(namespace string) *Metrics {
-	m := &Metrics{}
+func (m *Metrics) IncrementStateComparisons(network string, count int) {
+	m.stateComparisons.WithLabelValues(network).Add(float64(count))
+}
 
-	return m
+func (m *Metrics) IncrementStateDivergences(network, beaconImplementation, conflictingBeaconImplementation string) {
+	m.stateDivergences.WithLabelValues(network, beaconImplementation, conflictingBeaconImplementation).Inc()
 }
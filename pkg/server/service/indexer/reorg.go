@@ -0,0 +1,59 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// MarkOrphaned flags the blocks, states and traces a node indexed for blocks
+// that a reorg removed from its canonical chain. Copies indexed by other nodes
+// are left alone as they may not have seen the same reorg.
+func (i *Indexer) MarkOrphaned(ctx context.Context, req *indexer.MarkOrphanedRequest) (*indexer.MarkOrphanedResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	blocks, err := i.db.MarkBeaconBlocksOrphaned(ctx, req.GetNode(), req.GetNetwork(), req.GetBlockRoots())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	states, err := i.db.MarkBeaconStatesOrphaned(ctx, req.GetNode(), req.GetNetwork(), req.GetStateRoots())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// States on an orphaned fork were expected to differ from the canonical chain.
+	divergences, err := i.db.DeleteOrphanedBeaconStateDivergences(ctx, req.GetNode(), req.GetNetwork(), req.GetStateRoots())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	traces, err := i.db.MarkExecutionBlockTracesOrphaned(ctx, req.GetNode(), req.GetNetwork(), req.GetExecutionBlockHashes())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	i.log.WithFields(logrus.Fields{
+		KeyNode:                  req.GetNode(),
+		KeyNetwork:               req.GetNetwork(),
+		"beacon_blocks":          blocks,
+		"beacon_states":          states,
+		"state_divergences":      divergences,
+		"execution_block_traces": traces,
+	}).Debug("Marked orphaned artifacts")
+
+	return &indexer.MarkOrphanedResponse{
+		//nolint:gosec // not worried about int64 overflow here
+		BeaconBlocks: wrapperspb.UInt64(uint64(blocks)),
+		//nolint:gosec // not worried about int64 overflow here
+		BeaconStates: wrapperspb.UInt64(uint64(states)),
+		//nolint:gosec // not worried about int64 overflow here
+		ExecutionBlockTraces: wrapperspb.UInt64(uint64(traces)),
+	}, nil
+}
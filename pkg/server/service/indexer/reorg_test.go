@@ -0,0 +1,140 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+
+	pindexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestIndexerMarkOrphaned(t *testing.T) {
+	ctx := context.Background()
+	config := Config{}
+
+	index, cleanup, err := NewMockIndexer(ctx, &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	node := generateRandomString(5)
+	network := generateRandomString(5)
+
+	block := createStoredBeaconBlockRequest(ctx, t, index)
+	block.Node = wrapperspb.String(node)
+	block.Network = wrapperspb.String(network)
+
+	state := createStoredBeaconStateRequest(ctx, t, index)
+	state.Node = wrapperspb.String(node)
+	state.Network = wrapperspb.String(network)
+
+	trace := createRandomExecutionBlockTraceRequest()
+	trace.Node = wrapperspb.String(node)
+	trace.Network = wrapperspb.String(network)
+
+	if _, err := index.CreateBeaconBlock(ctx, block); err != nil {
+		t.Fatalf("failed to create beacon block: %v", err)
+	}
+
+	if _, err := index.CreateBeaconState(ctx, state); err != nil {
+		t.Fatalf("failed to create beacon state: %v", err)
+	}
+
+	if _, err := index.CreateExecutionBlockTrace(ctx, trace); err != nil {
+		t.Fatalf("failed to create execution block trace: %v", err)
+	}
+
+	t.Run("New artifacts are canonical", func(t *testing.T) {
+		rsp, err := index.CountBeaconBlock(ctx, &pindexer.CountBeaconBlockRequest{
+			Node:      node,
+			Canonical: wrapperspb.Bool(true),
+		})
+		if err != nil {
+			t.Fatalf("failed to count beacon blocks: %v", err)
+		}
+
+		if rsp.Count.Value != 1 {
+			t.Fatalf("expected 1 canonical beacon block, got %d", rsp.Count.Value)
+		}
+	})
+
+	t.Run("Requires a root or hash", func(t *testing.T) {
+		if _, err := index.MarkOrphaned(ctx, &pindexer.MarkOrphanedRequest{Node: node, Network: network}); err == nil {
+			t.Fatal("expected error when nothing is orphaned")
+		}
+	})
+
+	t.Run("Marks the node's artifacts as orphaned", func(t *testing.T) {
+		rsp, err := index.MarkOrphaned(ctx, &pindexer.MarkOrphanedRequest{
+			Node:                 node,
+			Network:              network,
+			BlockRoots:           []string{block.BlockRoot.Value},
+			StateRoots:           []string{state.StateRoot.Value},
+			ExecutionBlockHashes: []string{trace.BlockHash.Value},
+		})
+		if err != nil {
+			t.Fatalf("failed to mark orphaned: %v", err)
+		}
+
+		if rsp.BeaconBlocks.Value != 1 || rsp.BeaconStates.Value != 1 || rsp.ExecutionBlockTraces.Value != 1 {
+			t.Fatalf("expected 1 of each artifact to be orphaned, got %v", rsp)
+		}
+
+		blocks, err := index.ListBeaconBlock(ctx, &pindexer.ListBeaconBlockRequest{
+			Node:      node,
+			Canonical: wrapperspb.Bool(false),
+		})
+		if err != nil {
+			t.Fatalf("failed to list beacon blocks: %v", err)
+		}
+
+		if len(blocks.BeaconBlocks) != 1 || blocks.BeaconBlocks[0].GetCanonical().GetValue() {
+			t.Fatalf("expected 1 orphaned beacon block, got %v", blocks.BeaconBlocks)
+		}
+
+		states, err := index.ListBeaconState(ctx, &pindexer.ListBeaconStateRequest{
+			Node:      node,
+			Canonical: wrapperspb.Bool(false),
+		})
+		if err != nil {
+			t.Fatalf("failed to list beacon states: %v", err)
+		}
+
+		if len(states.BeaconStates) != 1 {
+			t.Fatalf("expected 1 orphaned beacon state, got %d", len(states.BeaconStates))
+		}
+
+		traces, err := index.CountExecutionBlockTrace(ctx, &pindexer.CountExecutionBlockTraceRequest{
+			Node:      node,
+			Canonical: wrapperspb.Bool(true),
+		})
+		if err != nil {
+			t.Fatalf("failed to count execution block traces: %v", err)
+		}
+
+		if traces.Count.Value != 0 {
+			t.Fatalf("expected no canonical execution block traces, got %d", traces.Count.Value)
+		}
+	})
+
+	t.Run("Leaves other nodes alone", func(t *testing.T) {
+		rsp, err := index.MarkOrphaned(ctx, &pindexer.MarkOrphanedRequest{
+			Node:       generateRandomString(5),
+			Network:    network,
+			BlockRoots: []string{block.BlockRoot.Value},
+		})
+		if err != nil {
+			t.Fatalf("failed to mark orphaned: %v", err)
+		}
+
+		if rsp.BeaconBlocks.Value != 0 {
+			t.Fatalf("expected no beacon blocks to be orphaned, got %d", rsp.BeaconBlocks.Value)
+		}
+	})
+}
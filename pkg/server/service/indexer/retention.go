@@ -13,23 +13,40 @@ import (
 
 func (i *Indexer) startRetentionWatchers(ctx context.Context) {
 	i.log.WithFields(logrus.Fields{
-		"beacon_state":          i.config.Retention.BeaconStates.Duration,
-		"beacon_block":          i.config.Retention.BeaconBlocks.Duration,
-		"beacon_bad_block":      i.config.Retention.BeaconBadBlocks.Duration,
-		"beacon_bad_blob":       i.config.Retention.BeaconBadBlobs.Duration,
-		"execution_block_trace": i.config.Retention.ExecutionBlockTraces.Duration,
-		"execution_bad_block":   i.config.Retention.ExecutionBadBlocks.Duration,
+		"beacon_state":           i.config.Retention.BeaconStates.Duration,
+		"finalized_beacon_state": i.config.Retention.FinalizedBeaconStates.Duration,
+		"beacon_block":           i.config.Retention.BeaconBlocks.Duration,
+		"blob_sidecar":           i.config.Retention.BlobSidecars.Duration,
+		"fork_choice":            i.config.Retention.ForkChoices.Duration,
+		"beacon_bad_block":       i.config.Retention.BeaconBadBlocks.Duration,
+		"beacon_bad_blob":        i.config.Retention.BeaconBadBlobs.Duration,
+		"execution_block_trace":  i.config.Retention.ExecutionBlockTraces.Duration,
+		"execution_witness":      i.config.Retention.ExecutionWitnesses.Duration,
+		"execution_bad_block":    i.config.Retention.ExecutionBadBlocks.Duration,
+		"state_divergence":       i.config.Retention.StateDivergences.Duration,
 	}).Info("Starting retention watcher")
 
 	for {
-		if err := i.purgeOldBeaconStates(ctx); err != nil {
+		if err := i.purgeOldBeaconStates(ctx, false); err != nil {
 			i.log.WithError(err).Error("Failed to delete old beacon states")
 		}
 
+		if err := i.purgeOldBeaconStates(ctx, true); err != nil {
+			i.log.WithError(err).Error("Failed to delete old finalized beacon states")
+		}
+
 		if err := i.purgeOldBeaconBlocks(ctx); err != nil {
 			i.log.WithError(err).Error("Failed to delete old beacon blocks")
 		}
 
+		if err := i.purgeOldBlobSidecars(ctx); err != nil {
+			i.log.WithError(err).Error("Failed to delete old blob sidecars")
+		}
+
+		if err := i.purgeOldForkChoices(ctx); err != nil {
+			i.log.WithError(err).Error("Failed to delete old fork choices")
+		}
+
 		if err := i.purgeOldBeaconBadBlocks(ctx); err != nil {
 			i.log.WithError(err).Error("Failed to delete old beacon bad blocks")
 		}
@@ -42,10 +59,18 @@ func (i *Indexer) startRetentionWatchers(ctx context.Context) {
 			i.log.WithError(err).Error("Failed to delete old execution traces")
 		}
 
+		if err := i.purgeOldExecutionWitnesses(ctx); err != nil {
+			i.log.WithError(err).Error("Failed to delete old execution witnesses")
+		}
+
 		if err := i.purgeOldExecutionBadBlocks(ctx); err != nil {
 			i.log.WithError(err).Error("Failed to delete old execution bad blocks")
 		}
 
+		if err := i.purgeOldStateDivergences(ctx); err != nil {
+			i.log.WithError(err).Error("Failed to delete old state divergences")
+		}
+
 		select {
 		case <-time.After(1 * time.Minute):
 		case <-ctx.Done():
@@ -54,47 +79,148 @@ func (i *Indexer) startRetentionWatchers(ctx context.Context) {
 	}
 }
 
-func (i *Indexer) purgeOldBeaconStates(ctx context.Context) error {
-	before := time.Now().Add(-i.config.Retention.BeaconStates.Duration)
+// purgeOldBeaconStates deletes beacon states past their retention. Finalized
+// checkpoint states have their own, longer, retention.
+func (i *Indexer) purgeOldBeaconStates(ctx context.Context, finalized bool) error {
+	retention := i.config.Retention.BeaconStates.Duration
+	if finalized {
+		retention = i.config.Retention.FinalizedBeaconStates.Duration
+	}
+
+	before := time.Now().Add(-retention)
 
 	filter := &persistence.BeaconStateFilter{
 		Before: &before,
 	}
 
+	filter.AddFinalized(finalized)
+
 	states, err := i.db.ListBeaconState(ctx, filter, &persistence.PaginationCursor{Limit: 10000, Offset: 0, OrderBy: "fetched_at ASC"})
 	if err != nil {
 		return err
 	}
 
-	i.log.WithField("before", before).Debugf("Purging %d old beacon states", len(states))
+	i.log.WithField("before", before).WithField("finalized", finalized).Debugf("Purging %d old beacon states", len(states))
 
 	for _, state := range states {
+		// Content addressed states are shared by nodes, so the object is only
+		// deleted along with its last reference.
+		if err := i.db.DeleteBeaconStateReference(ctx, state, func() error {
+			if err := i.store.DeleteBeaconState(ctx, state.Location); err != nil {
+				if !errors.Is(err, store.ErrNotFound) {
+					return err
+				}
+
+				i.log.WithField("state_id", state.ID).Warn("Beacon state not found in store")
+			}
+
+			return nil
+		}); err != nil {
+			i.log.WithError(err).WithField("state_id", state.ID).Error("Failed to delete beacon state, will retry next time")
+
+			continue
+		}
+
+		i.log.WithFields(
+			logrus.Fields{
+				"node":    state.Node,
+				"network": state.Network,
+				"slot":    state.Slot,
+				"id":      state.ID,
+			},
+		).Debug("Deleted beacon state")
+	}
+
+	return nil
+}
+
+func (i *Indexer) purgeOldBlobSidecars(ctx context.Context) error {
+	before := time.Now().Add(-i.config.Retention.BlobSidecars.Duration)
+
+	filter := &persistence.BlobSidecarFilter{
+		Before: &before,
+	}
+
+	sidecars, err := i.db.ListBlobSidecar(ctx, filter, &persistence.PaginationCursor{Limit: 10000, Offset: 0, OrderBy: "fetched_at ASC"})
+	if err != nil {
+		return err
+	}
+
+	i.log.WithField("before", before).Debugf("Purging %d old blob sidecars", len(sidecars))
+
+	for _, sidecar := range sidecars {
 		// Delete from the store first
-		if err := i.store.DeleteBeaconState(ctx, state.Location); err != nil {
+		if err := i.store.DeleteBlobSidecar(ctx, sidecar.Location); err != nil {
 			if errors.Is(err, store.ErrNotFound) {
-				i.log.WithField("state_id", state.ID).Warn("Beacon state not found in store")
+				i.log.WithField("blob_sidecar_id", sidecar.ID).Warn("Blob sidecar not found in store")
 			} else {
-				i.log.WithError(err).WithField("state_id", state.ID).Error("Failed to delete beacon state from store, will retry next time")
+				i.log.WithError(err).WithField("blob_sidecar_id", sidecar.ID).Error("Failed to delete blob sidecar from store, will retry next time")
 
 				continue
 			}
 		}
 
-		err := i.db.DeleteBeaconState(ctx, state.ID)
+		err := i.db.DeleteBlobSidecar(ctx, sidecar.ID)
 		if err != nil {
-			i.log.WithError(err).WithField("state_id", state.ID).Error("Failed to delete beacon state")
+			i.log.WithError(err).WithField("blob_sidecar_id", sidecar.ID).Error("Failed to delete blob sidecar")
 
 			continue
 		}
 
 		i.log.WithFields(
 			logrus.Fields{
-				"node":    state.Node,
-				"network": state.Network,
-				"slot":    state.Slot,
-				"id":      state.ID,
+				"node":    sidecar.Node,
+				"network": sidecar.Network,
+				"slot":    sidecar.Slot,
+				"id":      sidecar.ID,
 			},
-		).Debug("Deleted beacon state")
+		).Debug("Deleted blob sidecar")
+	}
+
+	return nil
+}
+
+func (i *Indexer) purgeOldForkChoices(ctx context.Context) error {
+	before := time.Now().Add(-i.config.Retention.ForkChoices.Duration)
+
+	filter := &persistence.ForkChoiceFilter{
+		Before: &before,
+	}
+
+	forkChoices, err := i.db.ListForkChoice(ctx, filter, &persistence.PaginationCursor{Limit: 10000, Offset: 0, OrderBy: "fetched_at ASC"})
+	if err != nil {
+		return err
+	}
+
+	i.log.WithField("before", before).Debugf("Purging %d old fork choices", len(forkChoices))
+
+	for _, forkChoice := range forkChoices {
+		// Delete from the store first
+		if err := i.store.DeleteForkChoice(ctx, forkChoice.Location); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				i.log.WithField("fork_choice_id", forkChoice.ID).Warn("Fork choice not found in store")
+			} else {
+				i.log.WithError(err).WithField("fork_choice_id", forkChoice.ID).Error("Failed to delete fork choice from store, will retry next time")
+
+				continue
+			}
+		}
+
+		err := i.db.DeleteForkChoice(ctx, forkChoice.ID)
+		if err != nil {
+			i.log.WithError(err).WithField("fork_choice_id", forkChoice.ID).Error("Failed to delete fork choice")
+
+			continue
+		}
+
+		i.log.WithFields(
+			logrus.Fields{
+				"node":    forkChoice.Node,
+				"network": forkChoice.Network,
+				"slot":    forkChoice.Slot,
+				"id":      forkChoice.ID,
+			},
+		).Debug("Deleted fork choice")
 	}
 
 	return nil
@@ -130,20 +256,20 @@ func (i *Indexer) purgeOldBeaconBlocks(ctx context.Context) error {
 		// Wait for the block to be processed
 		<-b.ProcessedChan
 
-		// Delete from the store first
-		if err := i.store.DeleteBeaconBlock(ctx, block.Location); err != nil {
-			if errors.Is(err, store.ErrNotFound) {
-				i.log.WithField("block_id", block.ID).Warn("Beacon block not found in store")
-			} else {
-				i.log.WithError(err).WithField("block_id", block.ID).Error("Failed to delete beacon block from store, will retry next time")
+		// Content addressed blocks are shared by nodes, so the object is only
+		// deleted along with its last reference.
+		if err := i.db.DeleteBeaconBlockReference(ctx, block, func() error {
+			if err := i.store.DeleteBeaconBlock(ctx, block.Location); err != nil {
+				if !errors.Is(err, store.ErrNotFound) {
+					return err
+				}
 
-				continue
+				i.log.WithField("block_id", block.ID).Warn("Beacon block not found in store")
 			}
-		}
 
-		err := i.db.DeleteBeaconBlock(ctx, block.ID)
-		if err != nil {
-			i.log.WithError(err).WithField("block_id", block.ID).Error("Failed to delete beacon block")
+			return nil
+		}); err != nil {
+			i.log.WithError(err).WithField("block_id", block.ID).Error("Failed to delete beacon block, will retry next time")
 
 			continue
 		}
@@ -300,6 +426,52 @@ func (i *Indexer) purgeOldExecutionTraces(ctx context.Context) error {
 	return nil
 }
 
+func (i *Indexer) purgeOldExecutionWitnesses(ctx context.Context) error {
+	before := time.Now().Add(-i.config.Retention.ExecutionWitnesses.Duration)
+
+	filter := &persistence.ExecutionWitnessFilter{
+		Before: &before,
+	}
+
+	witnesses, err := i.db.ListExecutionWitness(ctx, filter, &persistence.PaginationCursor{Limit: 10000, Offset: 0, OrderBy: "fetched_at ASC"})
+	if err != nil {
+		return err
+	}
+
+	i.log.WithField("before", before).Debugf("Purging %d old execution witnesses", len(witnesses))
+
+	for _, witness := range witnesses {
+		// Delete from the store first
+		if err := i.store.DeleteExecutionWitness(ctx, witness.Location); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				i.log.WithField("witness_id", witness.ID).Warn("Execution witness not found in store")
+			} else {
+				i.log.WithError(err).WithField("witness_id", witness.ID).Error("Failed to delete execution witness from store, will retry next time")
+
+				continue
+			}
+		}
+
+		err := i.db.DeleteExecutionWitness(ctx, witness.ID)
+		if err != nil {
+			i.log.WithError(err).WithField("witness_id", witness.ID).Error("Failed to delete execution witness")
+
+			continue
+		}
+
+		i.log.WithFields(
+			logrus.Fields{
+				"node":         witness.Node,
+				"network":      witness.Network,
+				"block_number": witness.BlockNumber,
+				"id":           witness.ID,
+			},
+		).Debug("Deleted execution witness")
+	}
+
+	return nil
+}
+
 func (i *Indexer) purgeOldExecutionBadBlocks(ctx context.Context) error {
 	before := time.Now().Add(-i.config.Retention.ExecutionBadBlocks.Duration)
 
@@ -315,15 +487,29 @@ func (i *Indexer) purgeOldExecutionBadBlocks(ctx context.Context) error {
 	i.log.WithField("before", before).Debugf("Purging %d old execution bad blocks", len(blocks))
 
 	for _, block := range blocks {
-		// Delete from the store first
-		if err := i.store.DeleteExecutionBadBlock(ctx, block.Location); err != nil {
-			if errors.Is(err, store.ErrNotFound) {
-				i.log.WithField("block_id", block.ID).Warn("Execution bad block not found in store")
-			} else {
-				i.log.WithError(err).WithField("block_id", block.ID).Error("Failed to delete execution bad block from store, will retry next time")
+		// Delete from the store first, including any traces captured alongside the block.
+		deleted := true
 
+		for _, location := range []string{block.Location, block.TraceLocation, block.IntermediateRootsLocation} {
+			if location == "" {
 				continue
 			}
+
+			if err := i.store.DeleteExecutionBadBlock(ctx, location); err != nil {
+				if errors.Is(err, store.ErrNotFound) {
+					i.log.WithField("block_id", block.ID).WithField("location", location).Warn("Execution bad block not found in store")
+				} else {
+					i.log.WithError(err).WithField("block_id", block.ID).Error("Failed to delete execution bad block from store, will retry next time")
+
+					deleted = false
+
+					break
+				}
+			}
+		}
+
+		if !deleted {
+			continue
 		}
 
 		err := i.db.DeleteExecutionBadBlock(ctx, block.ID)
@@ -345,3 +531,29 @@ func (i *Indexer) purgeOldExecutionBadBlocks(ctx context.Context) error {
 
 	return nil
 }
+
+func (i *Indexer) purgeOldStateDivergences(ctx context.Context) error {
+	before := time.Now().Add(-i.config.Retention.StateDivergences.Duration)
+
+	filter := &persistence.BeaconStateDivergenceFilter{
+		Before: &before,
+	}
+
+	divergences, err := i.db.ListBeaconStateDivergence(ctx, filter, &persistence.PaginationCursor{Limit: 10000, Offset: 0, OrderBy: "detected_at ASC"})
+	if err != nil {
+		return err
+	}
+
+	i.log.WithField("before", before).Debugf("Purging %d old state divergences", len(divergences))
+
+	for _, divergence := range divergences {
+		// Divergences only live in the db, there's nothing to remove from the store.
+		if err := i.db.DeleteBeaconStateDivergence(ctx, divergence.ID); err != nil {
+			i.log.WithError(err).WithField("divergence_id", divergence.ID).Error("Failed to delete state divergence")
+
+			continue
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,567 @@
+package indexer
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	"github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/server/persistence"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"github.com/ethpandaops/tracoor/pkg/tracediff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var errUploadMetadataRequired = status.Error(codes.InvalidArgument, "the first message of an upload must be its metadata")
+
+// uploadMessage is a message of an upload stream, carrying either the
+// metadata of the upload or a chunk of its data.
+type uploadMessage[Req any] interface {
+	GetMetadata() Req
+	GetChunk() []byte
+}
+
+// uploadFileMessage is implemented by upload messages that can start another
+// file of the upload.
+type uploadFileMessage interface {
+	GetFile() string
+}
+
+// uploadStream is the server side of an upload stream.
+type uploadStream[Req any, Msg uploadMessage[Req], Rsp any] interface {
+	Context() context.Context
+	Recv() (Msg, error)
+	SendAndClose(Rsp) error
+}
+
+// uploadRequest is the metadata of an upload.
+type uploadRequest interface {
+	comparable
+	Validate() error
+	GetNode() *wrapperspb.StringValue
+	GetLocation() *wrapperspb.StringValue
+	GetContentEncoding() *wrapperspb.StringValue
+}
+
+// uploadSpec describes how the server saves and indexes an upload of a data type.
+type uploadSpec[Req uploadRequest, Rsp any] struct {
+	dataType store.DataType
+	// locations returns the locations the upload may be saved at. They're
+	// built from the metadata so an upload can't write anywhere else.
+	locations func(req Req) ([]string, error)
+	// files returns the locations of the extra files the upload may carry,
+	// which must already have been checked by locations.
+	files func(req Req) []string
+	// dropFile removes an extra file that wasn't uploaded from the metadata,
+	// so it isn't indexed.
+	dropFile func(req Req, file string)
+	// check returns an error if the upload must be rejected before it's
+	// saved, and false if it only has to be indexed.
+	check func(ctx context.Context, req Req) (bool, error)
+	// create indexes the upload.
+	create func(ctx context.Context, req Req) (Rsp, error)
+}
+
+// uploadReader reads the data of an upload from its chunk messages. It stops
+// at the end of the stream, or when a message starts the next file of the
+// upload.
+type uploadReader struct {
+	recv func() (chunk []byte, file string, err error)
+
+	buf  []byte
+	next string
+	done bool
+}
+
+func newUploadReader(recv func() ([]byte, string, error)) *uploadReader {
+	return &uploadReader{recv: recv}
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.done || r.next != "" {
+			return 0, io.EOF
+		}
+
+		chunk, file, err := r.recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				r.done = true
+
+				return 0, io.EOF
+			}
+
+			return 0, err
+		}
+
+		if file != "" {
+			r.next = file
+
+			return 0, io.EOF
+		}
+
+		r.buf = chunk
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
+
+// nextFile returns the location of the next file of the upload and starts
+// reading it. An empty location is returned if there are no more files.
+func (r *uploadReader) nextFile() string {
+	next := r.next
+	r.next = ""
+
+	return next
+}
+
+// uploadNode returns the node the upload's authorization token is bound to.
+func (i *Indexer) uploadNode(ctx context.Context) (string, error) {
+	if len(i.config.Uploads.Tokens) == 0 {
+		return "", status.Error(codes.PermissionDenied, "uploads are disabled")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "authorization token is required")
+	}
+
+	provided := []byte(strings.TrimPrefix(values[0], "Bearer "))
+
+	for _, token := range i.config.Uploads.Tokens {
+		if subtle.ConstantTimeCompare(provided, []byte(token.Token)) == 1 {
+			return token.Node, nil
+		}
+	}
+
+	return "", status.Error(codes.Unauthenticated, "invalid authorization token")
+}
+
+// validateLocationComponents makes sure metadata used in a location can't
+// change its structure or escape the store's base path.
+func validateLocationComponents(components ...string) error {
+	for _, component := range components {
+		if component == "" ||
+			component == "." ||
+			component == ".." ||
+			strings.ContainsAny(component, "/\\") {
+			return fmt.Errorf("invalid location component %q", component)
+		}
+	}
+
+	return nil
+}
+
+// sharedLocation returns the location of a content addressed object, or an
+// empty location if the content encoding is unknown.
+func sharedLocation(location, contentEncoding string) string {
+	algorithm, err := compression.GetCompressionAlgorithmFromContentEncoding(contentEncoding)
+	if err != nil {
+		return ""
+	}
+
+	return compression.AddExtension(location+".ssz", algorithm)
+}
+
+// withExtensions returns location with each of the extensions.
+func withExtensions(location string, extensions ...string) []string {
+	locations := make([]string, 0, len(extensions))
+
+	for _, extension := range extensions {
+		locations = append(locations, location+extension)
+	}
+
+	return locations
+}
+
+// saveUpload saves the data of an upload to the store.
+func (i *Indexer) saveUpload(ctx context.Context, dataType store.DataType, location, contentEncoding string, data io.Reader) error {
+	if _, err := i.store.SaveStream(ctx, dataType, &store.SaveStreamParams{
+		Data:            data,
+		Location:        location,
+		ContentEncoding: contentEncoding,
+	}); err != nil {
+		i.log.
+			WithError(err).
+			WithField(KeyLocation, location).
+			WithField("type", dataType).
+			Error("Failed to save upload")
+
+		return status.Error(codes.Internal, fmt.Sprintf("failed to save %s", dataType))
+	}
+
+	return nil
+}
+
+// handleUpload authorizes and checks an upload, saves its data to the store
+// and indexes it. The upload's metadata is checked before any of its data is
+// saved.
+func handleUpload[Req uploadRequest, Msg uploadMessage[Req], Rsp any](i *Indexer, stream uploadStream[Req, Msg, Rsp], spec *uploadSpec[Req, Rsp]) error {
+	ctx := stream.Context()
+
+	node, err := i.uploadNode(ctx)
+	if err != nil {
+		return err
+	}
+
+	msg, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	var req Req
+	if err == nil {
+		req = msg.GetMetadata()
+	}
+
+	var zero Req
+	if req == zero {
+		return errUploadMetadataRequired
+	}
+
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetNode().GetValue() != node {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("not authorized to upload for node %s", req.GetNode().GetValue()))
+	}
+
+	locations, err := spec.locations(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	location := req.GetLocation().GetValue()
+	if !slices.Contains(locations, location) {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid location %s for %s", location, spec.dataType))
+	}
+
+	save := true
+
+	if spec.check != nil {
+		save, err = spec.check(ctx, req)
+		if err != nil {
+			return err
+		}
+	}
+
+	if save {
+		data := newUploadReader(func() ([]byte, string, error) {
+			msg, err := stream.Recv()
+			if err != nil {
+				return nil, "", err
+			}
+
+			var file string
+			if m, ok := any(msg).(uploadFileMessage); ok {
+				file = m.GetFile()
+			}
+
+			return msg.GetChunk(), file, nil
+		})
+
+		if err := i.saveUpload(ctx, spec.dataType, location, req.GetContentEncoding().GetValue(), data); err != nil {
+			return err
+		}
+
+		var files []string
+		if spec.files != nil {
+			files = spec.files(req)
+		}
+
+		received := make(map[string]bool, len(files))
+
+		for file := data.nextFile(); file != ""; file = data.nextFile() {
+			if !slices.Contains(files, file) {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("file %s is not part of the %s", file, spec.dataType))
+			}
+
+			if err := i.saveUpload(ctx, spec.dataType, file, req.GetContentEncoding().GetValue(), data); err != nil {
+				return err
+			}
+
+			received[file] = true
+		}
+
+		for _, file := range files {
+			if !received[file] {
+				spec.dropFile(req, file)
+			}
+		}
+	}
+
+	rsp, err := spec.create(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(rsp)
+}
+
+func (i *Indexer) UploadBeaconState(stream indexer.Indexer_UploadBeaconStateServer) error {
+	return handleUpload(i, stream, &uploadSpec[*indexer.CreateBeaconStateRequest, *indexer.CreateBeaconStateResponse]{
+		dataType: store.BeaconStateDataType,
+		locations: func(req *indexer.CreateBeaconStateRequest) ([]string, error) {
+			if err := validateLocationComponents(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetStateRoot().GetValue()); err != nil {
+				return nil, err
+			}
+
+			return []string{
+				store.CreateBeaconStateFileName(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetSlot().GetValue(), req.GetStateRoot().GetValue()) + ".ssz",
+				sharedLocation(store.CreateSharedBeaconStateFileName(req.GetNetwork().GetValue(), req.GetStateRoot().GetValue()), req.GetContentEncoding().GetValue()),
+			}, nil
+		},
+		check: func(ctx context.Context, req *indexer.CreateBeaconStateRequest) (bool, error) {
+			existing, err := i.indexedBeaconState(ctx, req)
+			if err != nil {
+				return false, status.Error(codes.Internal, err.Error())
+			}
+
+			if existing != nil {
+				if !req.GetFinalized().GetValue() {
+					return false, status.Error(codes.AlreadyExists, "beacon state already indexed")
+				}
+
+				// The state is already stored, so it only has to be flagged as
+				// finalized.
+				return false, nil
+			}
+
+			// A content addressed state another node indexed isn't overwritten.
+			// Indexing it checks that it's still stored.
+			location := req.GetLocation().GetValue()
+
+			references, err := i.db.CountBeaconState(ctx, &persistence.BeaconStateFilter{Location: &location})
+			if err != nil {
+				return false, status.Error(codes.Internal, err.Error())
+			}
+
+			return references == 0, nil
+		},
+		create: i.CreateBeaconState,
+	})
+}
+
+func (i *Indexer) UploadBeaconBlock(stream indexer.Indexer_UploadBeaconBlockServer) error {
+	return handleUpload(i, stream, &uploadSpec[*indexer.CreateBeaconBlockRequest, *indexer.CreateBeaconBlockResponse]{
+		dataType: store.BeaconBlockDataType,
+		locations: func(req *indexer.CreateBeaconBlockRequest) ([]string, error) {
+			if err := validateLocationComponents(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetBlockRoot().GetValue()); err != nil {
+				return nil, err
+			}
+
+			return []string{
+				store.CreateBeaconBlockFileName(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetSlot().GetValue(), req.GetBlockRoot().GetValue()) + ".ssz",
+				sharedLocation(store.CreateSharedBeaconBlockFileName(req.GetNetwork().GetValue(), req.GetBlockRoot().GetValue()), req.GetContentEncoding().GetValue()),
+			}, nil
+		},
+		check: func(ctx context.Context, req *indexer.CreateBeaconBlockRequest) (bool, error) {
+			if err := i.checkBeaconBlockNotIndexed(ctx, req); err != nil {
+				return false, err
+			}
+
+			// A content addressed block another node indexed isn't overwritten.
+			// Indexing it checks that it's still stored.
+			location := req.GetLocation().GetValue()
+
+			references, err := i.db.CountBeaconBlock(ctx, &persistence.BeaconBlockFilter{Location: &location})
+			if err != nil {
+				return false, status.Error(codes.Internal, err.Error())
+			}
+
+			return references == 0, nil
+		},
+		create: i.CreateBeaconBlock,
+	})
+}
+
+func (i *Indexer) UploadBlobSidecar(stream indexer.Indexer_UploadBlobSidecarServer) error {
+	return handleUpload(i, stream, &uploadSpec[*indexer.CreateBlobSidecarRequest, *indexer.CreateBlobSidecarResponse]{
+		dataType: store.BlobSidecarDataType,
+		locations: func(req *indexer.CreateBlobSidecarRequest) ([]string, error) {
+			if err := validateLocationComponents(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetBlockRoot().GetValue(), req.GetSidecarType().GetValue()); err != nil {
+				return nil, err
+			}
+
+			return []string{
+				store.CreateBlobSidecarFileName(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetSlot().GetValue(), req.GetBlockRoot().GetValue(), req.GetSidecarType().GetValue()) + ".ssz",
+			}, nil
+		},
+		check: func(ctx context.Context, req *indexer.CreateBlobSidecarRequest) (bool, error) {
+			return true, i.checkBlobSidecarNotIndexed(ctx, req)
+		},
+		create: i.CreateBlobSidecar,
+	})
+}
+
+func (i *Indexer) UploadForkChoice(stream indexer.Indexer_UploadForkChoiceServer) error {
+	return handleUpload(i, stream, &uploadSpec[*indexer.CreateForkChoiceRequest, *indexer.CreateForkChoiceResponse]{
+		dataType: store.ForkChoiceDataType,
+		locations: func(req *indexer.CreateForkChoiceRequest) ([]string, error) {
+			if err := validateLocationComponents(req.GetNode().GetValue(), req.GetNetwork().GetValue()); err != nil {
+				return nil, err
+			}
+
+			return []string{
+				store.CreateForkChoiceFileName(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetSlot().GetValue(), req.GetFetchedAt().AsTime()) + ".json",
+			}, nil
+		},
+		create: i.CreateForkChoice,
+	})
+}
+
+// beaconBadDataExtensions are the extensions of invalid gossip files, one per
+// encoding they can have.
+var beaconBadDataExtensions = []string{".ssz", ".ssz_snappy", ".json"}
+
+func (i *Indexer) UploadBeaconBadBlock(stream indexer.Indexer_UploadBeaconBadBlockServer) error {
+	return handleUpload(i, stream, &uploadSpec[*indexer.CreateBeaconBadBlockRequest, *indexer.CreateBeaconBadBlockResponse]{
+		dataType: store.BeaconBadBlockDataType,
+		locations: func(req *indexer.CreateBeaconBadBlockRequest) ([]string, error) {
+			if err := validateLocationComponents(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetBlockRoot().GetValue()); err != nil {
+				return nil, err
+			}
+
+			return withExtensions(
+				store.CreateBeaconBadBlockFileName(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetSlot().GetValue(), req.GetBlockRoot().GetValue()),
+				beaconBadDataExtensions...,
+			), nil
+		},
+		check: func(ctx context.Context, req *indexer.CreateBeaconBadBlockRequest) (bool, error) {
+			return true, i.checkBeaconBadBlockNotIndexed(ctx, req)
+		},
+		create: i.CreateBeaconBadBlock,
+	})
+}
+
+func (i *Indexer) UploadBeaconBadBlob(stream indexer.Indexer_UploadBeaconBadBlobServer) error {
+	return handleUpload(i, stream, &uploadSpec[*indexer.CreateBeaconBadBlobRequest, *indexer.CreateBeaconBadBlobResponse]{
+		dataType: store.BeaconBadBlobDataType,
+		locations: func(req *indexer.CreateBeaconBadBlobRequest) ([]string, error) {
+			if err := validateLocationComponents(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetBlockRoot().GetValue()); err != nil {
+				return nil, err
+			}
+
+			return withExtensions(
+				store.CreateBeaconBadBlobFileName(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetSlot().GetValue(), req.GetBlockRoot().GetValue(), req.GetIndex().GetValue()),
+				beaconBadDataExtensions...,
+			), nil
+		},
+		check: func(ctx context.Context, req *indexer.CreateBeaconBadBlobRequest) (bool, error) {
+			return true, i.checkBeaconBadBlobNotIndexed(ctx, req)
+		},
+		create: i.CreateBeaconBadBlob,
+	})
+}
+
+func (i *Indexer) UploadExecutionBlockTrace(stream indexer.Indexer_UploadExecutionBlockTraceServer) error {
+	return handleUpload(i, stream, &uploadSpec[*indexer.CreateExecutionBlockTraceRequest, *indexer.CreateExecutionBlockTraceResponse]{
+		dataType: store.BlockTraceDataType,
+		locations: func(req *indexer.CreateExecutionBlockTraceRequest) ([]string, error) {
+			tracer := req.GetTracer().GetValue()
+			if tracer == "" {
+				tracer = tracediff.StructLoggerTracer
+			}
+
+			if err := validateLocationComponents(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetBlockHash().GetValue(), tracer); err != nil {
+				return nil, err
+			}
+
+			//nolint:gosec // block numbers are never negative.
+			number := uint64(req.GetBlockNumber().GetValue())
+
+			return []string{
+				store.CreateExecutionBlockTraceFileName(req.GetNode().GetValue(), req.GetNetwork().GetValue(), number, req.GetBlockHash().GetValue(), tracer) + ".json",
+			}, nil
+		},
+		check: func(ctx context.Context, req *indexer.CreateExecutionBlockTraceRequest) (bool, error) {
+			return true, i.checkExecutionBlockTraceNotIndexed(ctx, req)
+		},
+		create: i.CreateExecutionBlockTrace,
+	})
+}
+
+func (i *Indexer) UploadExecutionWitness(stream indexer.Indexer_UploadExecutionWitnessServer) error {
+	return handleUpload(i, stream, &uploadSpec[*indexer.CreateExecutionWitnessRequest, *indexer.CreateExecutionWitnessResponse]{
+		dataType: store.ExecutionWitnessDataType,
+		locations: func(req *indexer.CreateExecutionWitnessRequest) ([]string, error) {
+			if err := validateLocationComponents(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetBlockHash().GetValue()); err != nil {
+				return nil, err
+			}
+
+			//nolint:gosec // block numbers are never negative.
+			number := uint64(req.GetBlockNumber().GetValue())
+
+			return []string{
+				store.CreateExecutionWitnessFileName(req.GetNode().GetValue(), req.GetNetwork().GetValue(), number, req.GetBlockHash().GetValue()) + ".json",
+			}, nil
+		},
+		check: func(ctx context.Context, req *indexer.CreateExecutionWitnessRequest) (bool, error) {
+			return true, i.checkExecutionWitnessNotIndexed(ctx, req)
+		},
+		create: i.CreateExecutionWitness,
+	})
+}
+
+// UploadExecutionBadBlock saves the bad block followed by its trace and
+// intermediate roots, if the upload carries them.
+func (i *Indexer) UploadExecutionBadBlock(stream indexer.Indexer_UploadExecutionBadBlockServer) error {
+	return handleUpload(i, stream, &uploadSpec[*indexer.CreateExecutionBadBlockRequest, *indexer.CreateExecutionBadBlockResponse]{
+		dataType: store.BadBlockDataType,
+		locations: func(req *indexer.CreateExecutionBadBlockRequest) ([]string, error) {
+			if err := validateLocationComponents(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetBlockHash().GetValue()); err != nil {
+				return nil, err
+			}
+
+			location := store.CreateExecutionBadBlockFileName(req.GetNode().GetValue(), req.GetNetwork().GetValue(), req.GetBlockHash().GetValue())
+
+			if trace := req.GetTraceLocation().GetValue(); trace != "" && trace != location+".trace.json" {
+				return nil, fmt.Errorf("invalid trace location %s", trace)
+			}
+
+			if roots := req.GetIntermediateRootsLocation().GetValue(); roots != "" && roots != location+".intermediate_roots.json" {
+				return nil, fmt.Errorf("invalid intermediate roots location %s", roots)
+			}
+
+			return []string{location + ".json"}, nil
+		},
+		files: func(req *indexer.CreateExecutionBadBlockRequest) []string {
+			var files []string
+
+			for _, file := range []string{req.GetTraceLocation().GetValue(), req.GetIntermediateRootsLocation().GetValue()} {
+				if file != "" {
+					files = append(files, file)
+				}
+			}
+
+			return files
+		},
+		dropFile: func(req *indexer.CreateExecutionBadBlockRequest, file string) {
+			if req.GetTraceLocation().GetValue() == file {
+				req.TraceLocation = nil
+			}
+
+			if req.GetIntermediateRootsLocation().GetValue() == file {
+				req.IntermediateRootsLocation = nil
+			}
+		},
+		check: func(ctx context.Context, req *indexer.CreateExecutionBadBlockRequest) (bool, error) {
+			return true, i.checkExecutionBadBlockNotIndexed(ctx, req)
+		},
+		create: i.CreateExecutionBadBlock,
+	})
+}
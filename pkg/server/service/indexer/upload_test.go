@@ -0,0 +1,444 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	pindexer "github.com/ethpandaops/tracoor/pkg/proto/tracoor/indexer"
+	"github.com/ethpandaops/tracoor/pkg/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type testUploadBeaconBlockStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	msgs []*pindexer.UploadBeaconBlockRequest
+	rsp  *pindexer.CreateBeaconBlockResponse
+}
+
+func (s *testUploadBeaconBlockStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testUploadBeaconBlockStream) Recv() (*pindexer.UploadBeaconBlockRequest, error) {
+	if len(s.msgs) == 0 {
+		return nil, io.EOF
+	}
+
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+
+	return msg, nil
+}
+
+func (s *testUploadBeaconBlockStream) SendAndClose(rsp *pindexer.CreateBeaconBlockResponse) error {
+	s.rsp = rsp
+
+	return nil
+}
+
+type testUploadExecutionBadBlockStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	msgs []*pindexer.UploadExecutionBadBlockRequest
+	rsp  *pindexer.CreateExecutionBadBlockResponse
+}
+
+func (s *testUploadExecutionBadBlockStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testUploadExecutionBadBlockStream) Recv() (*pindexer.UploadExecutionBadBlockRequest, error) {
+	if len(s.msgs) == 0 {
+		return nil, io.EOF
+	}
+
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+
+	return msg, nil
+}
+
+func (s *testUploadExecutionBadBlockStream) SendAndClose(rsp *pindexer.CreateExecutionBadBlockResponse) error {
+	s.rsp = rsp
+
+	return nil
+}
+
+func beaconBlockUpload(req *pindexer.CreateBeaconBlockRequest, chunks ...[]byte) []*pindexer.UploadBeaconBlockRequest {
+	msgs := []*pindexer.UploadBeaconBlockRequest{
+		{Payload: &pindexer.UploadBeaconBlockRequest_Metadata{Metadata: req}},
+	}
+
+	for _, chunk := range chunks {
+		msgs = append(msgs, &pindexer.UploadBeaconBlockRequest{Payload: &pindexer.UploadBeaconBlockRequest_Chunk{Chunk: chunk}})
+	}
+
+	return msgs
+}
+
+const (
+	testUploadNode  = "upload-node"
+	testUploadToken = "upload-token"
+)
+
+// uploadContext returns the context of an upload authorized with token.
+func uploadContext(ctx context.Context, token string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+}
+
+// createUploadBeaconBlockRequest returns a beacon block of the upload node,
+// stored at its own location.
+func createUploadBeaconBlockRequest() *pindexer.CreateBeaconBlockRequest {
+	req := createRandomBeaconBlockRequest()
+	req.Node = wrapperspb.String(testUploadNode)
+	req.Location = wrapperspb.String(store.CreateBeaconBlockFileName(
+		req.GetNode().GetValue(),
+		req.GetNetwork().GetValue(),
+		req.GetSlot().GetValue(),
+		req.GetBlockRoot().GetValue(),
+	) + ".ssz")
+
+	return req
+}
+
+func TestIndexerUpload(t *testing.T) {
+	config := Config{
+		Uploads: UploadConfig{
+			Tokens: []UploadToken{
+				{Node: testUploadNode, Token: testUploadToken},
+				{Node: "shared-node", Token: "shared-token"},
+			},
+		},
+	}
+
+	index, cleanup, err := NewMockIndexer(context.Background(), &config)
+	if err != nil {
+		t.Fatalf("failed to create indexer: %v", err)
+	}
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	}()
+
+	ctx := uploadContext(context.Background(), testUploadToken)
+
+	t.Run("Saves and indexes", func(t *testing.T) {
+		req := createUploadBeaconBlockRequest()
+
+		stream := &testUploadBeaconBlockStream{
+			ctx:  ctx,
+			msgs: beaconBlockUpload(req, []byte("hello "), []byte("world")),
+		}
+
+		if err := index.UploadBeaconBlock(stream); err != nil {
+			t.Fatalf("failed to upload beacon block: %v", err)
+		}
+
+		if stream.rsp.GetId().GetValue() == "" {
+			t.Fatal("expected the beacon block to be indexed")
+		}
+
+		data, err := index.store.GetBeaconBlock(ctx, req.GetLocation().GetValue())
+		if err != nil {
+			t.Fatalf("failed to get uploaded beacon block: %v", err)
+		}
+
+		if !bytes.Equal(*data, []byte("hello world")) {
+			t.Fatalf("unexpected uploaded data %q", *data)
+		}
+
+		rsp, err := index.ListBeaconBlock(ctx, &pindexer.ListBeaconBlockRequest{Id: stream.rsp.GetId().GetValue()})
+		if err != nil {
+			t.Fatalf("failed to list beacon block: %v", err)
+		}
+
+		if len(rsp.BeaconBlocks) != 1 {
+			t.Fatalf("expected 1 beacon block, got %d", len(rsp.BeaconBlocks))
+		}
+	})
+
+	t.Run("Shared location", func(t *testing.T) {
+		req := createUploadBeaconBlockRequest()
+		req.ContentEncoding = wrapperspb.String(compression.Gzip.ContentEncoding)
+		req.Location = wrapperspb.String(compression.AddExtension(
+			store.CreateSharedBeaconBlockFileName(req.GetNetwork().GetValue(), req.GetBlockRoot().GetValue())+".ssz",
+			compression.Gzip,
+		))
+
+		stream := &testUploadBeaconBlockStream{ctx: ctx, msgs: beaconBlockUpload(req, []byte("block"))}
+		if err := index.UploadBeaconBlock(stream); err != nil {
+			t.Fatalf("failed to upload beacon block: %v", err)
+		}
+
+		// Another node's upload of the same block is only indexed.
+		other := proto.Clone(req).(*pindexer.CreateBeaconBlockRequest)
+		other.Node = wrapperspb.String("shared-node")
+
+		stream = &testUploadBeaconBlockStream{ctx: uploadContext(context.Background(), "shared-token"), msgs: beaconBlockUpload(other, []byte("other"))}
+		if err := index.UploadBeaconBlock(stream); err != nil {
+			t.Fatalf("failed to upload beacon block: %v", err)
+		}
+
+		data, err := index.store.GetBeaconBlock(ctx, req.GetLocation().GetValue())
+		if err != nil {
+			t.Fatalf("failed to get uploaded beacon block: %v", err)
+		}
+
+		if string(*data) != "block" {
+			t.Fatalf("expected the shared beacon block to be kept, got %q", *data)
+		}
+
+		rsp, err := index.CountBeaconBlock(ctx, &pindexer.CountBeaconBlockRequest{Location: req.GetLocation().GetValue()})
+		if err != nil {
+			t.Fatalf("failed to count beacon blocks: %v", err)
+		}
+
+		if rsp.GetCount().GetValue() != 2 {
+			t.Fatalf("expected 2 beacon blocks, got %d", rsp.GetCount().GetValue())
+		}
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		req := createUploadBeaconBlockRequest()
+
+		stream := &testUploadBeaconBlockStream{ctx: ctx, msgs: beaconBlockUpload(req, []byte("block"))}
+		if err := index.UploadBeaconBlock(stream); err != nil {
+			t.Fatalf("failed to upload beacon block: %v", err)
+		}
+
+		stream = &testUploadBeaconBlockStream{ctx: ctx, msgs: beaconBlockUpload(req, []byte("other"))}
+		if err := index.UploadBeaconBlock(stream); status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected AlreadyExists, got %v", err)
+		}
+
+		// The duplicate is rejected before it's saved.
+		data, err := index.store.GetBeaconBlock(ctx, req.GetLocation().GetValue())
+		if err != nil {
+			t.Fatalf("failed to get uploaded beacon block: %v", err)
+		}
+
+		if string(*data) != "block" {
+			t.Fatalf("expected the beacon block to be kept, got %q", *data)
+		}
+	})
+
+	t.Run("Requires metadata first", func(t *testing.T) {
+		stream := &testUploadBeaconBlockStream{
+			ctx: ctx,
+			msgs: []*pindexer.UploadBeaconBlockRequest{
+				{Payload: &pindexer.UploadBeaconBlockRequest_Chunk{Chunk: []byte("block")}},
+			},
+		}
+
+		if err := index.UploadBeaconBlock(stream); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("Requires a valid token", func(t *testing.T) {
+		for name, ctx := range map[string]context.Context{
+			"missing": context.Background(),
+			"invalid": uploadContext(context.Background(), "other-token"),
+		} {
+			stream := &testUploadBeaconBlockStream{ctx: ctx, msgs: beaconBlockUpload(createUploadBeaconBlockRequest(), []byte("block"))}
+			if err := index.UploadBeaconBlock(stream); status.Code(err) != codes.Unauthenticated {
+				t.Fatalf("expected Unauthenticated for a %s token, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("Rejects other nodes", func(t *testing.T) {
+		req := createUploadBeaconBlockRequest()
+		req.Node = wrapperspb.String("other-node")
+
+		stream := &testUploadBeaconBlockStream{ctx: ctx, msgs: beaconBlockUpload(req, []byte("block"))}
+		if err := index.UploadBeaconBlock(stream); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("Disabled without tokens", func(t *testing.T) {
+		tokens := config.Uploads.Tokens
+		config.Uploads.Tokens = nil
+
+		defer func() {
+			config.Uploads.Tokens = tokens
+		}()
+
+		stream := &testUploadBeaconBlockStream{ctx: ctx, msgs: beaconBlockUpload(createUploadBeaconBlockRequest(), []byte("block"))}
+		if err := index.UploadBeaconBlock(stream); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("Rejects locations the server didn't build", func(t *testing.T) {
+		for _, location := range []string{"/etc/passwd", "../escape", "beacon_blocks/other.ssz"} {
+			req := createUploadBeaconBlockRequest()
+			req.Location = wrapperspb.String(location)
+
+			stream := &testUploadBeaconBlockStream{ctx: ctx, msgs: beaconBlockUpload(req, []byte("block"))}
+			if err := index.UploadBeaconBlock(stream); status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("expected InvalidArgument for %s, got %v", location, err)
+			}
+		}
+
+		// Metadata can't change the structure of the location.
+		req := createUploadBeaconBlockRequest()
+		req.Network = wrapperspb.String("..")
+		req.Location = wrapperspb.String(store.CreateBeaconBlockFileName(testUploadNode, "..", req.GetSlot().GetValue(), req.GetBlockRoot().GetValue()) + ".ssz")
+
+		stream := &testUploadBeaconBlockStream{ctx: ctx, msgs: beaconBlockUpload(req, []byte("block"))}
+		if err := index.UploadBeaconBlock(stream); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("Execution bad block files", func(t *testing.T) {
+		req := createRandomExecutionBadBlockRequest()
+		req.Node = wrapperspb.String(testUploadNode)
+
+		location := store.CreateExecutionBadBlockFileName(testUploadNode, req.GetNetwork().GetValue(), req.GetBlockHash().GetValue())
+
+		req.Location = wrapperspb.String(location + ".json")
+		req.TraceLocation = wrapperspb.String(location + ".trace.json")
+
+		stream := &testUploadExecutionBadBlockStream{
+			ctx: ctx,
+			msgs: []*pindexer.UploadExecutionBadBlockRequest{
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_Metadata{Metadata: req}},
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_Chunk{Chunk: []byte("block")}},
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_File{File: req.GetTraceLocation().GetValue()}},
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_Chunk{Chunk: []byte("trace")}},
+			},
+		}
+
+		if err := index.UploadExecutionBadBlock(stream); err != nil {
+			t.Fatalf("failed to upload execution bad block: %v", err)
+		}
+
+		for location, expected := range map[string]string{
+			req.GetLocation().GetValue():      "block",
+			req.GetTraceLocation().GetValue(): "trace",
+		} {
+			data, err := index.store.GetExecutionBadBlock(ctx, location)
+			if err != nil {
+				t.Fatalf("failed to get uploaded file %s: %v", location, err)
+			}
+
+			if string(*data) != expected {
+				t.Fatalf("expected %q at %s, got %q", expected, location, *data)
+			}
+		}
+
+		// A duplicate is rejected before any of its files are saved.
+		stream = &testUploadExecutionBadBlockStream{
+			ctx: ctx,
+			msgs: []*pindexer.UploadExecutionBadBlockRequest{
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_Metadata{Metadata: req}},
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_Chunk{Chunk: []byte("other")}},
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_File{File: req.GetTraceLocation().GetValue()}},
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_Chunk{Chunk: []byte("other")}},
+			},
+		}
+
+		if err := index.UploadExecutionBadBlock(stream); status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("expected AlreadyExists, got %v", err)
+		}
+
+		data, err := index.store.GetExecutionBadBlock(ctx, req.GetTraceLocation().GetValue())
+		if err != nil {
+			t.Fatalf("failed to get uploaded trace: %v", err)
+		}
+
+		if string(*data) != "trace" {
+			t.Fatalf("expected the trace to be kept, got %q", *data)
+		}
+
+		// Files that are declared but never uploaded aren't indexed.
+		req = createRandomExecutionBadBlockRequest()
+		req.Node = wrapperspb.String(testUploadNode)
+
+		location = store.CreateExecutionBadBlockFileName(testUploadNode, req.GetNetwork().GetValue(), req.GetBlockHash().GetValue())
+
+		req.Location = wrapperspb.String(location + ".json")
+		req.TraceLocation = wrapperspb.String(location + ".trace.json")
+		req.IntermediateRootsLocation = wrapperspb.String(location + ".intermediate_roots.json")
+
+		stream = &testUploadExecutionBadBlockStream{
+			ctx: ctx,
+			msgs: []*pindexer.UploadExecutionBadBlockRequest{
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_Metadata{Metadata: req}},
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_Chunk{Chunk: []byte("block")}},
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_File{File: req.GetIntermediateRootsLocation().GetValue()}},
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_Chunk{Chunk: []byte("roots")}},
+			},
+		}
+
+		if err := index.UploadExecutionBadBlock(stream); err != nil {
+			t.Fatalf("failed to upload execution bad block: %v", err)
+		}
+
+		blocks, err := index.ListExecutionBadBlock(ctx, &pindexer.ListExecutionBadBlockRequest{Id: stream.rsp.GetId().GetValue()})
+		if err != nil {
+			t.Fatalf("failed to list execution bad block: %v", err)
+		}
+
+		if len(blocks.ExecutionBadBlocks) != 1 {
+			t.Fatalf("expected 1 execution bad block, got %d", len(blocks.ExecutionBadBlocks))
+		}
+
+		block := blocks.ExecutionBadBlocks[0]
+
+		if block.GetTraceLocation().GetValue() != "" {
+			t.Fatalf("expected no trace location, got %s", block.GetTraceLocation().GetValue())
+		}
+
+		if block.GetIntermediateRootsLocation().GetValue() != location+".intermediate_roots.json" {
+			t.Fatalf("expected the intermediate roots location to be kept, got %s", block.GetIntermediateRootsLocation().GetValue())
+		}
+
+		// Files that aren't part of the bad block are rejected.
+		req = createRandomExecutionBadBlockRequest()
+		req.Node = wrapperspb.String(testUploadNode)
+		req.Location = wrapperspb.String(store.CreateExecutionBadBlockFileName(testUploadNode, req.GetNetwork().GetValue(), req.GetBlockHash().GetValue()) + ".json")
+
+		stream = &testUploadExecutionBadBlockStream{
+			ctx: ctx,
+			msgs: []*pindexer.UploadExecutionBadBlockRequest{
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_Metadata{Metadata: req}},
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_File{File: "other.json"}},
+			},
+		}
+
+		if err := index.UploadExecutionBadBlock(stream); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+
+		// So are extra files at locations the server didn't build.
+		req.TraceLocation = wrapperspb.String("other.json")
+
+		stream = &testUploadExecutionBadBlockStream{
+			ctx: ctx,
+			msgs: []*pindexer.UploadExecutionBadBlockRequest{
+				{Payload: &pindexer.UploadExecutionBadBlockRequest_Metadata{Metadata: req}},
+			},
+		}
+
+		if err := index.UploadExecutionBadBlock(stream); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+}
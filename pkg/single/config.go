@@ -39,6 +39,20 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("at least one agent configuration is required. If you just want to run the server, use the `server` subcommand instead")
 	}
 
+	dataDirs := make(map[string]string, len(c.Agents))
+
+	for _, agent := range c.Agents {
+		if agent.DataDir == "" {
+			continue
+		}
+
+		if other, ok := dataDirs[agent.DataDir]; ok {
+			return fmt.Errorf("agents %s and %s share the same dataDir %s", other, agent.Name, agent.DataDir)
+		}
+
+		dataDirs[agent.DataDir] = agent.Name
+	}
+
 	return nil
 }
 
@@ -0,0 +1,556 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	"github.com/ethpandaops/tracoor/pkg/mime"
+	"github.com/sirupsen/logrus"
+)
+
+// azureStreamBlockSize is the size of each block of a streamed upload, and the
+// most a streamed upload holds in memory at once.
+const azureStreamBlockSize = 16 * 1024 * 1024
+
+// azureCopyPollInterval is how often a pending copy is checked for completion.
+const azureCopyPollInterval = 500 * time.Millisecond
+
+type AzureStore struct {
+	client     *container.Client
+	credential *blob.SharedKeyCredential
+	// sasProtocol is the protocol download URLs are signed for.
+	sasProtocol sas.Protocol
+
+	config *AzureStoreConfig
+
+	log  logrus.FieldLogger
+	opts *Options
+
+	basicMetrics *BasicMetrics
+}
+
+//nolint:tagliatelle // required snake.
+type AzureStoreConfig struct {
+	AccountName   string `yaml:"account_name"`
+	AccountKey    string `yaml:"account_key"`
+	ContainerName string `yaml:"container_name"`
+	KeyPrefix     string `yaml:"key_prefix"`
+	// Endpoint is the blob service URL. Defaults to the account's public
+	// endpoint, e.g. https://account.blob.core.windows.net.
+	Endpoint   string `yaml:"endpoint"`
+	PreferURLs bool   `yaml:"prefer_urls"`
+}
+
+// NewAzureStore creates a new AzureStore instance with the specified account and container.
+func NewAzureStore(namespace string, log logrus.FieldLogger, config *AzureStoreConfig, opts *Options) (*AzureStore, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
+
+	if config.AccountName == "" || config.AccountKey == "" {
+		return nil, errors.New("account name and account key are required")
+	}
+
+	if config.ContainerName == "" {
+		return nil, errors.New("container name is required")
+	}
+
+	credential, err := blob.NewSharedKeyCredential(config.AccountName, config.AccountKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account key: %w", err)
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", config.AccountName)
+	}
+
+	client, err := container.NewClientWithSharedKeyCredential(
+		fmt.Sprintf("%s/%s", strings.TrimSuffix(endpoint, "/"), config.ContainerName),
+		credential,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Azure container client: %w", err)
+	}
+
+	// Only allow plain HTTP download URLs when the endpoint itself is plain
+	// HTTP, e.g. Azurite.
+	sasProtocol := sas.ProtocolHTTPS
+	if strings.HasPrefix(strings.ToLower(endpoint), "http://") {
+		sasProtocol = sas.ProtocolHTTPSandHTTP
+	}
+
+	metrics := GetBasicMetricsInstance(namespace, string(AzureStoreType), opts.MetricsEnabled)
+
+	return &AzureStore{
+		client:       client,
+		credential:   credential,
+		sasProtocol:  sasProtocol,
+		config:       config,
+		log:          log,
+		opts:         opts,
+		basicMetrics: metrics,
+	}, nil
+}
+
+func (s *AzureStore) PathPrefix() string {
+	return s.config.KeyPrefix
+}
+
+func (s *AzureStore) PreferURLs() bool {
+	return s.config.PreferURLs
+}
+
+func (s *AzureStore) Healthy(ctx context.Context) error {
+	if _, err := s.client.GetProperties(ctx, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *AzureStore) Exists(ctx context.Context, location string) (bool, error) {
+	_, err := s.client.NewBlobClient(location).GetProperties(ctx, nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// put uploads the stream to the blob at location. Blocks of a failed upload
+// are never committed, so a partial blob is never left behind.
+func (s *AzureStore) put(ctx context.Context, location, contentEncoding string, data io.Reader) (int64, error) {
+	counter := &countingReader{r: data}
+
+	headers := &blob.HTTPHeaders{
+		BlobContentType: ptr(string(mime.GetContentTypeFromExtension(filepath.Ext(compression.RemoveExtension(location))))),
+	}
+
+	if contentEncoding != "" {
+		headers.BlobContentEncoding = ptr(contentEncoding)
+	}
+
+	_, err := s.client.NewBlockBlobClient(location).UploadStream(ctx, counter, &blockblob.UploadStreamOptions{
+		BlockSize:   azureStreamBlockSize,
+		Concurrency: 1,
+		HTTPHeaders: headers,
+	})
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.ContainerNotFound) {
+			return 0, fmt.Errorf("container does not exist: %w", err)
+		}
+
+		return 0, err
+	}
+
+	return counter.n, nil
+}
+
+// GetRaw returns the blob as it's stored.
+// GetStream returns the blob's body without reading it into memory.
+func (s *AzureStore) GetStream(ctx context.Context, dataType DataType, location string) (io.ReadCloser, error) {
+	s.basicMetrics.ObserveCacheMiss(string(dataType))
+
+	rsp, err := s.client.NewBlobClient(location).DownloadStream(ctx, nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+
+	s.basicMetrics.ObserveItemRetreived(string(dataType))
+
+	return rsp.Body, nil
+}
+
+func (s *AzureStore) GetRaw(ctx context.Context, location string) (*bytes.Buffer, error) {
+	rsp, err := s.client.NewBlobClient(location).DownloadStream(ctx, nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+
+	defer rsp.Body.Close()
+
+	var buff bytes.Buffer
+
+	if _, err := buff.ReadFrom(rsp.Body); err != nil {
+		return nil, err
+	}
+
+	return &buff, nil
+}
+
+func (s *AzureStore) delete(ctx context.Context, location string) error {
+	if _, err := s.client.NewBlobClient(location).Delete(ctx, nil); err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	return nil
+}
+
+func (s *AzureStore) StorageHandshakeTokenExists(ctx context.Context, node string) (bool, error) {
+	exists, err := s.Exists(ctx, fmt.Sprintf("handshake/%s", node))
+	if err != nil {
+		return false, fmt.Errorf("failed to check if storage handshake token exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (s *AzureStore) SaveStorageHandshakeToken(ctx context.Context, node, data string) error {
+	if _, err := s.put(ctx, fmt.Sprintf("handshake/%s", node), "", strings.NewReader(data)); err != nil {
+		return fmt.Errorf("failed to save storage handshake for node %s: %w", node, err)
+	}
+
+	return nil
+}
+
+func (s *AzureStore) GetStorageHandshakeToken(ctx context.Context, node string) (string, error) {
+	data, err := s.GetRaw(ctx, fmt.Sprintf("handshake/%s", node))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return "", ErrNotFound
+		}
+
+		return "", fmt.Errorf("failed to get storage handshake for node %s: %w", node, err)
+	}
+
+	return data.String(), nil
+}
+
+// Copy copies the blob server-side, keeping its properties. Copies within an
+// account usually complete straight away, but are polled until they do.
+func (s *AzureStore) Copy(ctx context.Context, params *CopyParams) error {
+	if params.Source == "" || params.Destination == "" {
+		return errors.New("source and destination are required")
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"source":      params.Source,
+		"destination": params.Destination,
+	}).Debug("Performing object copy")
+
+	destination := s.client.NewBlobClient(params.Destination)
+
+	rsp, err := destination.StartCopyFromURL(ctx, s.client.NewBlobClient(params.Source).URL(), nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.CannotVerifyCopySource, bloberror.BlobNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed to copy object: %w", err)
+	}
+
+	copyStatus := rsp.CopyStatus
+
+	for copyStatus != nil && *copyStatus == blob.CopyStatusTypePending {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(azureCopyPollInterval):
+		}
+
+		props, err := destination.GetProperties(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to check copy status: %w", err)
+		}
+
+		copyStatus = props.CopyStatus
+	}
+
+	if copyStatus != nil && *copyStatus != blob.CopyStatusTypeSuccess {
+		return fmt.Errorf("failed to copy object: copy %s", *copyStatus)
+	}
+
+	return nil
+}
+
+// getSASURL returns a URL for downloading the blob, signed with the account key.
+func (s *AzureStore) getSASURL(params *GetURLParams) (string, error) {
+	// Remove the compression extension if it exists
+	extension := filepath.Ext(
+		compression.RemoveExtension(
+			params.Location,
+		),
+	)
+
+	values := sas.BlobSignatureValues{
+		Protocol:      s.sasProtocol,
+		ExpiryTime:    time.Now().UTC().Add(time.Duration(params.Expiry) * time.Second),
+		Permissions:   (&sas.BlobPermissions{Read: true}).String(),
+		ContainerName: s.config.ContainerName,
+		BlobName:      params.Location,
+		ContentType:   string(mime.GetContentTypeFromExtension(extension)),
+		ContentDisposition: fmt.Sprintf("attachment; filename=%q", compression.RemoveExtension(
+			filepath.Base(params.Location),
+		)),
+	}
+
+	// Backwards compatibility for old locations that still have the compression algorithm in the filename
+	if params.ContentEncoding == "" {
+		if compressionAlgorithm, err := compression.GetCompressionAlgorithm(params.Location); err == nil {
+			values.ContentEncoding = compressionAlgorithm.ContentEncoding
+		}
+	} else {
+		values.ContentEncoding = params.ContentEncoding
+	}
+
+	query, err := values.SignWithSharedKey(s.credential)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign URL: %w", err)
+	}
+
+	return fmt.Sprintf("%s?%s", s.client.NewBlobClient(params.Location).URL(), query.Encode()), nil
+}
+
+func (s *AzureStore) save(ctx context.Context, dataType DataType, params *SaveParams) (string, error) {
+	if params.Data == nil {
+		return "", errors.New("data is nil")
+	}
+
+	if _, err := s.put(ctx, params.Location, params.ContentEncoding, bytes.NewReader(*params.Data)); err != nil {
+		return "", fmt.Errorf("failed to save %s: %w", dataType, err)
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(dataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(dataType), len(*params.Data))
+
+	return params.Location, nil
+}
+
+func (s *AzureStore) get(ctx context.Context, dataType DataType, location string) (*[]byte, error) {
+	s.basicMetrics.ObserveCacheMiss(string(dataType))
+
+	data, err := s.GetRaw(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	s.basicMetrics.ObserveItemRetreived(string(dataType))
+
+	b := data.Bytes()
+
+	return &b, nil
+}
+
+func (s *AzureStore) getURL(dataType DataType, params *GetURLParams) (string, error) {
+	signedURL, err := s.getSASURL(params)
+	if err != nil {
+		return "", err
+	}
+
+	s.basicMetrics.ObserveItemURLRetreived(string(dataType))
+
+	return signedURL, nil
+}
+
+func (s *AzureStore) remove(ctx context.Context, dataType DataType, location string) error {
+	if err := s.delete(ctx, location); err != nil {
+		return err
+	}
+
+	s.basicMetrics.ObserveItemRemoved(string(dataType))
+
+	return nil
+}
+
+func (s *AzureStore) SaveStream(ctx context.Context, dataType DataType, params *SaveStreamParams) (string, error) {
+	n, err := s.put(ctx, params.Location, params.ContentEncoding, params.Data)
+	if err != nil {
+		return "", fmt.Errorf("failed to save %s: %w", dataType, err)
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(dataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(dataType), int(n))
+
+	return params.Location, nil
+}
+
+func (s *AzureStore) SaveBeaconState(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BeaconStateDataType, params)
+}
+
+func (s *AzureStore) GetBeaconState(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BeaconStateDataType, location)
+}
+
+func (s *AzureStore) GetBeaconStateURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BeaconStateDataType, params)
+}
+
+func (s *AzureStore) DeleteBeaconState(ctx context.Context, location string) error {
+	return s.remove(ctx, BeaconStateDataType, location)
+}
+
+func (s *AzureStore) SaveBeaconBlock(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BeaconBlockDataType, params)
+}
+
+func (s *AzureStore) GetBeaconBlock(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BeaconBlockDataType, location)
+}
+
+func (s *AzureStore) GetBeaconBlockURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BeaconBlockDataType, params)
+}
+
+func (s *AzureStore) DeleteBeaconBlock(ctx context.Context, location string) error {
+	return s.remove(ctx, BeaconBlockDataType, location)
+}
+
+func (s *AzureStore) SaveBlobSidecar(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BlobSidecarDataType, params)
+}
+
+func (s *AzureStore) GetBlobSidecar(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BlobSidecarDataType, location)
+}
+
+func (s *AzureStore) GetBlobSidecarURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BlobSidecarDataType, params)
+}
+
+func (s *AzureStore) DeleteBlobSidecar(ctx context.Context, location string) error {
+	return s.remove(ctx, BlobSidecarDataType, location)
+}
+
+func (s *AzureStore) SaveForkChoice(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, ForkChoiceDataType, params)
+}
+
+func (s *AzureStore) GetForkChoice(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, ForkChoiceDataType, location)
+}
+
+func (s *AzureStore) GetForkChoiceURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(ForkChoiceDataType, params)
+}
+
+func (s *AzureStore) DeleteForkChoice(ctx context.Context, location string) error {
+	return s.remove(ctx, ForkChoiceDataType, location)
+}
+
+func (s *AzureStore) SaveBeaconBadBlock(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BeaconBadBlockDataType, params)
+}
+
+func (s *AzureStore) GetBeaconBadBlock(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BeaconBadBlockDataType, location)
+}
+
+func (s *AzureStore) GetBeaconBadBlockURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BeaconBadBlockDataType, params)
+}
+
+func (s *AzureStore) DeleteBeaconBadBlock(ctx context.Context, location string) error {
+	return s.remove(ctx, BeaconBadBlockDataType, location)
+}
+
+func (s *AzureStore) SaveBeaconBadBlob(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BeaconBadBlobDataType, params)
+}
+
+func (s *AzureStore) GetBeaconBadBlob(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BeaconBadBlobDataType, location)
+}
+
+func (s *AzureStore) GetBeaconBadBlobURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BeaconBadBlobDataType, params)
+}
+
+func (s *AzureStore) DeleteBeaconBadBlob(ctx context.Context, location string) error {
+	return s.remove(ctx, BeaconBadBlobDataType, location)
+}
+
+func (s *AzureStore) SaveExecutionBlockTrace(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BlockTraceDataType, params)
+}
+
+func (s *AzureStore) GetExecutionBlockTrace(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BlockTraceDataType, location)
+}
+
+func (s *AzureStore) GetExecutionBlockTraceURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BlockTraceDataType, params)
+}
+
+func (s *AzureStore) DeleteExecutionBlockTrace(ctx context.Context, location string) error {
+	return s.remove(ctx, BlockTraceDataType, location)
+}
+
+func (s *AzureStore) SaveExecutionWitness(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, ExecutionWitnessDataType, params)
+}
+
+func (s *AzureStore) GetExecutionWitness(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, ExecutionWitnessDataType, location)
+}
+
+func (s *AzureStore) GetExecutionWitnessURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(ExecutionWitnessDataType, params)
+}
+
+func (s *AzureStore) DeleteExecutionWitness(ctx context.Context, location string) error {
+	return s.remove(ctx, ExecutionWitnessDataType, location)
+}
+
+func (s *AzureStore) SaveExecutionBadBlock(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BadBlockDataType, params)
+}
+
+func (s *AzureStore) GetExecutionBadBlock(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BadBlockDataType, location)
+}
+
+func (s *AzureStore) GetExecutionBadBlockURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BadBlockDataType, params)
+}
+
+func (s *AzureStore) DeleteExecutionBadBlock(ctx context.Context, location string) error {
+	return s.remove(ctx, BadBlockDataType, location)
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+
+	return n, err
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,168 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	"github.com/sirupsen/logrus"
+)
+
+func TestAzureStoreOperations(t *testing.T) {
+	containerName := "mycontainer"
+	ctx := context.Background()
+
+	store, cleanup, err := NewMockAzureStore(ctx, containerName)
+	if err != nil {
+		t.Fatalf("Failed to create Azure store: %v", err)
+	}
+
+	defer func() {
+		if err = cleanup(); err != nil {
+			t.Fatalf("Failed to clean up: %v", err)
+		}
+	}()
+
+	t.Run("BeaconState", func(t *testing.T) {
+		testBeaconState(ctx, t, store)
+	})
+
+	t.Run("SaveStream", func(t *testing.T) {
+		testSaveStream(ctx, t, store)
+	})
+
+	t.Run("BeaconBlock", func(t *testing.T) {
+		testBeaconBlock(ctx, t, store)
+	})
+
+	t.Run("BeaconBadBlock", func(t *testing.T) {
+		testBeaconBadBlock(ctx, t, store)
+	})
+
+	t.Run("ExecutionBlockTrace", func(t *testing.T) {
+		testExecutionBlockTrace(ctx, t, store)
+	})
+
+	t.Run("ExecutionBadBlock", func(t *testing.T) {
+		testExecutionBadBlock(ctx, t, store)
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		testCopy(ctx, t, store)
+	})
+
+	t.Run("ContentEncoding", func(t *testing.T) {
+		// Compressed data is returned as it was saved.
+		data := []byte(`{"abc": "def"}`)
+
+		compressed, err := compression.NewCompressor().Compress(&data, compression.Gzip)
+		if err != nil {
+			t.Fatalf("Failed to compress data: %v", err)
+		}
+
+		location, err := store.SaveForkChoice(ctx, &SaveParams{
+			Data:            &compressed,
+			Location:        "fork_choice/encoded.json",
+			ContentEncoding: compression.Gzip.ContentEncoding,
+		})
+		if err != nil {
+			t.Fatalf("Failed to save fork choice: %v", err)
+		}
+
+		retrieved, err := store.GetForkChoice(ctx, location)
+		if err != nil {
+			t.Fatalf("Failed to get fork choice: %v", err)
+		}
+
+		if !bytes.Equal(compressed, *retrieved) {
+			t.Fatal("Retrieved data does not match the compressed data")
+		}
+	})
+}
+
+func TestAzureStoreSASURL(t *testing.T) {
+	store, err := NewAzureStore("throwaway", logrus.New(), &AzureStoreConfig{
+		AccountName:   azuriteAccountName,
+		AccountKey:    azuriteAccountKey,
+		ContainerName: "mycontainer",
+		Endpoint:      "http://127.0.0.1:10000/" + azuriteAccountName,
+	}, DefaultOptions().SetMetricsEnabled(false))
+	if err != nil {
+		t.Fatalf("Failed to create Azure store: %v", err)
+	}
+
+	signed, err := store.GetBeaconStateURL(context.Background(), &GetURLParams{
+		Location:        "beacon_state/state.ssz",
+		Expiry:          3600,
+		ContentEncoding: compression.Gzip.ContentEncoding,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get SAS URL: %v", err)
+	}
+
+	parsed, err := url.Parse(signed)
+	if err != nil {
+		t.Fatalf("Failed to parse SAS URL: %v", err)
+	}
+
+	query := parsed.Query()
+
+	if query.Get("sig") == "" {
+		t.Fatalf("Expected the URL to be signed, got %s", signed)
+	}
+
+	if query.Get("sp") != "r" {
+		t.Fatalf("Expected a read-only SAS, got permissions %q", query.Get("sp"))
+	}
+
+	if query.Get("rsce") != compression.Gzip.ContentEncoding {
+		t.Fatalf("Unexpected content encoding %q", query.Get("rsce"))
+	}
+
+	if query.Get("rscd") != `attachment; filename="state.ssz"` {
+		t.Fatalf("Unexpected content disposition %q", query.Get("rscd"))
+	}
+
+	if parsed.Path != "/devstoreaccount1/mycontainer/beacon_state/state.ssz" {
+		t.Fatalf("Unexpected SAS URL path %s", parsed.Path)
+	}
+
+	// Plain HTTP is only allowed because the endpoint is plain HTTP.
+	if query.Get("spr") != "https,http" {
+		t.Fatalf("Unexpected SAS protocol %q", query.Get("spr"))
+	}
+}
+
+func TestAzureStoreSASURLHTTPSOnly(t *testing.T) {
+	store, err := NewAzureStore("throwaway", logrus.New(), &AzureStoreConfig{
+		AccountName:   azuriteAccountName,
+		AccountKey:    azuriteAccountKey,
+		ContainerName: "mycontainer",
+	}, DefaultOptions().SetMetricsEnabled(false))
+	if err != nil {
+		t.Fatalf("Failed to create Azure store: %v", err)
+	}
+
+	signed, err := store.GetBeaconStateURL(context.Background(), &GetURLParams{
+		Location: "beacon_state/state.ssz",
+		Expiry:   3600,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get SAS URL: %v", err)
+	}
+
+	parsed, err := url.Parse(signed)
+	if err != nil {
+		t.Fatalf("Failed to parse SAS URL: %v", err)
+	}
+
+	if parsed.Scheme != "https" {
+		t.Fatalf("Expected an https URL, got %s", signed)
+	}
+
+	if parsed.Query().Get("spr") != "https" {
+		t.Fatalf("Expected an https only SAS, got protocol %q", parsed.Query().Get("spr"))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -19,6 +20,8 @@ type FSStoreConfig struct {
 type FSStore struct {
 	basePath string
 	log      logrus.FieldLogger
+
+	basicMetrics *BasicMetrics
 }
 
 func NewFSStore(namespace string, log logrus.FieldLogger, config *FSStoreConfig, opts *Options) (*FSStore, error) {
@@ -35,9 +38,16 @@ func NewFSStore(namespace string, log logrus.FieldLogger, config *FSStoreConfig,
 		return nil, fmt.Errorf("failed to create base path: %w", err)
 	}
 
+	if opts == nil {
+		opts = DefaultOptions().WithMetricsDisabled()
+	}
+
+	metrics := GetBasicMetricsInstance(namespace, string(FSStoreType), opts.MetricsEnabled)
+
 	return &FSStore{
-		basePath: config.BasePath,
-		log:      log,
+		basePath:     config.BasePath,
+		log:          log,
+		basicMetrics: metrics,
 	}, nil
 }
 
@@ -83,6 +93,34 @@ func (s *FSStore) saveFile(data *[]byte, path string) error {
 	return nil
 }
 
+// saveStream writes the stream to a temporary file next to path and renames it
+// into place once it's complete, so a failed stream never leaves a partial file.
+func (s *FSStore) saveStream(r io.Reader, path string) (int64, error) {
+	if err := s.ensureDir(path); err != nil {
+		return 0, err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return 0, err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+
+		return 0, err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return 0, err
+	}
+
+	return n, os.Rename(tmp.Name(), path)
+}
+
 func (s *FSStore) constructLocation(parts ...string) string {
 	return filepath.Join(parts...)
 }
@@ -100,6 +138,37 @@ func (s *FSStore) removeFile(path string) error {
 	return os.Remove(path)
 }
 
+func (s *FSStore) SaveStream(ctx context.Context, dataType DataType, params *SaveStreamParams) (string, error) {
+	parts := strings.Split(params.Location, "/")
+
+	path := filepath.Join(s.basePath, filepath.Join(parts...))
+
+	n, err := s.saveStream(params.Data, path)
+	if err != nil {
+		return "", err
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(dataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(dataType), int(n))
+
+	return params.Location, nil
+}
+
+func (s *FSStore) GetStream(ctx context.Context, dataType DataType, location string) (io.ReadCloser, error) {
+	parts := strings.Split(location, "/")
+
+	f, err := os.Open(filepath.Join(s.basePath, filepath.Join(parts...)))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return f, nil
+}
+
 func (s *FSStore) SaveBeaconState(ctx context.Context, params *SaveParams) (string, error) {
 	parts := strings.Split(params.Location, "/")
 
@@ -108,6 +177,9 @@ func (s *FSStore) SaveBeaconState(ctx context.Context, params *SaveParams) (stri
 		return "", err
 	}
 
+	s.basicMetrics.ObserveItemAdded(string(BeaconStateDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(BeaconStateDataType), len(*params.Data))
+
 	return params.Location, nil
 }
 
@@ -136,6 +208,9 @@ func (s *FSStore) SaveBeaconBlock(ctx context.Context, params *SaveParams) (stri
 		return "", err
 	}
 
+	s.basicMetrics.ObserveItemAdded(string(BeaconBlockDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(BeaconBlockDataType), len(*params.Data))
+
 	return params.Location, nil
 }
 
@@ -156,6 +231,68 @@ func (s *FSStore) DeleteBeaconBlock(ctx context.Context, location string) error
 	return s.removeFile(path)
 }
 
+func (s *FSStore) SaveBlobSidecar(ctx context.Context, params *SaveParams) (string, error) {
+	parts := strings.Split(params.Location, "/")
+
+	path := filepath.Join(s.basePath, filepath.Join(parts...))
+	if err := s.saveFile(params.Data, path); err != nil {
+		return "", err
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(BlobSidecarDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(BlobSidecarDataType), len(*params.Data))
+
+	return params.Location, nil
+}
+
+func (s *FSStore) GetBlobSidecar(ctx context.Context, location string) (*[]byte, error) {
+	parts := strings.Split(location, "/")
+
+	return s.getFile(filepath.Join(s.basePath, filepath.Join(parts...)))
+}
+
+func (s *FSStore) GetBlobSidecarURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return "", errors.New("not supported")
+}
+
+func (s *FSStore) DeleteBlobSidecar(ctx context.Context, location string) error {
+	parts := strings.Split(location, "/")
+	path := filepath.Join(s.basePath, filepath.Join(parts...))
+
+	return s.removeFile(path)
+}
+
+func (s *FSStore) SaveForkChoice(ctx context.Context, params *SaveParams) (string, error) {
+	parts := strings.Split(params.Location, "/")
+
+	path := filepath.Join(s.basePath, filepath.Join(parts...))
+	if err := s.saveFile(params.Data, path); err != nil {
+		return "", err
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(ForkChoiceDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(ForkChoiceDataType), len(*params.Data))
+
+	return params.Location, nil
+}
+
+func (s *FSStore) GetForkChoice(ctx context.Context, location string) (*[]byte, error) {
+	parts := strings.Split(location, "/")
+
+	return s.getFile(filepath.Join(s.basePath, filepath.Join(parts...)))
+}
+
+func (s *FSStore) GetForkChoiceURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return "", errors.New("not supported")
+}
+
+func (s *FSStore) DeleteForkChoice(ctx context.Context, location string) error {
+	parts := strings.Split(location, "/")
+	path := filepath.Join(s.basePath, filepath.Join(parts...))
+
+	return s.removeFile(path)
+}
+
 func (s *FSStore) SaveBeaconBadBlock(ctx context.Context, params *SaveParams) (string, error) {
 	parts := strings.Split(params.Location, "/")
 
@@ -164,6 +301,9 @@ func (s *FSStore) SaveBeaconBadBlock(ctx context.Context, params *SaveParams) (s
 		return "", err
 	}
 
+	s.basicMetrics.ObserveItemAdded(string(BeaconBadBlockDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(BeaconBadBlockDataType), len(*params.Data))
+
 	return params.Location, nil
 }
 
@@ -193,6 +333,9 @@ func (s *FSStore) SaveBeaconBadBlob(ctx context.Context, params *SaveParams) (st
 		return "", err
 	}
 
+	s.basicMetrics.ObserveItemAdded(string(BeaconBadBlobDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(BeaconBadBlobDataType), len(*params.Data))
+
 	return params.Location, nil
 }
 
@@ -221,6 +364,9 @@ func (s *FSStore) SaveExecutionBlockTrace(ctx context.Context, params *SaveParam
 		return "", err
 	}
 
+	s.basicMetrics.ObserveItemAdded(string(BlockTraceDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(BlockTraceDataType), len(*params.Data))
+
 	return params.Location, nil
 }
 
@@ -241,6 +387,37 @@ func (s *FSStore) DeleteExecutionBlockTrace(ctx context.Context, location string
 	return s.removeFile(path)
 }
 
+func (s *FSStore) SaveExecutionWitness(ctx context.Context, params *SaveParams) (string, error) {
+	parts := strings.Split(params.Location, "/")
+
+	path := filepath.Join(s.basePath, filepath.Join(parts...))
+	if err := s.saveFile(params.Data, path); err != nil {
+		return "", err
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(ExecutionWitnessDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(ExecutionWitnessDataType), len(*params.Data))
+
+	return params.Location, nil
+}
+
+func (s *FSStore) GetExecutionWitness(ctx context.Context, location string) (*[]byte, error) {
+	parts := strings.Split(location, "/")
+
+	return s.getFile(filepath.Join(s.basePath, filepath.Join(parts...)))
+}
+
+func (s *FSStore) GetExecutionWitnessURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return "", errors.New("not supported")
+}
+
+func (s *FSStore) DeleteExecutionWitness(ctx context.Context, location string) error {
+	parts := strings.Split(location, "/")
+	path := filepath.Join(s.basePath, filepath.Join(parts...))
+
+	return s.removeFile(path)
+}
+
 func (s *FSStore) SaveExecutionBadBlock(ctx context.Context, params *SaveParams) (string, error) {
 	parts := strings.Split(params.Location, "/")
 
@@ -249,6 +426,9 @@ func (s *FSStore) SaveExecutionBadBlock(ctx context.Context, params *SaveParams)
 		return "", err
 	}
 
+	s.basicMetrics.ObserveItemAdded(string(BadBlockDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(BadBlockDataType), len(*params.Data))
+
 	return params.Location, nil
 }
 
@@ -1,9 +1,14 @@
 package store_test
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
 	"os"
+	"path/filepath"
 	"testing"
+	"testing/iotest"
 
 	"github.com/ethpandaops/tracoor/pkg/store"
 	"github.com/sirupsen/logrus"
@@ -46,6 +51,57 @@ func TestFSStoreOperations(t *testing.T) {
 		require.Equal(t, data, *savedData)
 	})
 
+	t.Run("SaveStream", func(t *testing.T) {
+		location := "beacon_state/stream.ssz"
+		data := []byte(`{"abc": "def"}`)
+		_, err := fsStore.SaveStream(ctx, store.BeaconStateDataType, &store.SaveStreamParams{
+			Data:     bytes.NewReader(data),
+			Location: location,
+		})
+		require.NoError(t, err)
+
+		savedData, err := fsStore.GetBeaconState(ctx, location)
+		require.NoError(t, err)
+		require.Equal(t, data, *savedData)
+
+		// A failed stream doesn't leave a partial file behind.
+		_, err = fsStore.SaveStream(ctx, store.BeaconStateDataType, &store.SaveStreamParams{
+			Data:     iotest.ErrReader(errors.New("stream failed")),
+			Location: "beacon_state/failed.ssz",
+		})
+		require.Error(t, err)
+
+		entries, err := os.ReadDir(filepath.Join(basePath, "beacon_state"))
+		require.NoError(t, err)
+
+		for _, entry := range entries {
+			require.NotEqual(t, "failed.ssz", entry.Name())
+			require.NotContains(t, entry.Name(), ".tmp")
+		}
+	})
+
+	t.Run("GetStream", func(t *testing.T) {
+		location := "execution_block_trace/stream.json"
+		data := []byte(`[{"result": {}}]`)
+		_, err := fsStore.SaveExecutionBlockTrace(ctx, &store.SaveParams{
+			Data:     &data,
+			Location: location,
+		})
+		require.NoError(t, err)
+
+		r, err := fsStore.GetStream(ctx, store.BlockTraceDataType, location)
+		require.NoError(t, err)
+
+		defer r.Close()
+
+		savedData, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, savedData)
+
+		_, err = fsStore.GetStream(ctx, store.BlockTraceDataType, "execution_block_trace/missing.json")
+		require.ErrorIs(t, err, store.ErrNotFound)
+	})
+
 	t.Run("DeleteBeaconState", func(t *testing.T) {
 		location := "beacon_state/location.json"
 		data := []byte(`{"abc": "def"}`)
@@ -94,6 +150,69 @@ func TestFSStoreOperations(t *testing.T) {
 		require.False(t, exists)
 	})
 
+	t.Run("SaveBlobSidecar", func(t *testing.T) {
+		location := "blob_sidecar/location.ssz"
+		data := []byte("blob sidecar data")
+		_, err := fsStore.SaveBlobSidecar(ctx, &store.SaveParams{
+			Data:     &data,
+			Location: location,
+		})
+		require.NoError(t, err)
+
+		savedData, err := fsStore.GetBlobSidecar(ctx, location)
+		require.NoError(t, err)
+		require.Equal(t, data, *savedData)
+
+		err = fsStore.DeleteBlobSidecar(ctx, location)
+		require.NoError(t, err)
+
+		exists, err := fsStore.Exists(ctx, location)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("SaveForkChoice", func(t *testing.T) {
+		location := "fork_choice/location.json"
+		data := []byte(`{"fork_choice_nodes": []}`)
+		_, err := fsStore.SaveForkChoice(ctx, &store.SaveParams{
+			Data:     &data,
+			Location: location,
+		})
+		require.NoError(t, err)
+
+		savedData, err := fsStore.GetForkChoice(ctx, location)
+		require.NoError(t, err)
+		require.Equal(t, data, *savedData)
+
+		err = fsStore.DeleteForkChoice(ctx, location)
+		require.NoError(t, err)
+
+		exists, err := fsStore.Exists(ctx, location)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("SaveExecutionWitness", func(t *testing.T) {
+		location := "execution_witness/location.json"
+		data := []byte(`{"state": []}`)
+		_, err := fsStore.SaveExecutionWitness(ctx, &store.SaveParams{
+			Data:     &data,
+			Location: location,
+		})
+		require.NoError(t, err)
+
+		savedData, err := fsStore.GetExecutionWitness(ctx, location)
+		require.NoError(t, err)
+		require.Equal(t, data, *savedData)
+
+		err = fsStore.DeleteExecutionWitness(ctx, location)
+		require.NoError(t, err)
+
+		exists, err := fsStore.Exists(ctx, location)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
 	t.Run("SaveBeaconBadBlock", func(t *testing.T) {
 		location := locationBadBeaconBlock
 		data := []byte(`{"bad_block": "data"}`)
@@ -0,0 +1,520 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/ethpandaops/tracoor/pkg/compression"
+	"github.com/ethpandaops/tracoor/pkg/mime"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/option"
+)
+
+type GCSStore struct {
+	client *storage.Client
+	bucket *storage.BucketHandle
+
+	config *GCSStoreConfig
+
+	// signer is the service account that signs URLs. If nil, the client's
+	// credentials are used.
+	signer *gcsServiceAccount
+
+	log  logrus.FieldLogger
+	opts *Options
+
+	basicMetrics *BasicMetrics
+}
+
+//nolint:tagliatelle // required snake.
+type GCSStoreConfig struct {
+	BucketName string `yaml:"bucket_name"`
+	KeyPrefix  string `yaml:"key_prefix"`
+	// CredentialsFile is a service account key file. If empty, application
+	// default credentials are used.
+	CredentialsFile string `yaml:"credentials_file"`
+	// Endpoint overrides the GCS endpoint, e.g. for an emulator. Requests to a
+	// custom endpoint aren't authenticated.
+	Endpoint   string `yaml:"endpoint"`
+	PreferURLs bool   `yaml:"prefer_urls"`
+}
+
+// gcsServiceAccount holds the fields of a service account key file needed to
+// sign URLs.
+//
+//nolint:tagliatelle // required snake.
+type gcsServiceAccount struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+}
+
+// NewGCSStore creates a new GCSStore instance with the specified bucket and credentials.
+func NewGCSStore(namespace string, log logrus.FieldLogger, config *GCSStoreConfig, opts *Options) (*GCSStore, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
+
+	if config.BucketName == "" {
+		return nil, errors.New("bucket name is required")
+	}
+
+	var (
+		clientOpts []option.ClientOption
+		signer     *gcsServiceAccount
+	)
+
+	if config.CredentialsFile != "" {
+		data, err := os.ReadFile(config.CredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read credentials file: %w", err)
+		}
+
+		signer = &gcsServiceAccount{}
+		if err := json.Unmarshal(data, signer); err != nil {
+			return nil, fmt.Errorf("failed to parse credentials file: %w", err)
+		}
+
+		if config.Endpoint == "" {
+			clientOpts = append(clientOpts, option.WithCredentialsJSON(data))
+		}
+	}
+
+	if config.Endpoint != "" {
+		clientOpts = append(clientOpts, option.WithEndpoint(config.Endpoint), option.WithoutAuthentication())
+	}
+
+	client, err := storage.NewClient(context.Background(), clientOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCS client: %w", err)
+	}
+
+	metrics := GetBasicMetricsInstance(namespace, string(GCSStoreType), opts.MetricsEnabled)
+
+	return &GCSStore{
+		client:       client,
+		bucket:       client.Bucket(config.BucketName),
+		config:       config,
+		signer:       signer,
+		log:          log,
+		opts:         opts,
+		basicMetrics: metrics,
+	}, nil
+}
+
+func (s *GCSStore) PathPrefix() string {
+	return s.config.KeyPrefix
+}
+
+func (s *GCSStore) PreferURLs() bool {
+	return s.config.PreferURLs
+}
+
+func (s *GCSStore) Healthy(ctx context.Context) error {
+	if _, err := s.bucket.Attrs(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *GCSStore) Exists(ctx context.Context, location string) (bool, error) {
+	_, err := s.bucket.Object(location).Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// put writes the stream to the object at location. The write is aborted if
+// the stream fails, so a partial object is never left behind.
+func (s *GCSStore) put(ctx context.Context, location, contentEncoding string, data io.Reader) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := s.bucket.Object(location).NewWriter(ctx)
+	w.ContentEncoding = contentEncoding
+	w.ContentType = string(mime.GetContentTypeFromExtension(filepath.Ext(compression.RemoveExtension(location))))
+
+	n, err := io.Copy(w, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := w.Close(); err != nil {
+		if errors.Is(err, storage.ErrBucketNotExist) {
+			return 0, fmt.Errorf("bucket does not exist: %w", err)
+		}
+
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// GetRaw returns the object as it's stored. Objects are read compressed so
+// GCS doesn't decompress objects saved with a content encoding.
+// GetStream returns a reader of the object without reading it into memory.
+func (s *GCSStore) GetStream(ctx context.Context, dataType DataType, location string) (io.ReadCloser, error) {
+	s.basicMetrics.ObserveCacheMiss(string(dataType))
+
+	r, err := s.bucket.Object(location).ReadCompressed(true).NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+
+	s.basicMetrics.ObserveItemRetreived(string(dataType))
+
+	return r, nil
+}
+
+func (s *GCSStore) GetRaw(ctx context.Context, location string) (*bytes.Buffer, error) {
+	r, err := s.bucket.Object(location).ReadCompressed(true).NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+
+	defer r.Close()
+
+	var buff bytes.Buffer
+
+	if _, err := buff.ReadFrom(r); err != nil {
+		return nil, err
+	}
+
+	return &buff, nil
+}
+
+func (s *GCSStore) delete(ctx context.Context, location string) error {
+	if err := s.bucket.Object(location).Delete(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	return nil
+}
+
+func (s *GCSStore) StorageHandshakeTokenExists(ctx context.Context, node string) (bool, error) {
+	exists, err := s.Exists(ctx, fmt.Sprintf("handshake/%s", node))
+	if err != nil {
+		return false, fmt.Errorf("failed to check if storage handshake token exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (s *GCSStore) SaveStorageHandshakeToken(ctx context.Context, node, data string) error {
+	if _, err := s.put(ctx, fmt.Sprintf("handshake/%s", node), "", strings.NewReader(data)); err != nil {
+		return fmt.Errorf("failed to save storage handshake for node %s: %w", node, err)
+	}
+
+	return nil
+}
+
+func (s *GCSStore) GetStorageHandshakeToken(ctx context.Context, node string) (string, error) {
+	data, err := s.GetRaw(ctx, fmt.Sprintf("handshake/%s", node))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return "", ErrNotFound
+		}
+
+		return "", fmt.Errorf("failed to get storage handshake for node %s: %w", node, err)
+	}
+
+	return data.String(), nil
+}
+
+func (s *GCSStore) Copy(ctx context.Context, params *CopyParams) error {
+	if params.Source == "" || params.Destination == "" {
+		return errors.New("source and destination are required")
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"source":      params.Source,
+		"destination": params.Destination,
+	}).Debug("Performing object copy")
+
+	// Copies are done server-side and keep the source's metadata.
+	if _, err := s.bucket.Object(params.Destination).CopierFrom(s.bucket.Object(params.Source)).Run(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed to copy object: %w", err)
+	}
+
+	return nil
+}
+
+// getSignedURL returns a V4 signed URL for downloading the object.
+func (s *GCSStore) getSignedURL(params *GetURLParams) (string, error) {
+	// Remove the compression extension if it exists
+	extension := filepath.Ext(
+		compression.RemoveExtension(
+			params.Location,
+		),
+	)
+
+	query := url.Values{}
+	query.Set("response-content-type", string(mime.GetContentTypeFromExtension(extension)))
+	query.Set("response-content-disposition", fmt.Sprintf("attachment; filename=%q", compression.RemoveExtension(
+		filepath.Base(params.Location),
+	)))
+
+	opts := &storage.SignedURLOptions{
+		Scheme:          storage.SigningSchemeV4,
+		Method:          http.MethodGet,
+		Expires:         time.Now().Add(time.Duration(params.Expiry) * time.Second),
+		QueryParameters: query,
+	}
+
+	if s.signer != nil {
+		opts.GoogleAccessID = s.signer.ClientEmail
+		opts.PrivateKey = []byte(s.signer.PrivateKey)
+	}
+
+	if s.config.Endpoint != "" {
+		endpoint, err := url.Parse(s.config.Endpoint)
+		if err != nil {
+			return "", fmt.Errorf("invalid endpoint: %w", err)
+		}
+
+		opts.Hostname = endpoint.Host
+		opts.Insecure = endpoint.Scheme == "http"
+	}
+
+	return s.bucket.SignedURL(params.Location, opts)
+}
+
+func (s *GCSStore) save(ctx context.Context, dataType DataType, params *SaveParams) (string, error) {
+	if params.Data == nil {
+		return "", errors.New("data is nil")
+	}
+
+	if _, err := s.put(ctx, params.Location, params.ContentEncoding, bytes.NewReader(*params.Data)); err != nil {
+		return "", fmt.Errorf("failed to save %s: %w", dataType, err)
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(dataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(dataType), len(*params.Data))
+
+	return params.Location, nil
+}
+
+func (s *GCSStore) get(ctx context.Context, dataType DataType, location string) (*[]byte, error) {
+	s.basicMetrics.ObserveCacheMiss(string(dataType))
+
+	data, err := s.GetRaw(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	s.basicMetrics.ObserveItemRetreived(string(dataType))
+
+	b := data.Bytes()
+
+	return &b, nil
+}
+
+func (s *GCSStore) getURL(dataType DataType, params *GetURLParams) (string, error) {
+	signedURL, err := s.getSignedURL(params)
+	if err != nil {
+		return "", err
+	}
+
+	s.basicMetrics.ObserveItemURLRetreived(string(dataType))
+
+	return signedURL, nil
+}
+
+func (s *GCSStore) remove(ctx context.Context, dataType DataType, location string) error {
+	if err := s.delete(ctx, location); err != nil {
+		return err
+	}
+
+	s.basicMetrics.ObserveItemRemoved(string(dataType))
+
+	return nil
+}
+
+func (s *GCSStore) SaveStream(ctx context.Context, dataType DataType, params *SaveStreamParams) (string, error) {
+	n, err := s.put(ctx, params.Location, params.ContentEncoding, params.Data)
+	if err != nil {
+		return "", fmt.Errorf("failed to save %s: %w", dataType, err)
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(dataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(dataType), int(n))
+
+	return params.Location, nil
+}
+
+func (s *GCSStore) SaveBeaconState(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BeaconStateDataType, params)
+}
+
+func (s *GCSStore) GetBeaconState(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BeaconStateDataType, location)
+}
+
+func (s *GCSStore) GetBeaconStateURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BeaconStateDataType, params)
+}
+
+func (s *GCSStore) DeleteBeaconState(ctx context.Context, location string) error {
+	return s.remove(ctx, BeaconStateDataType, location)
+}
+
+func (s *GCSStore) SaveBeaconBlock(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BeaconBlockDataType, params)
+}
+
+func (s *GCSStore) GetBeaconBlock(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BeaconBlockDataType, location)
+}
+
+func (s *GCSStore) GetBeaconBlockURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BeaconBlockDataType, params)
+}
+
+func (s *GCSStore) DeleteBeaconBlock(ctx context.Context, location string) error {
+	return s.remove(ctx, BeaconBlockDataType, location)
+}
+
+func (s *GCSStore) SaveBlobSidecar(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BlobSidecarDataType, params)
+}
+
+func (s *GCSStore) GetBlobSidecar(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BlobSidecarDataType, location)
+}
+
+func (s *GCSStore) GetBlobSidecarURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BlobSidecarDataType, params)
+}
+
+func (s *GCSStore) DeleteBlobSidecar(ctx context.Context, location string) error {
+	return s.remove(ctx, BlobSidecarDataType, location)
+}
+
+func (s *GCSStore) SaveForkChoice(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, ForkChoiceDataType, params)
+}
+
+func (s *GCSStore) GetForkChoice(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, ForkChoiceDataType, location)
+}
+
+func (s *GCSStore) GetForkChoiceURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(ForkChoiceDataType, params)
+}
+
+func (s *GCSStore) DeleteForkChoice(ctx context.Context, location string) error {
+	return s.remove(ctx, ForkChoiceDataType, location)
+}
+
+func (s *GCSStore) SaveBeaconBadBlock(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BeaconBadBlockDataType, params)
+}
+
+func (s *GCSStore) GetBeaconBadBlock(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BeaconBadBlockDataType, location)
+}
+
+func (s *GCSStore) GetBeaconBadBlockURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BeaconBadBlockDataType, params)
+}
+
+func (s *GCSStore) DeleteBeaconBadBlock(ctx context.Context, location string) error {
+	return s.remove(ctx, BeaconBadBlockDataType, location)
+}
+
+func (s *GCSStore) SaveBeaconBadBlob(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BeaconBadBlobDataType, params)
+}
+
+func (s *GCSStore) GetBeaconBadBlob(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BeaconBadBlobDataType, location)
+}
+
+func (s *GCSStore) GetBeaconBadBlobURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BeaconBadBlobDataType, params)
+}
+
+func (s *GCSStore) DeleteBeaconBadBlob(ctx context.Context, location string) error {
+	return s.remove(ctx, BeaconBadBlobDataType, location)
+}
+
+func (s *GCSStore) SaveExecutionBlockTrace(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BlockTraceDataType, params)
+}
+
+func (s *GCSStore) GetExecutionBlockTrace(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BlockTraceDataType, location)
+}
+
+func (s *GCSStore) GetExecutionBlockTraceURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BlockTraceDataType, params)
+}
+
+func (s *GCSStore) DeleteExecutionBlockTrace(ctx context.Context, location string) error {
+	return s.remove(ctx, BlockTraceDataType, location)
+}
+
+func (s *GCSStore) SaveExecutionWitness(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, ExecutionWitnessDataType, params)
+}
+
+func (s *GCSStore) GetExecutionWitness(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, ExecutionWitnessDataType, location)
+}
+
+func (s *GCSStore) GetExecutionWitnessURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(ExecutionWitnessDataType, params)
+}
+
+func (s *GCSStore) DeleteExecutionWitness(ctx context.Context, location string) error {
+	return s.remove(ctx, ExecutionWitnessDataType, location)
+}
+
+func (s *GCSStore) SaveExecutionBadBlock(ctx context.Context, params *SaveParams) (string, error) {
+	return s.save(ctx, BadBlockDataType, params)
+}
+
+func (s *GCSStore) GetExecutionBadBlock(ctx context.Context, location string) (*[]byte, error) {
+	return s.get(ctx, BadBlockDataType, location)
+}
+
+func (s *GCSStore) GetExecutionBadBlockURL(ctx context.Context, params *GetURLParams) (string, error) {
+	return s.getURL(BadBlockDataType, params)
+}
+
+func (s *GCSStore) DeleteExecutionBadBlock(ctx context.Context, location string) error {
+	return s.remove(ctx, BadBlockDataType, location)
+}
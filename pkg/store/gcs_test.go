@@ -0,0 +1,141 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/ethpandaops/tracoor/pkg/compression"
+)
+
+func TestGCSStoreOperations(t *testing.T) {
+	bucket := "mybucket"
+	ctx := context.Background()
+
+	store, cleanup, err := NewMockGCSStore(ctx, bucket)
+	if err != nil {
+		t.Fatalf("Failed to create GCS store: %v", err)
+	}
+
+	defer func() {
+		if err = cleanup(); err != nil {
+			t.Fatalf("Failed to clean up: %v", err)
+		}
+	}()
+
+	t.Run("BeaconState", func(t *testing.T) {
+		testBeaconState(ctx, t, store)
+	})
+
+	t.Run("SaveStream", func(t *testing.T) {
+		testSaveStream(ctx, t, store)
+	})
+
+	t.Run("BeaconBlock", func(t *testing.T) {
+		testBeaconBlock(ctx, t, store)
+	})
+
+	t.Run("BeaconBadBlock", func(t *testing.T) {
+		testBeaconBadBlock(ctx, t, store)
+	})
+
+	t.Run("ExecutionBlockTrace", func(t *testing.T) {
+		testExecutionBlockTrace(ctx, t, store)
+	})
+
+	t.Run("ExecutionBadBlock", func(t *testing.T) {
+		testExecutionBadBlock(ctx, t, store)
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		testCopy(ctx, t, store)
+	})
+
+	t.Run("HandshakeToken", func(t *testing.T) {
+		exists, err := store.StorageHandshakeTokenExists(ctx, "node")
+		if err != nil {
+			t.Fatalf("Failed to check storage handshake token: %v", err)
+		}
+
+		if exists {
+			t.Fatal("Expected storage handshake token to not exist")
+		}
+
+		if err := store.SaveStorageHandshakeToken(ctx, "node", "token"); err != nil {
+			t.Fatalf("Failed to save storage handshake token: %v", err)
+		}
+
+		token, err := store.GetStorageHandshakeToken(ctx, "node")
+		if err != nil {
+			t.Fatalf("Failed to get storage handshake token: %v", err)
+		}
+
+		if token != "token" {
+			t.Fatalf("Expected token %q, got %q", "token", token)
+		}
+	})
+
+	t.Run("ContentEncoding", func(t *testing.T) {
+		// Compressed data is returned as it was saved instead of being
+		// decompressed by GCS.
+		data := []byte(`{"abc": "def"}`)
+
+		compressed, err := compression.NewCompressor().Compress(&data, compression.Gzip)
+		if err != nil {
+			t.Fatalf("Failed to compress data: %v", err)
+		}
+
+		location, err := store.SaveForkChoice(ctx, &SaveParams{
+			Data:            &compressed,
+			Location:        "fork_choice/encoded.json",
+			ContentEncoding: compression.Gzip.ContentEncoding,
+		})
+		if err != nil {
+			t.Fatalf("Failed to save fork choice: %v", err)
+		}
+
+		retrieved, err := store.GetForkChoice(ctx, location)
+		if err != nil {
+			t.Fatalf("Failed to get fork choice: %v", err)
+		}
+
+		if !bytes.Equal(compressed, *retrieved) {
+			t.Fatal("Retrieved data does not match the compressed data")
+		}
+	})
+
+	t.Run("SignedURL", func(t *testing.T) {
+		signed, err := store.GetBeaconStateURL(ctx, &GetURLParams{
+			Location:        "beacon_state/state.ssz",
+			Expiry:          3600,
+			ContentEncoding: compression.Gzip.ContentEncoding,
+		})
+		if err != nil {
+			t.Fatalf("Failed to get signed URL: %v", err)
+		}
+
+		parsed, err := url.Parse(signed)
+		if err != nil {
+			t.Fatalf("Failed to parse signed URL: %v", err)
+		}
+
+		query := parsed.Query()
+
+		if query.Get("X-Goog-Algorithm") != "GOOG4-RSA-SHA256" {
+			t.Fatalf("Expected a V4 signed URL, got %s", signed)
+		}
+
+		if query.Get("X-Goog-Signature") == "" {
+			t.Fatalf("Expected the URL to be signed, got %s", signed)
+		}
+
+		if query.Get("response-content-disposition") != `attachment; filename="state.ssz"` {
+			t.Fatalf("Unexpected content disposition %q", query.Get("response-content-disposition"))
+		}
+
+		if parsed.Path != "/mybucket/beacon_state/state.ssz" {
+			t.Fatalf("Unexpected signed URL path %s", parsed.Path)
+		}
+	})
+}
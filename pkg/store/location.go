@@ -0,0 +1,200 @@
+package store
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/ethpandaops/tracoor/pkg/tracediff"
+)
+
+// The Create*FileName functions return the location of each data type, without
+// its file extension. Agents and the server share them so an item is stored at
+// the same location whichever of them saves it.
+
+func CreateBeaconStateFileName(
+	node string,
+	network string,
+	slot uint64,
+	stateRoot string,
+) string {
+	return path.Join(
+		"beacon_states",
+		network,
+		"slots",
+		fmt.Sprintf("%d", slot),
+		node,
+		stateRoot,
+	)
+}
+
+// CreateSharedBeaconStateFileName returns the location of a beacon state that
+// is stored once for every node on the network, keyed by its state root.
+func CreateSharedBeaconStateFileName(
+	network string,
+	stateRoot string,
+) string {
+	return path.Join(
+		"beacon_states",
+		network,
+		"roots",
+		stateRoot,
+	)
+}
+
+func CreateBeaconBlockFileName(
+	node string,
+	network string,
+	slot uint64,
+	blockRoot string,
+) string {
+	return path.Join(
+		"beacon_blocks",
+		network,
+		"slots",
+		fmt.Sprintf("%d", slot),
+		node,
+		blockRoot,
+	)
+}
+
+// CreateSharedBeaconBlockFileName returns the location of a beacon block that
+// is stored once for every node on the network, keyed by its block root.
+func CreateSharedBeaconBlockFileName(
+	network string,
+	blockRoot string,
+) string {
+	return path.Join(
+		"beacon_blocks",
+		network,
+		"roots",
+		blockRoot,
+	)
+}
+
+func CreateBlobSidecarFileName(
+	node string,
+	network string,
+	slot uint64,
+	blockRoot string,
+	sidecarType string,
+) string {
+	return path.Join(
+		fmt.Sprintf("%ss", sidecarType),
+		network,
+		"slots",
+		fmt.Sprintf("%d", slot),
+		node,
+		blockRoot,
+	)
+}
+
+func CreateForkChoiceFileName(
+	node string,
+	network string,
+	slot uint64,
+	fetchedAt time.Time,
+) string {
+	return path.Join(
+		"fork_choices",
+		network,
+		"slots",
+		fmt.Sprintf("%d", slot),
+		node,
+		fmt.Sprintf("%d", fetchedAt.UnixMilli()),
+	)
+}
+
+func CreateBeaconBadBlockFileName(
+	node string,
+	network string,
+	slot uint64,
+	blockRoot string,
+) string {
+	return path.Join(
+		"beacon_bad_blocks",
+		network,
+		"slots",
+		fmt.Sprintf("%d", slot),
+		node,
+		blockRoot,
+	)
+}
+
+func CreateBeaconBadBlobFileName(
+	node string,
+	network string,
+	slot uint64,
+	blockRoot string,
+	index uint64,
+) string {
+	return path.Join(
+		"beacon_bad_blobs",
+		network,
+		"slots",
+		fmt.Sprintf("%d", slot),
+		node,
+		blockRoot,
+		fmt.Sprintf("%d", index),
+	)
+}
+
+func CreateExecutionBlockTraceFileName(
+	node string,
+	network string,
+	blockNumber uint64,
+	blockHash string,
+	tracer string,
+) string {
+	// Struct logger traces keep their original location so existing
+	// layouts are unchanged.
+	if tracer == tracediff.StructLoggerTracer {
+		return path.Join(
+			"execution_block_traces",
+			network,
+			"blocks",
+			fmt.Sprintf("%d", blockNumber),
+			node,
+			blockHash,
+		)
+	}
+
+	return path.Join(
+		"execution_block_traces",
+		network,
+		"blocks",
+		fmt.Sprintf("%d", blockNumber),
+		node,
+		tracer,
+		blockHash,
+	)
+}
+
+func CreateExecutionWitnessFileName(
+	node string,
+	network string,
+	blockNumber uint64,
+	blockHash string,
+) string {
+	return path.Join(
+		"execution_witnesses",
+		network,
+		"blocks",
+		fmt.Sprintf("%d", blockNumber),
+		node,
+		blockHash,
+	)
+}
+
+func CreateExecutionBadBlockFileName(
+	node string,
+	network string,
+	blockHash string,
+) string {
+	return path.Join(
+		"execution_bad_blocks",
+		network,
+		node,
+		blockHash,
+	)
+}
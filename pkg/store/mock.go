@@ -2,9 +2,16 @@ package store
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"os"
 	"time"
 
+	"github.com/fsouza/fake-gcs-server/fakestorage"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/testcontainers/testcontainers-go"
@@ -70,3 +77,141 @@ func NewMockS3Store(ctx context.Context, bucket string) (Store, func() error, er
 
 	return store, deferrable, nil
 }
+
+// NewMockGCSStore starts an in-process fake-gcs-server with the bucket and
+// returns a GCS store for it. URLs are signed with a throwaway service account.
+func NewMockGCSStore(ctx context.Context, bucket string) (Store, func() error, error) {
+	server, err := fakestorage.NewServerWithOptions(fakestorage.Options{
+		Scheme: "http",
+		Host:   "127.0.0.1",
+		// Objects are read from the XML API on the same host.
+		PublicHost: "127.0.0.1",
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to start fake GCS server")
+	}
+
+	server.CreateBucketWithOpts(fakestorage.CreateBucketOpts{Name: bucket})
+
+	credentialsFile, err := writeMockGCSCredentials()
+	if err != nil {
+		server.Stop()
+
+		return nil, nil, err
+	}
+
+	deferrable := func() error {
+		server.Stop()
+
+		return os.Remove(credentialsFile)
+	}
+
+	store, err := NewGCSStore("throwaway", logrus.New(), &GCSStoreConfig{
+		BucketName:      bucket,
+		Endpoint:        server.URL() + "/storage/v1/",
+		CredentialsFile: credentialsFile,
+	}, DefaultOptions().SetMetricsEnabled(false))
+	if err != nil {
+		_ = deferrable()
+
+		return nil, nil, errors.Wrap(err, "failed to create GCS store")
+	}
+
+	return store, deferrable, nil
+}
+
+func writeMockGCSCredentials() (string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate service account key")
+	}
+
+	data, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "tracoor@example.iam.gserviceaccount.com",
+		"private_key": string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		})),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.CreateTemp("", "tracoor-gcs-*.json")
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		return "", err
+	}
+
+	return file.Name(), nil
+}
+
+// azuriteAccountName and azuriteAccountKey are Azurite's well-known development
+// account credentials.
+const (
+	azuriteAccountName = "devstoreaccount1"
+	azuriteAccountKey  = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+func setupAzuriteContainer(ctx context.Context) (testcontainers.Container, string, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        "mcr.microsoft.com/azure-storage/azurite",
+		ExposedPorts: []string{"10000/tcp"},
+		Cmd:          []string{"azurite-blob", "--blobHost", "0.0.0.0", "--skipApiVersionCheck"},
+		WaitingFor:   wait.ForListeningPort("10000/tcp").WithStartupTimeout(2 * time.Minute),
+	}
+
+	azuriteContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	endpoint, err := azuriteContainer.Endpoint(ctx, "")
+	if err != nil {
+		return nil, "", err
+	}
+
+	return azuriteContainer, endpoint, nil
+}
+
+// NewMockAzureStore starts an Azurite container and returns an Azure store for
+// a new container in it.
+func NewMockAzureStore(ctx context.Context, containerName string) (Store, func() error, error) {
+	azuriteContainer, endpoint, err := setupAzuriteContainer(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to setup Azurite container")
+	}
+
+	deferrable := func() error {
+		return azuriteContainer.Terminate(ctx)
+	}
+
+	store, err := NewAzureStore("throwaway", logrus.New(), &AzureStoreConfig{
+		AccountName:   azuriteAccountName,
+		AccountKey:    azuriteAccountKey,
+		ContainerName: containerName,
+		Endpoint:      fmt.Sprintf("http://%s/%s", endpoint, azuriteAccountName),
+	}, DefaultOptions().SetMetricsEnabled(false))
+	if err != nil {
+		_ = deferrable()
+
+		return nil, nil, errors.Wrap(err, "failed to create Azure store")
+	}
+
+	if _, err := store.client.Create(ctx, nil); err != nil {
+		_ = deferrable()
+
+		return nil, nil, errors.Wrap(err, "failed to create Azure container")
+	}
+
+	return store, deferrable, nil
+}
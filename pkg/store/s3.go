@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 	"strings"
 	"time"
@@ -88,6 +89,33 @@ func (s *S3Store) Healthy(ctx context.Context) error {
 	return nil
 }
 
+// GetStream returns the object's body without reading it into memory.
+func (s *S3Store) GetStream(ctx context.Context, dataType DataType, location string) (io.ReadCloser, error) {
+	s.basicMetrics.ObserveCacheMiss(string(dataType))
+
+	data, err := s.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.config.BucketName),
+		Key:    aws.String(location),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			switch apiErr.(type) {
+			case *s3types.NoSuchKey, *s3types.NotFound:
+				return nil, ErrNotFound
+			default:
+				return nil, errors.New("failed to get: " + apiErr.Error())
+			}
+		}
+
+		return nil, err
+	}
+
+	s.basicMetrics.ObserveItemRetreived(string(dataType))
+
+	return data.Body, nil
+}
+
 func (s *S3Store) GetRaw(ctx context.Context, location string) (*bytes.Buffer, error) {
 	data, err := s.s3Client.GetObject(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(s.config.BucketName),
@@ -432,6 +460,178 @@ func (s *S3Store) DeleteBeaconBlock(ctx context.Context, location string) error
 	return err
 }
 
+func (s *S3Store) SaveBlobSidecar(ctx context.Context, params *SaveParams) (string, error) {
+	if params.Data == nil {
+		return "", errors.New("data is nil")
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(s.config.BucketName),
+		Key:    aws.String(params.Location),
+		Body:   bytes.NewBuffer(*params.Data),
+	}
+
+	if params.ContentEncoding != "" {
+		input.ContentEncoding = aws.String(params.ContentEncoding)
+	}
+
+	_, err := s.s3Client.PutObject(ctx, input, s3.WithAPIOptions(v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware))
+	if err != nil {
+		var apiErr smithy.APIError
+
+		if errors.As(err, &apiErr) {
+			switch apiErr.(type) {
+			case *s3types.NoSuchBucket:
+				return "", errors.New("bucket does not exist: " + apiErr.Error())
+			case *s3types.NotFound:
+				return "", ErrNotFound
+			default:
+				return "", errors.New("failed to save frame: " + apiErr.Error())
+			}
+		}
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(BlobSidecarDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(BlobSidecarDataType), len(*params.Data))
+
+	return params.Location, err
+}
+
+func (s *S3Store) GetBlobSidecarURL(ctx context.Context, params *GetURLParams) (string, error) {
+	url, err := s.getPresignedURL(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	s.basicMetrics.ObserveItemURLRetreived(string(BlobSidecarDataType))
+
+	return url, nil
+}
+
+func (s *S3Store) GetBlobSidecar(ctx context.Context, location string) (*[]byte, error) {
+	s.basicMetrics.ObserveCacheMiss(string(BlobSidecarDataType))
+
+	data, err := s.GetRaw(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	s.basicMetrics.ObserveItemRetreived(string(BlobSidecarDataType))
+
+	b := data.Bytes()
+
+	return &b, nil
+}
+
+func (s *S3Store) DeleteBlobSidecar(ctx context.Context, location string) error {
+	_, err := s.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.config.BucketName),
+		Key:    aws.String(location),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+
+		if errors.As(err, &apiErr) {
+			switch apiErr.(type) {
+			case *s3types.NotFound:
+				return ErrNotFound
+			default:
+				return errors.New("failed to delete: " + apiErr.Error())
+			}
+		}
+	}
+
+	s.basicMetrics.ObserveItemRemoved(string(BlobSidecarDataType))
+
+	return err
+}
+
+func (s *S3Store) SaveForkChoice(ctx context.Context, params *SaveParams) (string, error) {
+	if params.Data == nil {
+		return "", errors.New("data is nil")
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(s.config.BucketName),
+		Key:    aws.String(params.Location),
+		Body:   bytes.NewBuffer(*params.Data),
+	}
+
+	if params.ContentEncoding != "" {
+		input.ContentEncoding = aws.String(params.ContentEncoding)
+	}
+
+	_, err := s.s3Client.PutObject(ctx, input, s3.WithAPIOptions(v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware))
+	if err != nil {
+		var apiErr smithy.APIError
+
+		if errors.As(err, &apiErr) {
+			switch apiErr.(type) {
+			case *s3types.NoSuchBucket:
+				return "", errors.New("bucket does not exist: " + apiErr.Error())
+			case *s3types.NotFound:
+				return "", ErrNotFound
+			default:
+				return "", errors.New("failed to save frame: " + apiErr.Error())
+			}
+		}
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(ForkChoiceDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(ForkChoiceDataType), len(*params.Data))
+
+	return params.Location, err
+}
+
+func (s *S3Store) GetForkChoiceURL(ctx context.Context, params *GetURLParams) (string, error) {
+	url, err := s.getPresignedURL(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	s.basicMetrics.ObserveItemURLRetreived(string(ForkChoiceDataType))
+
+	return url, nil
+}
+
+func (s *S3Store) GetForkChoice(ctx context.Context, location string) (*[]byte, error) {
+	s.basicMetrics.ObserveCacheMiss(string(ForkChoiceDataType))
+
+	data, err := s.GetRaw(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	s.basicMetrics.ObserveItemRetreived(string(ForkChoiceDataType))
+
+	b := data.Bytes()
+
+	return &b, nil
+}
+
+func (s *S3Store) DeleteForkChoice(ctx context.Context, location string) error {
+	_, err := s.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.config.BucketName),
+		Key:    aws.String(location),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+
+		if errors.As(err, &apiErr) {
+			switch apiErr.(type) {
+			case *s3types.NotFound:
+				return ErrNotFound
+			default:
+				return errors.New("failed to delete: " + apiErr.Error())
+			}
+		}
+	}
+
+	s.basicMetrics.ObserveItemRemoved(string(ForkChoiceDataType))
+
+	return err
+}
+
 func (s *S3Store) SaveBeaconBadBlock(ctx context.Context, params *SaveParams) (string, error) {
 	if params.Data == nil {
 		return "", errors.New("data is nil")
@@ -690,6 +890,92 @@ func (s *S3Store) DeleteExecutionBlockTrace(ctx context.Context, location string
 	return err
 }
 
+func (s *S3Store) SaveExecutionWitness(ctx context.Context, params *SaveParams) (string, error) {
+	if params.Data == nil {
+		return "", errors.New("data is nil")
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(s.config.BucketName),
+		Key:    aws.String(params.Location),
+		Body:   bytes.NewBuffer(*params.Data),
+	}
+
+	if params.ContentEncoding != "" {
+		input.ContentEncoding = aws.String(params.ContentEncoding)
+	}
+
+	_, err := s.s3Client.PutObject(ctx, input, s3.WithAPIOptions(v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware))
+	if err != nil {
+		var apiErr smithy.APIError
+
+		if errors.As(err, &apiErr) {
+			switch apiErr.(type) {
+			case *s3types.NoSuchBucket:
+				return "", errors.New("bucket does not exist: " + apiErr.Error())
+			case *s3types.NotFound:
+				return "", ErrNotFound
+			default:
+				return "", errors.New("failed to save execution witness: " + apiErr.Error())
+			}
+		}
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(ExecutionWitnessDataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(ExecutionWitnessDataType), len(*params.Data))
+
+	return params.Location, err
+}
+
+func (s *S3Store) GetExecutionWitness(ctx context.Context, location string) (*[]byte, error) {
+	s.basicMetrics.ObserveCacheMiss(string(ExecutionWitnessDataType))
+
+	data, err := s.GetRaw(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	s.basicMetrics.ObserveItemRetreived(string(ExecutionWitnessDataType))
+
+	b := data.Bytes()
+
+	return &b, nil
+}
+
+func (s *S3Store) GetExecutionWitnessURL(ctx context.Context, params *GetURLParams) (string, error) {
+	url, err := s.getPresignedURL(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	s.basicMetrics.ObserveItemURLRetreived(string(ExecutionWitnessDataType))
+
+	return url, nil
+}
+
+func (s *S3Store) DeleteExecutionWitness(ctx context.Context, location string) error {
+	_, err := s.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.config.BucketName),
+		Key:    aws.String(location),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+
+		if errors.As(err, &apiErr) {
+			switch apiErr.(type) {
+			case *s3types.NotFound:
+				return ErrNotFound
+			default:
+				return errors.New("failed to delete execution witness: " + apiErr.Error())
+			}
+		}
+	}
+
+	s.basicMetrics.ObserveItemRemoved(string(ExecutionWitnessDataType))
+
+	return err
+}
+
 func (s *S3Store) SaveExecutionBadBlock(ctx context.Context, params *SaveParams) (string, error) {
 	if params.Data == nil {
 		return "", errors.New("data is nil")
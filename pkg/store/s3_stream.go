@@ -0,0 +1,163 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+)
+
+// s3StreamPartSize is the size of each part of a multipart upload, and the
+// most a streamed upload holds in memory at once.
+const s3StreamPartSize = 16 * 1024 * 1024
+
+// SaveStream uploads the stream in parts so only a single part is held in
+// memory. Streams smaller than a part are uploaded with a single request.
+func (s *S3Store) SaveStream(ctx context.Context, dataType DataType, params *SaveStreamParams) (string, error) {
+	buf := make([]byte, s3StreamPartSize)
+
+	n, err := io.ReadFull(params.Data, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", fmt.Errorf("failed to read %s: %w", dataType, err)
+	}
+
+	if n < s3StreamPartSize {
+		if err := s.putStreamObject(ctx, params, buf[:n]); err != nil {
+			return "", s.streamError(dataType, err)
+		}
+
+		s.basicMetrics.ObserveItemAdded(string(dataType))
+		s.basicMetrics.ObserveItemAddedBytes(string(dataType), n)
+
+		return params.Location, nil
+	}
+
+	size, err := s.multipartUpload(ctx, params, buf)
+	if err != nil {
+		return "", s.streamError(dataType, err)
+	}
+
+	s.basicMetrics.ObserveItemAdded(string(dataType))
+	s.basicMetrics.ObserveItemAddedBytes(string(dataType), size)
+
+	return params.Location, nil
+}
+
+func (s *S3Store) putStreamObject(ctx context.Context, params *SaveStreamParams, data []byte) error {
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(s.config.BucketName),
+		Key:    aws.String(params.Location),
+		Body:   bytes.NewReader(data),
+	}
+
+	if params.ContentEncoding != "" {
+		input.ContentEncoding = aws.String(params.ContentEncoding)
+	}
+
+	_, err := s.s3Client.PutObject(ctx, input, s3.WithAPIOptions(v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware))
+
+	return err
+}
+
+// multipartUpload uploads the stream as a multipart upload, starting with the
+// already read first part in buf. The upload is aborted if anything fails so
+// no parts are left behind in the bucket. Returns the number of bytes uploaded.
+func (s *S3Store) multipartUpload(ctx context.Context, params *SaveStreamParams, buf []byte) (int, error) {
+	input := &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(s.config.BucketName),
+		Key:    aws.String(params.Location),
+	}
+
+	if params.ContentEncoding != "" {
+		input.ContentEncoding = aws.String(params.ContentEncoding)
+	}
+
+	upload, err := s.s3Client.CreateMultipartUpload(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+
+	abort := func() {
+		// Use a fresh context so the upload is still aborted if ctx was cancelled.
+		if _, err := s.s3Client.AbortMultipartUpload(context.Background(), &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(s.config.BucketName),
+			Key:      aws.String(params.Location),
+			UploadId: upload.UploadId,
+		}); err != nil {
+			s.log.WithError(err).WithField("location", params.Location).Warn("Failed to abort multipart upload")
+		}
+	}
+
+	var (
+		parts []s3types.CompletedPart
+		size  int
+		n     = len(buf)
+	)
+
+	for partNumber := int32(1); n > 0; partNumber++ {
+		part, err := s.s3Client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(s.config.BucketName),
+			Key:           aws.String(params.Location),
+			UploadId:      upload.UploadId,
+			PartNumber:    aws.Int32(partNumber),
+			Body:          bytes.NewReader(buf[:n]),
+			ContentLength: aws.Int64(int64(n)),
+		}, s3.WithAPIOptions(v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware))
+		if err != nil {
+			abort()
+
+			return 0, err
+		}
+
+		parts = append(parts, s3types.CompletedPart{
+			ETag:       part.ETag,
+			PartNumber: aws.Int32(partNumber),
+		})
+
+		size += n
+
+		n, err = io.ReadFull(params.Data, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			abort()
+
+			return 0, err
+		}
+	}
+
+	if _, err := s.s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(s.config.BucketName),
+		Key:             aws.String(params.Location),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &s3types.CompletedMultipartUpload{Parts: parts},
+	}); err != nil {
+		abort()
+
+		return 0, err
+	}
+
+	return size, nil
+}
+
+func (s *S3Store) streamError(dataType DataType, err error) error {
+	var apiErr smithy.APIError
+
+	if errors.As(err, &apiErr) {
+		switch apiErr.(type) {
+		case *s3types.NoSuchBucket:
+			return errors.New("bucket does not exist: " + apiErr.Error())
+		case *s3types.NotFound:
+			return ErrNotFound
+		default:
+			return fmt.Errorf("failed to save %s: %s", dataType, apiErr.Error())
+		}
+	}
+
+	return err
+}
@@ -28,6 +28,10 @@ func TestS3StoreOperations(t *testing.T) {
 		testBeaconState(ctx, t, store)
 	})
 
+	t.Run("SaveStream", func(t *testing.T) {
+		testSaveStream(ctx, t, store)
+	})
+
 	t.Run("BeaconBlock", func(t *testing.T) {
 		testBeaconBlock(ctx, t, store)
 	})
@@ -111,6 +115,41 @@ func testBeaconState(ctx context.Context, t *testing.T, store Store) {
 	})
 }
 
+func testSaveStream(ctx context.Context, t *testing.T, store Store) {
+	t.Helper()
+
+	// Sizes either side of the part size exercise both the single request and
+	// multipart paths.
+	sizes := map[string]int{
+		"Small":     1024,
+		"Multipart": s3StreamPartSize*2 + 1024,
+	}
+
+	for name, size := range sizes {
+		t.Run(name, func(t *testing.T) {
+			location := "beacon_state/stream_" + name + ".ssz"
+			data := bytes.Repeat([]byte("a"), size)
+
+			location, err := store.SaveStream(ctx, BeaconStateDataType, &SaveStreamParams{
+				Data:     bytes.NewReader(data),
+				Location: location,
+			})
+			if err != nil {
+				t.Fatalf("Failed to save stream: %v", err)
+			}
+
+			retrievedData, err := store.GetBeaconState(ctx, location)
+			if err != nil {
+				t.Fatalf("Failed to get beacon state: %v", err)
+			}
+
+			if !bytes.Equal(data, *retrievedData) {
+				t.Fatal("Retrieved data does not match")
+			}
+		})
+	}
+}
+
 func testBeaconBlock(ctx context.Context, t *testing.T, store Store) {
 	t.Helper()
 
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/ethpandaops/tracoor/pkg/yaml"
 	"github.com/sirupsen/logrus"
@@ -14,6 +15,14 @@ type SaveParams struct {
 	ContentEncoding string
 }
 
+// SaveStreamParams are the parameters for saving data read from a stream, so
+// large items don't have to be held in memory.
+type SaveStreamParams struct {
+	Data            io.Reader
+	Location        string
+	ContentEncoding string
+}
+
 type GetURLParams struct {
 	Location        string
 	Expiry          int
@@ -42,6 +51,12 @@ type Store interface {
 	// GetStorageHandshake fetches a storage handshake token from the store
 	GetStorageHandshakeToken(ctx context.Context, node string) (string, error)
 
+	// SaveStream saves data of the given type read from a stream to the store
+	SaveStream(ctx context.Context, dataType DataType, params *SaveStreamParams) (string, error)
+	// GetStream returns a stream of the data of the given type at location. The
+	// caller must close it.
+	GetStream(ctx context.Context, dataType DataType, location string) (io.ReadCloser, error)
+
 	// SaveBeaconState saves a beacon state to the store
 	SaveBeaconState(ctx context.Context, params *SaveParams) (string, error)
 	// GetBeaconState fetches a beacon state from the store
@@ -60,6 +75,24 @@ type Store interface {
 	// DeleteBeaconBlock deletes a beacon block from the store
 	DeleteBeaconBlock(ctx context.Context, location string) error
 
+	// SaveBlobSidecar saves the blob sidecars of a beacon block to the store
+	SaveBlobSidecar(ctx context.Context, params *SaveParams) (string, error)
+	// GetBlobSidecar fetches blob sidecars from the store
+	GetBlobSidecar(ctx context.Context, location string) (*[]byte, error)
+	// GetBlobSidecarURL returns a URL for the blob sidecars
+	GetBlobSidecarURL(ctx context.Context, params *GetURLParams) (string, error)
+	// DeleteBlobSidecar deletes blob sidecars from the store
+	DeleteBlobSidecar(ctx context.Context, location string) error
+
+	// SaveForkChoice saves a fork choice dump to the store
+	SaveForkChoice(ctx context.Context, params *SaveParams) (string, error)
+	// GetForkChoice fetches a fork choice dump from the store
+	GetForkChoice(ctx context.Context, location string) (*[]byte, error)
+	// GetForkChoiceURL returns a URL for the fork choice dump
+	GetForkChoiceURL(ctx context.Context, params *GetURLParams) (string, error)
+	// DeleteForkChoice deletes a fork choice dump from the store
+	DeleteForkChoice(ctx context.Context, location string) error
+
 	// SaveBeaconBadBlock saves a beacon bad block to the store
 	SaveBeaconBadBlock(ctx context.Context, params *SaveParams) (string, error)
 	// GetBeaconBadBlock fetches a beacon bad block from the store
@@ -86,6 +119,14 @@ type Store interface {
 	GetExecutionBlockTraceURL(ctx context.Context, params *GetURLParams) (string, error)
 	// DeleteExecutionBlockTrace deletes an execution block trace from the store
 	DeleteExecutionBlockTrace(ctx context.Context, location string) error
+	// SaveExecutionWitness saves an execution witness to the store
+	SaveExecutionWitness(ctx context.Context, params *SaveParams) (string, error)
+	// GetExecutionWitness fetches an execution witness from the store
+	GetExecutionWitness(ctx context.Context, location string) (*[]byte, error)
+	// GetExecutionWitnessURL returns a URL for the execution witness
+	GetExecutionWitnessURL(ctx context.Context, params *GetURLParams) (string, error)
+	// DeleteExecutionWitness deletes an execution witness from the store
+	DeleteExecutionWitness(ctx context.Context, location string) error
 
 	// SaveExecutionBadBlock saves an execution bad block to the store
 	SaveExecutionBadBlock(ctx context.Context, params *SaveParams) (string, error)
@@ -122,6 +163,22 @@ func NewStore(namespace string, log logrus.FieldLogger, storeType Type, config y
 		}
 
 		return NewFSStore(namespace, log, fsConfig, opts)
+	case GCSStoreType:
+		var gcsConfig *GCSStoreConfig
+
+		if err := config.Unmarshal(&gcsConfig); err != nil {
+			return nil, err
+		}
+
+		return NewGCSStore(namespace, log, gcsConfig, opts)
+	case AzureStoreType:
+		var azureConfig *AzureStoreConfig
+
+		if err := config.Unmarshal(&azureConfig); err != nil {
+			return nil, err
+		}
+
+		return NewAzureStore(namespace, log, azureConfig, opts)
 	default:
 		return nil, fmt.Errorf("unknown store type: %s", storeType)
 	}
@@ -3,9 +3,14 @@ package store
 type Type string
 
 const (
-	UnknownStore Type = "unknown"
-	S3StoreType  Type = "s3"
-	FSStoreType  Type = "fs"
+	UnknownStore   Type = "unknown"
+	S3StoreType    Type = "s3"
+	FSStoreType    Type = "fs"
+	GCSStoreType   Type = "gcs"
+	AzureStoreType Type = "azure"
+	// ServerStoreType is only used by agents, which upload to the server
+	// instead of writing to a store themselves.
+	ServerStoreType Type = "server"
 )
 
 func IsValidStoreType(st Type) bool {
@@ -14,6 +19,10 @@ func IsValidStoreType(st Type) bool {
 		return true
 	case FSStoreType:
 		return true
+	case GCSStoreType:
+		return true
+	case AzureStoreType:
+		return true
 	default:
 		return false
 	}
@@ -22,11 +31,14 @@ func IsValidStoreType(st Type) bool {
 type DataType string
 
 const (
-	UnknownDataType        DataType = "unknown"
-	BeaconStateDataType    DataType = "beacon_state"
-	BeaconBlockDataType    DataType = "beacon_block"
-	BeaconBadBlockDataType DataType = "beacon_bad_block"
-	BeaconBadBlobDataType  DataType = "beacon_bad_blob"
-	BlockTraceDataType     DataType = "execution_block_trace"
-	BadBlockDataType       DataType = "execution_bad_block"
+	UnknownDataType          DataType = "unknown"
+	BeaconStateDataType      DataType = "beacon_state"
+	BeaconBlockDataType      DataType = "beacon_block"
+	BlobSidecarDataType      DataType = "blob_sidecar"
+	ForkChoiceDataType       DataType = "fork_choice"
+	BeaconBadBlockDataType   DataType = "beacon_bad_block"
+	BeaconBadBlobDataType    DataType = "beacon_bad_blob"
+	BlockTraceDataType       DataType = "execution_block_trace"
+	ExecutionWitnessDataType DataType = "execution_witness"
+	BadBlockDataType         DataType = "execution_bad_block"
 )
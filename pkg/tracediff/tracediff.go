@@ -0,0 +1,502 @@
+package tracediff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// StructLoggerTracer is the name of the default debug_traceBlockByHash tracer,
+// the only one whose output Diff understands.
+const StructLoggerTracer = "structLogger"
+
+// Result is the structured difference between two debug_traceBlockByHash
+// struct-log traces of the same block.
+type Result struct {
+	// Identical is true when no divergence was found.
+	Identical bool
+	// TransactionCount is the number of transactions in the first trace.
+	TransactionCount int
+	// OtherTransactionCount is the number of transactions in the second trace.
+	OtherTransactionCount int
+	// FirstDivergence is the first transaction that differs. Nil if Identical.
+	FirstDivergence *TransactionDiff
+}
+
+// TransactionDiff describes the first transaction where two traces disagree.
+type TransactionDiff struct {
+	TransactionIndex int
+	TransactionHash  string
+	// DifferingFields lists the transaction level fields that differ, e.g.
+	// "gas", "failed", "return_value", "step_count" or "missing".
+	DifferingFields []string
+
+	Gas              uint64
+	OtherGas         uint64
+	Failed           bool
+	OtherFailed      bool
+	ReturnValue      string
+	OtherReturnValue string
+	StepCount        int
+	OtherStepCount   int
+
+	// FirstDivergentStep is the first opcode step that differs. Nil if the
+	// struct logs are identical (or one side is missing entirely).
+	FirstDivergentStep *StepDiff
+}
+
+// Step is a single opcode step from a struct log.
+type Step struct {
+	PC      uint64
+	Op      string
+	Gas     uint64
+	GasCost uint64
+	Depth   uint64
+}
+
+// StepDiff describes the first opcode step where two transactions disagree.
+type StepDiff struct {
+	Index int
+	// DifferingFields lists the step fields that differ, e.g. "op", "gas",
+	// "stack", "memory" or "storage".
+	DifferingFields []string
+
+	Step      *Step
+	OtherStep *Step
+	// GasDelta is the other step's remaining gas minus this step's remaining gas.
+	GasDelta int64
+
+	Stack   []ValueDiff
+	Memory  []ValueDiff
+	Storage []ValueDiff
+}
+
+// ValueDiff is a single differing stack item, memory word or storage slot.
+// Key is the index for stack/memory and the slot for storage. An empty value
+// means the entry is absent on that side.
+type ValueDiff struct {
+	Key        string
+	Value      string
+	OtherValue string
+}
+
+type transactionTrace struct {
+	TxHash string             `json:"txHash"`
+	Result *transactionResult `json:"result"`
+
+	// Some clients return the trace result directly instead of wrapping it
+	// alongside the transaction hash.
+	transactionResult
+}
+
+// result returns the trace result, wrapped or not.
+func (t *transactionTrace) result() *transactionResult {
+	if t.Result != nil {
+		return t.Result
+	}
+
+	return &t.transactionResult
+}
+
+type transactionResult struct {
+	Gas         flexUint64  `json:"gas"`
+	Failed      bool        `json:"failed"`
+	ReturnValue string      `json:"returnValue"`
+	StructLogs  []structLog `json:"structLogs"`
+}
+
+type structLog struct {
+	PC      flexUint64        `json:"pc"`
+	Op      string            `json:"op"`
+	Gas     flexUint64        `json:"gas"`
+	GasCost flexUint64        `json:"gasCost"`
+	Depth   flexUint64        `json:"depth"`
+	Stack   []string          `json:"stack"`
+	Memory  []string          `json:"memory"`
+	Storage map[string]string `json:"storage"`
+}
+
+// flexUint64 accepts both JSON numbers and hex/decimal strings since clients
+// don't agree on how to encode gas and pc values.
+type flexUint64 uint64
+
+func (f *flexUint64) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*f = 0
+
+		return nil
+	}
+
+	var (
+		v   uint64
+		err error
+	)
+
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		v, err = strconv.ParseUint(s[2:], 16, 64)
+	} else {
+		v, err = strconv.ParseUint(s, 10, 64)
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid integer %q: %w", s, err)
+	}
+
+	*f = flexUint64(v)
+
+	return nil
+}
+
+// Diff compares two uncompressed debug_traceBlockByHash responses and returns
+// the first point at which they diverge. Both traces are read in lockstep, one
+// transaction at a time, so a trace is never held in memory in full.
+func Diff(trace, other io.Reader) (*Result, error) {
+	a, err := newTransactionStream(trace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse trace: %w", err)
+	}
+
+	b, err := newTransactionStream(other)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse other trace: %w", err)
+	}
+
+	result := &Result{
+		Identical: true,
+	}
+
+	for idx := 0; ; idx++ {
+		ta, err := a.next()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse transaction %d in trace: %w", idx, err)
+		}
+
+		tb, err := b.next()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse transaction %d in other trace: %w", idx, err)
+		}
+
+		if ta == nil && tb == nil {
+			break
+		}
+
+		if ta == nil || tb == nil {
+			diff := &TransactionDiff{
+				TransactionIndex: idx,
+				DifferingFields:  []string{"missing"},
+			}
+
+			if ta != nil {
+				diff.TransactionHash = ta.TxHash
+			} else {
+				diff.TransactionHash = tb.TxHash
+			}
+
+			result.Identical = false
+			result.FirstDivergence = diff
+
+			break
+		}
+
+		if diff := diffTransaction(idx, ta, tb); diff != nil {
+			result.Identical = false
+			result.FirstDivergence = diff
+
+			break
+		}
+	}
+
+	// Count the rest of both traces' transactions.
+	if err := a.skip(); err != nil {
+		return nil, fmt.Errorf("failed to parse trace: %w", err)
+	}
+
+	if err := b.skip(); err != nil {
+		return nil, fmt.Errorf("failed to parse other trace: %w", err)
+	}
+
+	result.TransactionCount = a.count
+	result.OtherTransactionCount = b.count
+
+	return result, nil
+}
+
+// transactionStream decodes the transactions of a trace one at a time.
+type transactionStream struct {
+	dec   *json.Decoder
+	count int
+	done  bool
+}
+
+func newTransactionStream(r io.Reader) (*transactionStream, error) {
+	dec := json.NewDecoder(r)
+
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("expected an array of transactions, got %v", token)
+	}
+
+	return &transactionStream{dec: dec}, nil
+}
+
+// next returns the next transaction, or nil at the end of the trace.
+func (s *transactionStream) next() (*transactionTrace, error) {
+	if s.done {
+		return nil, nil
+	}
+
+	if !s.dec.More() {
+		return nil, s.end()
+	}
+
+	var tx transactionTrace
+	if err := s.dec.Decode(&tx); err != nil {
+		return nil, err
+	}
+
+	s.count++
+
+	return &tx, nil
+}
+
+// skip counts the remaining transactions without decoding them.
+func (s *transactionStream) skip() error {
+	for !s.done && s.dec.More() {
+		var raw json.RawMessage
+		if err := s.dec.Decode(&raw); err != nil {
+			return err
+		}
+
+		s.count++
+	}
+
+	if s.done {
+		return nil
+	}
+
+	return s.end()
+}
+
+func (s *transactionStream) end() error {
+	if _, err := s.dec.Token(); err != nil {
+		return err
+	}
+
+	s.done = true
+
+	return nil
+}
+
+func diffTransaction(idx int, a, b *transactionTrace) *TransactionDiff {
+	ra, rb := a.result(), b.result()
+
+	diff := &TransactionDiff{
+		TransactionIndex: idx,
+		TransactionHash:  a.TxHash,
+		Gas:              uint64(ra.Gas),
+		OtherGas:         uint64(rb.Gas),
+		Failed:           ra.Failed,
+		OtherFailed:      rb.Failed,
+		ReturnValue:      ra.ReturnValue,
+		OtherReturnValue: rb.ReturnValue,
+		StepCount:        len(ra.StructLogs),
+		OtherStepCount:   len(rb.StructLogs),
+	}
+
+	if diff.TransactionHash == "" {
+		diff.TransactionHash = b.TxHash
+	}
+
+	if diff.Gas != diff.OtherGas {
+		diff.DifferingFields = append(diff.DifferingFields, "gas")
+	}
+
+	if diff.Failed != diff.OtherFailed {
+		diff.DifferingFields = append(diff.DifferingFields, "failed")
+	}
+
+	if normalizeHex(diff.ReturnValue) != normalizeHex(diff.OtherReturnValue) {
+		diff.DifferingFields = append(diff.DifferingFields, "return_value")
+	}
+
+	if diff.StepCount != diff.OtherStepCount {
+		diff.DifferingFields = append(diff.DifferingFields, "step_count")
+	}
+
+	steps := len(ra.StructLogs)
+	if len(rb.StructLogs) < steps {
+		steps = len(rb.StructLogs)
+	}
+
+	for i := 0; i < steps; i++ {
+		if step := diffStep(i, &ra.StructLogs[i], &rb.StructLogs[i]); step != nil {
+			diff.FirstDivergentStep = step
+
+			break
+		}
+	}
+
+	if len(diff.DifferingFields) == 0 && diff.FirstDivergentStep == nil {
+		return nil
+	}
+
+	return diff
+}
+
+func diffStep(idx int, a, b *structLog) *StepDiff {
+	diff := &StepDiff{
+		Index: idx,
+		Step: &Step{
+			PC:      uint64(a.PC),
+			Op:      a.Op,
+			Gas:     uint64(a.Gas),
+			GasCost: uint64(a.GasCost),
+			Depth:   uint64(a.Depth),
+		},
+		OtherStep: &Step{
+			PC:      uint64(b.PC),
+			Op:      b.Op,
+			Gas:     uint64(b.Gas),
+			GasCost: uint64(b.GasCost),
+			Depth:   uint64(b.Depth),
+		},
+		//nolint:gosec // gas values comfortably fit in an int64
+		GasDelta: int64(b.Gas) - int64(a.Gas),
+	}
+
+	if a.PC != b.PC {
+		diff.DifferingFields = append(diff.DifferingFields, "pc")
+	}
+
+	if !strings.EqualFold(a.Op, b.Op) {
+		diff.DifferingFields = append(diff.DifferingFields, "op")
+	}
+
+	if a.Gas != b.Gas {
+		diff.DifferingFields = append(diff.DifferingFields, "gas")
+	}
+
+	if a.GasCost != b.GasCost {
+		diff.DifferingFields = append(diff.DifferingFields, "gas_cost")
+	}
+
+	if a.Depth != b.Depth {
+		diff.DifferingFields = append(diff.DifferingFields, "depth")
+	}
+
+	// Stack, memory and storage are optional (they can be disabled on the tracer)
+	// so only compare them when both sides captured them.
+	if a.Stack != nil && b.Stack != nil {
+		if diff.Stack = diffList(a.Stack, b.Stack); len(diff.Stack) > 0 {
+			diff.DifferingFields = append(diff.DifferingFields, "stack")
+		}
+	}
+
+	if a.Memory != nil && b.Memory != nil {
+		if diff.Memory = diffList(a.Memory, b.Memory); len(diff.Memory) > 0 {
+			diff.DifferingFields = append(diff.DifferingFields, "memory")
+		}
+	}
+
+	if a.Storage != nil && b.Storage != nil {
+		if diff.Storage = diffMap(a.Storage, b.Storage); len(diff.Storage) > 0 {
+			diff.DifferingFields = append(diff.DifferingFields, "storage")
+		}
+	}
+
+	if len(diff.DifferingFields) == 0 {
+		return nil
+	}
+
+	return diff
+}
+
+func diffList(a, b []string) []ValueDiff {
+	var diffs []ValueDiff
+
+	count := len(a)
+	if len(b) > count {
+		count = len(b)
+	}
+
+	for i := 0; i < count; i++ {
+		var va, vb string
+
+		if i < len(a) {
+			va = a[i]
+		}
+
+		if i < len(b) {
+			vb = b[i]
+		}
+
+		if i < len(a) && i < len(b) && normalizeHex(va) == normalizeHex(vb) {
+			continue
+		}
+
+		diffs = append(diffs, ValueDiff{Key: strconv.Itoa(i), Value: va, OtherValue: vb})
+	}
+
+	return diffs
+}
+
+func diffMap(a, b map[string]string) []ValueDiff {
+	na := make(map[string]string, len(a))
+	for k, v := range a {
+		na[normalizeHex(k)] = v
+	}
+
+	nb := make(map[string]string, len(b))
+	for k, v := range b {
+		nb[normalizeHex(k)] = v
+	}
+
+	keys := make(map[string]struct{}, len(na)+len(nb))
+	for k := range na {
+		keys[k] = struct{}{}
+	}
+
+	for k := range nb {
+		keys[k] = struct{}{}
+	}
+
+	var diffs []ValueDiff
+
+	for k := range keys {
+		va, oka := na[k]
+		vb, okb := nb[k]
+
+		if oka && okb && normalizeHex(va) == normalizeHex(vb) {
+			continue
+		}
+
+		diffs = append(diffs, ValueDiff{Key: "0x" + k, Value: va, OtherValue: vb})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Key < diffs[j].Key
+	})
+
+	return diffs
+}
+
+// normalizeHex strips the 0x prefix and leading zeros so that values padded
+// differently by different clients compare equal.
+func normalizeHex(s string) string {
+	s = strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		return "0"
+	}
+
+	return s
+}
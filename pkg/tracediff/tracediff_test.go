@@ -0,0 +1,160 @@
+package tracediff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const baseTrace = `[
+	{
+		"txHash": "0xaaa",
+		"result": {
+			"gas": 21000,
+			"failed": false,
+			"returnValue": "",
+			"structLogs": [
+				{"pc": 0, "op": "PUSH1", "gas": 100, "gasCost": 3, "depth": 1, "stack": []},
+				{"pc": 2, "op": "SSTORE", "gas": 97, "gasCost": 20000, "depth": 1, "stack": ["0x01", "0x02"], "storage": {"0x01": "0x02"}}
+			]
+		}
+	},
+	{
+		"txHash": "0xbbb",
+		"result": {
+			"gas": 30000,
+			"failed": false,
+			"returnValue": "0x01",
+			"structLogs": [
+				{"pc": 0, "op": "PUSH1", "gas": 200, "gasCost": 3, "depth": 1}
+			]
+		}
+	}
+]`
+
+func TestDiff_Identical(t *testing.T) {
+	result, err := Diff(strings.NewReader(baseTrace), strings.NewReader(baseTrace))
+	require.NoError(t, err)
+
+	assert.True(t, result.Identical)
+	assert.Equal(t, 2, result.TransactionCount)
+	assert.Equal(t, 2, result.OtherTransactionCount)
+	assert.Nil(t, result.FirstDivergence)
+}
+
+func TestDiff_BareResultsAndHexPadding(t *testing.T) {
+	// Same trace as the first transaction of baseTrace, but without the txHash
+	// wrapper and with hex encoded, zero padded values.
+	trace := `[{"gas": 21000, "failed": false, "returnValue": "", "structLogs": [{"pc": 0, "op": "PUSH1", "gas": 100, "gasCost": 3, "depth": 1}]}]`
+	other := `[{"gas": "0x5208", "failed": false, "returnValue": "0x", "structLogs": [{"pc": "0x0", "op": "push1", "gas": "0x64", "gasCost": "0x3", "depth": 1}]}]`
+
+	result, err := Diff(strings.NewReader(trace), strings.NewReader(other))
+	require.NoError(t, err)
+
+	assert.True(t, result.Identical)
+}
+
+func TestDiff_StepDivergence(t *testing.T) {
+	other := `[
+		{
+			"txHash": "0xaaa",
+			"result": {
+				"gas": 21000,
+				"failed": false,
+				"returnValue": "",
+				"structLogs": [
+					{"pc": 0, "op": "PUSH1", "gas": 100, "gasCost": 3, "depth": 1, "stack": []},
+					{"pc": 2, "op": "SSTORE", "gas": 90, "gasCost": 20000, "depth": 1, "stack": ["0x0000000000000000000000000000000000000000000000000000000000000001", "0x03"], "storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": "0x03"}}
+				]
+			}
+		}
+	]`
+
+	result, err := Diff(strings.NewReader(baseTrace), strings.NewReader(other))
+	require.NoError(t, err)
+
+	assert.False(t, result.Identical)
+	require.NotNil(t, result.FirstDivergence)
+
+	tx := result.FirstDivergence
+	assert.Equal(t, 0, tx.TransactionIndex)
+	assert.Equal(t, "0xaaa", tx.TransactionHash)
+	assert.Empty(t, tx.DifferingFields)
+	require.NotNil(t, tx.FirstDivergentStep)
+
+	step := tx.FirstDivergentStep
+	assert.Equal(t, 1, step.Index)
+	assert.Equal(t, []string{"gas", "stack", "storage"}, step.DifferingFields)
+	assert.Equal(t, int64(-7), step.GasDelta)
+	assert.Equal(t, "SSTORE", step.Step.Op)
+
+	assert.Equal(t, []ValueDiff{{Key: "1", Value: "0x02", OtherValue: "0x03"}}, step.Stack)
+	assert.Equal(t, []ValueDiff{{Key: "0x1", Value: "0x02", OtherValue: "0x03"}}, step.Storage)
+	assert.Empty(t, step.Memory)
+}
+
+func TestDiff_TransactionDivergence(t *testing.T) {
+	other := `[
+		{
+			"txHash": "0xaaa",
+			"result": {
+				"gas": 21000,
+				"failed": true,
+				"returnValue": "",
+				"structLogs": [
+					{"pc": 0, "op": "PUSH1", "gas": 100, "gasCost": 3, "depth": 1, "stack": []}
+				]
+			}
+		}
+	]`
+
+	result, err := Diff(strings.NewReader(baseTrace), strings.NewReader(other))
+	require.NoError(t, err)
+
+	// Transactions after the divergence are still counted.
+	assert.Equal(t, 2, result.TransactionCount)
+	assert.Equal(t, 1, result.OtherTransactionCount)
+
+	require.NotNil(t, result.FirstDivergence)
+	assert.Equal(t, []string{"failed", "step_count"}, result.FirstDivergence.DifferingFields)
+	assert.Equal(t, 2, result.FirstDivergence.StepCount)
+	assert.Equal(t, 1, result.FirstDivergence.OtherStepCount)
+	assert.Nil(t, result.FirstDivergence.FirstDivergentStep)
+}
+
+func TestDiff_MissingTransaction(t *testing.T) {
+	other := `[
+		{
+			"txHash": "0xaaa",
+			"result": {
+				"gas": 21000,
+				"failed": false,
+				"returnValue": "",
+				"structLogs": [
+					{"pc": 0, "op": "PUSH1", "gas": 100, "gasCost": 3, "depth": 1, "stack": []},
+					{"pc": 2, "op": "SSTORE", "gas": 97, "gasCost": 20000, "depth": 1, "stack": ["0x01", "0x02"], "storage": {"0x01": "0x02"}}
+				]
+			}
+		}
+	]`
+
+	result, err := Diff(strings.NewReader(baseTrace), strings.NewReader(other))
+	require.NoError(t, err)
+
+	assert.False(t, result.Identical)
+	assert.Equal(t, 1, result.OtherTransactionCount)
+	require.NotNil(t, result.FirstDivergence)
+	assert.Equal(t, 1, result.FirstDivergence.TransactionIndex)
+	assert.Equal(t, "0xbbb", result.FirstDivergence.TransactionHash)
+	assert.Equal(t, []string{"missing"}, result.FirstDivergence.DifferingFields)
+}
+
+func TestDiff_InvalidJSON(t *testing.T) {
+	_, err := Diff(strings.NewReader("not json"), strings.NewReader(baseTrace))
+	assert.Error(t, err)
+
+	_, err = Diff(strings.NewReader(baseTrace), strings.NewReader(`[{"txHash": "0xaaa", "result": {"gas": "nope"}}]`))
+	assert.Error(t, err)
+}